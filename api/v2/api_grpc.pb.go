@@ -19,25 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Dex_GetClient_FullMethodName       = "/api.Dex/GetClient"
-	Dex_CreateClient_FullMethodName    = "/api.Dex/CreateClient"
-	Dex_UpdateClient_FullMethodName    = "/api.Dex/UpdateClient"
-	Dex_DeleteClient_FullMethodName    = "/api.Dex/DeleteClient"
-	Dex_ListClients_FullMethodName     = "/api.Dex/ListClients"
-	Dex_CreatePassword_FullMethodName  = "/api.Dex/CreatePassword"
-	Dex_UpdatePassword_FullMethodName  = "/api.Dex/UpdatePassword"
-	Dex_DeletePassword_FullMethodName  = "/api.Dex/DeletePassword"
-	Dex_ListPasswords_FullMethodName   = "/api.Dex/ListPasswords"
-	Dex_CreateConnector_FullMethodName = "/api.Dex/CreateConnector"
-	Dex_UpdateConnector_FullMethodName = "/api.Dex/UpdateConnector"
-	Dex_DeleteConnector_FullMethodName = "/api.Dex/DeleteConnector"
-	Dex_ListConnectors_FullMethodName  = "/api.Dex/ListConnectors"
-	Dex_GetVersion_FullMethodName      = "/api.Dex/GetVersion"
-	Dex_GetDiscovery_FullMethodName    = "/api.Dex/GetDiscovery"
-	Dex_ListRefresh_FullMethodName     = "/api.Dex/ListRefresh"
-	Dex_RevokeRefresh_FullMethodName   = "/api.Dex/RevokeRefresh"
-	Dex_VerifyPassword_FullMethodName  = "/api.Dex/VerifyPassword"
-	Dex_ReloadConfig_FullMethodName    = "/api.Dex/ReloadConfig"
+	Dex_GetClient_FullMethodName                = "/api.Dex/GetClient"
+	Dex_CreateClient_FullMethodName             = "/api.Dex/CreateClient"
+	Dex_UpdateClient_FullMethodName             = "/api.Dex/UpdateClient"
+	Dex_DeleteClient_FullMethodName             = "/api.Dex/DeleteClient"
+	Dex_ListClients_FullMethodName              = "/api.Dex/ListClients"
+	Dex_CreatePassword_FullMethodName           = "/api.Dex/CreatePassword"
+	Dex_UpdatePassword_FullMethodName           = "/api.Dex/UpdatePassword"
+	Dex_DeletePassword_FullMethodName           = "/api.Dex/DeletePassword"
+	Dex_ListPasswords_FullMethodName            = "/api.Dex/ListPasswords"
+	Dex_CreateConnector_FullMethodName          = "/api.Dex/CreateConnector"
+	Dex_UpdateConnector_FullMethodName          = "/api.Dex/UpdateConnector"
+	Dex_DeleteConnector_FullMethodName          = "/api.Dex/DeleteConnector"
+	Dex_ListConnectors_FullMethodName           = "/api.Dex/ListConnectors"
+	Dex_GetVersion_FullMethodName               = "/api.Dex/GetVersion"
+	Dex_GetDiscovery_FullMethodName             = "/api.Dex/GetDiscovery"
+	Dex_ListRefresh_FullMethodName              = "/api.Dex/ListRefresh"
+	Dex_RevokeRefresh_FullMethodName            = "/api.Dex/RevokeRefresh"
+	Dex_VerifyPassword_FullMethodName           = "/api.Dex/VerifyPassword"
+	Dex_ReloadConfig_FullMethodName             = "/api.Dex/ReloadConfig"
+	Dex_CreateInitialAccessToken_FullMethodName = "/api.Dex/CreateInitialAccessToken"
+	Dex_DeleteInitialAccessToken_FullMethodName = "/api.Dex/DeleteInitialAccessToken"
+	Dex_ListConsents_FullMethodName             = "/api.Dex/ListConsents"
+	Dex_RevokeConsent_FullMethodName            = "/api.Dex/RevokeConsent"
+	Dex_CreateScope_FullMethodName              = "/api.Dex/CreateScope"
+	Dex_UpdateScope_FullMethodName              = "/api.Dex/UpdateScope"
+	Dex_DeleteScope_FullMethodName              = "/api.Dex/DeleteScope"
+	Dex_ListScopes_FullMethodName               = "/api.Dex/ListScopes"
 )
 
 // DexClient is the client API for Dex service.
@@ -86,6 +94,24 @@ type DexClient interface {
 	VerifyPassword(ctx context.Context, in *VerifyPasswordReq, opts ...grpc.CallOption) (*VerifyPasswordResp, error)
 	// ReloadConfig reloads the server configuration from the configuration file without restarting.
 	ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigResp, error)
+	// CreateInitialAccessToken issues a token clients can be registered with at
+	// the dynamic client registration endpoint.
+	CreateInitialAccessToken(ctx context.Context, in *CreateInitialAccessTokenReq, opts ...grpc.CallOption) (*CreateInitialAccessTokenResp, error)
+	// DeleteInitialAccessToken revokes an initial access token.
+	DeleteInitialAccessToken(ctx context.Context, in *DeleteInitialAccessTokenReq, opts ...grpc.CallOption) (*DeleteInitialAccessTokenResp, error)
+	// ListConsents lists the clients a user approved and the scopes granted.
+	ListConsents(ctx context.Context, in *ListConsentsReq, opts ...grpc.CallOption) (*ListConsentsResp, error)
+	// RevokeConsent revokes the consent of a user to a client, so the user is
+	// asked to approve the client again on the next login.
+	RevokeConsent(ctx context.Context, in *RevokeConsentReq, opts ...grpc.CallOption) (*RevokeConsentResp, error)
+	// CreateScope defines a custom scope.
+	CreateScope(ctx context.Context, in *CreateScopeReq, opts ...grpc.CallOption) (*CreateScopeResp, error)
+	// UpdateScope replaces the definition of a custom scope.
+	UpdateScope(ctx context.Context, in *UpdateScopeReq, opts ...grpc.CallOption) (*UpdateScopeResp, error)
+	// DeleteScope deletes a custom scope.
+	DeleteScope(ctx context.Context, in *DeleteScopeReq, opts ...grpc.CallOption) (*DeleteScopeResp, error)
+	// ListScopes lists the custom scopes.
+	ListScopes(ctx context.Context, in *ListScopesReq, opts ...grpc.CallOption) (*ListScopesResp, error)
 }
 
 type dexClient struct {
@@ -286,6 +312,86 @@ func (c *dexClient) ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts
 	return out, nil
 }
 
+func (c *dexClient) CreateInitialAccessToken(ctx context.Context, in *CreateInitialAccessTokenReq, opts ...grpc.CallOption) (*CreateInitialAccessTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInitialAccessTokenResp)
+	err := c.cc.Invoke(ctx, Dex_CreateInitialAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) DeleteInitialAccessToken(ctx context.Context, in *DeleteInitialAccessTokenReq, opts ...grpc.CallOption) (*DeleteInitialAccessTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInitialAccessTokenResp)
+	err := c.cc.Invoke(ctx, Dex_DeleteInitialAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) ListConsents(ctx context.Context, in *ListConsentsReq, opts ...grpc.CallOption) (*ListConsentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConsentsResp)
+	err := c.cc.Invoke(ctx, Dex_ListConsents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) RevokeConsent(ctx context.Context, in *RevokeConsentReq, opts ...grpc.CallOption) (*RevokeConsentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeConsentResp)
+	err := c.cc.Invoke(ctx, Dex_RevokeConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) CreateScope(ctx context.Context, in *CreateScopeReq, opts ...grpc.CallOption) (*CreateScopeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScopeResp)
+	err := c.cc.Invoke(ctx, Dex_CreateScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) UpdateScope(ctx context.Context, in *UpdateScopeReq, opts ...grpc.CallOption) (*UpdateScopeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateScopeResp)
+	err := c.cc.Invoke(ctx, Dex_UpdateScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) DeleteScope(ctx context.Context, in *DeleteScopeReq, opts ...grpc.CallOption) (*DeleteScopeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScopeResp)
+	err := c.cc.Invoke(ctx, Dex_DeleteScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) ListScopes(ctx context.Context, in *ListScopesReq, opts ...grpc.CallOption) (*ListScopesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScopesResp)
+	err := c.cc.Invoke(ctx, Dex_ListScopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DexServer is the server API for Dex service.
 // All implementations must embed UnimplementedDexServer
 // for forward compatibility.
@@ -332,6 +438,24 @@ type DexServer interface {
 	VerifyPassword(context.Context, *VerifyPasswordReq) (*VerifyPasswordResp, error)
 	// ReloadConfig reloads the server configuration from the configuration file without restarting.
 	ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigResp, error)
+	// CreateInitialAccessToken issues a token clients can be registered with at
+	// the dynamic client registration endpoint.
+	CreateInitialAccessToken(context.Context, *CreateInitialAccessTokenReq) (*CreateInitialAccessTokenResp, error)
+	// DeleteInitialAccessToken revokes an initial access token.
+	DeleteInitialAccessToken(context.Context, *DeleteInitialAccessTokenReq) (*DeleteInitialAccessTokenResp, error)
+	// ListConsents lists the clients a user approved and the scopes granted.
+	ListConsents(context.Context, *ListConsentsReq) (*ListConsentsResp, error)
+	// RevokeConsent revokes the consent of a user to a client, so the user is
+	// asked to approve the client again on the next login.
+	RevokeConsent(context.Context, *RevokeConsentReq) (*RevokeConsentResp, error)
+	// CreateScope defines a custom scope.
+	CreateScope(context.Context, *CreateScopeReq) (*CreateScopeResp, error)
+	// UpdateScope replaces the definition of a custom scope.
+	UpdateScope(context.Context, *UpdateScopeReq) (*UpdateScopeResp, error)
+	// DeleteScope deletes a custom scope.
+	DeleteScope(context.Context, *DeleteScopeReq) (*DeleteScopeResp, error)
+	// ListScopes lists the custom scopes.
+	ListScopes(context.Context, *ListScopesReq) (*ListScopesResp, error)
 	mustEmbedUnimplementedDexServer()
 }
 
@@ -399,6 +523,30 @@ func (UnimplementedDexServer) VerifyPassword(context.Context, *VerifyPasswordReq
 func (UnimplementedDexServer) ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
 }
+func (UnimplementedDexServer) CreateInitialAccessToken(context.Context, *CreateInitialAccessTokenReq) (*CreateInitialAccessTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInitialAccessToken not implemented")
+}
+func (UnimplementedDexServer) DeleteInitialAccessToken(context.Context, *DeleteInitialAccessTokenReq) (*DeleteInitialAccessTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInitialAccessToken not implemented")
+}
+func (UnimplementedDexServer) ListConsents(context.Context, *ListConsentsReq) (*ListConsentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsents not implemented")
+}
+func (UnimplementedDexServer) RevokeConsent(context.Context, *RevokeConsentReq) (*RevokeConsentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConsent not implemented")
+}
+func (UnimplementedDexServer) CreateScope(context.Context, *CreateScopeReq) (*CreateScopeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScope not implemented")
+}
+func (UnimplementedDexServer) UpdateScope(context.Context, *UpdateScopeReq) (*UpdateScopeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScope not implemented")
+}
+func (UnimplementedDexServer) DeleteScope(context.Context, *DeleteScopeReq) (*DeleteScopeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
+}
+func (UnimplementedDexServer) ListScopes(context.Context, *ListScopesReq) (*ListScopesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScopes not implemented")
+}
 func (UnimplementedDexServer) mustEmbedUnimplementedDexServer() {}
 func (UnimplementedDexServer) testEmbeddedByValue()             {}
 
@@ -762,6 +910,150 @@ func _Dex_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dex_CreateInitialAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInitialAccessTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).CreateInitialAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_CreateInitialAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).CreateInitialAccessToken(ctx, req.(*CreateInitialAccessTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_DeleteInitialAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInitialAccessTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).DeleteInitialAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_DeleteInitialAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).DeleteInitialAccessToken(ctx, req.(*DeleteInitialAccessTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_ListConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ListConsents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_ListConsents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ListConsents(ctx, req.(*ListConsentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_RevokeConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeConsentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).RevokeConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_RevokeConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).RevokeConsent(ctx, req.(*RevokeConsentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_CreateScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScopeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).CreateScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_CreateScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).CreateScope(ctx, req.(*CreateScopeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_UpdateScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScopeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).UpdateScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_UpdateScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).UpdateScope(ctx, req.(*UpdateScopeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScopeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).DeleteScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_DeleteScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).DeleteScope(ctx, req.(*DeleteScopeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_ListScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScopesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ListScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dex_ListScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ListScopes(ctx, req.(*ListScopesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dex_ServiceDesc is the grpc.ServiceDesc for Dex service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -845,6 +1137,38 @@ var Dex_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReloadConfig",
 			Handler:    _Dex_ReloadConfig_Handler,
 		},
+		{
+			MethodName: "CreateInitialAccessToken",
+			Handler:    _Dex_CreateInitialAccessToken_Handler,
+		},
+		{
+			MethodName: "DeleteInitialAccessToken",
+			Handler:    _Dex_DeleteInitialAccessToken_Handler,
+		},
+		{
+			MethodName: "ListConsents",
+			Handler:    _Dex_ListConsents_Handler,
+		},
+		{
+			MethodName: "RevokeConsent",
+			Handler:    _Dex_RevokeConsent_Handler,
+		},
+		{
+			MethodName: "CreateScope",
+			Handler:    _Dex_CreateScope_Handler,
+		},
+		{
+			MethodName: "UpdateScope",
+			Handler:    _Dex_UpdateScope_Handler,
+		},
+		{
+			MethodName: "DeleteScope",
+			Handler:    _Dex_DeleteScope_Handler,
+		},
+		{
+			MethodName: "ListScopes",
+			Handler:    _Dex_ListScopes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v2/api.proto",
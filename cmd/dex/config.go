@@ -54,6 +54,9 @@ type Config struct {
 	// upstream identity provider.
 	LoginRateLimit LoginRateLimit `json:"loginRateLimit"`
 
+	// Sessions lets a browser reuse a connector login across clients.
+	Sessions Sessions `json:"sessions"`
+
 	// Signer configuration controls signing of JWT tokens issued by Dex.
 	Signer Signer `json:"signer"`
 
@@ -73,6 +76,10 @@ type Config struct {
 	// querying the storage. Cannot be specified without enabling a passwords
 	// database.
 	StaticPasswords []password `json:"staticPasswords"`
+
+	// StaticScopes are custom scopes clients can request on top of the built-in
+	// ones. Write operations, like updating a scope, will fail.
+	StaticScopes []storage.Scope `json:"staticScopes"`
 }
 
 // Validate the configuration
@@ -91,6 +98,8 @@ func (c Config) Validate() error {
 		{c.Web.TLSMinVersion != "" && c.Web.TLSMinVersion != "1.2" && c.Web.TLSMinVersion != "1.3", "supported TLS versions are: 1.2, 1.3"},
 		{c.Web.TLSMaxVersion != "" && c.Web.TLSMaxVersion != "1.2" && c.Web.TLSMaxVersion != "1.3", "supported TLS versions are: 1.2, 1.3"},
 		{c.Web.TLSMaxVersion != "" && c.Web.TLSMinVersion != "" && c.Web.TLSMinVersion > c.Web.TLSMaxVersion, "TLSMinVersion greater than TLSMaxVersion"},
+		{c.Web.TLSClientAuth && c.Web.HTTPS == "", "cannot enable TLS client auth without HTTPS"},
+		{c.Web.TLSClientCA != "" && !c.Web.TLSClientAuth, "cannot specify a web TLS client CA without enabling TLS client auth"},
 		{c.GRPC.TLSCert != "" && c.GRPC.Addr == "", "no address specified for gRPC"},
 		{c.GRPC.TLSKey != "" && c.GRPC.Addr == "", "no address specified for gRPC"},
 		{(c.GRPC.TLSCert == "") != (c.GRPC.TLSKey == ""), "must specific both a gRPC TLS cert and key"},
@@ -179,6 +188,33 @@ type OAuth2 struct {
 	AlwaysShowLoginScreen bool `json:"alwaysShowLoginScreen"`
 	// This is the connector that can be used for password grant
 	PasswordConnector string `json:"passwordConnector"`
+	// Resources a client may ask an access token for with the RFC 8707
+	// "resource" parameter of a token request.
+	Resources []Resource `json:"resources"`
+	// Until this time (RFC 3339), /userinfo keeps accepting the untyped access
+	// tokens issued before RFC 9068 access tokens. These were copies of the ID
+	// token, so ID tokens are accepted as well. Unset, they are accepted for the
+	// ID token expiry after dex starts. A time in the past rejects them.
+	LegacyAccessTokensUntil string `json:"legacyAccessTokensUntil"`
+	// Scope clients request to receive the extra claims connectors pass on
+	// about users. No extra claims are released when it is empty.
+	ExtraClaimsScope string `json:"extraClaimsScope"`
+	// How the "sub" claim is formed from the user ID and the connector:
+	// "raw" (default), "connector", "uuid" or "legacy".
+	SubjectFormat string `json:"subjectFormat"`
+	// Secret the subjects of clients with the pairwise subject type are
+	// derived from. Required by such clients.
+	PairwiseSubjectSecret string `json:"pairwiseSubjectSecret"`
+}
+
+// Resource is a resource server access tokens can be issued for.
+type Resource struct {
+	// Resource is the value of the "resource" parameter, usually the URL of
+	// the resource server.
+	Resource string `json:"resource"`
+	// Audience is the "aud" claim of access tokens for the resource. Defaults
+	// to Resource.
+	Audience string `json:"audience"`
 }
 
 // Web is the config format for the HTTP server.
@@ -190,6 +226,8 @@ type Web struct {
 	TLSKey         string         `json:"tlsKey"`
 	TLSMinVersion  string         `json:"tlsMinVersion"`
 	TLSMaxVersion  string         `json:"tlsMaxVersion"`
+	TLSClientAuth  bool           `json:"tlsClientAuth"`
+	TLSClientCA    string         `json:"tlsClientCA"`
 	AllowedOrigins []string       `json:"allowedOrigins"`
 	AllowedHeaders []string       `json:"allowedHeaders"`
 	ClientRemoteIP ClientRemoteIP `json:"clientRemoteIP"`
@@ -549,12 +587,24 @@ type Expiry struct {
 	// IdTokens defines the duration of time for which the IdTokens will be valid.
 	IDTokens string `json:"idTokens"`
 
+	// AccessTokens defines the duration of time for which the access tokens will
+	// be valid. Defaults to IDTokens.
+	AccessTokens string `json:"accessTokens"`
+
 	// AuthRequests defines the duration of time for which the AuthRequests will be valid.
 	AuthRequests string `json:"authRequests"`
 
 	// DeviceRequests defines the duration of time for which the DeviceRequests will be valid.
 	DeviceRequests string `json:"deviceRequests"`
 
+	// PushedAuthRequests defines the duration of time for which the request_uri
+	// returned by the pushed authorization request endpoint can be used.
+	PushedAuthRequests string `json:"pushedAuthRequests"`
+
+	// ClientCredentialsTokens defines the duration of time for which tokens issued
+	// through the client_credentials grant will be valid.
+	ClientCredentialsTokens string `json:"clientCredentialsTokens"`
+
 	// RefreshTokens defines refresh tokens expiry policy
 	RefreshTokens RefreshToken `json:"refreshTokens"`
 }
@@ -581,6 +631,19 @@ type LoginRateLimit struct {
 	Window string `json:"window"`
 }
 
+// Sessions holds configuration for browser single sign-on sessions.
+type Sessions struct {
+	Enabled bool `json:"enabled"`
+
+	// IdleTimeout ends a session that has not been used for this long, e.g.
+	// "1h". Defaults to 1h.
+	IdleTimeout string `json:"idleTimeout"`
+
+	// AbsoluteLifetime ends a session this long after the user logged in,
+	// e.g. "24h". Defaults to 24h.
+	AbsoluteLifetime string `json:"absoluteLifetime"`
+}
+
 // Logger holds configuration required to customize logging for dex.
 type Logger struct {
 	// Level sets logging level severity.
@@ -14,6 +14,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"syscall"
@@ -64,6 +65,10 @@ var buildInfo = prometheus.NewGaugeVec(
 	[]string{"version", "go_version", "platform"},
 )
 
+// secretlessAuthMethods are the token endpoint auth methods of confidential
+// clients that authenticate without a client secret.
+var secretlessAuthMethods = []string{"private_key_jwt", "tls_client_auth", "self_signed_tls_client_auth"}
+
 func commandServe() *cobra.Command {
 	options := serveOptions{}
 
@@ -228,6 +233,18 @@ func runServe(options serveOptions) error {
 
 	logger.Info("config storage", "storage_type", c.Storage.Type)
 
+	// Parse expiry durations. Static clients are checked against the ID token
+	// expiry, which caps their token lifetimes.
+	idTokensValidFor := 24 * time.Hour // default
+	if c.Expiry.IDTokens != "" {
+		var err error
+		idTokensValidFor, err = time.ParseDuration(c.Expiry.IDTokens)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for id token expiry: %v", c.Expiry.IDTokens, err)
+		}
+		logger.Info("config id tokens", "valid_for", idTokensValidFor)
+	}
+
 	var updateStaticClients func([]storage.Client)
 
 	if len(c.StaticClients) > 0 {
@@ -244,7 +261,7 @@ func runServe(options serveOptions) error {
 				}
 				c.StaticClients[i].ID = os.Getenv(client.IDEnv)
 			}
-			if client.Secret == "" && client.SecretEnv == "" && !client.Public {
+			if client.Secret == "" && client.SecretEnv == "" && !client.Public && !slices.Contains(secretlessAuthMethods, client.TokenEndpointAuthMethod) {
 				return fmt.Errorf("invalid config: Secret or SecretEnv field is required for client %q", client.ID)
 			}
 			if client.SecretEnv != "" {
@@ -253,6 +270,32 @@ func runServe(options serveOptions) error {
 				}
 				c.StaticClients[i].Secret = os.Getenv(client.SecretEnv)
 			}
+			switch client.AccessTokenFormat {
+			case "", "jwt", "opaque":
+			default:
+				return fmt.Errorf("invalid config: unknown access token format %q for client %q", client.AccessTokenFormat, client.ID)
+			}
+			if err := server.ValidateClientKeys(client.JWKS, client.JWKSURI); err != nil {
+				return fmt.Errorf("invalid config: client %q: %v", client.ID, err)
+			}
+			if err := server.ValidateClientAuthMethod(client); err != nil {
+				return fmt.Errorf("invalid config: client %q: %v", client.ID, err)
+			}
+			if err := server.ValidateClaimMappings(client.ClaimMappings); err != nil {
+				return fmt.Errorf("invalid config: client %q: %v", client.ID, err)
+			}
+			if err := server.ValidateClientExpiry(client.Expiry, idTokensValidFor); err != nil {
+				return fmt.Errorf("invalid config: client %q: %v", client.ID, err)
+			}
+			if err := server.ValidateClientRestrictions(client); err != nil {
+				return fmt.Errorf("invalid config: client %q: %v", client.ID, err)
+			}
+			if err := server.ValidateSubjectType(client); err != nil {
+				return fmt.Errorf("invalid config: client %q: %v", client.ID, err)
+			}
+			if client.SubjectType == "pairwise" && c.OAuth2.PairwiseSubjectSecret == "" {
+				return fmt.Errorf("invalid config: client %q: pairwise subjects require oauth2.pairwiseSubjectSecret", client.ID)
+			}
 			logger.Info("config static client", "client_name", client.Name)
 		}
 		s, updateStaticClients = storage.WithStaticClients(s, c.StaticClients)
@@ -264,6 +307,17 @@ func runServe(options serveOptions) error {
 		}
 		s = storage.WithStaticPasswords(s, passwords, logger)
 	}
+	if len(c.StaticScopes) > 0 {
+		for _, sc := range c.StaticScopes {
+			if err := server.ValidateScope(sc); err != nil {
+				return fmt.Errorf("invalid config: %v", err)
+			}
+			if sc.Name == c.OAuth2.ExtraClaimsScope {
+				return fmt.Errorf("invalid config: scope %q is the extra claims scope", sc.Name)
+			}
+		}
+		s = storage.WithStaticScopes(s, c.StaticScopes)
+	}
 
 	storageConnectors := make([]storage.Connector, len(c.StaticConnectors))
 	for i, c := range c.StaticConnectors {
@@ -308,6 +362,9 @@ func runServe(options serveOptions) error {
 	if c.OAuth2.PasswordConnector != "" {
 		logger.Info("config using password grant connector", "password_connector", c.OAuth2.PasswordConnector)
 	}
+	if c.OAuth2.ExtraClaimsScope != "" {
+		logger.Info("config releasing extra claims", "scope", c.OAuth2.ExtraClaimsScope)
+	}
 	if len(c.Web.AllowedOrigins) > 0 {
 		logger.Info("config allowed origins", "origins", c.Web.AllowedOrigins)
 	}
@@ -320,17 +377,6 @@ func runServe(options serveOptions) error {
 
 	healthChecker := gosundheit.New()
 
-	// Parse expiry durations
-	idTokensValidFor := 24 * time.Hour // default
-	if c.Expiry.IDTokens != "" {
-		var err error
-		idTokensValidFor, err = time.ParseDuration(c.Expiry.IDTokens)
-		if err != nil {
-			return fmt.Errorf("invalid config value %q for id token expiry: %v", c.Expiry.IDTokens, err)
-		}
-		logger.Info("config id tokens", "valid_for", idTokensValidFor)
-	}
-
 	// Create signer
 	var signerInstance signer.Signer
 	switch c.Signer.Type {
@@ -385,6 +431,9 @@ func runServe(options serveOptions) error {
 		SkipApprovalScreen:         c.OAuth2.SkipApprovalScreen,
 		AlwaysShowLoginScreen:      c.OAuth2.AlwaysShowLoginScreen,
 		PasswordConnector:          c.OAuth2.PasswordConnector,
+		ExtraClaimsScope:           c.OAuth2.ExtraClaimsScope,
+		SubjectFormat:              c.OAuth2.SubjectFormat,
+		PairwiseSubjectSecret:      c.OAuth2.PairwiseSubjectSecret,
 		Headers:                    c.Web.Headers.ToHTTPHeader(),
 		AllowedOrigins:             c.Web.AllowedOrigins,
 		AllowedHeaders:             c.Web.AllowedHeaders,
@@ -422,6 +471,37 @@ func runServe(options serveOptions) error {
 		logger.Info("config login rate limit", "attempts", serverConfig.LoginRateLimit.Attempts, "window", serverConfig.LoginRateLimit.Window)
 	}
 
+	serverConfig.Sessions.Enabled = c.Sessions.Enabled
+	if c.Sessions.IdleTimeout != "" {
+		idleTimeout, err := time.ParseDuration(c.Sessions.IdleTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for session idle timeout: %v", c.Sessions.IdleTimeout, err)
+		}
+		serverConfig.Sessions.IdleTimeout = idleTimeout
+	}
+	if c.Sessions.AbsoluteLifetime != "" {
+		absoluteLifetime, err := time.ParseDuration(c.Sessions.AbsoluteLifetime)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for session absolute lifetime: %v", c.Sessions.AbsoluteLifetime, err)
+		}
+		serverConfig.Sessions.AbsoluteLifetime = absoluteLifetime
+	}
+
+	if c.Web.TLSClientAuth {
+		serverConfig.TLSClientAuth = true
+		if c.Web.TLSClientCA != "" {
+			caData, err := os.ReadFile(c.Web.TLSClientCA)
+			if err != nil {
+				return fmt.Errorf("invalid config: reading web TLS client CA: %v", err)
+			}
+			serverConfig.TLSClientCAs = x509.NewCertPool()
+			if !serverConfig.TLSClientCAs.AppendCertsFromPEM(caData) {
+				return errors.New("invalid config: failed to parse web TLS client CA")
+			}
+		}
+		logger.Info("config mutual TLS client authentication", "client_ca", c.Web.TLSClientCA)
+	}
+
 	if c.Expiry.AuthRequests != "" {
 		authRequests, err := time.ParseDuration(c.Expiry.AuthRequests)
 		if err != nil {
@@ -438,6 +518,51 @@ func runServe(options serveOptions) error {
 		logger.Info("config device requests", "valid_for", deviceRequests)
 		serverConfig.DeviceRequestsValidFor = deviceRequests
 	}
+	if c.Expiry.PushedAuthRequests != "" {
+		pushedAuthRequests, err := time.ParseDuration(c.Expiry.PushedAuthRequests)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for pushed auth request expiry: %v", c.Expiry.PushedAuthRequests, err)
+		}
+		logger.Info("config pushed auth requests", "valid_for", pushedAuthRequests)
+		serverConfig.PushedAuthRequestsValidFor = pushedAuthRequests
+	}
+	if c.Expiry.AccessTokens != "" {
+		accessTokens, err := time.ParseDuration(c.Expiry.AccessTokens)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for access token expiry: %v", c.Expiry.AccessTokens, err)
+		}
+		logger.Info("config access tokens", "valid_for", accessTokens)
+		serverConfig.AccessTokensValidFor = accessTokens
+	}
+	if len(c.OAuth2.Resources) > 0 {
+		serverConfig.Resources = make(map[string]string, len(c.OAuth2.Resources))
+		for _, r := range c.OAuth2.Resources {
+			if r.Resource == "" {
+				return fmt.Errorf("invalid config: resource without a resource indicator")
+			}
+			aud := r.Audience
+			if aud == "" {
+				aud = r.Resource
+			}
+			serverConfig.Resources[r.Resource] = aud
+		}
+	}
+	if c.OAuth2.LegacyAccessTokensUntil != "" {
+		until, err := time.Parse(time.RFC3339, c.OAuth2.LegacyAccessTokensUntil)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for legacy access tokens: %v", c.OAuth2.LegacyAccessTokensUntil, err)
+		}
+		logger.Warn("config accepting legacy access tokens at /userinfo", "until", until)
+		serverConfig.LegacyAccessTokensUntil = until
+	}
+	if c.Expiry.ClientCredentialsTokens != "" {
+		clientCredentialsTokens, err := time.ParseDuration(c.Expiry.ClientCredentialsTokens)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for client credentials token expiry: %v", c.Expiry.ClientCredentialsTokens, err)
+		}
+		logger.Info("config client credentials tokens", "valid_for", clientCredentialsTokens)
+		serverConfig.ClientCredentialsTokensValidFor = clientCredentialsTokens
+	}
 	refreshTokenPolicy, err := server.NewRefreshTokenPolicy(
 		logger,
 		c.Expiry.RefreshTokens.DisableRotation,
@@ -576,6 +701,11 @@ func runServe(options serveOptions) error {
 			CipherSuites:             allowedTLSCiphers,
 			PreferServerCipherSuites: true,
 		}
+		if c.Web.TLSClientAuth {
+			// Client certificates are optional, and verified by the server
+			// according to the token endpoint auth method of each client.
+			baseTLSConfig.ClientAuth = tls.RequestClientCert
+		}
 
 		tlsConfig, err := newTLSReloader(logger, c.Web.TLSCert, c.Web.TLSKey, "", baseTLSConfig)
 		if err != nil {
@@ -44,6 +44,14 @@ type Identity struct {
 
 	Groups []string
 
+	// MultiFactor is set by connectors that verified a second factor, such as
+	// a one-time passcode, during the login that returned the identity.
+	MultiFactor bool
+
+	// ExtraClaims holds further attributes of the user, keyed by claim name,
+	// which connectors are configured to pass on. Values must encode to JSON.
+	ExtraClaims map[string]any
+
 	// ConnectorData holds data used by the connector for subsequent requests after initial
 	// authentication, such as access tokens for upstream provides.
 	//
@@ -51,6 +59,24 @@ type Identity struct {
 	ConnectorData []byte
 }
 
+// ExtraClaims looks up the attributes of a user named by mapping, a map of
+// claim names to upstream attribute names. Attributes the user doesn't have are
+// left out, and nil is returned if there are none.
+func ExtraClaims(mapping map[string]string, lookup func(attr string) (any, bool)) map[string]any {
+	var claims map[string]any
+	for claim, attr := range mapping {
+		v, ok := lookup(attr)
+		if !ok {
+			continue
+		}
+		if claims == nil {
+			claims = make(map[string]any, len(mapping))
+		}
+		claims[claim] = v
+	}
+	return claims
+}
+
 // PasswordConnector is an interface implemented by connectors which take a
 // username and password.
 // Prompt() is used to inform the handler what to display in the password
@@ -82,6 +108,15 @@ type CallbackConnector interface {
 	HandleCallback(s Scopes, connData []byte, r *http.Request) (identity Identity, err error)
 }
 
+// LoginHintConnector is implemented by callback connectors whose upstream
+// provider accepts a hint about who is logging in, such as the OpenID Connect
+// "login_hint" parameter. The server uses it instead of LoginURL when the
+// client sent a hint.
+type LoginHintConnector interface {
+	// LoginURLWithHint is LoginURL, passing loginHint on to the provider.
+	LoginURLWithHint(s Scopes, callbackURL, state, loginHint string) (string, []byte, error)
+}
+
 // SAMLConnector represents SAML connectors which implement the HTTP POST binding.
 //
 //	RelayState is handled by the server.
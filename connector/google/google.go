@@ -169,6 +169,10 @@ func (c *googleConnector) Close() error {
 }
 
 func (c *googleConnector) LoginURL(s connector.Scopes, callbackURL, state string) (string, []byte, error) {
+	return c.LoginURLWithHint(s, callbackURL, state, "")
+}
+
+func (c *googleConnector) LoginURLWithHint(s connector.Scopes, callbackURL, state, loginHint string) (string, []byte, error) {
 	if c.redirectURI != callbackURL {
 		return "", nil, fmt.Errorf("expected callback URL %q did not match the URL in the config %q", callbackURL, c.redirectURI)
 	}
@@ -181,6 +185,9 @@ func (c *googleConnector) LoginURL(s connector.Scopes, callbackURL, state string
 		}
 		opts = append(opts, oauth2.SetAuthURLParam("hd", preferredDomain))
 	}
+	if loginHint != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("login_hint", loginHint))
+	}
 
 	if s.OfflineAccess {
 		opts = append(opts, oauth2.AccessTypeOffline, oauth2.SetAuthURLParam("prompt", c.promptType))
@@ -9,6 +9,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -33,6 +34,7 @@ type conn struct {
 	tokenCache    *timeCache
 	groupMap      map[string]string
 	fetchRoles    bool
+	extraClaims   map[string]string
 }
 
 type contextKey string
@@ -84,6 +86,8 @@ type domainKeystone struct {
 //			fetchRoles: true
 //			groupMapping:
 //			  "admin@my-project": "platform-admins"
+//			extraClaims:
+//			  project: default_project_id
 type Config struct {
 	Domain        string            `json:"domain"`
 	Host          string            `json:"keystoneHost"`
@@ -93,6 +97,9 @@ type Config struct {
 	CacheTTL      string            `json:"cacheTTL"`
 	FetchRoles    bool              `json:"fetchRoles"`
 	GroupMapping  map[string]string `json:"groupMapping"`
+	// ExtraClaims maps claim names to further attributes of the Keystone
+	// user to pass on, such as "default_project_id".
+	ExtraClaims map[string]string `json:"extraClaims"`
 }
 
 type loginRequestData struct {
@@ -138,6 +145,9 @@ type user struct {
 
 type token struct {
 	User userKeystone `json:"user"`
+	// Methods are the authentication methods Keystone verified to issue the
+	// token, such as "password" and "totp".
+	Methods []string `json:"methods"`
 }
 
 type tokenResponse struct {
@@ -159,6 +169,10 @@ type userResponse struct {
 		Email string `json:"email"`
 		ID    string `json:"id"`
 	} `json:"user"`
+
+	// attributes holds every attribute of the user, including the ones
+	// Keystone allows to be added freely.
+	attributes map[string]any
 }
 
 // Open returns an authentication strategy using Keystone.
@@ -196,6 +210,7 @@ func (c *Config) Open(id string, logger *slog.Logger) (connector.Connector, erro
 		tokenCache:    tokenCache,
 		groupMap:      c.GroupMapping,
 		fetchRoles:    c.FetchRoles,
+		extraClaims:   c.ExtraClaims,
 	}, nil
 }
 
@@ -258,6 +273,8 @@ func (p *conn) Login(ctx context.Context, scopes connector.Scopes, username, pas
 	}
 	identity.Username = username
 	identity.UserID = tokenResp.Token.User.ID
+	// Only a passcode Keystone checked counts, not one the user merely sent.
+	identity.MultiFactor = slices.Contains(tokenResp.Token.Methods, "totp")
 	// Stash the real Keystone user id in ConnectorData: when UserIDKey is email or
 	// username, UserID is overwritten below with a synthetic UUID that Refresh
 	// cannot use to address the Keystone API.
@@ -271,6 +288,7 @@ func (p *conn) Login(ctx context.Context, scopes connector.Scopes, username, pas
 		identity.Email = user.User.Email
 		identity.EmailVerified = true
 	}
+	identity.ExtraClaims = p.userExtraClaims(user)
 
 	if p.UserIDKey == "email" && identity.Email != "" {
 		identity.UserID = uuid.NewSHA1(uuid.NameSpaceURL, []byte(identity.Email)).String()
@@ -342,9 +360,12 @@ func (p *conn) TokenIdentity(ctx context.Context, subjectTokenType, subjectToken
 
 	// Use admin token to fetch user details (email) and groups.
 	user, err := p.getUser(ctx, userID, adminToken)
-	if err == nil && user.User.Email != "" {
-		identity.Email = user.User.Email
-		identity.EmailVerified = true
+	if err == nil {
+		if user.User.Email != "" {
+			identity.Email = user.User.Email
+			identity.EmailVerified = true
+		}
+		identity.ExtraClaims = p.userExtraClaims(user)
 	}
 	groups, err := p.getUserGroups(ctx, userID, adminToken)
 	if err == nil {
@@ -383,13 +404,11 @@ func (p *conn) Refresh(
 		userID = string(identity.ConnectorData)
 	}
 
-	ok, err := p.checkIfUserExists(ctx, userID, token)
+	user, err := p.getUser(ctx, userID, token)
 	if err != nil {
 		return identity, err
 	}
-	if !ok {
-		return identity, fmt.Errorf("keystone: user %q does not exist", userID)
-	}
+	identity.ExtraClaims = p.userExtraClaims(user)
 	if scopes.Groups {
 		groups, err := p.getUserGroups(ctx, userID, token)
 		if err != nil {
@@ -483,11 +502,6 @@ func (p *conn) getAdminToken(ctx context.Context) (string, error) {
 	return token, nil
 }
 
-func (p *conn) checkIfUserExists(ctx context.Context, userID string, token string) (bool, error) {
-	user, err := p.getUser(ctx, userID, token)
-	return user != nil, err
-}
-
 func (p *conn) getUser(ctx context.Context, userID string, token string) (*userResponse, error) {
 	// https://developer.openstack.org/api-ref/identity/v3/#show-user-details
 	userURL := p.Host + "/v3/users/" + userID
@@ -518,10 +532,24 @@ func (p *conn) getUser(ctx context.Context, userID string, token string) (*userR
 	if err != nil {
 		return nil, err
 	}
+	var attributes struct {
+		User map[string]any `json:"user"`
+	}
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+	user.attributes = attributes.User
 
 	return &user, nil
 }
 
+func (p *conn) userExtraClaims(user *userResponse) map[string]any {
+	return connector.ExtraClaims(p.extraClaims, func(attr string) (any, bool) {
+		v, ok := user.attributes[attr]
+		return v, ok
+	})
+}
+
 func (p *conn) getUserGroups(ctx context.Context, userID string, token string) ([]string, error) {
 	// https://developer.openstack.org/api-ref/identity/v3/#list-groups-to-which-a-user-belongs
 	groupsURL := p.Host + "/v3/users/" + userID + "/groups"
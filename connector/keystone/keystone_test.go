@@ -39,7 +39,7 @@ func newTestConn(host string) *conn {
 // Helpers: standard JSON responses
 // ─────────────────────────────────────────────
 
-func writeToken(w http.ResponseWriter, userID, userName, userToken string) {
+func writeToken(w http.ResponseWriter, userID, userName, userToken string, methods ...string) {
 	w.Header().Set("X-Subject-Token", userToken)
 	w.WriteHeader(http.StatusCreated)
 	resp := tokenResponse{
@@ -48,6 +48,7 @@ func writeToken(w http.ResponseWriter, userID, userName, userToken string) {
 				ID:   userID,
 				Name: userName,
 			},
+			Methods: methods,
 		},
 	}
 	json.NewEncoder(w).Encode(resp)
@@ -105,6 +106,9 @@ func TestLogin_Success(t *testing.T) {
 	if len(identity.Groups) != 2 {
 		t.Errorf("Groups: got %v, want 2 entries", identity.Groups)
 	}
+	if identity.MultiFactor {
+		t.Error("expected no MultiFactor for a password login")
+	}
 }
 
 func TestLogin_InvalidPassword(t *testing.T) {
@@ -162,7 +166,7 @@ func TestLogin_TOTPSuccessWithReceipt(t *testing.T) {
 		if r.Header.Get("openstack-auth-receipt") == "" {
 			t.Error("expected openstack-auth-receipt header in TOTP step")
 		}
-		writeToken(w, "user-42", "jdoe", "tok-totp")
+		writeToken(w, "user-42", "jdoe", "tok-totp", "password", "totp")
 	})
 	mux.HandleFunc("/v3/users/user-42", func(w http.ResponseWriter, r *http.Request) {
 		writeUser(w, "jdoe", "jdoe@example.com", "user-42")
@@ -184,6 +188,9 @@ func TestLogin_TOTPSuccessWithReceipt(t *testing.T) {
 	if identity.Email != "jdoe@example.com" {
 		t.Errorf("Email: got %q", identity.Email)
 	}
+	if !identity.MultiFactor {
+		t.Error("expected MultiFactor after TOTP")
+	}
 }
 
 func TestLogin_InvalidTOTP(t *testing.T) {
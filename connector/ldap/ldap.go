@@ -42,6 +42,8 @@ import (
 //         emailAttr: mail
 //         nameAttr: name
 //         preferredUsernameAttr: uid
+//         extraClaims:
+//           department: departmentNumber
 //       groupSearch:
 //         # Would translate to the separate query per user matcher pair and aggregate results into a single group list:
 //         #  "(&(|(objectClass=posixGroup)(objectClass=groupOfNames))(memberUid=<user uid>))"
@@ -128,6 +130,11 @@ type Config struct {
 		// If this is set, the email claim of the id token will be constructed from the idAttr and
 		// value of emailSuffix. This should not include the @ character.
 		EmailSuffix string `json:"emailSuffix"` // No default.
+
+		// ExtraClaims maps claim names to further attributes of the user entry
+		// to pass on, for example "department: departmentNumber". Attributes
+		// with several values are passed on as lists.
+		ExtraClaims map[string]string `json:"extraClaims"`
 	} `json:"userSearch"`
 
 	// Group search configuration.
@@ -413,6 +420,17 @@ func (c *ldapConnector) identityFromEntry(user ldap.Entry) (ident connector.Iden
 	// TODO(ericchiang): Let this value be set from an attribute.
 	ident.EmailVerified = true
 
+	ident.ExtraClaims = connector.ExtraClaims(c.UserSearch.ExtraClaims, func(attr string) (any, bool) {
+		switch values := c.getAttrs(user, attr); len(values) {
+		case 0:
+			return nil, false
+		case 1:
+			return values[0], true
+		default:
+			return values, true
+		}
+	})
+
 	if len(missing) != 0 {
 		err := fmt.Errorf("ldap: entry %q missing following required attribute(s): %q", user.DN, missing)
 		return connector.Identity{}, err
@@ -451,6 +469,10 @@ func (c *ldapConnector) userEntry(conn *ldap.Conn, username string) (user ldap.E
 		req.Attributes = append(req.Attributes, c.UserSearch.PreferredUsernameAttrAttr)
 	}
 
+	for _, attr := range c.UserSearch.ExtraClaims {
+		req.Attributes = append(req.Attributes, attr)
+	}
+
 	c.logger.Info("performing ldap search",
 		"base_dn", req.BaseDN, "scope", scopeString(req.Scope), "filter", req.Filter)
 	resp, err := conn.Search(req)
@@ -176,6 +176,10 @@ func (c *microsoftConnector) oauth2Config(scopes connector.Scopes) *oauth2.Confi
 }
 
 func (c *microsoftConnector) LoginURL(scopes connector.Scopes, callbackURL, state string) (string, []byte, error) {
+	return c.LoginURLWithHint(scopes, callbackURL, state, "")
+}
+
+func (c *microsoftConnector) LoginURLWithHint(scopes connector.Scopes, callbackURL, state, loginHint string) (string, []byte, error) {
 	if c.redirectURI != callbackURL {
 		return "", nil, fmt.Errorf("expected callback URL %q did not match the URL in the config %q", callbackURL, c.redirectURI)
 	}
@@ -187,6 +191,9 @@ func (c *microsoftConnector) LoginURL(scopes connector.Scopes, callbackURL, stat
 	if c.domainHint != "" {
 		options = append(options, oauth2.SetAuthURLParam("domain_hint", c.domainHint))
 	}
+	if loginHint != "" {
+		options = append(options, oauth2.SetAuthURLParam("login_hint", loginHint))
+	}
 
 	return c.oauth2Config(scopes).AuthCodeURL(state, options...), nil, nil
 }
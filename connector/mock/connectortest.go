@@ -22,6 +22,7 @@ func NewCallbackConnector(logger *slog.Logger) connector.Connector {
 			Email:         "kilgore@kilgore.trout",
 			EmailVerified: true,
 			Groups:        []string{"authors"},
+			ExtraClaims:   map[string]any{"genre": "science fiction"},
 			ConnectorData: connectorData,
 		},
 		Logger: logger,
@@ -30,6 +31,7 @@ func NewCallbackConnector(logger *slog.Logger) connector.Connector {
 
 var (
 	_ connector.CallbackConnector      = &Callback{}
+	_ connector.LoginHintConnector     = &Callback{}
 	_ connector.RefreshConnector       = &Callback{}
 	_ connector.TokenIdentityConnector = &Callback{}
 )
@@ -43,12 +45,21 @@ type Callback struct {
 
 // LoginURL returns the URL to redirect the user to login with.
 func (m *Callback) LoginURL(s connector.Scopes, callbackURL, state string) (string, []byte, error) {
+	return m.LoginURLWithHint(s, callbackURL, state, "")
+}
+
+// LoginURLWithHint returns the URL to redirect the user to login with, which
+// carries the login hint, if any, for tests to inspect.
+func (m *Callback) LoginURLWithHint(s connector.Scopes, callbackURL, state, loginHint string) (string, []byte, error) {
 	u, err := url.Parse(callbackURL)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to parse callbackURL %q: %v", callbackURL, err)
 	}
 	v := u.Query()
 	v.Set("state", state)
+	if loginHint != "" {
+		v.Set("login_hint", loginHint)
+	}
 	u.RawQuery = v.Encode()
 	return u.String(), nil, nil
 }
@@ -32,6 +32,7 @@ type oauthConnector struct {
 	emailKey             string
 	emailVerifiedKey     string
 	groupsKey            string
+	extraClaims          map[string]string
 	httpClient           *http.Client
 	logger               *slog.Logger
 }
@@ -58,6 +59,9 @@ type Config struct {
 		EmailKey             string `json:"emailKey"`             // defaults to "email"
 		EmailVerifiedKey     string `json:"emailVerifiedKey"`     // defaults to "email_verified"
 	} `json:"claimMapping"`
+	// ExtraClaims maps claim names to further keys of the userinfo response
+	// to pass on.
+	ExtraClaims map[string]string `json:"extraClaims"`
 }
 
 func (c *Config) Open(id string, logger *slog.Logger) (connector.Connector, error) {
@@ -108,6 +112,7 @@ func (c *Config) Open(id string, logger *slog.Logger) (connector.Connector, erro
 		groupsKey:            groupsKey,
 		emailKey:             emailKey,
 		emailVerifiedKey:     emailVerifiedKey,
+		extraClaims:          c.ExtraClaims,
 	}
 
 	oauthConn.httpClient, err = httpclient.NewHTTPClient(c.RootCAs, c.InsecureSkipVerify)
@@ -189,6 +194,10 @@ func (c *oauthConnector) HandleCallback(s connector.Scopes, _ []byte, r *http.Re
 	identity.PreferredUsername, _ = userInfoResult[c.preferredUsernameKey].(string)
 	identity.Email, _ = userInfoResult[c.emailKey].(string)
 	identity.EmailVerified, _ = userInfoResult[c.emailVerifiedKey].(bool)
+	identity.ExtraClaims = connector.ExtraClaims(c.extraClaims, func(key string) (any, bool) {
+		v, ok := userInfoResult[key]
+		return v, ok
+	})
 
 	if s.Groups {
 		groups := map[string]struct{}{}
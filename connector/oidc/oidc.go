@@ -100,6 +100,10 @@ type Config struct {
 		GroupsKey string `json:"groups"` // defaults to "groups"
 	} `json:"claimMapping"`
 
+	// ExtraClaims maps claim names to further claims of the upstream ID token,
+	// or userinfo response, to pass on.
+	ExtraClaims map[string]string `json:"extraClaims"`
+
 	// ClaimMutations holds all claim mutations options
 	ClaimMutations struct {
 		NewGroupFromClaims []NewGroupFromClaims `json:"newGroupFromClaims"`
@@ -316,6 +320,7 @@ func (c *Config) Open(id string, logger *slog.Logger) (conn connector.Connector,
 		groupsFilter:              groupsFilter,
 		groupsPrefix:              c.ClaimMutations.ModifyGroupNames.Prefix,
 		groupsSuffix:              c.ClaimMutations.ModifyGroupNames.Suffix,
+		extraClaims:               c.ExtraClaims,
 	}, nil
 }
 
@@ -349,6 +354,7 @@ type oidcConnector struct {
 	groupsFilter              *regexp.Regexp
 	groupsPrefix              string
 	groupsSuffix              string
+	extraClaims               map[string]string
 }
 
 func (c *oidcConnector) Close() error {
@@ -357,6 +363,10 @@ func (c *oidcConnector) Close() error {
 }
 
 func (c *oidcConnector) LoginURL(s connector.Scopes, callbackURL, state string) (string, []byte, error) {
+	return c.LoginURLWithHint(s, callbackURL, state, "")
+}
+
+func (c *oidcConnector) LoginURLWithHint(s connector.Scopes, callbackURL, state, loginHint string) (string, []byte, error) {
 	if c.redirectURI != callbackURL {
 		return "", nil, fmt.Errorf("expected callback URL %q did not match the URL in the config %q", callbackURL, c.redirectURI)
 	}
@@ -368,6 +378,10 @@ func (c *oidcConnector) LoginURL(s connector.Scopes, callbackURL, state string)
 		opts = append(opts, oauth2.SetAuthURLParam("acr_values", acrValues))
 	}
 
+	if loginHint != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("login_hint", loginHint))
+	}
+
 	if s.OfflineAccess {
 		opts = append(opts, oauth2.AccessTypeOffline, oauth2.SetAuthURLParam("prompt", c.promptType))
 	}
@@ -636,6 +650,10 @@ func (c *oidcConnector) createIdentity(ctx context.Context, identity connector.I
 		EmailVerified:     emailVerified,
 		Groups:            groups,
 		ConnectorData:     connData,
+		ExtraClaims: connector.ExtraClaims(c.extraClaims, func(claim string) (any, bool) {
+			v, ok := claims[claim]
+			return v, ok
+		}),
 	}
 
 	if c.userIDKey != "" {
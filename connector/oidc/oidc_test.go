@@ -13,6 +13,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
 	"testing"
@@ -743,6 +744,28 @@ func TestPromptType(t *testing.T) {
 	}
 }
 
+func TestLoginURLWithHint(t *testing.T) {
+	testServer, err := setupServer(nil, true)
+	require.NoError(t, err)
+
+	conn, err := newConnector(Config{
+		Issuer:      testServer.URL,
+		RedirectURI: "https://dex.example.com/callback",
+		Scopes:      []string{"openid"},
+	})
+	require.NoError(t, err)
+
+	loginURL, _, err := conn.LoginURLWithHint(connector.Scopes{}, "https://dex.example.com/callback", "state", "jane@example.com")
+	require.NoError(t, err)
+	u, err := url.Parse(loginURL)
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", u.Query().Get("login_hint"))
+
+	loginURL, _, err = conn.LoginURL(connector.Scopes{}, "https://dex.example.com/callback", "state")
+	require.NoError(t, err)
+	require.NotContains(t, loginURL, "login_hint")
+}
+
 func TestProviderOverride(t *testing.T) {
 	testServer, err := setupServer(map[string]any{
 		"sub":  "subvalue",
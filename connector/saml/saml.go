@@ -96,6 +96,10 @@ type Config struct {
 	FilterGroups  bool     `json:"filterGroups"`
 	RedirectURI   string   `json:"redirectURI"`
 
+	// ExtraClaims maps claim names to further assertion attributes to pass
+	// on. Attributes with several values are passed on as lists.
+	ExtraClaims map[string]string `json:"extraClaims"`
+
 	// Requested format of the NameID. The NameID value is is mapped to the ID Token
 	// 'sub' claim.
 	//
@@ -160,6 +164,7 @@ func (c *Config) openConnector(logger *slog.Logger) (*provider, error) {
 		groupsDelim:   c.GroupsDelim,
 		allowedGroups: c.AllowedGroups,
 		filterGroups:  c.FilterGroups,
+		extraClaims:   c.ExtraClaims,
 		redirectURI:   c.RedirectURI,
 		logger:        logger,
 
@@ -254,6 +259,7 @@ type provider struct {
 	groupsDelim   string
 	allowedGroups []string
 	filterGroups  bool
+	extraClaims   map[string]string
 
 	redirectURI string
 
@@ -266,12 +272,13 @@ type provider struct {
 // Since SAML has no native refresh mechanism, we cache the identity obtained during
 // the initial authentication and return it on subsequent refresh requests.
 type cachedIdentity struct {
-	UserID            string   `json:"userId"`
-	Username          string   `json:"username"`
-	PreferredUsername string   `json:"preferredUsername"`
-	Email             string   `json:"email"`
-	EmailVerified     bool     `json:"emailVerified"`
-	Groups            []string `json:"groups,omitempty"`
+	UserID            string         `json:"userId"`
+	Username          string         `json:"username"`
+	PreferredUsername string         `json:"preferredUsername"`
+	Email             string         `json:"email"`
+	EmailVerified     bool           `json:"emailVerified"`
+	Groups            []string       `json:"groups,omitempty"`
+	ExtraClaims       map[string]any `json:"extraClaims,omitempty"`
 }
 
 // marshalCachedIdentity serializes the identity into ConnectorData for refresh token support.
@@ -283,6 +290,7 @@ func marshalCachedIdentity(ident connector.Identity) (connector.Identity, error)
 		Email:             ident.Email,
 		EmailVerified:     ident.EmailVerified,
 		Groups:            ident.Groups,
+		ExtraClaims:       ident.ExtraClaims,
 	}
 	connectorData, err := json.Marshal(ci)
 	if err != nil {
@@ -440,6 +448,17 @@ func (p *provider) HandlePOST(s connector.Scopes, samlResponse, inResponseTo str
 		return ident, fmt.Errorf("no attribute with name %q: %s", p.usernameAttr, attributes.names())
 	}
 
+	ident.ExtraClaims = connector.ExtraClaims(p.extraClaims, func(attr string) (any, bool) {
+		values, ok := attributes.all(attr)
+		if !ok || len(values) == 0 {
+			return nil, false
+		}
+		if len(values) == 1 {
+			return values[0], true
+		}
+		return values, true
+	})
+
 	if len(p.allowedGroups) == 0 && (!s.Groups || p.groupsAttr == "") {
 		// Groups not requested or not configured. We're done.
 		return marshalCachedIdentity(ident)
@@ -505,6 +524,7 @@ func (p *provider) Refresh(ctx context.Context, s connector.Scopes, ident connec
 	ident.PreferredUsername = ci.PreferredUsername
 	ident.Email = ci.Email
 	ident.EmailVerified = ci.EmailVerified
+	ident.ExtraClaims = ci.ExtraClaims
 
 	// Only populate groups if the client requested the groups scope.
 	if s.Groups {
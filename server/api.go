@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -18,7 +20,7 @@ import (
 
 // apiVersion increases every time a new call is added to the API. Clients should use this info
 // to determine if the server supports specific features.
-const apiVersion = 3
+const apiVersion = 6
 
 const (
 	// recCost is the recommended bcrypt cost, which balances hash strength and
@@ -52,6 +54,24 @@ type dexAPI struct {
 	reloadFunc func(context.Context) error
 }
 
+// maxTokenLifetime is the longest lifetime clients can set for their ID and
+// access tokens. Without a server, it is the server's default ID token expiry.
+func (d dexAPI) maxTokenLifetime() time.Duration {
+	if d.server == nil {
+		return 24 * time.Hour
+	}
+	return d.server.idTokensValidFor
+}
+
+// validateSubjectType checks the subject type of a client, and with a server,
+// that it can derive pairwise subjects.
+func (d dexAPI) validateSubjectType(c storage.Client) error {
+	if d.server == nil {
+		return ValidateSubjectType(c)
+	}
+	return d.server.validateSubjectType(c)
+}
+
 func (d dexAPI) GetClient(ctx context.Context, req *api.GetClientReq) (*api.GetClientResp, error) {
 	c, err := d.s.GetClient(ctx, req.Id)
 	if err != nil {
@@ -60,13 +80,32 @@ func (d dexAPI) GetClient(ctx context.Context, req *api.GetClientReq) (*api.GetC
 
 	return &api.GetClientResp{
 		Client: &api.Client{
-			Id:           c.ID,
-			Name:         c.Name,
-			Secret:       c.Secret,
-			RedirectUris: c.RedirectURIs,
-			TrustedPeers: c.TrustedPeers,
-			Public:       c.Public,
-			LogoUrl:      c.LogoURL,
+			Id:                                 c.ID,
+			Name:                               c.Name,
+			Secret:                             c.Secret,
+			RedirectUris:                       c.RedirectURIs,
+			TrustedPeers:                       c.TrustedPeers,
+			Public:                             c.Public,
+			LogoUrl:                            c.LogoURL,
+			PostLogoutRedirectUris:             c.PostLogoutRedirectURIs,
+			BackchannelLogoutUri:               c.BackchannelLogoutURI,
+			ClientCredentialsScopes:            c.ClientCredentialsScopes,
+			ClientCredentialsAudiences:         c.ClientCredentialsAudiences,
+			AccessTokenFormat:                  c.AccessTokenFormat,
+			RequirePushedAuthorizationRequests: c.RequirePushedAuthorizationRequests,
+			Jwks:                               c.JWKS,
+			JwksUri:                            c.JWKSURI,
+			TokenEndpointAuthMethod:            c.TokenEndpointAuthMethod,
+			TlsClientAuthSubjectDn:             c.TLSClientAuthSubjectDN,
+			ClaimMappings:                      toAPIClaimMappings(c.ClaimMappings),
+			SubjectType:                        c.SubjectType,
+			SectorIdentifierUri:                c.SectorIdentifierURI,
+			RequiredScopes:                     c.RequiredScopes,
+			Expiry:                             toAPIClientExpiry(c.Expiry),
+			AllowedConnectors:                  c.AllowedConnectors,
+			AllowedGrantTypes:                  c.AllowedGrantTypes,
+			AllowedResponseTypes:               c.AllowedResponseTypes,
+			RequirePkce:                        c.RequirePKCE,
 		},
 	}, nil
 }
@@ -79,18 +118,61 @@ func (d dexAPI) CreateClient(ctx context.Context, req *api.CreateClientReq) (*ap
 	if req.Client.Id == "" {
 		req.Client.Id = storage.NewID()
 	}
-	if req.Client.Secret == "" && !req.Client.Public {
-		req.Client.Secret = storage.NewID() + storage.NewID()
+	if !validAccessTokenFormat(req.Client.AccessTokenFormat) {
+		return nil, fmt.Errorf("invalid access token format %q", req.Client.AccessTokenFormat)
+	}
+	if err := ValidateClientKeys(req.Client.Jwks, req.Client.JwksUri); err != nil {
+		return nil, fmt.Errorf("create client: %v", err)
+	}
+	claimMappings := fromAPIClaimMappings(req.Client.ClaimMappings)
+	if err := ValidateClaimMappings(claimMappings); err != nil {
+		return nil, fmt.Errorf("create client: %v", err)
+	}
+	expiry := fromAPIClientExpiry(req.Client.Expiry)
+	if err := ValidateClientExpiry(expiry, d.maxTokenLifetime()); err != nil {
+		return nil, fmt.Errorf("create client: %v", err)
 	}
 
 	c := storage.Client{
-		ID:           req.Client.Id,
-		Secret:       req.Client.Secret,
-		RedirectURIs: req.Client.RedirectUris,
-		TrustedPeers: req.Client.TrustedPeers,
-		Public:       req.Client.Public,
-		Name:         req.Client.Name,
-		LogoURL:      req.Client.LogoUrl,
+		ID:                                 req.Client.Id,
+		Secret:                             req.Client.Secret,
+		RedirectURIs:                       req.Client.RedirectUris,
+		TrustedPeers:                       req.Client.TrustedPeers,
+		Public:                             req.Client.Public,
+		Name:                               req.Client.Name,
+		LogoURL:                            req.Client.LogoUrl,
+		PostLogoutRedirectURIs:             req.Client.PostLogoutRedirectUris,
+		BackchannelLogoutURI:               req.Client.BackchannelLogoutUri,
+		ClientCredentialsScopes:            req.Client.ClientCredentialsScopes,
+		ClientCredentialsAudiences:         req.Client.ClientCredentialsAudiences,
+		AccessTokenFormat:                  req.Client.AccessTokenFormat,
+		RequirePushedAuthorizationRequests: req.Client.RequirePushedAuthorizationRequests,
+		JWKS:                               req.Client.Jwks,
+		JWKSURI:                            req.Client.JwksUri,
+		TokenEndpointAuthMethod:            req.Client.TokenEndpointAuthMethod,
+		TLSClientAuthSubjectDN:             req.Client.TlsClientAuthSubjectDn,
+		ClaimMappings:                      claimMappings,
+		SubjectType:                        req.Client.SubjectType,
+		SectorIdentifierURI:                req.Client.SectorIdentifierUri,
+		RequiredScopes:                     req.Client.RequiredScopes,
+		Expiry:                             expiry,
+		AllowedConnectors:                  req.Client.AllowedConnectors,
+		AllowedGrantTypes:                  req.Client.AllowedGrantTypes,
+		AllowedResponseTypes:               req.Client.AllowedResponseTypes,
+		RequirePKCE:                        req.Client.RequirePkce,
+	}
+	if c.Secret == "" && !c.Public && usesClientSecret(c) {
+		c.Secret = storage.NewID() + storage.NewID()
+		req.Client.Secret = c.Secret
+	}
+	if err := ValidateClientAuthMethod(c); err != nil {
+		return nil, fmt.Errorf("create client: %v", err)
+	}
+	if err := d.validateSubjectType(c); err != nil {
+		return nil, fmt.Errorf("create client: %v", err)
+	}
+	if err := ValidateClientRestrictions(c); err != nil {
+		return nil, fmt.Errorf("create client: %v", err)
 	}
 	if err := d.s.CreateClient(ctx, c); err != nil {
 		if err == storage.ErrAlreadyExists {
@@ -109,6 +191,20 @@ func (d dexAPI) UpdateClient(ctx context.Context, req *api.UpdateClientReq) (*ap
 	if req.Id == "" {
 		return nil, errors.New("update client: no client ID supplied")
 	}
+	if !validAccessTokenFormat(req.AccessTokenFormat) {
+		return nil, fmt.Errorf("update client: invalid access token format %q", req.AccessTokenFormat)
+	}
+	if err := ValidateClientKeys(req.Jwks, req.JwksUri); err != nil {
+		return nil, fmt.Errorf("update client: %v", err)
+	}
+	claimMappings := fromAPIClaimMappings(req.ClaimMappings)
+	if err := ValidateClaimMappings(claimMappings); err != nil {
+		return nil, fmt.Errorf("update client: %v", err)
+	}
+	expiry := fromAPIClientExpiry(req.Expiry)
+	if err := ValidateClientExpiry(expiry, d.maxTokenLifetime()); err != nil {
+		return nil, fmt.Errorf("update client: %v", err)
+	}
 
 	err := d.s.UpdateClient(ctx, req.Id, func(old storage.Client) (storage.Client, error) {
 		if req.RedirectUris != nil {
@@ -117,13 +213,79 @@ func (d dexAPI) UpdateClient(ctx context.Context, req *api.UpdateClientReq) (*ap
 		if req.TrustedPeers != nil {
 			old.TrustedPeers = req.TrustedPeers
 		}
+		if req.PostLogoutRedirectUris != nil {
+			old.PostLogoutRedirectURIs = req.PostLogoutRedirectUris
+		}
 		if req.Name != "" {
 			old.Name = req.Name
 		}
 		if req.LogoUrl != "" {
 			old.LogoURL = req.LogoUrl
 		}
-		return old, nil
+		if req.BackchannelLogoutUri != "" {
+			old.BackchannelLogoutURI = req.BackchannelLogoutUri
+		}
+		if req.ClientCredentialsScopes != nil {
+			old.ClientCredentialsScopes = req.ClientCredentialsScopes
+		}
+		if req.ClientCredentialsAudiences != nil {
+			old.ClientCredentialsAudiences = req.ClientCredentialsAudiences
+		}
+		if req.AccessTokenFormat != "" {
+			old.AccessTokenFormat = req.AccessTokenFormat
+		}
+		if req.RequirePushedAuthorizationRequests != nil {
+			old.RequirePushedAuthorizationRequests = *req.RequirePushedAuthorizationRequests
+		}
+		// A client uses either an inline key set or a URL to fetch it from.
+		if req.Jwks != "" {
+			old.JWKS = req.Jwks
+			old.JWKSURI = ""
+		}
+		if req.JwksUri != "" {
+			old.JWKSURI = req.JwksUri
+			old.JWKS = ""
+		}
+		if req.TokenEndpointAuthMethod != "" {
+			old.TokenEndpointAuthMethod = req.TokenEndpointAuthMethod
+		}
+		if req.TlsClientAuthSubjectDn != "" {
+			old.TLSClientAuthSubjectDN = req.TlsClientAuthSubjectDn
+		}
+		if claimMappings != nil {
+			old.ClaimMappings = claimMappings
+		}
+		if req.SubjectType != "" {
+			old.SubjectType = req.SubjectType
+		}
+		if req.SectorIdentifierUri != "" {
+			old.SectorIdentifierURI = req.SectorIdentifierUri
+		}
+		if req.RequiredScopes != nil {
+			old.RequiredScopes = req.RequiredScopes
+		}
+		if req.Expiry != nil {
+			old.Expiry = expiry
+		}
+		if req.AllowedConnectors != nil {
+			old.AllowedConnectors = req.AllowedConnectors
+		}
+		if req.AllowedGrantTypes != nil {
+			old.AllowedGrantTypes = req.AllowedGrantTypes
+		}
+		if req.AllowedResponseTypes != nil {
+			old.AllowedResponseTypes = req.AllowedResponseTypes
+		}
+		if req.RequirePkce != nil {
+			old.RequirePKCE = *req.RequirePkce
+		}
+		if err := d.validateSubjectType(old); err != nil {
+			return old, err
+		}
+		if err := ValidateClientRestrictions(old); err != nil {
+			return old, err
+		}
+		return old, ValidateClientAuthMethod(old)
 	})
 	if err != nil {
 		if err == storage.ErrNotFound {
@@ -157,12 +319,31 @@ func (d dexAPI) ListClients(ctx context.Context, req *api.ListClientReq) (*api.L
 	clients := make([]*api.ClientInfo, 0, len(clientList))
 	for _, client := range clientList {
 		c := api.ClientInfo{
-			Id:           client.ID,
-			Name:         client.Name,
-			RedirectUris: client.RedirectURIs,
-			TrustedPeers: client.TrustedPeers,
-			Public:       client.Public,
-			LogoUrl:      client.LogoURL,
+			Id:                                 client.ID,
+			Name:                               client.Name,
+			RedirectUris:                       client.RedirectURIs,
+			TrustedPeers:                       client.TrustedPeers,
+			Public:                             client.Public,
+			LogoUrl:                            client.LogoURL,
+			PostLogoutRedirectUris:             client.PostLogoutRedirectURIs,
+			BackchannelLogoutUri:               client.BackchannelLogoutURI,
+			ClientCredentialsScopes:            client.ClientCredentialsScopes,
+			ClientCredentialsAudiences:         client.ClientCredentialsAudiences,
+			AccessTokenFormat:                  client.AccessTokenFormat,
+			RequirePushedAuthorizationRequests: client.RequirePushedAuthorizationRequests,
+			Jwks:                               client.JWKS,
+			JwksUri:                            client.JWKSURI,
+			TokenEndpointAuthMethod:            client.TokenEndpointAuthMethod,
+			TlsClientAuthSubjectDn:             client.TLSClientAuthSubjectDN,
+			ClaimMappings:                      toAPIClaimMappings(client.ClaimMappings),
+			SubjectType:                        client.SubjectType,
+			SectorIdentifierUri:                client.SectorIdentifierURI,
+			RequiredScopes:                     client.RequiredScopes,
+			Expiry:                             toAPIClientExpiry(client.Expiry),
+			AllowedConnectors:                  client.AllowedConnectors,
+			AllowedGrantTypes:                  client.AllowedGrantTypes,
+			AllowedResponseTypes:               client.AllowedResponseTypes,
+			RequirePkce:                        client.RequirePKCE,
 		}
 		clients = append(clients, &c)
 	}
@@ -172,6 +353,60 @@ func (d dexAPI) ListClients(ctx context.Context, req *api.ListClientReq) (*api.L
 	}, nil
 }
 
+func toAPIClaimMappings(mappings []storage.ClaimMapping) []*api.ClaimMapping {
+	var apiMappings []*api.ClaimMapping
+	for _, m := range mappings {
+		apiMappings = append(apiMappings, &api.ClaimMapping{Claim: m.Claim, Template: m.Template, Json: m.JSON})
+	}
+	return apiMappings
+}
+
+func fromAPIClaimMappings(apiMappings []*api.ClaimMapping) []storage.ClaimMapping {
+	var mappings []storage.ClaimMapping
+	for _, m := range apiMappings {
+		mappings = append(mappings, storage.ClaimMapping{Claim: m.Claim, Template: m.Template, JSON: m.Json})
+	}
+	return mappings
+}
+
+func toAPIClientExpiry(e *storage.ClientExpiry) *api.ClientExpiry {
+	if e == nil {
+		return nil
+	}
+	return &api.ClientExpiry{
+		IdTokens:     e.IDTokens,
+		AccessTokens: e.AccessTokens,
+		RefreshTokens: &api.ClientRefreshTokenExpiry{
+			DisableRotation:   e.RefreshTokens.DisableRotation,
+			ValidIfNotUsedFor: e.RefreshTokens.ValidIfNotUsedFor,
+			AbsoluteLifetime:  e.RefreshTokens.AbsoluteLifetime,
+		},
+	}
+}
+
+// fromAPIClientExpiry returns nil for an empty message, which leaves every
+// lifetime to the server.
+func fromAPIClientExpiry(apiExpiry *api.ClientExpiry) *storage.ClientExpiry {
+	if apiExpiry == nil {
+		return nil
+	}
+	e := storage.ClientExpiry{
+		IDTokens:     apiExpiry.IdTokens,
+		AccessTokens: apiExpiry.AccessTokens,
+	}
+	if rt := apiExpiry.RefreshTokens; rt != nil {
+		e.RefreshTokens = storage.ClientRefreshTokenExpiry{
+			DisableRotation:   rt.DisableRotation,
+			ValidIfNotUsedFor: rt.ValidIfNotUsedFor,
+			AbsoluteLifetime:  rt.AbsoluteLifetime,
+		}
+	}
+	if e == (storage.ClientExpiry{}) {
+		return nil
+	}
+	return &e
+}
+
 // checkCost returns an error if the hash provided does not meet lower or upper
 // bound cost requirements.
 func checkCost(hash []byte) error {
@@ -349,25 +584,48 @@ func (d dexAPI) VerifyPassword(ctx context.Context, req *api.VerifyPasswordReq)
 
 // offlineSessionKey maps the user_id of an API request to the (userID, connID)
 // pair offline sessions are stored under.
+func (d dexAPI) offlineSessionKey(ctx context.Context, userID string) (string, string) {
+	return d.userKey(ctx, userID, func(connID string) bool {
+		_, err := d.s.GetOfflineSessions(ctx, userID, connID)
+		return err == nil
+	})
+}
+
+// consentKey maps the user_id of an API request to the (userID, connID) pair
+// consents are stored under.
+func (d dexAPI) consentKey(ctx context.Context, userID string) (string, string) {
+	return d.userKey(ctx, userID, func(connID string) bool {
+		consents, err := d.s.ListConsents(ctx, userID, connID)
+		return err == nil && len(consents) > 0
+	})
+}
+
+// userKey maps the user_id of an API request to a (userID, connID) pair.
 //
-// api.proto documents user_id as the "sub" claim of the ID token, but this fork
-// emits a flat user id in sub (see genSubject in oauth2.go), so the connector id
-// is not carried in it and has to be recovered by looking the user up in each
-// configured connector. Subjects in the upstream base64-protobuf format are
-// still resolved, so clients written against the .proto keep working.
+// api.proto documents user_id as the "sub" claim of the ID token. With the
+// default raw subject format sub is a flat user id that doesn't carry the
+// connector id, which has to be recovered by asking known whether the user
+// has data stored under each configured connector. Subjects in the
+// "connector" and the upstream base64-protobuf ("legacy") formats are resolved
+// as well. "uuid" subjects can't be reversed; pass the raw user id for those.
 //
-// An unknown user yields an empty connector id, which makes the lookups below
-// report not found through their usual path.
-func (d dexAPI) offlineSessionKey(ctx context.Context, userID string) (string, string) {
+// An unknown user yields an empty connector id, which makes the lookups of the
+// callers report not found through their usual path.
+func (d dexAPI) userKey(ctx context.Context, userID string, known func(connID string) bool) (string, string) {
 	conns, err := d.s.ListConnectors(ctx)
 	if err != nil {
 		d.logger.Error("failed to list connectors", "err", err)
 	}
 	for _, c := range conns {
-		if _, err := d.s.GetOfflineSessions(ctx, userID, c.ID); err == nil {
+		if known(c.ID) {
 			return userID, c.ID
 		}
 	}
+	for _, c := range conns {
+		if id, ok := strings.CutPrefix(userID, c.ID+":"); ok {
+			return id, c.ID
+		}
+	}
 
 	id := new(internal.IDTokenSubject)
 	if err := internal.Unmarshal(userID, id); err == nil && id.UserId != "" && id.ConnId != "" {
@@ -451,6 +709,13 @@ func (d dexAPI) RevokeRefresh(ctx context.Context, req *api.RevokeRefreshReq) (*
 		return nil, err
 	}
 
+	// The client's session for the user is over; tell it over the back-channel.
+	if d.server != nil {
+		d.server.backchannelLogout(ctx, d.server.subjectFor(userID, connID), "", []string{req.ClientId})
+	} else if err := queueBackchannelLogout(ctx, d.s, time.Now(), publicSubject(userID), "", []string{req.ClientId}); err != nil {
+		d.logger.Error("failed to queue back-channel logout", "err", err)
+	}
+
 	return &api.RevokeRefreshResp{}, nil
 }
 
@@ -622,6 +887,180 @@ func (d dexAPI) ReloadConfig(ctx context.Context, req *api.ReloadConfigReq) (*ap
 	}, nil
 }
 
+func (d dexAPI) CreateInitialAccessToken(ctx context.Context, req *api.CreateInitialAccessTokenReq) (*api.CreateInitialAccessTokenResp, error) {
+	if req.ExpiresIn < 0 {
+		return nil, errors.New("create initial access token: expires_in must not be negative")
+	}
+	expiresIn := 24 * time.Hour
+	if req.ExpiresIn > 0 {
+		expiresIn = time.Duration(req.ExpiresIn) * time.Second
+	}
+
+	token := storage.NewID() + storage.NewID()
+	now := time.Now()
+	t := storage.InitialAccessToken{
+		ID:        hashToken(token),
+		CreatedAt: now,
+		Expiry:    now.Add(expiresIn),
+	}
+	if err := d.s.CreateInitialAccessToken(ctx, t); err != nil {
+		d.logger.Error("api: failed to create initial access token", "err", err)
+		return nil, fmt.Errorf("create initial access token: %v", err)
+	}
+
+	return &api.CreateInitialAccessTokenResp{
+		Token:     token,
+		ExpiresAt: t.Expiry.Unix(),
+	}, nil
+}
+
+func (d dexAPI) DeleteInitialAccessToken(ctx context.Context, req *api.DeleteInitialAccessTokenReq) (*api.DeleteInitialAccessTokenResp, error) {
+	if req.Token == "" {
+		return nil, errors.New("no token supplied")
+	}
+
+	if err := d.s.DeleteInitialAccessToken(ctx, hashToken(req.Token)); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.DeleteInitialAccessTokenResp{NotFound: true}, nil
+		}
+		d.logger.Error("api: failed to delete initial access token", "err", err)
+		return nil, fmt.Errorf("delete initial access token: %v", err)
+	}
+
+	return &api.DeleteInitialAccessTokenResp{}, nil
+}
+
+func (d dexAPI) ListConsents(ctx context.Context, req *api.ListConsentsReq) (*api.ListConsentsResp, error) {
+	userID, connID := d.consentKey(ctx, req.UserId)
+
+	consents, err := d.s.ListConsents(ctx, userID, connID)
+	if err != nil {
+		d.logger.Error("api: failed to list consents", "err", err)
+		return nil, fmt.Errorf("list consents: %v", err)
+	}
+
+	resp := make([]*api.Consent, 0, len(consents))
+	for _, consent := range consents {
+		resp = append(resp, &api.Consent{
+			ClientId:  consent.ClientID,
+			Scopes:    consent.Scopes,
+			CreatedAt: consent.CreatedAt.Unix(),
+			UpdatedAt: consent.UpdatedAt.Unix(),
+		})
+	}
+
+	return &api.ListConsentsResp{
+		Consents: resp,
+	}, nil
+}
+
+func (d dexAPI) RevokeConsent(ctx context.Context, req *api.RevokeConsentReq) (*api.RevokeConsentResp, error) {
+	if req.ClientId == "" {
+		return nil, errors.New("no client_id supplied")
+	}
+	userID, connID := d.consentKey(ctx, req.UserId)
+
+	if err := d.s.DeleteConsent(ctx, userID, connID, req.ClientId); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.RevokeConsentResp{NotFound: true}, nil
+		}
+		d.logger.Error("api: failed to delete consent", "err", err)
+		return nil, fmt.Errorf("delete consent: %v", err)
+	}
+
+	return &api.RevokeConsentResp{}, nil
+}
+
+func toStorageScope(sc *api.Scope) storage.Scope {
+	return storage.Scope{
+		Name:         sc.Name,
+		Descriptions: sc.Descriptions,
+		Claims:       sc.Claims,
+		ClientIDs:    sc.ClientIds,
+	}
+}
+
+func (d dexAPI) CreateScope(ctx context.Context, req *api.CreateScopeReq) (*api.CreateScopeResp, error) {
+	if req.Scope == nil {
+		return nil, errors.New("no scope supplied")
+	}
+	sc := toStorageScope(req.Scope)
+	if err := ValidateScope(sc); err != nil {
+		return nil, fmt.Errorf("create scope: %v", err)
+	}
+
+	if err := d.s.CreateScope(ctx, sc); err != nil {
+		if err == storage.ErrAlreadyExists {
+			return &api.CreateScopeResp{AlreadyExists: true}, nil
+		}
+		d.logger.Error("api: failed to create scope", "err", err)
+		return nil, fmt.Errorf("create scope: %v", err)
+	}
+
+	return &api.CreateScopeResp{}, nil
+}
+
+func (d dexAPI) UpdateScope(ctx context.Context, req *api.UpdateScopeReq) (*api.UpdateScopeResp, error) {
+	if req.Scope == nil {
+		return nil, errors.New("no scope supplied")
+	}
+	sc := toStorageScope(req.Scope)
+	if err := ValidateScope(sc); err != nil {
+		return nil, fmt.Errorf("update scope: %v", err)
+	}
+
+	err := d.s.UpdateScope(ctx, sc.Name, func(old storage.Scope) (storage.Scope, error) {
+		return sc, nil
+	})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return &api.UpdateScopeResp{NotFound: true}, nil
+		}
+		d.logger.Error("api: failed to update scope", "err", err)
+		return nil, fmt.Errorf("update scope: %v", err)
+	}
+
+	return &api.UpdateScopeResp{}, nil
+}
+
+func (d dexAPI) DeleteScope(ctx context.Context, req *api.DeleteScopeReq) (*api.DeleteScopeResp, error) {
+	if req.Name == "" {
+		return nil, errors.New("no name supplied")
+	}
+
+	if err := d.s.DeleteScope(ctx, req.Name); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.DeleteScopeResp{NotFound: true}, nil
+		}
+		d.logger.Error("api: failed to delete scope", "err", err)
+		return nil, fmt.Errorf("delete scope: %v", err)
+	}
+
+	return &api.DeleteScopeResp{}, nil
+}
+
+func (d dexAPI) ListScopes(ctx context.Context, req *api.ListScopesReq) (*api.ListScopesResp, error) {
+	scopeList, err := d.s.ListScopes(ctx)
+	if err != nil {
+		d.logger.Error("api: failed to list scopes", "err", err)
+		return nil, fmt.Errorf("list scopes: %v", err)
+	}
+
+	scopes := make([]*api.Scope, 0, len(scopeList))
+	for _, sc := range scopeList {
+		scopes = append(scopes, &api.Scope{
+			Name:         sc.Name,
+			Descriptions: sc.Descriptions,
+			Claims:       sc.Claims,
+			ClientIds:    sc.ClientIDs,
+		})
+	}
+
+	return &api.ListScopesResp{
+		Scopes: scopes,
+	}, nil
+}
+
 func defaultTo[T comparable](v, def T) T {
 	var zeroT T
 	if v == zeroT {
@@ -4,6 +4,7 @@ import (
 	"log/slog"
 	"net"
 	"os"
+	"reflect"
 	"slices"
 	"strings"
 	"testing"
@@ -335,8 +336,8 @@ func TestRefreshToken(t *testing.T) {
 }
 
 // TestRefreshTokenFlatSubject exercises ListRefresh and RevokeRefresh with the
-// flat "sub" this fork puts in the ID token (see genSubject in oauth2.go),
-// rather than the upstream base64-protobuf subject covered by TestRefreshToken.
+// flat "sub" of the default raw subject format, rather than the upstream
+// base64-protobuf subject covered by TestRefreshToken.
 // Since that subject doesn't carry the connector id, the server has to recover
 // it from the configured connectors.
 func TestRefreshTokenFlatSubject(t *testing.T) {
@@ -395,6 +396,15 @@ func TestRefreshTokenFlatSubject(t *testing.T) {
 		t.Errorf("expected refresh token %q, got %q", r.ID, got)
 	}
 
+	// The subject of the "connector" subject format names the connector.
+	listResp, err = client.ListRefresh(ctx, &api.ListRefreshReq{UserId: "keystone:" + userID})
+	if err != nil {
+		t.Fatalf("list refresh tokens: %v", err)
+	}
+	if len(listResp.RefreshTokens) != 1 {
+		t.Fatalf("expected 1 refresh token for the connector subject, got %d", len(listResp.RefreshTokens))
+	}
+
 	revokeResp, err := client.RevokeRefresh(ctx, &api.RevokeRefreshReq{UserId: userID, ClientId: r.ClientID})
 	if err != nil {
 		t.Fatalf("revoke refresh token: %v", err)
@@ -425,6 +435,8 @@ func TestUpdateClient(t *testing.T) {
 	defer client.Close()
 
 	ctx := t.Context()
+	requirePAR := true
+	requirePKCE := true
 
 	createClient := func(t *testing.T, clientId string) {
 		resp, err := client.CreateClient(ctx, &api.CreateClientReq{
@@ -482,6 +494,17 @@ func TestUpdateClient(t *testing.T) {
 				TrustedPeers: []string{"test"},
 				Name:         "test",
 				LogoUrl:      "https://logout",
+
+				AccessTokenFormat:                  "opaque",
+				RequirePushedAuthorizationRequests: &requirePAR,
+				ClaimMappings:                      []*api.ClaimMapping{{Claim: "tenant", Template: "example"}},
+				Expiry: &api.ClientExpiry{
+					IdTokens:      "5m",
+					RefreshTokens: &api.ClientRefreshTokenExpiry{AbsoluteLifetime: "12h"},
+				},
+				AllowedConnectors: []string{"ldap"},
+				AllowedGrantTypes: []string{"authorization_code", "refresh_token"},
+				RequirePkce:       &requirePKCE,
 			},
 			wantErr: false,
 			want: &api.UpdateClientResp{
@@ -503,6 +526,90 @@ func TestUpdateClient(t *testing.T) {
 				NotFound: false,
 			},
 		},
+		"update client with unknown access token format": {
+			setup:   createClient,
+			cleanup: deleteClient,
+			req: &api.UpdateClientReq{
+				Id:                "test",
+				AccessTokenFormat: "paseto",
+			},
+			wantErr: true,
+			want: &api.UpdateClientResp{
+				NotFound: false,
+			},
+		},
+		"update client with invalid jwks": {
+			setup:   createClient,
+			cleanup: deleteClient,
+			req: &api.UpdateClientReq{
+				Id:   "test",
+				Jwks: `{"keys":[]}`,
+			},
+			wantErr: true,
+			want: &api.UpdateClientResp{
+				NotFound: false,
+			},
+		},
+		"update client to private_key_jwt without keys": {
+			setup:   createClient,
+			cleanup: deleteClient,
+			req: &api.UpdateClientReq{
+				Id:                      "test",
+				TokenEndpointAuthMethod: "private_key_jwt",
+			},
+			wantErr: true,
+			want: &api.UpdateClientResp{
+				NotFound: false,
+			},
+		},
+		"update client with a protected claim mapping": {
+			setup:   createClient,
+			cleanup: deleteClient,
+			req: &api.UpdateClientReq{
+				Id:            "test",
+				ClaimMappings: []*api.ClaimMapping{{Claim: "sub", Template: "admin"}},
+			},
+			wantErr: true,
+			want: &api.UpdateClientResp{
+				NotFound: false,
+			},
+		},
+		"update client with an invalid expiry": {
+			setup:   createClient,
+			cleanup: deleteClient,
+			req: &api.UpdateClientReq{
+				Id:     "test",
+				Expiry: &api.ClientExpiry{IdTokens: "five minutes"},
+			},
+			wantErr: true,
+			want: &api.UpdateClientResp{
+				NotFound: false,
+			},
+		},
+		"update client with an expiry beyond the ID token expiry": {
+			setup:   createClient,
+			cleanup: deleteClient,
+			req: &api.UpdateClientReq{
+				Id:     "test",
+				Expiry: &api.ClientExpiry{IdTokens: "48h"},
+			},
+			wantErr: true,
+			want: &api.UpdateClientResp{
+				NotFound: false,
+			},
+		},
+		"update client with an unknown grant type": {
+			setup:   createClient,
+			cleanup: deleteClient,
+			req: &api.UpdateClientReq{
+				Id:                "test",
+				AllowedGrantTypes: []string{"magic"},
+			},
+			wantErr: true,
+			want: &api.UpdateClientResp{
+				NotFound: false,
+			},
+		},
 		"update client which not exists ": {
 			req: &api.UpdateClientReq{
 				Id:           "test",
@@ -551,6 +658,25 @@ func TestUpdateClient(t *testing.T) {
 				if tc.req.LogoUrl != client.LogoURL {
 					t.Errorf("expected stored client with LogoURL: %s, found %s", tc.req.LogoUrl, client.LogoURL)
 				}
+				if tc.req.AccessTokenFormat != client.AccessTokenFormat {
+					t.Errorf("expected stored client with AccessTokenFormat: %s, found %s", tc.req.AccessTokenFormat, client.AccessTokenFormat)
+				}
+				if !reflect.DeepEqual(fromAPIClaimMappings(tc.req.ClaimMappings), client.ClaimMappings) {
+					t.Errorf("expected stored client with ClaimMappings: %v, found %v", tc.req.ClaimMappings, client.ClaimMappings)
+				}
+				if !reflect.DeepEqual(fromAPIClientExpiry(tc.req.Expiry), client.Expiry) {
+					t.Errorf("expected stored client with Expiry: %v, found %v", tc.req.Expiry, client.Expiry)
+				}
+				if tc.req.GetRequirePushedAuthorizationRequests() != client.RequirePushedAuthorizationRequests {
+					t.Errorf("expected stored client with RequirePushedAuthorizationRequests: %t, found %t", tc.req.GetRequirePushedAuthorizationRequests(), client.RequirePushedAuthorizationRequests)
+				}
+				if !slices.Equal(tc.req.AllowedConnectors, client.AllowedConnectors) || !slices.Equal(tc.req.AllowedGrantTypes, client.AllowedGrantTypes) {
+					t.Errorf("expected stored client with AllowedConnectors %v and AllowedGrantTypes %v, found %v and %v",
+						tc.req.AllowedConnectors, tc.req.AllowedGrantTypes, client.AllowedConnectors, client.AllowedGrantTypes)
+				}
+				if tc.req.GetRequirePkce() != client.RequirePKCE {
+					t.Errorf("expected stored client with RequirePKCE: %t, found %t", tc.req.GetRequirePkce(), client.RequirePKCE)
+				}
 				for _, redirectURI := range tc.req.RedirectUris {
 					found := slices.Contains(client.RedirectURIs, redirectURI)
 					if !found {
@@ -909,3 +1035,201 @@ func TestListClients(t *testing.T) {
 		}
 	}
 }
+
+func TestInitialAccessToken(t *testing.T) {
+	logger := newLogger(t)
+	s := memory.New(logger)
+
+	client := newAPI(t, s, logger)
+	defer client.Close()
+
+	ctx := t.Context()
+
+	resp, err := client.CreateInitialAccessToken(ctx, &api.CreateInitialAccessTokenReq{ExpiresIn: 60})
+	if err != nil {
+		t.Fatalf("Unable to create initial access token: %v", err)
+	}
+	if resp.Token == "" {
+		t.Fatal("Expected a token")
+	}
+	if d := time.Until(time.Unix(resp.ExpiresAt, 0)); d <= 0 || d > time.Minute {
+		t.Errorf("Expected the token to expire within a minute, got %v", d)
+	}
+
+	// Only the hash of the token is stored.
+	if _, err := s.GetInitialAccessToken(ctx, resp.Token); err != storage.ErrNotFound {
+		t.Errorf("Expected the token not to be stored as is, got %v", err)
+	}
+	if _, err := s.GetInitialAccessToken(ctx, hashToken(resp.Token)); err != nil {
+		t.Errorf("Unable to get initial access token: %v", err)
+	}
+
+	if _, err := client.CreateInitialAccessToken(ctx, &api.CreateInitialAccessTokenReq{ExpiresIn: -1}); err == nil {
+		t.Error("Expected an error for a negative lifetime")
+	}
+
+	deleteResp, err := client.DeleteInitialAccessToken(ctx, &api.DeleteInitialAccessTokenReq{Token: resp.Token})
+	if err != nil {
+		t.Fatalf("Unable to delete initial access token: %v", err)
+	}
+	if deleteResp.NotFound {
+		t.Fatal("Expected the token to be found")
+	}
+
+	deleteResp, err = client.DeleteInitialAccessToken(ctx, &api.DeleteInitialAccessTokenReq{Token: resp.Token})
+	if err != nil {
+		t.Fatalf("Unable to delete initial access token: %v", err)
+	}
+	if !deleteResp.NotFound {
+		t.Fatal("Should return not found")
+	}
+}
+
+func TestConsents(t *testing.T) {
+	logger := newLogger(t)
+	s := memory.New(logger)
+
+	client := newAPI(t, s, logger)
+	defer client.Close()
+
+	ctx := t.Context()
+
+	if err := s.CreateConnector(ctx, storage.Connector{ID: "ldap", Type: "ldap", Name: "LDAP"}); err != nil {
+		t.Fatalf("create connector: %v", err)
+	}
+	now := time.Now().UTC().Round(time.Second)
+	for _, clientID := range []string{"client-a", "client-b"} {
+		c := storage.Consent{
+			UserID:      "1",
+			ConnectorID: "ldap",
+			ClientID:    clientID,
+			Scopes:      []string{"openid", "email"},
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if err := s.CreateConsent(ctx, c); err != nil {
+			t.Fatalf("create consent: %v", err)
+		}
+	}
+
+	listResp, err := client.ListConsents(ctx, &api.ListConsentsReq{UserId: "1"})
+	if err != nil {
+		t.Fatalf("Unable to list consents: %v", err)
+	}
+	if len(listResp.Consents) != 2 {
+		t.Fatalf("Expected 2 consents, got %d", len(listResp.Consents))
+	}
+	for _, c := range listResp.Consents {
+		if !slices.Equal(c.Scopes, []string{"openid", "email"}) || c.CreatedAt != now.Unix() || c.UpdatedAt != now.Unix() {
+			t.Errorf("Unexpected consent %v", c)
+		}
+	}
+
+	revokeResp, err := client.RevokeConsent(ctx, &api.RevokeConsentReq{UserId: "ldap:1", ClientId: "client-a"})
+	if err != nil {
+		t.Fatalf("Unable to revoke consent: %v", err)
+	}
+	if revokeResp.NotFound {
+		t.Fatal("Expected the consent to be found")
+	}
+	if _, err := s.GetConsent(ctx, "1", "ldap", "client-a"); err != storage.ErrNotFound {
+		t.Errorf("Expected the consent to be deleted, got %v", err)
+	}
+
+	revokeResp, err = client.RevokeConsent(ctx, &api.RevokeConsentReq{UserId: "1", ClientId: "client-a"})
+	if err != nil {
+		t.Fatalf("Unable to revoke consent: %v", err)
+	}
+	if !revokeResp.NotFound {
+		t.Fatal("Should return not found")
+	}
+
+	listResp, err = client.ListConsents(ctx, &api.ListConsentsReq{UserId: "unknown"})
+	if err != nil {
+		t.Fatalf("Unable to list consents: %v", err)
+	}
+	if len(listResp.Consents) != 0 {
+		t.Errorf("Expected no consents for an unknown user, got %d", len(listResp.Consents))
+	}
+}
+
+func TestScopes(t *testing.T) {
+	logger := newLogger(t)
+	s := memory.New(logger)
+
+	client := newAPI(t, s, logger)
+	defer client.Close()
+
+	ctx := t.Context()
+
+	scope := &api.Scope{
+		Name:         "orders",
+		Descriptions: map[string]string{"en": "View your orders"},
+		Claims:       []string{"customer_id"},
+		ClientIds:    []string{"shop"},
+	}
+	createResp, err := client.CreateScope(ctx, &api.CreateScopeReq{Scope: scope})
+	if err != nil {
+		t.Fatalf("Unable to create scope: %v", err)
+	}
+	if createResp.AlreadyExists {
+		t.Fatal("Scope should not already exist")
+	}
+
+	createResp, err = client.CreateScope(ctx, &api.CreateScopeReq{Scope: scope})
+	if err != nil {
+		t.Fatalf("Unable to create scope: %v", err)
+	}
+	if !createResp.AlreadyExists {
+		t.Fatal("Should return already exists")
+	}
+
+	if _, err := client.CreateScope(ctx, &api.CreateScopeReq{Scope: &api.Scope{Name: "email"}}); err == nil {
+		t.Fatal("Expected an error defining a built-in scope")
+	}
+
+	scope.Claims = []string{"customer_id", "loyalty_tier"}
+	updateResp, err := client.UpdateScope(ctx, &api.UpdateScopeReq{Scope: scope})
+	if err != nil {
+		t.Fatalf("Unable to update scope: %v", err)
+	}
+	if updateResp.NotFound {
+		t.Fatal("Expected the scope to be found")
+	}
+
+	updateResp, err = client.UpdateScope(ctx, &api.UpdateScopeReq{Scope: &api.Scope{Name: "invoices"}})
+	if err != nil {
+		t.Fatalf("Unable to update scope: %v", err)
+	}
+	if !updateResp.NotFound {
+		t.Fatal("Should return not found")
+	}
+
+	listResp, err := client.ListScopes(ctx, &api.ListScopesReq{})
+	if err != nil {
+		t.Fatalf("Unable to list scopes: %v", err)
+	}
+	if len(listResp.Scopes) != 1 {
+		t.Fatalf("Expected 1 scope, got %d", len(listResp.Scopes))
+	}
+	if got := listResp.Scopes[0]; got.Name != "orders" || !slices.Equal(got.Claims, scope.Claims) ||
+		!slices.Equal(got.ClientIds, scope.ClientIds) || got.Descriptions["en"] != "View your orders" {
+		t.Errorf("Unexpected scope %v", got)
+	}
+
+	deleteResp, err := client.DeleteScope(ctx, &api.DeleteScopeReq{Name: "orders"})
+	if err != nil {
+		t.Fatalf("Unable to delete scope: %v", err)
+	}
+	if deleteResp.NotFound {
+		t.Fatal("Expected the scope to be found")
+	}
+
+	deleteResp, err = client.DeleteScope(ctx, &api.DeleteScopeReq{Name: "orders"})
+	if err != nil {
+		t.Fatalf("Unable to delete scope: %v", err)
+	}
+	if !deleteResp.NotFound {
+		t.Fatal("Should return not found")
+	}
+}
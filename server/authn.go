@@ -0,0 +1,73 @@
+package server
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// Authentication Context Class References reported in the "acr" claim, from
+// the weakest to the strongest. A login meets any class up to its own.
+const (
+	// acrSingleFactor is met by every login.
+	acrSingleFactor = "1"
+	// acrMultiFactor is met by logins that passed a second factor.
+	acrMultiFactor = "2"
+)
+
+var supportedACRValues = []string{acrSingleFactor, acrMultiFactor}
+
+// Authentication Method References reported in the "amr" claim. See RFC 8176.
+const (
+	amrPassword = "pwd"
+	amrOTP      = "otp"
+	amrMFA      = "mfa"
+	// amrFederated is not registered by RFC 8176. The user authenticated with
+	// an upstream provider, which does not tell dex how.
+	amrFederated = "fed"
+	// amrTrustedDevice is not registered by RFC 8176 either. The browser
+	// presented the token of an earlier second factor login instead of
+	// credentials; see Server.mfaTrustCookie.
+	amrTrustedDevice = "trusted_device"
+)
+
+// errUnmetAuthenticationRequirements is returned to clients whose acr_values
+// the login could not meet, as defined by OpenID Connect Core Unmet
+// Authentication Requirements 1.0.
+const errUnmetAuthenticationRequirements = "unmet_authentication_requirements"
+
+// newAuthentication describes a login that just happened using the given
+// methods.
+func (s *Server) newAuthentication(amr ...string) storage.Authentication {
+	acr := acrSingleFactor
+	if slices.Contains(amr, amrMFA) {
+		acr = acrMultiFactor
+	}
+	return storage.Authentication{ACR: acr, AMR: amr, Time: s.now(), SessionID: uuid.New().String()}
+}
+
+// passwordAuthentication describes a password login, which also checked a
+// one-time passcode if withOTP is set. Only the connector can tell, see
+// connector.Identity.MultiFactor.
+func (s *Server) passwordAuthentication(withOTP bool) storage.Authentication {
+	if withOTP {
+		return s.newAuthentication(amrPassword, amrOTP, amrMFA)
+	}
+	return s.newAuthentication(amrPassword)
+}
+
+// meetsACRValues reports whether a login of class acr satisfies the acr_values
+// a client requested. Clients list acceptable classes, so the weakest one dex
+// supports is required. Values dex does not support are ignored, and a request
+// without any supported value is met by every login.
+func meetsACRValues(acrValues []string, acr string) bool {
+	required := -1
+	for _, v := range acrValues {
+		if i := slices.Index(supportedACRValues, v); i >= 0 && (required < 0 || i < required) {
+			required = i
+		}
+	}
+	return required < 0 || slices.Index(supportedACRValues, acr) >= required
+}
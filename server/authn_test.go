@@ -0,0 +1,213 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestMeetsACRValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		acrValues []string
+		acr       string
+		want      bool
+	}{
+		{"no acr_values", nil, "", true},
+		{"single factor requested", []string{acrSingleFactor}, acrSingleFactor, true},
+		{"multi factor meets single factor", []string{acrSingleFactor}, acrMultiFactor, true},
+		{"multi factor requested", []string{acrMultiFactor}, acrSingleFactor, false},
+		{"multi factor login", []string{acrMultiFactor}, acrMultiFactor, true},
+		{"weakest acceptable class", []string{acrMultiFactor, acrSingleFactor}, acrSingleFactor, true},
+		{"unknown values ignored", []string{"urn:example:loa:3"}, acrSingleFactor, true},
+		{"unknown login class", []string{acrSingleFactor}, "", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, meetsACRValues(tc.acrValues, tc.acr))
+		})
+	}
+}
+
+func TestPasswordGrantAuthenticationClaims(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now().Truncate(time.Second)
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.PasswordConnector = "test"
+		c.Now = func() time.Time { return now }
+	})
+	defer httpServer.Close()
+
+	mockConnectorDataTestStorage(t, s.storage)
+
+	vals := url.Values{
+		"grant_type": {grantTypePassword},
+		"scope":      {"openid offline_access"},
+		"username":   {"test"},
+		"password":   {"test"},
+	}
+	req := httptest.NewRequest(http.MethodPost, httpServer.URL+"/token", strings.NewReader(vals.Encode()))
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("test", "barfoo")
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var res accessTokenResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+
+	verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: "test", Now: func() time.Time { return now }})
+	idToken, err := verifier.Verify(ctx, res.IDToken)
+	require.NoError(t, err)
+	var claims struct {
+		ACR      string   `json:"acr"`
+		AMR      []string `json:"amr"`
+		AuthTime int64    `json:"auth_time"`
+	}
+	require.NoError(t, idToken.Claims(&claims))
+	require.Equal(t, acrSingleFactor, claims.ACR)
+	require.Equal(t, []string{amrPassword}, claims.AMR)
+	require.Equal(t, now.Unix(), claims.AuthTime)
+
+	// The refresh token keeps the original login so refreshed ID tokens
+	// report the same authentication.
+	refreshTokens, err := s.storage.ListRefreshTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, refreshTokens, 1)
+	require.Equal(t, acrSingleFactor, refreshTokens[0].Authentication.ACR)
+	require.Equal(t, []string{amrPassword}, refreshTokens[0].Authentication.AMR)
+	require.True(t, now.Equal(refreshTokens[0].Authentication.Time))
+}
+
+func TestConnectorCallbackACRValues(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name      string
+		acrValues []string
+		wantError string
+	}{
+		{name: "no acr_values"},
+		{name: "single factor", acrValues: []string{acrSingleFactor}},
+		{name: "multi factor", acrValues: []string{acrMultiFactor}, wantError: errUnmetAuthenticationRequirements},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.SkipApprovalScreen = true
+				c.Now = time.Now
+			})
+			defer httpServer.Close()
+
+			authReq := storage.AuthRequest{
+				ID:            "test",
+				ClientID:      "test",
+				ConnectorID:   "mock",
+				RedirectURI:   "https://client.example.com/cb",
+				State:         "state",
+				Expiry:        time.Now().Add(time.Minute),
+				ResponseTypes: []string{responseTypeCode},
+				Scopes:        []string{"openid"},
+				ACRValues:     tc.acrValues,
+			}
+			require.NoError(t, s.storage.CreateAuthRequest(ctx, authReq))
+
+			rr := httptest.NewRecorder()
+			s.handleConnectorCallback(rr, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/callback/mock?state=%s", authReq.ID), nil))
+
+			location, err := url.Parse(rr.Header().Get("Location"))
+			require.NoError(t, err)
+			if tc.wantError != "" {
+				require.Equal(t, "client.example.com", location.Host)
+				require.Equal(t, tc.wantError, location.Query().Get("error"))
+				require.Equal(t, authReq.State, location.Query().Get("state"))
+				return
+			}
+
+			code := location.Query().Get("code")
+			require.NotEmpty(t, code, location.String())
+			authCode, err := s.storage.GetAuthCode(ctx, code)
+			require.NoError(t, err)
+			require.Equal(t, acrSingleFactor, authCode.Authentication.ACR)
+			require.Equal(t, []string{amrFederated}, authCode.Authentication.AMR)
+			require.False(t, authCode.Authentication.Time.IsZero())
+		})
+	}
+}
+
+func TestPasswordLoginIgnoresUnverifiedPasscode(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name      string
+		acrValues []string
+		wantError string
+	}{
+		{name: "no acr_values"},
+		{name: "multi factor", acrValues: []string{acrMultiFactor}, wantError: errUnmetAuthenticationRequirements},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.SkipApprovalScreen = true
+				c.Now = time.Now
+			})
+			defer httpServer.Close()
+
+			sc := storage.Connector{
+				ID:              "mockPw",
+				Type:            "mockPassword",
+				Name:            "MockPassword",
+				ResourceVersion: "1",
+				Config:          []byte(`{"username": "foo", "password": "password"}`),
+			}
+			require.NoError(t, s.storage.CreateConnector(ctx, sc))
+			_, err := s.OpenConnector(sc)
+			require.NoError(t, err)
+
+			authReq := storage.AuthRequest{
+				ID:            "test",
+				ClientID:      "test",
+				ConnectorID:   sc.ID,
+				RedirectURI:   "https://client.example.com/cb",
+				State:         "state",
+				Expiry:        time.Now().Add(time.Minute),
+				ResponseTypes: []string{responseTypeCode},
+				Scopes:        []string{"openid"},
+				ACRValues:     tc.acrValues,
+			}
+			require.NoError(t, s.storage.CreateAuthRequest(ctx, authReq))
+
+			// The mock connector never checks a passcode, so sending one must
+			// not make the login count as multi factor.
+			rr := httptest.NewRecorder()
+			path := fmt.Sprintf("/auth/%s/login?state=%s&back=&login=foo&password=password&totp=123456", sc.ID, authReq.ID)
+			s.handlePasswordLogin(rr, httptest.NewRequest(http.MethodPost, path, nil))
+
+			location, err := url.Parse(rr.Header().Get("Location"))
+			require.NoError(t, err)
+			if tc.wantError != "" {
+				require.Equal(t, "client.example.com", location.Host)
+				require.Equal(t, tc.wantError, location.Query().Get("error"))
+				return
+			}
+
+			code := location.Query().Get("code")
+			require.NotEmpty(t, code, location.String())
+			authCode, err := s.storage.GetAuthCode(ctx, code)
+			require.NoError(t, err)
+			require.Equal(t, acrSingleFactor, authCode.Authentication.ACR)
+			require.Equal(t, []string{amrPassword}, authCode.Authentication.AMR)
+		})
+	}
+}
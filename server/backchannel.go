@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// OpenID Connect Back-Channel Logout 1.0.
+//
+// Logouts are not delivered inline. Ending a session only queues a
+// LogoutNotification per client in storage; a background worker signs a
+// logout token for each one when it is due and POSTs it to the client's
+// backchannelLogoutURI. A failed delivery stays queued and is retried with
+// exponential backoff until it expires, so a client that is briefly down
+// still learns about the logout, and a dex restart loses nothing.
+
+const backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+// logoutTokenType is the "typ" header of logout tokens, which keeps them from
+// being mistaken for ID tokens.
+const logoutTokenType = "logout+jwt"
+
+const (
+	// How long dex keeps trying to deliver a logout before giving up.
+	logoutNotificationLifetime = 24 * time.Hour
+
+	// How often the queue is scanned for due notifications, which is also
+	// the first retry delay.
+	logoutRetryInterval = 30 * time.Second
+	logoutMaxRetryDelay = time.Hour
+
+	// Logout tokens are consumed immediately, so they only need to outlive
+	// the request carrying them.
+	logoutTokenValidFor = 2 * time.Minute
+)
+
+var errLogoutNotificationClaimed = errors.New("logout notification claimed by another worker")
+
+type logoutTokenClaims struct {
+	Issuer    string              `json:"iss"`
+	Subject   string              `json:"sub"`
+	Audience  audience            `json:"aud"`
+	IssuedAt  int64               `json:"iat"`
+	Expiry    int64               `json:"exp"`
+	JTI       string              `json:"jti"`
+	Events    map[string]struct{} `json:"events"`
+	SessionID string              `json:"sid,omitempty"`
+}
+
+// queueBackchannelLogout queues a logout for each client in clientIDs that
+// registered a backchannelLogoutURI. subjectFor returns the subject the
+// client knows the user by, and sessionID, if set, the session that ended.
+func queueBackchannelLogout(ctx context.Context, s storage.Storage, now time.Time, subjectFor func(storage.Client) (string, error), sessionID string, clientIDs []string) error {
+	var errs []error
+	for _, clientID := range clientIDs {
+		client, err := s.GetClient(ctx, clientID)
+		if err != nil {
+			if err != storage.ErrNotFound {
+				errs = append(errs, fmt.Errorf("get client %q: %v", clientID, err))
+			}
+			continue
+		}
+		if client.BackchannelLogoutURI == "" {
+			continue
+		}
+		subject, err := subjectFor(client)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subject for client %q: %v", clientID, err))
+			continue
+		}
+		notification := storage.LogoutNotification{
+			ID:          storage.NewID(),
+			ClientID:    clientID,
+			Subject:     subject,
+			SessionID:   sessionID,
+			CreatedAt:   now,
+			NextAttempt: now,
+			Expiry:      now.Add(logoutNotificationLifetime),
+		}
+		if err := s.CreateLogoutNotification(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("queue logout for client %q: %v", clientID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backchannelLogout queues a logout for clientIDs and wakes the delivery
+// worker.
+func (s *Server) backchannelLogout(ctx context.Context, subjectFor func(storage.Client) (string, error), sessionID string, clientIDs []string) {
+	if err := queueBackchannelLogout(ctx, s.storage, s.now(), subjectFor, sessionID, clientIDs); err != nil {
+		s.logger.ErrorContext(ctx, "failed to queue back-channel logout", "err", err)
+	}
+	select {
+	case s.logoutQueued <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Server) startBackchannelLogout(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.logoutQueued:
+			case <-time.After(logoutRetryInterval):
+			}
+			s.deliverLogoutNotifications(ctx)
+		}
+	}()
+}
+
+// deliverLogoutNotifications makes one delivery attempt for every queued
+// notification that is due.
+func (s *Server) deliverLogoutNotifications(ctx context.Context) {
+	notifications, err := s.storage.ListLogoutNotifications(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list logout notifications", "err", err)
+		return
+	}
+
+	for _, n := range notifications {
+		now := s.now()
+		if now.Before(n.NextAttempt) || !now.Before(n.Expiry) {
+			continue
+		}
+
+		// Push NextAttempt back before sending, so other replicas leave the
+		// notification alone and a failure is already scheduled for retry.
+		err := s.storage.UpdateLogoutNotification(ctx, n.ID, func(old storage.LogoutNotification) (storage.LogoutNotification, error) {
+			if now.Before(old.NextAttempt) {
+				return old, errLogoutNotificationClaimed
+			}
+			old.Attempts++
+			old.NextAttempt = now.Add(logoutRetryDelay(old.Attempts))
+			return old, nil
+		})
+		if err != nil {
+			if err != errLogoutNotificationClaimed && err != storage.ErrNotFound {
+				s.logger.ErrorContext(ctx, "failed to update logout notification", "err", err)
+			}
+			continue
+		}
+
+		if err := s.sendLogoutToken(ctx, n); err != nil {
+			s.logger.WarnContext(ctx, "back-channel logout delivery failed",
+				"client_id", n.ClientID, "attempt", n.Attempts+1, "err", err)
+			continue
+		}
+		if err := s.storage.DeleteLogoutNotification(ctx, n.ID); err != nil && err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to delete logout notification", "err", err)
+		}
+	}
+}
+
+func logoutRetryDelay(attempts int) time.Duration {
+	delay := logoutRetryInterval
+	for i := 1; i < attempts && delay < logoutMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, logoutMaxRetryDelay)
+}
+
+// sendLogoutToken signs a logout token for n and POSTs it to the client.
+func (s *Server) sendLogoutToken(ctx context.Context, n storage.LogoutNotification) error {
+	client, err := s.storage.GetClient(ctx, n.ClientID)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			// Nobody left to tell.
+			return nil
+		}
+		return fmt.Errorf("get client: %v", err)
+	}
+	if client.BackchannelLogoutURI == "" {
+		return nil
+	}
+
+	issuedAt := s.now()
+	payload, err := json.Marshal(logoutTokenClaims{
+		Issuer:    s.issuerURL.String(),
+		Subject:   n.Subject,
+		Audience:  audience{n.ClientID},
+		IssuedAt:  issuedAt.Unix(),
+		Expiry:    issuedAt.Add(logoutTokenValidFor).Unix(),
+		JTI:       uuid.New().String(),
+		Events:    map[string]struct{}{backchannelLogoutEvent: {}},
+		SessionID: n.SessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not serialize claims: %v", err)
+	}
+	logoutToken, err := s.signer.SignWithType(ctx, logoutTokenType, payload)
+	if err != nil {
+		return fmt.Errorf("failed to sign payload: %v", err)
+	}
+
+	form := url.Values{"logout_token": {logoutToken}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.BackchannelLogoutURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.backchannelClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// logoutReceiver is a client's back-channel logout endpoint.
+type logoutReceiver struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	status int
+	tokens []string
+}
+
+func newLogoutReceiver(t *testing.T) *logoutReceiver {
+	rcv := &logoutReceiver{status: http.StatusOK}
+	rcv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rcv.mu.Lock()
+		defer rcv.mu.Unlock()
+		if rcv.status == http.StatusOK {
+			rcv.tokens = append(rcv.tokens, r.PostFormValue("logout_token"))
+		}
+		w.WriteHeader(rcv.status)
+	}))
+	t.Cleanup(rcv.Close)
+	return rcv
+}
+
+func (rcv *logoutReceiver) received() []string {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	return append([]string(nil), rcv.tokens...)
+}
+
+func (rcv *logoutReceiver) setStatus(status int) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	rcv.status = status
+}
+
+func TestBackchannelLogoutDelivery(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	rcv := newLogoutReceiver(t)
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:                   "app",
+		Secret:               "secret",
+		BackchannelLogoutURI: rcv.URL,
+	}))
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:     "no-backchannel",
+		Secret: "secret",
+	}))
+
+	require.NoError(t, queueBackchannelLogout(ctx, s.storage, s.now(), s.subjectFor("user-1", "mock"), "session-1", []string{"app", "no-backchannel", "unknown"}))
+
+	queued, err := s.storage.ListLogoutNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	require.Equal(t, "app", queued[0].ClientID)
+
+	s.deliverLogoutNotifications(ctx)
+
+	tokens := rcv.received()
+	require.Len(t, tokens, 1)
+
+	verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: "app"})
+	logoutToken, err := verifier.Verify(ctx, tokens[0])
+	require.NoError(t, err)
+	require.Equal(t, "user-1", logoutToken.Subject)
+	require.Equal(t, logoutTokenType, jwtType(tokens[0]))
+
+	var claims struct {
+		Events    map[string]any `json:"events"`
+		JTI       string         `json:"jti"`
+		Nonce     *string        `json:"nonce"`
+		SessionID string         `json:"sid"`
+	}
+	require.NoError(t, logoutToken.Claims(&claims))
+	require.Contains(t, claims.Events, backchannelLogoutEvent)
+	require.NotEmpty(t, claims.JTI)
+	require.Nil(t, claims.Nonce)
+	require.Equal(t, "session-1", claims.SessionID)
+
+	queued, err = s.storage.ListLogoutNotifications(ctx)
+	require.NoError(t, err)
+	require.Empty(t, queued)
+}
+
+func TestBackchannelLogoutRetry(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Now = func() time.Time { return now }
+	})
+	defer httpServer.Close()
+
+	rcv := newLogoutReceiver(t)
+	rcv.setStatus(http.StatusServiceUnavailable)
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:                   "app",
+		Secret:               "secret",
+		BackchannelLogoutURI: rcv.URL,
+	}))
+	require.NoError(t, queueBackchannelLogout(ctx, s.storage, now, s.subjectFor("user-1", "mock"), "", []string{"app"}))
+
+	s.deliverLogoutNotifications(ctx)
+
+	queued, err := s.storage.ListLogoutNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	require.Equal(t, 1, queued[0].Attempts)
+	require.True(t, queued[0].NextAttempt.Equal(now.Add(logoutRetryInterval)))
+
+	// Not due yet, so the recovered client is left alone.
+	rcv.setStatus(http.StatusOK)
+	s.deliverLogoutNotifications(ctx)
+	require.Empty(t, rcv.received())
+
+	now = now.Add(logoutRetryInterval)
+	s.deliverLogoutNotifications(ctx)
+	require.Len(t, rcv.received(), 1)
+
+	queued, err = s.storage.ListLogoutNotifications(ctx)
+	require.NoError(t, err)
+	require.Empty(t, queued)
+}
+
+func TestLogoutRetryDelay(t *testing.T) {
+	require.Equal(t, logoutRetryInterval, logoutRetryDelay(1))
+	require.Equal(t, 2*logoutRetryInterval, logoutRetryDelay(2))
+	require.Equal(t, 4*logoutRetryInterval, logoutRetryDelay(3))
+	require.Equal(t, logoutMaxRetryDelay, logoutRetryDelay(100))
+}
+
+func TestLogoutEndpointNotifiesClients(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	rcv := newLogoutReceiver(t)
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:                   "app",
+		Secret:               "secret",
+		BackchannelLogoutURI: rcv.URL,
+	}))
+
+	idToken, _, _, err := s.newIDToken(ctx, storage.Client{ID: "app"}, storage.Claims{UserID: "user-1"}, storage.Authentication{}, []string{"openid"}, "", "", "", "mock", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/logout?"+url.Values{"id_token_hint": {idToken}}.Encode(), nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// Delivery happens in the background.
+	require.Eventually(t, func() bool { return len(rcv.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLogoutNotifiesSessionClients(t *testing.T) {
+	ctx := t.Context()
+	s, _ := newSessionTestServer(t)
+
+	receivers := map[string]*logoutReceiver{}
+	for _, id := range []string{"app1", "app2"} {
+		rcv := newLogoutReceiver(t)
+		receivers[id] = rcv
+		require.NoError(t, s.storage.UpdateClient(ctx, id, func(old storage.Client) (storage.Client, error) {
+			old.BackchannelLogoutURI = rcv.URL
+			return old, nil
+		}))
+	}
+
+	// Neither client asks for offline_access, so only the session knows the
+	// user logged in to them.
+	cookie := login(t, s, "app1")
+	rr := authorize(s, "app2", cookie, nil)
+	requireCodeResponse(t, rr, "app2")
+
+	session, err := s.storage.GetUserSession(ctx, cookie.Value)
+	require.NoError(t, err)
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	code, err := s.storage.GetAuthCode(ctx, u.Query().Get("code"))
+	require.NoError(t, err)
+	// ID tokens for the code carry the session's sid.
+	require.Equal(t, session.Authentication.SessionID, code.Authentication.SessionID)
+
+	rr = confirmedLogout(t, s, nil, cookie)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	for id, rcv := range receivers {
+		require.Eventually(t, func() bool { return len(rcv.received()) == 1 }, 5*time.Second, 10*time.Millisecond, id)
+
+		verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: id})
+		logoutToken, err := verifier.Verify(ctx, rcv.received()[0])
+		require.NoError(t, err)
+		var claims struct {
+			SessionID string `json:"sid"`
+		}
+		require.NoError(t, logoutToken.Claims(&claims))
+		require.Equal(t, session.Authentication.SessionID, claims.SessionID)
+	}
+}
+
+func TestLogoutHintForAnotherUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		hintUserID string
+		wantApp2   bool
+	}{
+		{name: "same user", wantApp2: true},
+		{name: "other user", hintUserID: "someone-else"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			s, _ := newSessionTestServer(t)
+
+			receivers := map[string]*logoutReceiver{}
+			for _, id := range []string{"app1", "app2"} {
+				rcv := newLogoutReceiver(t)
+				receivers[id] = rcv
+				require.NoError(t, s.storage.UpdateClient(ctx, id, func(old storage.Client) (storage.Client, error) {
+					old.BackchannelLogoutURI = rcv.URL
+					return old, nil
+				}))
+			}
+
+			cookie := login(t, s, "app1")
+			session, err := s.storage.GetUserSession(ctx, cookie.Value)
+			require.NoError(t, err)
+
+			// The hint was issued to a client the session never logged in to.
+			hintUserID := tc.hintUserID
+			if hintUserID == "" {
+				hintUserID = session.Claims.UserID
+			}
+			app2, err := s.storage.GetClient(ctx, "app2")
+			require.NoError(t, err)
+			idToken, _, _, err := s.newIDToken(ctx, app2, storage.Claims{UserID: hintUserID}, storage.Authentication{}, []string{"openid"}, "", "", "", session.ConnectorID, nil)
+			require.NoError(t, err)
+
+			r := httptest.NewRequest(http.MethodGet, "/logout?"+url.Values{"id_token_hint": {idToken}}.Encode(), nil)
+			r.AddCookie(cookie)
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, r)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			wantSubject, err := s.subjectFor(session.Claims.UserID, session.ConnectorID)(app2)
+			require.NoError(t, err)
+			require.Eventually(t, func() bool { return len(receivers["app1"].received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+			if !tc.wantApp2 {
+				require.Never(t, func() bool { return len(receivers["app2"].received()) > 0 }, 200*time.Millisecond, 10*time.Millisecond)
+				return
+			}
+			require.Eventually(t, func() bool { return len(receivers["app2"].received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+			verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: "app2"})
+			logoutToken, err := verifier.Verify(ctx, receivers["app2"].received()[0])
+			require.NoError(t, err)
+			require.Equal(t, wantSubject, logoutToken.Subject)
+		})
+	}
+}
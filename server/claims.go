@@ -0,0 +1,230 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// protectedClaims cannot be set or removed by claim mappings. Clients and
+// resource servers rely on them to validate tokens.
+var protectedClaims = map[string]bool{
+	"iss":        true,
+	"sub":        true,
+	"aud":        true,
+	"exp":        true,
+	"iat":        true,
+	"nbf":        true,
+	"nonce":      true,
+	"azp":        true,
+	"at_hash":    true,
+	"c_hash":     true,
+	"cnf":        true,
+	"client_id":  true,
+	"scope":      true,
+	"active":     true,
+	"token_type": true,
+	"token_use":  true,
+}
+
+// authenticationClaims describe how the user logged in. Claim mappings of a
+// client may change them, but extra claims from upstream never set them.
+var authenticationClaims = map[string]bool{
+	"acr":       true,
+	"amr":       true,
+	"auth_time": true,
+}
+
+// claimTemplateFuncs are the functions available to claim mapping templates,
+// on top of the text/template builtins.
+var claimTemplateFuncs = template.FuncMap{
+	"toJSON": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"contains":   func(list []string, s string) bool { return slices.Contains(list, s) },
+	"hasPrefix":  strings.HasPrefix,
+	"hasSuffix":  strings.HasSuffix,
+	"trimPrefix": strings.TrimPrefix,
+	"trimSuffix": strings.TrimSuffix,
+	"lower":      strings.ToLower,
+	"upper":      strings.ToUpper,
+	"join":       strings.Join,
+	"split":      strings.Split,
+	// stripPrefix returns the elements of list starting with prefix, without
+	// it. For example, it derives roles from groups named "role:<name>".
+	"stripPrefix": func(prefix string, list []string) []string {
+		stripped := []string{}
+		for _, s := range list {
+			if name, ok := strings.CutPrefix(s, prefix); ok {
+				stripped = append(stripped, name)
+			}
+		}
+		return stripped
+	},
+}
+
+// claimTemplateData is what claim mapping templates are executed with.
+type claimTemplateData struct {
+	// Claims about the user, limited to the ones the scopes grant. UserID is
+	// the ID the connector returned, not the subject of the token. Responses
+	// about access tokens that were copies of the ID token don't know it.
+	Claims storage.Claims
+	Scopes []string
+
+	// ConnectorID is empty when the token does not record it, and the
+	// ConnectorData, decoded from JSON, is only known when tokens are issued.
+	// Neither is available in most userinfo and introspection responses.
+	ConnectorID   string
+	ConnectorData any
+
+	// Token holds the claims being mapped, including the ones set by earlier
+	// mappings.
+	Token map[string]any
+}
+
+func newClaimTemplateData(userID string, info userInfo, scopes []string, connID string, connData []byte) claimTemplateData {
+	data := claimTemplateData{
+		Claims: storage.Claims{
+			UserID:            userID,
+			Username:          info.Name,
+			PreferredUsername: info.PreferredUsername,
+			Email:             info.Email,
+			Groups:            info.Groups,
+			ExtraClaims:       info.ExtraClaims,
+		},
+		Scopes:      scopes,
+		ConnectorID: connID,
+	}
+	if info.EmailVerified != nil {
+		data.Claims.EmailVerified = *info.EmailVerified
+	}
+	// Connector data which is not JSON is left out.
+	if len(connData) > 0 {
+		_ = json.Unmarshal(connData, &data.ConnectorData)
+	}
+	return data
+}
+
+// ValidateClaimMappings checks that claim mappings only touch claims they
+// can change and that their templates parse.
+func ValidateClaimMappings(mappings []storage.ClaimMapping) error {
+	for _, m := range mappings {
+		if m.Claim == "" {
+			return errors.New("claim mapping has no claim name")
+		}
+		if protectedClaims[m.Claim] {
+			return fmt.Errorf("claim %q cannot be mapped", m.Claim)
+		}
+		if _, err := parseClaimTemplate(m); err != nil {
+			return fmt.Errorf("invalid template for claim %q: %v", m.Claim, err)
+		}
+	}
+	return nil
+}
+
+func parseClaimTemplate(m storage.ClaimMapping) (*template.Template, error) {
+	return template.New(m.Claim).Funcs(claimTemplateFuncs).Parse(m.Template)
+}
+
+// releasedExtraClaims returns the extra claims of a user the scopes release:
+// all of them for the extra claims scope, or else those named by the custom
+// scopes among scopes.
+func (s *Server) releasedExtraClaims(ctx context.Context, claims storage.Claims, scopes []string) map[string]any {
+	if s.extraClaimsScope != "" && slices.Contains(scopes, s.extraClaimsScope) {
+		return claims.ExtraClaims
+	}
+
+	var released map[string]any
+	for _, scope := range scopes {
+		if slices.Contains(builtinScopes, scope) {
+			continue
+		}
+		sc, err := s.customScope(ctx, scope)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get scope", "scope", scope, "err", err)
+			continue
+		}
+		if sc == nil {
+			continue
+		}
+		for _, name := range sc.Claims {
+			if v, ok := claims.ExtraClaims[name]; ok {
+				if released == nil {
+					released = make(map[string]any)
+				}
+				released[name] = v
+			}
+		}
+	}
+	return released
+}
+
+// marshalWithExtraClaims serializes claims together with extra claims of the
+// user.
+func marshalWithExtraClaims(claims any, extra map[string]any) ([]byte, error) {
+	return applyClaimMappings(nil, claims, claimTemplateData{Claims: storage.Claims{ExtraClaims: extra}})
+}
+
+// applyClaimMappings serializes claims, together with the extra claims of the
+// user in data, after applying the claim mappings of a client to them.
+func applyClaimMappings(mappings []storage.ClaimMapping, claims any, data claimTemplateData) ([]byte, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil || (len(mappings) == 0 && len(data.Claims.ExtraClaims) == 0) {
+		return payload, err
+	}
+
+	// Decode numbers as json.Number so timestamps are written back unchanged.
+	d := json.NewDecoder(bytes.NewReader(payload))
+	d.UseNumber()
+	if err := d.Decode(&data.Token); err != nil {
+		return nil, err
+	}
+
+	// Extra claims never replace the claims of the token, nor set protected
+	// or authentication ones it lacks, such as nonce or acr.
+	for name, v := range data.Claims.ExtraClaims {
+		if _, ok := data.Token[name]; ok || protectedClaims[name] || authenticationClaims[name] {
+			continue
+		}
+		data.Token[name] = v
+	}
+
+	for _, m := range mappings {
+		// Mappings are validated when clients are created through the API, but
+		// storage can also be edited directly.
+		if protectedClaims[m.Claim] {
+			return nil, fmt.Errorf("claim %q cannot be mapped", m.Claim)
+		}
+		if m.Template == "" {
+			delete(data.Token, m.Claim)
+			continue
+		}
+
+		tmpl, err := parseClaimTemplate(m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template for claim %q: %v", m.Claim, err)
+		}
+		var value bytes.Buffer
+		if err := tmpl.Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("failed to render claim %q: %v", m.Claim, err)
+		}
+		if !m.JSON {
+			data.Token[m.Claim] = value.String()
+			continue
+		}
+		var v any
+		if err := json.Unmarshal(value.Bytes(), &v); err != nil {
+			return nil, fmt.Errorf("claim %q is not valid JSON: %v", m.Claim, err)
+		}
+		data.Token[m.Claim] = v
+	}
+	return json.Marshal(data.Token)
+}
@@ -0,0 +1,329 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+func TestApplyClaimMappings(t *testing.T) {
+	verified := true
+	info := userInfo{
+		Subject:       "user-1",
+		Email:         "jane@example.com",
+		EmailVerified: &verified,
+		Groups:        []string{"admins", "role:editor", "role:viewer"},
+	}
+	claims := struct {
+		Issuer string `json:"iss"`
+		Expiry int64  `json:"exp"`
+		ACR    string `json:"acr"`
+		userInfo
+	}{"https://dex.example.com", 1700000000, "1", info}
+	data := newClaimTemplateData("1234", info, []string{"openid", "email", "groups"}, "ldap", []byte(`{"tenant":"acme"}`))
+
+	tests := []struct {
+		name     string
+		mappings []storage.ClaimMapping
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "no mappings",
+			want: `{"iss":"https://dex.example.com","exp":1700000000,"acr":"1","sub":"user-1","email":"jane@example.com","email_verified":true,"groups":["admins","role:editor","role:viewer"]}`,
+		},
+		{
+			name: "rename, constant and removal",
+			mappings: []storage.ClaimMapping{
+				{Claim: "mail", Template: "{{ .Token.email }}"},
+				{Claim: "email"},
+				{Claim: "acr"},
+				{Claim: "tenant", Template: "{{ .ConnectorData.tenant }}"},
+				{Claim: "source", Template: "dex-{{ .ConnectorID }}"},
+			},
+			want: `{"iss":"https://dex.example.com","exp":1700000000,"sub":"user-1","mail":"jane@example.com","email_verified":true,"groups":["admins","role:editor","role:viewer"],"tenant":"acme","source":"dex-ldap"}`,
+		},
+		{
+			name: "roles from groups",
+			mappings: []storage.ClaimMapping{
+				{Claim: "roles", Template: `{{ toJSON (stripPrefix "role:" .Claims.Groups) }}`, JSON: true},
+				{Claim: "admin", Template: `{{ contains .Claims.Groups "admins" }}`, JSON: true},
+				{Claim: "groups"},
+			},
+			want: `{"iss":"https://dex.example.com","exp":1700000000,"acr":"1","sub":"user-1","email":"jane@example.com","email_verified":true,"roles":["editor","viewer"],"admin":true}`,
+		},
+		{
+			name:     "invalid JSON",
+			mappings: []storage.ClaimMapping{{Claim: "roles", Template: "{{ .Claims.Email }}", JSON: true}},
+			wantErr:  true,
+		},
+		{
+			name:     "protected claim",
+			mappings: []storage.ClaimMapping{{Claim: "sub", Template: "admin"}},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := applyClaimMappings(tc.mappings, claims, data)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(payload))
+		})
+	}
+}
+
+func TestValidateClaimMappings(t *testing.T) {
+	require.NoError(t, ValidateClaimMappings(nil))
+	require.NoError(t, ValidateClaimMappings([]storage.ClaimMapping{{Claim: "typ"}, {Claim: "jti"}, {Claim: "team", Template: "{{ .Claims.Username }}"}}))
+	require.Error(t, ValidateClaimMappings([]storage.ClaimMapping{{Template: "value"}}))
+	require.Error(t, ValidateClaimMappings([]storage.ClaimMapping{{Claim: "aud", Template: "other"}}))
+	require.Error(t, ValidateClaimMappings([]storage.ClaimMapping{{Claim: "team", Template: "{{ .Claims.Username "}}))
+	require.Error(t, ValidateClaimMappings([]storage.ClaimMapping{{Claim: "team", Template: "{{ unknown }}"}}))
+}
+
+func TestClientClaimMappings(t *testing.T) {
+	ctx := t.Context()
+	// The subject differs from the user ID, which templates see.
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.PasswordConnector = "test"
+		c.SubjectFormat = subjectFormatConnector
+	})
+	defer httpServer.Close()
+
+	mockConnectorDataTestStorage(t, s.storage)
+	require.NoError(t, s.storage.UpdateClient(ctx, "test", func(old storage.Client) (storage.Client, error) {
+		old.ClaimMappings = []storage.ClaimMapping{
+			{Claim: "mail", Template: "{{ .Claims.Email }}"},
+			{Claim: "email"},
+			{Claim: "tenant", Template: "example"},
+			{Claim: "uid", Template: "{{ .Claims.UserID }}"},
+			{Claim: "acr"},
+			{Claim: "typ"},
+			{Claim: "jti"},
+		}
+		old.ClientCredentialsScopes = []string{"read"}
+		return old, nil
+	}))
+
+	post := func(path string, vals url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, httpServer.URL+path, strings.NewReader(vals.Encode()))
+		req.Header.Set("content-type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("test", "barfoo")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+	checkClaims := func(t *testing.T, claims map[string]any) {
+		t.Helper()
+		require.Equal(t, "example", claims["tenant"])
+		require.Equal(t, "0-385-28089-0", claims["uid"])
+		require.NotEmpty(t, claims["mail"])
+		require.NotContains(t, claims, "email")
+		require.NotContains(t, claims, "acr")
+		require.NotContains(t, claims, "typ")
+	}
+
+	rr := post("/token", url.Values{
+		"grant_type": {grantTypePassword},
+		"scope":      {"openid email"},
+		"username":   {"test"},
+		"password":   {"test"},
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var res accessTokenResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+
+	t.Run("id token", func(t *testing.T) {
+		verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: "test", Now: time.Now})
+		idToken, err := verifier.Verify(ctx, res.IDToken)
+		require.NoError(t, err)
+		var claims map[string]any
+		require.NoError(t, idToken.Claims(&claims))
+		checkClaims(t, claims)
+		require.NotEmpty(t, claims["at_hash"])
+		require.NotContains(t, claims, "jti")
+	})
+
+	t.Run("access token", func(t *testing.T) {
+		require.Equal(t, accessTokenType, jwtType(res.AccessToken))
+		verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{SkipClientIDCheck: true, Now: time.Now})
+		accessToken, err := verifier.Verify(ctx, res.AccessToken)
+		require.NoError(t, err)
+		var claims map[string]any
+		require.NoError(t, accessToken.Claims(&claims))
+		checkClaims(t, claims)
+		require.Equal(t, "test", claims["client_id"])
+		// RFC 9068 requires jti, so the mapping removing it is skipped.
+		require.NotEmpty(t, claims["jti"])
+	})
+
+	t.Run("userinfo", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, httpServer.URL+"/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer "+res.AccessToken)
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var claims map[string]any
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &claims))
+		checkClaims(t, claims)
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		rr := post("/token/introspect", url.Values{"token": {res.AccessToken}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var claims map[string]any
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &claims))
+		require.Equal(t, true, claims["active"])
+		require.Equal(t, "example", claims["tenant"])
+		require.Equal(t, "0-385-28089-0", claims["uid"])
+		require.NotEmpty(t, claims["mail"])
+		require.NotContains(t, claims["ext"], "email")
+	})
+
+	t.Run("client credentials", func(t *testing.T) {
+		rr := post("/token", url.Values{"grant_type": {grantTypeClientCredentials}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var res accessTokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+
+		verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{SkipClientIDCheck: true, Now: time.Now})
+		accessToken, err := verifier.Verify(ctx, res.AccessToken)
+		require.NoError(t, err)
+		var claims map[string]any
+		require.NoError(t, accessToken.Claims(&claims))
+		require.Equal(t, "example", claims["tenant"])
+		require.Equal(t, "test", claims["sub"])
+		require.Equal(t, "test", claims["uid"])
+	})
+}
+
+func TestMarshalWithExtraClaims(t *testing.T) {
+	claims := struct {
+		Subject string `json:"sub"`
+		Email   string `json:"email"`
+		Expiry  int64  `json:"exp"`
+	}{"user-1", "jane@example.com", 1700000000}
+	extra := map[string]any{
+		"department": "engineering",
+		"email":      "other@example.com",
+		"nonce":      "injected",
+	}
+
+	payload, err := marshalWithExtraClaims(claims, extra)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"sub":"user-1","email":"jane@example.com","exp":1700000000,"department":"engineering"}`, string(payload))
+}
+
+func TestExtraClaimsScope(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.ExtraClaimsScope = "attributes"
+	})
+	defer httpServer.Close()
+
+	mockConnectorDataTestStorage(t, s.storage)
+	require.Contains(t, s.constructDiscovery(ctx).Scopes, "attributes")
+
+	// The mock connector passes on the genre of its user when refreshing.
+	now := s.now()
+	for _, id := range []string{"with-scope", "without-scope"} {
+		scopes := []string{"openid", "email", "offline_access"}
+		if id == "with-scope" {
+			scopes = append(scopes, "attributes")
+		}
+		require.NoError(t, s.storage.CreateRefresh(ctx, storage.RefreshToken{
+			ID:          id,
+			Token:       "secret",
+			ClientID:    "test",
+			ConnectorID: "mock",
+			Scopes:      scopes,
+			Claims: storage.Claims{
+				UserID:      id,
+				Email:       "kilgore@kilgore.trout",
+				ExtraClaims: map[string]any{"genre": "satire"},
+			},
+			CreatedAt: now,
+			LastUsed:  now,
+		}))
+		require.NoError(t, s.storage.CreateOfflineSessions(ctx, storage.OfflineSessions{
+			UserID:  id,
+			ConnID:  "mock",
+			Refresh: map[string]*storage.RefreshTokenRef{"test": {ID: id, ClientID: "test"}},
+		}))
+	}
+
+	post := func(path string, vals url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, httpServer.URL+path, strings.NewReader(vals.Encode()))
+		req.Header.Set("content-type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("test", "barfoo")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+	refresh := func(t *testing.T, id string) accessTokenResponse {
+		refreshToken, err := internal.Marshal(&internal.RefreshToken{RefreshId: id, Token: "secret"})
+		require.NoError(t, err)
+		rr := post("/token", url.Values{
+			"grant_type":    {grantTypeRefreshToken},
+			"refresh_token": {refreshToken},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var res accessTokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		return res
+	}
+	idTokenClaims := func(t *testing.T, rawIDToken string) map[string]any {
+		verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: "test", Now: time.Now})
+		idToken, err := verifier.Verify(ctx, rawIDToken)
+		require.NoError(t, err)
+		var claims map[string]any
+		require.NoError(t, idToken.Claims(&claims))
+		return claims
+	}
+	userInfo := func(t *testing.T, accessToken string) string {
+		req := httptest.NewRequest(http.MethodGet, httpServer.URL+"/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		return rr.Body.String()
+	}
+
+	t.Run("released", func(t *testing.T) {
+		res := refresh(t, "with-scope")
+		require.Equal(t, "science fiction", idTokenClaims(t, res.IDToken)["genre"])
+
+		stored, err := s.storage.GetRefresh(ctx, "with-scope")
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"genre": "science fiction"}, stored.Claims.ExtraClaims)
+		session, err := s.storage.GetOfflineSessions(ctx, "with-scope", "mock")
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"genre": "science fiction"}, session.ExtraClaims)
+
+		require.Contains(t, userInfo(t, res.AccessToken), `"genre":"science fiction"`)
+
+		rr := post("/token/introspect", url.Values{"token": {res.AccessToken}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), `"genre":"science fiction"`)
+	})
+
+	t.Run("not requested", func(t *testing.T) {
+		res := refresh(t, "without-scope")
+		require.NotContains(t, idTokenClaims(t, res.IDToken), "genre")
+		require.NotContains(t, userInfo(t, res.AccessToken), "genre")
+	})
+}
@@ -0,0 +1,339 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// clientAssertionTypeJWTBearer is the client_assertion_type of JWT client
+// assertions (RFC 7523 section 2.2).
+const clientAssertionTypeJWTBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// Token endpoint authentication methods, as registered for the
+// token_endpoint_auth_method client metadata.
+const (
+	authMethodClientSecretBasic = "client_secret_basic"
+	authMethodClientSecretPost  = "client_secret_post"
+	authMethodClientSecretJWT   = "client_secret_jwt"
+	authMethodPrivateKeyJWT     = "private_key_jwt"
+	authMethodNone              = "none"
+
+	// Mutual TLS client authentication (RFC 8705 section 2).
+	authMethodTLSClientAuth           = "tls_client_auth"
+	authMethodSelfSignedTLSClientAuth = "self_signed_tls_client_auth"
+)
+
+// clientSecretJWTSigningAlgs are the algorithms of client_secret_jwt assertions.
+// private_key_jwt assertions use requestObjectSigningAlgs.
+var clientSecretJWTSigningAlgs = []jose.SignatureAlgorithm{jose.HS256, jose.HS384, jose.HS512}
+
+// maxClientAssertionLifetime bounds the expiry of client assertions. Their jti
+// is kept in storage until they expire.
+const maxClientAssertionLifetime = time.Hour
+
+// ValidateClientAuthMethod checks that a client has the credentials its token
+// endpoint authentication method needs.
+func ValidateClientAuthMethod(c storage.Client) error {
+	switch c.TokenEndpointAuthMethod {
+	case "":
+	case authMethodClientSecretBasic, authMethodClientSecretPost, authMethodClientSecretJWT:
+		if c.Public {
+			return fmt.Errorf("public clients cannot use %q", c.TokenEndpointAuthMethod)
+		}
+	case authMethodPrivateKeyJWT:
+		if c.Public {
+			return fmt.Errorf("public clients cannot use %q", c.TokenEndpointAuthMethod)
+		}
+		if c.JWKS == "" && c.JWKSURI == "" {
+			return fmt.Errorf("%q requires jwks or jwksURI", c.TokenEndpointAuthMethod)
+		}
+	case authMethodTLSClientAuth:
+		if c.Public {
+			return fmt.Errorf("public clients cannot use %q", c.TokenEndpointAuthMethod)
+		}
+		if c.TLSClientAuthSubjectDN == "" {
+			return fmt.Errorf("%q requires tlsClientAuthSubjectDN", c.TokenEndpointAuthMethod)
+		}
+	case authMethodSelfSignedTLSClientAuth:
+		if c.Public {
+			return fmt.Errorf("public clients cannot use %q", c.TokenEndpointAuthMethod)
+		}
+		// The certificate is matched against the registered keys, which must
+		// be known up front.
+		if c.JWKS == "" {
+			return fmt.Errorf("%q requires jwks", c.TokenEndpointAuthMethod)
+		}
+	case authMethodNone:
+		if !c.Public {
+			return fmt.Errorf("%q requires a public client", c.TokenEndpointAuthMethod)
+		}
+	default:
+		return fmt.Errorf("unknown token endpoint auth method %q", c.TokenEndpointAuthMethod)
+	}
+	return nil
+}
+
+// clientSecretAllowed reports whether the client can authenticate with its
+// secret, sent with HTTP basic auth or in the request body.
+func clientSecretAllowed(client storage.Client, basicAuth bool) bool {
+	switch client.TokenEndpointAuthMethod {
+	case "", authMethodNone:
+		return true
+	case authMethodClientSecretBasic:
+		return basicAuth
+	case authMethodClientSecretPost:
+		return !basicAuth
+	}
+	return false
+}
+
+// tlsClientAuthMethod reports whether method authenticates the client with the
+// certificate of the TLS connection.
+func tlsClientAuthMethod(method string) bool {
+	return method == authMethodTLSClientAuth || method == authMethodSelfSignedTLSClientAuth
+}
+
+// withClientFromCertificate authenticates a client with the certificate it
+// presented on the TLS connection, as described in
+// [IETF RFC 8705](https://tools.ietf.org/html/rfc8705). The thumbprint of the
+// certificate is added to the request context, binding the access tokens issued
+// to the client to the certificate.
+func (s *Server) withClientFromCertificate(w http.ResponseWriter, r *http.Request, client storage.Client, clientSecret string, handler func(http.ResponseWriter, *http.Request, storage.Client)) {
+	ctx := r.Context()
+	if clientSecret != "" {
+		s.tokenErrHelper(w, errInvalidRequest, "Multiple client authentication methods.", http.StatusBadRequest)
+		return
+	}
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		s.logger.InfoContext(ctx, "missing client certificate on token request", "client_id", client.ID)
+		s.tokenErrHelper(w, errInvalidClient, "Invalid client credentials.", http.StatusUnauthorized)
+		return
+	}
+	if err := s.verifyClientCertificate(client, r.TLS.PeerCertificates); err != nil {
+		s.logger.InfoContext(ctx, "invalid client certificate on token request", "client_id", client.ID, "err", err)
+		s.tokenErrHelper(w, errInvalidClient, "Invalid client credentials.", http.StatusUnauthorized)
+		return
+	}
+
+	ctx = context.WithValue(ctx, certificateThumbprintKey{}, certificateThumbprint(r.TLS.PeerCertificates[0]))
+	handler(w, r.WithContext(ctx), client)
+}
+
+// verifyClientCertificate checks the certificate chain presented by a client.
+// "tls_client_auth" clients need a certificate issued by one of the configured
+// CAs for their subject DN, "self_signed_tls_client_auth" clients a certificate
+// for one of their registered keys.
+func (s *Server) verifyClientCertificate(client storage.Client, chain []*x509.Certificate) error {
+	cert := chain[0]
+	if client.TokenEndpointAuthMethod == authMethodSelfSignedTLSClientAuth {
+		var jwks jose.JSONWebKeySet
+		if err := json.Unmarshal([]byte(client.JWKS), &jwks); err != nil {
+			return fmt.Errorf("invalid JSON Web Key Set: %v", err)
+		}
+		for _, key := range jwks.Keys {
+			if k, ok := key.Key.(interface{ Equal(crypto.PublicKey) bool }); ok && k.Equal(cert.PublicKey) {
+				return nil
+			}
+		}
+		return errors.New("certificate does not match a key of the client")
+	}
+
+	if s.tlsClientCAs == nil {
+		return errors.New("no client certificate authorities configured")
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range chain[1:] {
+		intermediates.AddCert(c)
+	}
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:         s.tlsClientCAs,
+		Intermediates: intermediates,
+		CurrentTime:   s.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return err
+	}
+	if subject := cert.Subject.String(); subject != client.TLSClientAuthSubjectDN {
+		return fmt.Errorf("unexpected subject %q", subject)
+	}
+	return nil
+}
+
+// certificateThumbprintKey is the request context key of the x5t#S256
+// thumbprint of the certificate a client authenticated with.
+type certificateThumbprintKey struct{}
+
+// certificateThumbprint returns the x5t#S256 thumbprint of cert (RFC 8705
+// section 3.1).
+func certificateThumbprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// certificateThumbprintFromContext returns the thumbprint of the certificate
+// the client authenticated with, if it used mutual TLS.
+func certificateThumbprintFromContext(ctx context.Context) string {
+	thumbprint, _ := ctx.Value(certificateThumbprintKey{}).(string)
+	return thumbprint
+}
+
+// presentsCertificate reports whether the request was made over a TLS
+// connection authenticated with the certificate of the given thumbprint.
+func presentsCertificate(r *http.Request, thumbprint string) bool {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return false
+	}
+	presented := certificateThumbprint(r.TLS.PeerCertificates[0])
+	return subtle.ConstantTimeCompare([]byte(presented), []byte(thumbprint)) == 1
+}
+
+// withClientFromAssertion authenticates a client with a JWT assertion, as
+// described in [IETF RFC 7523](https://tools.ietf.org/html/rfc7523). Assertions
+// signed with a key of the client are "private_key_jwt" and assertions signed
+// with an HMAC of the client secret are "client_secret_jwt".
+//
+// Each assertion can only be used once: its jti is kept in storage until it
+// expires.
+func (s *Server) withClientFromAssertion(w http.ResponseWriter, r *http.Request, handler func(http.ResponseWriter, *http.Request, storage.Client)) {
+	ctx := r.Context()
+	if _, _, ok := r.BasicAuth(); ok {
+		s.tokenErrHelper(w, errInvalidRequest, "Multiple client authentication methods.", http.StatusBadRequest)
+		return
+	}
+	if r.PostFormValue("client_assertion_type") != clientAssertionTypeJWTBearer {
+		s.tokenErrHelper(w, errInvalidRequest, "Unsupported client_assertion_type.", http.StatusBadRequest)
+		return
+	}
+	assertion := r.PostFormValue("client_assertion")
+
+	invalid := func(description string, args ...any) {
+		s.logger.InfoContext(ctx, "invalid client assertion", append([]any{"reason", description}, args...)...)
+		s.tokenErrHelper(w, errInvalidClient, "Invalid client credentials.", http.StatusUnauthorized)
+	}
+
+	jws, err := jose.ParseSigned(assertion, slices.Concat(requestObjectSigningAlgs, clientSecretJWTSigningAlgs))
+	if err != nil {
+		invalid("malformed assertion", "err", err)
+		return
+	}
+
+	// The claims are verified once the client and its keys are known.
+	var claims struct {
+		Issuer    string   `json:"iss"`
+		Subject   string   `json:"sub"`
+		Audience  audience `json:"aud"`
+		Expiry    *int64   `json:"exp"`
+		NotBefore *int64   `json:"nbf"`
+		JTI       string   `json:"jti"`
+	}
+	if err := json.Unmarshal(jws.UnsafePayloadWithoutVerification(), &claims); err != nil {
+		invalid("malformed claims", "err", err)
+		return
+	}
+	if claims.Subject == "" || claims.Issuer != claims.Subject {
+		invalid("iss and sub must be the client_id")
+		return
+	}
+	if clientID := r.PostFormValue("client_id"); clientID != "" && clientID != claims.Subject {
+		invalid("client_id does not match the assertion", "client_id", clientID)
+		return
+	}
+
+	client, err := s.storage.GetClient(ctx, claims.Subject)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get client", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		} else {
+			invalid("unknown client", "client_id", claims.Subject)
+		}
+		return
+	}
+
+	method := authMethodPrivateKeyJWT
+	if slices.Contains(clientSecretJWTSigningAlgs, jose.SignatureAlgorithm(jws.Signatures[0].Header.Algorithm)) {
+		method = authMethodClientSecretJWT
+	}
+	if client.TokenEndpointAuthMethod != "" && client.TokenEndpointAuthMethod != method {
+		invalid("authentication method not allowed for the client", "client_id", client.ID, "method", method)
+		return
+	}
+	if err := s.verifyClientAssertionSignature(r, client, method, jws, assertion); err != nil {
+		invalid("invalid signature", "client_id", client.ID, "err", err)
+		return
+	}
+
+	endpoint := s.issuerURL
+	endpoint.Path = r.URL.Path
+	if !claims.Audience.contains(s.issuerURL.String()) && !claims.Audience.contains(s.absURL("/token")) &&
+		!claims.Audience.contains(endpoint.String()) {
+		invalid("assertion is for another audience", "client_id", client.ID)
+		return
+	}
+	now := s.now()
+	if claims.Expiry == nil || now.Unix() >= *claims.Expiry {
+		invalid("assertion has expired", "client_id", client.ID)
+		return
+	}
+	expiry := time.Unix(*claims.Expiry, 0)
+	if expiry.After(now.Add(maxClientAssertionLifetime)) {
+		invalid("assertion expires too late", "client_id", client.ID)
+		return
+	}
+	if claims.NotBefore != nil && now.Unix() < *claims.NotBefore {
+		invalid("assertion is not valid yet", "client_id", client.ID)
+		return
+	}
+	if claims.JTI == "" {
+		invalid("assertion has no jti", "client_id", client.ID)
+		return
+	}
+
+	err = s.storage.CreateClientAssertion(ctx, storage.ClientAssertion{
+		ID:       hashToken(client.ID + ":" + claims.JTI),
+		ClientID: client.ID,
+		Expiry:   expiry,
+	})
+	if err != nil {
+		if err == storage.ErrAlreadyExists {
+			invalid("assertion has already been used", "client_id", client.ID)
+			return
+		}
+		s.logger.ErrorContext(ctx, "failed to store client assertion", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	handler(w, r, client)
+}
+
+func (s *Server) verifyClientAssertionSignature(r *http.Request, client storage.Client, method string, jws *jose.JSONWebSignature, assertion string) error {
+	if method == authMethodClientSecretJWT {
+		if client.Secret == "" {
+			return errors.New("client has no secret")
+		}
+		_, err := jws.Verify([]byte(client.Secret))
+		return err
+	}
+	keySet, err := s.clientKeySet(client)
+	if err != nil {
+		return err
+	}
+	_, err = keySet.VerifySignature(r.Context(), assertion)
+	return err
+}
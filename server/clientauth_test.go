@@ -0,0 +1,437 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestClientAssertion(t *testing.T) {
+	const secret = "a-client-secret-that-is-long-enough-for-hs256"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: key.Public(), KeyID: "client-key", Algorithm: string(jose.ES256), Use: "sig"},
+	}})
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	sign := func(t *testing.T, alg jose.SignatureAlgorithm, key any, claims map[string]any) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, nil)
+		require.NoError(t, err)
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+		jws, err := signer.Sign(payload)
+		require.NoError(t, err)
+		token, err := jws.CompactSerialize()
+		require.NoError(t, err)
+		return token
+	}
+
+	tests := []struct {
+		name     string
+		clientID string
+		alg      jose.SignatureAlgorithm
+		key      any
+		// Claims overriding the defaults. A nil value removes the claim.
+		claims   map[string]any
+		basic    bool
+		wantCode int
+	}{
+		{
+			name:     "private_key_jwt",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "private_key_jwt with the token endpoint as audience",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			claims:   map[string]any{"aud": "TOKEN_ENDPOINT"},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "client_secret_jwt",
+			clientID: "secret",
+			alg:      jose.HS256,
+			key:      []byte(secret),
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "signed by another key",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      otherKey,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "client_secret_jwt with a wrong secret",
+			clientID: "secret",
+			alg:      jose.HS256,
+			key:      []byte("another-client-secret-that-is-long-enough"),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "client_secret_jwt for a private_key_jwt client",
+			clientID: "keys",
+			alg:      jose.HS256,
+			key:      []byte(secret),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "private_key_jwt for a client_secret_jwt client",
+			clientID: "secret",
+			alg:      jose.ES256,
+			key:      key,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "issuer is not the client",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			claims:   map[string]any{"iss": "secret"},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "another audience",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			claims:   map[string]any{"aud": "https://other.example.com"},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "expired",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			claims:   map[string]any{"exp": time.Now().Add(-time.Minute).Unix()},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "expiring too late",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			claims:   map[string]any{"exp": time.Now().Add(24 * time.Hour).Unix()},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "without jti",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			claims:   map[string]any{"jti": nil},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "along with basic auth",
+			clientID: "keys",
+			alg:      jose.ES256,
+			key:      key,
+			basic:    true,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			httpServer, s := newTestServer(t, nil)
+			defer httpServer.Close()
+
+			require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+				ID:                      "keys",
+				JWKS:                    string(jwks),
+				TokenEndpointAuthMethod: authMethodPrivateKeyJWT,
+				ClientCredentialsScopes: []string{"orders:read"},
+			}))
+			require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+				ID:                      "secret",
+				Secret:                  secret,
+				TokenEndpointAuthMethod: authMethodClientSecretJWT,
+				ClientCredentialsScopes: []string{"orders:read"},
+			}))
+
+			claims := map[string]any{
+				"iss": tc.clientID,
+				"sub": tc.clientID,
+				"aud": httpServer.URL,
+				"exp": time.Now().Add(time.Minute).Unix(),
+				"jti": storage.NewID(),
+			}
+			for k, v := range tc.claims {
+				if v == "TOKEN_ENDPOINT" {
+					v = httpServer.URL + "/token"
+				}
+				if v == nil {
+					delete(claims, k)
+				} else {
+					claims[k] = v
+				}
+			}
+			vals := url.Values{
+				"grant_type":            {grantTypeClientCredentials},
+				"scope":                 {"orders:read"},
+				"client_assertion_type": {clientAssertionTypeJWTBearer},
+				"client_assertion":      {sign(t, tc.alg, tc.key, claims)},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/token", strings.NewReader(vals.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tc.basic {
+				req.SetBasicAuth(tc.clientID, "")
+			}
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			require.Equal(t, tc.wantCode, rr.Code, rr.Body.String())
+			if tc.wantCode == http.StatusUnauthorized {
+				require.Contains(t, rr.Body.String(), `"error":"`+errInvalidClient+`"`)
+			}
+			if tc.wantCode != http.StatusOK {
+				return
+			}
+
+			// The same assertion cannot be used twice.
+			req = httptest.NewRequest(http.MethodPost, "/token", strings.NewReader(vals.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr = httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		})
+	}
+}
+
+func TestClientSecretAuthMethod(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		basic  bool
+		want   int
+	}{
+		{name: "any method with basic auth", basic: true, want: http.StatusOK},
+		{name: "any method with post", want: http.StatusOK},
+		{name: "client_secret_basic with basic auth", method: authMethodClientSecretBasic, basic: true, want: http.StatusOK},
+		{name: "client_secret_basic with post", method: authMethodClientSecretBasic, want: http.StatusUnauthorized},
+		{name: "client_secret_post with basic auth", method: authMethodClientSecretPost, basic: true, want: http.StatusUnauthorized},
+		{name: "client_secret_post with post", method: authMethodClientSecretPost, want: http.StatusOK},
+		{name: "client_secret_jwt with basic auth", method: authMethodClientSecretJWT, basic: true, want: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, nil)
+			defer httpServer.Close()
+
+			require.NoError(t, s.storage.CreateClient(t.Context(), storage.Client{
+				ID:                      "service",
+				Secret:                  "secret",
+				TokenEndpointAuthMethod: tc.method,
+				ClientCredentialsScopes: []string{"orders:read"},
+			}))
+
+			vals := url.Values{"grant_type": {grantTypeClientCredentials}, "scope": {"orders:read"}}
+			if !tc.basic {
+				vals.Set("client_id", "service")
+				vals.Set("client_secret", "secret")
+			}
+			req := httptest.NewRequest(http.MethodPost, "/token", strings.NewReader(vals.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tc.basic {
+				req.SetBasicAuth("service", "secret")
+			}
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			require.Equal(t, tc.want, rr.Code, rr.Body.String())
+		})
+	}
+}
+
+func TestValidateClientAuthMethod(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  storage.Client
+		wantErr bool
+	}{
+		{name: "default", client: storage.Client{Secret: "secret"}},
+		{name: "client_secret_jwt", client: storage.Client{Secret: "secret", TokenEndpointAuthMethod: authMethodClientSecretJWT}},
+		{name: "private_key_jwt", client: storage.Client{JWKSURI: "https://client.example.com/jwks", TokenEndpointAuthMethod: authMethodPrivateKeyJWT}},
+		{name: "private_key_jwt without keys", client: storage.Client{TokenEndpointAuthMethod: authMethodPrivateKeyJWT}, wantErr: true},
+		{name: "none", client: storage.Client{Public: true, TokenEndpointAuthMethod: authMethodNone}},
+		{name: "none for a confidential client", client: storage.Client{Secret: "secret", TokenEndpointAuthMethod: authMethodNone}, wantErr: true},
+		{name: "secret for a public client", client: storage.Client{Public: true, TokenEndpointAuthMethod: authMethodClientSecretBasic}, wantErr: true},
+		{name: "tls_client_auth", client: storage.Client{TLSClientAuthSubjectDN: "CN=client", TokenEndpointAuthMethod: authMethodTLSClientAuth}},
+		{name: "tls_client_auth without subject", client: storage.Client{TokenEndpointAuthMethod: authMethodTLSClientAuth}, wantErr: true},
+		{name: "self_signed_tls_client_auth", client: storage.Client{JWKS: `{"keys":[]}`, TokenEndpointAuthMethod: authMethodSelfSignedTLSClientAuth}},
+		{name: "self_signed_tls_client_auth with jwksURI", client: storage.Client{JWKSURI: "https://client.example.com/jwks", TokenEndpointAuthMethod: authMethodSelfSignedTLSClientAuth}, wantErr: true},
+		{name: "unknown", client: storage.Client{TokenEndpointAuthMethod: "urn:example:unknown"}, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateClientAuthMethod(tc.client)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClientCertificateAuth(t *testing.T) {
+	newCert := func(t *testing.T, subject pkix.Name, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) *x509.Certificate {
+		tmpl := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               subject,
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			BasicConstraintsValid: true,
+			IsCA:                  isCA,
+		}
+		if isCA {
+			tmpl.KeyUsage = x509.KeyUsageCertSign
+		}
+		if parent == nil {
+			parent, parentKey = tmpl, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, key.Public(), parentKey)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		return cert
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := newCert(t, pkix.Name{CommonName: "Example CA"}, caKey, nil, nil, true)
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pkiCert := newCert(t, pkix.Name{CommonName: "pki", Organization: []string{"Example"}}, clientKey, ca, caKey, false)
+	otherSubjectCert := newCert(t, pkix.Name{CommonName: "other", Organization: []string{"Example"}}, clientKey, ca, caKey, false)
+	selfSignedCert := newCert(t, pkix.Name{CommonName: "self-signed"}, clientKey, nil, nil, false)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherSelfSignedCert := newCert(t, pkix.Name{CommonName: "self-signed"}, otherKey, nil, nil, false)
+
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: clientKey.Public(), KeyID: "client-key", Algorithm: string(jose.ES256), Use: "sig"},
+	}})
+	require.NoError(t, err)
+	cas := x509.NewCertPool()
+	cas.AddCert(ca)
+
+	tests := []struct {
+		name     string
+		clientID string
+		cert     *x509.Certificate
+		secret   string
+		wantCode int
+	}{
+		{name: "tls_client_auth", clientID: "pki", cert: pkiCert, wantCode: http.StatusOK},
+		{name: "tls_client_auth with another subject", clientID: "pki", cert: otherSubjectCert, wantCode: http.StatusUnauthorized},
+		{name: "tls_client_auth with a self-signed certificate", clientID: "pki", cert: selfSignedCert, wantCode: http.StatusUnauthorized},
+		{name: "tls_client_auth without certificate", clientID: "pki", wantCode: http.StatusUnauthorized},
+		{name: "tls_client_auth with a secret", clientID: "pki", cert: pkiCert, secret: "secret", wantCode: http.StatusBadRequest},
+		{name: "self_signed_tls_client_auth", clientID: "self-signed", cert: selfSignedCert, wantCode: http.StatusOK},
+		{name: "self_signed_tls_client_auth with a CA issued certificate", clientID: "self-signed", cert: pkiCert, wantCode: http.StatusOK},
+		{name: "self_signed_tls_client_auth with another key", clientID: "self-signed", cert: otherSelfSignedCert, wantCode: http.StatusUnauthorized},
+		{name: "self_signed_tls_client_auth with opaque access tokens", clientID: "opaque", cert: selfSignedCert, wantCode: http.StatusOK},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.TLSClientAuth = true
+				c.TLSClientCAs = cas
+			})
+			defer httpServer.Close()
+
+			for _, client := range []storage.Client{
+				{ID: "pki", TokenEndpointAuthMethod: authMethodTLSClientAuth, TLSClientAuthSubjectDN: "CN=pki,O=Example"},
+				{ID: "self-signed", TokenEndpointAuthMethod: authMethodSelfSignedTLSClientAuth, JWKS: string(jwks)},
+				{ID: "opaque", TokenEndpointAuthMethod: authMethodSelfSignedTLSClientAuth, JWKS: string(jwks), AccessTokenFormat: accessTokenFormatOpaque},
+			} {
+				client.ClientCredentialsScopes = []string{"orders:read"}
+				require.NoError(t, s.storage.CreateClient(ctx, client))
+			}
+
+			withCert := func(req *http.Request, cert *x509.Certificate) *http.Request {
+				if cert != nil {
+					req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+				}
+				return req
+			}
+
+			vals := url.Values{
+				"grant_type": {grantTypeClientCredentials},
+				"scope":      {"orders:read"},
+				"client_id":  {tc.clientID},
+			}
+			if tc.secret != "" {
+				vals.Set("client_secret", tc.secret)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/token", strings.NewReader(vals.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, withCert(req, tc.cert))
+			require.Equal(t, tc.wantCode, rr.Code, rr.Body.String())
+			if tc.wantCode != http.StatusOK {
+				return
+			}
+
+			var resp struct {
+				AccessToken string `json:"access_token"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			thumbprint := certificateThumbprint(tc.cert)
+
+			req = httptest.NewRequest(http.MethodPost, "/token/introspect", strings.NewReader(url.Values{"token": {resp.AccessToken}}.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr = httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			var introspection Introspection
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &introspection))
+			require.True(t, introspection.Active)
+			require.NotNil(t, introspection.Confirmation)
+			require.Equal(t, thumbprint, introspection.Confirmation.X5TS256)
+
+			// The token is only accepted with the certificate it is bound to.
+			for _, cert := range []*x509.Certificate{nil, otherSelfSignedCert, tc.cert} {
+				req = httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+				req.Header.Set("Authorization", "Bearer "+resp.AccessToken)
+				rr = httptest.NewRecorder()
+				s.ServeHTTP(rr, withCert(req, cert))
+				if cert == tc.cert {
+					require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+				} else {
+					require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+				}
+			}
+		})
+	}
+}
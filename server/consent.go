@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// hasConsent reports whether the user has already granted the client every
+// scope in scopes. Failing to look the consent up counts as no consent, so the
+// user is asked again.
+func (s *Server) hasConsent(ctx context.Context, userID, connID, clientID string, scopes []string) bool {
+	consent, err := s.storage.GetConsent(ctx, userID, connID, clientID)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			s.logger.ErrorContext(ctx, "failed to get consent", "client_id", clientID, "err", err)
+		}
+		return false
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(consent.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordConsent stores that the user of authReq approved its scopes, adding
+// them to those granted to the client before.
+func (s *Server) recordConsent(ctx context.Context, authReq storage.AuthRequest) error {
+	now := s.now()
+	consent := storage.Consent{
+		UserID:      authReq.Claims.UserID,
+		ConnectorID: authReq.ConnectorID,
+		ClientID:    authReq.ClientID,
+		Scopes:      authReq.Scopes,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	err := s.storage.CreateConsent(ctx, consent)
+	if !errors.Is(err, storage.ErrAlreadyExists) {
+		return err
+	}
+	return s.storage.UpdateConsent(ctx, consent.UserID, consent.ConnectorID, consent.ClientID, func(old storage.Consent) (storage.Consent, error) {
+		for _, scope := range authReq.Scopes {
+			if !slices.Contains(old.Scopes, scope) {
+				old.Scopes = append(old.Scopes, scope)
+			}
+		}
+		old.UpdatedAt = now
+		return old, nil
+	})
+}
+
+// approvalScopes lists the scopes of an auth request the approval screen
+// shows, with their descriptions in the first of langs available. Scopes
+// without a description, such as "openid", are always granted and not shown.
+func (s *Server) approvalScopes(ctx context.Context, client storage.Client, scopes, langs []string) ([]approvalScope, error) {
+	var approval []approvalScope
+	for _, scope := range scopes {
+		description, ok := scopeDescriptions[scope]
+		if !ok {
+			sc, err := s.customScope(ctx, scope)
+			if err != nil {
+				return nil, err
+			}
+			if sc == nil {
+				continue
+			}
+			description = scopeDescription(*sc, langs)
+		}
+		approval = append(approval, approvalScope{
+			Name:        scope,
+			Description: description,
+			Required:    slices.Contains(client.RequiredScopes, scope),
+		})
+	}
+	return approval, nil
+}
+
+// grantedScopes narrows the requested scopes to those the user selected on the
+// approval screen, which showed the scopes in shown. Scopes the screen doesn't
+// show or doesn't let the user deselect are kept.
+func grantedScopes(shown []approvalScope, requested, selected []string) []string {
+	var granted []string
+	for _, scope := range requested {
+		optional := slices.ContainsFunc(shown, func(a approvalScope) bool {
+			return a.Name == scope && !a.Required
+		})
+		if !optional || slices.Contains(selected, scope) {
+			granted = append(granted, scope)
+		}
+	}
+	return granted
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"crypto"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestConsentSkipsApproval(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.SkipApprovalScreen = false
+		c.Now = time.Now
+	})
+	defer httpServer.Close()
+
+	// login runs the callback of the mock connector for an auth request and
+	// returns where the user is sent next.
+	login := func(id string, scopes []string, force bool) *url.URL {
+		authReq := storage.AuthRequest{
+			ID:                  id,
+			ClientID:            "test",
+			ConnectorID:         "mock",
+			RedirectURI:         "https://client.example.com/cb",
+			Expiry:              time.Now().Add(time.Minute),
+			ResponseTypes:       []string{responseTypeCode},
+			Scopes:              scopes,
+			ForceApprovalPrompt: force,
+			HMACKey:             storage.NewHMACKey(crypto.SHA256),
+		}
+		require.NoError(t, s.storage.CreateAuthRequest(ctx, authReq))
+
+		rr := httptest.NewRecorder()
+		s.handleConnectorCallback(rr, httptest.NewRequest(http.MethodGet, "/callback/mock?state="+id, nil))
+		require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+		location, err := url.Parse(rr.Header().Get("Location"))
+		require.NoError(t, err)
+		return location
+	}
+
+	location := login("first", []string{"openid", "email"}, false)
+	require.Equal(t, "/approval", location.Path)
+
+	form := location.Query()
+	form.Set("approval", "approve")
+	req := httptest.NewRequest(http.MethodPost, "/approval", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	s.handleApproval(rr, req)
+	require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+
+	consent, err := s.storage.GetConsent(ctx, "0-385-28089-0", "mock", "test")
+	require.NoError(t, err)
+	require.Equal(t, []string{"openid", "email"}, consent.Scopes)
+
+	// Scopes granted before are not asked for again.
+	location = login("second", []string{"openid"}, false)
+	require.Equal(t, "client.example.com", location.Host)
+	require.NotEmpty(t, location.Query().Get("code"))
+
+	// New scopes are.
+	location = login("third", []string{"openid", "groups"}, false)
+	require.Equal(t, "/approval", location.Path)
+
+	// prompt=consent asks regardless.
+	location = login("fourth", []string{"openid"}, true)
+	require.Equal(t, "/approval", location.Path)
+}
+
+func TestRecordConsentMergesScopes(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	authReq := storage.AuthRequest{
+		ClientID:    "test",
+		ConnectorID: "mock",
+		Scopes:      []string{"openid", "email"},
+		Claims:      storage.Claims{UserID: "user"},
+	}
+	require.NoError(t, s.recordConsent(ctx, authReq))
+	created, err := s.storage.GetConsent(ctx, "user", "mock", "test")
+	require.NoError(t, err)
+
+	authReq.Scopes = []string{"openid", "groups"}
+	require.NoError(t, s.recordConsent(ctx, authReq))
+	consent, err := s.storage.GetConsent(ctx, "user", "mock", "test")
+	require.NoError(t, err)
+	require.Equal(t, []string{"openid", "email", "groups"}, consent.Scopes)
+	require.True(t, created.CreatedAt.Equal(consent.CreatedAt))
+}
+
+func TestGrantedScopes(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	require.NoError(t, s.storage.CreateScope(ctx, storage.Scope{
+		Name:         "orders",
+		Descriptions: map[string]string{"en": "View your orders"},
+	}))
+	client := storage.Client{RequiredScopes: []string{"groups"}}
+	requested := []string{"openid", "email", "profile", "groups", "offline_access", "orders", "audience:server:client_id:peer"}
+	shown, err := s.approvalScopes(ctx, client, requested, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		selected []string
+		want     []string
+	}{
+		{"all selected", []string{"email", "profile", "groups", "offline_access", "orders"}, requested},
+		{"none selected", nil, []string{"openid", "groups", "audience:server:client_id:peer"}},
+		{"some selected", []string{"email", "orders"}, []string{"openid", "email", "groups", "orders", "audience:server:client_id:peer"}},
+		{"unrequested scopes ignored", []string{"email", "federated:id"}, []string{"openid", "email", "groups", "audience:server:client_id:peer"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, grantedScopes(shown, requested, tc.selected))
+		})
+	}
+}
+
+func TestApprovalScopeSelection(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.SkipApprovalScreen = false
+		c.Now = time.Now
+	})
+	defer httpServer.Close()
+
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:             "scoped",
+		RedirectURIs:   []string{"https://client.example.com/cb"},
+		RequiredScopes: []string{"groups"},
+	}))
+	authReq := storage.AuthRequest{
+		ID:            "test",
+		ClientID:      "scoped",
+		ConnectorID:   "mock",
+		RedirectURI:   "https://client.example.com/cb",
+		Expiry:        time.Now().Add(time.Minute),
+		ResponseTypes: []string{responseTypeCode},
+		Scopes:        []string{"openid", "email", "groups", "offline_access"},
+		HMACKey:       storage.NewHMACKey(crypto.SHA256),
+	}
+	require.NoError(t, s.storage.CreateAuthRequest(ctx, authReq))
+
+	rr := httptest.NewRecorder()
+	s.handleConnectorCallback(rr, httptest.NewRequest(http.MethodGet, "/callback/mock?state="+authReq.ID, nil))
+	location, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "/approval", location.Path)
+
+	rr = httptest.NewRecorder()
+	s.handleApproval(rr, httptest.NewRequest(http.MethodGet, location.String(), nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	require.Contains(t, body, `name="scope" value="email"`)
+	require.Contains(t, body, `name="scope" value="offline_access"`)
+	require.NotContains(t, body, `name="scope" value="groups"`)
+
+	form := location.Query()
+	form.Set("approval", "approve")
+	form.Set("scope_selection", "true")
+	form.Add("scope", "email")
+	req := httptest.NewRequest(http.MethodPost, "/approval", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr = httptest.NewRecorder()
+	s.handleApproval(rr, req)
+	require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+
+	location, err = url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	code, err := s.storage.GetAuthCode(ctx, location.Query().Get("code"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"openid", "email", "groups"}, code.Scopes)
+
+	consent, err := s.storage.GetConsent(ctx, "0-385-28089-0", "mock", "scoped")
+	require.NoError(t, err)
+	require.Equal(t, []string{"openid", "email", "groups"}, consent.Scopes)
+}
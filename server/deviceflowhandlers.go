@@ -83,6 +83,24 @@ func (s *Server) handleDeviceCode(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Unknown clients are turned away once the user enters the code.
+		client, err := s.storage.GetClient(ctx, clientID)
+		switch {
+		case err == nil:
+			if !grantTypeAllowed(client, grantTypeDeviceCode) {
+				s.tokenErrHelper(w, errUnauthorizedClient, "The client is not allowed to use the device code grant.", http.StatusBadRequest)
+				return
+			}
+			if client.RequirePKCE && codeChallenge == "" {
+				s.tokenErrHelper(w, errInvalidRequest, "Client requires PKCE: missing code_challenge.", http.StatusBadRequest)
+				return
+			}
+		case err != storage.ErrNotFound:
+			s.logger.ErrorContext(r.Context(), "failed to get client", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+
 		if len(scopes) == 0 {
 			// per RFC8628 section 3.1, https://datatracker.ietf.org/doc/html/rfc8628#section-3.1
 			// scope is optional but dex requires that it is always at least 'openid' so default it
@@ -344,7 +362,7 @@ func (s *Server) handleDeviceCallback(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		resp, err := s.exchangeAuthCode(ctx, w, authCode, client)
+		resp, err := s.exchangeAuthCode(ctx, w, authCode, client, nil)
 		if err != nil {
 			s.logger.ErrorContext(r.Context(), "could not exchange auth code for clien", "client_id", deviceReq.ClientID, "err", err)
 			s.renderError(r, w, http.StatusInternalServerError, "Failed to exchange auth code.")
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// DPoP (Demonstrating Proof of Possession) binds tokens to a key pair held by
+// the client, as described in [IETF RFC 9449](https://tools.ietf.org/html/rfc9449).
+// Every request using a bound token carries a proof JWT signed with that key.
+const (
+	// dpopHeader is the request header carrying the proof.
+	dpopHeader = "DPoP"
+	// dpopProofType is the required JWS "typ" header of proofs.
+	dpopProofType = "dpop+jwt"
+	// tokenTypeDPoP is the token_type of access tokens bound to a DPoP key,
+	// and the authorization scheme they are presented with.
+	tokenTypeDPoP = "DPoP"
+)
+
+// dpopProofMaxAge bounds how far the iat of a proof may be from the current
+// time. The jti of a proof is kept in storage for as long as it is accepted.
+const dpopProofMaxAge = 5 * time.Minute
+
+// dpopProofError is returned for proofs that are rejected, as opposed to
+// failures to record them.
+type dpopProofError struct {
+	reason string
+}
+
+func (e *dpopProofError) Error() string {
+	return "invalid DPoP proof: " + e.reason
+}
+
+// dpopKeyThumbprintKey is the request context key of the JWK thumbprint of
+// the key a client proved possession of at the token endpoint.
+type dpopKeyThumbprintKey struct{}
+
+// dpopKeyThumbprintFromContext returns the JWK thumbprint of the DPoP key of
+// the request, if it carried a valid proof.
+func dpopKeyThumbprintFromContext(ctx context.Context) string {
+	thumbprint, _ := ctx.Value(dpopKeyThumbprintKey{}).(string)
+	return thumbprint
+}
+
+// accessTokenTypeFromContext returns the token_type of access tokens issued
+// during the request.
+func accessTokenTypeFromContext(ctx context.Context) string {
+	if dpopKeyThumbprintFromContext(ctx) != "" {
+		return tokenTypeDPoP
+	}
+	return "Bearer"
+}
+
+// verifyDPoPProof verifies the DPoP proof of r and returns the JWK thumbprint
+// of its key. When the request presents an access token, the proof must be
+// bound to it through the "ath" claim.
+func (s *Server) verifyDPoPProof(r *http.Request, accessToken string) (string, error) {
+	proofs := r.Header.Values(dpopHeader)
+	if len(proofs) != 1 {
+		return "", &dpopProofError{"exactly one DPoP header is required"}
+	}
+	proof := proofs[0]
+
+	if jwtType(proof) != dpopProofType {
+		return "", &dpopProofError{fmt.Sprintf("typ must be %q", dpopProofType)}
+	}
+	jws, err := jose.ParseSigned(proof, requestObjectSigningAlgs)
+	if err != nil {
+		return "", &dpopProofError{fmt.Sprintf("malformed proof: %v", err)}
+	}
+	jwk := jws.Signatures[0].Header.JSONWebKey
+	if jwk == nil || !jwk.Valid() || !jwk.IsPublic() {
+		return "", &dpopProofError{"jwk header must hold a public key"}
+	}
+	payload, err := jws.Verify(jwk)
+	if err != nil {
+		return "", &dpopProofError{fmt.Sprintf("invalid signature: %v", err)}
+	}
+
+	var claims struct {
+		JTI             string `json:"jti"`
+		Method          string `json:"htm"`
+		URI             string `json:"htu"`
+		IssuedAt        *int64 `json:"iat"`
+		AccessTokenHash string `json:"ath"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", &dpopProofError{fmt.Sprintf("malformed claims: %v", err)}
+	}
+	if claims.JTI == "" {
+		return "", &dpopProofError{"jti is required"}
+	}
+	if claims.Method != r.Method {
+		return "", &dpopProofError{"htm does not match the request method"}
+	}
+
+	// The query and fragment are ignored when comparing htu with the request.
+	htu, err := url.Parse(claims.URI)
+	if err != nil {
+		return "", &dpopProofError{"malformed htu"}
+	}
+	endpoint := s.issuerURL
+	endpoint.Path = r.URL.Path
+	if !strings.EqualFold(htu.Scheme, endpoint.Scheme) || !strings.EqualFold(htu.Host, endpoint.Host) || htu.Path != endpoint.Path {
+		return "", &dpopProofError{"htu does not match the request URI"}
+	}
+
+	if claims.IssuedAt == nil {
+		return "", &dpopProofError{"iat is required"}
+	}
+	issuedAt := time.Unix(*claims.IssuedAt, 0)
+	now := s.now()
+	if issuedAt.Before(now.Add(-dpopProofMaxAge)) || issuedAt.After(now.Add(dpopProofMaxAge)) {
+		return "", &dpopProofError{"iat is too far from the current time"}
+	}
+
+	if accessToken != "" {
+		sum := sha256.Sum256([]byte(accessToken))
+		ath := base64.RawURLEncoding.EncodeToString(sum[:])
+		if subtle.ConstantTimeCompare([]byte(claims.AccessTokenHash), []byte(ath)) != 1 {
+			return "", &dpopProofError{"ath does not match the access token"}
+		}
+	}
+
+	rawThumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", &dpopProofError{fmt.Sprintf("failed to compute the key thumbprint: %v", err)}
+	}
+	thumbprint := base64.RawURLEncoding.EncodeToString(rawThumbprint)
+
+	err = s.storage.CreateDPoPProof(r.Context(), storage.DPoPProof{
+		ID:     hashToken(thumbprint + ":" + claims.JTI),
+		Expiry: issuedAt.Add(dpopProofMaxAge),
+	})
+	if err != nil {
+		if err == storage.ErrAlreadyExists {
+			return "", &dpopProofError{"proof has already been used"}
+		}
+		return "", fmt.Errorf("failed to record DPoP proof: %v", err)
+	}
+	return thumbprint, nil
+}
@@ -0,0 +1,255 @@
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+type dpopProofClaims struct {
+	JTI             string `json:"jti,omitempty"`
+	Method          string `json:"htm,omitempty"`
+	URI             string `json:"htu,omitempty"`
+	IssuedAt        int64  `json:"iat,omitempty"`
+	AccessTokenHash string `json:"ath,omitempty"`
+}
+
+func signDPoPProof(t *testing.T, key *ecdsa.PrivateKey, typ string, claims dpopProofClaims) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{EmbedJWK: true}).WithType(jose.ContentType(typ)))
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
+
+func newDPoPProof(t *testing.T, key *ecdsa.PrivateKey, method, uri, accessToken string, now time.Time) string {
+	t.Helper()
+	claims := dpopProofClaims{
+		JTI:      storage.NewID(),
+		Method:   method,
+		URI:      uri,
+		IssuedAt: now.Unix(),
+	}
+	if accessToken != "" {
+		sum := sha256.Sum256([]byte(accessToken))
+		claims.AccessTokenHash = base64.RawURLEncoding.EncodeToString(sum[:])
+	}
+	return signDPoPProof(t, key, dpopProofType, claims)
+}
+
+func dpopKeyThumbprint(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	jwk := jose.JSONWebKey{Key: key.Public()}
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(thumbprint)
+}
+
+func TestVerifyDPoPProof(t *testing.T) {
+	now := time.Now()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Now = func() time.Time { return now }
+	})
+	defer httpServer.Close()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	userInfoURL := s.absURL("/userinfo")
+
+	valid := func() dpopProofClaims {
+		sum := sha256.Sum256([]byte("access-token"))
+		return dpopProofClaims{
+			JTI:             storage.NewID(),
+			Method:          http.MethodGet,
+			URI:             userInfoURL,
+			IssuedAt:        now.Unix(),
+			AccessTokenHash: base64.RawURLEncoding.EncodeToString(sum[:]),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		typ     string
+		modify  func(c *dpopProofClaims)
+		wantErr bool
+	}{
+		{name: "valid"},
+		{name: "query is ignored", modify: func(c *dpopProofClaims) { c.URI += "?foo=bar" }},
+		{name: "wrong typ", typ: "JWT", wantErr: true},
+		{name: "missing jti", modify: func(c *dpopProofClaims) { c.JTI = "" }, wantErr: true},
+		{name: "wrong method", modify: func(c *dpopProofClaims) { c.Method = http.MethodPost }, wantErr: true},
+		{name: "wrong uri", modify: func(c *dpopProofClaims) { c.URI = s.absURL("/token") }, wantErr: true},
+		{name: "stale", modify: func(c *dpopProofClaims) { c.IssuedAt = now.Add(-time.Hour).Unix() }, wantErr: true},
+		{name: "missing iat", modify: func(c *dpopProofClaims) { c.IssuedAt = 0 }, wantErr: true},
+		{name: "wrong ath", modify: func(c *dpopProofClaims) { c.AccessTokenHash = "other" }, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			claims := valid()
+			if tc.modify != nil {
+				tc.modify(&claims)
+			}
+			typ := tc.typ
+			if typ == "" {
+				typ = dpopProofType
+			}
+
+			r := httptest.NewRequest(http.MethodGet, userInfoURL, nil)
+			r.Header.Set(dpopHeader, signDPoPProof(t, key, typ, claims))
+			thumbprint, err := s.verifyDPoPProof(r, "access-token")
+			if tc.wantErr {
+				require.IsType(t, &dpopProofError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, dpopKeyThumbprint(t, key), thumbprint)
+
+			// A proof can only be used once.
+			_, err = s.verifyDPoPProof(r, "access-token")
+			require.IsType(t, &dpopProofError{}, err)
+		})
+	}
+}
+
+func TestDPoPBoundTokens(t *testing.T) {
+	now := time.Now()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Now = func() time.Time { return now }
+	})
+	defer httpServer.Close()
+
+	ctx := t.Context()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	thumbprint := dpopKeyThumbprint(t, key)
+
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:           "spa",
+		Public:       true,
+		RedirectURIs: []string{"https://spa.example.com/callback"},
+	}))
+	require.NoError(t, s.storage.CreateRefresh(ctx, storage.RefreshToken{
+		ID:                "refresh",
+		Token:             "secret",
+		ClientID:          "spa",
+		ConnectorID:       "mock",
+		Scopes:            []string{"openid", "email", "offline_access"},
+		Claims:            storage.Claims{UserID: "1", Username: "jane", Email: "jane.doe@example.com", EmailVerified: true},
+		CreatedAt:         now,
+		LastUsed:          now,
+		DPoPKeyThumbprint: thumbprint,
+	}))
+	require.NoError(t, s.storage.CreateOfflineSessions(ctx, storage.OfflineSessions{
+		UserID:  "1",
+		ConnID:  "mock",
+		Refresh: map[string]*storage.RefreshTokenRef{"spa": {ID: "refresh", ClientID: "spa"}},
+	}))
+	refreshToken, err := internal.Marshal(&internal.RefreshToken{RefreshId: "refresh", Token: "secret"})
+	require.NoError(t, err)
+
+	tokenURL := s.absURL("/token")
+	refresh := func(t *testing.T, proof string) *httptest.ResponseRecorder {
+		form := url.Values{
+			"grant_type":    {grantTypeRefreshToken},
+			"refresh_token": {refreshToken},
+			"client_id":     {"spa"},
+		}
+		req := httptest.NewRequest(http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if proof != "" {
+			req.Header.Set(dpopHeader, proof)
+		}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// The refresh token can only be used with a proof of its key.
+	rr := refresh(t, "")
+	require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	require.Contains(t, rr.Body.String(), `"error":"`+errInvalidGrant+`"`)
+	rr = refresh(t, newDPoPProof(t, otherKey, http.MethodPost, tokenURL, "", now))
+	require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	require.Contains(t, rr.Body.String(), `"error":"`+errInvalidGrant+`"`)
+	rr = refresh(t, "malformed")
+	require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	require.Contains(t, rr.Body.String(), `"error":"`+errInvalidDPoPProof+`"`)
+
+	rr = refresh(t, newDPoPProof(t, key, http.MethodPost, tokenURL, "", now))
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var resp accessTokenResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, tokenTypeDPoP, resp.TokenType)
+
+	rotated, err := s.storage.GetRefresh(ctx, "refresh")
+	require.NoError(t, err)
+	require.Equal(t, thumbprint, rotated.DPoPKeyThumbprint)
+
+	userInfoURL := s.absURL("/userinfo")
+	userInfo := func(t *testing.T, authorization, proof string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, userInfoURL, nil)
+		req.Header.Set("Authorization", authorization)
+		if proof != "" {
+			req.Header.Set(dpopHeader, proof)
+		}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("userinfo", func(t *testing.T) {
+		rr := userInfo(t, "Bearer "+resp.AccessToken, "")
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		require.True(t, strings.HasPrefix(rr.Header().Get("WWW-Authenticate"), tokenTypeDPoP))
+
+		rr = userInfo(t, "DPoP "+resp.AccessToken, newDPoPProof(t, otherKey, http.MethodGet, userInfoURL, resp.AccessToken, now))
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+
+		proof := newDPoPProof(t, key, http.MethodGet, userInfoURL, resp.AccessToken, now)
+		rr = userInfo(t, "DPoP "+resp.AccessToken, proof)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), `"email_verified":true`)
+
+		rr = userInfo(t, "DPoP "+resp.AccessToken, proof)
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), `"error":"`+errInvalidDPoPProof+`"`)
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, s.absURL("/token/introspect"), strings.NewReader(url.Values{"token": {resp.AccessToken}}.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var introspection Introspection
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &introspection))
+		require.True(t, introspection.Active)
+		require.Equal(t, tokenTypeDPoP, introspection.TokenType)
+		require.Equal(t, &confirmation{JKT: thumbprint}, introspection.Confirmation)
+	})
+}
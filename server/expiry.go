@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// ValidateClientExpiry checks the token lifetimes of a client. ID and access
+// tokens can't outlive maxTokenLifetime, the server's ID token lifetime, since
+// signing keys are only kept for that long after they are rotated.
+func ValidateClientExpiry(e *storage.ClientExpiry, maxTokenLifetime time.Duration) error {
+	if e == nil {
+		return nil
+	}
+	durations := []struct {
+		name, value string
+		max         time.Duration
+	}{
+		{"idTokens", e.IDTokens, maxTokenLifetime},
+		{"accessTokens", e.AccessTokens, maxTokenLifetime},
+		{"refreshTokens.validIfNotUsedFor", e.RefreshTokens.ValidIfNotUsedFor, 0},
+		{"refreshTokens.absoluteLifetime", e.RefreshTokens.AbsoluteLifetime, 0},
+	}
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		v, err := time.ParseDuration(d.value)
+		if err != nil {
+			return fmt.Errorf("invalid expiry %s %q: %v", d.name, d.value, err)
+		}
+		if v <= 0 {
+			return fmt.Errorf("invalid expiry %s %q: must be positive", d.name, d.value)
+		}
+		if d.max > 0 && v > d.max {
+			return fmt.Errorf("invalid expiry %s %q: must not exceed the server's ID token expiry of %s", d.name, d.value, d.max)
+		}
+	}
+	return nil
+}
+
+// clientDuration parses a duration of a client's expiry settings, returning def
+// if it is unset. The settings are validated before they are stored, so a
+// value that doesn't parse is treated as unset as well.
+func clientDuration(value string, def time.Duration) time.Duration {
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+// idTokensValidForClient is how long ID tokens issued to client are valid for.
+func (s *Server) idTokensValidForClient(client storage.Client) time.Duration {
+	if client.Expiry == nil {
+		return s.idTokensValidFor
+	}
+	return clientDuration(client.Expiry.IDTokens, s.idTokensValidFor)
+}
+
+// accessTokensValidForClient is how long access tokens issued to client are
+// valid for. Like the server setting, it defaults to the ID token lifetime the
+// client overrides.
+func (s *Server) accessTokensValidForClient(client storage.Client) time.Duration {
+	if client.Expiry == nil {
+		return s.accessTokensValidFor
+	}
+	def := clientDuration(client.Expiry.IDTokens, s.accessTokensValidFor)
+	return clientDuration(client.Expiry.AccessTokens, def)
+}
+
+// refreshTokenPolicyForClient returns the refresh token policy of the server
+// with the overrides of client applied.
+func (s *Server) refreshTokenPolicyForClient(client storage.Client) *RefreshTokenPolicy {
+	r := s.refreshTokenPolicy
+	if r == nil || client.Expiry == nil {
+		return r
+	}
+	e := client.Expiry.RefreshTokens
+
+	p := *r
+	if e.DisableRotation != nil {
+		p.rotateRefreshTokens = !*e.DisableRotation
+	}
+	p.validIfNotUsedFor = clientDuration(e.ValidIfNotUsedFor, r.validIfNotUsedFor)
+	p.absoluteLifetime = clientDuration(e.AbsoluteLifetime, r.absoluteLifetime)
+	return &p
+}
+
+// refreshTokenPolicyForClientID is refreshTokenPolicyForClient for callers that
+// only know the ID of the client. Tokens of clients that no longer exist get the
+// server's policy.
+func (s *Server) refreshTokenPolicyForClientID(ctx context.Context, clientID string) (*RefreshTokenPolicy, error) {
+	client, err := s.storage.GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return s.refreshTokenPolicy, nil
+		}
+		return nil, err
+	}
+	return s.refreshTokenPolicyForClient(client), nil
+}
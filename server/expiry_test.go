@@ -0,0 +1,144 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+func TestValidateClientExpiry(t *testing.T) {
+	const maxLifetime = 24 * time.Hour
+	require.NoError(t, ValidateClientExpiry(nil, maxLifetime))
+	require.NoError(t, ValidateClientExpiry(&storage.ClientExpiry{IDTokens: "5m", RefreshTokens: storage.ClientRefreshTokenExpiry{AbsoluteLifetime: "720h"}}, maxLifetime))
+	require.NoError(t, ValidateClientExpiry(&storage.ClientExpiry{IDTokens: "24h", AccessTokens: "24h"}, maxLifetime))
+	require.Error(t, ValidateClientExpiry(&storage.ClientExpiry{IDTokens: "5 minutes"}, maxLifetime))
+	require.Error(t, ValidateClientExpiry(&storage.ClientExpiry{AccessTokens: "-5m"}, maxLifetime))
+	require.Error(t, ValidateClientExpiry(&storage.ClientExpiry{RefreshTokens: storage.ClientRefreshTokenExpiry{ValidIfNotUsedFor: "0s"}}, maxLifetime))
+	// Tokens must not outlive the signing keys.
+	require.Error(t, ValidateClientExpiry(&storage.ClientExpiry{IDTokens: "48h"}, maxLifetime))
+	require.Error(t, ValidateClientExpiry(&storage.ClientExpiry{AccessTokens: "25h"}, maxLifetime))
+}
+
+func TestClientTokenLifetimes(t *testing.T) {
+	t0 := time.Now().Round(time.Second)
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.IDTokensValidFor = 24 * time.Hour
+		c.AccessTokensValidFor = time.Hour
+		c.Now = func() time.Time { return t0 }
+	})
+	defer httpServer.Close()
+
+	tests := []struct {
+		name         string
+		expiry       *storage.ClientExpiry
+		idTokens     time.Duration
+		accessTokens time.Duration
+	}{
+		{"server settings", nil, 24 * time.Hour, time.Hour},
+		{"only refresh tokens", &storage.ClientExpiry{RefreshTokens: storage.ClientRefreshTokenExpiry{AbsoluteLifetime: "1h"}}, 24 * time.Hour, time.Hour},
+		{"access tokens follow ID tokens", &storage.ClientExpiry{IDTokens: "5m"}, 5 * time.Minute, 5 * time.Minute},
+		{"both", &storage.ClientExpiry{IDTokens: "12h", AccessTokens: "30m"}, 12 * time.Hour, 30 * time.Minute},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := storage.Client{ID: "test", Expiry: tc.expiry}
+			require.Equal(t, tc.idTokens, s.idTokensValidForClient(client))
+			require.Equal(t, tc.accessTokens, s.accessTokensValidForClient(client))
+
+			_, _, expiry, err := s.newIDToken(t.Context(), client, storage.Claims{UserID: "1"}, storage.Authentication{}, []string{"openid"}, "", "", "", "test", nil)
+			require.NoError(t, err)
+			require.Equal(t, t0.Add(tc.idTokens), expiry)
+		})
+	}
+}
+
+func TestClientRefreshTokenPolicy(t *testing.T) {
+	t0 := time.Now()
+	disable, enable := true, false
+
+	tests := []struct {
+		name       string
+		expiry     *storage.ClientExpiry
+		rotated    bool
+		expiresIn  int
+		wantExpire bool
+	}{
+		{
+			name:    "server policy",
+			rotated: true,
+		},
+		{
+			name:    "rotation disabled",
+			expiry:  &storage.ClientExpiry{RefreshTokens: storage.ClientRefreshTokenExpiry{DisableRotation: &disable}},
+			rotated: false,
+		},
+		{
+			name:      "rotation kept",
+			expiry:    &storage.ClientExpiry{RefreshTokens: storage.ClientRefreshTokenExpiry{DisableRotation: &enable, AbsoluteLifetime: "2h"}},
+			rotated:   true,
+			expiresIn: 7200,
+		},
+		{
+			name:       "absolute lifetime",
+			expiry:     &storage.ClientExpiry{RefreshTokens: storage.ClientRefreshTokenExpiry{AbsoluteLifetime: "30m"}},
+			wantExpire: true,
+		},
+		{
+			name:       "valid if not used for",
+			expiry:     &storage.ClientExpiry{RefreshTokens: storage.ClientRefreshTokenExpiry{ValidIfNotUsedFor: "30m"}},
+			wantExpire: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.RefreshTokenPolicy = &RefreshTokenPolicy{
+					rotateRefreshTokens: true,
+					now:                 func() time.Time { return t0.Add(time.Hour) },
+				}
+				c.Now = func() time.Time { return t0.Add(time.Hour) }
+			})
+			defer httpServer.Close()
+
+			mockRefreshTokenTestStorage(t, s.storage, false)
+			require.NoError(t, s.storage.UpdateClient(t.Context(), "test", func(old storage.Client) (storage.Client, error) {
+				old.Expiry = tc.expiry
+				return old, nil
+			}))
+
+			tokenData, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "bar"})
+			require.NoError(t, err)
+
+			v := url.Values{}
+			v.Add("grant_type", "refresh_token")
+			v.Add("refresh_token", tokenData)
+			req := httptest.NewRequest(http.MethodPost, "/token", strings.NewReader(v.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth("test", "barfoo")
+
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+
+			if tc.wantExpire {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+				require.Contains(t, rr.Body.String(), "Refresh token expired.")
+				return
+			}
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var resp accessTokenResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Equal(t, tc.rotated, resp.RefreshToken != tokenData)
+			require.Equal(t, tc.expiresIn, resp.RefreshExpiresIn)
+		})
+	}
+}
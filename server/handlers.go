@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -80,6 +81,13 @@ type discovery struct {
 	UserInfo          string   `json:"userinfo_endpoint"`
 	DeviceEndpoint    string   `json:"device_authorization_endpoint"`
 	Introspect        string   `json:"introspection_endpoint"`
+	Revocation        string   `json:"revocation_endpoint"`
+	PushedAuthRequest string   `json:"pushed_authorization_request_endpoint"`
+	Registration      string   `json:"registration_endpoint"`
+	RequestParameter  bool     `json:"request_parameter_supported"`
+	RequestObjectAlgs []string `json:"request_object_signing_alg_values_supported"`
+	EndSession        string   `json:"end_session_endpoint"`
+	BackchannelLogout bool     `json:"backchannel_logout_supported"`
 	GrantTypes        []string `json:"grant_types_supported"`
 	ResponseTypes     []string `json:"response_types_supported"`
 	Subjects          []string `json:"subject_types_supported"`
@@ -87,18 +95,33 @@ type discovery struct {
 	CodeChallengeAlgs []string `json:"code_challenge_methods_supported"`
 	Scopes            []string `json:"scopes_supported"`
 	AuthMethods       []string `json:"token_endpoint_auth_methods_supported"`
+	AuthMethodAlgs    []string `json:"token_endpoint_auth_signing_alg_values_supported"`
 	Claims            []string `json:"claims_supported"`
+	ACRValues         []string `json:"acr_values_supported"`
+	UILocales         []string `json:"ui_locales_supported"`
+	DPoPAlgs          []string `json:"dpop_signing_alg_values_supported"`
+
+	TLSClientCertificateBoundAccessTokens bool `json:"tls_client_certificate_bound_access_tokens,omitempty"`
 }
 
 func (s *Server) discoveryHandler(ctx context.Context) (http.HandlerFunc, error) {
 	d := s.constructDiscovery(ctx)
 
-	data, err := json.MarshalIndent(d, "", "  ")
-	if err != nil {
+	if _, err := json.Marshal(d); err != nil {
 		return nil, fmt.Errorf("failed to marshal discovery data: %v", err)
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Custom scopes can be added and removed while the server runs. They are
+		// advertised within customScopesCacheFor.
+		d := d
+		d.Scopes = s.supportedScopes(r.Context())
+		data, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to marshal discovery data", "err", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 		w.Header().Set("Content-Type", "application/json")
 		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
 		w.Write(data)
@@ -114,15 +137,26 @@ func (s *Server) constructDiscovery(ctx context.Context) discovery {
 		UserInfo:          s.absURL("/userinfo"),
 		DeviceEndpoint:    s.absURL("/device/code"),
 		Introspect:        s.absURL("/token/introspect"),
-		Subjects:          []string{"public"},
+		Revocation:        s.absURL("/token/revoke"),
+		PushedAuthRequest: s.absURL("/par"),
+		Registration:      s.absURL("/register"),
+		RequestParameter:  true,
+		EndSession:        s.absURL("/logout"),
+		BackchannelLogout: true,
+		Subjects:          s.supportedSubjectTypes(),
 		IDTokenAlgs:       []string{string(jose.RS256)},
 		CodeChallengeAlgs: []string{codeChallengeMethodS256, codeChallengeMethodPlain},
-		Scopes:            []string{"openid", "email", "groups", "profile", "offline_access"},
-		AuthMethods:       []string{"client_secret_basic", "client_secret_post"},
+		AuthMethods: []string{
+			authMethodClientSecretBasic, authMethodClientSecretPost,
+			authMethodClientSecretJWT, authMethodPrivateKeyJWT,
+		},
 		Claims: []string{
 			"iss", "sub", "aud", "iat", "exp", "email", "email_verified",
 			"locale", "name", "preferred_username", "at_hash",
+			"acr", "amr", "auth_time",
 		},
+		ACRValues: supportedACRValues,
+		UILocales: SupportedLanguages(),
 	}
 
 	// Determine signing algorithm from signer
@@ -138,6 +172,22 @@ func (s *Server) constructDiscovery(ctx context.Context) discovery {
 	}
 	sort.Strings(d.ResponseTypes)
 
+	for _, alg := range requestObjectSigningAlgs {
+		d.RequestObjectAlgs = append(d.RequestObjectAlgs, string(alg))
+	}
+	for _, alg := range slices.Concat(requestObjectSigningAlgs, clientSecretJWTSigningAlgs) {
+		d.AuthMethodAlgs = append(d.AuthMethodAlgs, string(alg))
+	}
+	// DPoP proofs are signed with the same asymmetric algorithms.
+	d.DPoPAlgs = d.RequestObjectAlgs
+
+	if s.tlsClientAuth {
+		d.AuthMethods = append(d.AuthMethods, authMethodTLSClientAuth, authMethodSelfSignedTLSClientAuth)
+		d.TLSClientCertificateBoundAccessTokens = true
+	}
+
+	d.Scopes = s.supportedScopes(ctx)
+
 	d.GrantTypes = s.supportedGrantTypes
 	return d
 }
@@ -153,6 +203,36 @@ func (s *Server) handleAuthorization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Arguments forwarded to the connector login, in the browser's URL.
+	query := r.Form
+	if r.Form.Get("request_uri") != "" {
+		form, err := s.pushedAuthorizationParams(ctx, r.Form)
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to resolve pushed authorization request", "err", err)
+			s.renderError(r, w, err.Status, err.Error())
+			return
+		}
+		r.Form = form
+		query = pushedAuthorizationQuery(form)
+	} else if r.Form.Get("request") != "" {
+		// The connector login verifies the request object again, so keep
+		// forwarding it as is.
+		form, err := s.requestObjectParams(ctx, r.Form)
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to verify request object", "err", err)
+			switch authErr := err.(type) {
+			case *redirectedAuthErr:
+				authErr.Handler().ServeHTTP(w, r)
+			case *displayedAuthErr:
+				s.renderError(r, w, authErr.Status, err.Error())
+			default:
+				panic("unsupported error type")
+			}
+			return
+		}
+		r.Form = form
+	}
+
 	connectorID := r.Form.Get("connector_id")
 	connectors, err := s.storage.ListConnectors(ctx)
 	if err != nil {
@@ -160,13 +240,24 @@ func (s *Server) handleAuthorization(w http.ResponseWriter, r *http.Request) {
 		s.renderError(r, w, http.StatusInternalServerError, "Failed to retrieve connector list.")
 		return
 	}
+	connectors, err = s.clientConnectors(ctx, r.Form.Get("client_id"), connectors)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to get client", "err", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Failed to retrieve connector list.")
+		return
+	}
+	if len(connectors) == 0 {
+		s.logger.ErrorContext(r.Context(), "no connectors allowed for client", "client_id", r.Form.Get("client_id"))
+		s.renderError(r, w, http.StatusBadRequest, "No connectors are available for this client.")
+		return
+	}
 
 	// We don't need connector_id any more
-	r.Form.Del("connector_id")
+	query.Del("connector_id")
 
 	// Construct a URL with all of the arguments in its query
 	connURL := url.URL{
-		RawQuery: r.Form.Encode(),
+		RawQuery: query.Encode(),
 	}
 
 	// Redirect if a client chooses a specific connector_id
@@ -182,6 +273,39 @@ func (s *Server) handleAuthorization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A browser that already logged in goes straight back to the connector it
+	// used, where the session answers the request, unless the client asked to
+	// let the user pick a connector again.
+	prompt := r.Form.Get("prompt")
+	if session, ok := s.reusableSession(r, ""); ok && !hasPrompt(prompt, promptSelectAccount) {
+		for _, c := range connectors {
+			if c.ID == session.ConnectorID {
+				connURL.Path = s.absPath("/auth", url.PathEscape(c.ID))
+				http.Redirect(w, r, connURL.String(), http.StatusFound)
+				return
+			}
+		}
+	}
+
+	// Without a session the user has to log in, which prompt=none forbids.
+	if hasPrompt(prompt, promptNone) {
+		authReq, err := s.validateAuthorizationRequest(ctx, r.Form, r.Form.Get("request_uri") != "")
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to parse authorization request", "err", err)
+			switch authErr := err.(type) {
+			case *redirectedAuthErr:
+				authErr.Handler().ServeHTTP(w, r)
+			case *displayedAuthErr:
+				s.renderError(r, w, authErr.Status, err.Error())
+			default:
+				panic("unsupported error type")
+			}
+			return
+		}
+		s.interactionRequired(w, r, *authReq, errLoginRequired, "The user is not logged in.")
+		return
+	}
+
 	if len(connectors) == 1 && !s.alwaysShowLogin {
 		connURL.Path = s.absPath("/auth", url.PathEscape(connectors[0].ID))
 		http.Redirect(w, r, connURL.String(), http.StatusFound)
@@ -198,7 +322,8 @@ func (s *Server) handleAuthorization(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if err := s.templates.login(s.brand(r, r.Form.Get("client_id")), w, connectorInfos); err != nil {
+	b := s.brand(r, r.Form.Get("client_id"), strings.Fields(r.Form.Get("ui_locales"))...)
+	if err := s.templates.login(b, w, connectorInfos); err != nil {
 		s.logger.ErrorContext(r.Context(), "server template error", "err", err)
 	}
 }
@@ -228,6 +353,18 @@ func (s *Server) handleConnectorLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	client, err := s.storage.GetClient(ctx, authReq.ClientID)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to get client", "client_id", authReq.ClientID, "err", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+	if !connectorAllowed(client, connID) {
+		s.logger.ErrorContext(r.Context(), "connector not allowed for client", "client_id", client.ID, "connector_id", connID)
+		s.renderError(r, w, http.StatusBadRequest, "Connector ID does not match a valid Connector")
+		return
+	}
+
 	conn, err := s.getConnector(ctx, connID)
 	if err != nil {
 		s.logger.ErrorContext(r.Context(), "Failed to get connector", "err", err)
@@ -258,21 +395,43 @@ func (s *Server) handleConnectorLogin(w http.ResponseWriter, r *http.Request) {
 	// Work out where the "Select another login method" link should go.
 	backLink := ""
 	if len(s.connectors) > 1 {
+		query := r.Form
+		if query.Get("request_uri") != "" {
+			query = pushedAuthorizationQuery(query)
+		}
 		backLinkURL := url.URL{
 			Path:     s.absPath("/auth"),
-			RawQuery: r.Form.Encode(),
+			RawQuery: query.Encode(),
 		}
 		backLink = backLinkURL.String()
 	}
 
 	switch r.Method {
 	case http.MethodGet:
+		if session, ok := s.reusableSession(r, connID); ok {
+			s.completeLogin(w, r, s.resumeSession(ctx, session, authReq.ClientID), session.Authentication, *authReq, conn.Connector)
+			return
+		}
+		if hasPrompt(authReq.Prompt, promptNone) {
+			s.interactionRequired(w, r, *authReq, errLoginRequired, "The user is not logged in.")
+			return
+		}
+
 		switch conn := conn.Connector.(type) {
 		case connector.CallbackConnector:
 			// Use the auth request ID as the "state" token.
 			//
 			// TODO(ericchiang): Is this appropriate or should we also be using a nonce?
-			callbackURL, connData, err := conn.LoginURL(scopes, s.absURL("/callback"), authReq.ID)
+			var (
+				callbackURL string
+				connData    []byte
+				err         error
+			)
+			if hintConn, ok := conn.(connector.LoginHintConnector); ok && authReq.LoginHint != "" {
+				callbackURL, connData, err = hintConn.LoginURLWithHint(scopes, s.absURL("/callback"), authReq.ID, authReq.LoginHint)
+			} else {
+				callbackURL, connData, err = conn.LoginURL(scopes, s.absURL("/callback"), authReq.ID)
+			}
 			if err != nil {
 				s.logger.ErrorContext(r.Context(), "connector returned error when creating callback", "connector_id", connID, "err", err)
 				s.renderError(r, w, http.StatusInternalServerError, "Login error.")
@@ -381,24 +540,34 @@ func (s *Server) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Enable domain field in the login template only for Keystone connectors.
+	// They are also the only ones that can ask for a second factor.
 	showDomain := false
 	if storageConn, serr := s.storage.GetConnector(ctx, authReq.ConnectorID); serr == nil && storageConn.Type == "keystone" {
 		showDomain = true
 	}
+	requireMFA := showDomain && !meetsACRValues(authReq.ACRValues, acrSingleFactor)
 
-	b := s.brand(r, authReq.ClientID)
+	b := s.brand(r, authReq.ClientID, authReq.UILocales...)
 
 	// A device can only be trusted if the connector can later revalidate the
 	// token it issued, which is what lets us skip the second factor.
 	tiConn, canTrustDevice := conn.Connector.(connector.TokenIdentityConnector)
 	canTrustDevice = canTrustDevice && s.mfaTrust.Enabled
 
+	// Clients that ask for a fresh login want the user to type credentials,
+	// which the trusted device shortcut below skips.
+	reauthenticate := hasPrompt(authReq.Prompt, promptLogin) || authReq.MaxAge >= 0
+
 	switch r.Method {
 	case http.MethodGet:
-		if token := s.mfaTrustToken(r, authReq.ConnectorID); canTrustDevice && token != "" {
+		// A trusted device skips the second factor, which a step-up request
+		// asks for again.
+		if token := s.mfaTrustToken(r, authReq.ConnectorID); canTrustDevice && !requireMFA && !reauthenticate && token != "" {
 			identity, err := tiConn.TokenIdentity(ctx, "", token)
 			if err == nil {
-				s.completeLogin(w, r, identity, authReq, conn.Connector)
+				authn := s.newAuthentication(amrTrustedDevice)
+				s.startSession(w, r, authReq.ClientID, authReq.ConnectorID, identity, authn)
+				s.completeLogin(w, r, identity, authn, authReq, conn.Connector)
 				return
 			}
 			// Expired or revoked upstream: drop the cookie and ask for credentials.
@@ -406,7 +575,7 @@ func (s *Server) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 			s.clearMFATrustCookie(w, authReq.ConnectorID)
 		}
 
-		if err := s.templates.password(b, w, r.URL.String(), "", usernamePrompt(pwConn), false, backLink, showDomain, "", false, "", "", false); err != nil {
+		if err := s.templates.password(b, w, r.URL.String(), authReq.LoginHint, usernamePrompt(pwConn), false, backLink, showDomain, "", false, "", "", false); err != nil {
 			s.logger.ErrorContext(r.Context(), "server template error", "err", err)
 		}
 	case http.MethodPost:
@@ -469,11 +638,22 @@ func (s *Server) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 		}
 		s.loginLimiter.reset(limitKey)
 
-		if issuedToken != "" {
+		// The upstream provider accepted the password alone, but the client
+		// asked for a second factor: ask for a passcode and log in again.
+		if requireMFA && !identity.MultiFactor {
+			if err := s.templates.password(b, w, r.URL.String(), username, usernamePrompt(pwConn), false, backLink, showDomain, r.FormValue("domain"), true, "", password, canTrustDevice); err != nil {
+				s.logger.ErrorContext(r.Context(), "server template error", "err", err)
+			}
+			return
+		}
+
+		if issuedToken != "" && identity.MultiFactor {
 			s.setMFATrustCookie(w, authReq.ConnectorID, issuedToken)
 		}
 
-		s.completeLogin(w, r, identity, authReq, conn.Connector)
+		authn := s.passwordAuthentication(identity.MultiFactor)
+		s.startSession(w, r, authReq.ClientID, authReq.ConnectorID, identity, authn)
+		s.completeLogin(w, r, identity, authn, authReq, conn.Connector)
 	default:
 		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
 	}
@@ -481,10 +661,28 @@ func (s *Server) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 
 // completeLogin finalizes an authenticated identity and sends the user back to
 // the client, either directly or through the approval screen.
-func (s *Server) completeLogin(w http.ResponseWriter, r *http.Request, identity connector.Identity, authReq storage.AuthRequest, conn connector.Connector) {
+func (s *Server) completeLogin(w http.ResponseWriter, r *http.Request, identity connector.Identity, authn storage.Authentication, authReq storage.AuthRequest, conn connector.Connector) {
 	ctx := r.Context()
 
-	redirectURL, canSkipApproval, err := s.finalizeLogin(ctx, identity, authReq, conn)
+	if !meetsACRValues(authReq.ACRValues, authn.ACR) {
+		s.logger.InfoContext(ctx, "login does not meet requested acr_values",
+			"connector_id", authReq.ConnectorID, "acr", authn.ACR, "acr_values", authReq.ACRValues)
+		err := &redirectedAuthErr{
+			State:       authReq.State,
+			RedirectURI: authReq.RedirectURI,
+			Type:        errUnmetAuthenticationRequirements,
+			Description: "The login method cannot meet the requested authentication context class.",
+		}
+		err.Handler().ServeHTTP(w, r)
+		return
+	}
+
+	if hasPrompt(authReq.Prompt, promptNone) && s.needsApproval(ctx, authReq, identity.UserID) {
+		s.interactionRequired(w, r, authReq, errConsentRequired, "The user must approve the request.")
+		return
+	}
+
+	redirectURL, canSkipApproval, err := s.finalizeLogin(ctx, identity, authn, authReq, conn)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to finalize login", "err", err)
 		s.renderError(r, w, http.StatusInternalServerError, "Login error.")
@@ -505,6 +703,32 @@ func (s *Server) completeLogin(w http.ResponseWriter, r *http.Request, identity
 	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
 }
 
+// interactionRequired fails a prompt=none request that can only go on by
+// showing the user a page.
+func (s *Server) interactionRequired(w http.ResponseWriter, r *http.Request, authReq storage.AuthRequest, typ, description string) {
+	s.logger.InfoContext(r.Context(), "authorization request needs user interaction", "client_id", authReq.ClientID, "error", typ)
+	err := &redirectedAuthErr{
+		State:       authReq.State,
+		RedirectURI: authReq.RedirectURI,
+		Type:        typ,
+		Description: description,
+	}
+	err.Handler().ServeHTTP(w, r)
+}
+
+// needsApproval reports whether the user with userID must approve authReq on
+// the approval screen. A consent given before covers the scopes it granted,
+// unless the client asks for the prompt again.
+func (s *Server) needsApproval(ctx context.Context, authReq storage.AuthRequest, userID string) bool {
+	if authReq.ForceApprovalPrompt {
+		return true
+	}
+	if s.skipApproval {
+		return false
+	}
+	return !s.hasConsent(ctx, userID, authReq.ConnectorID, authReq.ClientID, authReq.Scopes)
+}
+
 func (s *Server) handleConnectorCallback(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var authID string
@@ -581,30 +805,14 @@ func (s *Server) handleConnectorCallback(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	redirectURL, canSkipApproval, err := s.finalizeLogin(ctx, identity, authReq, conn.Connector)
-	if err != nil {
-		s.logger.ErrorContext(r.Context(), "failed to finalize login", "err", err)
-		s.renderError(r, w, http.StatusInternalServerError, "Login error.")
-		return
-	}
-
-	if canSkipApproval {
-		authReq, err = s.storage.GetAuthRequest(ctx, authReq.ID)
-		if err != nil {
-			s.logger.ErrorContext(r.Context(), "failed to get finalized auth request", "err", err)
-			s.renderError(r, w, http.StatusInternalServerError, "Login error.")
-			return
-		}
-		s.sendCodeResponse(w, r, authReq)
-		return
-	}
-
-	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+	authn := s.newAuthentication(amrFederated)
+	s.startSession(w, r, authReq.ClientID, authReq.ConnectorID, identity, authn)
+	s.completeLogin(w, r, identity, authn, authReq, conn.Connector)
 }
 
-// finalizeLogin associates the user's identity with the current AuthRequest, then returns
-// the approval page's path.
-func (s *Server) finalizeLogin(ctx context.Context, identity connector.Identity, authReq storage.AuthRequest, conn connector.Connector) (string, bool, error) {
+// finalizeLogin associates the user's identity and how they authenticated with
+// the current AuthRequest, then returns the approval page's path.
+func (s *Server) finalizeLogin(ctx context.Context, identity connector.Identity, authn storage.Authentication, authReq storage.AuthRequest, conn connector.Connector) (string, bool, error) {
 	claims := storage.Claims{
 		UserID:            identity.UserID,
 		Username:          identity.Username,
@@ -612,11 +820,13 @@ func (s *Server) finalizeLogin(ctx context.Context, identity connector.Identity,
 		Email:             identity.Email,
 		EmailVerified:     identity.EmailVerified,
 		Groups:            identity.Groups,
+		ExtraClaims:       identity.ExtraClaims,
 	}
 
 	updater := func(a storage.AuthRequest) (storage.AuthRequest, error) {
 		a.LoggedIn = true
 		a.Claims = claims
+		a.Authentication = authn
 		a.ConnectorData = identity.ConnectorData
 		return a, nil
 	}
@@ -652,6 +862,7 @@ func (s *Server) finalizeLogin(ctx context.Context, identity connector.Identity,
 				ConnID:        authReq.ConnectorID,
 				Refresh:       make(map[string]*storage.RefreshTokenRef),
 				ConnectorData: identity.ConnectorData,
+				ExtraClaims:   identity.ExtraClaims,
 			}
 
 			// Create a new OfflineSession object for the user and add a reference object for
@@ -666,6 +877,7 @@ func (s *Server) finalizeLogin(ctx context.Context, identity connector.Identity,
 				if len(identity.ConnectorData) > 0 {
 					old.ConnectorData = identity.ConnectorData
 				}
+				old.ExtraClaims = identity.ExtraClaims
 				return old, nil
 			}); err != nil {
 				s.logger.ErrorContext(ctx, "failed to update offline session", "err", err)
@@ -678,7 +890,7 @@ func (s *Server) finalizeLogin(ctx context.Context, identity connector.Identity,
 	}
 
 	// we can skip the redirect to /approval and go ahead and send code if it's not required
-	if s.skipApproval && !authReq.ForceApprovalPrompt {
+	if !s.needsApproval(ctx, authReq, identity.UserID) {
 		return "", true, nil
 	}
 
@@ -735,7 +947,13 @@ func (s *Server) handleApproval(w http.ResponseWriter, r *http.Request) {
 			s.renderError(r, w, http.StatusInternalServerError, "Failed to retrieve client.")
 			return
 		}
-		if err := s.templates.approval(s.brand(r, authReq.ClientID), w, authReq.ID, authReq.Claims.Username, client.Name, authReq.Scopes); err != nil {
+		scopes, err := s.approvalScopes(ctx, client, authReq.Scopes, preferredLanguages(r.Header.Get("Accept-Language"), authReq.UILocales...))
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to describe scopes", "err", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		if err := s.templates.approval(s.brand(r, authReq.ClientID, authReq.UILocales...), w, authReq.ID, authReq.Claims.Username, client.Name, scopes); err != nil {
 			s.logger.ErrorContext(r.Context(), "server template error", "err", err)
 		}
 	case http.MethodPost:
@@ -743,6 +961,27 @@ func (s *Server) handleApproval(w http.ResponseWriter, r *http.Request) {
 			s.renderError(r, w, http.StatusInternalServerError, "Approval rejected.")
 			return
 		}
+		// Approval screens that let the user pick scopes say so; older
+		// templates grant everything requested.
+		if r.FormValue("scope_selection") != "" {
+			client, err := s.storage.GetClient(ctx, authReq.ClientID)
+			if err != nil {
+				s.logger.ErrorContext(r.Context(), "Failed to get client", "client_id", authReq.ClientID, "err", err)
+				s.renderError(r, w, http.StatusInternalServerError, "Failed to retrieve client.")
+				return
+			}
+			shown, err := s.approvalScopes(ctx, client, authReq.Scopes, nil)
+			if err != nil {
+				s.logger.ErrorContext(r.Context(), "failed to describe scopes", "err", err)
+				s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+				return
+			}
+			authReq.Scopes = grantedScopes(shown, authReq.Scopes, r.Form["scope"])
+		}
+		if err := s.recordConsent(ctx, authReq); err != nil {
+			// The user approved the request, so go on and ask again next time.
+			s.logger.ErrorContext(r.Context(), "failed to record consent", "client_id", authReq.ClientID, "err", err)
+		}
 		s.sendCodeResponse(w, r, authReq)
 	}
 }
@@ -779,28 +1018,29 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 
 		// ID token returned immediately if the response_type includes "id_token".
 		// Only valid for implicit and hybrid flows.
-		idToken       string
-		idTokenExpiry time.Time
+		idToken   string
+		sessionID string
 
 		// Access token
-		accessToken string
-		sessionID   string
+		accessToken       string
+		accessTokenExpiry time.Time
 	)
 
 	for _, responseType := range authReq.ResponseTypes {
 		switch responseType {
 		case responseTypeCode:
 			code = storage.AuthCode{
-				ID:            storage.NewID(),
-				ClientID:      authReq.ClientID,
-				ConnectorID:   authReq.ConnectorID,
-				Nonce:         authReq.Nonce,
-				Scopes:        authReq.Scopes,
-				Claims:        authReq.Claims,
-				Expiry:        s.now().Add(time.Minute * 30),
-				RedirectURI:   authReq.RedirectURI,
-				ConnectorData: authReq.ConnectorData,
-				PKCE:          authReq.PKCE,
+				ID:             storage.NewID(),
+				ClientID:       authReq.ClientID,
+				ConnectorID:    authReq.ConnectorID,
+				Nonce:          authReq.Nonce,
+				Scopes:         authReq.Scopes,
+				Claims:         authReq.Claims,
+				Authentication: authReq.Authentication,
+				Expiry:         s.now().Add(time.Minute * 30),
+				RedirectURI:    authReq.RedirectURI,
+				ConnectorData:  authReq.ConnectorData,
+				PKCE:           authReq.PKCE,
 			}
 			if err := s.storage.CreateAuthCode(ctx, code); err != nil {
 				s.logger.ErrorContext(r.Context(), "Failed to create auth code", "err", err)
@@ -818,9 +1058,14 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 			}
 		case responseTypeToken:
 			implicitOrHybrid = true
-			var err error
+			client, err := s.storage.GetClient(ctx, authReq.ClientID)
+			if err != nil {
+				s.logger.ErrorContext(r.Context(), "failed to get client", "client_id", authReq.ClientID, "err", err)
+				s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+				return
+			}
 
-			accessToken, sessionID, _, err = s.newAccessToken(r.Context(), authReq.ClientID, authReq.Claims, authReq.Scopes, authReq.Nonce, authReq.ConnectorID)
+			accessToken, accessTokenExpiry, err = s.newAccessToken(r.Context(), client, nil, authReq.Claims, authReq.Scopes, authReq.ConnectorID, authReq.ConnectorData, "")
 			if err != nil {
 				s.logger.ErrorContext(r.Context(), "failed to create new access token", "err", err)
 				s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
@@ -828,9 +1073,14 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 			}
 		case responseTypeIDToken:
 			implicitOrHybrid = true
-			var err error
+			client, err := s.storage.GetClient(ctx, authReq.ClientID)
+			if err != nil {
+				s.logger.ErrorContext(r.Context(), "failed to get client", "client_id", authReq.ClientID, "err", err)
+				s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+				return
+			}
 
-			idToken, sessionID, idTokenExpiry, err = s.newIDToken(r.Context(), authReq.ClientID, authReq.Claims, authReq.Scopes, authReq.Nonce, accessToken, code.ID, authReq.ConnectorID)
+			idToken, sessionID, _, err = s.newIDToken(r.Context(), client, authReq.Claims, authReq.Authentication, authReq.Scopes, authReq.Nonce, accessToken, code.ID, authReq.ConnectorID, authReq.ConnectorData)
 			if err != nil {
 				s.logger.ErrorContext(r.Context(), "failed to create ID token", "err", err)
 				s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
@@ -850,8 +1100,10 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 			//
 			// https://openid.net/specs/openid-connect-core-1_0.html#HybridAuthResponse
 			if code.ID == "" {
-				v.Set("expires_in", strconv.Itoa(int(idTokenExpiry.Sub(s.now()).Seconds())))
+				v.Set("expires_in", strconv.Itoa(int(accessTokenExpiry.Sub(s.now()).Seconds())))
 			}
+			// The user may have granted fewer scopes than requested.
+			v.Set("scope", strings.Join(authReq.Scopes, " "))
 		}
 		v.Set("state", authReq.State)
 		if idToken != "" {
@@ -892,6 +1144,11 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 
 func (s *Server) withClientFromStorage(w http.ResponseWriter, r *http.Request, handler func(http.ResponseWriter, *http.Request, storage.Client)) {
 	ctx := r.Context()
+	if r.PostFormValue("client_assertion_type") != "" || r.PostFormValue("client_assertion") != "" {
+		s.withClientFromAssertion(w, r, handler)
+		return
+	}
+
 	clientID, clientSecret, ok := r.BasicAuth()
 	if ok {
 		var err error
@@ -919,6 +1176,18 @@ func (s *Server) withClientFromStorage(w http.ResponseWriter, r *http.Request, h
 		return
 	}
 
+	if tlsClientAuthMethod(client.TokenEndpointAuthMethod) {
+		s.withClientFromCertificate(w, r, client, clientSecret, handler)
+		return
+	}
+
+	if !clientSecretAllowed(client, ok) {
+		s.logger.InfoContext(r.Context(), "client authentication method not allowed",
+			"client_id", client.ID, "token_endpoint_auth_method", client.TokenEndpointAuthMethod)
+		s.tokenErrHelper(w, errInvalidClient, "Invalid client credentials.", http.StatusUnauthorized)
+		return
+	}
+
 	if subtle.ConstantTimeCompare([]byte(client.Secret), []byte(clientSecret)) != 1 {
 		if clientSecret == "" {
 			s.logger.InfoContext(r.Context(), "missing client_secret on token request", "client_id", client.ID)
@@ -952,20 +1221,53 @@ func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
 		s.tokenErrHelper(w, errUnsupportedGrantType, "", http.StatusBadRequest)
 		return
 	}
+
+	// Tokens issued to a client proving possession of a DPoP key are bound
+	// to that key.
+	if len(r.Header.Values(dpopHeader)) > 0 {
+		thumbprint, err := s.verifyDPoPProof(r, "")
+		if err != nil {
+			if _, ok := err.(*dpopProofError); ok {
+				s.logger.InfoContext(r.Context(), "rejected DPoP proof", "err", err)
+				s.tokenErrHelper(w, errInvalidDPoPProof, "Invalid DPoP proof.", http.StatusBadRequest)
+				return
+			}
+			s.logger.ErrorContext(r.Context(), "failed to verify DPoP proof", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		r = r.WithContext(context.WithValue(r.Context(), dpopKeyThumbprintKey{}, thumbprint))
+	}
+
+	var handler func(http.ResponseWriter, *http.Request, storage.Client)
 	switch grantType {
 	case grantTypeDeviceCode:
+		// Device clients don't authenticate here. The grant was checked when
+		// the device code was issued.
 		s.handleDeviceToken(w, r)
+		return
 	case grantTypeAuthorizationCode:
-		s.withClientFromStorage(w, r, s.handleAuthCode)
+		handler = s.handleAuthCode
 	case grantTypeRefreshToken:
-		s.withClientFromStorage(w, r, s.handleRefreshToken)
+		handler = s.handleRefreshToken
 	case grantTypePassword:
-		s.withClientFromStorage(w, r, s.handlePasswordGrant)
+		handler = s.handlePasswordGrant
 	case grantTypeTokenExchange:
-		s.withClientFromStorage(w, r, s.handleTokenExchange)
+		handler = s.handleTokenExchange
+	case grantTypeClientCredentials:
+		handler = s.handleClientCredentialsGrant
 	default:
 		s.tokenErrHelper(w, errUnsupportedGrantType, "", http.StatusBadRequest)
+		return
 	}
+	s.withClientFromStorage(w, r, func(w http.ResponseWriter, r *http.Request, client storage.Client) {
+		if !grantTypeAllowed(client, grantType) {
+			s.logger.InfoContext(r.Context(), "grant type not allowed for client", "client_id", client.ID, "grant_type", grantType)
+			s.tokenErrHelper(w, errUnauthorizedClient, "The client is not allowed to use this grant type.", http.StatusBadRequest)
+			return
+		}
+		handler(w, r, client)
+	})
 }
 
 func (s *Server) calculateCodeChallenge(codeVerifier, codeChallengeMethod string) (string, error) {
@@ -1033,35 +1335,21 @@ func (s *Server) handleAuthCode(w http.ResponseWriter, r *http.Request, client s
 		return
 	}
 
-	tokenResponse, err := s.exchangeAuthCode(ctx, w, authCode, client)
+	aud, err := s.accessTokenAudience(r)
 	if err != nil {
-		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		s.tokenErrHelper(w, errInvalidTarget, err.Error(), http.StatusBadRequest)
 		return
 	}
-	s.writeAccessToken(w, tokenResponse)
-}
-
-func (s *Server) exchangeAuthCode(ctx context.Context, w http.ResponseWriter, authCode storage.AuthCode, client storage.Client) (*accessTokenResponse, error) {
-	accessToken, _, _, err := s.newAccessToken(ctx, client.ID, authCode.Claims, authCode.Scopes, authCode.Nonce, authCode.ConnectorID)
-	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to create new access token", "err", err)
-		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
-		return nil, err
-	}
 
-	idToken, sessionID, expiry, err := s.newIDToken(ctx, client.ID, authCode.Claims, authCode.Scopes, authCode.Nonce, accessToken, authCode.ID, authCode.ConnectorID)
+	tokenResponse, err := s.exchangeAuthCode(ctx, w, authCode, client, aud)
 	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to create ID token", "err", err)
 		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
-		return nil, err
-	}
-
-	if err := s.storage.DeleteAuthCode(ctx, authCode.ID); err != nil {
-		s.logger.ErrorContext(ctx, "failed to delete auth code", "err", err)
-		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
-		return nil, err
+		return
 	}
+	s.writeAccessToken(w, tokenResponse)
+}
 
+func (s *Server) exchangeAuthCode(ctx context.Context, w http.ResponseWriter, authCode storage.AuthCode, client storage.Client, aud audience) (*accessTokenResponse, error) {
 	reqRefresh := func() bool {
 		// Ensure the connector supports refresh tokens.
 		//
@@ -1085,19 +1373,52 @@ func (s *Server) exchangeAuthCode(ctx context.Context, w http.ResponseWriter, au
 		}
 		return false
 	}()
+	// Opaque access tokens are revoked along with the refresh token issued
+	// with them.
+	var refreshID string
+	if reqRefresh {
+		refreshID = storage.NewID()
+	}
+
+	accessToken, expiry, err := s.newAccessToken(ctx, client, aud, authCode.Claims, authCode.Scopes, authCode.ConnectorID, authCode.ConnectorData, refreshID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create new access token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return nil, err
+	}
+
+	idToken, sessionID, _, err := s.newIDToken(ctx, client, authCode.Claims, authCode.Authentication, authCode.Scopes, authCode.Nonce, accessToken, authCode.ID, authCode.ConnectorID, authCode.ConnectorData)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create ID token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return nil, err
+	}
+
+	if err := s.storage.DeleteAuthCode(ctx, authCode.ID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete auth code", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return nil, err
+	}
+
 	var refreshToken string
 	if reqRefresh {
 		refresh := storage.RefreshToken{
-			ID:            storage.NewID(),
-			Token:         storage.NewID(),
-			ClientID:      authCode.ClientID,
-			ConnectorID:   authCode.ConnectorID,
-			Scopes:        authCode.Scopes,
-			Claims:        authCode.Claims,
-			Nonce:         authCode.Nonce,
-			ConnectorData: authCode.ConnectorData,
-			CreatedAt:     s.now(),
-			LastUsed:      s.now(),
+			ID:             refreshID,
+			Token:          storage.NewID(),
+			ClientID:       authCode.ClientID,
+			ConnectorID:    authCode.ConnectorID,
+			Scopes:         authCode.Scopes,
+			Claims:         authCode.Claims,
+			Authentication: authCode.Authentication,
+			Nonce:          authCode.Nonce,
+			ConnectorData:  authCode.ConnectorData,
+			CreatedAt:      s.now(),
+			LastUsed:       s.now(),
+		}
+		// Public clients do not authenticate when refreshing, so their refresh
+		// tokens are bound to the DPoP key they used instead, if any.
+		if client.Public {
+			refresh.DPoPKeyThumbprint = dpopKeyThumbprintFromContext(ctx)
 		}
 		token := &internal.RefreshToken{
 			RefreshId: refresh.ID,
@@ -1150,6 +1471,7 @@ func (s *Server) exchangeAuthCode(ctx context.Context, w http.ResponseWriter, au
 				ConnID:        refresh.ConnectorID,
 				Refresh:       make(map[string]*storage.RefreshTokenRef),
 				ConnectorData: refresh.ConnectorData,
+				ExtraClaims:   refresh.Claims.ExtraClaims,
 			}
 			offlineSessions.Refresh[tokenRef.ClientID] = &tokenRef
 
@@ -1178,6 +1500,7 @@ func (s *Server) exchangeAuthCode(ctx context.Context, w http.ResponseWriter, au
 				if len(refresh.ConnectorData) > 0 {
 					old.ConnectorData = refresh.ConnectorData
 				}
+				old.ExtraClaims = refresh.Claims.ExtraClaims
 				return old, nil
 			}); err != nil {
 				s.logger.ErrorContext(ctx, "failed to update offline session", "err", err)
@@ -1187,38 +1510,179 @@ func (s *Server) exchangeAuthCode(ctx context.Context, w http.ResponseWriter, au
 			}
 		}
 	}
-	return s.toAccessTokenResponse(idToken, accessToken, refreshToken, expiry, sessionID, authCode.Scopes), nil
+	return s.toAccessTokenResponse(ctx, client, idToken, accessToken, refreshToken, expiry, sessionID, authCode.Scopes), nil
 }
 
 func (s *Server) handleUserInfo(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	const prefix = "Bearer "
 
-	auth := r.Header.Get("authorization")
-	if len(auth) < len(prefix) || !strings.EqualFold(prefix, auth[:len(prefix)]) {
+	scheme, rawToken, _ := strings.Cut(r.Header.Get("authorization"), " ")
+	isDPoP := strings.EqualFold(scheme, tokenTypeDPoP)
+	if rawToken == "" || (!strings.EqualFold(scheme, "Bearer") && !isDPoP) {
 		w.Header().Set("WWW-Authenticate", "Bearer")
 		s.tokenErrHelper(w, errAccessDenied, "Invalid bearer token.", http.StatusUnauthorized)
 		return
 	}
-	rawIDToken := auth[len(prefix):]
+
+	// A token presented with the DPoP scheme comes with a proof of possession
+	// of the key it is bound to.
+	var dpopThumbprint string
+	if isDPoP {
+		thumbprint, err := s.verifyDPoPProof(r, rawToken)
+		if err != nil {
+			if _, ok := err.(*dpopProofError); ok {
+				s.logger.InfoContext(ctx, "rejected DPoP proof", "err", err)
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf("%s error=%q", tokenTypeDPoP, errInvalidDPoPProof))
+				s.tokenErrHelper(w, errInvalidDPoPProof, "Invalid DPoP proof.", http.StatusUnauthorized)
+				return
+			}
+			s.logger.ErrorContext(ctx, "failed to verify DPoP proof", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		dpopThumbprint = thumbprint
+	}
+
+	if !isJWT(rawToken) {
+		tok, err := s.getOpaqueAccessToken(ctx, rawToken)
+		if err != nil {
+			if err != storage.ErrNotFound {
+				s.logger.ErrorContext(r.Context(), "failed to get access token", "err", err)
+				s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+				return
+			}
+			s.tokenErrHelper(w, errAccessDenied, "Invalid bearer token.", http.StatusForbidden)
+			return
+		}
+		if !s.verifyTokenBinding(w, r, accessTokenConfirmation(tok), dpopThumbprint) {
+			return
+		}
+		client, ok := s.userInfoClient(w, r, tok.ClientID)
+		if !ok {
+			return
+		}
+		info, err := s.newUserInfo(ctx, client, tok.Claims, tok.Scopes, tok.ConnectorID)
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to build userinfo", "client_id", tok.ClientID, "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		s.writeMappedUserInfo(w, r, client, info, newClaimTemplateData(tok.Claims.UserID, info, tok.Scopes, tok.ConnectorID, nil))
+		return
+	}
 
 	verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{SkipClientIDCheck: true})
-	idToken, err := verifier.Verify(ctx, rawIDToken)
+	token, err := verifier.Verify(ctx, rawToken)
 	if err != nil {
-		s.logger.ErrorContext(r.Context(), "failed to verify ID token", "err", err)
+		s.logger.ErrorContext(r.Context(), "failed to verify access token", "err", err)
 		s.tokenErrHelper(w, errAccessDenied, "Invalid bearer token.", http.StatusForbidden)
 		return
 	}
 
-	var claims json.RawMessage
-	if err := idToken.Claims(&claims); err != nil {
+	// JWT access tokens hold the claims of the user with the claim mappings of
+	// their client already applied, so they are answered as they are.
+	if jwtType(rawToken) == accessTokenType {
+		var tok struct {
+			Confirmation *confirmation `json:"cnf"`
+		}
+		var claims map[string]json.RawMessage
+		err = token.Claims(&tok)
+		if err == nil {
+			err = token.Claims(&claims)
+		}
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to decode access token claims", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		if !s.verifyTokenBinding(w, r, tok.Confirmation, dpopThumbprint) {
+			return
+		}
+		for name := range accessTokenMetadataClaims {
+			delete(claims, name)
+		}
+		s.writeUserInfo(w, r, claims)
+		return
+	}
+
+	// Access tokens issued before dex followed RFC 9068 were copies of the ID
+	// token. They can't be told apart from ID tokens, so they are only accepted
+	// while the operator allows it, and answered with their claims, already
+	// mapped.
+	if !s.now().Before(s.legacyAccessTokensUntil) {
+		s.tokenErrHelper(w, errAccessDenied, "Invalid bearer token.", http.StatusForbidden)
+		return
+	}
+	if !s.verifyTokenBinding(w, r, nil, dpopThumbprint) {
+		return
+	}
+	var raw json.RawMessage
+	if err := token.Claims(&raw); err != nil {
 		s.logger.ErrorContext(r.Context(), "failed to decode ID token claims", "err", err)
 		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
 		return
 	}
+	s.writeUserInfo(w, r, raw)
+}
 
+// verifyTokenBinding checks that the request proves possession of the keys an
+// access token is bound to, given the thumbprint of the DPoP key it proved
+// possession of, if any. It writes an error response when it does not.
+func (s *Server) verifyTokenBinding(w http.ResponseWriter, r *http.Request, cnf *confirmation, dpopThumbprint string) bool {
+	if cnf == nil {
+		cnf = &confirmation{}
+	}
+	if cnf.X5TS256 != "" && !presentsCertificate(r, cnf.X5TS256) {
+		s.tokenErrHelper(w, errAccessDenied, "Access token is bound to a client certificate.", http.StatusForbidden)
+		return false
+	}
+	switch {
+	case cnf.JKT == "" && dpopThumbprint != "":
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf("%s error=%q", tokenTypeDPoP, errInvalidToken))
+		s.tokenErrHelper(w, errInvalidToken, "Access token is not bound to a DPoP key.", http.StatusUnauthorized)
+		return false
+	case cnf.JKT != "" && subtle.ConstantTimeCompare([]byte(cnf.JKT), []byte(dpopThumbprint)) != 1:
+		// This includes DPoP-bound tokens presented as bearer tokens.
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf("%s error=%q", tokenTypeDPoP, errInvalidToken))
+		s.tokenErrHelper(w, errInvalidToken, "Access token is bound to a DPoP key.", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+// userInfoClient gets the client an access token presented to /userinfo was
+// issued to. It writes an error response when that fails.
+func (s *Server) userInfoClient(w http.ResponseWriter, r *http.Request, clientID string) (storage.Client, bool) {
+	client, err := s.storage.GetClient(r.Context(), clientID)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to get client", "client_id", clientID, "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return storage.Client{}, false
+	}
+	return client, true
+}
+
+// writeMappedUserInfo writes a userinfo response after applying the claim
+// mappings of the client the access token was issued to.
+func (s *Server) writeMappedUserInfo(w http.ResponseWriter, r *http.Request, client storage.Client, info userInfo, data claimTemplateData) {
+	claims, err := applyClaimMappings(client.ClaimMappings, info, data)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to map userinfo claims", "client_id", client.ID, "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	s.writeUserInfo(w, r, json.RawMessage(claims))
+}
+
+func (s *Server) writeUserInfo(w http.ResponseWriter, r *http.Request, claims any) {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to marshal userinfo response", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
-	w.Write(claims)
+	w.Write(data)
 }
 
 func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, client storage.Client) {
@@ -1244,8 +1708,21 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		switch scope {
 		case scopeOpenID:
 			hasOpenIDScope = true
-		case scopeOfflineAccess, scopeEmail, scopeProfile, scopeGroups, scopeFederatedID:
+		case scopeOfflineAccess, scopeEmail, scopeProfile, scopeGroups, scopeFederatedID, s.extraClaimsScope:
 		default:
+			custom, allowed, err := s.customScopeAllowed(ctx, client.ID, scope)
+			if err != nil {
+				s.logger.ErrorContext(r.Context(), "failed to get scope", "scope", scope, "err", err)
+				s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+				return
+			}
+			if custom {
+				if !allowed {
+					invalidScopes = append(invalidScopes, scope)
+				}
+				continue
+			}
+
 			peerID, ok := parseCrossClientScope(scope)
 			if !ok {
 				unrecognized = append(unrecognized, scope)
@@ -1276,8 +1753,18 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		return
 	}
 
+	aud, err := s.accessTokenAudience(r)
+	if err != nil {
+		s.tokenErrHelper(w, errInvalidTarget, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Which connector
 	connID := s.passwordConnector
+	if !connectorAllowed(client, connID) {
+		s.tokenErrHelper(w, errInvalidRequest, "Requested connector is not allowed for this client.", http.StatusBadRequest)
+		return
+	}
 	conn, err := s.getConnector(ctx, connID)
 	if err != nil {
 		s.tokenErrHelper(w, errInvalidRequest, "Requested connector does not exist.", http.StatusBadRequest)
@@ -1313,6 +1800,7 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		return
 	}
 	s.loginLimiter.reset(limitKey)
+	authn := s.passwordAuthentication(false)
 
 	// Build the claims to send the id token
 	claims := storage.Claims{
@@ -1322,20 +1810,7 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		Email:             identity.Email,
 		EmailVerified:     identity.EmailVerified,
 		Groups:            identity.Groups,
-	}
-
-	accessToken, _, _, err := s.newAccessToken(ctx, client.ID, claims, scopes, nonce, connID)
-	if err != nil {
-		s.logger.ErrorContext(r.Context(), "password grant failed to create new access token", "err", err)
-		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
-		return
-	}
-
-	idToken, sessionID, expiry, err := s.newIDToken(ctx, client.ID, claims, scopes, nonce, accessToken, "", connID)
-	if err != nil {
-		s.logger.ErrorContext(r.Context(), "password grant failed to create new ID token", "err", err)
-		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
-		return
+		ExtraClaims:       identity.ExtraClaims,
 	}
 
 	reqRefresh := func() bool {
@@ -1354,10 +1829,31 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		}
 		return false
 	}()
+	// Opaque access tokens are revoked along with the refresh token issued
+	// with them.
+	var refreshID string
+	if reqRefresh {
+		refreshID = storage.NewID()
+	}
+
+	accessToken, expiry, err := s.newAccessToken(ctx, client, aud, claims, scopes, connID, identity.ConnectorData, refreshID)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "password grant failed to create new access token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	idToken, sessionID, _, err := s.newIDToken(ctx, client, claims, authn, scopes, nonce, accessToken, "", connID, identity.ConnectorData)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "password grant failed to create new ID token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
 	var refreshToken string
 	if reqRefresh {
 		refresh := storage.RefreshToken{
-			ID:          storage.NewID(),
+			ID:          refreshID,
 			Token:       storage.NewID(),
 			ClientID:    client.ID,
 			ConnectorID: connID,
@@ -1365,8 +1861,12 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 			Claims:      claims,
 			Nonce:       nonce,
 			// ConnectorData: authCode.ConnectorData,
-			CreatedAt: s.now(),
-			LastUsed:  s.now(),
+			Authentication: authn,
+			CreatedAt:      s.now(),
+			LastUsed:       s.now(),
+		}
+		if client.Public {
+			refresh.DPoPKeyThumbprint = dpopKeyThumbprintFromContext(ctx)
 		}
 		token := &internal.RefreshToken{
 			RefreshId: refresh.ID,
@@ -1419,6 +1919,7 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 				ConnID:        refresh.ConnectorID,
 				Refresh:       make(map[string]*storage.RefreshTokenRef),
 				ConnectorData: identity.ConnectorData,
+				ExtraClaims:   identity.ExtraClaims,
 			}
 			offlineSessions.Refresh[tokenRef.ClientID] = &tokenRef
 
@@ -1449,6 +1950,7 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 			if err := s.storage.UpdateOfflineSessions(ctx, session.UserID, session.ConnID, func(old storage.OfflineSessions) (storage.OfflineSessions, error) {
 				old.Refresh[tokenRef.ClientID] = &tokenRef
 				old.ConnectorData = identity.ConnectorData
+				old.ExtraClaims = identity.ExtraClaims
 				return old, nil
 			}); err != nil {
 				s.logger.ErrorContext(r.Context(), "failed to update offline session", "err", err)
@@ -1459,10 +1961,13 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		}
 	}
 
-	resp := s.toAccessTokenResponse(idToken, accessToken, refreshToken, expiry, sessionID, scopes)
+	resp := s.toAccessTokenResponse(ctx, client, idToken, accessToken, refreshToken, expiry, sessionID, scopes)
 	s.writeAccessToken(w, resp)
 }
 
+// handleTokenExchange exchanges a token of the upstream provider of a
+// connector, named by the non-standard connector_id parameter, for dex tokens
+// (RFC 8693). The connector verifies the subject token and returns its user.
 func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, client storage.Client) {
 	ctx := r.Context()
 
@@ -1493,7 +1998,29 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 		s.tokenErrHelper(w, errInvalidRequest, "Missing subject_token", http.StatusBadRequest)
 		return
 	}
-	// REVISAR#
+	// Other scopes pass through, but custom ones release claims only to the
+	// clients they name.
+	for _, scope := range scopes {
+		custom, allowed, err := s.customScopeAllowed(ctx, client.ID, scope)
+		if err != nil {
+			s.logger.ErrorContext(r.Context(), "failed to get scope", "scope", scope, "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		if custom && !allowed {
+			s.tokenErrHelper(w, errInvalidScope, fmt.Sprintf("Client can't request scope %q.", scope), http.StatusBadRequest)
+			return
+		}
+	}
+	aud, err := s.accessTokenAudience(r)
+	if err != nil {
+		s.tokenErrHelper(w, errInvalidTarget, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !connectorAllowed(client, connID) {
+		s.tokenErrHelper(w, errInvalidRequest, "Requested connector is not allowed for this client.", http.StatusBadRequest)
+		return
+	}
 	conn, err := s.getConnector(ctx, connID)
 	if err != nil {
 		s.logger.ErrorContext(r.Context(), "failed to get connector", "err", err)
@@ -1512,97 +2039,74 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 		s.tokenErrHelper(w, errAccessDenied, "", http.StatusUnauthorized)
 		return
 	}
+	// The user authenticated with the provider that issued the subject token.
+	authn := s.newAuthentication(amrFederated)
 
-	// keycloack compatibility: ensure sub is a valid UUID if it is a 32-char hex string
-	userID := identity.UserID
-	if len(userID) == 32 && isHex(userID) {
-		userID = fmt.Sprintf("%s-%s-%s-%s-%s", userID[0:8], userID[8:12], userID[12:16], userID[16:20], userID[20:])
-	}
-
-	// REVISAR#
+	// The user ID is kept as the connector returned it, so it matches the one
+	// of a login through the same connector. The subject format decides what
+	// the "sub" claim looks like.
 	claims := storage.Claims{
-		UserID:            userID,
+		UserID:            identity.UserID,
 		Username:          identity.Username,
 		PreferredUsername: identity.PreferredUsername,
 		Email:             identity.Email,
 		EmailVerified:     identity.EmailVerified,
 		Groups:            identity.Groups,
+		ExtraClaims:       identity.ExtraClaims,
 	}
 	resp := accessTokenResponse{
 		IssuedTokenType: requestedTokenType,
 		TokenType:       "bearer",
 	}
+	if dpopKeyThumbprintFromContext(r.Context()) != "" {
+		resp.TokenType = tokenTypeDPoP
+	}
 
-	// Always generate an access token first. This gives us the sessionID and the access token
-	// string needed to calculate at_hash for the ID Token.
-	accessToken, sessionID, expiry, err := s.newAccessToken(r.Context(), client.ID, claims, scopes, "", connID)
+	// offline_access gets a refresh token whether or not the connector can
+	// refresh identities; one that can't leaves the identity as it is. A refresh
+	// token is only minted when the response can carry it back: only the
+	// access-token branch below has a refresh_token field. For an id_token
+	// request, or an invalid requested_token_type, persisting one would leave an
+	// unreachable refresh token and offline session behind.
+	reqRefresh := requestedTokenType == tokenTypeAccess && slices.Contains(scopes, scopeOfflineAccess)
+
+	var refreshID string
+	if reqRefresh {
+		refreshID = storage.NewID()
+	}
+
+	// Always generate an access token first. The ID token needs its string to
+	// calculate at_hash.
+	accessToken, expiry, err := s.newAccessToken(r.Context(), client, aud, claims, scopes, connID, identity.ConnectorData, refreshID)
 	if err != nil {
 		s.logger.ErrorContext(r.Context(), "token exchange failed to create access token", "err", err)
 		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
 		return
 	}
 
-	// Always generate an ID Token using the access token for at_hash.
-	// We might not use it if the user only asked for an access token and didn't ask for openid scope,
-	// but simplified logic often helps consistency. However, let's stick to the spec/request:
-	// If requestedTokenType is ID Token, we return the ID Token.
-	// If requestedTokenType is Access Token, we return Access Token (and ID Token if openid scope present).
-
-	// Note: We ignore the sessionID returned by newIDToken and use the one from newAccessToken
-	// to ensure consistency if both are returned.
-	idToken, _, _, err := s.newIDToken(r.Context(), client.ID, claims, scopes, "", accessToken, "", connID)
+	// The ID token is issued even if the response leaves it out, see below.
+	idToken, sessionID, _, err := s.newIDToken(r.Context(), client, claims, authn, scopes, "", accessToken, "", connID, identity.ConnectorData)
 	if err != nil {
 		s.logger.ErrorContext(r.Context(), "token exchange failed to create id token", "err", err)
 		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
 		return
 	}
 
-	reqRefresh := func() bool {
-		// Ensure the connector supports refresh tokens.
-		//
-		// Connectors like `saml` do not implement RefreshConnector.
-		// For TokenExchange, we assume the connector (TokenIdentityConnector) essentially behaves like one
-		// or we just allow it if the client asked for it and we are okay with it.
-		// Since Token Exchange is a bit different, we might not check the connector type explicitly for RefreshConnector
-		// if the `teConn` doesn't implement it, but let's check `conn.Connector` safely.
-		if _, ok := conn.Connector.(connector.RefreshConnector); !ok {
-			// Actually, typical TokenExchange connectors might NOT implement RefreshConnector interface
-			// because they implement TokenIdentityConnector.
-			// However, if we want to issue a refresh token *from Dex*, we interpret it as Dex managing the session.
-			// Let's assume if the user asks for offline_access, we grant it if the connector allowed the identity.
-			// But sticking to safety:
-			// If we want to be strict: checks if the underlying connector supports it.
-			// If we want to be flexible for Token Exchange: we might skip this check or check if `teConn` implements it.
-			// Let's check if the standard connector interface supports it or if we should just allow it.
-			// Given this is a specific requirement for this user, let's look for offline_access scope.
-		}
-
-		// Check scopes
-		for _, scope := range scopes {
-			if scope == scopeOfflineAccess {
-				return true
-			}
-		}
-		return false
-	}() && requestedTokenType == tokenTypeAccess
-	// A refresh token is only minted when the response can carry it back: only
-	// the access-token branch below has a refresh_token field. For an id_token
-	// request, or an invalid requested_token_type that falls through to the
-	// error above, persisting one would leave an unreachable refresh token and
-	// offline session behind.
-
 	var refreshToken string
 	if reqRefresh {
 		refresh := storage.RefreshToken{
-			ID:          storage.NewID(),
-			Token:       storage.NewID(),
-			ClientID:    client.ID,
-			ConnectorID: connID,
-			Scopes:      scopes,
-			Claims:      claims,
-			Nonce:       "", // Token Exchange doesn't strictly have a nonce in the same way, or we could use one if passed? RFC 8693 doesn't emphasize nonce.
-			CreatedAt:   s.now(),
-			LastUsed:    s.now(),
+			ID:             refreshID,
+			Token:          storage.NewID(),
+			ClientID:       client.ID,
+			ConnectorID:    connID,
+			Scopes:         scopes,
+			Claims:         claims,
+			Authentication: authn,
+			CreatedAt:      s.now(),
+			LastUsed:       s.now(),
+		}
+		if client.Public {
+			refresh.DPoPKeyThumbprint = dpopKeyThumbprintFromContext(r.Context())
 		}
 		token := &internal.RefreshToken{
 			RefreshId: refresh.ID,
@@ -1647,10 +2151,10 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 				return
 			}
 			offlineSessions := storage.OfflineSessions{
-				UserID:        refresh.Claims.UserID,
-				ConnID:        refresh.ConnectorID,
-				Refresh:       make(map[string]*storage.RefreshTokenRef),
-				ConnectorData: nil, // We don't have connector data from TokenIdentity usually?
+				UserID:      refresh.Claims.UserID,
+				ConnID:      refresh.ConnectorID,
+				Refresh:     make(map[string]*storage.RefreshTokenRef),
+				ExtraClaims: refresh.Claims.ExtraClaims,
 			}
 			offlineSessions.Refresh[tokenRef.ClientID] = &tokenRef
 
@@ -1676,6 +2180,7 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 
 			if err := s.storage.UpdateOfflineSessions(r.Context(), session.UserID, session.ConnID, func(old storage.OfflineSessions) (storage.OfflineSessions, error) {
 				old.Refresh[tokenRef.ClientID] = &tokenRef
+				old.ExtraClaims = refresh.Claims.ExtraClaims
 				return old, nil
 			}); err != nil {
 				s.logger.ErrorContext(r.Context(), "failed to update offline session", "err", err)
@@ -1688,14 +2193,9 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 
 	switch requestedTokenType {
 	case tokenTypeID:
-		// If ID Token is requested, we return it in the access_token field (as per RFC 8693 for issued_token_type=id_token)
-		// But since we generated it with the accessToken we just created, it will have the at_hash of that accessToken.
-		// NOTE: The access token itself is NOT returned in this case, meaning at_hash refers to a token the client doesn't see.
-		// This might be what the user wants if they simply "want at_hash present".
-		// UPDATE: The user reported at_hash mismatch. This is because we were returning the ID Token in the access_token field.
-		// The client (or validator) hashes the content of access_token field and compares it to at_hash.
-		// Since at_hash is hash(accessToken), we MUST return accessToken in the access_token field.
-		// Even if requested_token_type is ID Token, consistent OIDC behavior often requires both if validation is needed.
+		// The ID token goes in id_token rather than access_token, as RFC 8693
+		// would have it, because its at_hash is the hash of the access token
+		// clients validate it against.
 		resp.AccessToken = accessToken
 		resp.IDToken = idToken
 		resp.ExpiresIn = int(time.Until(expiry).Seconds())
@@ -1703,16 +2203,7 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 		resp.AccessToken = accessToken
 		resp.RefreshToken = refreshToken
 		resp.ExpiresIn = int(time.Until(expiry).Seconds())
-		// If scope contains "openid", we should also return the ID Token in the id_token field.
-		// Check for "openid" scope.
-		hasOpenID := false
-		for _, s := range scopes {
-			if s == "openid" {
-				hasOpenID = true
-				break
-			}
-		}
-		if hasOpenID {
+		if slices.Contains(scopes, scopeOpenID) {
 			resp.IDToken = idToken
 		}
 	default:
@@ -1722,8 +2213,8 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 
 	resp.SessionState = sessionID
 	resp.Scope = strings.Join(scopes, " ")
-	if s.refreshTokenPolicy != nil {
-		resp.RefreshExpiresIn = int(s.refreshTokenPolicy.absoluteLifetime.Seconds())
+	if policy := s.refreshTokenPolicyForClient(client); policy != nil {
+		resp.RefreshExpiresIn = int(policy.absoluteLifetime.Seconds())
 	}
 
 	// Token response must include cache headers https://tools.ietf.org/html/rfc6749#section-5.1
@@ -1733,6 +2224,60 @@ func (s *Server) handleTokenExchange(w http.ResponseWriter, r *http.Request, cli
 	json.NewEncoder(w).Encode(resp)
 }
 
+// handleClientCredentialsGrant issues a token to the client itself. There is no
+// end user and no connector involved, so no ID or refresh token is returned.
+func (s *Server) handleClientCredentialsGrant(w http.ResponseWriter, r *http.Request, client storage.Client) {
+	ctx := r.Context()
+
+	if client.Public {
+		// A public client has no secret, so anybody could claim to be it.
+		s.tokenErrHelper(w, errUnauthorizedClient, "Public clients cannot use the client_credentials grant.", http.StatusBadRequest)
+		return
+	}
+	// Tokens without an end user must be opted into, otherwise every
+	// confidential client, including dynamically registered ones, could get them.
+	if len(client.ClientCredentialsScopes) == 0 && !slices.Contains(client.AllowedGrantTypes, grantTypeClientCredentials) {
+		s.tokenErrHelper(w, errUnauthorizedClient, "Client is not allowed to use the client_credentials grant.", http.StatusBadRequest)
+		return
+	}
+
+	scopes := strings.Fields(r.PostFormValue("scope"))
+	if len(scopes) == 0 {
+		scopes = client.ClientCredentialsScopes
+	}
+	for _, scope := range scopes {
+		if !contains(client.ClientCredentialsScopes, scope) {
+			s.logger.InfoContext(ctx, "client requested a scope it is not allowed", "client_id", client.ID, "scope", scope)
+			s.tokenErrHelper(w, errInvalidScope, fmt.Sprintf("Scope %q is not allowed for this client.", scope), http.StatusBadRequest)
+			return
+		}
+	}
+
+	audiences := r.PostForm["audience"]
+	for _, aud := range audiences {
+		if !contains(client.ClientCredentialsAudiences, aud) {
+			s.logger.InfoContext(ctx, "client requested an audience it is not allowed", "client_id", client.ID, "audience", aud)
+			s.tokenErrHelper(w, errInvalidTarget, fmt.Sprintf("Audience %q is not allowed for this client.", aud), http.StatusBadRequest)
+			return
+		}
+	}
+
+	accessToken, expiry, err := s.newClientCredentialsToken(ctx, client, scopes, audiences)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create client credentials token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.InfoContext(ctx, "client credentials token issued", "client_id", client.ID, "scopes", scopes, "audiences", audiences)
+	s.writeAccessToken(w, &accessTokenResponse{
+		AccessToken: accessToken,
+		TokenType:   accessTokenTypeFromContext(ctx),
+		ExpiresIn:   int(expiry.Sub(s.now()).Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	})
+}
+
 type accessTokenResponse struct {
 	AccessToken      string `json:"access_token"`
 	IssuedTokenType  string `json:"issued_token_type,omitempty"`
@@ -1746,10 +2291,10 @@ type accessTokenResponse struct {
 	Scope            string `json:"scope,omitempty"`
 }
 
-func (s *Server) toAccessTokenResponse(idToken, accessToken, refreshToken string, expiry time.Time, sessionID string, scopes []string) *accessTokenResponse {
+func (s *Server) toAccessTokenResponse(ctx context.Context, client storage.Client, idToken, accessToken, refreshToken string, expiry time.Time, sessionID string, scopes []string) *accessTokenResponse {
 	resp := &accessTokenResponse{
 		AccessToken:     accessToken,
-		TokenType:       "Bearer",
+		TokenType:       accessTokenTypeFromContext(ctx),
 		ExpiresIn:       int(expiry.Sub(s.now()).Seconds()),
 		RefreshToken:    refreshToken,
 		IDToken:         idToken,
@@ -1757,8 +2302,8 @@ func (s *Server) toAccessTokenResponse(idToken, accessToken, refreshToken string
 		SessionState:    sessionID,
 		Scope:           strings.Join(scopes, " "),
 	}
-	if refreshToken != "" && s.refreshTokenPolicy != nil {
-		resp.RefreshExpiresIn = int(s.refreshTokenPolicy.absoluteLifetime.Seconds())
+	if policy := s.refreshTokenPolicyForClient(client); refreshToken != "" && policy != nil {
+		resp.RefreshExpiresIn = int(policy.absoluteLifetime.Seconds())
 	}
 	return resp
 }
@@ -1800,12 +2345,3 @@ func usernamePrompt(conn connector.PasswordConnector) string {
 	}
 	return "Username"
 }
-
-func isHex(s string) bool {
-	for _, c := range s {
-		if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')) {
-			return false
-		}
-	}
-	return true
-}
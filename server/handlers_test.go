@@ -54,15 +54,22 @@ func TestHandleDiscovery(t *testing.T) {
 	err := json.NewDecoder(rr.Result().Body).Decode(&res)
 	require.NoError(t, err)
 	require.Equal(t, discovery{
-		Issuer:         httpServer.URL,
-		Auth:           fmt.Sprintf("%s/auth", httpServer.URL),
-		Token:          fmt.Sprintf("%s/token", httpServer.URL),
-		Keys:           fmt.Sprintf("%s/keys", httpServer.URL),
-		UserInfo:       fmt.Sprintf("%s/userinfo", httpServer.URL),
-		DeviceEndpoint: fmt.Sprintf("%s/device/code", httpServer.URL),
-		Introspect:     fmt.Sprintf("%s/token/introspect", httpServer.URL),
+		Issuer:            httpServer.URL,
+		Auth:              fmt.Sprintf("%s/auth", httpServer.URL),
+		Token:             fmt.Sprintf("%s/token", httpServer.URL),
+		Keys:              fmt.Sprintf("%s/keys", httpServer.URL),
+		UserInfo:          fmt.Sprintf("%s/userinfo", httpServer.URL),
+		DeviceEndpoint:    fmt.Sprintf("%s/device/code", httpServer.URL),
+		Introspect:        fmt.Sprintf("%s/token/introspect", httpServer.URL),
+		Revocation:        fmt.Sprintf("%s/token/revoke", httpServer.URL),
+		PushedAuthRequest: fmt.Sprintf("%s/par", httpServer.URL),
+		Registration:      fmt.Sprintf("%s/register", httpServer.URL),
+		RequestParameter:  true,
+		EndSession:        fmt.Sprintf("%s/logout", httpServer.URL),
+		BackchannelLogout: true,
 		GrantTypes: []string{
 			"authorization_code",
+			"client_credentials",
 			"refresh_token",
 			"urn:ietf:params:oauth:grant-type:device_code",
 			"urn:ietf:params:oauth:grant-type:token-exchange",
@@ -80,6 +87,12 @@ func TestHandleDiscovery(t *testing.T) {
 			"S256",
 			"plain",
 		},
+		RequestObjectAlgs: []string{
+			"RS256", "RS384", "RS512",
+			"ES256", "ES384", "ES512",
+			"PS256", "PS384", "PS512",
+			"EdDSA",
+		},
 		Scopes: []string{
 			"openid",
 			"email",
@@ -90,6 +103,15 @@ func TestHandleDiscovery(t *testing.T) {
 		AuthMethods: []string{
 			"client_secret_basic",
 			"client_secret_post",
+			"client_secret_jwt",
+			"private_key_jwt",
+		},
+		AuthMethodAlgs: []string{
+			"RS256", "RS384", "RS512",
+			"ES256", "ES384", "ES512",
+			"PS256", "PS384", "PS512",
+			"EdDSA",
+			"HS256", "HS384", "HS512",
 		},
 		Claims: []string{
 			"iss",
@@ -103,6 +125,26 @@ func TestHandleDiscovery(t *testing.T) {
 			"name",
 			"preferred_username",
 			"at_hash",
+			"acr",
+			"amr",
+			"auth_time",
+		},
+		ACRValues: []string{
+			"1",
+			"2",
+		},
+		UILocales: []string{
+			"de",
+			"en",
+			"es",
+			"fr",
+			"pt",
+		},
+		DPoPAlgs: []string{
+			"RS256", "RS384", "RS512",
+			"ES256", "ES384", "ES512",
+			"PS256", "PS384", "PS512",
+			"EdDSA",
 		},
 	}, res)
 }
@@ -971,6 +1013,407 @@ func TestHandleTokenExchangeOnlyStoresReachableRefreshTokens(t *testing.T) {
 	}
 }
 
+func TestHandleClientCredentials(t *testing.T) {
+	tests := []struct {
+		name      string
+		clientID  string
+		scope     string
+		audiences []string
+
+		expectedCode  int
+		expectedError string
+		expectedScope string
+		expectedAud   []string
+	}{
+		{
+			name:          "default scopes",
+			clientID:      "service",
+			expectedCode:  http.StatusOK,
+			expectedScope: "orders:read orders:write",
+			expectedAud:   []string{"service"},
+		},
+		{
+			name:          "narrowed scopes and audience",
+			clientID:      "service",
+			scope:         "orders:read",
+			audiences:     []string{"orders-api"},
+			expectedCode:  http.StatusOK,
+			expectedScope: "orders:read",
+			expectedAud:   []string{"orders-api"},
+		},
+		{
+			name:          "scope not allowed",
+			clientID:      "service",
+			scope:         "orders:read admin",
+			expectedCode:  http.StatusBadRequest,
+			expectedError: errInvalidScope,
+		},
+		{
+			name:          "audience not allowed",
+			clientID:      "service",
+			audiences:     []string{"billing-api"},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: errInvalidTarget,
+		},
+		{
+			name:          "public client",
+			clientID:      "public",
+			expectedCode:  http.StatusBadRequest,
+			expectedError: errUnauthorizedClient,
+		},
+		{
+			name:          "client not opted in",
+			clientID:      "web",
+			expectedCode:  http.StatusBadRequest,
+			expectedError: errUnauthorizedClient,
+		},
+		{
+			name:         "opted in through allowed grant types",
+			clientID:     "machine",
+			expectedCode: http.StatusOK,
+			expectedAud:  []string{"machine"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			now := time.Now()
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.Now = func() time.Time { return now }
+				c.ClientCredentialsTokensValidFor = 5 * time.Minute
+			})
+			defer httpServer.Close()
+
+			require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+				ID:                         "service",
+				Secret:                     "secret",
+				ClientCredentialsScopes:    []string{"orders:read", "orders:write"},
+				ClientCredentialsAudiences: []string{"orders-api"},
+			}))
+			require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+				ID:                      "public",
+				Secret:                  "secret",
+				Public:                  true,
+				ClientCredentialsScopes: []string{"orders:read"},
+			}))
+			require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+				ID:     "web",
+				Secret: "secret",
+			}))
+			require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+				ID:                "machine",
+				Secret:            "secret",
+				AllowedGrantTypes: []string{grantTypeClientCredentials},
+			}))
+
+			vals := make(url.Values)
+			vals.Set("grant_type", grantTypeClientCredentials)
+			setNonEmpty(vals, "scope", tc.scope)
+			vals["audience"] = tc.audiences
+
+			req := httptest.NewRequest(http.MethodPost, httpServer.URL+"/token", strings.NewReader(vals.Encode()))
+			req.Header.Set("content-type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(tc.clientID, "secret")
+
+			rr := httptest.NewRecorder()
+			s.handleToken(rr, req)
+			require.Equal(t, tc.expectedCode, rr.Code, rr.Body.String())
+
+			if tc.expectedCode != http.StatusOK {
+				var res struct{ Error string }
+				require.NoError(t, json.NewDecoder(rr.Result().Body).Decode(&res))
+				require.Equal(t, tc.expectedError, res.Error)
+				return
+			}
+
+			var res accessTokenResponse
+			require.NoError(t, json.NewDecoder(rr.Result().Body).Decode(&res))
+			require.Empty(t, res.IDToken)
+			require.Empty(t, res.RefreshToken)
+			require.Equal(t, tc.expectedScope, res.Scope)
+			require.Equal(t, 300, res.ExpiresIn)
+
+			verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{SkipClientIDCheck: true})
+			token, err := verifier.Verify(ctx, res.AccessToken)
+			require.NoError(t, err)
+			require.Equal(t, tc.clientID, token.Subject)
+			require.Equal(t, tc.expectedAud, token.Audience)
+
+			var claims struct {
+				ClientID string `json:"client_id"`
+				Scope    string `json:"scope"`
+			}
+			require.NoError(t, token.Claims(&claims))
+			require.Equal(t, tc.clientID, claims.ClientID)
+			require.Equal(t, tc.expectedScope, claims.Scope)
+
+			introspection, err := s.introspectAccessToken(ctx, res.AccessToken)
+			require.NoError(t, err)
+			require.True(t, introspection.Active)
+			require.Equal(t, tc.clientID, introspection.ClientID)
+		})
+	}
+}
+
+func TestHandleAccessToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []string
+
+		expectedCode  int
+		expectedError string
+		expectedAud   []string
+	}{
+		{
+			name:         "no resource",
+			expectedCode: http.StatusOK,
+			expectedAud:  []string{"test"},
+		},
+		{
+			name:         "resource",
+			resources:    []string{"https://orders.example.com"},
+			expectedCode: http.StatusOK,
+			expectedAud:  []string{"orders-api"},
+		},
+		{
+			name:          "unknown resource",
+			resources:     []string{"https://billing.example.com"},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: errInvalidTarget,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.PasswordConnector = "test"
+				c.Now = time.Now
+				c.Resources = map[string]string{"https://orders.example.com": "orders-api"}
+			})
+			defer httpServer.Close()
+
+			mockConnectorDataTestStorage(t, s.storage)
+
+			vals := make(url.Values)
+			vals.Set("grant_type", grantTypePassword)
+			vals.Set("scope", "openid email")
+			vals.Set("username", "test")
+			vals.Set("password", "test")
+			vals["resource"] = tc.resources
+
+			req := httptest.NewRequest(http.MethodPost, httpServer.URL+"/token", strings.NewReader(vals.Encode()))
+			req.Header.Set("content-type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth("test", "barfoo")
+
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			require.Equal(t, tc.expectedCode, rr.Code, rr.Body.String())
+
+			if tc.expectedCode != http.StatusOK {
+				var res struct{ Error string }
+				require.NoError(t, json.NewDecoder(rr.Result().Body).Decode(&res))
+				require.Equal(t, tc.expectedError, res.Error)
+				return
+			}
+
+			var res accessTokenResponse
+			require.NoError(t, json.NewDecoder(rr.Result().Body).Decode(&res))
+			require.Equal(t, accessTokenType, jwtType(res.AccessToken))
+			require.NotEqual(t, accessTokenType, jwtType(res.IDToken))
+
+			verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{SkipClientIDCheck: true})
+			token, err := verifier.Verify(ctx, res.AccessToken)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedAud, token.Audience)
+
+			var claims struct {
+				ClientID string `json:"client_id"`
+				Scope    string `json:"scope"`
+				Email    string `json:"email"`
+				Nonce    string `json:"nonce"`
+			}
+			require.NoError(t, token.Claims(&claims))
+			require.Equal(t, "test", claims.ClientID)
+			require.Equal(t, "openid email", claims.Scope)
+			require.NotEmpty(t, claims.Email)
+			require.Empty(t, claims.Nonce)
+
+			introspection, err := s.introspectAccessToken(ctx, res.AccessToken)
+			require.NoError(t, err)
+			require.True(t, introspection.Active)
+			require.Equal(t, "test", introspection.ClientID)
+			require.Equal(t, "openid email", introspection.Scope)
+
+			req = httptest.NewRequest(http.MethodGet, httpServer.URL+"/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+res.AccessToken)
+			rr = httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var info map[string]any
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &info))
+			require.Equal(t, token.Subject, info["sub"])
+			require.Equal(t, claims.Email, info["email"])
+			require.NotContains(t, info, "client_id")
+			require.NotContains(t, info, "aud")
+		})
+	}
+}
+
+func TestUserInfoLegacyAccessTokens(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		until        time.Time
+		expectedCode int
+	}{
+		{name: "accepted by default", expectedCode: http.StatusOK},
+		{name: "accepted during rollout", until: now.Add(time.Hour), expectedCode: http.StatusOK},
+		{name: "rollout over", until: now.Add(-time.Hour), expectedCode: http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.PasswordConnector = "test"
+				c.Now = func() time.Time { return now }
+				c.LegacyAccessTokensUntil = tc.until
+			})
+			defer httpServer.Close()
+			if tc.until.IsZero() {
+				require.Equal(t, now.Add(s.idTokensValidFor), s.legacyAccessTokensUntil)
+			}
+
+			mockConnectorDataTestStorage(t, s.storage)
+
+			vals := url.Values{
+				"grant_type": {grantTypePassword},
+				"scope":      {"openid email"},
+				"username":   {"test"},
+				"password":   {"test"},
+			}
+			req := httptest.NewRequest(http.MethodPost, httpServer.URL+"/token", strings.NewReader(vals.Encode()))
+			req.Header.Set("content-type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth("test", "barfoo")
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var res accessTokenResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+
+			userInfo := func(token string) int {
+				req := httptest.NewRequest(http.MethodGet, httpServer.URL+"/userinfo", nil)
+				req.Header.Set("Authorization", "Bearer "+token)
+				rr := httptest.NewRecorder()
+				s.ServeHTTP(rr, req)
+				return rr.Code
+			}
+			require.Equal(t, http.StatusOK, userInfo(res.AccessToken))
+			// ID tokens look like the access tokens issued before RFC 9068.
+			require.Equal(t, tc.expectedCode, userInfo(res.IDToken))
+		})
+	}
+}
+
+func TestHandleOpaqueAccessToken(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.PasswordConnector = "test"
+		c.Now = func() time.Time { return now }
+		c.AccessTokensValidFor = time.Hour
+	})
+	defer httpServer.Close()
+
+	mockConnectorDataTestStorage(t, s.storage)
+	require.NoError(t, s.storage.UpdateClient(ctx, "test", func(old storage.Client) (storage.Client, error) {
+		old.AccessTokenFormat = accessTokenFormatOpaque
+		return old, nil
+	}))
+
+	post := func(path string, vals url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, httpServer.URL+path, strings.NewReader(vals.Encode()))
+		req.Header.Set("content-type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("test", "barfoo")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+	introspect := func(token string) Introspection {
+		rr := post("/token/introspect", url.Values{"token": {token}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var res Introspection
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		return res
+	}
+	userInfo := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, httpServer.URL+"/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+	newToken := func() string {
+		rr := post("/token", url.Values{
+			"grant_type": {grantTypePassword},
+			"scope":      {"openid email"},
+			"username":   {"test"},
+			"password":   {"test"},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var res accessTokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		require.False(t, isJWT(res.AccessToken))
+		require.Equal(t, 3600, res.ExpiresIn)
+		return res.AccessToken
+	}
+
+	token := newToken()
+	// Only the hash of the token is stored.
+	_, err := s.storage.GetAccessToken(ctx, token)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	stored, err := s.storage.GetAccessToken(ctx, hashToken(token))
+	require.NoError(t, err)
+	require.Equal(t, "test", stored.ClientID)
+	require.Equal(t, []string{"openid", "email"}, stored.Scopes)
+
+	res := introspect(token)
+	require.True(t, res.Active)
+	require.Equal(t, "test", res.ClientID)
+	require.Equal(t, "openid email", res.Scope)
+	require.Equal(t, stored.Claims.UserID, res.Subject)
+	require.Equal(t, stored.Claims.Email, res.Extra.Email)
+	require.Equal(t, "access_token", res.TokenUse)
+
+	rr := userInfo(token)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var info map[string]any
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &info))
+	require.Equal(t, stored.Claims.UserID, info["sub"])
+	require.Equal(t, stored.Claims.Email, info["email"])
+
+	// Revocation takes effect immediately.
+	rr = post("/token/revoke", url.Values{"token": {token}})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	_, err = s.storage.GetAccessToken(ctx, hashToken(token))
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	require.False(t, introspect(token).Active)
+	require.Equal(t, http.StatusForbidden, userInfo(token).Code)
+
+	// Expired tokens are inactive until garbage collection deletes them.
+	token = newToken()
+	now = now.Add(2 * time.Hour)
+	require.False(t, introspect(token).Active)
+	require.Equal(t, http.StatusForbidden, userInfo(token).Code)
+
+	result, err := s.storage.GarbageCollect(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.AccessTokens)
+}
+
 func setNonEmpty(vals url.Values, key, value string) {
 	if value != "" {
 		vals.Set(key, value)
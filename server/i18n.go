@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/fs"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -58,10 +59,24 @@ func init() {
 
 // GetTranslations returns the translation map for the requested language.
 // It accepts full Accept-Language header values (e.g. "es-ES,es;q=0.9,en;q=0.8")
-// and falls back to English if the language is not available.
-func GetTranslations(acceptLang string) map[string]string {
+// and falls back to English if the language is not available. The language
+// tags in uiLocales, from the "ui_locales" authorization parameter, are tried
+// before the header.
+func GetTranslations(acceptLang string, uiLocales ...string) map[string]string {
+	for _, lang := range preferredLanguages(acceptLang, uiLocales...) {
+		if tr, ok := translations[lang]; ok {
+			return tr
+		}
+	}
+	return translations["en"]
+}
+
+// preferredLanguages lists the base languages of uiLocales and of an
+// Accept-Language header, most preferred first.
+func preferredLanguages(acceptLang string, uiLocales ...string) []string {
+	var langs []string
 	// Iterate through the comma-separated preference list.
-	for _, part := range strings.Split(acceptLang, ",") {
+	for _, part := range slices.Concat(uiLocales, strings.Split(acceptLang, ",")) {
 		// Strip quality value: "es-ES;q=0.9" -> "es-ES"
 		tag := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
 		// Normalize to lowercase base language: "es-ES" -> "es"
@@ -69,11 +84,11 @@ func GetTranslations(acceptLang string) map[string]string {
 		if idx := strings.IndexByte(lang, '-'); idx != -1 {
 			lang = lang[:idx]
 		}
-		if tr, ok := translations[lang]; ok {
-			return tr
+		if lang != "" {
+			langs = append(langs, lang)
 		}
 	}
-	return translations["en"]
+	return langs
 }
 
 // SupportedLanguages returns the list of language codes currently loaded.
@@ -82,5 +97,6 @@ func SupportedLanguages() []string {
 	for k := range translations {
 		langs = append(langs, k)
 	}
+	slices.Sort(langs)
 	return langs
 }
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 
 	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
 )
 
 // Introspection contains an access token's session data as specified by
@@ -75,8 +77,27 @@ type Introspection struct {
 	// TokenUse is the introspected token's use, for example `access_token` or `refresh_token`.
 	TokenUse string `json:"token_use"`
 
+	// Confirmation of a sender-constrained token. Resource servers must check
+	// that the token is presented with the certificate or DPoP key it is bound to.
+	Confirmation *confirmation `json:"cnf,omitempty"`
+
 	// Extra is arbitrary data set from the token claims.
 	Extra IntrospectionExtra `json:"ext,omitempty"`
+
+	// claimsMapped is set when the claims come from a token the claim
+	// mappings of its client were applied to when it was issued.
+	claimsMapped bool
+	// userID is the ID the connector returned for the user, if known.
+	userID string
+}
+
+// introspectionTokenType returns the token_type of an access token with the
+// given confirmation claim.
+func introspectionTokenType(cnf *confirmation) string {
+	if cnf != nil && cnf.JKT != "" {
+		return tokenTypeDPoP
+	}
+	return "Bearer"
 }
 
 type IntrospectionExtra struct {
@@ -91,6 +112,10 @@ type IntrospectionExtra struct {
 	PreferredUsername string `json:"preferred_username,omitempty"`
 
 	FederatedIDClaims *federatedIDClaims `json:"federated_claims,omitempty"`
+
+	// ExtraClaims of the user are serialized alongside the top level claims
+	// of the response, see applyClaimMappings.
+	ExtraClaims map[string]any `json:"-"`
 }
 
 type TokenTypeEnum int
@@ -156,13 +181,20 @@ func (s *Server) guessTokenType(ctx context.Context, token string) (TokenTypeEnu
 	}
 
 	verifier := oidc.NewVerifier(s.issuerURL.String(), nil, &verifierConfig)
-	if _, err := verifier.Verify(ctx, token); err != nil {
-		// If it's not an access token, let's assume it's a refresh token;
-		return RefreshToken, nil
+	if _, err := verifier.Verify(ctx, token); err == nil {
+		// If it's a valid JWT, it's an access token.
+		return AccessToken, nil
 	}
 
-	// If it's a valid JWT, it's an access token.
-	return AccessToken, nil
+	// Opaque access tokens are only known to the storage.
+	if _, err := s.storage.GetAccessToken(ctx, hashToken(token)); err == nil {
+		return AccessToken, nil
+	} else if err != storage.ErrNotFound {
+		return 0, err
+	}
+
+	// If it's not an access token, let's assume it's a refresh token;
+	return RefreshToken, nil
 }
 
 func (s *Server) getTokenFromRequest(r *http.Request) (string, TokenTypeEnum, error) {
@@ -215,13 +247,24 @@ func (s *Server) introspectRefreshToken(ctx context.Context, token string) (*Int
 		return nil, newIntrospectInternalServerError()
 	}
 
+	client, cErr := s.storage.GetClient(ctx, rCtx.storageToken.ClientID)
+	if cErr != nil {
+		s.logger.ErrorContext(ctx, "failed to get client", "client_id", rCtx.storageToken.ClientID, "err", cErr)
+		return nil, newIntrospectInternalServerError()
+	}
+	subject, sErr := s.subject(client, rCtx.storageToken.Claims.UserID, rCtx.storageToken.ConnectorID)
+	if sErr != nil {
+		s.logger.ErrorContext(ctx, "failed to derive subject", "err", sErr)
+		return nil, newIntrospectInternalServerError()
+	}
+
 	return &Introspection{
 		Active:    true,
 		ClientID:  rCtx.storageToken.ClientID,
 		IssuedAt:  rCtx.storageToken.CreatedAt.Unix(),
 		NotBefore: rCtx.storageToken.CreatedAt.Unix(),
-		Expiry:    rCtx.storageToken.CreatedAt.Add(s.refreshTokenPolicy.absoluteLifetime).Unix(),
-		Subject:   rCtx.storageToken.Claims.UserID,
+		Expiry:    rCtx.storageToken.CreatedAt.Add(rCtx.policy.absoluteLifetime).Unix(),
+		Subject:   subject,
 		Username:  rCtx.storageToken.Claims.PreferredUsername,
 		Audience:  getAudience(rCtx.storageToken.ClientID, rCtx.scopes),
 		Issuer:    s.issuerURL.String(),
@@ -233,13 +276,19 @@ func (s *Server) introspectRefreshToken(ctx context.Context, token string) (*Int
 			Groups:            rCtx.storageToken.Claims.Groups,
 			Name:              rCtx.storageToken.Claims.Username,
 			PreferredUsername: rCtx.storageToken.Claims.PreferredUsername,
+			ExtraClaims:       s.releasedExtraClaims(ctx, rCtx.storageToken.Claims, rCtx.scopes),
 		},
 		TokenType: "Bearer",
 		TokenUse:  "refresh_token",
+		userID:    rCtx.storageToken.Claims.UserID,
 	}, nil
 }
 
 func (s *Server) introspectAccessToken(ctx context.Context, token string) (*Introspection, error) {
+	if !isJWT(token) {
+		return s.introspectOpaqueAccessToken(ctx, token)
+	}
+
 	verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{SkipClientIDCheck: true})
 	idToken, err := verifier.Verify(ctx, token)
 	if err != nil {
@@ -251,13 +300,37 @@ func (s *Server) introspectAccessToken(ctx context.Context, token string) (*Intr
 		s.logger.ErrorContext(ctx, "error while fetching token claims", "err", err.Error())
 		return nil, newIntrospectInternalServerError()
 	}
-
-	clientID, err := getClientID(idToken.Audience, claims.AuthorizingParty)
-	if err != nil {
-		s.logger.ErrorContext(ctx, "error while fetching client_id from token:", "err", err.Error())
+	// Access tokens issued before dex followed RFC 9068 were copies of the ID
+	// token, and have no extra claims.
+	if jwtType(token) == accessTokenType {
+		var all map[string]any
+		if err := idToken.Claims(&all); err != nil {
+			s.logger.ErrorContext(ctx, "error while fetching token claims", "err", err.Error())
+			return nil, newIntrospectInternalServerError()
+		}
+		claims.ExtraClaims = accessTokenExtraClaims(all)
+	}
+	var atClaims struct {
+		ClientID     string        `json:"client_id"`
+		Scope        string        `json:"scope"`
+		Confirmation *confirmation `json:"cnf"`
+	}
+	if err := idToken.Claims(&atClaims); err != nil {
+		s.logger.ErrorContext(ctx, "error while fetching token claims", "err", err.Error())
 		return nil, newIntrospectInternalServerError()
 	}
 
+	// RFC 9068 access tokens name their client in client_id, older ones only
+	// in aud and azp.
+	clientID := atClaims.ClientID
+	if clientID == "" {
+		clientID, err = getClientID(idToken.Audience, claims.AuthorizingParty)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "error while fetching client_id from token:", "err", err.Error())
+			return nil, newIntrospectInternalServerError()
+		}
+	}
+
 	client, err := s.storage.GetClient(ctx, clientID)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "error while fetching client from storage", "err", err.Error())
@@ -267,6 +340,7 @@ func (s *Server) introspectAccessToken(ctx context.Context, token string) (*Intr
 	return &Introspection{
 		Active:    true,
 		ClientID:  client.ID,
+		Scope:     atClaims.Scope,
 		IssuedAt:  idToken.IssuedAt.Unix(),
 		NotBefore: idToken.IssuedAt.Unix(),
 		Expiry:    idToken.Expiry.Unix(),
@@ -275,9 +349,61 @@ func (s *Server) introspectAccessToken(ctx context.Context, token string) (*Intr
 		Audience:  idToken.Audience,
 		Issuer:    s.issuerURL.String(),
 
-		Extra:     claims,
-		TokenType: "Bearer",
-		TokenUse:  "access_token",
+		Extra:        claims,
+		TokenType:    introspectionTokenType(atClaims.Confirmation),
+		TokenUse:     "access_token",
+		Confirmation: atClaims.Confirmation,
+		claimsMapped: jwtType(token) == accessTokenType,
+	}, nil
+}
+
+func (s *Server) introspectOpaqueAccessToken(ctx context.Context, token string) (*Introspection, error) {
+	tok, err := s.getOpaqueAccessToken(ctx, token)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, newIntrospectInactiveTokenError()
+		}
+		s.logger.ErrorContext(ctx, "failed to get access token", "err", err)
+		return nil, newIntrospectInternalServerError()
+	}
+
+	client, err := s.storage.GetClient(ctx, tok.ClientID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get client", "client_id", tok.ClientID, "err", err)
+		return nil, newIntrospectInternalServerError()
+	}
+	info, err := s.newUserInfo(ctx, client, tok.Claims, tok.Scopes, tok.ConnectorID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to build access token claims", "client_id", tok.ClientID, "err", err)
+		return nil, newIntrospectInternalServerError()
+	}
+	cnf := accessTokenConfirmation(tok)
+	return &Introspection{
+		Active:    true,
+		ClientID:  tok.ClientID,
+		Scope:     strings.Join(tok.Scopes, " "),
+		IssuedAt:  tok.CreatedAt.Unix(),
+		NotBefore: tok.CreatedAt.Unix(),
+		Expiry:    tok.Expiry.Unix(),
+		Subject:   info.Subject,
+		Username:  info.PreferredUsername,
+		Audience:  tok.Audience,
+		Issuer:    s.issuerURL.String(),
+
+		Extra: IntrospectionExtra{
+			AuthorizingParty:  tok.ClientID,
+			Email:             info.Email,
+			EmailVerified:     info.EmailVerified,
+			Groups:            info.Groups,
+			Name:              info.Name,
+			PreferredUsername: info.PreferredUsername,
+			FederatedIDClaims: info.FederatedIDClaims,
+			ExtraClaims:       info.ExtraClaims,
+		},
+		TokenType:    introspectionTokenType(cnf),
+		TokenUse:     "access_token",
+		Confirmation: cnf,
+		userID:       tok.Claims.UserID,
 	}, nil
 }
 
@@ -311,7 +437,7 @@ func (s *Server) handleIntrospect(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	rawJSON, jsonErr := json.Marshal(introspect)
+	rawJSON, jsonErr := s.mapIntrospection(ctx, introspect)
 	if jsonErr != nil {
 		s.logger.ErrorContext(r.Context(), "failed to marshal introspection response", "err", jsonErr)
 		s.introspectErrHelper(w, errServerError, "", http.StatusInternalServerError)
@@ -322,6 +448,31 @@ func (s *Server) handleIntrospect(w http.ResponseWriter, r *http.Request) {
 	w.Write(rawJSON)
 }
 
+// mapIntrospection serializes an introspection response after applying the
+// claim mappings of the client the token was issued to, unless they already
+// were.
+func (s *Server) mapIntrospection(ctx context.Context, introspect *Introspection) ([]byte, error) {
+	if introspect.claimsMapped {
+		return marshalWithExtraClaims(introspect, introspect.Extra.ExtraClaims)
+	}
+	client, err := s.storage.GetClient(ctx, introspect.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %v", err)
+	}
+	info := userInfo{
+		Subject:           introspect.Subject,
+		Email:             introspect.Extra.Email,
+		EmailVerified:     introspect.Extra.EmailVerified,
+		Groups:            introspect.Extra.Groups,
+		Name:              introspect.Extra.Name,
+		PreferredUsername: introspect.Extra.PreferredUsername,
+		FederatedIDClaims: introspect.Extra.FederatedIDClaims,
+		ExtraClaims:       introspect.Extra.ExtraClaims,
+	}
+	data := newClaimTemplateData(introspect.userID, info, strings.Fields(introspect.Scope), "", nil)
+	return applyClaimMappings(client.ClaimMappings, introspect, data)
+}
+
 func (s *Server) introspectErrHelper(w http.ResponseWriter, typ string, description string, statusCode int) {
 	if typ == errInactiveToken {
 		introspectInactiveErr(w)
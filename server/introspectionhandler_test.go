@@ -150,10 +150,10 @@ func TestGetTokenFromRequestSuccess(t *testing.T) {
 	mockTestStorage(t, s.storage)
 
 	// Generate a valid RS256-signed access token
-	accessToken, _, _, err := s.newIDToken(ctx, "test", storage.Claims{
+	accessToken, _, _, err := s.newIDToken(ctx, storage.Client{ID: "test"}, storage.Claims{
 		UserID:   "1",
 		Username: "jane",
-	}, []string{"openid"}, "nonce", "", "", "test")
+	}, storage.Authentication{}, []string{"openid"}, "nonce", "", "", "test", nil)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -265,13 +265,13 @@ func TestHandleIntrospect(t *testing.T) {
 
 	mockTestStorage(t, s.storage)
 
-	activeAccessToken, _, expiry, err := s.newIDToken(ctx, "test", storage.Claims{
+	activeAccessToken, _, expiry, err := s.newIDToken(ctx, storage.Client{ID: "test"}, storage.Claims{
 		UserID:        "1",
 		Username:      "jane",
 		Email:         "jane.doe@example.com",
 		EmailVerified: true,
 		Groups:        []string{"a", "b"},
-	}, []string{"openid", "email", "profile", "groups"}, "foo", "", "", "test")
+	}, storage.Authentication{}, []string{"openid", "email", "profile", "groups"}, "foo", "", "", "test", nil)
 	require.NoError(t, err)
 
 	activeRefreshToken, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "bar"})
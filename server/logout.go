@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// handleLogout implements OpenID Connect RP-Initiated Logout 1.0.
+//
+// The relying party identifies itself with an id_token_hint, a client_id or
+// both, and may ask to be sent back to one of its registered
+// postLogoutRedirectURIs. A redirect URI is never honoured for a request that
+// cannot be tied to a client, since dex would otherwise act as an open
+// redirector.
+//
+// Without an id_token_hint any site could send the browser here, so the user
+// confirms the logout through a form first.
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		s.renderError(r, w, http.StatusMethodNotAllowed, "Method not allowed.")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		s.renderError(r, w, http.StatusBadRequest, "Failed to parse request.")
+		return
+	}
+
+	clientID := r.Form.Get("client_id")
+	redirectURI := r.Form.Get("post_logout_redirect_uri")
+
+	var hint *idTokenHint
+	if rawHint := r.Form.Get("id_token_hint"); rawHint != "" {
+		var err error
+		if hint, err = s.verifyIDTokenHint(ctx, rawHint); err != nil {
+			s.logger.InfoContext(ctx, "invalid id_token_hint in logout request", "err", err)
+			s.renderError(r, w, http.StatusBadRequest, "Invalid id_token_hint.")
+			return
+		}
+		switch {
+		case clientID == "":
+			clientID = hint.ClientID
+		case !slices.Contains(hint.Audience, clientID):
+			s.renderError(r, w, http.StatusBadRequest, "The id_token_hint was not issued to this client.")
+			return
+		}
+	}
+
+	if redirectURI != "" {
+		if clientID == "" {
+			s.renderError(r, w, http.StatusBadRequest, "A post_logout_redirect_uri requires an id_token_hint or client_id.")
+			return
+		}
+		client, err := s.storage.GetClient(ctx, clientID)
+		if err != nil {
+			if err == storage.ErrNotFound {
+				s.renderError(r, w, http.StatusBadRequest, "Unknown client.")
+				return
+			}
+			s.logger.ErrorContext(ctx, "failed to get client", "client_id", clientID, "err", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		if !slices.Contains(client.PostLogoutRedirectURIs, redirectURI) {
+			s.renderError(r, w, http.StatusBadRequest, "Unregistered post_logout_redirect_uri.")
+			return
+		}
+	}
+
+	if hint == nil && !logoutConfirmed(r) {
+		s.confirmLogout(w, r, clientID, redirectURI)
+		return
+	}
+	if _, err := r.Cookie(logoutCSRFCookieName); err == nil {
+		http.SetCookie(w, s.logoutCSRFCookie("", -1))
+	}
+
+	session := s.clearSessionCookies(w, r)
+	s.notifyLogout(ctx, session, hint)
+
+	if redirectURI == "" {
+		if err := s.templates.logout(s.brand(r, clientID), w, nil); err != nil {
+			s.logger.ErrorContext(ctx, "server template error", "err", err)
+		}
+		return
+	}
+
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		s.renderError(r, w, http.StatusInternalServerError, "Invalid post_logout_redirect_uri.")
+		return
+	}
+	if state := r.Form.Get("state"); state != "" {
+		q := u.Query()
+		q.Set("state", state)
+		u.RawQuery = q.Encode()
+	}
+	http.Redirect(w, r, u.String(), http.StatusSeeOther)
+}
+
+// logoutCSRFCookieName names the cookie holding the token the logout
+// confirmation form must post back. Another site can neither read it nor, being
+// SameSite=Strict, make the browser send it along with a forged form.
+const logoutCSRFCookieName = "dex_logout_csrf"
+
+func (s *Server) logoutCSRFCookie(token string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     logoutCSRFCookieName,
+		Value:    token,
+		Path:     s.absPath("/logout"),
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   s.issuerURL.Scheme == "https",
+		SameSite: http.SameSiteStrictMode,
+	}
+}
+
+// confirmLogout asks the user to confirm a logout request, which is only
+// carried out once the form posts back the token set in the cookie.
+func (s *Server) confirmLogout(w http.ResponseWriter, r *http.Request, clientID, redirectURI string) {
+	token := storage.NewID()
+	http.SetCookie(w, s.logoutCSRFCookie(token, 0))
+	err := s.templates.logout(s.brand(r, clientID), w, &logoutConfirmation{
+		ClientID:              clientID,
+		PostLogoutRedirectURI: redirectURI,
+		State:                 r.Form.Get("state"),
+		CSRFToken:             token,
+	})
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "server template error", "err", err)
+	}
+}
+
+// logoutConfirmed reports whether r is the confirmation form of confirmLogout.
+func logoutConfirmed(r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		return false
+	}
+	c, err := r.Cookie(logoutCSRFCookieName)
+	if err != nil || c.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.Value), []byte(r.PostForm.Get("csrf_token"))) == 1
+}
+
+// idTokenHint is what a verified id_token_hint tells about the logout request.
+type idTokenHint struct {
+	Audience audience
+	// ClientID is the client the token was issued to.
+	ClientID  string
+	Subject   string
+	SessionID string
+}
+
+// verifyIDTokenHint checks that hint is an ID token dex issued. The hint is
+// usually presented after the token expired, so expiry is not enforced.
+func (s *Server) verifyIDTokenHint(ctx context.Context, hint string) (*idTokenHint, error) {
+	verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{
+		SkipClientIDCheck: true,
+		SkipExpiryCheck:   true,
+	})
+	idToken, err := verifier.Verify(ctx, hint)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims struct {
+		AuthorizingParty string `json:"azp"`
+		SessionID        string `json:"sid"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+	clientID, err := getClientID(idToken.Audience, claims.AuthorizingParty)
+	if err != nil {
+		return nil, err
+	}
+	if clientID == "" {
+		return nil, errors.New("no client the token was issued to")
+	}
+	return &idTokenHint{
+		Audience:  idToken.Audience,
+		ClientID:  clientID,
+		Subject:   idToken.Subject,
+		SessionID: claims.SessionID,
+	}, nil
+}
+
+// notifyLogout queues back-channel logouts for the user leaving. The user is
+// whoever the ended session belonged to, or failing that the subject of the
+// id_token_hint. Every client the session logged the user in to or holding
+// refresh tokens for the user is told, along with the client the hint was
+// issued to if the hint names the same user.
+func (s *Server) notifyLogout(ctx context.Context, session storage.UserSession, hint *idTokenHint) {
+	var (
+		subjectFor func(storage.Client) (string, error)
+		sessionID  string
+		clientIDs  []string
+	)
+	addClient := func(clientID string) {
+		if !slices.Contains(clientIDs, clientID) {
+			clientIDs = append(clientIDs, clientID)
+		}
+	}
+	if session.ID != "" && session.Claims.UserID != "" {
+		subjectFor = s.subjectFor(session.Claims.UserID, session.ConnectorID)
+		sessionID = session.Authentication.SessionID
+		for _, clientID := range session.ClientIDs {
+			addClient(clientID)
+		}
+
+		offlineSessions, err := s.storage.GetOfflineSessions(ctx, session.Claims.UserID, session.ConnectorID)
+		switch {
+		case err == nil:
+			for clientID := range offlineSessions.Refresh {
+				addClient(clientID)
+			}
+		case err != storage.ErrNotFound:
+			s.logger.ErrorContext(ctx, "failed to get offline sessions", "err", err)
+		}
+	}
+	if hint != nil && hint.Subject != "" {
+		switch {
+		case subjectFor == nil:
+			// The hint carries the subject as the client it was issued to knows it.
+			subjectFor = func(storage.Client) (string, error) { return hint.Subject, nil }
+			sessionID = hint.SessionID
+			addClient(hint.ClientID)
+		case s.isSubjectOf(ctx, subjectFor, hint):
+			addClient(hint.ClientID)
+		}
+	}
+	if subjectFor == nil || len(clientIDs) == 0 {
+		return
+	}
+	s.backchannelLogout(ctx, subjectFor, sessionID, clientIDs)
+}
+
+// isSubjectOf reports whether the user subjectFor derives subjects for is the
+// subject of hint. A hint for another user, say one the browser logged in as
+// earlier, must not get that user a logout token under the session user's
+// subject.
+func (s *Server) isSubjectOf(ctx context.Context, subjectFor func(storage.Client) (string, error), hint *idTokenHint) bool {
+	client, err := s.storage.GetClient(ctx, hint.ClientID)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get client", "client_id", hint.ClientID, "err", err)
+		}
+		return false
+	}
+	subject, err := subjectFor(client)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to derive subject", "client_id", hint.ClientID, "err", err)
+		return false
+	}
+	return subject == hint.Subject
+}
+
+// clearSessionCookies expires every cookie dex keeps in the browser, so the
+// next visit to /auth starts from a clean slate. It returns the single sign-on
+// session that was ended, if there was one.
+func (s *Server) clearSessionCookies(w http.ResponseWriter, r *http.Request) storage.UserSession {
+	session, hadCookie := s.endSession(r.Context(), r)
+	if hadCookie {
+		http.SetCookie(w, s.sessionCookie("", -1))
+	}
+	for _, c := range r.Cookies() {
+		if strings.HasPrefix(c.Name, mfaTrustCookiePrefix) {
+			// The name is already sanitized, so it maps back onto itself.
+			s.clearMFATrustCookie(w, strings.TrimPrefix(c.Name, mfaTrustCookiePrefix))
+		}
+	}
+	return session
+}
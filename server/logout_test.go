@@ -0,0 +1,210 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestHandleLogout(t *testing.T) {
+	ctx := t.Context()
+
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:                     "app",
+		Secret:                 "secret",
+		RedirectURIs:           []string{"https://app.example.com/callback"},
+		PostLogoutRedirectURIs: []string{"https://app.example.com/logged-out"},
+	}))
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:     "other",
+		Secret: "secret",
+	}))
+
+	idToken, _, _, err := s.newIDToken(ctx, storage.Client{ID: "app"}, storage.Claims{
+		UserID:   "1",
+		Username: "jane",
+	}, storage.Authentication{}, []string{"openid"}, "nonce", "", "", "mock", nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		query        url.Values
+		wantCode     int
+		wantLocation string
+		wantConfirm  bool
+	}{
+		{
+			name:        "no parameters",
+			wantCode:    http.StatusOK,
+			wantConfirm: true,
+		},
+		{
+			name: "redirect with id_token_hint",
+			query: url.Values{
+				"id_token_hint":            {idToken},
+				"post_logout_redirect_uri": {"https://app.example.com/logged-out"},
+				"state":                    {"xyz"},
+			},
+			wantCode:     http.StatusSeeOther,
+			wantLocation: "https://app.example.com/logged-out?state=xyz",
+		},
+		{
+			name: "redirect with client_id",
+			query: url.Values{
+				"client_id":                {"app"},
+				"post_logout_redirect_uri": {"https://app.example.com/logged-out"},
+			},
+			wantCode:    http.StatusOK,
+			wantConfirm: true,
+		},
+		{
+			name: "unregistered redirect",
+			query: url.Values{
+				"id_token_hint":            {idToken},
+				"post_logout_redirect_uri": {"https://evil.example.com/"},
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "redirect without client",
+			query: url.Values{
+				"post_logout_redirect_uri": {"https://app.example.com/logged-out"},
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "hint issued to another client",
+			query: url.Values{
+				"id_token_hint": {idToken},
+				"client_id":     {"other"},
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "malformed hint",
+			query: url.Values{
+				"id_token_hint": {"not-a-jwt"},
+			},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/logout?"+tc.query.Encode(), nil))
+
+			require.Equal(t, tc.wantCode, rr.Code, rr.Body.String())
+			require.Equal(t, tc.wantLocation, rr.Header().Get("Location"))
+			require.Equal(t, tc.wantConfirm, responseCookie(rr, logoutCSRFCookieName) != nil)
+		})
+	}
+}
+
+// responseCookie returns the cookie named name that rr sets, or nil.
+func responseCookie(rr *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, c := range rr.Result().Cookies() {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+var csrfTokenInput = regexp.MustCompile(`name="csrf_token" value="([^"]+)"`)
+
+// confirmedLogout sends a logout request without id_token_hint and answers the
+// confirmation form it gets, as the user's browser would.
+func confirmedLogout(t *testing.T, s *Server, query url.Values, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, "/logout?"+query.Encode(), nil)
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, r)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	csrfCookie := responseCookie(rr, logoutCSRFCookieName)
+	require.NotNil(t, csrfCookie)
+	m := csrfTokenInput.FindStringSubmatch(rr.Body.String())
+	require.NotNil(t, m, rr.Body.String())
+
+	form := url.Values{"csrf_token": {m[1]}}
+	for k, v := range query {
+		form[k] = v
+	}
+	r = httptest.NewRequest(http.MethodPost, "/logout", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, c := range append(cookies, csrfCookie) {
+		r.AddCookie(c)
+	}
+	rr = httptest.NewRecorder()
+	s.ServeHTTP(rr, r)
+	return rr
+}
+
+func TestHandleLogoutConfirmation(t *testing.T) {
+	s, _ := newSessionTestServer(t)
+	require.NoError(t, s.storage.UpdateClient(t.Context(), "app1", func(old storage.Client) (storage.Client, error) {
+		old.PostLogoutRedirectURIs = []string{"https://app1.example.com/logged-out"}
+		return old, nil
+	}))
+	cookie := login(t, s, "app1")
+	query := url.Values{
+		"client_id":                {"app1"},
+		"post_logout_redirect_uri": {"https://app1.example.com/logged-out"},
+		"state":                    {"xyz"},
+	}
+
+	// A page of another site can send the browser to /logout, or post a form
+	// to it, but can't know the token of the confirmation form.
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		form := url.Values{"csrf_token": {"guessed"}}
+		for k, v := range query {
+			form[k] = v
+		}
+		r := httptest.NewRequest(method, "/logout?"+form.Encode(), nil)
+		r.AddCookie(cookie)
+		r.AddCookie(&http.Cookie{Name: logoutCSRFCookieName, Value: "other"})
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, r)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Empty(t, rr.Header().Get("Location"))
+		require.Nil(t, responseCookie(rr, sessionCookieName))
+		_, err := s.storage.GetUserSession(t.Context(), cookie.Value)
+		require.NoError(t, err, method)
+	}
+
+	rr := confirmedLogout(t, s, query, cookie)
+	require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+	require.Equal(t, "https://app1.example.com/logged-out?state=xyz", rr.Header().Get("Location"))
+	require.Negative(t, responseCookie(rr, logoutCSRFCookieName).MaxAge)
+	_, err := s.storage.GetUserSession(t.Context(), cookie.Value)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestHandleLogoutClearsCookies(t *testing.T) {
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	rr := confirmedLogout(t, s, nil,
+		&http.Cookie{Name: mfaTrustCookieName("keystone"), Value: "gAAAAA-token"},
+		&http.Cookie{Name: "unrelated", Value: "keep"})
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	require.Nil(t, responseCookie(rr, "unrelated"))
+	cookie := responseCookie(rr, mfaTrustCookieName("keystone"))
+	require.NotNil(t, cookie)
+	require.Empty(t, cookie.Value)
+	require.Negative(t, cookie.MaxAge)
+}
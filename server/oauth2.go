@@ -14,6 +14,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -110,6 +111,12 @@ const (
 	errInvalidGrant            = "invalid_grant"
 	errInvalidClient           = "invalid_client"
 	errInactiveToken           = "inactive_token"
+	errUnsupportedTokenType    = "unsupported_token_type"
+	errInvalidTarget           = "invalid_target"
+	errInvalidRequestObject    = "invalid_request_object"
+	errInvalidDPoPProof        = "invalid_dpop_proof"
+	errLoginRequired           = "login_required"
+	errConsentRequired         = "consent_required"
 )
 
 const (
@@ -122,6 +129,20 @@ const (
 	scopeCrossClientPrefix = "audience:server:client_id:"
 )
 
+// Values of the OpenID Connect "prompt" parameter, which may hold several
+// separated by spaces.
+const (
+	promptNone          = "none"           // Fail instead of showing any page.
+	promptLogin         = "login"          // Authenticate again.
+	promptConsent       = "consent"        // Show the approval screen.
+	promptSelectAccount = "select_account" // Offer every connector.
+)
+
+// hasPrompt reports whether the "prompt" parameter contains value.
+func hasPrompt(prompt, value string) bool {
+	return slices.Contains(strings.Fields(prompt), value)
+}
+
 const (
 	deviceCallbackURI = "/device/callback"
 )
@@ -137,6 +158,7 @@ const (
 	grantTypePassword          = "password"
 	grantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
 	grantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	grantTypeClientCredentials = "client_credentials"
 )
 
 const (
@@ -231,6 +253,15 @@ func (a audience) MarshalJSON() ([]byte, error) {
 	return json.Marshal([]string(a))
 }
 
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(a))
+}
+
 type idTokenClaims struct {
 	Issuer           string   `json:"iss"`
 	Subject          string   `json:"sub"`
@@ -240,6 +271,10 @@ type idTokenClaims struct {
 	AuthorizingParty string   `json:"azp,omitempty"`
 	Nonce            string   `json:"nonce,omitempty"`
 
+	// How and when the user authenticated.
+	AuthTime int64    `json:"auth_time,omitempty"`
+	AMR      []string `json:"amr,omitempty"`
+
 	AccessTokenHash string `json:"at_hash,omitempty"`
 	CodeHash        string `json:"c_hash,omitempty"`
 
@@ -265,8 +300,323 @@ type federatedIDClaims struct {
 	UserID      string `json:"user_id,omitempty"`
 }
 
-func (s *Server) newAccessToken(ctx context.Context, clientID string, claims storage.Claims, scopes []string, nonce, connID string) (accessToken, sessionID string, expiry time.Time, err error) {
-	return s.newIDToken(ctx, clientID, claims, scopes, nonce, storage.NewID(), "", connID)
+// accessTokenType is the JWS "typ" header of access tokens, which lets resource
+// servers tell them apart from ID tokens. See RFC 9068, section 2.1.
+const accessTokenType = "at+jwt"
+
+// userInfo holds the claims about the user that an access token carries and
+// that /userinfo returns.
+type userInfo struct {
+	Subject string `json:"sub"`
+
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+
+	Groups []string `json:"groups,omitempty"`
+
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+
+	FederatedIDClaims *federatedIDClaims `json:"federated_claims,omitempty"`
+
+	// ExtraClaims are serialized alongside the other claims, see
+	// applyClaimMappings.
+	ExtraClaims map[string]any `json:"-"`
+}
+
+func (s *Server) newUserInfo(ctx context.Context, client storage.Client, claims storage.Claims, scopes []string, connID string) (userInfo, error) {
+	subject, err := s.subject(client, claims.UserID, connID)
+	if err != nil {
+		return userInfo{}, err
+	}
+	info := userInfo{Subject: subject, ExtraClaims: s.releasedExtraClaims(ctx, claims, scopes)}
+	for _, scope := range scopes {
+		switch scope {
+		case scopeEmail:
+			info.Email = claims.Email
+			info.EmailVerified = &claims.EmailVerified
+		case scopeGroups:
+			info.Groups = claims.Groups
+		case scopeProfile:
+			info.Name = claims.Username
+			info.PreferredUsername = claims.PreferredUsername
+		case scopeFederatedID:
+			info.FederatedIDClaims = &federatedIDClaims{
+				ConnectorID: connID,
+				UserID:      claims.UserID,
+			}
+		}
+	}
+	return info, nil
+}
+
+// accessTokenClaims follows the JWT profile for access tokens of RFC 9068.
+type accessTokenClaims struct {
+	Issuer   string   `json:"iss"`
+	Audience audience `json:"aud"`
+	Expiry   int64    `json:"exp"`
+	IssuedAt int64    `json:"iat"`
+	JTI      string   `json:"jti"`
+	ClientID string   `json:"client_id"`
+	Scope    string   `json:"scope,omitempty"`
+
+	Confirmation *confirmation `json:"cnf,omitempty"`
+
+	userInfo
+}
+
+// accessTokenClaimNames are the claims accessTokenClaims is serialized to,
+// besides the extra claims of the user.
+var accessTokenClaimNames = map[string]bool{
+	"iss": true, "aud": true, "exp": true, "iat": true, "jti": true,
+	"client_id": true, "scope": true, "cnf": true,
+	"sub": true, "email": true, "email_verified": true, "groups": true,
+	"name": true, "preferred_username": true, "federated_claims": true,
+}
+
+// accessTokenMetadataClaims are the claims of a JWT access token about the
+// token itself rather than its user.
+var accessTokenMetadataClaims = map[string]bool{
+	"iss": true, "aud": true, "exp": true, "iat": true, "jti": true,
+	"client_id": true, "scope": true, "cnf": true,
+}
+
+// accessTokenExtraClaims returns the extra claims of the user among the claims
+// of a JWT access token.
+func accessTokenExtraClaims(claims map[string]any) map[string]any {
+	var extra map[string]any
+	for name, v := range claims {
+		if accessTokenClaimNames[name] {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]any)
+		}
+		extra[name] = v
+	}
+	return extra
+}
+
+// confirmation is the "cnf" claim (RFC 7800) of a sender-constrained access
+// token, naming the key its holder must prove possession of.
+type confirmation struct {
+	// Thumbprint of the client certificate the token is bound to (RFC 8705).
+	X5TS256 string `json:"x5t#S256,omitempty"`
+	// JWK thumbprint of the DPoP key the token is bound to (RFC 9449).
+	JKT string `json:"jkt,omitempty"`
+}
+
+// newConfirmation returns the confirmation claim binding the tokens issued
+// during a request to the keys the client proved possession of, or nil.
+func newConfirmation(ctx context.Context) *confirmation {
+	cnf := confirmation{
+		X5TS256: certificateThumbprintFromContext(ctx),
+		JKT:     dpopKeyThumbprintFromContext(ctx),
+	}
+	if cnf == (confirmation{}) {
+		return nil
+	}
+	return &cnf
+}
+
+// accessTokenConfirmation returns the confirmation claim of an opaque access
+// token, or nil if it is not sender-constrained.
+func accessTokenConfirmation(tok storage.AccessToken) *confirmation {
+	cnf := confirmation{
+		X5TS256: tok.CertificateThumbprint,
+		JKT:     tok.DPoPKeyThumbprint,
+	}
+	if cnf == (confirmation{}) {
+		return nil
+	}
+	return &cnf
+}
+
+// Access token formats a client can choose with storage.Client.AccessTokenFormat.
+const (
+	accessTokenFormatJWT    = "jwt"
+	accessTokenFormatOpaque = "opaque"
+)
+
+func validAccessTokenFormat(format string) bool {
+	switch format {
+	case "", accessTokenFormatJWT, accessTokenFormatOpaque:
+		return true
+	}
+	return false
+}
+
+// newAccessToken issues an access token for the user in claims. The audience
+// defaults to the client, plus any peers requested with cross-client scopes.
+// JWT access tokens carry the same mapped claims as the ID token. Opaque access
+// tokens remember refreshID, the refresh token issued along with them, if any.
+func (s *Server) newAccessToken(ctx context.Context, client storage.Client, aud audience, claims storage.Claims, scopes []string, connID string, connData []byte, refreshID string) (accessToken string, expiry time.Time, err error) {
+	if len(aud) == 0 {
+		aud = getAudience(client.ID, scopes)
+	}
+	issuedAt := s.now()
+	expiry = issuedAt.Add(s.accessTokensValidForClient(client))
+
+	if client.AccessTokenFormat == accessTokenFormatOpaque {
+		accessToken, err = s.newOpaqueAccessToken(ctx, storage.AccessToken{
+			ClientID:    client.ID,
+			Scopes:      scopes,
+			Audience:    aud,
+			ConnectorID: connID,
+			Claims:      claims,
+
+			RefreshTokenID:        refreshID,
+			CertificateThumbprint: certificateThumbprintFromContext(ctx),
+			DPoPKeyThumbprint:     dpopKeyThumbprintFromContext(ctx),
+			CreatedAt:             issuedAt,
+			Expiry:                expiry,
+		})
+		return accessToken, expiry, err
+	}
+
+	info, err := s.newUserInfo(ctx, client, claims, scopes, connID)
+	if err != nil {
+		return "", expiry, err
+	}
+	accessToken, err = s.signAccessToken(ctx, client.ClaimMappings, accessTokenClaims{
+		Issuer:   s.issuerURL.String(),
+		Audience: aud,
+		Expiry:   expiry.Unix(),
+		IssuedAt: issuedAt.Unix(),
+		ClientID: client.ID,
+		Scope:    strings.Join(scopes, " "),
+
+		Confirmation: newConfirmation(ctx),
+		userInfo:     info,
+	}, newClaimTemplateData(claims.UserID, info, scopes, connID, connData))
+	return accessToken, expiry, err
+}
+
+// newClientCredentialsToken issues an access token whose subject is the client
+// itself. Without requested audiences the client is the audience as well.
+func (s *Server) newClientCredentialsToken(ctx context.Context, client storage.Client, scopes, audiences []string) (accessToken string, expiry time.Time, err error) {
+	aud := audience(audiences)
+	if len(aud) == 0 {
+		aud = audience{client.ID}
+	}
+	issuedAt := s.now()
+	expiry = issuedAt.Add(s.clientCredentialsTokensValidFor)
+
+	if client.AccessTokenFormat == accessTokenFormatOpaque {
+		accessToken, err = s.newOpaqueAccessToken(ctx, storage.AccessToken{
+			ClientID: client.ID,
+			Scopes:   scopes,
+			Audience: aud,
+			Claims:   storage.Claims{UserID: client.ID},
+
+			CertificateThumbprint: certificateThumbprintFromContext(ctx),
+			DPoPKeyThumbprint:     dpopKeyThumbprintFromContext(ctx),
+			CreatedAt:             issuedAt,
+			Expiry:                expiry,
+		})
+		return accessToken, expiry, err
+	}
+
+	info := userInfo{Subject: client.ID}
+	accessToken, err = s.signAccessToken(ctx, client.ClaimMappings, accessTokenClaims{
+		Issuer:   s.issuerURL.String(),
+		Audience: aud,
+		Expiry:   expiry.Unix(),
+		IssuedAt: issuedAt.Unix(),
+		ClientID: client.ID,
+		Scope:    strings.Join(scopes, " "),
+
+		Confirmation: newConfirmation(ctx),
+		userInfo:     info,
+	}, newClaimTemplateData(client.ID, info, scopes, "", nil))
+	return accessToken, expiry, err
+}
+
+// newOpaqueAccessToken stores tok under the hash of a new random token, which
+// is handed to the client.
+func (s *Server) newOpaqueAccessToken(ctx context.Context, tok storage.AccessToken) (string, error) {
+	token := storage.NewID() + storage.NewID()
+	tok.ID = hashToken(token)
+	if err := s.storage.CreateAccessToken(ctx, tok); err != nil {
+		return "", fmt.Errorf("failed to store access token: %v", err)
+	}
+	return token, nil
+}
+
+// getOpaqueAccessToken resolves an opaque access token. Expired tokens that
+// garbage collection has not deleted yet are reported as not found.
+func (s *Server) getOpaqueAccessToken(ctx context.Context, token string) (storage.AccessToken, error) {
+	tok, err := s.storage.GetAccessToken(ctx, hashToken(token))
+	if err != nil {
+		return storage.AccessToken{}, err
+	}
+	if s.now().After(tok.Expiry) {
+		return storage.AccessToken{}, storage.ErrNotFound
+	}
+	return tok, nil
+}
+
+// signAccessToken signs tok after applying the claim mappings of its client,
+// so resource servers and /userinfo see the claims the ID token has.
+func (s *Server) signAccessToken(ctx context.Context, mappings []storage.ClaimMapping, tok accessTokenClaims, data claimTemplateData) (string, error) {
+	tok.JTI = uuid.New().String()
+	// Clients may drop jti from ID tokens, but RFC 9068 requires it in access
+	// tokens.
+	mappings = slices.DeleteFunc(slices.Clone(mappings), func(m storage.ClaimMapping) bool {
+		return m.Claim == "jti"
+	})
+	payload, err := applyClaimMappings(mappings, tok, data)
+	if err != nil {
+		return "", fmt.Errorf("could not serialize claims: %v", err)
+	}
+	accessToken, err := s.signer.SignWithType(ctx, accessTokenType, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign payload: %v", err)
+	}
+	return accessToken, nil
+}
+
+// accessTokenAudience maps the resource indicators (RFC 8707) of a token
+// request to the audience of the access token. It returns nil if the request
+// names no resource.
+func (s *Server) accessTokenAudience(r *http.Request) (audience, error) {
+	var aud audience
+	for _, resource := range r.PostForm["resource"] {
+		a, ok := s.resources[resource]
+		if !ok {
+			return nil, fmt.Errorf("unknown resource %q", resource)
+		}
+		if !aud.contains(a) {
+			aud = append(aud, a)
+		}
+	}
+	return aud, nil
+}
+
+// isJWT reports whether token has the shape of a compact serialized JWT. Opaque
+// access tokens and refresh tokens never contain dots.
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// jwtType returns the "typ" header of a compact serialized JWT, without
+// verifying it.
+func jwtType(token string) string {
+	header, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return ""
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(header)
+	if err != nil {
+		return ""
+	}
+	var h struct {
+		Type string `json:"typ"`
+	}
+	if err := json.Unmarshal(raw, &h); err != nil {
+		return ""
+	}
+	return h.Type
 }
 
 func getClientID(aud audience, azp string) (string, error) {
@@ -274,6 +624,11 @@ func getClientID(aud audience, azp string) (string, error) {
 	case 0:
 		return "", fmt.Errorf("no audience is set, could not find ClientID")
 	case 1:
+		if azp != "" {
+			// A client_credentials token may be issued for another audience,
+			// but always names the client in azp.
+			return azp, nil
+		}
 		return aud[0], nil
 	default:
 		return azp, nil
@@ -312,21 +667,36 @@ func genSubject(userID string, connID string) (string, error) {
 	return internal.Marshal(sub)
 }
 
-func (s *Server) newIDToken(ctx context.Context, clientID string, claims storage.Claims, scopes []string, nonce, accessToken, code, connID string) (idToken, sessionID string, expiry time.Time, err error) {
+func (s *Server) newIDToken(ctx context.Context, client storage.Client, claims storage.Claims, authn storage.Authentication, scopes []string, nonce, accessToken, code, connID string, connData []byte) (idToken, sessionID string, expiry time.Time, err error) {
 	issuedAt := s.now()
-	expiry = issuedAt.Add(s.idTokensValidFor)
+	expiry = issuedAt.Add(s.idTokensValidForClient(client))
+
+	subject, err := s.subject(client, claims.UserID, connID)
+	if err != nil {
+		return "", "", expiry, err
+	}
 
-	sessionID = uuid.New().String()
+	// Tokens issued before dex recorded the login's session ID get one of their
+	// own.
+	sessionID = authn.SessionID
+	if sessionID == "" {
+		sessionID = uuid.New().String()
+	}
 	tok := idTokenClaims{
 		Issuer:    s.issuerURL.String(),
-		Subject:   claims.UserID, // Use UserID directly, no base64
+		Subject:   subject,
 		Nonce:     nonce,
 		Expiry:    expiry.Unix(),
 		IssuedAt:  issuedAt.Unix(),
 		JTI:       uuid.New().String(),
 		Type:      "ID",
 		SessionID: sessionID,
-		ACR:       "1",
+		ACR:       authn.ACR,
+		AMR:       authn.AMR,
+	}
+	// Tokens issued before dex recorded the authentication time lack it.
+	if !authn.Time.IsZero() {
+		tok.AuthTime = authn.Time.Unix()
 	}
 
 	// Determine signing algorithm from signer
@@ -377,7 +747,7 @@ func (s *Server) newIDToken(ctx context.Context, clientID string, claims storage
 				// initial auth request.
 				continue
 			}
-			isTrusted, err := s.validateCrossClientTrust(ctx, clientID, peerID)
+			isTrusted, err := s.validateCrossClientTrust(ctx, client.ID, peerID)
 			if err != nil {
 				return "", "", expiry, err
 			}
@@ -388,10 +758,15 @@ func (s *Server) newIDToken(ctx context.Context, clientID string, claims storage
 		}
 	}
 
-	tok.Audience = getAudience(clientID, scopes)
-	tok.AuthorizingParty = clientID
+	tok.Audience = getAudience(client.ID, scopes)
+	tok.AuthorizingParty = client.ID
 
-	payload, err := json.Marshal(tok)
+	info, err := s.newUserInfo(ctx, client, claims, scopes, connID)
+	if err != nil {
+		return "", "", expiry, err
+	}
+	data := newClaimTemplateData(claims.UserID, info, scopes, connID, connData)
+	payload, err := applyClaimMappings(client.ClaimMappings, tok, data)
 	if err != nil {
 		return "", "", expiry, fmt.Errorf("could not serialize claims: %v", err)
 	}
@@ -408,7 +783,29 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 	if err := r.ParseForm(); err != nil {
 		return nil, newDisplayedErr(http.StatusBadRequest, "Failed to parse request.")
 	}
-	q := r.Form
+
+	// Later steps, like the session checks, read the parameters from the form.
+	pushed := r.Form.Get("request_uri") != ""
+	switch {
+	case pushed:
+		q, err := s.pushedAuthorizationParams(ctx, r.Form)
+		if err != nil {
+			return nil, err
+		}
+		r.Form = q
+	case r.Form.Get("request") != "":
+		q, err := s.requestObjectParams(ctx, r.Form)
+		if err != nil {
+			return nil, err
+		}
+		r.Form = q
+	}
+	return s.validateAuthorizationRequest(ctx, r.Form, pushed)
+}
+
+// validateAuthorizationRequest validates the parameters of an authorization
+// request. pushed reports whether they came through the PAR endpoint.
+func (s *Server) validateAuthorizationRequest(ctx context.Context, q url.Values, pushed bool) (*storage.AuthRequest, error) {
 	redirectURI, err := url.QueryUnescape(q.Get("redirect_uri"))
 	if err != nil {
 		return nil, newDisplayedErr(http.StatusBadRequest, "No redirect_uri provided.")
@@ -432,18 +829,21 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 	client, err := s.storage.GetClient(ctx, clientID)
 	if err != nil {
 		if err == storage.ErrNotFound {
-			s.logger.ErrorContext(r.Context(), "invalid client_id provided", "client_id", clientID)
+			s.logger.ErrorContext(ctx, "invalid client_id provided", "client_id", clientID)
 			return nil, newDisplayedErr(http.StatusNotFound, "Invalid client_id.")
 		}
-		s.logger.ErrorContext(r.Context(), "failed to get client", "err", err)
+		s.logger.ErrorContext(ctx, "failed to get client", "err", err)
 		return nil, newDisplayedErr(http.StatusInternalServerError, "Database error.")
 	}
 
 	if !validateRedirectURI(client, redirectURI) {
-		s.logger.ErrorContext(r.Context(), "unregistered redirect_uri", "redirect_uri", redirectURI, "client_id", clientID)
+		s.logger.ErrorContext(ctx, "unregistered redirect_uri", "redirect_uri", redirectURI, "client_id", clientID)
 		return nil, newDisplayedErr(http.StatusBadRequest, "Unregistered redirect_uri.")
 	}
-	if redirectURI == deviceCallbackURI && client.Public {
+	// Dex itself makes the authorization requests of the device flow, whose
+	// restrictions were checked when the device code was issued.
+	deviceFlow := redirectURI == deviceCallbackURI
+	if deviceFlow && client.Public {
 		redirectURI = s.absPath(deviceCallbackURI)
 	}
 
@@ -452,23 +852,21 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 		return &redirectedAuthErr{state, redirectURI, typ, fmt.Sprintf(format, a...)}
 	}
 
+	if client.RequirePushedAuthorizationRequests && !pushed {
+		return nil, newRedirectedErr(errInvalidRequest, "Client requires pushed authorization requests.")
+	}
+
 	if connectorID != "" {
 		connectors, err := s.storage.ListConnectors(ctx)
 		if err != nil {
-			s.logger.ErrorContext(r.Context(), "failed to list connectors", "err", err)
+			s.logger.ErrorContext(ctx, "failed to list connectors", "err", err)
 			return nil, newRedirectedErr(errServerError, "Unable to retrieve connectors")
 		}
-		if !validateConnectorID(connectors, connectorID) {
+		if !validateConnectorID(connectors, connectorID) || !connectorAllowed(client, connectorID) {
 			return nil, newRedirectedErr(errInvalidRequest, "Invalid ConnectorID")
 		}
 	}
 
-	// dex doesn't support request parameter and must return request_not_supported error
-	// https://openid.net/specs/openid-connect-core-1_0.html#6.1
-	if q.Get("request") != "" {
-		return nil, newRedirectedErr(errRequestNotSupported, "Server does not support request parameter.")
-	}
-
 	if codeChallengeMethod != codeChallengeMethodS256 && codeChallengeMethod != codeChallengeMethodPlain {
 		description := fmt.Sprintf("Unsupported PKCE challenge method (%q).", codeChallengeMethod)
 		return nil, newRedirectedErr(errInvalidRequest, "%s", description)
@@ -483,15 +881,27 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 		switch scope {
 		case scopeOpenID:
 			hasOpenIDScope = true
-		case scopeOfflineAccess, scopeEmail, scopeProfile, scopeGroups, scopeFederatedID:
+		case scopeOfflineAccess, scopeEmail, scopeProfile, scopeGroups, scopeFederatedID, s.extraClaimsScope:
 		default:
+			custom, allowed, err := s.customScopeAllowed(ctx, clientID, scope)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "failed to get scope", "scope", scope, "err", err)
+				return nil, newRedirectedErr(errServerError, "Internal server error.")
+			}
+			if custom {
+				if !allowed {
+					invalidScopes = append(invalidScopes, scope)
+				}
+				continue
+			}
+
 			peerID, ok := parseCrossClientScope(scope)
 			if !ok {
 				unrecognized = append(unrecognized, scope)
 				continue
 			}
 
-			isTrusted, err := s.validateCrossClientTrust(r.Context(), clientID, peerID)
+			isTrusted, err := s.validateCrossClientTrust(ctx, clientID, peerID)
 			if err != nil {
 				return nil, newRedirectedErr(errServerError, "Internal server error.")
 			}
@@ -531,6 +941,9 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 		if !s.supportedResponseTypes[responseType] {
 			return nil, newRedirectedErr(errUnsupportedResponseType, "Unsupported response type %q", responseType)
 		}
+		if !deviceFlow && !responseTypeAllowed(client, responseType) {
+			return nil, newRedirectedErr(errUnauthorizedClient, "Client can't use response type %q", responseType)
+		}
 	}
 
 	if len(responseTypes) == 0 {
@@ -543,6 +956,18 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 		// https://openid.net/specs/openid-connect-core-1_0.html#Authentication
 		return nil, newRedirectedErr(errInvalidRequest, "Response type 'token' must be provided with type 'id_token' and/or 'code'")
 	}
+	if !deviceFlow {
+		if rt.code && !grantTypeAllowed(client, grantTypeAuthorizationCode) {
+			return nil, newRedirectedErr(errUnauthorizedClient, "Client can't use the authorization code grant.")
+		}
+		// Tokens returned from the authorization endpoint make for the implicit grant.
+		if (rt.idToken || rt.token) && !grantTypeAllowed(client, grantTypeImplicit) {
+			return nil, newRedirectedErr(errUnauthorizedClient, "Client can't use the implicit grant.")
+		}
+		if rt.code && client.RequirePKCE && codeChallenge == "" {
+			return nil, newRedirectedErr(errInvalidRequest, "Client requires PKCE: missing code_challenge.")
+		}
+	}
 	if !rt.code {
 		// Either "id_token token" or "id_token" has been provided which implies the
 		// implicit flow. Implicit flow requires a nonce value.
@@ -552,6 +977,27 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 			return nil, newRedirectedErr(errInvalidRequest, "Response type 'token' requires a 'nonce' value.")
 		}
 	}
+	maxAge := -1
+	if v := q.Get("max_age"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, newRedirectedErr(errInvalidRequest, "Invalid max_age value %q", v)
+		}
+		maxAge = n
+	}
+	prompt := q.Get("prompt")
+	prompts := strings.Fields(prompt)
+	for _, p := range prompts {
+		switch p {
+		case promptNone:
+			if len(prompts) > 1 {
+				return nil, newRedirectedErr(errInvalidRequest, "Prompt value 'none' cannot be combined with other values.")
+			}
+		case promptLogin, promptConsent, promptSelectAccount:
+		default:
+			return nil, newRedirectedErr(errInvalidRequest, "Invalid prompt value %q", p)
+		}
+	}
 	if rt.token {
 		if redirectURI == redirectURIOOB {
 			err := fmt.Sprintf("Cannot use response type 'token' with redirect_uri '%s'.", redirectURIOOB)
@@ -564,11 +1010,16 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 		ClientID:            client.ID,
 		State:               state,
 		Nonce:               nonce,
-		ForceApprovalPrompt: q.Get("approval_prompt") == "force",
+		ForceApprovalPrompt: q.Get("approval_prompt") == "force" || hasPrompt(prompt, promptConsent),
 		Scopes:              scopes,
 		RedirectURI:         redirectURI,
 		ResponseTypes:       responseTypes,
 		ConnectorID:         connectorID,
+		Prompt:              prompt,
+		MaxAge:              maxAge,
+		ACRValues:           strings.Fields(q.Get("acr_values")),
+		LoginHint:           q.Get("login_hint"),
+		UILocales:           strings.Fields(q.Get("ui_locales")),
 		PKCE: storage.PKCE{
 			CodeChallenge:       codeChallenge,
 			CodeChallengeMethod: codeChallengeMethod,
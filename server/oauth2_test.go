@@ -26,6 +26,10 @@ func TestGetClientID(t *testing.T) {
 	require.Equal(t, "a", cid)
 	require.NoError(t, err)
 
+	cid, err = getClientID(audience{"a"}, "azp")
+	require.Equal(t, "azp", cid)
+	require.NoError(t, err)
+
 	cid, err = getClientID(audience{"a", "b"}, "azp")
 	require.Equal(t, "azp", cid)
 	require.NoError(t, err)
@@ -319,6 +323,59 @@ func TestParseAuthorizationRequest(t *testing.T) {
 			},
 			expectedError: &redirectedAuthErr{Type: errInvalidRequest},
 		},
+		{
+			name: "prompt login and consent",
+			clients: []storage.Client{
+				{
+					ID:           "bar",
+					RedirectURIs: []string{"https://example.com/bar"},
+				},
+			},
+			supportedResponseTypes: []string{"code"},
+			queryParams: map[string]string{
+				"client_id":     "bar",
+				"redirect_uri":  "https://example.com/bar",
+				"response_type": "code",
+				"scope":         "openid",
+				"prompt":        "login consent",
+			},
+		},
+		{
+			name: "prompt none with other values",
+			clients: []storage.Client{
+				{
+					ID:           "bar",
+					RedirectURIs: []string{"https://example.com/bar"},
+				},
+			},
+			supportedResponseTypes: []string{"code"},
+			queryParams: map[string]string{
+				"client_id":     "bar",
+				"redirect_uri":  "https://example.com/bar",
+				"response_type": "code",
+				"scope":         "openid",
+				"prompt":        "none login",
+			},
+			expectedError: &redirectedAuthErr{Type: errInvalidRequest},
+		},
+		{
+			name: "unknown prompt value",
+			clients: []storage.Client{
+				{
+					ID:           "bar",
+					RedirectURIs: []string{"https://example.com/bar"},
+				},
+			},
+			supportedResponseTypes: []string{"code"},
+			queryParams: map[string]string{
+				"client_id":     "bar",
+				"redirect_uri":  "https://example.com/bar",
+				"response_type": "code",
+				"scope":         "openid",
+				"prompt":        "create",
+			},
+			expectedError: &redirectedAuthErr{Type: errInvalidRequest},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// requestURIPrefix is the URN namespace of the request_uri values returned by
+// the pushed authorization request endpoint.
+const requestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+
+type pushedAuthorizationResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int    `json:"expires_in"`
+}
+
+// handlePushedAuthorizationRequest implements the pushed authorization request
+// endpoint described in [IETF RFC 9126](https://tools.ietf.org/html/rfc9126).
+//
+// The client posts the parameters it would otherwise send to /auth and gets
+// back a request_uri, which is all the browser carries to /auth afterwards.
+func (s *Server) handlePushedAuthorizationRequest(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	if r.Method != http.MethodPost {
+		s.tokenErrHelper(w, errInvalidRequest, "method not allowed", http.StatusBadRequest)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		s.logger.ErrorContext(r.Context(), "could not parse request body", "err", err)
+		s.tokenErrHelper(w, errInvalidRequest, "", http.StatusBadRequest)
+		return
+	}
+
+	s.withClientFromStorage(w, r, s.pushAuthorizationRequest)
+}
+
+func (s *Server) pushAuthorizationRequest(w http.ResponseWriter, r *http.Request, client storage.Client) {
+	ctx := r.Context()
+
+	q := r.PostForm
+	if q.Get("request_uri") != "" {
+		s.tokenErrHelper(w, errInvalidRequest, "request_uri cannot be pushed.", http.StatusBadRequest)
+		return
+	}
+	if clientID := q.Get("client_id"); clientID != "" && clientID != client.ID {
+		s.tokenErrHelper(w, errInvalidRequest, "client_id does not match the authenticated client.", http.StatusBadRequest)
+		return
+	}
+	q.Set("client_id", client.ID)
+
+	if q.Get("request") != "" {
+		params, err := s.requestObjectParams(ctx, q)
+		if err != nil {
+			s.pushedAuthorizationError(w, r, client, errInvalidRequestObject, err)
+			return
+		}
+		q = params
+	}
+
+	authReq, err := s.validateAuthorizationRequest(ctx, q, true)
+	if err != nil {
+		s.pushedAuthorizationError(w, r, client, errInvalidRequest, err)
+		return
+	}
+
+	authReq.Expiry = s.now().Add(s.pushedAuthRequestsValidFor)
+	if err := s.storage.CreateAuthRequest(ctx, *authReq); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create pushed authorization request", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(pushedAuthorizationResponse{
+		RequestURI: requestURIPrefix + authReq.ID,
+		ExpiresIn:  int(s.pushedAuthRequestsValidFor.Seconds()),
+	})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to marshal pushed authorization response", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusCreated)
+	w.Write(data)
+}
+
+// pushedAuthorizationError reports an invalid pushed request to the client.
+// Errors that /auth would only display are returned with the type typ.
+func (s *Server) pushedAuthorizationError(w http.ResponseWriter, r *http.Request, client storage.Client, typ string, err error) {
+	s.logger.ErrorContext(r.Context(), "invalid pushed authorization request", "client_id", client.ID, "err", err)
+	switch authErr := err.(type) {
+	case *redirectedAuthErr:
+		s.tokenErrHelper(w, authErr.Type, authErr.Description, http.StatusBadRequest)
+	case *displayedAuthErr:
+		if authErr.Status >= http.StatusInternalServerError {
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		s.tokenErrHelper(w, typ, authErr.Description, http.StatusBadRequest)
+	default:
+		panic("unsupported error type")
+	}
+}
+
+// pushedAuthorizationParams resolves the request_uri of an authorization request
+// into the parameters pushed for it. Any other parameter sent along with the
+// request_uri, except client_id, is ignored.
+//
+// A pushed request stays usable until it expires, so that the user can go back
+// to the connector selection or reload the page.
+func (s *Server) pushedAuthorizationParams(ctx context.Context, q url.Values) (url.Values, *displayedAuthErr) {
+	requestURI := q.Get("request_uri")
+	id, ok := strings.CutPrefix(requestURI, requestURIPrefix)
+	if !ok || id == "" {
+		return nil, newDisplayedErr(http.StatusBadRequest, "Invalid request_uri.")
+	}
+
+	authReq, err := s.storage.GetAuthRequest(ctx, id)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get pushed authorization request", "err", err)
+			return nil, newDisplayedErr(http.StatusInternalServerError, "Database error.")
+		}
+		return nil, newDisplayedErr(http.StatusBadRequest, "Invalid or expired request_uri.")
+	}
+	if authReq.LoggedIn || s.now().After(authReq.Expiry) {
+		return nil, newDisplayedErr(http.StatusBadRequest, "Invalid or expired request_uri.")
+	}
+	if authReq.ClientID != q.Get("client_id") {
+		s.logger.ErrorContext(ctx, "request_uri used by a different client",
+			"client_id", q.Get("client_id"), "request_client_id", authReq.ClientID)
+		return nil, newDisplayedErr(http.StatusBadRequest, "Invalid request_uri.")
+	}
+
+	params := url.Values{}
+	params.Set("client_id", authReq.ClientID)
+	params.Set("request_uri", requestURI)
+	params.Set("redirect_uri", authReq.RedirectURI)
+	params.Set("response_type", strings.Join(authReq.ResponseTypes, " "))
+	params.Set("scope", strings.Join(authReq.Scopes, " "))
+	setIfNotEmpty := func(key, value string) {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+	setIfNotEmpty("state", authReq.State)
+	setIfNotEmpty("nonce", authReq.Nonce)
+	setIfNotEmpty("connector_id", authReq.ConnectorID)
+	setIfNotEmpty("prompt", authReq.Prompt)
+	setIfNotEmpty("acr_values", strings.Join(authReq.ACRValues, " "))
+	setIfNotEmpty("login_hint", authReq.LoginHint)
+	setIfNotEmpty("ui_locales", strings.Join(authReq.UILocales, " "))
+	if authReq.PKCE.CodeChallenge != "" {
+		params.Set("code_challenge", authReq.PKCE.CodeChallenge)
+		params.Set("code_challenge_method", authReq.PKCE.CodeChallengeMethod)
+	}
+	if authReq.MaxAge >= 0 {
+		params.Set("max_age", strconv.Itoa(authReq.MaxAge))
+	}
+	if authReq.ForceApprovalPrompt {
+		params.Set("approval_prompt", "force")
+	}
+	return params, nil
+}
+
+// pushedAuthorizationQuery returns the parameters that stand for a pushed
+// request in URLs the browser follows.
+func pushedAuthorizationQuery(q url.Values) url.Values {
+	return url.Values{
+		"client_id":   {q.Get("client_id")},
+		"request_uri": {q.Get("request_uri")},
+	}
+}
@@ -0,0 +1,254 @@
+package server
+
+import (
+	"crypto"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// followAuthRedirects follows the redirects of an authorization request until
+// they leave the server, and returns the last location. Parameters of pushed
+// requests must not show up in the URLs on the way.
+func followAuthRedirects(t *testing.T, s *Server, issuer, target string) *url.URL {
+	for range 10 {
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+		require.Contains(t, []int{http.StatusFound, http.StatusSeeOther}, rr.Code, "%s: %s", target, rr.Body.String())
+		location := strings.TrimPrefix(rr.Header().Get("Location"), issuer)
+		u, err := url.Parse(location)
+		require.NoError(t, err)
+		if u.Host != "" {
+			return u
+		}
+		if strings.Contains(target, "request_uri") {
+			require.NotContains(t, u.RawQuery, "redirect_uri", "pushed parameters leaked into %s", location)
+		}
+		target = location
+	}
+	t.Fatal("too many redirects")
+	return nil
+}
+
+func TestHandlePushedAuthorizationRequest(t *testing.T) {
+	const redirectURI = "https://client.example.com/callback"
+
+	push := func(t *testing.T, s *Server, clientID, secret string, form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/par", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(clientID, secret)
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+
+	pushForm := url.Values{
+		"response_type": {"code"},
+		"redirect_uri":  {redirectURI},
+		"scope":         {"openid email"},
+		"state":         {"xyz"},
+	}
+
+	tests := []struct {
+		name    string
+		client  storage.Client
+		secret  string
+		form    url.Values
+		wantErr string
+	}{
+		{
+			name:   "valid request",
+			client: storage.Client{ID: "test", Secret: "secret", RedirectURIs: []string{redirectURI}},
+			secret: "secret",
+			form:   pushForm,
+		},
+		{
+			name:    "invalid client credentials",
+			client:  storage.Client{ID: "test", Secret: "secret", RedirectURIs: []string{redirectURI}},
+			secret:  "wrong",
+			form:    pushForm,
+			wantErr: errInvalidClient,
+		},
+		{
+			name:    "unregistered redirect_uri",
+			client:  storage.Client{ID: "test", Secret: "secret", RedirectURIs: []string{"https://other.example.com"}},
+			secret:  "secret",
+			form:    pushForm,
+			wantErr: errInvalidRequest,
+		},
+		{
+			name:   "missing openid scope",
+			client: storage.Client{ID: "test", Secret: "secret", RedirectURIs: []string{redirectURI}},
+			secret: "secret",
+			form: url.Values{
+				"response_type": {"code"},
+				"redirect_uri":  {redirectURI},
+				"scope":         {"email"},
+			},
+			wantErr: errInvalidScope,
+		},
+		{
+			name:   "nested request_uri",
+			client: storage.Client{ID: "test", Secret: "secret", RedirectURIs: []string{redirectURI}},
+			secret: "secret",
+			form: url.Values{
+				"request_uri": {requestURIPrefix + "foo"},
+			},
+			wantErr: errInvalidRequest,
+		},
+		{
+			name:   "client_id of another client",
+			client: storage.Client{ID: "test", Secret: "secret", RedirectURIs: []string{redirectURI}},
+			secret: "secret",
+			form: url.Values{
+				"client_id":     {"other"},
+				"response_type": {"code"},
+				"redirect_uri":  {redirectURI},
+				"scope":         {"openid"},
+			},
+			wantErr: errInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, nil)
+			defer httpServer.Close()
+			require.NoError(t, s.storage.CreateClient(t.Context(), tc.client))
+
+			rr := push(t, s, tc.client.ID, tc.secret, tc.form)
+			if tc.wantErr != "" {
+				require.NotEqual(t, http.StatusCreated, rr.Code)
+				require.Contains(t, rr.Body.String(), `"error":"`+tc.wantErr+`"`)
+				return
+			}
+			require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+			require.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+
+			var resp pushedAuthorizationResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.True(t, strings.HasPrefix(resp.RequestURI, requestURIPrefix), resp.RequestURI)
+			require.Equal(t, int((5 * time.Minute).Seconds()), resp.ExpiresIn)
+
+			callback := followAuthRedirects(t, s, httpServer.URL, "/auth?"+url.Values{
+				"client_id":   {tc.client.ID},
+				"request_uri": {resp.RequestURI},
+				// Ignored in favor of the pushed parameters.
+				"state": {"other"},
+			}.Encode())
+			require.Equal(t, redirectURI, callback.Scheme+"://"+callback.Host+callback.Path)
+			require.Equal(t, "xyz", callback.Query().Get("state"))
+			require.NotEmpty(t, callback.Query().Get("code"), callback.String())
+		})
+	}
+}
+
+func TestPushedAuthorizationRequestURI(t *testing.T) {
+	const redirectURI = "https://client.example.com/callback"
+
+	now := time.Now()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Now = func() time.Time { return now }
+	})
+	defer httpServer.Close()
+
+	ctx := t.Context()
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{
+		ID:                                 "test",
+		Secret:                             "secret",
+		RedirectURIs:                       []string{redirectURI},
+		RequirePushedAuthorizationRequests: true,
+	}))
+
+	pushed := storage.AuthRequest{
+		ID:            storage.NewID(),
+		ClientID:      "test",
+		ResponseTypes: []string{responseTypeCode},
+		Scopes:        []string{"openid"},
+		RedirectURI:   redirectURI,
+		State:         "xyz",
+		Prompt:        "login",
+		MaxAge:        -1,
+		Expiry:        now.Add(time.Minute),
+		HMACKey:       storage.NewHMACKey(crypto.SHA256),
+	}
+	require.NoError(t, s.storage.CreateAuthRequest(ctx, pushed))
+
+	t.Run("parameters", func(t *testing.T) {
+		params, err := s.pushedAuthorizationParams(ctx, url.Values{
+			"client_id":   {"test"},
+			"request_uri": {requestURIPrefix + pushed.ID},
+		})
+		require.Nil(t, err)
+		require.Equal(t, redirectURI, params.Get("redirect_uri"))
+		require.Equal(t, "code", params.Get("response_type"))
+		require.Equal(t, "xyz", params.Get("state"))
+		require.Equal(t, "login", params.Get("prompt"))
+		require.Empty(t, params.Get("max_age"))
+	})
+
+	tests := []struct {
+		name     string
+		query    url.Values
+		wantCode int
+	}{
+		{
+			name: "parameters without request_uri",
+			query: url.Values{
+				"client_id":     {"test"},
+				"response_type": {"code"},
+				"redirect_uri":  {redirectURI},
+				"scope":         {"openid"},
+			},
+			wantCode: http.StatusSeeOther,
+		},
+		{
+			name:     "pushed request",
+			query:    url.Values{"client_id": {"test"}, "request_uri": {requestURIPrefix + pushed.ID}},
+			wantCode: http.StatusFound,
+		},
+		{
+			name:     "unknown request_uri",
+			query:    url.Values{"client_id": {"test"}, "request_uri": {requestURIPrefix + "unknown"}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "request_uri without prefix",
+			query:    url.Values{"client_id": {"test"}, "request_uri": {pushed.ID}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "request_uri of another client",
+			query:    url.Values{"client_id": {"other"}, "request_uri": {requestURIPrefix + pushed.ID}},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/auth/mock?"+tc.query.Encode(), nil))
+			require.Equal(t, tc.wantCode, rr.Code, rr.Body.String())
+			if tc.wantCode == http.StatusSeeOther {
+				location, err := url.Parse(rr.Header().Get("Location"))
+				require.NoError(t, err)
+				require.Equal(t, errInvalidRequest, location.Query().Get("error"))
+			}
+		})
+	}
+
+	t.Run("expired request_uri", func(t *testing.T) {
+		now = now.Add(2 * time.Minute)
+		query := url.Values{"client_id": {"test"}, "request_uri": {requestURIPrefix + pushed.ID}}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/auth?"+query.Encode(), nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -147,11 +147,15 @@ type refreshContext struct {
 	connector     Connector
 	connectorData []byte
 
+	// policy is the refresh token policy of the client the token was issued to.
+	policy *RefreshTokenPolicy
+
 	scopes []string
 }
 
-// getRefreshTokenFromStorage checks that refresh token is valid and exists in the storage and gets its info
-func (s *Server) getRefreshTokenFromStorage(ctx context.Context, clientID *string, token *internal.RefreshToken) (*refreshContext, *refreshError) {
+// getRefreshTokenFromStorage checks that refresh token is valid and exists in the storage and gets its info.
+// If client is nil, the token may belong to any client.
+func (s *Server) getRefreshTokenFromStorage(ctx context.Context, client *storage.Client, token *internal.RefreshToken) (*refreshContext, *refreshError) {
 	refreshCtx := refreshContext{requestToken: token}
 
 	// Get RefreshToken
@@ -165,31 +169,43 @@ func (s *Server) getRefreshTokenFromStorage(ctx context.Context, clientID *strin
 	}
 
 	// Only check ClientID if it was provided;
-	if clientID != nil && (refresh.ClientID != *clientID) {
-		s.logger.ErrorContext(ctx, "trying to claim token for different client", "client_id", clientID, "refresh_client_id", refresh.ClientID)
+	if client != nil && (refresh.ClientID != client.ID) {
+		s.logger.ErrorContext(ctx, "trying to claim token for different client", "client_id", client.ID, "refresh_client_id", refresh.ClientID)
 		// According to https://datatracker.ietf.org/doc/html/rfc6749#section-5.2 Dex should respond with an
 		//  invalid grant error if token has already been claimed by another client.
 		return nil, &refreshError{msg: errInvalidGrant, desc: invalidErr.desc, code: http.StatusBadRequest}
 	}
 
+	if client != nil {
+		refreshCtx.policy = s.refreshTokenPolicyForClient(*client)
+	} else {
+		refreshCtx.policy, err = s.refreshTokenPolicyForClientID(ctx, refresh.ClientID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get client", "client_id", refresh.ClientID, "err", err)
+			return nil, newInternalServerError()
+		}
+	}
+
 	if refresh.Token != token.Token {
 		switch {
-		case !s.refreshTokenPolicy.AllowedToReuse(refresh.LastUsed):
-			fallthrough
-		case refresh.ObsoleteToken != token.Token:
-			fallthrough
-		case refresh.ObsoleteToken == "":
+		case refresh.ObsoleteToken == "" || refresh.ObsoleteToken != token.Token:
 			s.logger.ErrorContext(ctx, "refresh token claimed twice", "token_id", refresh.ID)
 			return nil, invalidErr
+		case !refreshCtx.policy.AllowedToReuse(refresh.LastUsed):
+			// The token was rotated, and whoever rotated it had the time to use
+			// the new one. Either this client or the last one is not the
+			// rightful owner, and there's no telling which.
+			s.revokeRefreshFamily(ctx, refresh)
+			return nil, invalidErr
 		}
 	}
 
-	if s.refreshTokenPolicy.CompletelyExpired(refresh.CreatedAt) {
+	if refreshCtx.policy.CompletelyExpired(refresh.CreatedAt) {
 		s.logger.ErrorContext(ctx, "refresh token expired", "token_id", refresh.ID)
 		return nil, expiredErr
 	}
 
-	if s.refreshTokenPolicy.ExpiredBecauseUnused(refresh.LastUsed) {
+	if refreshCtx.policy.ExpiredBecauseUnused(refresh.LastUsed) {
 		s.logger.ErrorContext(ctx, "refresh token expired due to inactivity", "token_id", refresh.ID)
 		return nil, expiredErr
 	}
@@ -221,6 +237,35 @@ func (s *Server) getRefreshTokenFromStorage(ctx context.Context, clientID *strin
 	return &refreshCtx, nil
 }
 
+// revokeRefreshFamily revokes a refresh token whose obsolete token was
+// replayed after the reuse interval, along with every token it was rotated
+// into: they share its ID. The client is told through the back-channel logout
+// if it registered for it.
+func (s *Server) revokeRefreshFamily(ctx context.Context, refresh storage.RefreshToken) {
+	s.logger.WarnContext(ctx, "refresh token reuse detected, revoking the token family",
+		"event", "refresh_token_reuse", "client_id", refresh.ClientID, "user_id", refresh.Claims.UserID,
+		"connector_id", refresh.ConnectorID, "token_id", refresh.ID)
+
+	updater := func(old storage.OfflineSessions) (storage.OfflineSessions, error) {
+		if ref, ok := old.Refresh[refresh.ClientID]; ok && ref.ID == refresh.ID {
+			delete(old.Refresh, refresh.ClientID)
+		}
+		return old, nil
+	}
+	if err := s.storage.UpdateOfflineSessions(ctx, refresh.Claims.UserID, refresh.ConnectorID, updater); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to update offline session", "err", err)
+	}
+	if err := s.storage.DeleteRefreshAccessTokens(ctx, refresh.ID); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to delete access tokens of refresh token", "token_id", refresh.ID, "err", err)
+	}
+	if err := s.storage.DeleteRefresh(ctx, refresh.ID); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to delete refresh token", "token_id", refresh.ID, "err", err)
+		return
+	}
+
+	s.backchannelLogout(ctx, s.subjectFor(refresh.Claims.UserID, refresh.ConnectorID), refresh.Authentication.SessionID, []string{refresh.ClientID})
+}
+
 func (s *Server) getRefreshScopes(r *http.Request, refresh *storage.RefreshToken) ([]string, *refreshError) {
 	// Per the OAuth2 spec, if the client has omitted the scopes, default to the original
 	// authorized scopes.
@@ -286,6 +331,7 @@ func (s *Server) updateOfflineSession(ctx context.Context, refresh *storage.Refr
 		if len(ident.ConnectorData) > 0 {
 			old.ConnectorData = ident.ConnectorData
 		}
+		old.ExtraClaims = ident.ExtraClaims
 
 		s.logger.DebugContext(ctx, "saved connector data", "user_id", ident.UserID, "connector_data", ident.ConnectorData)
 
@@ -321,11 +367,12 @@ func (s *Server) updateRefreshToken(ctx context.Context, rCtx *refreshContext) (
 		Email:             rCtx.storageToken.Claims.Email,
 		EmailVerified:     rCtx.storageToken.Claims.EmailVerified,
 		Groups:            rCtx.storageToken.Claims.Groups,
+		ExtraClaims:       rCtx.storageToken.Claims.ExtraClaims,
 	}
 
 	refreshTokenUpdater := func(old storage.RefreshToken) (storage.RefreshToken, error) {
-		rotationEnabled := s.refreshTokenPolicy.RotationEnabled()
-		reusingAllowed := s.refreshTokenPolicy.AllowedToReuse(old.LastUsed)
+		rotationEnabled := rCtx.policy.RotationEnabled()
+		reusingAllowed := rCtx.policy.AllowedToReuse(old.LastUsed)
 
 		switch {
 		case !rotationEnabled && reusingAllowed:
@@ -380,6 +427,7 @@ func (s *Server) updateRefreshToken(ctx context.Context, rCtx *refreshContext) (
 		old.Claims.Email = ident.Email
 		old.Claims.EmailVerified = ident.EmailVerified
 		old.Claims.Groups = ident.Groups
+		old.Claims.ExtraClaims = ident.ExtraClaims
 
 		return old, nil
 	}
@@ -408,12 +456,18 @@ func (s *Server) handleRefreshToken(w http.ResponseWriter, r *http.Request, clie
 		return
 	}
 
-	rCtx, rerr := s.getRefreshTokenFromStorage(r.Context(), &client.ID, token)
+	rCtx, rerr := s.getRefreshTokenFromStorage(r.Context(), &client, token)
 	if rerr != nil {
 		s.refreshTokenErrHelper(w, rerr)
 		return
 	}
 
+	if thumbprint := rCtx.storageToken.DPoPKeyThumbprint; thumbprint != "" && thumbprint != dpopKeyThumbprintFromContext(r.Context()) {
+		s.logger.InfoContext(r.Context(), "refresh token used without a proof of its DPoP key", "client_id", client.ID)
+		s.refreshTokenErrHelper(w, &refreshError{msg: errInvalidGrant, desc: "Refresh token is bound to a DPoP key.", code: http.StatusBadRequest})
+		return
+	}
+
 	rCtx.scopes, rerr = s.getRefreshScopes(r, rCtx.storageToken)
 	if rerr != nil {
 		s.refreshTokenErrHelper(w, rerr)
@@ -433,16 +487,23 @@ func (s *Server) handleRefreshToken(w http.ResponseWriter, r *http.Request, clie
 		Email:             ident.Email,
 		EmailVerified:     ident.EmailVerified,
 		Groups:            ident.Groups,
+		ExtraClaims:       ident.ExtraClaims,
+	}
+
+	aud, err := s.accessTokenAudience(r)
+	if err != nil {
+		s.refreshTokenErrHelper(w, &refreshError{msg: errInvalidTarget, desc: err.Error(), code: http.StatusBadRequest})
+		return
 	}
 
-	accessToken, _, _, err := s.newAccessToken(r.Context(), client.ID, claims, rCtx.scopes, rCtx.storageToken.Nonce, rCtx.storageToken.ConnectorID)
+	accessToken, expiry, err := s.newAccessToken(r.Context(), client, aud, claims, rCtx.scopes, rCtx.storageToken.ConnectorID, ident.ConnectorData, rCtx.storageToken.ID)
 	if err != nil {
 		s.logger.ErrorContext(r.Context(), "failed to create new access token", "err", err)
 		s.refreshTokenErrHelper(w, newInternalServerError())
 		return
 	}
 
-	idToken, sessionID, expiry, err := s.newIDToken(r.Context(), client.ID, claims, rCtx.scopes, rCtx.storageToken.Nonce, accessToken, "", rCtx.storageToken.ConnectorID)
+	idToken, sessionID, _, err := s.newIDToken(r.Context(), client, claims, rCtx.storageToken.Authentication, rCtx.scopes, rCtx.storageToken.Nonce, accessToken, "", rCtx.storageToken.ConnectorID, ident.ConnectorData)
 	if err != nil {
 		s.logger.ErrorContext(r.Context(), "failed to create ID token", "err", err)
 		s.refreshTokenErrHelper(w, newInternalServerError())
@@ -456,6 +517,6 @@ func (s *Server) handleRefreshToken(w http.ResponseWriter, r *http.Request, clie
 		return
 	}
 
-	resp := s.toAccessTokenResponse(idToken, accessToken, rawNewToken, expiry, sessionID, rCtx.scopes)
+	resp := s.toAccessTokenResponse(r.Context(), client, idToken, accessToken, rawNewToken, expiry, sessionID, rCtx.scopes)
 	s.writeAccessToken(w, resp)
 }
@@ -230,3 +230,73 @@ func TestRefreshTokenPolicy(t *testing.T) {
 		require.Equal(t, true, r.CompletelyExpired(lastTime))
 	})
 }
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	t0 := time.Now()
+	ctx := t.Context()
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		wantRevoked bool
+	}{
+		{name: "within reuse interval", now: t0.Add(10 * time.Second)},
+		{name: "after reuse interval", now: t0.Add(time.Minute), wantRevoked: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, func(c *Config) {
+				c.RefreshTokenPolicy = &RefreshTokenPolicy{
+					rotateRefreshTokens: true,
+					reuseInterval:       30 * time.Second,
+					now:                 func() time.Time { return tc.now },
+				}
+			})
+			defer httpServer.Close()
+
+			// The token "bar" was rotated into "testtest" at t0.
+			mockRefreshTokenTestStorage(t, s.storage, true)
+			require.NoError(t, s.storage.UpdateRefreshToken(ctx, "test", func(old storage.RefreshToken) (storage.RefreshToken, error) {
+				old.LastUsed = t0
+				return old, nil
+			}))
+			rcv := newLogoutReceiver(t)
+			require.NoError(t, s.storage.UpdateClient(ctx, "test", func(old storage.Client) (storage.Client, error) {
+				old.BackchannelLogoutURI = rcv.URL
+				return old, nil
+			}))
+
+			obsolete, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "bar"})
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, "/token", bytes.NewBufferString(url.Values{
+				"grant_type":    {grantTypeRefreshToken},
+				"refresh_token": {obsolete},
+			}.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth("test", "barfoo")
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+
+			_, err = s.storage.GetRefresh(ctx, "test")
+			offlineSessions, osErr := s.storage.GetOfflineSessions(ctx, "1", "test")
+			require.NoError(t, osErr)
+			if !tc.wantRevoked {
+				require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+				require.NoError(t, err)
+				require.Contains(t, offlineSessions.Refresh, "test")
+				return
+			}
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.ErrorIs(t, err, storage.ErrNotFound)
+			require.NotContains(t, offlineSessions.Refresh, "test")
+
+			// The current token of the family is revoked as well.
+			_, rerr := s.getRefreshTokenFromStorage(ctx, nil, &internal.RefreshToken{RefreshId: "test", Token: "testtest"})
+			require.Equal(t, invalidErr, rerr)
+
+			// The client registered for back-channel logouts is told.
+			require.Eventually(t, func() bool { return len(rcv.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
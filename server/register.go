@@ -0,0 +1,404 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/dexidp/dex/storage"
+)
+
+const (
+	errInvalidToken          = "invalid_token"
+	errInvalidRedirectURI    = "invalid_redirect_uri"
+	errInvalidClientMetadata = "invalid_client_metadata"
+)
+
+// maxClientMetadataSize limits the request bodies of the registration
+// endpoints, which are reachable with long-lived bearer tokens.
+const maxClientMetadataSize = 1 << 20
+
+// hashToken returns the hash under which a bearer token is stored, be it an
+// opaque access token or a token of the registration endpoints, so that a
+// leaked storage doesn't leak usable tokens.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// clientMetadata is the client metadata of RFC 7591 section 2 that dex can
+// store for a client.
+type clientMetadata struct {
+	RedirectURIs            []string        `json:"redirect_uris,omitempty"`
+	TokenEndpointAuthMethod string          `json:"token_endpoint_auth_method,omitempty"`
+	GrantTypes              []string        `json:"grant_types,omitempty"`
+	ResponseTypes           []string        `json:"response_types,omitempty"`
+	ClientName              string          `json:"client_name,omitempty"`
+	LogoURI                 string          `json:"logo_uri,omitempty"`
+	JWKS                    json.RawMessage `json:"jwks,omitempty"`
+	JWKSURI                 string          `json:"jwks_uri,omitempty"`
+	PostLogoutRedirectURIs  []string        `json:"post_logout_redirect_uris,omitempty"`
+	BackchannelLogoutURI    string          `json:"backchannel_logout_uri,omitempty"`
+	TLSClientAuthSubjectDN  string          `json:"tls_client_auth_subject_dn,omitempty"`
+	SubjectType             string          `json:"subject_type,omitempty"`
+	SectorIdentifierURI     string          `json:"sector_identifier_uri,omitempty"`
+
+	RequirePushedAuthorizationRequests bool `json:"require_pushed_authorization_requests,omitempty"`
+}
+
+// clientInformationResponse is returned by the registration endpoints (RFC 7591
+// section 3.2.1 and RFC 7592 section 3).
+type clientInformationResponse struct {
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64  `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   *int64 `json:"client_secret_expires_at,omitempty"`
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string `json:"registration_client_uri"`
+
+	clientMetadata
+}
+
+// bearerToken returns the token of an "Authorization: Bearer" header.
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(prefix, auth[:len(prefix)]) {
+		return "", false
+	}
+	return auth[len(prefix):], true
+}
+
+func (s *Server) invalidTokenErr(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	s.tokenErrHelper(w, errInvalidToken, "Invalid bearer token.", http.StatusUnauthorized)
+}
+
+// handleRegister implements the dynamic client registration endpoint described
+// in [IETF RFC 7591](https://tools.ietf.org/html/rfc7591).
+//
+// Registration requires an initial access token issued through the gRPC API.
+// The token can be used until it expires or is deleted.
+func (s *Server) handleRegister(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Cache-Control", "no-store")
+	if r.Method != http.MethodPost {
+		s.tokenErrHelper(w, errInvalidRequest, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, ok := bearerToken(r)
+	if !ok {
+		s.invalidTokenErr(w)
+		return
+	}
+	initialAccessToken, err := s.storage.GetInitialAccessToken(ctx, hashToken(token))
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get initial access token", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		s.invalidTokenErr(w)
+		return
+	}
+	if s.now().After(initialAccessToken.Expiry) {
+		s.invalidTokenErr(w)
+		return
+	}
+
+	var metadata clientMetadata
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxClientMetadataSize)).Decode(&metadata); err != nil {
+		s.tokenErrHelper(w, errInvalidClientMetadata, "Invalid JSON body.", http.StatusBadRequest)
+		return
+	}
+
+	client := storage.Client{ID: storage.NewID()}
+	if typ, description := s.applyClientMetadata(ctx, &client, metadata); typ != "" {
+		s.tokenErrHelper(w, typ, description, http.StatusBadRequest)
+		return
+	}
+	if usesClientSecret(client) {
+		client.Secret = storage.NewID() + storage.NewID()
+	}
+	registrationAccessToken := storage.NewID() + storage.NewID()
+	client.RegistrationAccessTokenHash = hashToken(registrationAccessToken)
+
+	if err := s.storage.CreateClient(ctx, client); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create client", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	s.logger.InfoContext(ctx, "registered client", "client_id", client.ID)
+
+	resp := s.clientInformation(client)
+	resp.ClientIDIssuedAt = s.now().Unix()
+	resp.RegistrationAccessToken = registrationAccessToken
+	s.writeClientInformation(w, r, http.StatusCreated, resp)
+}
+
+// handleRegisteredClient implements the dynamic client registration management
+// endpoint described in [IETF RFC 7592](https://tools.ietf.org/html/rfc7592),
+// through which a registered client reads, updates or deletes itself with its
+// registration access token.
+func (s *Server) handleRegisteredClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Cache-Control", "no-store")
+
+	clientID, err := url.PathUnescape(mux.Vars(r)["client_id"])
+	if err != nil {
+		s.invalidTokenErr(w)
+		return
+	}
+	token, ok := bearerToken(r)
+	if !ok {
+		s.invalidTokenErr(w)
+		return
+	}
+	client, err := s.storage.GetClient(ctx, clientID)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get client", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		// Don't disclose whether the client exists.
+		s.invalidTokenErr(w)
+		return
+	}
+	if client.RegistrationAccessTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(client.RegistrationAccessTokenHash), []byte(hashToken(token))) != 1 {
+		s.invalidTokenErr(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.writeClientInformation(w, r, http.StatusOK, s.clientInformation(client))
+	case http.MethodPut:
+		var req struct {
+			ClientID     string `json:"client_id"`
+			ClientSecret string `json:"client_secret"`
+			clientMetadata
+		}
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxClientMetadataSize)).Decode(&req); err != nil {
+			s.tokenErrHelper(w, errInvalidClientMetadata, "Invalid JSON body.", http.StatusBadRequest)
+			return
+		}
+		if req.ClientID != client.ID {
+			s.tokenErrHelper(w, errInvalidRequest, "client_id does not match the registered client.", http.StatusBadRequest)
+			return
+		}
+		if req.ClientSecret != "" && req.ClientSecret != client.Secret {
+			s.tokenErrHelper(w, errInvalidRequest, "client_secret does not match the registered client.", http.StatusBadRequest)
+			return
+		}
+
+		// The request replaces all the metadata of the client.
+		updated := storage.Client{
+			ID:                          client.ID,
+			Secret:                      client.Secret,
+			RegistrationAccessTokenHash: client.RegistrationAccessTokenHash,
+		}
+		if typ, description := s.applyClientMetadata(ctx, &updated, req.clientMetadata); typ != "" {
+			s.tokenErrHelper(w, typ, description, http.StatusBadRequest)
+			return
+		}
+		switch {
+		case !usesClientSecret(updated):
+			updated.Secret = ""
+		case updated.Secret == "":
+			updated.Secret = storage.NewID() + storage.NewID()
+		}
+
+		err := s.storage.UpdateClient(ctx, client.ID, func(old storage.Client) (storage.Client, error) {
+			// Keep the settings that are managed through the gRPC API only.
+			updated.TrustedPeers = old.TrustedPeers
+			updated.ClientCredentialsScopes = old.ClientCredentialsScopes
+			updated.ClientCredentialsAudiences = old.ClientCredentialsAudiences
+			updated.AccessTokenFormat = old.AccessTokenFormat
+			updated.ClaimMappings = old.ClaimMappings
+			updated.Expiry = old.Expiry
+			updated.AllowedConnectors = old.AllowedConnectors
+			updated.RequirePKCE = old.RequirePKCE
+			updated.RequiredScopes = old.RequiredScopes
+			return updated, nil
+		})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to update client", "client_id", client.ID, "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		s.writeClientInformation(w, r, http.StatusOK, s.clientInformation(updated))
+	case http.MethodDelete:
+		if err := s.storage.DeleteClient(ctx, client.ID); err != nil && err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to delete client", "client_id", client.ID, "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		s.logger.InfoContext(ctx, "deleted registered client", "client_id", client.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.tokenErrHelper(w, errInvalidRequest, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// applyClientMetadata validates the metadata sent by a client and sets it on the
+// client. It returns the type and description of the error if it is invalid.
+func (s *Server) applyClientMetadata(ctx context.Context, client *storage.Client, metadata clientMetadata) (string, string) {
+	grantTypes := metadata.GrantTypes
+	if len(grantTypes) == 0 {
+		grantTypes = []string{grantTypeAuthorizationCode}
+	}
+	for _, grantType := range grantTypes {
+		if !slices.Contains(s.supportedGrantTypes, grantType) {
+			return errInvalidClientMetadata, fmt.Sprintf("Unsupported grant type %q.", grantType)
+		}
+	}
+	// The default of RFC 7591 is "code". Response types such as "code id_token"
+	// are allowed value by value.
+	responseTypes := metadata.ResponseTypes
+	if len(responseTypes) == 0 {
+		responseTypes = []string{responseTypeCode}
+	}
+	var allowedResponseTypes []string
+	for _, responseType := range responseTypes {
+		for _, value := range strings.Fields(responseType) {
+			if !s.supportedResponseTypes[value] {
+				return errInvalidClientMetadata, fmt.Sprintf("Unsupported response type %q.", responseType)
+			}
+			if !slices.Contains(allowedResponseTypes, value) {
+				allowedResponseTypes = append(allowedResponseTypes, value)
+			}
+		}
+	}
+
+	if len(metadata.RedirectURIs) == 0 &&
+		(slices.Contains(grantTypes, grantTypeAuthorizationCode) || slices.Contains(grantTypes, grantTypeImplicit)) {
+		return errInvalidRedirectURI, "At least one redirect_uri is required."
+	}
+	for _, redirectURI := range metadata.RedirectURIs {
+		if !validRegisteredURI(redirectURI) {
+			return errInvalidRedirectURI, fmt.Sprintf("Invalid redirect_uri %q.", redirectURI)
+		}
+	}
+	for _, redirectURI := range metadata.PostLogoutRedirectURIs {
+		if !validRegisteredURI(redirectURI) {
+			return errInvalidClientMetadata, fmt.Sprintf("Invalid post_logout_redirect_uri %q.", redirectURI)
+		}
+	}
+	if metadata.BackchannelLogoutURI != "" && !validRegisteredURI(metadata.BackchannelLogoutURI) {
+		return errInvalidClientMetadata, "Invalid backchannel_logout_uri."
+	}
+
+	var jwks string
+	if len(metadata.JWKS) > 0 && string(metadata.JWKS) != "null" {
+		jwks = string(metadata.JWKS)
+	}
+	if err := ValidateClientKeys(jwks, metadata.JWKSURI); err != nil {
+		return errInvalidClientMetadata, err.Error()
+	}
+
+	// The default of RFC 7591 is client_secret_basic.
+	client.TokenEndpointAuthMethod = defaultTo(metadata.TokenEndpointAuthMethod, authMethodClientSecretBasic)
+	client.Public = client.TokenEndpointAuthMethod == authMethodNone
+	client.JWKS = jwks
+	client.JWKSURI = metadata.JWKSURI
+	client.TLSClientAuthSubjectDN = metadata.TLSClientAuthSubjectDN
+	if err := ValidateClientAuthMethod(*client); err != nil {
+		return errInvalidClientMetadata, err.Error()
+	}
+
+	client.RedirectURIs = metadata.RedirectURIs
+	client.PostLogoutRedirectURIs = metadata.PostLogoutRedirectURIs
+	client.BackchannelLogoutURI = metadata.BackchannelLogoutURI
+	client.Name = metadata.ClientName
+	client.LogoURL = metadata.LogoURI
+	client.RequirePushedAuthorizationRequests = metadata.RequirePushedAuthorizationRequests
+	client.AllowedGrantTypes = grantTypes
+	client.AllowedResponseTypes = allowedResponseTypes
+
+	client.SubjectType = metadata.SubjectType
+	client.SectorIdentifierURI = metadata.SectorIdentifierURI
+	if err := s.validateSubjectType(*client); err != nil {
+		return errInvalidClientMetadata, err.Error()
+	}
+	if client.SectorIdentifierURI != "" {
+		if err := s.verifySectorIdentifierURI(ctx, *client); err != nil {
+			return errInvalidClientMetadata, err.Error()
+		}
+	}
+	return "", ""
+}
+
+// usesClientSecret reports whether a registered client authenticates with a
+// client secret.
+func usesClientSecret(client storage.Client) bool {
+	switch client.TokenEndpointAuthMethod {
+	case authMethodNone, authMethodPrivateKeyJWT, authMethodTLSClientAuth, authMethodSelfSignedTLSClientAuth:
+		return false
+	}
+	return true
+}
+
+// validRegisteredURI reports whether uri is an absolute URL without fragment.
+func validRegisteredURI(uri string) bool {
+	u, err := url.Parse(uri)
+	return err == nil && u.Scheme != "" && u.Host != "" && u.Fragment == ""
+}
+
+// clientInformation returns the registered metadata of a client.
+func (s *Server) clientInformation(client storage.Client) clientInformationResponse {
+	resp := clientInformationResponse{
+		ClientID:              client.ID,
+		ClientSecret:          client.Secret,
+		RegistrationClientURI: s.absURL("/register", url.PathEscape(client.ID)),
+		clientMetadata: clientMetadata{
+			RedirectURIs:                       client.RedirectURIs,
+			GrantTypes:                         client.AllowedGrantTypes,
+			ResponseTypes:                      client.AllowedResponseTypes,
+			TokenEndpointAuthMethod:            client.TokenEndpointAuthMethod,
+			ClientName:                         client.Name,
+			LogoURI:                            client.LogoURL,
+			JWKSURI:                            client.JWKSURI,
+			PostLogoutRedirectURIs:             client.PostLogoutRedirectURIs,
+			BackchannelLogoutURI:               client.BackchannelLogoutURI,
+			TLSClientAuthSubjectDN:             client.TLSClientAuthSubjectDN,
+			SubjectType:                        client.SubjectType,
+			SectorIdentifierURI:                client.SectorIdentifierURI,
+			RequirePushedAuthorizationRequests: client.RequirePushedAuthorizationRequests,
+		},
+	}
+	if client.Secret != "" {
+		// Client secrets don't expire.
+		var never int64
+		resp.ClientSecretExpiresAt = &never
+	}
+	if client.JWKS != "" {
+		resp.JWKS = json.RawMessage(client.JWKS)
+	}
+	return resp
+}
+
+func (s *Server) writeClientInformation(w http.ResponseWriter, r *http.Request, status int, resp clientInformationResponse) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to marshal client information response", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	w.Write(data)
+}
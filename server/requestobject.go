@@ -0,0 +1,190 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// requestObjectSigningAlgs are the algorithms request objects can be signed
+// with. Unsigned request objects and shared secrets are not accepted.
+var requestObjectSigningAlgs = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.EdDSA,
+}
+
+// parseJWKS parses a JSON Web Key Set holding public keys.
+func parseJWKS(data string) (*oidc.StaticKeySet, error) {
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal([]byte(data), &jwks); err != nil {
+		return nil, fmt.Errorf("invalid JSON Web Key Set: %v", err)
+	}
+	keySet := &oidc.StaticKeySet{}
+	for _, key := range jwks.Keys {
+		if !key.IsPublic() {
+			return nil, fmt.Errorf("key %q is not a public key", key.KeyID)
+		}
+		keySet.PublicKeys = append(keySet.PublicKeys, key.Key)
+	}
+	if len(keySet.PublicKeys) == 0 {
+		return nil, errors.New("JSON Web Key Set has no keys")
+	}
+	return keySet, nil
+}
+
+// ValidateClientKeys checks the key set or key set URL registered for a client.
+func ValidateClientKeys(jwks, jwksURI string) error {
+	if jwks != "" && jwksURI != "" {
+		return errors.New("jwks and jwksURI are mutually exclusive")
+	}
+	if jwks != "" {
+		if _, err := parseJWKS(jwks); err != nil {
+			return err
+		}
+	}
+	if jwksURI != "" {
+		u, err := url.Parse(jwksURI)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("invalid jwksURI %q", jwksURI)
+		}
+	}
+	return nil
+}
+
+// remoteKeySets holds the key sets fetched from the jwks_uri of clients. They
+// cache the keys, so they are kept across requests.
+type remoteKeySets struct {
+	mu   sync.Mutex
+	sets map[string]*oidc.RemoteKeySet
+}
+
+func (r *remoteKeySets) get(jwksURI string) *oidc.RemoteKeySet {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.sets == nil {
+		r.sets = make(map[string]*oidc.RemoteKeySet)
+	}
+	keySet, ok := r.sets[jwksURI]
+	if !ok {
+		keySet = oidc.NewRemoteKeySet(context.Background(), jwksURI)
+		r.sets[jwksURI] = keySet
+	}
+	return keySet
+}
+
+// clientKeySet returns the keys that verify JWTs signed by the client.
+func (s *Server) clientKeySet(client storage.Client) (oidc.KeySet, error) {
+	switch {
+	case client.JWKS != "":
+		return parseJWKS(client.JWKS)
+	case client.JWKSURI != "":
+		return s.remoteKeySets.get(client.JWKSURI), nil
+	}
+	return nil, errors.New("client has no keys registered")
+}
+
+// requestObjectParams verifies the request object (RFC 9101) passed in the
+// "request" parameter, and returns the authorization parameters with the claims
+// of the request object taking precedence over the other parameters.
+//
+// Invalid request objects are reported to the client if the parameters outside
+// of the request object hold a redirect_uri registered for it.
+func (s *Server) requestObjectParams(ctx context.Context, q url.Values) (url.Values, error) {
+	requestObject := q.Get("request")
+	clientID := q.Get("client_id")
+
+	client, err := s.storage.GetClient(ctx, clientID)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "invalid client_id provided", "client_id", clientID)
+			return nil, newDisplayedErr(http.StatusNotFound, "Invalid client_id.")
+		}
+		s.logger.ErrorContext(ctx, "failed to get client", "err", err)
+		return nil, newDisplayedErr(http.StatusInternalServerError, "Database error.")
+	}
+
+	invalid := func(description string) error {
+		if redirectURI := q.Get("redirect_uri"); validateRedirectURI(client, redirectURI) {
+			return &redirectedAuthErr{q.Get("state"), redirectURI, errInvalidRequestObject, description}
+		}
+		return newDisplayedErr(http.StatusBadRequest, "%s", description)
+	}
+
+	if _, err := jose.ParseSigned(requestObject, requestObjectSigningAlgs); err != nil {
+		s.logger.ErrorContext(ctx, "failed to parse request object", "client_id", clientID, "err", err)
+		return nil, invalid("Invalid request object.")
+	}
+	keySet, err := s.clientKeySet(client)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "cannot verify request object", "client_id", clientID, "err", err)
+		return nil, invalid("Client has no keys to verify request objects.")
+	}
+	payload, err := keySet.VerifySignature(ctx, requestObject)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to verify request object", "client_id", clientID, "err", err)
+		return nil, invalid("Invalid request object signature.")
+	}
+
+	var registered struct {
+		Issuer   string   `json:"iss"`
+		Audience audience `json:"aud"`
+		Expiry   *int64   `json:"exp"`
+		ClientID string   `json:"client_id"`
+	}
+	if err := json.Unmarshal(payload, &registered); err != nil {
+		return nil, invalid("Invalid request object claims.")
+	}
+	if registered.Issuer != "" && registered.Issuer != client.ID {
+		return nil, invalid("Request object was issued by another client.")
+	}
+	if registered.ClientID != "" && registered.ClientID != client.ID {
+		return nil, invalid("Request object is for another client.")
+	}
+	if len(registered.Audience) > 0 && !registered.Audience.contains(s.issuerURL.String()) {
+		return nil, invalid("Request object is for another audience.")
+	}
+	if registered.Expiry != nil && s.now().Unix() >= *registered.Expiry {
+		return nil, invalid("Request object has expired.")
+	}
+
+	var claims map[string]any
+	d := json.NewDecoder(bytes.NewReader(payload))
+	d.UseNumber()
+	if err := d.Decode(&claims); err != nil {
+		return nil, invalid("Invalid request object claims.")
+	}
+
+	params := url.Values{}
+	for k, v := range q {
+		params[k] = v
+	}
+	for name, value := range claims {
+		switch name {
+		case "iss", "aud", "exp", "iat", "nbf", "jti", "client_id", "request", "request_uri":
+			continue
+		}
+		// Parameters are strings, except max_age. Others, like "claims", are not
+		// supported.
+		switch value := value.(type) {
+		case string:
+			params.Set(name, value)
+		case json.Number:
+			params.Set(name, value.String())
+		}
+	}
+	params.Set("client_id", client.ID)
+	return params, nil
+}
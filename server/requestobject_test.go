@@ -0,0 +1,219 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestRequestObject(t *testing.T) {
+	const redirectURI = "https://client.example.com/callback"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: key.Public(), KeyID: "client-key", Algorithm: string(jose.ES256), Use: "sig"},
+	}})
+	require.NoError(t, err)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	sign := func(t *testing.T, key any, claims map[string]any) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, nil)
+		require.NoError(t, err)
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+		jws, err := signer.Sign(payload)
+		require.NoError(t, err)
+		token, err := jws.CompactSerialize()
+		require.NoError(t, err)
+		return token
+	}
+
+	tests := []struct {
+		name      string
+		signer    any
+		claims    map[string]any
+		wantState string
+		wantError string
+	}{
+		{
+			name:   "claims override query parameters",
+			signer: key,
+			claims: map[string]any{
+				"iss":   "test",
+				"aud":   "ISSUER",
+				"exp":   time.Now().Add(time.Minute).Unix(),
+				"state": "from-request-object",
+				"scope": "openid email",
+			},
+			wantState: "from-request-object",
+		},
+		{
+			name:      "signed by another key",
+			signer:    otherKey,
+			claims:    map[string]any{"state": "from-request-object"},
+			wantState: "from-query",
+			wantError: errInvalidRequestObject,
+		},
+		{
+			name:      "expired",
+			signer:    key,
+			claims:    map[string]any{"exp": time.Now().Add(-time.Minute).Unix()},
+			wantState: "from-query",
+			wantError: errInvalidRequestObject,
+		},
+		{
+			name:      "issued by another client",
+			signer:    key,
+			claims:    map[string]any{"iss": "other"},
+			wantState: "from-query",
+			wantError: errInvalidRequestObject,
+		},
+		{
+			name:      "for another audience",
+			signer:    key,
+			claims:    map[string]any{"aud": "https://other.example.com"},
+			wantState: "from-query",
+			wantError: errInvalidRequestObject,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpServer, s := newTestServer(t, nil)
+			defer httpServer.Close()
+			require.NoError(t, s.storage.CreateClient(t.Context(), storage.Client{
+				ID:           "test",
+				Secret:       "secret",
+				RedirectURIs: []string{redirectURI},
+				JWKS:         string(jwks),
+			}))
+
+			if tc.claims["aud"] == "ISSUER" {
+				tc.claims["aud"] = httpServer.URL
+			}
+			query := url.Values{
+				"client_id":     {"test"},
+				"redirect_uri":  {redirectURI},
+				"response_type": {"code"},
+				"scope":         {"openid"},
+				"state":         {"from-query"},
+				"request":       {sign(t, tc.signer, tc.claims)},
+			}
+
+			callback := followAuthRedirects(t, s, httpServer.URL, "/auth?"+query.Encode())
+			require.Equal(t, redirectURI, callback.Scheme+"://"+callback.Host+callback.Path)
+			require.Equal(t, tc.wantState, callback.Query().Get("state"))
+			require.Equal(t, tc.wantError, callback.Query().Get("error"), callback.Query().Get("error_description"))
+			if tc.wantError == "" {
+				require.NotEmpty(t, callback.Query().Get("code"))
+			}
+		})
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		httpServer, s := newTestServer(t, nil)
+		defer httpServer.Close()
+		require.NoError(t, s.storage.CreateClient(t.Context(), storage.Client{
+			ID:           "test",
+			Secret:       "secret",
+			RedirectURIs: []string{redirectURI},
+			JWKS:         string(jwks),
+		}))
+
+		// {"alg":"none"}.{"state":"from-request-object"}.
+		unsigned := "eyJhbGciOiJub25lIn0.eyJzdGF0ZSI6ImZyb20tcmVxdWVzdC1vYmplY3QifQ."
+		query := url.Values{
+			"client_id":    {"test"},
+			"redirect_uri": {"https://unregistered.example.com"},
+			"request":      {unsigned},
+		}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/auth/mock?"+query.Encode(), nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("pushed", func(t *testing.T) {
+		httpServer, s := newTestServer(t, nil)
+		defer httpServer.Close()
+		require.NoError(t, s.storage.CreateClient(t.Context(), storage.Client{
+			ID:           "test",
+			Secret:       "secret",
+			RedirectURIs: []string{redirectURI},
+			JWKS:         string(jwks),
+		}))
+
+		push := func(requestObject string) *httptest.ResponseRecorder {
+			form := url.Values{"request": {requestObject}}
+			req := httptest.NewRequest(http.MethodPost, "/par", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth("test", "secret")
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+			return rr
+		}
+
+		claims := map[string]any{
+			"redirect_uri":  redirectURI,
+			"response_type": "code",
+			"scope":         "openid",
+			"state":         "from-request-object",
+			"max_age":       300,
+		}
+		rr := push(sign(t, key, claims))
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var resp pushedAuthorizationResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		authReq, err := s.storage.GetAuthRequest(t.Context(), strings.TrimPrefix(resp.RequestURI, requestURIPrefix))
+		require.NoError(t, err)
+		require.Equal(t, "from-request-object", authReq.State)
+		require.Equal(t, 300, authReq.MaxAge)
+
+		rr = push(sign(t, otherKey, claims))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), `"error":"`+errInvalidRequestObject+`"`)
+	})
+}
+
+func TestValidateClientKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		jwks    string
+		jwksURI string
+		wantErr bool
+	}{
+		{name: "none"},
+		{name: "jwks", jwks: `{"keys":[{"kty":"EC","crv":"P-256","x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}]}`},
+		{name: "jwks uri", jwksURI: "https://client.example.com/jwks"},
+		{name: "both", jwks: `{"keys":[]}`, jwksURI: "https://client.example.com/jwks", wantErr: true},
+		{name: "no keys", jwks: `{"keys":[]}`, wantErr: true},
+		{name: "not json", jwks: "keys", wantErr: true},
+		{name: "private key", jwks: `{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`, wantErr: true},
+		{name: "relative uri", jwksURI: "/jwks", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateClientKeys(tc.jwks, tc.jwksURI)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
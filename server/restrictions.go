@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// knownGrantTypes are the grant types a client can be restricted to.
+var knownGrantTypes = []string{
+	grantTypeAuthorizationCode,
+	grantTypeRefreshToken,
+	grantTypeImplicit,
+	grantTypePassword,
+	grantTypeDeviceCode,
+	grantTypeTokenExchange,
+	grantTypeClientCredentials,
+}
+
+// ValidateClientRestrictions checks that the grant and response types a client
+// is restricted to are ones dex knows. Allowed response types are listed one
+// by one: "code", "id_token" and "token".
+func ValidateClientRestrictions(client storage.Client) error {
+	for _, grantType := range client.AllowedGrantTypes {
+		if !slices.Contains(knownGrantTypes, grantType) {
+			return fmt.Errorf("unknown grant type %q", grantType)
+		}
+	}
+	for _, responseType := range client.AllowedResponseTypes {
+		switch responseType {
+		case responseTypeCode, responseTypeIDToken, responseTypeToken:
+		default:
+			return fmt.Errorf("unknown response type %q", responseType)
+		}
+	}
+	return nil
+}
+
+func connectorAllowed(client storage.Client, connID string) bool {
+	return len(client.AllowedConnectors) == 0 || slices.Contains(client.AllowedConnectors, connID)
+}
+
+func grantTypeAllowed(client storage.Client, grantType string) bool {
+	return len(client.AllowedGrantTypes) == 0 || slices.Contains(client.AllowedGrantTypes, grantType)
+}
+
+func responseTypeAllowed(client storage.Client, responseType string) bool {
+	return len(client.AllowedResponseTypes) == 0 || slices.Contains(client.AllowedResponseTypes, responseType)
+}
+
+// clientConnectors narrows connectors to those users of the client with
+// clientID may log in with. Unknown clients are left for the validation of the
+// authorization request to reject.
+func (s *Server) clientConnectors(ctx context.Context, clientID string, connectors []storage.Connector) ([]storage.Connector, error) {
+	client, err := s.storage.GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return connectors, nil
+		}
+		return nil, err
+	}
+	if len(client.AllowedConnectors) == 0 {
+		return connectors, nil
+	}
+	var allowed []storage.Connector
+	for _, conn := range connectors {
+		if connectorAllowed(client, conn.ID) {
+			allowed = append(allowed, conn)
+		}
+	}
+	return allowed, nil
+}
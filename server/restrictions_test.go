@@ -0,0 +1,196 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestValidateClientRestrictions(t *testing.T) {
+	require.NoError(t, ValidateClientRestrictions(storage.Client{}))
+	require.NoError(t, ValidateClientRestrictions(storage.Client{
+		AllowedGrantTypes:    []string{grantTypeAuthorizationCode, grantTypeDeviceCode},
+		AllowedResponseTypes: []string{responseTypeCode, responseTypeIDToken},
+	}))
+	require.Error(t, ValidateClientRestrictions(storage.Client{AllowedGrantTypes: []string{"jwt-bearer"}}))
+	require.Error(t, ValidateClientRestrictions(storage.Client{AllowedResponseTypes: []string{"code id_token"}}))
+}
+
+func TestAuthorizationClientRestrictions(t *testing.T) {
+	httpServer, s := newTestServerMultipleConnectors(t, func(c *Config) {
+		c.SupportedResponseTypes = []string{responseTypeCode, responseTypeIDToken, responseTypeToken}
+		c.Storage, _ = storage.WithStaticClients(c.Storage, []storage.Client{
+			{
+				ID:                   "restricted",
+				RedirectURIs:         []string{"https://client.example.com/cb"},
+				AllowedConnectors:    []string{"mock"},
+				AllowedResponseTypes: []string{responseTypeCode, responseTypeIDToken},
+				RequirePKCE:          true,
+			},
+			{
+				ID:                "code-only",
+				RedirectURIs:      []string{"https://client.example.com/cb"},
+				AllowedGrantTypes: []string{grantTypeAuthorizationCode, grantTypeRefreshToken},
+			},
+		})
+	})
+	defer httpServer.Close()
+
+	tests := []struct {
+		name      string
+		clientID  string
+		params    url.Values
+		wantError string
+	}{
+		{
+			name:     "allowed",
+			clientID: "restricted",
+			params:   url.Values{"response_type": {"code"}, "code_challenge": {"challenge"}, "connector_id": {"mock"}},
+		},
+		{
+			name:      "connector not allowed",
+			clientID:  "restricted",
+			params:    url.Values{"response_type": {"code"}, "code_challenge": {"challenge"}, "connector_id": {"mock2"}},
+			wantError: errInvalidRequest,
+		},
+		{
+			name:      "response type not allowed",
+			clientID:  "restricted",
+			params:    url.Values{"response_type": {"code token"}, "code_challenge": {"challenge"}, "nonce": {"n"}},
+			wantError: errUnauthorizedClient,
+		},
+		{
+			name:      "missing code challenge",
+			clientID:  "restricted",
+			params:    url.Values{"response_type": {"code"}},
+			wantError: errInvalidRequest,
+		},
+		{
+			name:     "implicit without code challenge",
+			clientID: "restricted",
+			params:   url.Values{"response_type": {"id_token"}, "nonce": {"n"}},
+		},
+		{
+			name:      "implicit grant not allowed",
+			clientID:  "code-only",
+			params:    url.Values{"response_type": {"id_token"}, "nonce": {"n"}},
+			wantError: errUnauthorizedClient,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := tc.params
+			params.Set("client_id", tc.clientID)
+			params.Set("redirect_uri", "https://client.example.com/cb")
+			params.Set("scope", "openid")
+			req := httptest.NewRequest(http.MethodGet, httpServer.URL+"/auth?"+params.Encode(), nil)
+
+			_, err := s.parseAuthorizationRequest(req)
+			if tc.wantError == "" {
+				require.NoError(t, err)
+				return
+			}
+			authErr, ok := err.(*redirectedAuthErr)
+			require.True(t, ok, "expected redirectedAuthErr, got %v", err)
+			require.Equal(t, tc.wantError, authErr.Type)
+		})
+	}
+}
+
+func TestLoginPageClientConnectors(t *testing.T) {
+	httpServer, s := newTestServerMultipleConnectors(t, func(c *Config) {
+		c.AlwaysShowLoginScreen = true
+		c.Storage, _ = storage.WithStaticClients(c.Storage, []storage.Client{
+			{ID: "all", RedirectURIs: []string{"https://client.example.com/cb"}},
+			{ID: "mock2-only", RedirectURIs: []string{"https://client.example.com/cb"}, AllowedConnectors: []string{"mock2"}},
+		})
+	})
+	defer httpServer.Close()
+
+	login := func(clientID string, extra url.Values) *httptest.ResponseRecorder {
+		params := url.Values{
+			"client_id":     {clientID},
+			"redirect_uri":  {"https://client.example.com/cb"},
+			"response_type": {"code"},
+			"scope":         {"openid"},
+		}
+		for k, v := range extra {
+			params[k] = v
+		}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/auth?"+params.Encode(), nil))
+		return rr
+	}
+
+	rr := login("all", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), "/auth/mock?")
+	require.Contains(t, rr.Body.String(), "/auth/mock2?")
+
+	rr = login("mock2-only", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NotContains(t, rr.Body.String(), "/auth/mock?")
+	require.Contains(t, rr.Body.String(), "/auth/mock2?")
+
+	// Connectors the client isn't allowed can't be picked directly either.
+	rr = login("mock2-only", url.Values{"connector_id": {"mock"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = httptest.NewRecorder()
+	s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/auth/mock?client_id=mock2-only&redirect_uri=https%3A%2F%2Fclient.example.com%2Fcb&response_type=code&scope=openid", nil))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestTokenGrantClientRestrictions(t *testing.T) {
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Storage, _ = storage.WithStaticClients(c.Storage, []storage.Client{
+			{ID: "cli", Secret: "secret", AllowedGrantTypes: []string{grantTypeAuthorizationCode}},
+		})
+	})
+	defer httpServer.Close()
+
+	v := url.Values{"grant_type": {grantTypeClientCredentials}, "scope": {"openid"}}
+	req := httptest.NewRequest(http.MethodPost, "/token", strings.NewReader(v.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("cli", "secret")
+
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), errUnauthorizedClient)
+}
+
+func TestDeviceCodeClientRestrictions(t *testing.T) {
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Storage, _ = storage.WithStaticClients(c.Storage, []storage.Client{
+			{ID: "web", AllowedGrantTypes: []string{grantTypeAuthorizationCode}},
+			{ID: "tv", RequirePKCE: true},
+		})
+	})
+	defer httpServer.Close()
+
+	deviceCode := func(params url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/device/code", strings.NewReader(params.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := deviceCode(url.Values{"client_id": {"web"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), errUnauthorizedClient)
+
+	rr = deviceCode(url.Values{"client_id": {"tv"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), errInvalidRequest)
+
+	rr = deviceCode(url.Values{"client_id": {"tv"}, "code_challenge": {"challenge"}})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+}
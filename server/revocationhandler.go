@@ -0,0 +1,147 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+// handleRevoke implements the token revocation endpoint described in
+// [IETF RFC 7009](https://tools.ietf.org/html/rfc7009).
+//
+// Refresh tokens and opaque access tokens can be revoked. ID tokens and JWT
+// access tokens are self-contained and stay valid until they expire.
+func (s *Server) handleRevoke(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	if r.Method != http.MethodPost {
+		s.tokenErrHelper(w, errInvalidRequest, "method not allowed", http.StatusBadRequest)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		s.logger.ErrorContext(r.Context(), "could not parse request body", "err", err)
+		s.tokenErrHelper(w, errInvalidRequest, "", http.StatusBadRequest)
+		return
+	}
+
+	s.withClientFromStorage(w, r, s.revokeToken)
+}
+
+func (s *Server) revokeToken(w http.ResponseWriter, r *http.Request, client storage.Client) {
+	ctx := r.Context()
+
+	code := r.PostFormValue("token")
+	if code == "" {
+		s.tokenErrHelper(w, errInvalidRequest, "The POST body doesn't contain 'token' parameter.", http.StatusBadRequest)
+		return
+	}
+
+	tokenType, err := s.guessTokenType(ctx, code)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to guess token type", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	if tokenType == AccessToken {
+		if isJWT(code) {
+			s.tokenErrHelper(w, errUnsupportedTokenType, "JWT access tokens cannot be revoked.", http.StatusBadRequest)
+			return
+		}
+		s.revokeAccessToken(w, r, client, code)
+		return
+	}
+
+	token := new(internal.RefreshToken)
+	if err := internal.Unmarshal(code, token); err != nil {
+		// For backward compatibility, assume the token is a raw refresh token ID.
+		token = &internal.RefreshToken{RefreshId: code, Token: ""}
+	}
+
+	refresh, err := s.storage.GetRefresh(ctx, token.RefreshId)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get refresh token", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		// Per RFC 7009, invalid tokens do not cause an error response; the
+		// client cannot do anything about them anyway.
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if refresh.Token != token.Token && (refresh.ObsoleteToken == "" || refresh.ObsoleteToken != token.Token) {
+		// Knowing the refresh token ID is not enough to revoke it.
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if refresh.ClientID != client.ID {
+		// RFC 7009, section 2.1: the token is left alone, and the client isn't
+		// told it belongs to another client.
+		s.logger.WarnContext(ctx, "trying to revoke token issued to a different client",
+			"client_id", client.ID, "refresh_client_id", refresh.ClientID)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// RFC 7009, section 2.1: the access tokens issued with the refresh token
+	// are revoked with it. JWT access tokens can't be, see handleRevoke.
+	if err := s.storage.DeleteRefreshAccessTokens(ctx, refresh.ID); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to delete access tokens of refresh token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	updater := func(old storage.OfflineSessions) (storage.OfflineSessions, error) {
+		if ref, ok := old.Refresh[refresh.ClientID]; ok && ref.ID == refresh.ID {
+			delete(old.Refresh, refresh.ClientID)
+		}
+		return old, nil
+	}
+	if err := s.storage.UpdateOfflineSessions(ctx, refresh.Claims.UserID, refresh.ConnectorID, updater); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to update offline session", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	if err := s.storage.DeleteRefresh(ctx, refresh.ID); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to delete refresh token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.InfoContext(ctx, "refresh token revoked", "client_id", client.ID, "token_id", refresh.ID)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) revokeAccessToken(w http.ResponseWriter, r *http.Request, client storage.Client, token string) {
+	ctx := r.Context()
+
+	accessToken, err := s.storage.GetAccessToken(ctx, hashToken(token))
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get access token", "err", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if accessToken.ClientID != client.ID {
+		s.logger.WarnContext(ctx, "trying to revoke token issued to a different client",
+			"client_id", client.ID, "access_token_client_id", accessToken.ClientID)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := s.storage.DeleteAccessToken(ctx, accessToken.ID); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to delete access token", "err", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.InfoContext(ctx, "access token revoked", "client_id", client.ID)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,214 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+func TestHandleRevoke(t *testing.T) {
+	refreshToken, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "bar"})
+	require.NoError(t, err)
+	wrongSecret, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "baz"})
+	require.NoError(t, err)
+	unknownToken, err := internal.Marshal(&internal.RefreshToken{RefreshId: "unknown", Token: "bar"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		method       string
+		clientID     string
+		clientSecret string
+		token        string
+		// Set if token is replaced by a freshly signed ID token.
+		accessToken bool
+
+		wantCode    int
+		wantError   string
+		wantRevoked bool
+	}{
+		{
+			name:         "refresh token",
+			clientID:     "test",
+			clientSecret: "barfoo",
+			token:        refreshToken,
+			wantCode:     http.StatusOK,
+			wantRevoked:  true,
+		},
+		{
+			name:         "unknown token",
+			clientID:     "test",
+			clientSecret: "barfoo",
+			token:        unknownToken,
+			wantCode:     http.StatusOK,
+		},
+		{
+			name:         "wrong token secret",
+			clientID:     "test",
+			clientSecret: "barfoo",
+			token:        wrongSecret,
+			wantCode:     http.StatusOK,
+		},
+		{
+			name:         "invalid client credentials",
+			clientID:     "test",
+			clientSecret: "wrong",
+			token:        refreshToken,
+			wantCode:     http.StatusUnauthorized,
+			wantError:    errInvalidClient,
+		},
+		{
+			name:         "token of another client",
+			clientID:     "other",
+			clientSecret: "secret",
+			token:        refreshToken,
+			wantCode:     http.StatusOK,
+		},
+		{
+			name:         "access token",
+			clientID:     "test",
+			clientSecret: "barfoo",
+			accessToken:  true,
+			wantCode:     http.StatusBadRequest,
+			wantError:    errUnsupportedTokenType,
+		},
+		{
+			name:         "no token",
+			clientID:     "test",
+			clientSecret: "barfoo",
+			wantCode:     http.StatusBadRequest,
+			wantError:    errInvalidRequest,
+		},
+		{
+			name:         "GET request",
+			method:       http.MethodGet,
+			clientID:     "test",
+			clientSecret: "barfoo",
+			token:        refreshToken,
+			wantCode:     http.StatusBadRequest,
+			wantError:    errInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			httpServer, s := newTestServer(t, nil)
+			defer httpServer.Close()
+
+			mockTestStorage(t, s.storage)
+			require.NoError(t, s.storage.CreateClient(ctx, storage.Client{ID: "other", Secret: "secret"}))
+
+			token := tc.token
+			if tc.accessToken {
+				token, _, _, err = s.newIDToken(ctx, storage.Client{ID: "test"}, storage.Claims{UserID: "1"}, storage.Authentication{}, []string{"openid"}, "", "", "", "test", nil)
+				require.NoError(t, err)
+			}
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			form := url.Values{}
+			if token != "" {
+				form.Set("token", token)
+			}
+			req := httptest.NewRequest(method, "/token/revoke", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(tc.clientID, tc.clientSecret)
+
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantCode, rr.Code, rr.Body.String())
+			if tc.wantError != "" {
+				require.Contains(t, rr.Body.String(), `"error":"`+tc.wantError+`"`)
+			}
+
+			_, err := s.storage.GetRefresh(ctx, "test")
+			session, sessionErr := s.storage.GetOfflineSessions(ctx, "1", "test")
+			require.NoError(t, sessionErr)
+			if tc.wantRevoked {
+				require.ErrorIs(t, err, storage.ErrNotFound)
+				require.NotContains(t, session.Refresh, "test")
+			} else {
+				require.NoError(t, err)
+				require.Contains(t, session.Refresh, "test")
+			}
+		})
+	}
+}
+
+func TestRevokeOpaqueAccessTokens(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.PasswordConnector = "test"
+	})
+	defer httpServer.Close()
+
+	mockConnectorDataTestStorage(t, s.storage)
+	require.NoError(t, s.storage.UpdateClient(ctx, "test", func(old storage.Client) (storage.Client, error) {
+		old.AccessTokenFormat = accessTokenFormatOpaque
+		return old, nil
+	}))
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{ID: "other", Secret: "secret"}))
+
+	post := func(t *testing.T, clientID, secret, path string, vals url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(vals.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(clientID, secret)
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+	tokens := func(t *testing.T, vals url.Values) accessTokenResponse {
+		rr := post(t, "test", "barfoo", "/token", vals)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var res accessTokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		return res
+	}
+	revoke := func(t *testing.T, clientID, secret, token string) {
+		rr := post(t, clientID, secret, "/token/revoke", url.Values{"token": {token}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	}
+	stored := func(token string) bool {
+		_, err := s.storage.GetAccessToken(ctx, hashToken(token))
+		if err == storage.ErrNotFound {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	res := tokens(t, url.Values{
+		"grant_type": {grantTypePassword},
+		"scope":      {"openid offline_access"},
+		"username":   {"test"},
+		"password":   {"test"},
+	})
+	require.NotEmpty(t, res.RefreshToken)
+	refreshed := tokens(t, url.Values{
+		"grant_type":    {grantTypeRefreshToken},
+		"refresh_token": {res.RefreshToken},
+	})
+
+	// Tokens of another client are left alone.
+	revoke(t, "other", "secret", res.AccessToken)
+	revoke(t, "other", "secret", refreshed.RefreshToken)
+	require.True(t, stored(res.AccessToken))
+	require.True(t, stored(refreshed.AccessToken))
+
+	// Revoking the refresh token revokes the access tokens issued with it.
+	revoke(t, "test", "barfoo", refreshed.RefreshToken)
+	require.False(t, stored(res.AccessToken))
+	require.False(t, stored(refreshed.AccessToken))
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// builtinScopes are the scopes dex defines itself. Custom scopes can't
+// redefine them.
+var builtinScopes = []string{scopeOpenID, scopeOfflineAccess, scopeEmail, scopeProfile, scopeGroups, scopeFederatedID}
+
+// ValidateScope checks a custom scope before it is stored.
+func ValidateScope(sc storage.Scope) error {
+	if sc.Name == "" {
+		return errors.New("scope has no name")
+	}
+	// RFC 6749, section 3.3: scope-token = 1*( %x21 / %x23-5B / %x5D-7E )
+	for _, r := range sc.Name {
+		if r < 0x21 || r > 0x7e || r == '"' || r == '\\' {
+			return fmt.Errorf("scope %q contains characters scopes can't hold", sc.Name)
+		}
+	}
+	if slices.Contains(builtinScopes, sc.Name) {
+		return fmt.Errorf("scope %q is built in", sc.Name)
+	}
+	if strings.HasPrefix(sc.Name, scopeCrossClientPrefix) {
+		return fmt.Errorf("scope %q is reserved for cross-client requests", sc.Name)
+	}
+	for _, claim := range sc.Claims {
+		if protectedClaims[claim] || authenticationClaims[claim] {
+			return fmt.Errorf("scope %q can't release claim %q", sc.Name, claim)
+		}
+	}
+	return nil
+}
+
+// customScope returns the custom scope with name, or nil if there is none.
+func (s *Server) customScope(ctx context.Context, name string) (*storage.Scope, error) {
+	sc, err := s.storage.GetScope(ctx, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sc, nil
+}
+
+// customScopeAllowed reports whether scope is a custom scope, and if so,
+// whether the client with clientID may request it.
+func (s *Server) customScopeAllowed(ctx context.Context, clientID, scope string) (custom, allowed bool, err error) {
+	sc, err := s.customScope(ctx, scope)
+	if err != nil || sc == nil {
+		return false, false, err
+	}
+	return true, len(sc.ClientIDs) == 0 || slices.Contains(sc.ClientIDs, clientID), nil
+}
+
+// customScopesCacheFor is how long the discovery document advertises the
+// custom scopes it listed, rather than listing them on every request.
+const customScopesCacheFor = 30 * time.Second
+
+// cachedScopes are the sorted names of the custom scopes.
+type cachedScopes struct {
+	names  []string
+	expiry time.Time
+}
+
+// customScopeNames returns the sorted names of the custom scopes, listing them
+// at most once every customScopesCacheFor.
+func (s *Server) customScopeNames(ctx context.Context) ([]string, error) {
+	cached, ok := s.customScopes.Load().(*cachedScopes)
+	if ok && cached != nil && s.now().Before(cached.expiry) {
+		return cached.names, nil
+	}
+
+	custom, err := s.storage.ListScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(custom))
+	for _, sc := range custom {
+		names = append(names, sc.Name)
+	}
+	slices.Sort(names)
+	s.customScopes.Store(&cachedScopes{names: names, expiry: s.now().Add(customScopesCacheFor)})
+	return names, nil
+}
+
+// supportedScopes lists the scopes advertised in the discovery document.
+func (s *Server) supportedScopes(ctx context.Context) []string {
+	scopes := []string{scopeOpenID, scopeEmail, scopeGroups, scopeProfile, scopeOfflineAccess}
+	if s.extraClaimsScope != "" {
+		scopes = append(scopes, s.extraClaimsScope)
+	}
+
+	names, err := s.customScopeNames(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list scopes", "err", err)
+		return scopes
+	}
+	return append(scopes, names...)
+}
+
+// scopeDescription picks the description of a custom scope in the first of
+// langs it has one in, falling back to English and then to its name.
+func scopeDescription(sc storage.Scope, langs []string) string {
+	// Appending to langs itself could overwrite the caller's backing array.
+	for _, lang := range append(slices.Clone(langs), "en") {
+		if description, ok := sc.Descriptions[lang]; ok {
+			return description
+		}
+	}
+	return sc.Name
+}
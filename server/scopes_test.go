@@ -0,0 +1,145 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestValidateScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   storage.Scope
+		wantErr bool
+	}{
+		{"valid", storage.Scope{Name: "orders:read", Claims: []string{"customer_id"}}, false},
+		{"no name", storage.Scope{}, true},
+		{"whitespace", storage.Scope{Name: "orders read"}, true},
+		{"quote", storage.Scope{Name: `orders"`}, true},
+		{"built in", storage.Scope{Name: "email"}, true},
+		{"cross client", storage.Scope{Name: "audience:server:client_id:peer"}, true},
+		{"protected claim", storage.Scope{Name: "orders", Claims: []string{"sub"}}, true},
+		{"authentication claim", storage.Scope{Name: "orders", Claims: []string{"acr"}}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateScope(tc.scope)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomScopeAuthorization(t *testing.T) {
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Storage, _ = storage.WithStaticClients(c.Storage, []storage.Client{
+			{ID: "shop", RedirectURIs: []string{"https://shop.example.com/cb"}},
+			{ID: "other", RedirectURIs: []string{"https://other.example.com/cb"}},
+		})
+	})
+	defer httpServer.Close()
+
+	ctx := t.Context()
+	require.NoError(t, s.storage.CreateScope(ctx, storage.Scope{Name: "orders", ClientIDs: []string{"shop"}}))
+	require.NoError(t, s.storage.CreateScope(ctx, storage.Scope{Name: "department"}))
+
+	tests := []struct {
+		name     string
+		clientID string
+		scope    string
+		wantErr  bool
+	}{
+		{"allowed client", "shop", "openid orders", false},
+		{"scope for every client", "other", "openid department", false},
+		{"other client", "other", "openid orders", true},
+		{"unknown scope", "shop", "openid invoices", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := url.Values{
+				"client_id":     {tc.clientID},
+				"redirect_uri":  {"https://" + tc.clientID + ".example.com/cb"},
+				"response_type": {"code"},
+				"scope":         {tc.scope},
+			}
+			req := httptest.NewRequest(http.MethodGet, httpServer.URL+"/auth?"+params.Encode(), nil)
+			_, err := s.parseAuthorizationRequest(req)
+			if !tc.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			authErr, ok := err.(*redirectedAuthErr)
+			require.True(t, ok, "expected redirectedAuthErr, got %v", err)
+			require.Equal(t, errInvalidScope, authErr.Type)
+		})
+	}
+}
+
+func TestCustomScopeReleasesClaims(t *testing.T) {
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	ctx := t.Context()
+	require.NoError(t, s.storage.CreateScope(ctx, storage.Scope{Name: "department", Claims: []string{"department", "cost_center"}}))
+
+	claims := storage.Claims{ExtraClaims: map[string]any{
+		"department": "engineering",
+		"salary":     100,
+	}}
+	require.Equal(t, map[string]any{"department": "engineering"}, s.releasedExtraClaims(ctx, claims, []string{"openid", "department"}))
+	require.Nil(t, s.releasedExtraClaims(ctx, claims, []string{"openid", "email"}))
+}
+
+func TestSupportedScopesDiscovery(t *testing.T) {
+	now := time.Now()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Now = func() time.Time { return now }
+	})
+	defer httpServer.Close()
+
+	discoveredScopes := func(t *testing.T) []string {
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var d discovery
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &d))
+		return d.Scopes
+	}
+
+	// Scopes added while the server runs are advertised once the cached list
+	// expires.
+	require.NoError(t, s.storage.CreateScope(t.Context(), storage.Scope{Name: "department"}))
+	scopes := discoveredScopes(t)
+	require.Contains(t, scopes, "openid")
+	require.NotContains(t, scopes, "department")
+
+	now = now.Add(customScopesCacheFor)
+	require.Contains(t, discoveredScopes(t), "department")
+}
+
+func TestScopeDescription(t *testing.T) {
+	sc := storage.Scope{
+		Name:         "department",
+		Descriptions: map[string]string{"en": "View your department", "de": "Ihre Abteilung ansehen"},
+	}
+	require.Equal(t, "Ihre Abteilung ansehen", scopeDescription(sc, preferredLanguages("de-DE,en;q=0.8")))
+	require.Equal(t, "View your department", scopeDescription(sc, preferredLanguages("fr")))
+	require.Equal(t, "department", scopeDescription(storage.Scope{Name: "department"}, nil))
+
+	// The fallback isn't appended to the caller's slice.
+	langs := make([]string, 1, 2)
+	langs[0] = "fr"
+	require.Equal(t, "View your department", scopeDescription(sc, langs))
+	require.Empty(t, langs[:2][1])
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -100,6 +102,44 @@ type Config struct {
 	AuthRequestsValidFor   time.Duration // Defaults to 24 hours
 	DeviceRequestsValidFor time.Duration // Defaults to 5 minutes
 
+	PushedAuthRequestsValidFor time.Duration // Defaults to 5 minutes
+
+	AccessTokensValidFor            time.Duration // Defaults to IDTokensValidFor
+	ClientCredentialsTokensValidFor time.Duration // Defaults to 1 hour
+
+	// TLSClientAuth enables mutual TLS client authentication and certificate
+	// bound access tokens (RFC 8705) on the token endpoint. The listener must
+	// request client certificates without verifying them, since each client is
+	// verified according to its token endpoint auth method.
+	TLSClientAuth bool
+
+	// TLSClientCAs verifies the certificates of "tls_client_auth" clients.
+	TLSClientCAs *x509.CertPool
+
+	// Resources maps the resource indicators (RFC 8707) clients may pass to the
+	// token endpoint to the audience of the access tokens issued for them.
+	Resources map[string]string
+
+	// /userinfo accepts the untyped access tokens issued before RFC 9068 access
+	// tokens until then. These are copies of ID tokens, so ID tokens are accepted
+	// as well. Defaults to the ID token expiry after the server starts, when the
+	// last of them expire; a time in the past rejects them right away.
+	LegacyAccessTokensUntil time.Time
+
+	// ExtraClaimsScope is the scope clients request to receive the extra
+	// claims connectors pass on about users. They are never released if it
+	// is empty.
+	ExtraClaimsScope string
+
+	// SubjectFormat is how the "sub" claim is formed from a user ID and the
+	// connector the user logged in with: "raw", the default, "connector",
+	// "uuid" or "legacy". See subject.go.
+	SubjectFormat string
+
+	// PairwiseSubjectSecret keys the "sub" claims of clients with the pairwise
+	// subject type. Changing it changes the subjects those clients see.
+	PairwiseSubjectSecret string
+
 	// Refresh token expiration settings
 	RefreshTokenPolicy *RefreshTokenPolicy
 
@@ -133,6 +173,24 @@ type Config struct {
 	// LoginRateLimit throttles failed password logins before they reach the
 	// upstream identity provider.
 	LoginRateLimit LoginRateLimitConfig
+
+	// Sessions lets a browser that already logged in through a connector reuse
+	// that login for other clients instead of authenticating again.
+	Sessions SessionConfig
+}
+
+// SessionConfig configures browser single sign-on sessions. See
+// Server.reusableSession for when a session is honoured.
+type SessionConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// A session ends after it has not been used for IdleTimeout.
+	// Defaults to 1h.
+	IdleTimeout time.Duration `json:"idleTimeout"`
+
+	// A session ends AbsoluteLifetime after the user authenticated, however
+	// often it is used. Defaults to 24h.
+	AbsoluteLifetime time.Duration `json:"absoluteLifetime"`
 }
 
 // LoginRateLimitConfig configures the brute force protection applied to the
@@ -244,6 +302,25 @@ type Server struct {
 	authRequestsValidFor   time.Duration
 	deviceRequestsValidFor time.Duration
 
+	pushedAuthRequestsValidFor time.Duration
+
+	accessTokensValidFor            time.Duration
+	clientCredentialsTokensValidFor time.Duration
+
+	resources map[string]string
+
+	legacyAccessTokensUntil time.Time
+
+	extraClaimsScope string
+
+	customScopes atomic.Value // Always holds nil or type *cachedScopes.
+
+	subjectFormat         string
+	pairwiseSubjectSecret []byte
+
+	tlsClientAuth bool
+	tlsClientCAs  *x509.CertPool
+
 	refreshTokenPolicy *RefreshTokenPolicy
 
 	logger *slog.Logger
@@ -255,6 +332,17 @@ type Server struct {
 
 	mfaTrust MFATrustConfig
 
+	sessions SessionConfig
+
+	// Wakes the back-channel logout worker when a logout is queued.
+	logoutQueued      chan struct{}
+	backchannelClient *http.Client
+
+	remoteKeySets remoteKeySets
+
+	// Fetches the sector_identifier_uri of dynamically registered clients.
+	sectorIdentifierClient *http.Client
+
 	loginLimiter *loginLimiter
 }
 
@@ -277,7 +365,7 @@ func newServer(ctx context.Context, c Config) (*Server, error) {
 		c.SupportedResponseTypes = []string{responseTypeCode}
 	}
 	if len(c.AllowedHeaders) == 0 {
-		c.AllowedHeaders = []string{"Authorization"}
+		c.AllowedHeaders = []string{"Authorization", dpopHeader}
 	}
 
 	allSupportedGrants := map[string]bool{
@@ -285,6 +373,7 @@ func newServer(ctx context.Context, c Config) (*Server, error) {
 		grantTypeRefreshToken:      true,
 		grantTypeDeviceCode:        true,
 		grantTypeTokenExchange:     true,
+		grantTypeClientCredentials: true,
 	}
 	supportedRes := make(map[string]bool)
 
@@ -306,6 +395,15 @@ func newServer(ctx context.Context, c Config) (*Server, error) {
 		allSupportedGrants[grantTypePassword] = true
 	}
 
+	switch c.ExtraClaimsScope {
+	case scopeOpenID, scopeOfflineAccess, scopeEmail, scopeProfile, scopeGroups, scopeFederatedID:
+		return nil, fmt.Errorf("server: extra claims scope %q is already a standard scope", c.ExtraClaimsScope)
+	}
+
+	if !slices.Contains(subjectFormats, defaultTo(c.SubjectFormat, subjectFormatRaw)) {
+		return nil, fmt.Errorf("server: unsupported subject format %q", c.SubjectFormat)
+	}
+
 	var supportedGrants []string
 	if len(c.AllowedGrantTypes) > 0 {
 		for _, grant := range c.AllowedGrantTypes {
@@ -371,10 +469,34 @@ func newServer(ctx context.Context, c Config) (*Server, error) {
 		signer:                 c.Signer,
 		clientThemes:           c.Web.ClientThemes,
 		mfaTrust:               c.MFATrust,
+		sessions:               c.Sessions,
+		logoutQueued:           make(chan struct{}, 1),
+		backchannelClient:      &http.Client{Timeout: 10 * time.Second},
+		sectorIdentifierClient: &http.Client{Timeout: 10 * time.Second},
+
+		accessTokensValidFor:            value(c.AccessTokensValidFor, value(c.IDTokensValidFor, 24*time.Hour)),
+		clientCredentialsTokensValidFor: value(c.ClientCredentialsTokensValidFor, time.Hour),
+		pushedAuthRequestsValidFor:      value(c.PushedAuthRequestsValidFor, 5*time.Minute),
+		resources:                       c.Resources,
+		legacyAccessTokensUntil:         c.LegacyAccessTokensUntil,
+		extraClaimsScope:                c.ExtraClaimsScope,
+		subjectFormat:                   defaultTo(c.SubjectFormat, subjectFormatRaw),
+		pairwiseSubjectSecret:           []byte(c.PairwiseSubjectSecret),
+		tlsClientAuth:                   c.TLSClientAuth,
+		tlsClientCAs:                    c.TLSClientCAs,
+	}
+	if s.legacyAccessTokensUntil.IsZero() {
+		s.legacyAccessTokensUntil = now().Add(s.idTokensValidFor)
 	}
 	if s.mfaTrust.Duration <= 0 {
 		s.mfaTrust.Duration = 720 * time.Hour
 	}
+	if s.sessions.IdleTimeout <= 0 {
+		s.sessions.IdleTimeout = time.Hour
+	}
+	if s.sessions.AbsoluteLifetime <= 0 {
+		s.sessions.AbsoluteLifetime = 24 * time.Hour
+	}
 
 	loginRateLimit := c.LoginRateLimit
 	if loginRateLimit.Attempts <= 0 {
@@ -544,6 +666,10 @@ func newServer(ctx context.Context, c Config) (*Server, error) {
 	handleWithCORS("/keys", s.handlePublicKeys)
 	handleWithCORS("/userinfo", s.handleUserInfo)
 	handleWithCORS("/token/introspect", s.handleIntrospect)
+	handleWithCORS("/token/revoke", s.handleRevoke)
+	handleWithCORS("/par", s.handlePushedAuthorizationRequest)
+	handleWithCORS("/register", s.handleRegister)
+	handleWithCORS("/register/{client_id}", s.handleRegisteredClient)
 	handleFunc("/auth", s.handleAuthorization)
 	handleFunc("/auth/{connector}", s.handleConnectorLogin)
 	handleFunc("/auth/{connector}/login", s.handlePasswordLogin)
@@ -567,6 +693,7 @@ func newServer(ctx context.Context, c Config) (*Server, error) {
 	// "authproxy" connector.
 	handleFunc("/callback/{connector}", s.handleConnectorCallback)
 	handleFunc("/approval", s.handleApproval)
+	handleFunc("/logout", s.handleLogout)
 	handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !c.HealthChecker.IsHealthy() {
 			s.renderError(r, w, http.StatusInternalServerError, "Health check failed.")
@@ -583,6 +710,7 @@ func newServer(ctx context.Context, c Config) (*Server, error) {
 
 	s.signer.Start(ctx)
 	s.startGarbageCollection(ctx, value(c.GCFrequency, 5*time.Minute), now)
+	s.startBackchannelLogout(ctx)
 
 	return s, nil
 }
@@ -731,7 +859,10 @@ func (s *Server) startGarbageCollection(ctx context.Context, frequency time.Dura
 				} else if !r.IsEmpty() {
 					s.logger.InfoContext(ctx, "garbage collection run, delete auth",
 						"requests", r.AuthRequests, "auth_codes", r.AuthCodes,
-						"device_requests", r.DeviceRequests, "device_tokens", r.DeviceTokens)
+						"device_requests", r.DeviceRequests, "device_tokens", r.DeviceTokens,
+						"user_sessions", r.UserSessions, "logout_notifications", r.LogoutNotifications,
+						"access_tokens", r.AccessTokens, "initial_access_tokens", r.InitialAccessTokens,
+						"client_assertions", r.ClientAssertions, "dpop_proofs", r.DPoPProofs)
 				}
 			}
 		}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/storage"
+)
+
+const sessionCookieName = "dex_session"
+
+// A session records the identity a connector returned, keyed by a random ID
+// kept in a cookie. Later authorization requests from the same browser, for
+// any client, reuse that identity instead of sending the user back to the
+// connector. Only the cookie value lives in the browser; the identity stays in
+// storage, so deleting the session server side ends it everywhere.
+func (s *Server) sessionCookie(id string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    id,
+		Path:     s.absPath("/"),
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   s.issuerURL.Scheme == "https",
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// reusableSession returns the browser's session if it is still valid and may
+// answer the authorization request in r without a fresh login. An empty connID
+// accepts a session from any connector.
+func (s *Server) reusableSession(r *http.Request, connID string) (storage.UserSession, bool) {
+	if !s.sessions.Enabled {
+		return storage.UserSession{}, false
+	}
+	c, err := r.Cookie(sessionCookieName)
+	if err != nil || c.Value == "" {
+		return storage.UserSession{}, false
+	}
+
+	ctx := r.Context()
+	session, err := s.storage.GetUserSession(ctx, c.Value)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get user session", "err", err)
+		}
+		return storage.UserSession{}, false
+	}
+
+	now := s.now()
+	if !now.Before(session.Expiry) {
+		return storage.UserSession{}, false
+	}
+	if connID != "" && session.ConnectorID != connID {
+		return storage.UserSession{}, false
+	}
+	// Sessions older than dex recording the login time started with the login.
+	authTime := session.Authentication.Time
+	if authTime.IsZero() {
+		authTime = session.CreatedAt
+	}
+	if requiresFreshLogin(r.Form, authTime, now) {
+		return storage.UserSession{}, false
+	}
+	// A session older than dex recording how users authenticated meets no
+	// acr_values, so step-up requests always log in again.
+	if !meetsACRValues(strings.Fields(r.Form.Get("acr_values")), session.Authentication.ACR) {
+		return storage.UserSession{}, false
+	}
+	return session, true
+}
+
+// requiresFreshLogin reports whether the authorization request asks the user
+// to authenticate again: prompt=login always does, and max_age does once the
+// login is older than it allows.
+func requiresFreshLogin(q url.Values, authTime, now time.Time) bool {
+	if hasPrompt(q.Get("prompt"), promptLogin) {
+		return true
+	}
+	if v := q.Get("max_age"); v != "" {
+		maxAge, err := strconv.Atoi(v)
+		if err != nil || now.Sub(authTime) > time.Duration(maxAge)*time.Second {
+			return true
+		}
+	}
+	return false
+}
+
+// startSession records a fresh connector login for clientID and points the
+// browser's session cookie at it. Any session the browser held before is
+// dropped, so a cookie planted before the login cannot ride along with it.
+func (s *Server) startSession(w http.ResponseWriter, r *http.Request, clientID, connID string, identity connector.Identity, authn storage.Authentication) {
+	if !s.sessions.Enabled {
+		return
+	}
+	ctx := r.Context()
+
+	s.endSession(ctx, r)
+
+	now := s.now()
+	session := storage.UserSession{
+		ID:            storage.NewID(),
+		ConnectorID:   connID,
+		ConnectorData: identity.ConnectorData,
+		Claims: storage.Claims{
+			UserID:            identity.UserID,
+			Username:          identity.Username,
+			PreferredUsername: identity.PreferredUsername,
+			Email:             identity.Email,
+			EmailVerified:     identity.EmailVerified,
+			Groups:            identity.Groups,
+			ExtraClaims:       identity.ExtraClaims,
+		},
+		Authentication: authn,
+		ClientIDs:      []string{clientID},
+		CreatedAt:      now,
+		LastUsed:       now,
+		Expiry:         s.sessionExpiry(now, now),
+	}
+	if err := s.storage.CreateUserSession(ctx, session); err != nil {
+		// The login itself succeeded; the user only loses single sign-on.
+		s.logger.ErrorContext(ctx, "failed to create user session", "err", err)
+		return
+	}
+	http.SetCookie(w, s.sessionCookie(session.ID, int(s.sessions.AbsoluteLifetime.Seconds())))
+}
+
+// resumeSession extends the idle timeout of a session that is about to answer
+// an authorization request of clientID, and returns the identity it holds.
+func (s *Server) resumeSession(ctx context.Context, session storage.UserSession, clientID string) connector.Identity {
+	now := s.now()
+	updater := func(old storage.UserSession) (storage.UserSession, error) {
+		old.LastUsed = now
+		old.Expiry = s.sessionExpiry(old.CreatedAt, now)
+		if !slices.Contains(old.ClientIDs, clientID) {
+			old.ClientIDs = append(old.ClientIDs, clientID)
+		}
+		return old, nil
+	}
+	if err := s.storage.UpdateUserSession(ctx, session.ID, updater); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update user session", "err", err)
+	}
+
+	return connector.Identity{
+		UserID:            session.Claims.UserID,
+		Username:          session.Claims.Username,
+		PreferredUsername: session.Claims.PreferredUsername,
+		Email:             session.Claims.Email,
+		EmailVerified:     session.Claims.EmailVerified,
+		Groups:            session.Claims.Groups,
+		ExtraClaims:       session.Claims.ExtraClaims,
+		ConnectorData:     session.ConnectorData,
+	}
+}
+
+// endSession deletes the session the browser's cookie points at and returns
+// it. The boolean reports whether the browser sent a session cookie at all; the
+// session is empty if the cookie no longer matched one.
+func (s *Server) endSession(ctx context.Context, r *http.Request) (storage.UserSession, bool) {
+	c, err := r.Cookie(sessionCookieName)
+	if err != nil || c.Value == "" {
+		return storage.UserSession{}, false
+	}
+	session, err := s.storage.GetUserSession(ctx, c.Value)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.ErrorContext(ctx, "failed to get user session", "err", err)
+		}
+		return storage.UserSession{}, true
+	}
+	if err := s.storage.DeleteUserSession(ctx, c.Value); err != nil && err != storage.ErrNotFound {
+		s.logger.ErrorContext(ctx, "failed to delete user session", "err", err)
+	}
+	return session, true
+}
+
+func (s *Server) sessionExpiry(createdAt, lastUsed time.Time) time.Time {
+	idle := lastUsed.Add(s.sessions.IdleTimeout)
+	absolute := createdAt.Add(s.sessions.AbsoluteLifetime)
+	if idle.Before(absolute) {
+		return idle
+	}
+	return absolute
+}
@@ -0,0 +1,315 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func newSessionTestServer(t *testing.T) (*Server, *time.Time) {
+	now := time.Now()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.Now = func() time.Time { return now }
+		c.Sessions = SessionConfig{
+			Enabled:          true,
+			IdleTimeout:      time.Hour,
+			AbsoluteLifetime: 3 * time.Hour,
+		}
+	})
+	t.Cleanup(httpServer.Close)
+
+	for _, id := range []string{"app1", "app2"} {
+		require.NoError(t, s.storage.CreateClient(t.Context(), storage.Client{
+			ID:           id,
+			Secret:       "secret",
+			RedirectURIs: []string{"https://" + id + ".example.com/callback"},
+		}))
+	}
+	return s, &now
+}
+
+// authorize starts an authorization request against the mock connector.
+func authorize(s *Server, clientID string, cookie *http.Cookie, extra url.Values) *httptest.ResponseRecorder {
+	q := url.Values{
+		"client_id":     {clientID},
+		"redirect_uri":  {"https://" + clientID + ".example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+		"state":         {"state"},
+	}
+	for k, v := range extra {
+		q[k] = v
+	}
+	r := httptest.NewRequest(http.MethodGet, "/auth/mock?"+q.Encode(), nil)
+	if cookie != nil {
+		r.AddCookie(cookie)
+	}
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, r)
+	return rr
+}
+
+// login runs a full connector login for clientID and returns the session cookie.
+func login(t *testing.T, s *Server, clientID string) *http.Cookie {
+	t.Helper()
+	rr := authorize(s, clientID, nil, nil)
+	requireConnectorRedirect(t, rr)
+
+	callback := rr.Header().Get("Location")
+	rr = httptest.NewRecorder()
+	s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, callback, nil))
+	require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+
+	for _, c := range rr.Result().Cookies() {
+		if c.Name == sessionCookieName {
+			return c
+		}
+	}
+	t.Fatal("login did not set a session cookie")
+	return nil
+}
+
+func requireCodeResponse(t *testing.T, rr *httptest.ResponseRecorder, clientID string) {
+	t.Helper()
+	require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, clientID+".example.com", u.Host)
+	require.NotEmpty(t, u.Query().Get("code"))
+}
+
+func requireConnectorRedirect(t *testing.T, rr *httptest.ResponseRecorder) {
+	t.Helper()
+	require.Equal(t, http.StatusFound, rr.Code, rr.Body.String())
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "/callback", u.Path)
+}
+
+func TestSessionReusedAcrossClients(t *testing.T) {
+	s, _ := newSessionTestServer(t)
+	cookie := login(t, s, "app1")
+
+	requireCodeResponse(t, authorize(s, "app2", cookie, nil), "app2")
+
+	session, err := s.storage.GetUserSession(t.Context(), cookie.Value)
+	require.NoError(t, err)
+	require.Equal(t, "mock", session.ConnectorID)
+	require.Equal(t, "0-385-28089-0", session.Claims.UserID)
+	require.Equal(t, []string{"app1", "app2"}, session.ClientIDs)
+	require.NotEmpty(t, session.Authentication.SessionID)
+}
+
+func TestSessionForcedLogin(t *testing.T) {
+	tests := []struct {
+		name      string
+		elapsed   time.Duration
+		query     url.Values
+		wantReuse bool
+	}{
+		{
+			name:      "within idle timeout",
+			elapsed:   30 * time.Minute,
+			wantReuse: true,
+		},
+		{
+			name:    "prompt login",
+			query:   url.Values{"prompt": {"login"}},
+			elapsed: time.Minute,
+		},
+		{
+			name:      "max_age satisfied",
+			query:     url.Values{"max_age": {"3600"}},
+			elapsed:   time.Minute,
+			wantReuse: true,
+		},
+		{
+			name:    "max_age exceeded",
+			query:   url.Values{"max_age": {"30"}},
+			elapsed: time.Minute,
+		},
+		{
+			name:    "idle timeout",
+			elapsed: 2 * time.Hour,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, now := newSessionTestServer(t)
+			cookie := login(t, s, "app1")
+
+			*now = now.Add(tc.elapsed)
+			rr := authorize(s, "app2", cookie, tc.query)
+			if tc.wantReuse {
+				requireCodeResponse(t, rr, "app2")
+			} else {
+				requireConnectorRedirect(t, rr)
+			}
+		})
+	}
+}
+
+func TestSessionAbsoluteLifetime(t *testing.T) {
+	s, now := newSessionTestServer(t)
+	cookie := login(t, s, "app1")
+
+	// Regular use keeps pushing the idle timeout back, but not past the
+	// absolute lifetime.
+	for range 3 {
+		*now = now.Add(50 * time.Minute)
+		requireCodeResponse(t, authorize(s, "app2", cookie, nil), "app2")
+	}
+
+	*now = now.Add(40 * time.Minute)
+	requireConnectorRedirect(t, authorize(s, "app2", cookie, nil))
+}
+
+func TestSessionInvalidMaxAge(t *testing.T) {
+	s, _ := newSessionTestServer(t)
+
+	rr := authorize(s, "app1", nil, url.Values{"max_age": {"-1"}})
+	require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, errInvalidRequest, u.Query().Get("error"))
+}
+
+func TestSessionPromptNone(t *testing.T) {
+	s, _ := newSessionTestServer(t)
+	none := url.Values{"prompt": {"none"}}
+
+	requireAuthError := func(t *testing.T, rr *httptest.ResponseRecorder, typ string) {
+		t.Helper()
+		require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+		u, err := url.Parse(rr.Header().Get("Location"))
+		require.NoError(t, err)
+		require.Equal(t, "app1.example.com", u.Host)
+		require.Equal(t, typ, u.Query().Get("error"))
+		require.Equal(t, "state", u.Query().Get("state"))
+	}
+
+	t.Run("not logged in", func(t *testing.T) {
+		requireAuthError(t, authorize(s, "app1", nil, none), errLoginRequired)
+
+		// The connector selection page is not shown either.
+		r := httptest.NewRequest(http.MethodGet, "/auth?client_id=app1&redirect_uri=https://app1.example.com/callback&response_type=code&scope=openid&state=state&prompt=none", nil)
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, r)
+		requireAuthError(t, rr, errLoginRequired)
+	})
+
+	cookie := login(t, s, "app1")
+
+	t.Run("logged in", func(t *testing.T) {
+		requireCodeResponse(t, authorize(s, "app1", cookie, none), "app1")
+	})
+
+	t.Run("approval required", func(t *testing.T) {
+		s.skipApproval = false
+		t.Cleanup(func() { s.skipApproval = true })
+		requireAuthError(t, authorize(s, "app1", cookie, none), errConsentRequired)
+	})
+}
+
+func TestSessionPromptConsent(t *testing.T) {
+	s, _ := newSessionTestServer(t)
+	cookie := login(t, s, "app1")
+
+	rr := authorize(s, "app1", cookie, url.Values{"prompt": {"consent"}})
+	require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "/approval", u.Path)
+}
+
+func TestSessionMaxAgeCountsFromLogin(t *testing.T) {
+	s, now := newSessionTestServer(t)
+	cookie := login(t, s, "app1")
+
+	// Only logging in again moves the authentication time, not session use.
+	*now = now.Add(20 * time.Minute)
+	requireCodeResponse(t, authorize(s, "app2", cookie, nil), "app2")
+	*now = now.Add(20 * time.Minute)
+	requireConnectorRedirect(t, authorize(s, "app2", cookie, url.Values{"max_age": {"1800"}}))
+}
+
+func TestLoginHint(t *testing.T) {
+	s, _ := newSessionTestServer(t)
+
+	rr := authorize(s, "app1", nil, url.Values{"login_hint": {"kilgore@kilgore.trout"}})
+	requireConnectorRedirect(t, rr)
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "kilgore@kilgore.trout", u.Query().Get("login_hint"))
+}
+
+func TestPasswordLoginHintAndUILocales(t *testing.T) {
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+	mockConnectorDataTestStorage(t, s.storage)
+
+	q := url.Values{
+		"client_id":     {"test"},
+		"redirect_uri":  {"https://auth.example.com"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+		"login_hint":    {"jane@example.com"},
+		"ui_locales":    {"fr-CA en"},
+	}
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/auth/test?"+q.Encode(), nil))
+	require.Equal(t, http.StatusFound, rr.Code, rr.Body.String())
+
+	r := httptest.NewRequest(http.MethodGet, rr.Header().Get("Location"), nil)
+	r.Header.Set("Accept-Language", "de")
+	rr = httptest.NewRecorder()
+	s.ServeHTTP(rr, r)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.Contains(t, rr.Body.String(), `value="jane@example.com"`)
+	require.Contains(t, rr.Body.String(), "Mot de passe")
+}
+
+func TestSessionEndedByLogout(t *testing.T) {
+	s, _ := newSessionTestServer(t)
+	cookie := login(t, s, "app1")
+
+	rr := confirmedLogout(t, s, nil, cookie)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	cleared := responseCookie(rr, sessionCookieName)
+	require.NotNil(t, cleared)
+	require.Negative(t, cleared.MaxAge)
+
+	_, err := s.storage.GetUserSession(t.Context(), cookie.Value)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+
+	requireConnectorRedirect(t, authorize(s, "app2", cookie, nil))
+}
+
+func TestSessionDisabled(t *testing.T) {
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+	require.NoError(t, s.storage.CreateClient(t.Context(), storage.Client{
+		ID:           "app1",
+		Secret:       "secret",
+		RedirectURIs: []string{"https://app1.example.com/callback"},
+	}))
+
+	rr := authorize(s, "app1", nil, nil)
+	requireConnectorRedirect(t, rr)
+
+	callback := rr.Header().Get("Location")
+	rr = httptest.NewRecorder()
+	s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, callback, nil))
+	require.Equal(t, http.StatusSeeOther, rr.Code)
+	for _, c := range rr.Result().Cookies() {
+		require.NotEqual(t, sessionCookieName, c.Name)
+	}
+}
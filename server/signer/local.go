@@ -70,6 +70,10 @@ func (l *localSigner) Start(ctx context.Context) {
 }
 
 func (l *localSigner) Sign(ctx context.Context, payload []byte) (string, error) {
+	return l.SignWithType(ctx, "JWT", payload)
+}
+
+func (l *localSigner) SignWithType(ctx context.Context, typ string, payload []byte) (string, error) {
 	keys, err := l.storage.GetKeys(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get keys: %v", err)
@@ -84,7 +88,7 @@ func (l *localSigner) Sign(ctx context.Context, payload []byte) (string, error)
 		return "", err
 	}
 
-	return signPayload(signingKey, signingAlg, payload)
+	return signPayload(signingKey, signingAlg, typ, payload)
 }
 
 func (l *localSigner) ValidationKeys(ctx context.Context) ([]*jose.JSONWebKey, error) {
@@ -55,8 +55,12 @@ type mockSigner struct {
 	pubKey *jose.JSONWebKey
 }
 
-func (m *mockSigner) Sign(_ context.Context, payload []byte) (string, error) {
-	return signPayload(m.key, jose.RS256, payload)
+func (m *mockSigner) Sign(ctx context.Context, payload []byte) (string, error) {
+	return m.SignWithType(ctx, "JWT", payload)
+}
+
+func (m *mockSigner) SignWithType(_ context.Context, typ string, payload []byte) (string, error) {
+	return signPayload(m.key, jose.RS256, typ, payload)
 }
 
 func (m *mockSigner) ValidationKeys(_ context.Context) ([]*jose.JSONWebKey, error) {
@@ -10,6 +10,9 @@ import (
 type Signer interface {
 	// Sign signs the provided payload.
 	Sign(ctx context.Context, payload []byte) (string, error)
+	// SignWithType signs the provided payload like Sign, but sets the JWS "typ"
+	// header to typ instead of "JWT".
+	SignWithType(ctx context.Context, typ string, payload []byte) (string, error)
 	// ValidationKeys returns the current public keys used for signature validation.
 	ValidationKeys(ctx context.Context) ([]*jose.JSONWebKey, error)
 	// Algorithm returns the signing algorithm used by this signer.
@@ -43,10 +43,10 @@ func signatureAlgorithm(jwk *jose.JSONWebKey) (alg jose.SignatureAlgorithm, err
 	}
 }
 
-func signPayload(key *jose.JSONWebKey, alg jose.SignatureAlgorithm, payload []byte) (jws string, err error) {
+func signPayload(key *jose.JSONWebKey, alg jose.SignatureAlgorithm, typ string, payload []byte) (jws string, err error) {
 	signingKey := jose.SigningKey{Key: key, Algorithm: alg}
 
-	opts := (&jose.SignerOptions{}).WithType("JWT")
+	opts := (&jose.SignerOptions{}).WithType(jose.ContentType(typ))
 	signer, err := jose.NewSigner(signingKey, opts)
 	if err != nil {
 		return "", fmt.Errorf("new signer: %v", err)
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// Subject identifier types, see OpenID Connect Core 1.0, section 8.
+const (
+	subjectTypePublic   = "public"
+	subjectTypePairwise = "pairwise"
+)
+
+// Formats of the public "sub" claim. Only subjectFormatRaw lets two connectors
+// issuing the same user ID hand out the same subject.
+const (
+	// The user ID as the connector returned it.
+	subjectFormatRaw = "raw"
+	// The connector ID and the user ID, joined by a colon.
+	subjectFormatConnector = "connector"
+	// A name-based (version 5) UUID of the user ID, in a namespace of the
+	// connector.
+	subjectFormatUUID = "uuid"
+	// The base64 encoded protobuf of upstream dex, see genSubject.
+	subjectFormatLegacy = "legacy"
+)
+
+var subjectFormats = []string{subjectFormatRaw, subjectFormatConnector, subjectFormatUUID, subjectFormatLegacy}
+
+// subjectNamespace is the namespace the per-connector namespaces of
+// subjectFormatUUID are derived in. It must never change.
+var subjectNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://dexidp.io/subject"))
+
+// formatSubject formats the subject of the user with userID at the connector
+// connID.
+func formatSubject(format, userID, connID string) (string, error) {
+	switch format {
+	case subjectFormatConnector:
+		return connID + ":" + userID, nil
+	case subjectFormatUUID:
+		namespace := uuid.NewSHA1(subjectNamespace, []byte(connID))
+		return uuid.NewSHA1(namespace, []byte(userID)).String(), nil
+	case subjectFormatLegacy:
+		return genSubject(userID, connID)
+	default:
+		return userID, nil
+	}
+}
+
+// ValidateSubjectType checks the subject type of a client, and that a pairwise
+// client has a single sector its subjects can be derived from.
+func ValidateSubjectType(c storage.Client) error {
+	switch c.SubjectType {
+	case "", subjectTypePublic:
+		return nil
+	case subjectTypePairwise:
+		_, err := sectorIdentifier(c)
+		return err
+	default:
+		return fmt.Errorf("unsupported subject type %q", c.SubjectType)
+	}
+}
+
+// supportedSubjectTypes are the subject types advertised by discovery. Pairwise
+// subjects need a secret to be derived with.
+func (s *Server) supportedSubjectTypes() []string {
+	if len(s.pairwiseSubjectSecret) == 0 {
+		return []string{subjectTypePublic}
+	}
+	return []string{subjectTypePublic, subjectTypePairwise}
+}
+
+// validateSubjectType is ValidateSubjectType, also rejecting pairwise clients
+// if the server has no secret to derive their subjects with.
+func (s *Server) validateSubjectType(c storage.Client) error {
+	if err := ValidateSubjectType(c); err != nil {
+		return err
+	}
+	if c.SubjectType == subjectTypePairwise && len(s.pairwiseSubjectSecret) == 0 {
+		return errors.New("pairwise subjects are not supported, no pairwise subject secret is configured")
+	}
+	return nil
+}
+
+// sectorIdentifier returns the host the pairwise subjects of c are derived
+// from: the host of its sector identifier URI, or else the one host all its
+// redirect URIs share. Clients of the same sector see the same subjects.
+func sectorIdentifier(c storage.Client) (string, error) {
+	if c.SectorIdentifierURI != "" {
+		u, err := url.Parse(c.SectorIdentifierURI)
+		if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+			return "", fmt.Errorf("sector identifier URI %q is not an https URL", c.SectorIdentifierURI)
+		}
+		return u.Hostname(), nil
+	}
+
+	var host string
+	for _, uri := range c.RedirectURIs {
+		u, err := url.Parse(uri)
+		if err != nil || u.Hostname() == "" {
+			return "", fmt.Errorf("redirect URI %q has no host", uri)
+		}
+		if host != "" && u.Hostname() != host {
+			return "", errors.New("redirect URIs on several hosts require a sector identifier URI")
+		}
+		host = u.Hostname()
+	}
+	if host == "" {
+		return "", errors.New("pairwise subjects require redirect URIs or a sector identifier URI")
+	}
+	return host, nil
+}
+
+// verifySectorIdentifierURI fetches the sector identifier URI of a client and
+// checks that it lists every redirect URI of the client, as OpenID Connect
+// Dynamic Client Registration 1.0, section 5 requires. Otherwise a client
+// could claim the sector of another and learn the subjects it sees.
+func (s *Server) verifySectorIdentifierURI(ctx context.Context, c storage.Client) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.SectorIdentifierURI, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.sectorIdentifierClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch sector identifier URI: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch sector identifier URI: unexpected status %s", resp.Status)
+	}
+
+	var redirectURIs []string
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&redirectURIs); err != nil {
+		return fmt.Errorf("sector identifier URI is not a JSON array of redirect URIs: %v", err)
+	}
+	for _, uri := range c.RedirectURIs {
+		if !slices.Contains(redirectURIs, uri) {
+			return fmt.Errorf("sector identifier URI does not list redirect URI %q", uri)
+		}
+	}
+	return nil
+}
+
+// pairwiseSubject derives the subject of a user for a sector as suggested by
+// OpenID Connect Core 1.0, section 8.1: a keyed hash of the sector and the
+// user, which neither the client nor anyone without the secret can reverse or
+// link to the subjects of other sectors.
+func pairwiseSubject(secret []byte, sector, userID, connID string) string {
+	mac := hmac.New(sha256.New, secret)
+	for _, v := range []string{sector, connID, userID} {
+		// Length prefixes keep the values from running into each other.
+		mac.Write([]byte(strconv.Itoa(len(v)) + ":" + v))
+	}
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// subject returns the "sub" claim client receives for the user with userID at
+// the connector connID.
+func (s *Server) subject(client storage.Client, userID, connID string) (string, error) {
+	if client.SubjectType != subjectTypePairwise {
+		return formatSubject(s.subjectFormat, userID, connID)
+	}
+	if len(s.pairwiseSubjectSecret) == 0 {
+		return "", fmt.Errorf("client %q uses pairwise subjects, but no pairwise subject secret is configured", client.ID)
+	}
+	sector, err := sectorIdentifier(client)
+	if err != nil {
+		return "", fmt.Errorf("client %q: %v", client.ID, err)
+	}
+	return pairwiseSubject(s.pairwiseSubjectSecret, sector, userID, connID), nil
+}
+
+// publicSubject is subjectFor for callers without a server, and so without
+// the secret pairwise subjects are derived with. It assumes the raw subject
+// format.
+func publicSubject(userID string) func(storage.Client) (string, error) {
+	return func(client storage.Client) (string, error) {
+		if client.SubjectType == subjectTypePairwise {
+			return "", fmt.Errorf("client %q uses pairwise subjects, which need the server", client.ID)
+		}
+		return userID, nil
+	}
+}
+
+// subjectFor binds a user to s.subject, for callers that address several
+// clients at once.
+func (s *Server) subjectFor(userID, connID string) func(storage.Client) (string, error) {
+	return func(client storage.Client) (string, error) {
+		return s.subject(client, userID, connID)
+	}
+}
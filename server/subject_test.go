@@ -0,0 +1,248 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/memory"
+)
+
+func TestSectorIdentifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  storage.Client
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "single redirect host",
+			client: storage.Client{RedirectURIs: []string{"https://app.example.com/a", "https://app.example.com/b"}},
+			want:   "app.example.com",
+		},
+		{
+			name:    "several redirect hosts",
+			client:  storage.Client{RedirectURIs: []string{"https://a.example.com/cb", "https://b.example.com/cb"}},
+			wantErr: true,
+		},
+		{
+			name: "sector identifier URI",
+			client: storage.Client{
+				RedirectURIs:        []string{"https://a.example.com/cb", "https://b.example.com/cb"},
+				SectorIdentifierURI: "https://example.com/redirect_uris.json",
+			},
+			want: "example.com",
+		},
+		{
+			name: "http sector identifier URI",
+			client: storage.Client{
+				RedirectURIs:        []string{"https://a.example.com/cb"},
+				SectorIdentifierURI: "http://example.com/redirect_uris.json",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "no redirect URIs",
+			client:  storage.Client{},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sectorIdentifier(tc.client)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPairwiseSubject(t *testing.T) {
+	secret := []byte("secret")
+	sub := pairwiseSubject(secret, "a.example.com", "user", "conn")
+	require.Equal(t, sub, pairwiseSubject(secret, "a.example.com", "user", "conn"))
+	require.NotEqual(t, sub, pairwiseSubject(secret, "b.example.com", "user", "conn"))
+	require.NotEqual(t, sub, pairwiseSubject(secret, "a.example.com", "other", "conn"))
+	require.NotEqual(t, sub, pairwiseSubject(secret, "a.example.com", "user", "other"))
+	require.NotEqual(t, sub, pairwiseSubject([]byte("other"), "a.example.com", "user", "conn"))
+	// The values are delimited, so they can't be shifted into one another.
+	require.NotEqual(t, sub, pairwiseSubject(secret, "a.example.com", "ruser", "con"))
+}
+
+func TestPairwiseIDTokenSubject(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.PasswordConnector = "test"
+		c.PairwiseSubjectSecret = "secret"
+	})
+	defer httpServer.Close()
+
+	require.Equal(t, []string{subjectTypePublic, subjectTypePairwise}, s.constructDiscovery(ctx).Subjects)
+
+	mockConnectorDataTestStorage(t, s.storage)
+	client := storage.Client{
+		ID:           "pairwise",
+		Secret:       "secret",
+		RedirectURIs: []string{"https://pairwise.example.com/callback"},
+		SubjectType:  subjectTypePairwise,
+	}
+	require.NoError(t, s.storage.CreateClient(ctx, client))
+
+	subjectOf := func(clientID, secret string) string {
+		vals := url.Values{
+			"grant_type": {grantTypePassword},
+			"scope":      {"openid"},
+			"username":   {"test"},
+			"password":   {"test"},
+		}
+		req := httptest.NewRequest(http.MethodPost, httpServer.URL+"/token", strings.NewReader(vals.Encode()))
+		req.Header.Set("content-type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(clientID, secret)
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var res accessTokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: clientID})
+		idToken, err := verifier.Verify(ctx, res.IDToken)
+		require.NoError(t, err)
+		return idToken.Subject
+	}
+
+	public := subjectOf("test", "barfoo")
+	pairwise := subjectOf(client.ID, client.Secret)
+	require.NotEqual(t, public, pairwise)
+	require.Equal(t, pairwiseSubject([]byte("secret"), "pairwise.example.com", "0-385-28089-0", "test"), pairwise)
+}
+
+func TestPairwiseSubjectWithoutSecret(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+
+	require.Equal(t, []string{subjectTypePublic}, s.constructDiscovery(ctx).Subjects)
+
+	client := storage.Client{
+		ID:           "pairwise",
+		RedirectURIs: []string{"https://pairwise.example.com/callback"},
+		SubjectType:  subjectTypePairwise,
+	}
+	_, err := s.subject(client, "user", "conn")
+	require.Error(t, err)
+
+	// Such clients can't be registered.
+	require.Error(t, s.validateSubjectType(client))
+	typ, _ := s.applyClientMetadata(ctx, &storage.Client{}, clientMetadata{RedirectURIs: client.RedirectURIs, SubjectType: subjectTypePairwise})
+	require.Equal(t, errInvalidClientMetadata, typ)
+}
+
+func TestVerifySectorIdentifierURI(t *testing.T) {
+	sectorServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`["https://a.example.com/cb", "https://b.example.com/cb"]`))
+	}))
+	defer sectorServer.Close()
+
+	httpServer, s := newTestServer(t, nil)
+	defer httpServer.Close()
+	s.sectorIdentifierClient = sectorServer.Client()
+
+	client := storage.Client{
+		RedirectURIs:        []string{"https://a.example.com/cb", "https://b.example.com/cb"},
+		SubjectType:         subjectTypePairwise,
+		SectorIdentifierURI: sectorServer.URL,
+	}
+	require.NoError(t, s.verifySectorIdentifierURI(t.Context(), client))
+
+	client.RedirectURIs = append(client.RedirectURIs, "https://c.example.com/cb")
+	require.Error(t, s.verifySectorIdentifierURI(t.Context(), client))
+}
+
+func TestFormatSubject(t *testing.T) {
+	legacy, err := genSubject("user", "conn")
+	require.NoError(t, err)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{subjectFormatRaw, "user"},
+		{subjectFormatConnector, "conn:user"},
+		// Pinned: changing the namespace would change every existing subject.
+		{subjectFormatUUID, "68b356b3-6ff1-532a-b4a7-1de6f1aeda02"},
+		{subjectFormatLegacy, legacy},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			got, err := formatSubject(tc.format, "user", "conn")
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+
+			// Only raw subjects collide across connectors.
+			other, err := formatSubject(tc.format, "user", "other")
+			require.NoError(t, err)
+			require.Equal(t, tc.format == subjectFormatRaw, got == other)
+		})
+	}
+}
+
+func TestUnsupportedSubjectFormat(t *testing.T) {
+	logger := newLogger(t)
+	_, err := NewServer(t.Context(), Config{
+		Issuer:        "http://localhost",
+		Storage:       memory.New(logger),
+		Logger:        logger,
+		SubjectFormat: "urn:example:unknown",
+	})
+	require.ErrorContains(t, err, "unsupported subject format")
+}
+
+// The subject format applies to every token dex hands out for a user.
+func TestSubjectFormatTokenExchange(t *testing.T) {
+	ctx := t.Context()
+	httpServer, s := newTestServer(t, func(c *Config) {
+		c.SubjectFormat = subjectFormatConnector
+	})
+	defer httpServer.Close()
+	require.NoError(t, s.storage.CreateClient(ctx, storage.Client{ID: "client_1", Secret: "secret_1"}))
+
+	vals := url.Values{
+		"grant_type":           {grantTypeTokenExchange},
+		"connector_id":         {"mock"},
+		"scope":                {"openid offline_access"},
+		"requested_token_type": {tokenTypeAccess},
+		"subject_token_type":   {tokenTypeID},
+		"subject_token":        {"foobar"},
+	}
+	req := httptest.NewRequest(http.MethodPost, httpServer.URL+"/token", strings.NewReader(vals.Encode()))
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("client_1", "secret_1")
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var res accessTokenResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	const want = "mock:0-385-28089-0"
+
+	verifier := oidc.NewVerifier(s.issuerURL.String(), &signerKeySet{s.signer}, &oidc.Config{ClientID: "client_1"})
+	idToken, err := verifier.Verify(ctx, res.IDToken)
+	require.NoError(t, err)
+	require.Equal(t, want, idToken.Subject)
+
+	require.NotEmpty(t, res.RefreshToken)
+	introspection, err := s.introspectRefreshToken(ctx, res.RefreshToken)
+	require.NoError(t, err)
+	require.Equal(t, want, introspection.Subject)
+}
@@ -23,6 +23,7 @@ const (
 	tmplError         = "error.html"
 	tmplDevice        = "device.html"
 	tmplDeviceSuccess = "device_success.html"
+	tmplLogout        = "logout.html"
 )
 
 var requiredTmpls = []string{
@@ -33,6 +34,7 @@ var requiredTmpls = []string{
 	tmplError,
 	tmplDevice,
 	tmplDeviceSuccess,
+	tmplLogout,
 }
 
 type templates struct {
@@ -43,6 +45,7 @@ type templates struct {
 	errorTmpl         *template.Template
 	deviceTmpl        *template.Template
 	deviceSuccessTmpl *template.Template
+	logoutTmpl        *template.Template
 }
 
 type webConfig struct {
@@ -170,6 +173,7 @@ func loadTemplates(c webConfig, templatesDir string) (*templates, error) {
 		errorTmpl:         tmpls.Lookup(tmplError),
 		deviceTmpl:        tmpls.Lookup(tmplDevice),
 		deviceSuccessTmpl: tmpls.Lookup(tmplDeviceSuccess),
+		logoutTmpl:        tmpls.Lookup(tmplLogout),
 	}, nil
 }
 
@@ -263,11 +267,13 @@ type Brand struct {
 }
 
 // brand resolves the branding for clientID. An empty clientID, an unknown one,
-// or a storage failure all fall back to the global frontend branding.
-func (s *Server) brand(r *http.Request, clientID string) Brand {
+// or a storage failure all fall back to the global frontend branding. Pages of
+// an authorization request pass its ui_locales, which pick the language ahead
+// of the browser's.
+func (s *Server) brand(r *http.Request, clientID string, uiLocales ...string) Brand {
 	b := Brand{
 		ReqPath: r.URL.Path,
-		Tr:      GetTranslations(r.Header.Get("Accept-Language")),
+		Tr:      GetTranslations(r.Header.Get("Accept-Language"), uiLocales...),
 	}
 	if clientID == "" {
 		return b
@@ -358,22 +364,23 @@ func (t *templates) password(b Brand, w http.ResponseWriter, postURL, lastUserna
 	return renderTemplate(w, t.passwordTmpl, data)
 }
 
-func (t *templates) approval(b Brand, w http.ResponseWriter, authReqID, username, clientName string, scopes []string) error {
-	accesses := []string{}
-	for _, scope := range scopes {
-		access, ok := scopeDescriptions[scope]
-		if ok {
-			accesses = append(accesses, access)
-		}
-	}
-	sort.Strings(accesses)
+// approvalScope is a scope as the approval screen lists it.
+type approvalScope struct {
+	Name        string
+	Description string
+	// Required scopes can't be deselected.
+	Required bool
+}
+
+func (t *templates) approval(b Brand, w http.ResponseWriter, authReqID, username, clientName string, scopes []approvalScope) error {
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].Description < scopes[j].Description })
 	data := struct {
 		Brand
 		User      string
 		Client    string
 		AuthReqID string
-		Scopes    []string
-	}{b, username, clientName, authReqID, accesses}
+		Scopes    []approvalScope
+	}{b, username, clientName, authReqID, scopes}
 	return renderTemplate(w, t.approvalTmpl, data)
 }
 
@@ -385,6 +392,25 @@ func (t *templates) oob(b Brand, w http.ResponseWriter, code string) error {
 	return renderTemplate(w, t.oobTmpl, data)
 }
 
+// logoutConfirmation is the form asking the user to confirm a logout request
+// that no ID token ties to one of their clients.
+type logoutConfirmation struct {
+	ClientID              string
+	PostLogoutRedirectURI string
+	State                 string
+	CSRFToken             string
+}
+
+// logout renders the logout page, or with confirm, the form asking for a
+// confirmation first.
+func (t *templates) logout(b Brand, w http.ResponseWriter, confirm *logoutConfirmation) error {
+	data := struct {
+		Brand
+		Confirm *logoutConfirmation
+	}{b, confirm}
+	return renderTemplate(w, t.logoutTmpl, data)
+}
+
 func (t *templates) err(b Brand, w http.ResponseWriter, errCode int, errMsg string) error {
 	w.WriteHeader(errCode)
 	data := struct {
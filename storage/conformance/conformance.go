@@ -51,6 +51,14 @@ func RunTests(t *testing.T, newStorage func(t *testing.T) storage.Storage) {
 		{"TimezoneSupport", testTimezones},
 		{"DeviceRequestCRUD", testDeviceRequestCRUD},
 		{"DeviceTokenCRUD", testDeviceTokenCRUD},
+		{"UserSessionCRUD", testUserSessionCRUD},
+		{"LogoutNotificationCRUD", testLogoutNotificationCRUD},
+		{"AccessTokenCRUD", testAccessTokenCRUD},
+		{"InitialAccessTokenCRUD", testInitialAccessTokenCRUD},
+		{"ClientAssertionCRUD", testClientAssertionCRUD},
+		{"DPoPProofCRUD", testDPoPProofCRUD},
+		{"ConsentCRUD", testConsentCRUD},
+		{"ScopeCRUD", testScopeCRUD},
 	})
 }
 
@@ -106,9 +114,21 @@ func testAuthRequestCRUD(t *testing.T, s storage.Storage) {
 			Email:         "jane.doe@example.com",
 			EmailVerified: true,
 			Groups:        []string{"a", "b"},
+			ExtraClaims:   map[string]any{"department": "engineering", "projects": []any{"a", "b"}},
 		},
-		PKCE:    codeChallenge,
-		HMACKey: []byte("hmac_key"),
+		Authentication: storage.Authentication{
+			ACR:       "2",
+			AMR:       []string{"pwd", "otp"},
+			Time:      time.Now().UTC().Round(time.Millisecond),
+			SessionID: "session",
+		},
+		PKCE:      codeChallenge,
+		HMACKey:   []byte("hmac_key"),
+		Prompt:    "login",
+		MaxAge:    300,
+		ACRValues: []string{"2", "1"},
+		LoginHint: "jane@example.com",
+		UILocales: []string{"fr-CA", "fr", "en"},
 	}
 
 	identity := storage.Claims{Email: "foobar"}
@@ -168,6 +188,24 @@ func testAuthRequestCRUD(t *testing.T, s storage.Storage) {
 		t.Fatalf("storage does not support PKCE, wanted challenge=%#v got %#v", codeChallenge, got.PKCE)
 	}
 
+	if got.Prompt != a1.Prompt || got.MaxAge != a1.MaxAge {
+		t.Fatalf("wanted prompt=%q max_age=%d got prompt=%q max_age=%d", a1.Prompt, a1.MaxAge, got.Prompt, got.MaxAge)
+	}
+
+	if !reflect.DeepEqual(got.ACRValues, a1.ACRValues) {
+		t.Fatalf("wanted acr_values=%v got %v", a1.ACRValues, got.ACRValues)
+	}
+	if got.LoginHint != a1.LoginHint || !reflect.DeepEqual(got.UILocales, a1.UILocales) {
+		t.Fatalf("wanted login_hint=%q ui_locales=%v got login_hint=%q ui_locales=%v", a1.LoginHint, a1.UILocales, got.LoginHint, got.UILocales)
+	}
+	if !got.Authentication.Time.Equal(a1.Authentication.Time) {
+		t.Fatalf("wanted auth time %v got %v", a1.Authentication.Time, got.Authentication.Time)
+	}
+	got.Authentication.Time = a1.Authentication.Time
+	if !reflect.DeepEqual(got.Authentication, a1.Authentication) {
+		t.Fatalf("wanted authentication=%#v got %#v", a1.Authentication, got.Authentication)
+	}
+
 	if err := s.DeleteAuthRequest(ctx, a1.ID); err != nil {
 		t.Fatalf("failed to delete auth request: %v", err)
 	}
@@ -201,6 +239,13 @@ func testAuthCodeCRUD(t *testing.T, s storage.Storage) {
 			Email:         "jane.doe@example.com",
 			EmailVerified: true,
 			Groups:        []string{"a", "b"},
+			ExtraClaims:   map[string]any{"department": "engineering", "projects": []any{"a", "b"}},
+		},
+		Authentication: storage.Authentication{
+			ACR:       "2",
+			AMR:       []string{"pwd", "otp"},
+			Time:      time.Now().UTC().Round(time.Millisecond),
+			SessionID: "session",
 		},
 	}
 
@@ -241,7 +286,11 @@ func testAuthCodeCRUD(t *testing.T, s storage.Storage) {
 	if a1.Expiry.Unix() != got.Expiry.Unix() {
 		t.Errorf("auth code expiry did not match want=%s vs got=%s", a1.Expiry, got.Expiry)
 	}
+	if !a1.Authentication.Time.Equal(got.Authentication.Time) {
+		t.Errorf("auth code auth time did not match want=%s vs got=%s", a1.Authentication.Time, got.Authentication.Time)
+	}
 	got.Expiry = a1.Expiry // time fields do not compare well
+	got.Authentication.Time = a1.Authentication.Time
 	if diff := pretty.Compare(a1, got); diff != "" {
 		t.Errorf("auth code retrieved from storage did not match: %s", diff)
 	}
@@ -316,6 +365,176 @@ func testClientCRUD(t *testing.T, s storage.Storage) {
 	c1.Secret = newSecret
 	getAndCompare(id1, c1)
 
+	postLogoutRedirectURIs := []string{"https://auth.example.com/logged-out"}
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.PostLogoutRedirectURIs = postLogoutRedirectURIs
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.PostLogoutRedirectURIs = postLogoutRedirectURIs
+	getAndCompare(id1, c1)
+
+	backchannelLogoutURI := "https://auth.example.com/backchannel-logout"
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.BackchannelLogoutURI = backchannelLogoutURI
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.BackchannelLogoutURI = backchannelLogoutURI
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.ClientCredentialsScopes = []string{"read", "write"}
+		old.ClientCredentialsAudiences = []string{"api"}
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.ClientCredentialsScopes = []string{"read", "write"}
+	c1.ClientCredentialsAudiences = []string{"api"}
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.AccessTokenFormat = "opaque"
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.AccessTokenFormat = "opaque"
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.RequirePushedAuthorizationRequests = true
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.RequirePushedAuthorizationRequests = true
+	getAndCompare(id1, c1)
+
+	jwks := `{"keys":[{"kty":"EC","crv":"P-256","x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}]}`
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.JWKS = jwks
+		old.JWKSURI = "https://localhost/jwks"
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.JWKS = jwks
+	c1.JWKSURI = "https://localhost/jwks"
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.RegistrationAccessTokenHash = "registration-access-token-hash"
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.RegistrationAccessTokenHash = "registration-access-token-hash"
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.TokenEndpointAuthMethod = "private_key_jwt"
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.TokenEndpointAuthMethod = "private_key_jwt"
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.TokenEndpointAuthMethod = "tls_client_auth"
+		old.TLSClientAuthSubjectDN = "CN=client1,O=Example"
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.TokenEndpointAuthMethod = "tls_client_auth"
+	c1.TLSClientAuthSubjectDN = "CN=client1,O=Example"
+	getAndCompare(id1, c1)
+
+	claimMappings := []storage.ClaimMapping{
+		{Claim: "roles", Template: "{{ toJSON .Claims.Groups }}", JSON: true},
+		{Claim: "acr"},
+	}
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.ClaimMappings = claimMappings
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.ClaimMappings = claimMappings
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.SubjectType = "pairwise"
+		old.SectorIdentifierURI = "https://client1.example.com/sector.json"
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.SubjectType = "pairwise"
+	c1.SectorIdentifierURI = "https://client1.example.com/sector.json"
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.RequiredScopes = []string{"email"}
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.RequiredScopes = []string{"email"}
+	getAndCompare(id1, c1)
+
+	disableRotation := true
+	expiry := &storage.ClientExpiry{
+		IDTokens: "5m",
+		RefreshTokens: storage.ClientRefreshTokenExpiry{
+			DisableRotation:   &disableRotation,
+			ValidIfNotUsedFor: "1h",
+			AbsoluteLifetime:  "12h",
+		},
+	}
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.Expiry = expiry
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.Expiry = expiry
+	getAndCompare(id1, c1)
+
+	err = s.UpdateClient(ctx, id1, func(old storage.Client) (storage.Client, error) {
+		old.AllowedConnectors = []string{"ldap"}
+		old.AllowedGrantTypes = []string{"authorization_code", "refresh_token"}
+		old.AllowedResponseTypes = []string{"code"}
+		old.RequirePKCE = true
+		return old, nil
+	})
+	if err != nil {
+		t.Errorf("update client: %v", err)
+	}
+	c1.AllowedConnectors = []string{"ldap"}
+	c1.AllowedGrantTypes = []string{"authorization_code", "refresh_token"}
+	c1.AllowedResponseTypes = []string{"code"}
+	c1.RequirePKCE = true
+	getAndCompare(id1, c1)
+
 	if err := s.DeleteClient(ctx, id1); err != nil {
 		t.Fatalf("delete client: %v", err)
 	}
@@ -347,8 +566,15 @@ func testRefreshTokenCRUD(t *testing.T, s storage.Storage) {
 			Email:         "jane.doe@example.com",
 			EmailVerified: true,
 			Groups:        []string{"a", "b"},
+			ExtraClaims:   map[string]any{"department": "engineering", "projects": []any{"a", "b"}},
 		},
 		ConnectorData: []byte(`{"some":"data"}`),
+		Authentication: storage.Authentication{
+			ACR:       "2",
+			AMR:       []string{"pwd", "otp"},
+			Time:      time.Now().UTC().Round(time.Millisecond),
+			SessionID: "session",
+		},
 	}
 	if err := s.CreateRefresh(ctx, refresh); err != nil {
 		t.Fatalf("create refresh token: %v", err)
@@ -373,10 +599,16 @@ func testRefreshTokenCRUD(t *testing.T, s storage.Storage) {
 			t.Errorf("refresh token last used timestamp retrieved from storage did not match: %s", diff)
 		}
 
+		if !gr.Authentication.Time.Equal(want.Authentication.Time) {
+			t.Errorf("refresh token auth time retrieved from storage did not match: want %v, got %v", want.Authentication.Time, gr.Authentication.Time)
+		}
+
 		gr.CreatedAt = time.Time{}
 		gr.LastUsed = time.Time{}
+		gr.Authentication.Time = time.Time{}
 		want.CreatedAt = time.Time{}
 		want.LastUsed = time.Time{}
+		want.Authentication.Time = time.Time{}
 
 		if diff := pretty.Compare(want, gr); diff != "" {
 			t.Errorf("refresh token retrieved from storage did not match: %s", diff)
@@ -404,6 +636,9 @@ func testRefreshTokenCRUD(t *testing.T, s storage.Storage) {
 			Groups:        []string{"a", "b"},
 		},
 		ConnectorData: []byte(`{"some":"data"}`),
+
+		// Unlike the first token, bound to a DPoP key.
+		DPoPKeyThumbprint: "dpop-key-thumbprint",
 	}
 
 	if err := s.CreateRefresh(ctx, refresh2); err != nil {
@@ -603,8 +838,11 @@ func testOfflineSessionCRUD(t *testing.T, s storage.Storage) {
 	}
 	session1.Refresh[tokenRef.ClientID] = &tokenRef
 
+	session1.ExtraClaims = map[string]any{"department": "engineering"}
+
 	if err := s.UpdateOfflineSessions(ctx, session1.UserID, session1.ConnID, func(old storage.OfflineSessions) (storage.OfflineSessions, error) {
 		old.Refresh[tokenRef.ClientID] = &tokenRef
+		old.ExtraClaims = session1.ExtraClaims
 		return old, nil
 	}); err != nil {
 		t.Fatalf("failed to update offline session: %v", err)
@@ -948,6 +1186,219 @@ func testGC(t *testing.T, s storage.Storage) {
 	} else if err != storage.ErrNotFound {
 		t.Errorf("expected storage.ErrNotFound, got %v", err)
 	}
+
+	us := storage.UserSession{
+		ID:          storage.NewID(),
+		ConnectorID: "mock",
+		Claims: storage.Claims{
+			UserID:   "1",
+			Username: "jane",
+		},
+		CreatedAt: time.Now(),
+		LastUsed:  time.Now(),
+		Expiry:    expiry,
+	}
+
+	if err := s.CreateUserSession(ctx, us); err != nil {
+		t.Fatalf("failed creating user session: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(ctx, expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.UserSessions != 0 {
+			t.Errorf("expected no user session garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetUserSession(ctx, us.ID); err != nil {
+			t.Errorf("expected to be able to get user session after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(ctx, expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.UserSessions != 1 {
+		t.Errorf("expected to garbage collect 1 user session, got %d", r.UserSessions)
+	}
+
+	if _, err := s.GetUserSession(ctx, us.ID); err == nil {
+		t.Errorf("expected user session to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	ln := storage.LogoutNotification{
+		ID:          storage.NewID(),
+		ClientID:    "client1",
+		Subject:     "1",
+		CreatedAt:   time.Now(),
+		NextAttempt: time.Now(),
+		Expiry:      expiry,
+	}
+
+	if err := s.CreateLogoutNotification(ctx, ln); err != nil {
+		t.Fatalf("failed creating logout notification: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(ctx, expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.LogoutNotifications != 0 {
+			t.Errorf("expected no logout notification garbage collection results, got %#v", result)
+		}
+		if notifications, err := s.ListLogoutNotifications(ctx); err != nil {
+			t.Errorf("failed to list logout notifications: %v", err)
+		} else if len(notifications) != 1 {
+			t.Errorf("expected logout notification to survive GC, got %d notifications", len(notifications))
+		}
+	}
+	if r, err := s.GarbageCollect(ctx, expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.LogoutNotifications != 1 {
+		t.Errorf("expected to garbage collect 1 logout notification, got %d", r.LogoutNotifications)
+	}
+
+	if notifications, err := s.ListLogoutNotifications(ctx); err != nil {
+		t.Errorf("failed to list logout notifications: %v", err)
+	} else if len(notifications) != 0 {
+		t.Errorf("expected logout notification to be GC'd")
+	}
+
+	at := storage.AccessToken{
+		ID:       storage.NewID(),
+		ClientID: "client1",
+		Claims: storage.Claims{
+			UserID:   "1",
+			Username: "jane",
+		},
+		CreatedAt: time.Now(),
+		Expiry:    expiry,
+	}
+
+	if err := s.CreateAccessToken(ctx, at); err != nil {
+		t.Fatalf("failed creating access token: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(ctx, expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.AccessTokens != 0 {
+			t.Errorf("expected no access token garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetAccessToken(ctx, at.ID); err != nil {
+			t.Errorf("expected to be able to get access token after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(ctx, expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.AccessTokens != 1 {
+		t.Errorf("expected to garbage collect 1 access token, got %d", r.AccessTokens)
+	}
+
+	if _, err := s.GetAccessToken(ctx, at.ID); err == nil {
+		t.Errorf("expected access token to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	iat := storage.InitialAccessToken{
+		ID:        storage.NewID(),
+		CreatedAt: time.Now(),
+		Expiry:    expiry,
+	}
+
+	if err := s.CreateInitialAccessToken(ctx, iat); err != nil {
+		t.Fatalf("failed creating initial access token: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(ctx, expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.InitialAccessTokens != 0 {
+			t.Errorf("expected no initial access token garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetInitialAccessToken(ctx, iat.ID); err != nil {
+			t.Errorf("expected to be able to get initial access token after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(ctx, expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.InitialAccessTokens != 1 {
+		t.Errorf("expected to garbage collect 1 initial access token, got %d", r.InitialAccessTokens)
+	}
+
+	if _, err := s.GetInitialAccessToken(ctx, iat.ID); err == nil {
+		t.Errorf("expected initial access token to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	ca := storage.ClientAssertion{
+		ID:       storage.NewID(),
+		ClientID: "client1",
+		Expiry:   expiry,
+	}
+
+	if err := s.CreateClientAssertion(ctx, ca); err != nil {
+		t.Fatalf("failed creating client assertion: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(ctx, expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.ClientAssertions != 0 {
+			t.Errorf("expected no client assertion garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetClientAssertion(ctx, ca.ID); err != nil {
+			t.Errorf("expected to be able to get client assertion after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(ctx, expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.ClientAssertions != 1 {
+		t.Errorf("expected to garbage collect 1 client assertion, got %d", r.ClientAssertions)
+	}
+
+	if _, err := s.GetClientAssertion(ctx, ca.ID); err == nil {
+		t.Errorf("expected client assertion to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	dp := storage.DPoPProof{
+		ID:     storage.NewID(),
+		Expiry: expiry,
+	}
+
+	if err := s.CreateDPoPProof(ctx, dp); err != nil {
+		t.Fatalf("failed creating dpop proof: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(ctx, expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.DPoPProofs != 0 {
+			t.Errorf("expected no dpop proof garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetDPoPProof(ctx, dp.ID); err != nil {
+			t.Errorf("expected to be able to get dpop proof after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(ctx, expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.DPoPProofs != 1 {
+		t.Errorf("expected to garbage collect 1 dpop proof, got %d", r.DPoPProofs)
+	}
+
+	if _, err := s.GetDPoPProof(ctx, dp.ID); err == nil {
+		t.Errorf("expected dpop proof to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
 }
 
 // testTimezones tests that backends either fully support timezones or
@@ -1079,3 +1530,540 @@ func testDeviceTokenCRUD(t *testing.T, s storage.Storage) {
 		t.Fatalf("storage does not support PKCE, wanted challenge=%#v got %#v", codeChallenge, got.PKCE)
 	}
 }
+
+func testUserSessionCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	session := storage.UserSession{
+		ID:            storage.NewID(),
+		ConnectorID:   "mock",
+		ConnectorData: []byte(`{"some":"data"}`),
+		Claims: storage.Claims{
+			UserID:            "1",
+			Username:          "jane",
+			PreferredUsername: "jane.doe",
+			Email:             "jane.doe@example.com",
+			EmailVerified:     true,
+			Groups:            []string{"a", "b"},
+			ExtraClaims:       map[string]any{"department": "engineering", "projects": []any{"a", "b"}},
+		},
+		Authentication: storage.Authentication{
+			ACR:       "2",
+			AMR:       []string{"pwd", "otp"},
+			Time:      time.Now().UTC().Round(time.Millisecond),
+			SessionID: "session",
+		},
+		ClientIDs: []string{"client1"},
+		CreatedAt: time.Now().UTC().Round(time.Millisecond),
+		LastUsed:  time.Now().UTC().Round(time.Millisecond),
+		Expiry:    neverExpire,
+	}
+
+	if err := s.CreateUserSession(ctx, session); err != nil {
+		t.Fatalf("failed creating user session: %v", err)
+	}
+
+	// Attempt to create same user session twice.
+	err := s.CreateUserSession(ctx, session)
+	mustBeErrAlreadyExists(t, "user session", err)
+
+	getAndCompare := func(want storage.UserSession) {
+		got, err := s.GetUserSession(ctx, want.ID)
+		if err != nil {
+			t.Errorf("get user session: %v", err)
+			return
+		}
+
+		for _, ts := range []struct {
+			name      string
+			want, got time.Time
+		}{
+			{"created at", want.CreatedAt, got.CreatedAt},
+			{"last used", want.LastUsed, got.LastUsed},
+			{"expiry", want.Expiry, got.Expiry},
+			{"auth time", want.Authentication.Time, got.Authentication.Time},
+		} {
+			if !ts.want.Equal(ts.got) {
+				t.Errorf("user session %s timestamp retrieved from storage did not match: want %v, got %v", ts.name, ts.want, ts.got)
+			}
+		}
+
+		got.CreatedAt, got.LastUsed, got.Expiry = time.Time{}, time.Time{}, time.Time{}
+		want.CreatedAt, want.LastUsed, want.Expiry = time.Time{}, time.Time{}, time.Time{}
+		got.Authentication.Time, want.Authentication.Time = time.Time{}, time.Time{}
+
+		if diff := pretty.Compare(want, got); diff != "" {
+			t.Errorf("user session retrieved from storage did not match: %s", diff)
+		}
+	}
+
+	getAndCompare(session)
+
+	lastUsed := time.Now().UTC().Add(time.Minute).Round(time.Millisecond)
+	expiry := lastUsed.Add(time.Hour)
+
+	if err := s.UpdateUserSession(ctx, session.ID, func(old storage.UserSession) (storage.UserSession, error) {
+		old.LastUsed = lastUsed
+		old.Expiry = expiry
+		old.Claims.Groups = []string{"a"}
+		old.ClientIDs = append(old.ClientIDs, "client2")
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update user session: %v", err)
+	}
+	session.LastUsed = lastUsed
+	session.Expiry = expiry
+	session.Claims.Groups = []string{"a"}
+	session.ClientIDs = []string{"client1", "client2"}
+	getAndCompare(session)
+
+	if err := s.DeleteUserSession(ctx, session.ID); err != nil {
+		t.Fatalf("failed to delete user session: %v", err)
+	}
+
+	_, err = s.GetUserSession(ctx, session.ID)
+	mustBeErrNotFound(t, "user session", err)
+}
+
+func testLogoutNotificationCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	n1 := storage.LogoutNotification{
+		ID:          storage.NewID(),
+		ClientID:    "client1",
+		Subject:     "1",
+		SessionID:   "session",
+		CreatedAt:   time.Now().UTC().Round(time.Millisecond),
+		NextAttempt: time.Now().UTC().Round(time.Millisecond),
+		Expiry:      neverExpire,
+	}
+	if err := s.CreateLogoutNotification(ctx, n1); err != nil {
+		t.Fatalf("failed creating logout notification: %v", err)
+	}
+
+	// Attempt to create same logout notification twice.
+	err := s.CreateLogoutNotification(ctx, n1)
+	mustBeErrAlreadyExists(t, "logout notification", err)
+
+	n2 := storage.LogoutNotification{
+		ID:          storage.NewID(),
+		ClientID:    "client2",
+		Subject:     "1",
+		CreatedAt:   time.Now().UTC().Round(time.Millisecond),
+		NextAttempt: time.Now().UTC().Round(time.Millisecond),
+		Expiry:      neverExpire,
+	}
+	if err := s.CreateLogoutNotification(ctx, n2); err != nil {
+		t.Fatalf("failed creating logout notification: %v", err)
+	}
+
+	listAndCompare := func(want []storage.LogoutNotification) {
+		got, err := s.ListLogoutNotifications(ctx)
+		if err != nil {
+			t.Errorf("list logout notifications: %v", err)
+			return
+		}
+		sort.Slice(got, func(i, j int) bool { return got[i].ClientID < got[j].ClientID })
+		sort.Slice(want, func(i, j int) bool { return want[i].ClientID < want[j].ClientID })
+
+		if len(got) != len(want) {
+			t.Errorf("expected %d logout notifications, got %d", len(want), len(got))
+			return
+		}
+		for i := range want {
+			for _, ts := range []struct {
+				name      string
+				want, got time.Time
+			}{
+				{"created at", want[i].CreatedAt, got[i].CreatedAt},
+				{"next attempt", want[i].NextAttempt, got[i].NextAttempt},
+				{"expiry", want[i].Expiry, got[i].Expiry},
+			} {
+				if !ts.want.Equal(ts.got) {
+					t.Errorf("logout notification %s timestamp retrieved from storage did not match: want %v, got %v", ts.name, ts.want, ts.got)
+				}
+			}
+			w, g := want[i], got[i]
+			w.CreatedAt, w.NextAttempt, w.Expiry = time.Time{}, time.Time{}, time.Time{}
+			g.CreatedAt, g.NextAttempt, g.Expiry = time.Time{}, time.Time{}, time.Time{}
+			if diff := pretty.Compare(w, g); diff != "" {
+				t.Errorf("logout notification retrieved from storage did not match: %s", diff)
+			}
+		}
+	}
+
+	listAndCompare([]storage.LogoutNotification{n1, n2})
+
+	nextAttempt := time.Now().UTC().Add(time.Minute).Round(time.Millisecond)
+	if err := s.UpdateLogoutNotification(ctx, n1.ID, func(old storage.LogoutNotification) (storage.LogoutNotification, error) {
+		old.Attempts++
+		old.NextAttempt = nextAttempt
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update logout notification: %v", err)
+	}
+	n1.Attempts = 1
+	n1.NextAttempt = nextAttempt
+
+	// Ensure that updating the first notification doesn't impact the second.
+	listAndCompare([]storage.LogoutNotification{n1, n2})
+
+	if err := s.DeleteLogoutNotification(ctx, n1.ID); err != nil {
+		t.Fatalf("failed to delete logout notification: %v", err)
+	}
+	listAndCompare([]storage.LogoutNotification{n2})
+
+	err = s.DeleteLogoutNotification(ctx, n1.ID)
+	mustBeErrNotFound(t, "logout notification", err)
+
+	if err := s.DeleteLogoutNotification(ctx, n2.ID); err != nil {
+		t.Fatalf("failed to delete logout notification: %v", err)
+	}
+}
+
+func testAccessTokenCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	token := storage.AccessToken{
+		ID:          storage.NewID(),
+		ClientID:    "client1",
+		Scopes:      []string{"openid", "email"},
+		Audience:    []string{"orders-api"},
+		ConnectorID: "mock",
+		Claims: storage.Claims{
+			UserID:            "1",
+			Username:          "jane",
+			PreferredUsername: "jane.doe",
+			Email:             "jane.doe@example.com",
+			EmailVerified:     true,
+			Groups:            []string{"a", "b"},
+			ExtraClaims:       map[string]any{"department": "engineering", "projects": []any{"a", "b"}},
+		},
+		RefreshTokenID:        "refresh1",
+		CertificateThumbprint: "certificate-thumbprint",
+		DPoPKeyThumbprint:     "dpop-key-thumbprint",
+		CreatedAt:             time.Now().UTC().Round(time.Millisecond),
+		Expiry:                neverExpire,
+	}
+
+	if err := s.CreateAccessToken(ctx, token); err != nil {
+		t.Fatalf("failed creating access token: %v", err)
+	}
+
+	// Attempt to create same access token twice.
+	err := s.CreateAccessToken(ctx, token)
+	mustBeErrAlreadyExists(t, "access token", err)
+
+	got, err := s.GetAccessToken(ctx, token.ID)
+	if err != nil {
+		t.Fatalf("get access token: %v", err)
+	}
+	if !got.CreatedAt.Equal(token.CreatedAt) {
+		t.Errorf("access token created at timestamp retrieved from storage did not match: want %v, got %v", token.CreatedAt, got.CreatedAt)
+	}
+	if !got.Expiry.Equal(token.Expiry) {
+		t.Errorf("access token expiry timestamp retrieved from storage did not match: want %v, got %v", token.Expiry, got.Expiry)
+	}
+	want := token
+	got.CreatedAt, got.Expiry = time.Time{}, time.Time{}
+	want.CreatedAt, want.Expiry = time.Time{}, time.Time{}
+	if diff := pretty.Compare(want, got); diff != "" {
+		t.Errorf("access token retrieved from storage did not match: %s", diff)
+	}
+
+	if err := s.DeleteAccessToken(ctx, token.ID); err != nil {
+		t.Fatalf("failed to delete access token: %v", err)
+	}
+
+	_, err = s.GetAccessToken(ctx, token.ID)
+	mustBeErrNotFound(t, "access token", err)
+
+	err = s.DeleteAccessToken(ctx, token.ID)
+	mustBeErrNotFound(t, "access token", err)
+
+	// Revoking a refresh token deletes the access tokens issued with it, and
+	// only those.
+	sibling := token
+	sibling.ID = storage.NewID()
+	other := token
+	other.ID = storage.NewID()
+	other.RefreshTokenID = "refresh2"
+	for _, tok := range []storage.AccessToken{token, sibling, other} {
+		if err := s.CreateAccessToken(ctx, tok); err != nil {
+			t.Fatalf("failed creating access token: %v", err)
+		}
+	}
+	if err := s.DeleteRefreshAccessTokens(ctx, "refresh1"); err != nil {
+		t.Fatalf("failed to delete refresh access tokens: %v", err)
+	}
+	for _, id := range []string{token.ID, sibling.ID} {
+		_, err = s.GetAccessToken(ctx, id)
+		mustBeErrNotFound(t, "access token", err)
+	}
+	if _, err := s.GetAccessToken(ctx, other.ID); err != nil {
+		t.Fatalf("access token of another refresh token was deleted: %v", err)
+	}
+
+	err = s.DeleteRefreshAccessTokens(ctx, "refresh1")
+	mustBeErrNotFound(t, "access token", err)
+
+	if err := s.DeleteAccessToken(ctx, other.ID); err != nil {
+		t.Fatalf("failed to delete access token: %v", err)
+	}
+}
+
+func testInitialAccessTokenCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	token := storage.InitialAccessToken{
+		ID:        storage.NewID(),
+		CreatedAt: time.Now().UTC().Round(time.Millisecond),
+		Expiry:    neverExpire,
+	}
+
+	if err := s.CreateInitialAccessToken(ctx, token); err != nil {
+		t.Fatalf("failed creating initial access token: %v", err)
+	}
+
+	err := s.CreateInitialAccessToken(ctx, token)
+	mustBeErrAlreadyExists(t, "initial access token", err)
+
+	got, err := s.GetInitialAccessToken(ctx, token.ID)
+	if err != nil {
+		t.Fatalf("get initial access token: %v", err)
+	}
+	if got.ID != token.ID {
+		t.Errorf("initial access token ID retrieved from storage did not match: want %q, got %q", token.ID, got.ID)
+	}
+	if !got.CreatedAt.Equal(token.CreatedAt) {
+		t.Errorf("initial access token created at timestamp retrieved from storage did not match: want %v, got %v", token.CreatedAt, got.CreatedAt)
+	}
+	if !got.Expiry.Equal(token.Expiry) {
+		t.Errorf("initial access token expiry timestamp retrieved from storage did not match: want %v, got %v", token.Expiry, got.Expiry)
+	}
+
+	if err := s.DeleteInitialAccessToken(ctx, token.ID); err != nil {
+		t.Fatalf("failed to delete initial access token: %v", err)
+	}
+
+	_, err = s.GetInitialAccessToken(ctx, token.ID)
+	mustBeErrNotFound(t, "initial access token", err)
+
+	err = s.DeleteInitialAccessToken(ctx, token.ID)
+	mustBeErrNotFound(t, "initial access token", err)
+}
+
+func testClientAssertionCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	assertion := storage.ClientAssertion{
+		ID:       storage.NewID(),
+		ClientID: "client1",
+		Expiry:   neverExpire,
+	}
+
+	if err := s.CreateClientAssertion(ctx, assertion); err != nil {
+		t.Fatalf("failed creating client assertion: %v", err)
+	}
+
+	// A replayed assertion must be rejected.
+	err := s.CreateClientAssertion(ctx, assertion)
+	mustBeErrAlreadyExists(t, "client assertion", err)
+
+	got, err := s.GetClientAssertion(ctx, assertion.ID)
+	if err != nil {
+		t.Fatalf("get client assertion: %v", err)
+	}
+	if !got.Expiry.Equal(assertion.Expiry) {
+		t.Errorf("client assertion expiry timestamp retrieved from storage did not match: want %v, got %v", assertion.Expiry, got.Expiry)
+	}
+	got.Expiry = assertion.Expiry
+	if diff := pretty.Compare(assertion, got); diff != "" {
+		t.Errorf("client assertion retrieved from storage did not match: %s", diff)
+	}
+
+	_, err = s.GetClientAssertion(ctx, storage.NewID())
+	mustBeErrNotFound(t, "client assertion", err)
+}
+
+func testDPoPProofCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	proof := storage.DPoPProof{
+		ID:     storage.NewID(),
+		Expiry: neverExpire,
+	}
+
+	if err := s.CreateDPoPProof(ctx, proof); err != nil {
+		t.Fatalf("failed creating dpop proof: %v", err)
+	}
+
+	// A replayed proof must be rejected.
+	err := s.CreateDPoPProof(ctx, proof)
+	mustBeErrAlreadyExists(t, "dpop proof", err)
+
+	got, err := s.GetDPoPProof(ctx, proof.ID)
+	if err != nil {
+		t.Fatalf("get dpop proof: %v", err)
+	}
+	if !got.Expiry.Equal(proof.Expiry) {
+		t.Errorf("dpop proof expiry timestamp retrieved from storage did not match: want %v, got %v", proof.Expiry, got.Expiry)
+	}
+	got.Expiry = proof.Expiry
+	if diff := pretty.Compare(proof, got); diff != "" {
+		t.Errorf("dpop proof retrieved from storage did not match: %s", diff)
+	}
+
+	_, err = s.GetDPoPProof(ctx, storage.NewID())
+	mustBeErrNotFound(t, "dpop proof", err)
+}
+
+func testConsentCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	now := time.Now().UTC().Round(time.Millisecond)
+	c1 := storage.Consent{
+		UserID:      "user1",
+		ConnectorID: "conn1",
+		ClientID:    "client1",
+		Scopes:      []string{"openid", "email"},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	c2 := c1
+	c2.ClientID = "client2"
+	c3 := c1
+	c3.UserID = "user2"
+
+	for _, c := range []storage.Consent{c1, c2, c3} {
+		if err := s.CreateConsent(ctx, c); err != nil {
+			t.Fatalf("failed creating consent: %v", err)
+		}
+	}
+
+	err := s.CreateConsent(ctx, c1)
+	mustBeErrAlreadyExists(t, "consent", err)
+
+	getAndCompare := func(want storage.Consent) {
+		t.Helper()
+		got, err := s.GetConsent(ctx, want.UserID, want.ConnectorID, want.ClientID)
+		if err != nil {
+			t.Fatalf("get consent: %v", err)
+		}
+		if !got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+			t.Errorf("consent timestamps retrieved from storage did not match: want %v/%v, got %v/%v",
+				want.CreatedAt, want.UpdatedAt, got.CreatedAt, got.UpdatedAt)
+		}
+		got.CreatedAt, got.UpdatedAt = want.CreatedAt, want.UpdatedAt
+		if diff := pretty.Compare(want, got); diff != "" {
+			t.Errorf("consent retrieved from storage did not match: %s", diff)
+		}
+	}
+	getAndCompare(c1)
+
+	consents, err := s.ListConsents(ctx, c1.UserID, c1.ConnectorID)
+	if err != nil {
+		t.Fatalf("list consents: %v", err)
+	}
+	var clientIDs []string
+	for _, c := range consents {
+		clientIDs = append(clientIDs, c.ClientID)
+	}
+	sort.Strings(clientIDs)
+	require.Equal(t, []string{"client1", "client2"}, clientIDs)
+
+	updatedAt := now.Add(time.Minute)
+	err = s.UpdateConsent(ctx, c1.UserID, c1.ConnectorID, c1.ClientID, func(old storage.Consent) (storage.Consent, error) {
+		old.Scopes = append(old.Scopes, "groups")
+		old.UpdatedAt = updatedAt
+		return old, nil
+	})
+	if err != nil {
+		t.Fatalf("failed to update consent: %v", err)
+	}
+	c1.Scopes = []string{"openid", "email", "groups"}
+	c1.UpdatedAt = updatedAt
+	getAndCompare(c1)
+
+	if err := s.DeleteConsent(ctx, c1.UserID, c1.ConnectorID, c1.ClientID); err != nil {
+		t.Fatalf("failed to delete consent: %v", err)
+	}
+
+	_, err = s.GetConsent(ctx, c1.UserID, c1.ConnectorID, c1.ClientID)
+	mustBeErrNotFound(t, "consent", err)
+
+	err = s.DeleteConsent(ctx, c1.UserID, c1.ConnectorID, c1.ClientID)
+	mustBeErrNotFound(t, "consent", err)
+
+	// The consents of others are left alone.
+	getAndCompare(c2)
+	getAndCompare(c3)
+}
+
+func testScopeCRUD(t *testing.T, s storage.Storage) {
+	ctx := t.Context()
+
+	sc1 := storage.Scope{
+		Name:         "orders:read",
+		Descriptions: map[string]string{"en": "View your orders", "de": "Ihre Bestellungen ansehen"},
+		Claims:       []string{"customer_id"},
+		ClientIDs:    []string{"shop"},
+	}
+	sc2 := storage.Scope{
+		Name:         "department",
+		Descriptions: map[string]string{"en": "View your department"},
+		Claims:       []string{"department", "cost_center"},
+	}
+	for _, sc := range []storage.Scope{sc1, sc2} {
+		if err := s.CreateScope(ctx, sc); err != nil {
+			t.Fatalf("failed creating scope: %v", err)
+		}
+	}
+
+	err := s.CreateScope(ctx, sc1)
+	mustBeErrAlreadyExists(t, "scope", err)
+
+	getAndCompare := func(want storage.Scope) {
+		t.Helper()
+		got, err := s.GetScope(ctx, want.Name)
+		if err != nil {
+			t.Fatalf("get scope: %v", err)
+		}
+		if diff := pretty.Compare(want, got); diff != "" {
+			t.Errorf("scope retrieved from storage did not match: %s", diff)
+		}
+	}
+	getAndCompare(sc1)
+	getAndCompare(sc2)
+
+	scopes, err := s.ListScopes(ctx)
+	if err != nil {
+		t.Fatalf("list scopes: %v", err)
+	}
+	var names []string
+	for _, sc := range scopes {
+		names = append(names, sc.Name)
+	}
+	sort.Strings(names)
+	require.Equal(t, []string{"department", "orders:read"}, names)
+
+	err = s.UpdateScope(ctx, sc1.Name, func(old storage.Scope) (storage.Scope, error) {
+		old.ClientIDs = append(old.ClientIDs, "backoffice")
+		return old, nil
+	})
+	if err != nil {
+		t.Fatalf("failed to update scope: %v", err)
+	}
+	sc1.ClientIDs = []string{"shop", "backoffice"}
+	getAndCompare(sc1)
+
+	if err := s.DeleteScope(ctx, sc1.Name); err != nil {
+		t.Fatalf("failed to delete scope: %v", err)
+	}
+
+	_, err = s.GetScope(ctx, sc1.Name)
+	mustBeErrNotFound(t, "scope", err)
+
+	err = s.DeleteScope(ctx, sc1.Name)
+	mustBeErrNotFound(t, "scope", err)
+}
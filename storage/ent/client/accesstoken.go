@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
+)
+
+// CreateAccessToken saves provided opaque access token into the database.
+func (d *Database) CreateAccessToken(ctx context.Context, token storage.AccessToken) error {
+	_, err := d.client.AccessToken.Create().
+		SetID(token.ID).
+		SetClientID(token.ClientID).
+		SetScopes(token.Scopes).
+		SetAudience(token.Audience).
+		SetConnectorID(token.ConnectorID).
+		SetClaimsUserID(token.Claims.UserID).
+		SetClaimsUsername(token.Claims.Username).
+		SetClaimsPreferredUsername(token.Claims.PreferredUsername).
+		SetClaimsEmail(token.Claims.Email).
+		SetClaimsEmailVerified(token.Claims.EmailVerified).
+		SetClaimsGroups(token.Claims.Groups).
+		SetClaimsExtra(token.Claims.ExtraClaims).
+		SetCertificateThumbprint(token.CertificateThumbprint).
+		SetDpopKeyThumbprint(token.DPoPKeyThumbprint).
+		SetRefreshTokenID(token.RefreshTokenID).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetCreatedAt(token.CreatedAt.UTC()).
+		SetExpiry(token.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create access token: %w", err)
+	}
+	return nil
+}
+
+// GetAccessToken extracts an opaque access token from the database by id.
+func (d *Database) GetAccessToken(ctx context.Context, id string) (storage.AccessToken, error) {
+	token, err := d.client.AccessToken.Get(ctx, id)
+	if err != nil {
+		return storage.AccessToken{}, convertDBError("get access token: %w", err)
+	}
+	return toStorageAccessToken(token), nil
+}
+
+// DeleteAccessToken deletes an opaque access token from the database by id.
+func (d *Database) DeleteAccessToken(ctx context.Context, id string) error {
+	err := d.client.AccessToken.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return convertDBError("delete access token: %w", err)
+	}
+	return nil
+}
+
+// DeleteRefreshAccessTokens deletes the opaque access tokens issued along with
+// a refresh token.
+func (d *Database) DeleteRefreshAccessTokens(ctx context.Context, refreshID string) error {
+	n, err := d.client.AccessToken.Delete().
+		Where(accesstoken.RefreshTokenID(refreshID)).
+		Exec(ctx)
+	if err != nil {
+		return convertDBError("delete refresh access tokens: %w", err)
+	}
+	if n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
@@ -20,6 +20,11 @@ func (d *Database) CreateAuthCode(ctx context.Context, code storage.AuthCode) er
 		SetClaimsUsername(code.Claims.Username).
 		SetClaimsPreferredUsername(code.Claims.PreferredUsername).
 		SetClaimsGroups(code.Claims.Groups).
+		SetClaimsExtra(code.Claims.ExtraClaims).
+		SetAcr(code.Authentication.ACR).
+		SetAmr(code.Authentication.AMR).
+		SetAuthTime(code.Authentication.Time.UTC()).
+		SetSid(code.Authentication.SessionID).
 		SetCodeChallenge(code.PKCE.CodeChallenge).
 		SetCodeChallengeMethod(code.PKCE.CodeChallengeMethod).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
@@ -25,6 +25,14 @@ func (d *Database) CreateAuthRequest(ctx context.Context, authRequest storage.Au
 		SetClaimsUsername(authRequest.Claims.Username).
 		SetClaimsPreferredUsername(authRequest.Claims.PreferredUsername).
 		SetClaimsGroups(authRequest.Claims.Groups).
+		SetClaimsExtra(authRequest.Claims.ExtraClaims).
+		SetAcrValues(authRequest.ACRValues).
+		SetAcr(authRequest.Authentication.ACR).
+		SetAmr(authRequest.Authentication.AMR).
+		SetAuthTime(authRequest.Authentication.Time.UTC()).
+		SetSid(authRequest.Authentication.SessionID).
+		SetLoginHint(authRequest.LoginHint).
+		SetUILocales(authRequest.UILocales).
 		SetCodeChallenge(authRequest.PKCE.CodeChallenge).
 		SetCodeChallengeMethod(authRequest.PKCE.CodeChallengeMethod).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
@@ -32,6 +40,8 @@ func (d *Database) CreateAuthRequest(ctx context.Context, authRequest storage.Au
 		SetConnectorID(authRequest.ConnectorID).
 		SetConnectorData(authRequest.ConnectorData).
 		SetHmacKey(authRequest.HMACKey).
+		SetPrompt(authRequest.Prompt).
+		SetMaxAge(authRequest.MaxAge).
 		Save(ctx)
 	if err != nil {
 		return convertDBError("create auth request: %w", err)
@@ -89,6 +99,14 @@ func (d *Database) UpdateAuthRequest(ctx context.Context, id string, updater fun
 		SetClaimsUsername(newAuthRequest.Claims.Username).
 		SetClaimsPreferredUsername(newAuthRequest.Claims.PreferredUsername).
 		SetClaimsGroups(newAuthRequest.Claims.Groups).
+		SetClaimsExtra(newAuthRequest.Claims.ExtraClaims).
+		SetAcrValues(newAuthRequest.ACRValues).
+		SetAcr(newAuthRequest.Authentication.ACR).
+		SetAmr(newAuthRequest.Authentication.AMR).
+		SetAuthTime(newAuthRequest.Authentication.Time.UTC()).
+		SetSid(newAuthRequest.Authentication.SessionID).
+		SetLoginHint(newAuthRequest.LoginHint).
+		SetUILocales(newAuthRequest.UILocales).
 		SetCodeChallenge(newAuthRequest.PKCE.CodeChallenge).
 		SetCodeChallengeMethod(newAuthRequest.PKCE.CodeChallengeMethod).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
@@ -96,6 +114,8 @@ func (d *Database) UpdateAuthRequest(ctx context.Context, id string, updater fun
 		SetConnectorID(newAuthRequest.ConnectorID).
 		SetConnectorData(newAuthRequest.ConnectorData).
 		SetHmacKey(newAuthRequest.HMACKey).
+		SetPrompt(newAuthRequest.Prompt).
+		SetMaxAge(newAuthRequest.MaxAge).
 		Save(context.TODO())
 	if err != nil {
 		return rollback(tx, "update auth request uploading: %w", err)
@@ -16,6 +16,26 @@ func (d *Database) CreateClient(ctx context.Context, client storage.Client) erro
 		SetLogoURL(client.LogoURL).
 		SetRedirectUris(client.RedirectURIs).
 		SetTrustedPeers(client.TrustedPeers).
+		SetPostLogoutRedirectUris(client.PostLogoutRedirectURIs).
+		SetBackchannelLogoutURI(client.BackchannelLogoutURI).
+		SetClientCredentialsScopes(client.ClientCredentialsScopes).
+		SetClientCredentialsAudiences(client.ClientCredentialsAudiences).
+		SetAccessTokenFormat(client.AccessTokenFormat).
+		SetRequirePushedAuthorizationRequests(client.RequirePushedAuthorizationRequests).
+		SetJwks(client.JWKS).
+		SetJwksURI(client.JWKSURI).
+		SetRegistrationAccessTokenHash(client.RegistrationAccessTokenHash).
+		SetTokenEndpointAuthMethod(client.TokenEndpointAuthMethod).
+		SetTLSClientAuthSubjectDn(client.TLSClientAuthSubjectDN).
+		SetClaimMappings(client.ClaimMappings).
+		SetSubjectType(client.SubjectType).
+		SetSectorIdentifierURI(client.SectorIdentifierURI).
+		SetRequiredScopes(client.RequiredScopes).
+		SetExpiry(client.Expiry).
+		SetAllowedConnectors(client.AllowedConnectors).
+		SetAllowedGrantTypes(client.AllowedGrantTypes).
+		SetAllowedResponseTypes(client.AllowedResponseTypes).
+		SetRequirePkce(client.RequirePKCE).
 		Save(ctx)
 	if err != nil {
 		return convertDBError("create oauth2 client: %w", err)
@@ -79,6 +99,26 @@ func (d *Database) UpdateClient(ctx context.Context, id string, updater func(old
 		SetLogoURL(newClient.LogoURL).
 		SetRedirectUris(newClient.RedirectURIs).
 		SetTrustedPeers(newClient.TrustedPeers).
+		SetPostLogoutRedirectUris(newClient.PostLogoutRedirectURIs).
+		SetBackchannelLogoutURI(newClient.BackchannelLogoutURI).
+		SetClientCredentialsScopes(newClient.ClientCredentialsScopes).
+		SetClientCredentialsAudiences(newClient.ClientCredentialsAudiences).
+		SetAccessTokenFormat(newClient.AccessTokenFormat).
+		SetRequirePushedAuthorizationRequests(newClient.RequirePushedAuthorizationRequests).
+		SetJwks(newClient.JWKS).
+		SetJwksURI(newClient.JWKSURI).
+		SetRegistrationAccessTokenHash(newClient.RegistrationAccessTokenHash).
+		SetTokenEndpointAuthMethod(newClient.TokenEndpointAuthMethod).
+		SetTLSClientAuthSubjectDn(newClient.TLSClientAuthSubjectDN).
+		SetClaimMappings(newClient.ClaimMappings).
+		SetSubjectType(newClient.SubjectType).
+		SetSectorIdentifierURI(newClient.SectorIdentifierURI).
+		SetRequiredScopes(newClient.RequiredScopes).
+		SetExpiry(newClient.Expiry).
+		SetAllowedConnectors(newClient.AllowedConnectors).
+		SetAllowedGrantTypes(newClient.AllowedGrantTypes).
+		SetAllowedResponseTypes(newClient.AllowedResponseTypes).
+		SetRequirePkce(newClient.RequirePKCE).
 		Save(ctx)
 	if err != nil {
 		return rollback(tx, "update client uploading: %w", err)
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateClientAssertion saves provided client assertion into the database.
+func (d *Database) CreateClientAssertion(ctx context.Context, assertion storage.ClientAssertion) error {
+	_, err := d.client.ClientAssertion.Create().
+		SetID(assertion.ID).
+		SetClientID(assertion.ClientID).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetExpiry(assertion.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create client assertion: %w", err)
+	}
+	return nil
+}
+
+// GetClientAssertion extracts a client assertion from the database by id.
+func (d *Database) GetClientAssertion(ctx context.Context, id string) (storage.ClientAssertion, error) {
+	assertion, err := d.client.ClientAssertion.Get(ctx, id)
+	if err != nil {
+		return storage.ClientAssertion{}, convertDBError("get client assertion: %w", err)
+	}
+	return toStorageClientAssertion(assertion), nil
+}
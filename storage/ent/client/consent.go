@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/ent/db/consent"
+)
+
+// CreateConsent saves provided consent into the database.
+func (d *Database) CreateConsent(ctx context.Context, c storage.Consent) error {
+	_, err := d.client.Consent.Create().
+		SetID(consentID(c.UserID, c.ConnectorID, c.ClientID, d.hasher)).
+		SetUserID(c.UserID).
+		SetConnID(c.ConnectorID).
+		SetClientID(c.ClientID).
+		SetScopes(c.Scopes).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetCreatedAt(c.CreatedAt.UTC()).
+		SetUpdatedAt(c.UpdatedAt.UTC()).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create consent: %w", err)
+	}
+	return nil
+}
+
+// GetConsent extracts a consent from the database by user, connector and client id.
+func (d *Database) GetConsent(ctx context.Context, userID, connID, clientID string) (storage.Consent, error) {
+	c, err := d.client.Consent.Get(ctx, consentID(userID, connID, clientID, d.hasher))
+	if err != nil {
+		return storage.Consent{}, convertDBError("get consent: %w", err)
+	}
+	return toStorageConsent(c), nil
+}
+
+// ListConsents extracts the consents of a user from the database.
+func (d *Database) ListConsents(ctx context.Context, userID, connID string) ([]storage.Consent, error) {
+	consents, err := d.client.Consent.Query().
+		Where(consent.UserID(userID), consent.ConnID(connID)).
+		All(ctx)
+	if err != nil {
+		return nil, convertDBError("list consents: %w", err)
+	}
+
+	storageConsents := make([]storage.Consent, 0, len(consents))
+	for _, c := range consents {
+		storageConsents = append(storageConsents, toStorageConsent(c))
+	}
+	return storageConsents, nil
+}
+
+// UpdateConsent changes a consent by user, connector and client id using an updater function.
+func (d *Database) UpdateConsent(ctx context.Context, userID, connID, clientID string, updater func(c storage.Consent) (storage.Consent, error)) error {
+	id := consentID(userID, connID, clientID, d.hasher)
+
+	tx, err := d.BeginTx(ctx)
+	if err != nil {
+		return convertDBError("update consent tx: %w", err)
+	}
+
+	c, err := tx.Consent.Get(ctx, id)
+	if err != nil {
+		return rollback(tx, "update consent database: %w", err)
+	}
+
+	newConsent, err := updater(toStorageConsent(c))
+	if err != nil {
+		return rollback(tx, "update consent updating: %w", err)
+	}
+
+	_, err = tx.Consent.UpdateOneID(id).
+		SetScopes(newConsent.Scopes).
+		SetUpdatedAt(newConsent.UpdatedAt.UTC()).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, "update consent uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update consent commit: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteConsent deletes a consent from the database by user, connector and client id.
+func (d *Database) DeleteConsent(ctx context.Context, userID, connID, clientID string) error {
+	err := d.client.Consent.DeleteOneID(consentID(userID, connID, clientID, d.hasher)).Exec(ctx)
+	if err != nil {
+		return convertDBError("delete consent: %w", err)
+	}
+	return nil
+}
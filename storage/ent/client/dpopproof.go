@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateDPoPProof saves provided DPoP proof into the database.
+func (d *Database) CreateDPoPProof(ctx context.Context, proof storage.DPoPProof) error {
+	_, err := d.client.DpopProof.Create().
+		SetID(proof.ID).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetExpiry(proof.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create dpop proof: %w", err)
+	}
+	return nil
+}
+
+// GetDPoPProof extracts a DPoP proof from the database by id.
+func (d *Database) GetDPoPProof(ctx context.Context, id string) (storage.DPoPProof, error) {
+	proof, err := d.client.DpopProof.Get(ctx, id)
+	if err != nil {
+		return storage.DPoPProof{}, convertDBError("get dpop proof: %w", err)
+	}
+	return toStorageDPoPProof(proof), nil
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateInitialAccessToken saves provided initial access token into the database.
+func (d *Database) CreateInitialAccessToken(ctx context.Context, token storage.InitialAccessToken) error {
+	_, err := d.client.InitialAccessToken.Create().
+		SetID(token.ID).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetCreatedAt(token.CreatedAt.UTC()).
+		SetExpiry(token.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create initial access token: %w", err)
+	}
+	return nil
+}
+
+// GetInitialAccessToken extracts an initial access token from the database by id.
+func (d *Database) GetInitialAccessToken(ctx context.Context, id string) (storage.InitialAccessToken, error) {
+	token, err := d.client.InitialAccessToken.Get(ctx, id)
+	if err != nil {
+		return storage.InitialAccessToken{}, convertDBError("get initial access token: %w", err)
+	}
+	return toStorageInitialAccessToken(token), nil
+}
+
+// DeleteInitialAccessToken deletes an initial access token from the database by id.
+func (d *Database) DeleteInitialAccessToken(ctx context.Context, id string) error {
+	err := d.client.InitialAccessToken.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return convertDBError("delete initial access token: %w", err)
+	}
+	return nil
+}
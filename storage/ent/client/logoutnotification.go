@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateLogoutNotification saves provided logout notification into the database.
+func (d *Database) CreateLogoutNotification(ctx context.Context, notification storage.LogoutNotification) error {
+	_, err := d.client.LogoutNotification.Create().
+		SetID(notification.ID).
+		SetClientID(notification.ClientID).
+		SetSubject(notification.Subject).
+		SetSessionID(notification.SessionID).
+		SetAttempts(notification.Attempts).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetCreatedAt(notification.CreatedAt.UTC()).
+		SetNextAttempt(notification.NextAttempt.UTC()).
+		SetExpiry(notification.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create logout notification: %w", err)
+	}
+	return nil
+}
+
+// ListLogoutNotifications extracts an array of logout notifications from the database.
+func (d *Database) ListLogoutNotifications(ctx context.Context) ([]storage.LogoutNotification, error) {
+	notifications, err := d.client.LogoutNotification.Query().All(ctx)
+	if err != nil {
+		return nil, convertDBError("list logout notifications: %w", err)
+	}
+
+	storageNotifications := make([]storage.LogoutNotification, 0, len(notifications))
+	for _, n := range notifications {
+		storageNotifications = append(storageNotifications, toStorageLogoutNotification(n))
+	}
+	return storageNotifications, nil
+}
+
+// DeleteLogoutNotification deletes a logout notification from the database by id.
+func (d *Database) DeleteLogoutNotification(ctx context.Context, id string) error {
+	err := d.client.LogoutNotification.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return convertDBError("delete logout notification: %w", err)
+	}
+	return nil
+}
+
+// UpdateLogoutNotification changes a logout notification by id using an updater function and saves it to the database.
+func (d *Database) UpdateLogoutNotification(ctx context.Context, id string, updater func(old storage.LogoutNotification) (storage.LogoutNotification, error)) error {
+	tx, err := d.BeginTx(ctx)
+	if err != nil {
+		return convertDBError("update logout notification tx: %w", err)
+	}
+
+	notification, err := tx.LogoutNotification.Get(ctx, id)
+	if err != nil {
+		return rollback(tx, "update logout notification database: %w", err)
+	}
+
+	newNotification, err := updater(toStorageLogoutNotification(notification))
+	if err != nil {
+		return rollback(tx, "update logout notification updating: %w", err)
+	}
+
+	_, err = tx.LogoutNotification.UpdateOneID(newNotification.ID).
+		SetClientID(newNotification.ClientID).
+		SetSubject(newNotification.Subject).
+		SetSessionID(newNotification.SessionID).
+		SetAttempts(newNotification.Attempts).
+		SetCreatedAt(newNotification.CreatedAt.UTC()).
+		SetNextAttempt(newNotification.NextAttempt.UTC()).
+		SetExpiry(newNotification.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, "update logout notification uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update logout notification commit: %w", err)
+	}
+	return nil
+}
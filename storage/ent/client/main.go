@@ -8,11 +8,17 @@ import (
 
 	"github.com/dexidp/dex/storage"
 	"github.com/dexidp/dex/storage/ent/db"
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
 	"github.com/dexidp/dex/storage/ent/db/migrate"
+	"github.com/dexidp/dex/storage/ent/db/usersession"
 )
 
 var _ storage.Storage = (*Database)(nil)
@@ -106,5 +112,53 @@ func (d *Database) GarbageCollect(ctx context.Context, now time.Time) (storage.G
 	}
 	result.DeviceTokens = int64(q)
 
+	q, err = d.client.UserSession.Delete().
+		Where(usersession.ExpiryLT(utcNow)).
+		Exec(ctx)
+	if err != nil {
+		return result, convertDBError("gc user session: %w", err)
+	}
+	result.UserSessions = int64(q)
+
+	q, err = d.client.LogoutNotification.Delete().
+		Where(logoutnotification.ExpiryLT(utcNow)).
+		Exec(ctx)
+	if err != nil {
+		return result, convertDBError("gc logout notification: %w", err)
+	}
+	result.LogoutNotifications = int64(q)
+
+	q, err = d.client.AccessToken.Delete().
+		Where(accesstoken.ExpiryLT(utcNow)).
+		Exec(ctx)
+	if err != nil {
+		return result, convertDBError("gc access token: %w", err)
+	}
+	result.AccessTokens = int64(q)
+
+	q, err = d.client.InitialAccessToken.Delete().
+		Where(initialaccesstoken.ExpiryLT(utcNow)).
+		Exec(ctx)
+	if err != nil {
+		return result, convertDBError("gc initial access token: %w", err)
+	}
+	result.InitialAccessTokens = int64(q)
+
+	q, err = d.client.ClientAssertion.Delete().
+		Where(clientassertion.ExpiryLT(utcNow)).
+		Exec(ctx)
+	if err != nil {
+		return result, convertDBError("gc client assertion: %w", err)
+	}
+	result.ClientAssertions = int64(q)
+
+	q, err = d.client.DpopProof.Delete().
+		Where(dpopproof.ExpiryLT(utcNow)).
+		Exec(ctx)
+	if err != nil {
+		return result, convertDBError("gc dpop proof: %w", err)
+	}
+	result.DPoPProofs = int64(q)
+
 	return result, err
 }
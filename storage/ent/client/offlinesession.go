@@ -21,6 +21,7 @@ func (d *Database) CreateOfflineSessions(ctx context.Context, session storage.Of
 		SetUserID(session.UserID).
 		SetConnID(session.ConnID).
 		SetConnectorData(session.ConnectorData).
+		SetExtraClaims(session.ExtraClaims).
 		SetRefresh(encodedRefresh).
 		Save(ctx)
 	if err != nil {
@@ -79,6 +80,7 @@ func (d *Database) UpdateOfflineSessions(ctx context.Context, userID string, con
 		SetUserID(newOfflineSession.UserID).
 		SetConnID(newOfflineSession.ConnID).
 		SetConnectorData(newOfflineSession.ConnectorData).
+		SetExtraClaims(newOfflineSession.ExtraClaims).
 		SetRefresh(encodedRefresh).
 		Save(ctx)
 	if err != nil {
@@ -19,10 +19,16 @@ func (d *Database) CreateRefresh(ctx context.Context, refresh storage.RefreshTok
 		SetClaimsUsername(refresh.Claims.Username).
 		SetClaimsPreferredUsername(refresh.Claims.PreferredUsername).
 		SetClaimsGroups(refresh.Claims.Groups).
+		SetClaimsExtra(refresh.Claims.ExtraClaims).
+		SetAcr(refresh.Authentication.ACR).
+		SetAmr(refresh.Authentication.AMR).
+		SetAuthTime(refresh.Authentication.Time.UTC()).
+		SetSid(refresh.Authentication.SessionID).
 		SetConnectorID(refresh.ConnectorID).
 		SetConnectorData(refresh.ConnectorData).
 		SetToken(refresh.Token).
 		SetObsoleteToken(refresh.ObsoleteToken).
+		SetDpopKeyThumbprint(refresh.DPoPKeyThumbprint).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
 		SetLastUsed(refresh.LastUsed.UTC()).
 		SetCreatedAt(refresh.CreatedAt.UTC()).
@@ -92,10 +98,16 @@ func (d *Database) UpdateRefreshToken(ctx context.Context, id string, updater fu
 		SetClaimsUsername(newtToken.Claims.Username).
 		SetClaimsPreferredUsername(newtToken.Claims.PreferredUsername).
 		SetClaimsGroups(newtToken.Claims.Groups).
+		SetClaimsExtra(newtToken.Claims.ExtraClaims).
+		SetAcr(newtToken.Authentication.ACR).
+		SetAmr(newtToken.Authentication.AMR).
+		SetAuthTime(newtToken.Authentication.Time.UTC()).
+		SetSid(newtToken.Authentication.SessionID).
 		SetConnectorID(newtToken.ConnectorID).
 		SetConnectorData(newtToken.ConnectorData).
 		SetToken(newtToken.Token).
 		SetObsoleteToken(newtToken.ObsoleteToken).
+		SetDpopKeyThumbprint(newtToken.DPoPKeyThumbprint).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
 		SetLastUsed(newtToken.LastUsed.UTC()).
 		SetCreatedAt(newtToken.CreatedAt.UTC()).
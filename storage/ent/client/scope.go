@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateScope saves a scope into the database.
+func (d *Database) CreateScope(ctx context.Context, sc storage.Scope) error {
+	_, err := d.client.Scope.Create().
+		SetID(sc.Name).
+		SetDescriptions(sc.Descriptions).
+		SetClaims(sc.Claims).
+		SetClientIds(sc.ClientIDs).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create scope: %w", err)
+	}
+	return nil
+}
+
+// ListScopes extracts an array of scopes from the database.
+func (d *Database) ListScopes(ctx context.Context) ([]storage.Scope, error) {
+	scopes, err := d.client.Scope.Query().All(ctx)
+	if err != nil {
+		return nil, convertDBError("list scopes: %w", err)
+	}
+
+	storageScopes := make([]storage.Scope, 0, len(scopes))
+	for _, sc := range scopes {
+		storageScopes = append(storageScopes, toStorageScope(sc))
+	}
+	return storageScopes, nil
+}
+
+// GetScope extracts a scope from the database by name.
+func (d *Database) GetScope(ctx context.Context, name string) (storage.Scope, error) {
+	sc, err := d.client.Scope.Get(ctx, name)
+	if err != nil {
+		return storage.Scope{}, convertDBError("get scope: %w", err)
+	}
+	return toStorageScope(sc), nil
+}
+
+// DeleteScope deletes a scope from the database by name.
+func (d *Database) DeleteScope(ctx context.Context, name string) error {
+	err := d.client.Scope.DeleteOneID(name).Exec(ctx)
+	if err != nil {
+		return convertDBError("delete scope: %w", err)
+	}
+	return nil
+}
+
+// UpdateScope changes a scope by name using an updater function and saves it to the database.
+func (d *Database) UpdateScope(ctx context.Context, name string, updater func(old storage.Scope) (storage.Scope, error)) error {
+	tx, err := d.BeginTx(ctx)
+	if err != nil {
+		return convertDBError("update scope tx: %w", err)
+	}
+
+	sc, err := tx.Scope.Get(ctx, name)
+	if err != nil {
+		return rollback(tx, "update scope database: %w", err)
+	}
+
+	newScope, err := updater(toStorageScope(sc))
+	if err != nil {
+		return rollback(tx, "update scope updating: %w", err)
+	}
+
+	_, err = tx.Scope.UpdateOneID(name).
+		SetDescriptions(newScope.Descriptions).
+		SetClaims(newScope.Claims).
+		SetClientIds(newScope.ClientIDs).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, "update scope uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update scope commit: %w", err)
+	}
+
+	return nil
+}
@@ -40,12 +40,24 @@ func toStorageAuthRequest(a *db.AuthRequest) storage.AuthRequest {
 			Email:             a.ClaimsEmail,
 			EmailVerified:     a.ClaimsEmailVerified,
 			Groups:            a.ClaimsGroups,
+			ExtraClaims:       a.ClaimsExtra,
 		},
 		PKCE: storage.PKCE{
 			CodeChallenge:       a.CodeChallenge,
 			CodeChallengeMethod: a.CodeChallengeMethod,
 		},
-		HMACKey: a.HmacKey,
+		HMACKey:   a.HmacKey,
+		Prompt:    a.Prompt,
+		MaxAge:    a.MaxAge,
+		ACRValues: a.AcrValues,
+		LoginHint: a.LoginHint,
+		UILocales: a.UILocales,
+		Authentication: storage.Authentication{
+			ACR:       a.Acr,
+			AMR:       a.Amr,
+			Time:      a.AuthTime,
+			SessionID: a.Sid,
+		},
 	}
 }
 
@@ -66,6 +78,13 @@ func toStorageAuthCode(a *db.AuthCode) storage.AuthCode {
 			Email:             a.ClaimsEmail,
 			EmailVerified:     a.ClaimsEmailVerified,
 			Groups:            a.ClaimsGroups,
+			ExtraClaims:       a.ClaimsExtra,
+		},
+		Authentication: storage.Authentication{
+			ACR:       a.Acr,
+			AMR:       a.Amr,
+			Time:      a.AuthTime,
+			SessionID: a.Sid,
 		},
 		PKCE: storage.PKCE{
 			CodeChallenge:       a.CodeChallenge,
@@ -76,13 +95,33 @@ func toStorageAuthCode(a *db.AuthCode) storage.AuthCode {
 
 func toStorageClient(c *db.OAuth2Client) storage.Client {
 	return storage.Client{
-		ID:           c.ID,
-		Secret:       c.Secret,
-		RedirectURIs: c.RedirectUris,
-		TrustedPeers: c.TrustedPeers,
-		Public:       c.Public,
-		Name:         c.Name,
-		LogoURL:      c.LogoURL,
+		ID:                                 c.ID,
+		Secret:                             c.Secret,
+		RedirectURIs:                       c.RedirectUris,
+		TrustedPeers:                       c.TrustedPeers,
+		PostLogoutRedirectURIs:             c.PostLogoutRedirectUris,
+		BackchannelLogoutURI:               c.BackchannelLogoutURI,
+		ClientCredentialsScopes:            c.ClientCredentialsScopes,
+		ClientCredentialsAudiences:         c.ClientCredentialsAudiences,
+		AccessTokenFormat:                  c.AccessTokenFormat,
+		ClaimMappings:                      c.ClaimMappings,
+		SubjectType:                        c.SubjectType,
+		SectorIdentifierURI:                c.SectorIdentifierURI,
+		RequiredScopes:                     c.RequiredScopes,
+		Expiry:                             c.Expiry,
+		AllowedConnectors:                  c.AllowedConnectors,
+		AllowedGrantTypes:                  c.AllowedGrantTypes,
+		AllowedResponseTypes:               c.AllowedResponseTypes,
+		RequirePKCE:                        c.RequirePkce,
+		RequirePushedAuthorizationRequests: c.RequirePushedAuthorizationRequests,
+		JWKS:                               c.Jwks,
+		JWKSURI:                            c.JwksURI,
+		RegistrationAccessTokenHash:        c.RegistrationAccessTokenHash,
+		TokenEndpointAuthMethod:            c.TokenEndpointAuthMethod,
+		TLSClientAuthSubjectDN:             c.TLSClientAuthSubjectDn,
+		Public:                             c.Public,
+		Name:                               c.Name,
+		LogoURL:                            c.LogoURL,
 	}
 }
 
@@ -100,6 +139,7 @@ func toStorageOfflineSession(o *db.OfflineSession) storage.OfflineSessions {
 		UserID:        o.UserID,
 		ConnID:        o.ConnID,
 		ConnectorData: *o.ConnectorData,
+		ExtraClaims:   o.ExtraClaims,
 	}
 
 	if o.Refresh != nil {
@@ -133,7 +173,15 @@ func toStorageRefreshToken(r *db.RefreshToken) storage.RefreshToken {
 			Email:             r.ClaimsEmail,
 			EmailVerified:     r.ClaimsEmailVerified,
 			Groups:            r.ClaimsGroups,
+			ExtraClaims:       r.ClaimsExtra,
+		},
+		Authentication: storage.Authentication{
+			ACR:       r.Acr,
+			AMR:       r.Amr,
+			Time:      r.AuthTime,
+			SessionID: r.Sid,
 		},
+		DPoPKeyThumbprint: r.DpopKeyThumbprint,
 	}
 }
 
@@ -175,3 +223,113 @@ func toStorageDeviceToken(t *db.DeviceToken) storage.DeviceToken {
 		},
 	}
 }
+
+func toStorageUserSession(s *db.UserSession) storage.UserSession {
+	session := storage.UserSession{
+		ID:          s.ID,
+		ConnectorID: s.ConnectorID,
+		Claims: storage.Claims{
+			UserID:            s.ClaimsUserID,
+			Username:          s.ClaimsUsername,
+			PreferredUsername: s.ClaimsPreferredUsername,
+			Email:             s.ClaimsEmail,
+			EmailVerified:     s.ClaimsEmailVerified,
+			Groups:            s.ClaimsGroups,
+			ExtraClaims:       s.ClaimsExtra,
+		},
+		Authentication: storage.Authentication{
+			ACR:       s.Acr,
+			AMR:       s.Amr,
+			Time:      s.AuthTime,
+			SessionID: s.Sid,
+		},
+		ClientIDs: s.ClientIds,
+		CreatedAt: s.CreatedAt,
+		LastUsed:  s.LastUsed,
+		Expiry:    s.Expiry,
+	}
+	if s.ConnectorData != nil {
+		session.ConnectorData = *s.ConnectorData
+	}
+	return session
+}
+
+func toStorageLogoutNotification(n *db.LogoutNotification) storage.LogoutNotification {
+	return storage.LogoutNotification{
+		ID:          n.ID,
+		ClientID:    n.ClientID,
+		Subject:     n.Subject,
+		SessionID:   n.SessionID,
+		CreatedAt:   n.CreatedAt,
+		Attempts:    n.Attempts,
+		NextAttempt: n.NextAttempt,
+		Expiry:      n.Expiry,
+	}
+}
+
+func toStorageAccessToken(t *db.AccessToken) storage.AccessToken {
+	return storage.AccessToken{
+		ID:          t.ID,
+		ClientID:    t.ClientID,
+		Scopes:      t.Scopes,
+		Audience:    t.Audience,
+		ConnectorID: t.ConnectorID,
+		Claims: storage.Claims{
+			UserID:            t.ClaimsUserID,
+			Username:          t.ClaimsUsername,
+			PreferredUsername: t.ClaimsPreferredUsername,
+			Email:             t.ClaimsEmail,
+			EmailVerified:     t.ClaimsEmailVerified,
+			Groups:            t.ClaimsGroups,
+			ExtraClaims:       t.ClaimsExtra,
+		},
+		CertificateThumbprint: t.CertificateThumbprint,
+		DPoPKeyThumbprint:     t.DpopKeyThumbprint,
+		RefreshTokenID:        t.RefreshTokenID,
+		CreatedAt:             t.CreatedAt,
+		Expiry:                t.Expiry,
+	}
+}
+
+func toStorageInitialAccessToken(t *db.InitialAccessToken) storage.InitialAccessToken {
+	return storage.InitialAccessToken{
+		ID:        t.ID,
+		CreatedAt: t.CreatedAt,
+		Expiry:    t.Expiry,
+	}
+}
+
+func toStorageClientAssertion(a *db.ClientAssertion) storage.ClientAssertion {
+	return storage.ClientAssertion{
+		ID:       a.ID,
+		ClientID: a.ClientID,
+		Expiry:   a.Expiry,
+	}
+}
+
+func toStorageDPoPProof(p *db.DpopProof) storage.DPoPProof {
+	return storage.DPoPProof{
+		ID:     p.ID,
+		Expiry: p.Expiry,
+	}
+}
+
+func toStorageConsent(c *db.Consent) storage.Consent {
+	return storage.Consent{
+		UserID:      c.UserID,
+		ConnectorID: c.ConnID,
+		ClientID:    c.ClientID,
+		Scopes:      c.Scopes,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func toStorageScope(sc *db.Scope) storage.Scope {
+	return storage.Scope{
+		Name:         sc.ID,
+		Descriptions: sc.Descriptions,
+		Claims:       sc.Claims,
+		ClientIDs:    sc.ClientIds,
+	}
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateUserSession saves provided user session into the database.
+func (d *Database) CreateUserSession(ctx context.Context, session storage.UserSession) error {
+	_, err := d.client.UserSession.Create().
+		SetID(session.ID).
+		SetConnectorID(session.ConnectorID).
+		SetConnectorData(session.ConnectorData).
+		SetClaimsUserID(session.Claims.UserID).
+		SetClaimsUsername(session.Claims.Username).
+		SetClaimsPreferredUsername(session.Claims.PreferredUsername).
+		SetClaimsEmail(session.Claims.Email).
+		SetClaimsEmailVerified(session.Claims.EmailVerified).
+		SetClaimsGroups(session.Claims.Groups).
+		SetClaimsExtra(session.Claims.ExtraClaims).
+		SetAcr(session.Authentication.ACR).
+		SetAmr(session.Authentication.AMR).
+		SetAuthTime(session.Authentication.Time.UTC()).
+		SetSid(session.Authentication.SessionID).
+		SetClientIds(session.ClientIDs).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetCreatedAt(session.CreatedAt.UTC()).
+		SetLastUsed(session.LastUsed.UTC()).
+		SetExpiry(session.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return convertDBError("create user session: %w", err)
+	}
+	return nil
+}
+
+// GetUserSession extracts a user session from the database by id.
+func (d *Database) GetUserSession(ctx context.Context, id string) (storage.UserSession, error) {
+	session, err := d.client.UserSession.Get(ctx, id)
+	if err != nil {
+		return storage.UserSession{}, convertDBError("get user session: %w", err)
+	}
+	return toStorageUserSession(session), nil
+}
+
+// DeleteUserSession deletes a user session from the database by id.
+func (d *Database) DeleteUserSession(ctx context.Context, id string) error {
+	err := d.client.UserSession.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return convertDBError("delete user session: %w", err)
+	}
+	return nil
+}
+
+// UpdateUserSession changes a user session by id using an updater function and saves it to the database.
+func (d *Database) UpdateUserSession(ctx context.Context, id string, updater func(old storage.UserSession) (storage.UserSession, error)) error {
+	tx, err := d.BeginTx(ctx)
+	if err != nil {
+		return convertDBError("update user session tx: %w", err)
+	}
+
+	session, err := tx.UserSession.Get(ctx, id)
+	if err != nil {
+		return rollback(tx, "update user session database: %w", err)
+	}
+
+	newSession, err := updater(toStorageUserSession(session))
+	if err != nil {
+		return rollback(tx, "update user session updating: %w", err)
+	}
+
+	_, err = tx.UserSession.UpdateOneID(newSession.ID).
+		SetConnectorID(newSession.ConnectorID).
+		SetConnectorData(newSession.ConnectorData).
+		SetClaimsUserID(newSession.Claims.UserID).
+		SetClaimsUsername(newSession.Claims.Username).
+		SetClaimsPreferredUsername(newSession.Claims.PreferredUsername).
+		SetClaimsEmail(newSession.Claims.Email).
+		SetClaimsEmailVerified(newSession.Claims.EmailVerified).
+		SetClaimsGroups(newSession.Claims.Groups).
+		SetClaimsExtra(newSession.Claims.ExtraClaims).
+		SetAcr(newSession.Authentication.ACR).
+		SetAmr(newSession.Authentication.AMR).
+		SetAuthTime(newSession.Authentication.Time.UTC()).
+		SetSid(newSession.Authentication.SessionID).
+		SetClientIds(newSession.ClientIDs).
+		SetCreatedAt(newSession.CreatedAt.UTC()).
+		SetLastUsed(newSession.LastUsed.UTC()).
+		SetExpiry(newSession.Expiry.UTC()).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, "update user session uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update user session commit: %w", err)
+	}
+	return nil
+}
@@ -42,3 +42,14 @@ func offlineSessionID(userID string, connID string, hasher func() hash.Hash) str
 	h.Write([]byte(connID))
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
+
+// compose hashed id from user, connection and client id of a consent, length
+// prefixed so that the ids can't run into each other
+func consentID(userID, connID, clientID string, hasher func() hash.Hash) string {
+	h := hasher()
+
+	for _, id := range []string{userID, connID, clientID} {
+		fmt.Fprintf(h, "%d:%s", len(id), id)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
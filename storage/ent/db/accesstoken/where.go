@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accesstoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClientID, v))
+}
+
+// ConnectorID applies equality check predicate on the "connector_id" field. It's identical to ConnectorIDEQ.
+func ConnectorID(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// ClaimsUserID applies equality check predicate on the "claims_user_id" field. It's identical to ClaimsUserIDEQ.
+func ClaimsUserID(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsUserID, v))
+}
+
+// ClaimsUsername applies equality check predicate on the "claims_username" field. It's identical to ClaimsUsernameEQ.
+func ClaimsUsername(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsUsername, v))
+}
+
+// ClaimsPreferredUsername applies equality check predicate on the "claims_preferred_username" field. It's identical to ClaimsPreferredUsernameEQ.
+func ClaimsPreferredUsername(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsEmail applies equality check predicate on the "claims_email" field. It's identical to ClaimsEmailEQ.
+func ClaimsEmail(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailVerified applies equality check predicate on the "claims_email_verified" field. It's identical to ClaimsEmailVerifiedEQ.
+func ClaimsEmailVerified(v bool) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsEmailVerified, v))
+}
+
+// CertificateThumbprint applies equality check predicate on the "certificate_thumbprint" field. It's identical to CertificateThumbprintEQ.
+func CertificateThumbprint(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldCertificateThumbprint, v))
+}
+
+// DpopKeyThumbprint applies equality check predicate on the "dpop_key_thumbprint" field. It's identical to DpopKeyThumbprintEQ.
+func DpopKeyThumbprint(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldDpopKeyThumbprint, v))
+}
+
+// RefreshTokenID applies equality check predicate on the "refresh_token_id" field. It's identical to RefreshTokenIDEQ.
+func RefreshTokenID(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldRefreshTokenID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Expiry applies equality check predicate on the "expiry" field. It's identical to ExpiryEQ.
+func Expiry(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldExpiry, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotNull(FieldScopes))
+}
+
+// AudienceIsNil applies the IsNil predicate on the "audience" field.
+func AudienceIsNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIsNull(FieldAudience))
+}
+
+// AudienceNotNil applies the NotNil predicate on the "audience" field.
+func AudienceNotNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotNull(FieldAudience))
+}
+
+// ConnectorIDEQ applies the EQ predicate on the "connector_id" field.
+func ConnectorIDEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDNEQ applies the NEQ predicate on the "connector_id" field.
+func ConnectorIDNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDIn applies the In predicate on the "connector_id" field.
+func ConnectorIDIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDNotIn applies the NotIn predicate on the "connector_id" field.
+func ConnectorIDNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDGT applies the GT predicate on the "connector_id" field.
+func ConnectorIDGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldConnectorID, v))
+}
+
+// ConnectorIDGTE applies the GTE predicate on the "connector_id" field.
+func ConnectorIDGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldConnectorID, v))
+}
+
+// ConnectorIDLT applies the LT predicate on the "connector_id" field.
+func ConnectorIDLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldConnectorID, v))
+}
+
+// ConnectorIDLTE applies the LTE predicate on the "connector_id" field.
+func ConnectorIDLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldConnectorID, v))
+}
+
+// ConnectorIDContains applies the Contains predicate on the "connector_id" field.
+func ConnectorIDContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldConnectorID, v))
+}
+
+// ConnectorIDHasPrefix applies the HasPrefix predicate on the "connector_id" field.
+func ConnectorIDHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldConnectorID, v))
+}
+
+// ConnectorIDHasSuffix applies the HasSuffix predicate on the "connector_id" field.
+func ConnectorIDHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldConnectorID, v))
+}
+
+// ConnectorIDEqualFold applies the EqualFold predicate on the "connector_id" field.
+func ConnectorIDEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldConnectorID, v))
+}
+
+// ConnectorIDContainsFold applies the ContainsFold predicate on the "connector_id" field.
+func ConnectorIDContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldConnectorID, v))
+}
+
+// ClaimsUserIDEQ applies the EQ predicate on the "claims_user_id" field.
+func ClaimsUserIDEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDNEQ applies the NEQ predicate on the "claims_user_id" field.
+func ClaimsUserIDNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDIn applies the In predicate on the "claims_user_id" field.
+func ClaimsUserIDIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldClaimsUserID, vs...))
+}
+
+// ClaimsUserIDNotIn applies the NotIn predicate on the "claims_user_id" field.
+func ClaimsUserIDNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldClaimsUserID, vs...))
+}
+
+// ClaimsUserIDGT applies the GT predicate on the "claims_user_id" field.
+func ClaimsUserIDGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDGTE applies the GTE predicate on the "claims_user_id" field.
+func ClaimsUserIDGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDLT applies the LT predicate on the "claims_user_id" field.
+func ClaimsUserIDLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDLTE applies the LTE predicate on the "claims_user_id" field.
+func ClaimsUserIDLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDContains applies the Contains predicate on the "claims_user_id" field.
+func ClaimsUserIDContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDHasPrefix applies the HasPrefix predicate on the "claims_user_id" field.
+func ClaimsUserIDHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDHasSuffix applies the HasSuffix predicate on the "claims_user_id" field.
+func ClaimsUserIDHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDEqualFold applies the EqualFold predicate on the "claims_user_id" field.
+func ClaimsUserIDEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDContainsFold applies the ContainsFold predicate on the "claims_user_id" field.
+func ClaimsUserIDContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldClaimsUserID, v))
+}
+
+// ClaimsUsernameEQ applies the EQ predicate on the "claims_username" field.
+func ClaimsUsernameEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameNEQ applies the NEQ predicate on the "claims_username" field.
+func ClaimsUsernameNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameIn applies the In predicate on the "claims_username" field.
+func ClaimsUsernameIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldClaimsUsername, vs...))
+}
+
+// ClaimsUsernameNotIn applies the NotIn predicate on the "claims_username" field.
+func ClaimsUsernameNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldClaimsUsername, vs...))
+}
+
+// ClaimsUsernameGT applies the GT predicate on the "claims_username" field.
+func ClaimsUsernameGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameGTE applies the GTE predicate on the "claims_username" field.
+func ClaimsUsernameGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameLT applies the LT predicate on the "claims_username" field.
+func ClaimsUsernameLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameLTE applies the LTE predicate on the "claims_username" field.
+func ClaimsUsernameLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameContains applies the Contains predicate on the "claims_username" field.
+func ClaimsUsernameContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameHasPrefix applies the HasPrefix predicate on the "claims_username" field.
+func ClaimsUsernameHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameHasSuffix applies the HasSuffix predicate on the "claims_username" field.
+func ClaimsUsernameHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameEqualFold applies the EqualFold predicate on the "claims_username" field.
+func ClaimsUsernameEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameContainsFold applies the ContainsFold predicate on the "claims_username" field.
+func ClaimsUsernameContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldClaimsUsername, v))
+}
+
+// ClaimsPreferredUsernameEQ applies the EQ predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameNEQ applies the NEQ predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameIn applies the In predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldClaimsPreferredUsername, vs...))
+}
+
+// ClaimsPreferredUsernameNotIn applies the NotIn predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldClaimsPreferredUsername, vs...))
+}
+
+// ClaimsPreferredUsernameGT applies the GT predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameGTE applies the GTE predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameLT applies the LT predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameLTE applies the LTE predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameContains applies the Contains predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameHasPrefix applies the HasPrefix predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameHasSuffix applies the HasSuffix predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameEqualFold applies the EqualFold predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameContainsFold applies the ContainsFold predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsEmailEQ applies the EQ predicate on the "claims_email" field.
+func ClaimsEmailEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailNEQ applies the NEQ predicate on the "claims_email" field.
+func ClaimsEmailNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailIn applies the In predicate on the "claims_email" field.
+func ClaimsEmailIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldClaimsEmail, vs...))
+}
+
+// ClaimsEmailNotIn applies the NotIn predicate on the "claims_email" field.
+func ClaimsEmailNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldClaimsEmail, vs...))
+}
+
+// ClaimsEmailGT applies the GT predicate on the "claims_email" field.
+func ClaimsEmailGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailGTE applies the GTE predicate on the "claims_email" field.
+func ClaimsEmailGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailLT applies the LT predicate on the "claims_email" field.
+func ClaimsEmailLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailLTE applies the LTE predicate on the "claims_email" field.
+func ClaimsEmailLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailContains applies the Contains predicate on the "claims_email" field.
+func ClaimsEmailContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailHasPrefix applies the HasPrefix predicate on the "claims_email" field.
+func ClaimsEmailHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailHasSuffix applies the HasSuffix predicate on the "claims_email" field.
+func ClaimsEmailHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailEqualFold applies the EqualFold predicate on the "claims_email" field.
+func ClaimsEmailEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailContainsFold applies the ContainsFold predicate on the "claims_email" field.
+func ClaimsEmailContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailVerifiedEQ applies the EQ predicate on the "claims_email_verified" field.
+func ClaimsEmailVerifiedEQ(v bool) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldClaimsEmailVerified, v))
+}
+
+// ClaimsEmailVerifiedNEQ applies the NEQ predicate on the "claims_email_verified" field.
+func ClaimsEmailVerifiedNEQ(v bool) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldClaimsEmailVerified, v))
+}
+
+// ClaimsGroupsIsNil applies the IsNil predicate on the "claims_groups" field.
+func ClaimsGroupsIsNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIsNull(FieldClaimsGroups))
+}
+
+// ClaimsGroupsNotNil applies the NotNil predicate on the "claims_groups" field.
+func ClaimsGroupsNotNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotNull(FieldClaimsGroups))
+}
+
+// ClaimsExtraIsNil applies the IsNil predicate on the "claims_extra" field.
+func ClaimsExtraIsNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIsNull(FieldClaimsExtra))
+}
+
+// ClaimsExtraNotNil applies the NotNil predicate on the "claims_extra" field.
+func ClaimsExtraNotNil() predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotNull(FieldClaimsExtra))
+}
+
+// CertificateThumbprintEQ applies the EQ predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintNEQ applies the NEQ predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintIn applies the In predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldCertificateThumbprint, vs...))
+}
+
+// CertificateThumbprintNotIn applies the NotIn predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldCertificateThumbprint, vs...))
+}
+
+// CertificateThumbprintGT applies the GT predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintGTE applies the GTE predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintLT applies the LT predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintLTE applies the LTE predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintContains applies the Contains predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintHasPrefix applies the HasPrefix predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintHasSuffix applies the HasSuffix predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintEqualFold applies the EqualFold predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldCertificateThumbprint, v))
+}
+
+// CertificateThumbprintContainsFold applies the ContainsFold predicate on the "certificate_thumbprint" field.
+func CertificateThumbprintContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldCertificateThumbprint, v))
+}
+
+// DpopKeyThumbprintEQ applies the EQ predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintNEQ applies the NEQ predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintIn applies the In predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldDpopKeyThumbprint, vs...))
+}
+
+// DpopKeyThumbprintNotIn applies the NotIn predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldDpopKeyThumbprint, vs...))
+}
+
+// DpopKeyThumbprintGT applies the GT predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintGTE applies the GTE predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintLT applies the LT predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintLTE applies the LTE predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintContains applies the Contains predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintHasPrefix applies the HasPrefix predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintHasSuffix applies the HasSuffix predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintEqualFold applies the EqualFold predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintContainsFold applies the ContainsFold predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldDpopKeyThumbprint, v))
+}
+
+// RefreshTokenIDEQ applies the EQ predicate on the "refresh_token_id" field.
+func RefreshTokenIDEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDNEQ applies the NEQ predicate on the "refresh_token_id" field.
+func RefreshTokenIDNEQ(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDIn applies the In predicate on the "refresh_token_id" field.
+func RefreshTokenIDIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldRefreshTokenID, vs...))
+}
+
+// RefreshTokenIDNotIn applies the NotIn predicate on the "refresh_token_id" field.
+func RefreshTokenIDNotIn(vs ...string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldRefreshTokenID, vs...))
+}
+
+// RefreshTokenIDGT applies the GT predicate on the "refresh_token_id" field.
+func RefreshTokenIDGT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDGTE applies the GTE predicate on the "refresh_token_id" field.
+func RefreshTokenIDGTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDLT applies the LT predicate on the "refresh_token_id" field.
+func RefreshTokenIDLT(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDLTE applies the LTE predicate on the "refresh_token_id" field.
+func RefreshTokenIDLTE(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDContains applies the Contains predicate on the "refresh_token_id" field.
+func RefreshTokenIDContains(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContains(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDHasPrefix applies the HasPrefix predicate on the "refresh_token_id" field.
+func RefreshTokenIDHasPrefix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasPrefix(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDHasSuffix applies the HasSuffix predicate on the "refresh_token_id" field.
+func RefreshTokenIDHasSuffix(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldHasSuffix(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDEqualFold applies the EqualFold predicate on the "refresh_token_id" field.
+func RefreshTokenIDEqualFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEqualFold(FieldRefreshTokenID, v))
+}
+
+// RefreshTokenIDContainsFold applies the ContainsFold predicate on the "refresh_token_id" field.
+func RefreshTokenIDContainsFold(v string) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldContainsFold(FieldRefreshTokenID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExpiryEQ applies the EQ predicate on the "expiry" field.
+func ExpiryEQ(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldEQ(FieldExpiry, v))
+}
+
+// ExpiryNEQ applies the NEQ predicate on the "expiry" field.
+func ExpiryNEQ(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNEQ(FieldExpiry, v))
+}
+
+// ExpiryIn applies the In predicate on the "expiry" field.
+func ExpiryIn(vs ...time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldIn(FieldExpiry, vs...))
+}
+
+// ExpiryNotIn applies the NotIn predicate on the "expiry" field.
+func ExpiryNotIn(vs ...time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldNotIn(FieldExpiry, vs...))
+}
+
+// ExpiryGT applies the GT predicate on the "expiry" field.
+func ExpiryGT(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGT(FieldExpiry, v))
+}
+
+// ExpiryGTE applies the GTE predicate on the "expiry" field.
+func ExpiryGTE(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldGTE(FieldExpiry, v))
+}
+
+// ExpiryLT applies the LT predicate on the "expiry" field.
+func ExpiryLT(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLT(FieldExpiry, v))
+}
+
+// ExpiryLTE applies the LTE predicate on the "expiry" field.
+func ExpiryLTE(v time.Time) predicate.AccessToken {
+	return predicate.AccessToken(sql.FieldLTE(FieldExpiry, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccessToken) predicate.AccessToken {
+	return predicate.AccessToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccessToken) predicate.AccessToken {
+	return predicate.AccessToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccessToken) predicate.AccessToken {
+	return predicate.AccessToken(sql.NotPredicates(p))
+}
@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
+)
+
+// AccessTokenCreate is the builder for creating a AccessToken entity.
+type AccessTokenCreate struct {
+	config
+	mutation *AccessTokenMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *AccessTokenCreate) SetClientID(v string) *AccessTokenCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *AccessTokenCreate) SetScopes(v []string) *AccessTokenCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetAudience sets the "audience" field.
+func (_c *AccessTokenCreate) SetAudience(v []string) *AccessTokenCreate {
+	_c.mutation.SetAudience(v)
+	return _c
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_c *AccessTokenCreate) SetConnectorID(v string) *AccessTokenCreate {
+	_c.mutation.SetConnectorID(v)
+	return _c
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (_c *AccessTokenCreate) SetNillableConnectorID(v *string) *AccessTokenCreate {
+	if v != nil {
+		_c.SetConnectorID(*v)
+	}
+	return _c
+}
+
+// SetClaimsUserID sets the "claims_user_id" field.
+func (_c *AccessTokenCreate) SetClaimsUserID(v string) *AccessTokenCreate {
+	_c.mutation.SetClaimsUserID(v)
+	return _c
+}
+
+// SetClaimsUsername sets the "claims_username" field.
+func (_c *AccessTokenCreate) SetClaimsUsername(v string) *AccessTokenCreate {
+	_c.mutation.SetClaimsUsername(v)
+	return _c
+}
+
+// SetNillableClaimsUsername sets the "claims_username" field if the given value is not nil.
+func (_c *AccessTokenCreate) SetNillableClaimsUsername(v *string) *AccessTokenCreate {
+	if v != nil {
+		_c.SetClaimsUsername(*v)
+	}
+	return _c
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (_c *AccessTokenCreate) SetClaimsPreferredUsername(v string) *AccessTokenCreate {
+	_c.mutation.SetClaimsPreferredUsername(v)
+	return _c
+}
+
+// SetNillableClaimsPreferredUsername sets the "claims_preferred_username" field if the given value is not nil.
+func (_c *AccessTokenCreate) SetNillableClaimsPreferredUsername(v *string) *AccessTokenCreate {
+	if v != nil {
+		_c.SetClaimsPreferredUsername(*v)
+	}
+	return _c
+}
+
+// SetClaimsEmail sets the "claims_email" field.
+func (_c *AccessTokenCreate) SetClaimsEmail(v string) *AccessTokenCreate {
+	_c.mutation.SetClaimsEmail(v)
+	return _c
+}
+
+// SetNillableClaimsEmail sets the "claims_email" field if the given value is not nil.
+func (_c *AccessTokenCreate) SetNillableClaimsEmail(v *string) *AccessTokenCreate {
+	if v != nil {
+		_c.SetClaimsEmail(*v)
+	}
+	return _c
+}
+
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (_c *AccessTokenCreate) SetClaimsEmailVerified(v bool) *AccessTokenCreate {
+	_c.mutation.SetClaimsEmailVerified(v)
+	return _c
+}
+
+// SetClaimsGroups sets the "claims_groups" field.
+func (_c *AccessTokenCreate) SetClaimsGroups(v []string) *AccessTokenCreate {
+	_c.mutation.SetClaimsGroups(v)
+	return _c
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (_c *AccessTokenCreate) SetClaimsExtra(v map[string]interface{}) *AccessTokenCreate {
+	_c.mutation.SetClaimsExtra(v)
+	return _c
+}
+
+// SetCertificateThumbprint sets the "certificate_thumbprint" field.
+func (_c *AccessTokenCreate) SetCertificateThumbprint(v string) *AccessTokenCreate {
+	_c.mutation.SetCertificateThumbprint(v)
+	return _c
+}
+
+// SetNillableCertificateThumbprint sets the "certificate_thumbprint" field if the given value is not nil.
+func (_c *AccessTokenCreate) SetNillableCertificateThumbprint(v *string) *AccessTokenCreate {
+	if v != nil {
+		_c.SetCertificateThumbprint(*v)
+	}
+	return _c
+}
+
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (_c *AccessTokenCreate) SetDpopKeyThumbprint(v string) *AccessTokenCreate {
+	_c.mutation.SetDpopKeyThumbprint(v)
+	return _c
+}
+
+// SetNillableDpopKeyThumbprint sets the "dpop_key_thumbprint" field if the given value is not nil.
+func (_c *AccessTokenCreate) SetNillableDpopKeyThumbprint(v *string) *AccessTokenCreate {
+	if v != nil {
+		_c.SetDpopKeyThumbprint(*v)
+	}
+	return _c
+}
+
+// SetRefreshTokenID sets the "refresh_token_id" field.
+func (_c *AccessTokenCreate) SetRefreshTokenID(v string) *AccessTokenCreate {
+	_c.mutation.SetRefreshTokenID(v)
+	return _c
+}
+
+// SetNillableRefreshTokenID sets the "refresh_token_id" field if the given value is not nil.
+func (_c *AccessTokenCreate) SetNillableRefreshTokenID(v *string) *AccessTokenCreate {
+	if v != nil {
+		_c.SetRefreshTokenID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccessTokenCreate) SetCreatedAt(v time.Time) *AccessTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *AccessTokenCreate) SetExpiry(v time.Time) *AccessTokenCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccessTokenCreate) SetID(v string) *AccessTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (_c *AccessTokenCreate) Mutation() *AccessTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the AccessToken in the database.
+func (_c *AccessTokenCreate) Save(ctx context.Context) (*AccessToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccessTokenCreate) SaveX(ctx context.Context) *AccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccessTokenCreate) defaults() {
+	if _, ok := _c.mutation.ConnectorID(); !ok {
+		v := accesstoken.DefaultConnectorID
+		_c.mutation.SetConnectorID(v)
+	}
+	if _, ok := _c.mutation.ClaimsUsername(); !ok {
+		v := accesstoken.DefaultClaimsUsername
+		_c.mutation.SetClaimsUsername(v)
+	}
+	if _, ok := _c.mutation.ClaimsPreferredUsername(); !ok {
+		v := accesstoken.DefaultClaimsPreferredUsername
+		_c.mutation.SetClaimsPreferredUsername(v)
+	}
+	if _, ok := _c.mutation.ClaimsEmail(); !ok {
+		v := accesstoken.DefaultClaimsEmail
+		_c.mutation.SetClaimsEmail(v)
+	}
+	if _, ok := _c.mutation.CertificateThumbprint(); !ok {
+		v := accesstoken.DefaultCertificateThumbprint
+		_c.mutation.SetCertificateThumbprint(v)
+	}
+	if _, ok := _c.mutation.DpopKeyThumbprint(); !ok {
+		v := accesstoken.DefaultDpopKeyThumbprint
+		_c.mutation.SetDpopKeyThumbprint(v)
+	}
+	if _, ok := _c.mutation.RefreshTokenID(); !ok {
+		v := accesstoken.DefaultRefreshTokenID
+		_c.mutation.SetRefreshTokenID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccessTokenCreate) check() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`db: missing required field "AccessToken.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := accesstoken.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "AccessToken.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConnectorID(); !ok {
+		return &ValidationError{Name: "connector_id", err: errors.New(`db: missing required field "AccessToken.connector_id"`)}
+	}
+	if _, ok := _c.mutation.ClaimsUserID(); !ok {
+		return &ValidationError{Name: "claims_user_id", err: errors.New(`db: missing required field "AccessToken.claims_user_id"`)}
+	}
+	if v, ok := _c.mutation.ClaimsUserID(); ok {
+		if err := accesstoken.ClaimsUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`db: validator failed for field "AccessToken.claims_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClaimsUsername(); !ok {
+		return &ValidationError{Name: "claims_username", err: errors.New(`db: missing required field "AccessToken.claims_username"`)}
+	}
+	if _, ok := _c.mutation.ClaimsPreferredUsername(); !ok {
+		return &ValidationError{Name: "claims_preferred_username", err: errors.New(`db: missing required field "AccessToken.claims_preferred_username"`)}
+	}
+	if _, ok := _c.mutation.ClaimsEmail(); !ok {
+		return &ValidationError{Name: "claims_email", err: errors.New(`db: missing required field "AccessToken.claims_email"`)}
+	}
+	if _, ok := _c.mutation.ClaimsEmailVerified(); !ok {
+		return &ValidationError{Name: "claims_email_verified", err: errors.New(`db: missing required field "AccessToken.claims_email_verified"`)}
+	}
+	if _, ok := _c.mutation.CertificateThumbprint(); !ok {
+		return &ValidationError{Name: "certificate_thumbprint", err: errors.New(`db: missing required field "AccessToken.certificate_thumbprint"`)}
+	}
+	if _, ok := _c.mutation.DpopKeyThumbprint(); !ok {
+		return &ValidationError{Name: "dpop_key_thumbprint", err: errors.New(`db: missing required field "AccessToken.dpop_key_thumbprint"`)}
+	}
+	if _, ok := _c.mutation.RefreshTokenID(); !ok {
+		return &ValidationError{Name: "refresh_token_id", err: errors.New(`db: missing required field "AccessToken.refresh_token_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "AccessToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "AccessToken.expiry"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accesstoken.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "AccessToken.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AccessTokenCreate) sqlSave(ctx context.Context) (*AccessToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AccessToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccessTokenCreate) createSpec() (*AccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accesstoken.Table, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(accesstoken.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Audience(); ok {
+		_spec.SetField(accesstoken.FieldAudience, field.TypeJSON, value)
+		_node.Audience = value
+	}
+	if value, ok := _c.mutation.ConnectorID(); ok {
+		_spec.SetField(accesstoken.FieldConnectorID, field.TypeString, value)
+		_node.ConnectorID = value
+	}
+	if value, ok := _c.mutation.ClaimsUserID(); ok {
+		_spec.SetField(accesstoken.FieldClaimsUserID, field.TypeString, value)
+		_node.ClaimsUserID = value
+	}
+	if value, ok := _c.mutation.ClaimsUsername(); ok {
+		_spec.SetField(accesstoken.FieldClaimsUsername, field.TypeString, value)
+		_node.ClaimsUsername = value
+	}
+	if value, ok := _c.mutation.ClaimsPreferredUsername(); ok {
+		_spec.SetField(accesstoken.FieldClaimsPreferredUsername, field.TypeString, value)
+		_node.ClaimsPreferredUsername = value
+	}
+	if value, ok := _c.mutation.ClaimsEmail(); ok {
+		_spec.SetField(accesstoken.FieldClaimsEmail, field.TypeString, value)
+		_node.ClaimsEmail = value
+	}
+	if value, ok := _c.mutation.ClaimsEmailVerified(); ok {
+		_spec.SetField(accesstoken.FieldClaimsEmailVerified, field.TypeBool, value)
+		_node.ClaimsEmailVerified = value
+	}
+	if value, ok := _c.mutation.ClaimsGroups(); ok {
+		_spec.SetField(accesstoken.FieldClaimsGroups, field.TypeJSON, value)
+		_node.ClaimsGroups = value
+	}
+	if value, ok := _c.mutation.ClaimsExtra(); ok {
+		_spec.SetField(accesstoken.FieldClaimsExtra, field.TypeJSON, value)
+		_node.ClaimsExtra = value
+	}
+	if value, ok := _c.mutation.CertificateThumbprint(); ok {
+		_spec.SetField(accesstoken.FieldCertificateThumbprint, field.TypeString, value)
+		_node.CertificateThumbprint = value
+	}
+	if value, ok := _c.mutation.DpopKeyThumbprint(); ok {
+		_spec.SetField(accesstoken.FieldDpopKeyThumbprint, field.TypeString, value)
+		_node.DpopKeyThumbprint = value
+	}
+	if value, ok := _c.mutation.RefreshTokenID(); ok {
+		_spec.SetField(accesstoken.FieldRefreshTokenID, field.TypeString, value)
+		_node.RefreshTokenID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(accesstoken.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// AccessTokenCreateBulk is the builder for creating many AccessToken entities in bulk.
+type AccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*AccessTokenCreate
+}
+
+// Save creates the AccessToken entities in the database.
+func (_c *AccessTokenCreateBulk) Save(ctx context.Context) ([]*AccessToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccessToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccessTokenCreateBulk) SaveX(ctx context.Context) []*AccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
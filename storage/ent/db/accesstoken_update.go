@@ -0,0 +1,831 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// AccessTokenUpdate is the builder for updating AccessToken entities.
+type AccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (_u *AccessTokenUpdate) Where(ps ...predicate.AccessToken) *AccessTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *AccessTokenUpdate) SetClientID(v string) *AccessTokenUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableClientID(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *AccessTokenUpdate) SetScopes(v []string) *AccessTokenUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *AccessTokenUpdate) AppendScopes(v []string) *AccessTokenUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *AccessTokenUpdate) ClearScopes() *AccessTokenUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetAudience sets the "audience" field.
+func (_u *AccessTokenUpdate) SetAudience(v []string) *AccessTokenUpdate {
+	_u.mutation.SetAudience(v)
+	return _u
+}
+
+// AppendAudience appends value to the "audience" field.
+func (_u *AccessTokenUpdate) AppendAudience(v []string) *AccessTokenUpdate {
+	_u.mutation.AppendAudience(v)
+	return _u
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (_u *AccessTokenUpdate) ClearAudience() *AccessTokenUpdate {
+	_u.mutation.ClearAudience()
+	return _u
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_u *AccessTokenUpdate) SetConnectorID(v string) *AccessTokenUpdate {
+	_u.mutation.SetConnectorID(v)
+	return _u
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableConnectorID(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetConnectorID(*v)
+	}
+	return _u
+}
+
+// SetClaimsUserID sets the "claims_user_id" field.
+func (_u *AccessTokenUpdate) SetClaimsUserID(v string) *AccessTokenUpdate {
+	_u.mutation.SetClaimsUserID(v)
+	return _u
+}
+
+// SetNillableClaimsUserID sets the "claims_user_id" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableClaimsUserID(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetClaimsUserID(*v)
+	}
+	return _u
+}
+
+// SetClaimsUsername sets the "claims_username" field.
+func (_u *AccessTokenUpdate) SetClaimsUsername(v string) *AccessTokenUpdate {
+	_u.mutation.SetClaimsUsername(v)
+	return _u
+}
+
+// SetNillableClaimsUsername sets the "claims_username" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableClaimsUsername(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetClaimsUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (_u *AccessTokenUpdate) SetClaimsPreferredUsername(v string) *AccessTokenUpdate {
+	_u.mutation.SetClaimsPreferredUsername(v)
+	return _u
+}
+
+// SetNillableClaimsPreferredUsername sets the "claims_preferred_username" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableClaimsPreferredUsername(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetClaimsPreferredUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmail sets the "claims_email" field.
+func (_u *AccessTokenUpdate) SetClaimsEmail(v string) *AccessTokenUpdate {
+	_u.mutation.SetClaimsEmail(v)
+	return _u
+}
+
+// SetNillableClaimsEmail sets the "claims_email" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableClaimsEmail(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetClaimsEmail(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (_u *AccessTokenUpdate) SetClaimsEmailVerified(v bool) *AccessTokenUpdate {
+	_u.mutation.SetClaimsEmailVerified(v)
+	return _u
+}
+
+// SetNillableClaimsEmailVerified sets the "claims_email_verified" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableClaimsEmailVerified(v *bool) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetClaimsEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetClaimsGroups sets the "claims_groups" field.
+func (_u *AccessTokenUpdate) SetClaimsGroups(v []string) *AccessTokenUpdate {
+	_u.mutation.SetClaimsGroups(v)
+	return _u
+}
+
+// AppendClaimsGroups appends value to the "claims_groups" field.
+func (_u *AccessTokenUpdate) AppendClaimsGroups(v []string) *AccessTokenUpdate {
+	_u.mutation.AppendClaimsGroups(v)
+	return _u
+}
+
+// ClearClaimsGroups clears the value of the "claims_groups" field.
+func (_u *AccessTokenUpdate) ClearClaimsGroups() *AccessTokenUpdate {
+	_u.mutation.ClearClaimsGroups()
+	return _u
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *AccessTokenUpdate) SetClaimsExtra(v map[string]interface{}) *AccessTokenUpdate {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *AccessTokenUpdate) ClearClaimsExtra() *AccessTokenUpdate {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
+// SetCertificateThumbprint sets the "certificate_thumbprint" field.
+func (_u *AccessTokenUpdate) SetCertificateThumbprint(v string) *AccessTokenUpdate {
+	_u.mutation.SetCertificateThumbprint(v)
+	return _u
+}
+
+// SetNillableCertificateThumbprint sets the "certificate_thumbprint" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableCertificateThumbprint(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetCertificateThumbprint(*v)
+	}
+	return _u
+}
+
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (_u *AccessTokenUpdate) SetDpopKeyThumbprint(v string) *AccessTokenUpdate {
+	_u.mutation.SetDpopKeyThumbprint(v)
+	return _u
+}
+
+// SetNillableDpopKeyThumbprint sets the "dpop_key_thumbprint" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableDpopKeyThumbprint(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetDpopKeyThumbprint(*v)
+	}
+	return _u
+}
+
+// SetRefreshTokenID sets the "refresh_token_id" field.
+func (_u *AccessTokenUpdate) SetRefreshTokenID(v string) *AccessTokenUpdate {
+	_u.mutation.SetRefreshTokenID(v)
+	return _u
+}
+
+// SetNillableRefreshTokenID sets the "refresh_token_id" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableRefreshTokenID(v *string) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetRefreshTokenID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AccessTokenUpdate) SetCreatedAt(v time.Time) *AccessTokenUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableCreatedAt(v *time.Time) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *AccessTokenUpdate) SetExpiry(v time.Time) *AccessTokenUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *AccessTokenUpdate) SetNillableExpiry(v *time.Time) *AccessTokenUpdate {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (_u *AccessTokenUpdate) Mutation() *AccessTokenMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccessTokenUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := accesstoken.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "AccessToken.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClaimsUserID(); ok {
+		if err := accesstoken.ClaimsUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`db: validator failed for field "AccessToken.claims_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccessTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(accesstoken.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(accesstoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Audience(); ok {
+		_spec.SetField(accesstoken.FieldAudience, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAudience(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldAudience, value)
+		})
+	}
+	if _u.mutation.AudienceCleared() {
+		_spec.ClearField(accesstoken.FieldAudience, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ConnectorID(); ok {
+		_spec.SetField(accesstoken.FieldConnectorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsUserID(); ok {
+		_spec.SetField(accesstoken.FieldClaimsUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsUsername(); ok {
+		_spec.SetField(accesstoken.FieldClaimsUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
+		_spec.SetField(accesstoken.FieldClaimsPreferredUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmail(); ok {
+		_spec.SetField(accesstoken.FieldClaimsEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmailVerified(); ok {
+		_spec.SetField(accesstoken.FieldClaimsEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClaimsGroups(); ok {
+		_spec.SetField(accesstoken.FieldClaimsGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaimsGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldClaimsGroups, value)
+		})
+	}
+	if _u.mutation.ClaimsGroupsCleared() {
+		_spec.ClearField(accesstoken.FieldClaimsGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(accesstoken.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(accesstoken.FieldClaimsExtra, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CertificateThumbprint(); ok {
+		_spec.SetField(accesstoken.FieldCertificateThumbprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DpopKeyThumbprint(); ok {
+		_spec.SetField(accesstoken.FieldDpopKeyThumbprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshTokenID(); ok {
+		_spec.SetField(accesstoken.FieldRefreshTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(accesstoken.FieldExpiry, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccessTokenUpdateOne is the builder for updating a single AccessToken entity.
+type AccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *AccessTokenUpdateOne) SetClientID(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableClientID(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *AccessTokenUpdateOne) SetScopes(v []string) *AccessTokenUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *AccessTokenUpdateOne) AppendScopes(v []string) *AccessTokenUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *AccessTokenUpdateOne) ClearScopes() *AccessTokenUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetAudience sets the "audience" field.
+func (_u *AccessTokenUpdateOne) SetAudience(v []string) *AccessTokenUpdateOne {
+	_u.mutation.SetAudience(v)
+	return _u
+}
+
+// AppendAudience appends value to the "audience" field.
+func (_u *AccessTokenUpdateOne) AppendAudience(v []string) *AccessTokenUpdateOne {
+	_u.mutation.AppendAudience(v)
+	return _u
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (_u *AccessTokenUpdateOne) ClearAudience() *AccessTokenUpdateOne {
+	_u.mutation.ClearAudience()
+	return _u
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_u *AccessTokenUpdateOne) SetConnectorID(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetConnectorID(v)
+	return _u
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableConnectorID(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetConnectorID(*v)
+	}
+	return _u
+}
+
+// SetClaimsUserID sets the "claims_user_id" field.
+func (_u *AccessTokenUpdateOne) SetClaimsUserID(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetClaimsUserID(v)
+	return _u
+}
+
+// SetNillableClaimsUserID sets the "claims_user_id" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableClaimsUserID(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetClaimsUserID(*v)
+	}
+	return _u
+}
+
+// SetClaimsUsername sets the "claims_username" field.
+func (_u *AccessTokenUpdateOne) SetClaimsUsername(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetClaimsUsername(v)
+	return _u
+}
+
+// SetNillableClaimsUsername sets the "claims_username" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableClaimsUsername(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetClaimsUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (_u *AccessTokenUpdateOne) SetClaimsPreferredUsername(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetClaimsPreferredUsername(v)
+	return _u
+}
+
+// SetNillableClaimsPreferredUsername sets the "claims_preferred_username" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableClaimsPreferredUsername(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetClaimsPreferredUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmail sets the "claims_email" field.
+func (_u *AccessTokenUpdateOne) SetClaimsEmail(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetClaimsEmail(v)
+	return _u
+}
+
+// SetNillableClaimsEmail sets the "claims_email" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableClaimsEmail(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetClaimsEmail(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (_u *AccessTokenUpdateOne) SetClaimsEmailVerified(v bool) *AccessTokenUpdateOne {
+	_u.mutation.SetClaimsEmailVerified(v)
+	return _u
+}
+
+// SetNillableClaimsEmailVerified sets the "claims_email_verified" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableClaimsEmailVerified(v *bool) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetClaimsEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetClaimsGroups sets the "claims_groups" field.
+func (_u *AccessTokenUpdateOne) SetClaimsGroups(v []string) *AccessTokenUpdateOne {
+	_u.mutation.SetClaimsGroups(v)
+	return _u
+}
+
+// AppendClaimsGroups appends value to the "claims_groups" field.
+func (_u *AccessTokenUpdateOne) AppendClaimsGroups(v []string) *AccessTokenUpdateOne {
+	_u.mutation.AppendClaimsGroups(v)
+	return _u
+}
+
+// ClearClaimsGroups clears the value of the "claims_groups" field.
+func (_u *AccessTokenUpdateOne) ClearClaimsGroups() *AccessTokenUpdateOne {
+	_u.mutation.ClearClaimsGroups()
+	return _u
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *AccessTokenUpdateOne) SetClaimsExtra(v map[string]interface{}) *AccessTokenUpdateOne {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *AccessTokenUpdateOne) ClearClaimsExtra() *AccessTokenUpdateOne {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
+// SetCertificateThumbprint sets the "certificate_thumbprint" field.
+func (_u *AccessTokenUpdateOne) SetCertificateThumbprint(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetCertificateThumbprint(v)
+	return _u
+}
+
+// SetNillableCertificateThumbprint sets the "certificate_thumbprint" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableCertificateThumbprint(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetCertificateThumbprint(*v)
+	}
+	return _u
+}
+
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (_u *AccessTokenUpdateOne) SetDpopKeyThumbprint(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetDpopKeyThumbprint(v)
+	return _u
+}
+
+// SetNillableDpopKeyThumbprint sets the "dpop_key_thumbprint" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableDpopKeyThumbprint(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetDpopKeyThumbprint(*v)
+	}
+	return _u
+}
+
+// SetRefreshTokenID sets the "refresh_token_id" field.
+func (_u *AccessTokenUpdateOne) SetRefreshTokenID(v string) *AccessTokenUpdateOne {
+	_u.mutation.SetRefreshTokenID(v)
+	return _u
+}
+
+// SetNillableRefreshTokenID sets the "refresh_token_id" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableRefreshTokenID(v *string) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetRefreshTokenID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AccessTokenUpdateOne) SetCreatedAt(v time.Time) *AccessTokenUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableCreatedAt(v *time.Time) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *AccessTokenUpdateOne) SetExpiry(v time.Time) *AccessTokenUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *AccessTokenUpdateOne) SetNillableExpiry(v *time.Time) *AccessTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (_u *AccessTokenUpdateOne) Mutation() *AccessTokenMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (_u *AccessTokenUpdateOne) Where(ps ...predicate.AccessToken) *AccessTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccessTokenUpdateOne) Select(field string, fields ...string) *AccessTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccessToken entity.
+func (_u *AccessTokenUpdateOne) Save(ctx context.Context) (*AccessToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessTokenUpdateOne) SaveX(ctx context.Context) *AccessToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccessTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := accesstoken.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "AccessToken.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClaimsUserID(); ok {
+		if err := accesstoken.ClaimsUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`db: validator failed for field "AccessToken.claims_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *AccessToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "AccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesstoken.FieldID)
+		for _, f := range fields {
+			if !accesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != accesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(accesstoken.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(accesstoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Audience(); ok {
+		_spec.SetField(accesstoken.FieldAudience, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAudience(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldAudience, value)
+		})
+	}
+	if _u.mutation.AudienceCleared() {
+		_spec.ClearField(accesstoken.FieldAudience, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ConnectorID(); ok {
+		_spec.SetField(accesstoken.FieldConnectorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsUserID(); ok {
+		_spec.SetField(accesstoken.FieldClaimsUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsUsername(); ok {
+		_spec.SetField(accesstoken.FieldClaimsUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
+		_spec.SetField(accesstoken.FieldClaimsPreferredUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmail(); ok {
+		_spec.SetField(accesstoken.FieldClaimsEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmailVerified(); ok {
+		_spec.SetField(accesstoken.FieldClaimsEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClaimsGroups(); ok {
+		_spec.SetField(accesstoken.FieldClaimsGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaimsGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldClaimsGroups, value)
+		})
+	}
+	if _u.mutation.ClaimsGroupsCleared() {
+		_spec.ClearField(accesstoken.FieldClaimsGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(accesstoken.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(accesstoken.FieldClaimsExtra, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CertificateThumbprint(); ok {
+		_spec.SetField(accesstoken.FieldCertificateThumbprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DpopKeyThumbprint(); ok {
+		_spec.SetField(accesstoken.FieldDpopKeyThumbprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshTokenID(); ok {
+		_spec.SetField(accesstoken.FieldRefreshTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(accesstoken.FieldExpiry, field.TypeTime, value)
+	}
+	_node = &AccessToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -36,6 +36,8 @@ type AuthCode struct {
 	ClaimsEmailVerified bool `json:"claims_email_verified,omitempty"`
 	// ClaimsGroups holds the value of the "claims_groups" field.
 	ClaimsGroups []string `json:"claims_groups,omitempty"`
+	// ClaimsExtra holds the value of the "claims_extra" field.
+	ClaimsExtra map[string]interface{} `json:"claims_extra,omitempty"`
 	// ClaimsPreferredUsername holds the value of the "claims_preferred_username" field.
 	ClaimsPreferredUsername string `json:"claims_preferred_username,omitempty"`
 	// ConnectorID holds the value of the "connector_id" field.
@@ -48,7 +50,15 @@ type AuthCode struct {
 	CodeChallenge string `json:"code_challenge,omitempty"`
 	// CodeChallengeMethod holds the value of the "code_challenge_method" field.
 	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
-	selectValues        sql.SelectValues
+	// Acr holds the value of the "acr" field.
+	Acr string `json:"acr,omitempty"`
+	// Amr holds the value of the "amr" field.
+	Amr []string `json:"amr,omitempty"`
+	// AuthTime holds the value of the "auth_time" field.
+	AuthTime time.Time `json:"auth_time,omitempty"`
+	// Sid holds the value of the "sid" field.
+	Sid          string `json:"sid,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -56,13 +66,13 @@ func (*AuthCode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case authcode.FieldScopes, authcode.FieldClaimsGroups, authcode.FieldConnectorData:
+		case authcode.FieldScopes, authcode.FieldClaimsGroups, authcode.FieldClaimsExtra, authcode.FieldConnectorData, authcode.FieldAmr:
 			values[i] = new([]byte)
 		case authcode.FieldClaimsEmailVerified:
 			values[i] = new(sql.NullBool)
-		case authcode.FieldID, authcode.FieldClientID, authcode.FieldNonce, authcode.FieldRedirectURI, authcode.FieldClaimsUserID, authcode.FieldClaimsUsername, authcode.FieldClaimsEmail, authcode.FieldClaimsPreferredUsername, authcode.FieldConnectorID, authcode.FieldCodeChallenge, authcode.FieldCodeChallengeMethod:
+		case authcode.FieldID, authcode.FieldClientID, authcode.FieldNonce, authcode.FieldRedirectURI, authcode.FieldClaimsUserID, authcode.FieldClaimsUsername, authcode.FieldClaimsEmail, authcode.FieldClaimsPreferredUsername, authcode.FieldConnectorID, authcode.FieldCodeChallenge, authcode.FieldCodeChallengeMethod, authcode.FieldAcr, authcode.FieldSid:
 			values[i] = new(sql.NullString)
-		case authcode.FieldExpiry:
+		case authcode.FieldExpiry, authcode.FieldAuthTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -143,6 +153,14 @@ func (_m *AuthCode) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field claims_groups: %w", err)
 				}
 			}
+		case authcode.FieldClaimsExtra:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claims_extra", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClaimsExtra); err != nil {
+					return fmt.Errorf("unmarshal field claims_extra: %w", err)
+				}
+			}
 		case authcode.FieldClaimsPreferredUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field claims_preferred_username", values[i])
@@ -179,6 +197,32 @@ func (_m *AuthCode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CodeChallengeMethod = value.String
 			}
+		case authcode.FieldAcr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acr", values[i])
+			} else if value.Valid {
+				_m.Acr = value.String
+			}
+		case authcode.FieldAmr:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field amr", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Amr); err != nil {
+					return fmt.Errorf("unmarshal field amr: %w", err)
+				}
+			}
+		case authcode.FieldAuthTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_time", values[i])
+			} else if value.Valid {
+				_m.AuthTime = value.Time
+			}
+		case authcode.FieldSid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sid", values[i])
+			} else if value.Valid {
+				_m.Sid = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -242,6 +286,9 @@ func (_m *AuthCode) String() string {
 	builder.WriteString("claims_groups=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ClaimsGroups))
 	builder.WriteString(", ")
+	builder.WriteString("claims_extra=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClaimsExtra))
+	builder.WriteString(", ")
 	builder.WriteString("claims_preferred_username=")
 	builder.WriteString(_m.ClaimsPreferredUsername)
 	builder.WriteString(", ")
@@ -261,6 +308,18 @@ func (_m *AuthCode) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("code_challenge_method=")
 	builder.WriteString(_m.CodeChallengeMethod)
+	builder.WriteString(", ")
+	builder.WriteString("acr=")
+	builder.WriteString(_m.Acr)
+	builder.WriteString(", ")
+	builder.WriteString("amr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amr))
+	builder.WriteString(", ")
+	builder.WriteString("auth_time=")
+	builder.WriteString(_m.AuthTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sid=")
+	builder.WriteString(_m.Sid)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -29,6 +29,8 @@ const (
 	FieldClaimsEmailVerified = "claims_email_verified"
 	// FieldClaimsGroups holds the string denoting the claims_groups field in the database.
 	FieldClaimsGroups = "claims_groups"
+	// FieldClaimsExtra holds the string denoting the claims_extra field in the database.
+	FieldClaimsExtra = "claims_extra"
 	// FieldClaimsPreferredUsername holds the string denoting the claims_preferred_username field in the database.
 	FieldClaimsPreferredUsername = "claims_preferred_username"
 	// FieldConnectorID holds the string denoting the connector_id field in the database.
@@ -41,6 +43,14 @@ const (
 	FieldCodeChallenge = "code_challenge"
 	// FieldCodeChallengeMethod holds the string denoting the code_challenge_method field in the database.
 	FieldCodeChallengeMethod = "code_challenge_method"
+	// FieldAcr holds the string denoting the acr field in the database.
+	FieldAcr = "acr"
+	// FieldAmr holds the string denoting the amr field in the database.
+	FieldAmr = "amr"
+	// FieldAuthTime holds the string denoting the auth_time field in the database.
+	FieldAuthTime = "auth_time"
+	// FieldSid holds the string denoting the sid field in the database.
+	FieldSid = "sid"
 	// Table holds the table name of the authcode in the database.
 	Table = "auth_codes"
 )
@@ -57,12 +67,17 @@ var Columns = []string{
 	FieldClaimsEmail,
 	FieldClaimsEmailVerified,
 	FieldClaimsGroups,
+	FieldClaimsExtra,
 	FieldClaimsPreferredUsername,
 	FieldConnectorID,
 	FieldConnectorData,
 	FieldExpiry,
 	FieldCodeChallenge,
 	FieldCodeChallengeMethod,
+	FieldAcr,
+	FieldAmr,
+	FieldAuthTime,
+	FieldSid,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -96,6 +111,10 @@ var (
 	DefaultCodeChallenge string
 	// DefaultCodeChallengeMethod holds the default value on creation for the "code_challenge_method" field.
 	DefaultCodeChallengeMethod string
+	// DefaultAcr holds the default value on creation for the "acr" field.
+	DefaultAcr string
+	// DefaultSid holds the default value on creation for the "sid" field.
+	DefaultSid string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -167,3 +186,18 @@ func ByCodeChallenge(opts ...sql.OrderTermOption) OrderOption {
 func ByCodeChallengeMethod(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCodeChallengeMethod, opts...).ToFunc()
 }
+
+// ByAcr orders the results by the acr field.
+func ByAcr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcr, opts...).ToFunc()
+}
+
+// ByAuthTime orders the results by the auth_time field.
+func ByAuthTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthTime, opts...).ToFunc()
+}
+
+// BySid orders the results by the sid field.
+func BySid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSid, opts...).ToFunc()
+}
@@ -129,6 +129,21 @@ func CodeChallengeMethod(v string) predicate.AuthCode {
 	return predicate.AuthCode(sql.FieldEQ(FieldCodeChallengeMethod, v))
 }
 
+// Acr applies equality check predicate on the "acr" field. It's identical to AcrEQ.
+func Acr(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEQ(FieldAcr, v))
+}
+
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// Sid applies equality check predicate on the "sid" field. It's identical to SidEQ.
+func Sid(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEQ(FieldSid, v))
+}
+
 // ClientIDEQ applies the EQ predicate on the "client_id" field.
 func ClientIDEQ(v string) predicate.AuthCode {
 	return predicate.AuthCode(sql.FieldEQ(FieldClientID, v))
@@ -549,6 +564,16 @@ func ClaimsGroupsNotNil() predicate.AuthCode {
 	return predicate.AuthCode(sql.FieldNotNull(FieldClaimsGroups))
 }
 
+// ClaimsExtraIsNil applies the IsNil predicate on the "claims_extra" field.
+func ClaimsExtraIsNil() predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldIsNull(FieldClaimsExtra))
+}
+
+// ClaimsExtraNotNil applies the NotNil predicate on the "claims_extra" field.
+func ClaimsExtraNotNil() predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNotNull(FieldClaimsExtra))
+}
+
 // ClaimsPreferredUsernameEQ applies the EQ predicate on the "claims_preferred_username" field.
 func ClaimsPreferredUsernameEQ(v string) predicate.AuthCode {
 	return predicate.AuthCode(sql.FieldEQ(FieldClaimsPreferredUsername, v))
@@ -899,6 +924,196 @@ func CodeChallengeMethodContainsFold(v string) predicate.AuthCode {
 	return predicate.AuthCode(sql.FieldContainsFold(FieldCodeChallengeMethod, v))
 }
 
+// AcrEQ applies the EQ predicate on the "acr" field.
+func AcrEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEQ(FieldAcr, v))
+}
+
+// AcrNEQ applies the NEQ predicate on the "acr" field.
+func AcrNEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNEQ(FieldAcr, v))
+}
+
+// AcrIn applies the In predicate on the "acr" field.
+func AcrIn(vs ...string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldIn(FieldAcr, vs...))
+}
+
+// AcrNotIn applies the NotIn predicate on the "acr" field.
+func AcrNotIn(vs ...string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNotIn(FieldAcr, vs...))
+}
+
+// AcrGT applies the GT predicate on the "acr" field.
+func AcrGT(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldGT(FieldAcr, v))
+}
+
+// AcrGTE applies the GTE predicate on the "acr" field.
+func AcrGTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldGTE(FieldAcr, v))
+}
+
+// AcrLT applies the LT predicate on the "acr" field.
+func AcrLT(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldLT(FieldAcr, v))
+}
+
+// AcrLTE applies the LTE predicate on the "acr" field.
+func AcrLTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldLTE(FieldAcr, v))
+}
+
+// AcrContains applies the Contains predicate on the "acr" field.
+func AcrContains(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldContains(FieldAcr, v))
+}
+
+// AcrHasPrefix applies the HasPrefix predicate on the "acr" field.
+func AcrHasPrefix(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldHasPrefix(FieldAcr, v))
+}
+
+// AcrHasSuffix applies the HasSuffix predicate on the "acr" field.
+func AcrHasSuffix(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldHasSuffix(FieldAcr, v))
+}
+
+// AcrEqualFold applies the EqualFold predicate on the "acr" field.
+func AcrEqualFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEqualFold(FieldAcr, v))
+}
+
+// AcrContainsFold applies the ContainsFold predicate on the "acr" field.
+func AcrContainsFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldContainsFold(FieldAcr, v))
+}
+
+// AmrIsNil applies the IsNil predicate on the "amr" field.
+func AmrIsNil() predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldIsNull(FieldAmr))
+}
+
+// AmrNotNil applies the NotNil predicate on the "amr" field.
+func AmrNotNil() predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNotNull(FieldAmr))
+}
+
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// AuthTimeIsNil applies the IsNil predicate on the "auth_time" field.
+func AuthTimeIsNil() predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldIsNull(FieldAuthTime))
+}
+
+// AuthTimeNotNil applies the NotNil predicate on the "auth_time" field.
+func AuthTimeNotNil() predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNotNull(FieldAuthTime))
+}
+
+// SidEQ applies the EQ predicate on the "sid" field.
+func SidEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEQ(FieldSid, v))
+}
+
+// SidNEQ applies the NEQ predicate on the "sid" field.
+func SidNEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNEQ(FieldSid, v))
+}
+
+// SidIn applies the In predicate on the "sid" field.
+func SidIn(vs ...string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldIn(FieldSid, vs...))
+}
+
+// SidNotIn applies the NotIn predicate on the "sid" field.
+func SidNotIn(vs ...string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldNotIn(FieldSid, vs...))
+}
+
+// SidGT applies the GT predicate on the "sid" field.
+func SidGT(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldGT(FieldSid, v))
+}
+
+// SidGTE applies the GTE predicate on the "sid" field.
+func SidGTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldGTE(FieldSid, v))
+}
+
+// SidLT applies the LT predicate on the "sid" field.
+func SidLT(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldLT(FieldSid, v))
+}
+
+// SidLTE applies the LTE predicate on the "sid" field.
+func SidLTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldLTE(FieldSid, v))
+}
+
+// SidContains applies the Contains predicate on the "sid" field.
+func SidContains(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldContains(FieldSid, v))
+}
+
+// SidHasPrefix applies the HasPrefix predicate on the "sid" field.
+func SidHasPrefix(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldHasPrefix(FieldSid, v))
+}
+
+// SidHasSuffix applies the HasSuffix predicate on the "sid" field.
+func SidHasSuffix(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldHasSuffix(FieldSid, v))
+}
+
+// SidEqualFold applies the EqualFold predicate on the "sid" field.
+func SidEqualFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldEqualFold(FieldSid, v))
+}
+
+// SidContainsFold applies the ContainsFold predicate on the "sid" field.
+func SidContainsFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(sql.FieldContainsFold(FieldSid, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AuthCode) predicate.AuthCode {
 	return predicate.AuthCode(sql.AndPredicates(predicates...))
@@ -74,6 +74,12 @@ func (_c *AuthCodeCreate) SetClaimsGroups(v []string) *AuthCodeCreate {
 	return _c
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_c *AuthCodeCreate) SetClaimsExtra(v map[string]interface{}) *AuthCodeCreate {
+	_c.mutation.SetClaimsExtra(v)
+	return _c
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_c *AuthCodeCreate) SetClaimsPreferredUsername(v string) *AuthCodeCreate {
 	_c.mutation.SetClaimsPreferredUsername(v)
@@ -134,6 +140,54 @@ func (_c *AuthCodeCreate) SetNillableCodeChallengeMethod(v *string) *AuthCodeCre
 	return _c
 }
 
+// SetAcr sets the "acr" field.
+func (_c *AuthCodeCreate) SetAcr(v string) *AuthCodeCreate {
+	_c.mutation.SetAcr(v)
+	return _c
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_c *AuthCodeCreate) SetNillableAcr(v *string) *AuthCodeCreate {
+	if v != nil {
+		_c.SetAcr(*v)
+	}
+	return _c
+}
+
+// SetAmr sets the "amr" field.
+func (_c *AuthCodeCreate) SetAmr(v []string) *AuthCodeCreate {
+	_c.mutation.SetAmr(v)
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *AuthCodeCreate) SetAuthTime(v time.Time) *AuthCodeCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *AuthCodeCreate) SetNillableAuthTime(v *time.Time) *AuthCodeCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
+// SetSid sets the "sid" field.
+func (_c *AuthCodeCreate) SetSid(v string) *AuthCodeCreate {
+	_c.mutation.SetSid(v)
+	return _c
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_c *AuthCodeCreate) SetNillableSid(v *string) *AuthCodeCreate {
+	if v != nil {
+		_c.SetSid(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuthCodeCreate) SetID(v string) *AuthCodeCreate {
 	_c.mutation.SetID(v)
@@ -187,6 +241,14 @@ func (_c *AuthCodeCreate) defaults() {
 		v := authcode.DefaultCodeChallengeMethod
 		_c.mutation.SetCodeChallengeMethod(v)
 	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		v := authcode.DefaultAcr
+		_c.mutation.SetAcr(v)
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		v := authcode.DefaultSid
+		_c.mutation.SetSid(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -262,6 +324,12 @@ func (_c *AuthCodeCreate) check() error {
 	if _, ok := _c.mutation.CodeChallengeMethod(); !ok {
 		return &ValidationError{Name: "code_challenge_method", err: errors.New(`db: missing required field "AuthCode.code_challenge_method"`)}
 	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		return &ValidationError{Name: "acr", err: errors.New(`db: missing required field "AuthCode.acr"`)}
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		return &ValidationError{Name: "sid", err: errors.New(`db: missing required field "AuthCode.sid"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := authcode.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "AuthCode.id": %w`, err)}
@@ -338,6 +406,10 @@ func (_c *AuthCodeCreate) createSpec() (*AuthCode, *sqlgraph.CreateSpec) {
 		_spec.SetField(authcode.FieldClaimsGroups, field.TypeJSON, value)
 		_node.ClaimsGroups = value
 	}
+	if value, ok := _c.mutation.ClaimsExtra(); ok {
+		_spec.SetField(authcode.FieldClaimsExtra, field.TypeJSON, value)
+		_node.ClaimsExtra = value
+	}
 	if value, ok := _c.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(authcode.FieldClaimsPreferredUsername, field.TypeString, value)
 		_node.ClaimsPreferredUsername = value
@@ -362,6 +434,22 @@ func (_c *AuthCodeCreate) createSpec() (*AuthCode, *sqlgraph.CreateSpec) {
 		_spec.SetField(authcode.FieldCodeChallengeMethod, field.TypeString, value)
 		_node.CodeChallengeMethod = value
 	}
+	if value, ok := _c.mutation.Acr(); ok {
+		_spec.SetField(authcode.FieldAcr, field.TypeString, value)
+		_node.Acr = value
+	}
+	if value, ok := _c.mutation.Amr(); ok {
+		_spec.SetField(authcode.FieldAmr, field.TypeJSON, value)
+		_node.Amr = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(authcode.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
+	if value, ok := _c.mutation.Sid(); ok {
+		_spec.SetField(authcode.FieldSid, field.TypeString, value)
+		_node.Sid = value
+	}
 	return _node, _spec
 }
 
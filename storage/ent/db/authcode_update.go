@@ -163,6 +163,18 @@ func (_u *AuthCodeUpdate) ClearClaimsGroups() *AuthCodeUpdate {
 	return _u
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *AuthCodeUpdate) SetClaimsExtra(v map[string]interface{}) *AuthCodeUpdate {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *AuthCodeUpdate) ClearClaimsExtra() *AuthCodeUpdate {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_u *AuthCodeUpdate) SetClaimsPreferredUsername(v string) *AuthCodeUpdate {
 	_u.mutation.SetClaimsPreferredUsername(v)
@@ -245,6 +257,72 @@ func (_u *AuthCodeUpdate) SetNillableCodeChallengeMethod(v *string) *AuthCodeUpd
 	return _u
 }
 
+// SetAcr sets the "acr" field.
+func (_u *AuthCodeUpdate) SetAcr(v string) *AuthCodeUpdate {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *AuthCodeUpdate) SetNillableAcr(v *string) *AuthCodeUpdate {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *AuthCodeUpdate) SetAmr(v []string) *AuthCodeUpdate {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *AuthCodeUpdate) AppendAmr(v []string) *AuthCodeUpdate {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *AuthCodeUpdate) ClearAmr() *AuthCodeUpdate {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthCodeUpdate) SetAuthTime(v time.Time) *AuthCodeUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthCodeUpdate) SetNillableAuthTime(v *time.Time) *AuthCodeUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *AuthCodeUpdate) ClearAuthTime() *AuthCodeUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *AuthCodeUpdate) SetSid(v string) *AuthCodeUpdate {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *AuthCodeUpdate) SetNillableSid(v *string) *AuthCodeUpdate {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AuthCodeMutation object of the builder.
 func (_u *AuthCodeUpdate) Mutation() *AuthCodeMutation {
 	return _u.mutation
@@ -372,6 +450,12 @@ func (_u *AuthCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ClaimsGroupsCleared() {
 		_spec.ClearField(authcode.FieldClaimsGroups, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(authcode.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(authcode.FieldClaimsExtra, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(authcode.FieldClaimsPreferredUsername, field.TypeString, value)
 	}
@@ -393,6 +477,29 @@ func (_u *AuthCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
 		_spec.SetField(authcode.FieldCodeChallengeMethod, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(authcode.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(authcode.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authcode.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(authcode.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authcode.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(authcode.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(authcode.FieldSid, field.TypeString, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authcode.Label}
@@ -547,6 +654,18 @@ func (_u *AuthCodeUpdateOne) ClearClaimsGroups() *AuthCodeUpdateOne {
 	return _u
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *AuthCodeUpdateOne) SetClaimsExtra(v map[string]interface{}) *AuthCodeUpdateOne {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *AuthCodeUpdateOne) ClearClaimsExtra() *AuthCodeUpdateOne {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_u *AuthCodeUpdateOne) SetClaimsPreferredUsername(v string) *AuthCodeUpdateOne {
 	_u.mutation.SetClaimsPreferredUsername(v)
@@ -629,6 +748,72 @@ func (_u *AuthCodeUpdateOne) SetNillableCodeChallengeMethod(v *string) *AuthCode
 	return _u
 }
 
+// SetAcr sets the "acr" field.
+func (_u *AuthCodeUpdateOne) SetAcr(v string) *AuthCodeUpdateOne {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *AuthCodeUpdateOne) SetNillableAcr(v *string) *AuthCodeUpdateOne {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *AuthCodeUpdateOne) SetAmr(v []string) *AuthCodeUpdateOne {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *AuthCodeUpdateOne) AppendAmr(v []string) *AuthCodeUpdateOne {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *AuthCodeUpdateOne) ClearAmr() *AuthCodeUpdateOne {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthCodeUpdateOne) SetAuthTime(v time.Time) *AuthCodeUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthCodeUpdateOne) SetNillableAuthTime(v *time.Time) *AuthCodeUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *AuthCodeUpdateOne) ClearAuthTime() *AuthCodeUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *AuthCodeUpdateOne) SetSid(v string) *AuthCodeUpdateOne {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *AuthCodeUpdateOne) SetNillableSid(v *string) *AuthCodeUpdateOne {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AuthCodeMutation object of the builder.
 func (_u *AuthCodeUpdateOne) Mutation() *AuthCodeMutation {
 	return _u.mutation
@@ -786,6 +971,12 @@ func (_u *AuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *AuthCode, err
 	if _u.mutation.ClaimsGroupsCleared() {
 		_spec.ClearField(authcode.FieldClaimsGroups, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(authcode.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(authcode.FieldClaimsExtra, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(authcode.FieldClaimsPreferredUsername, field.TypeString, value)
 	}
@@ -807,6 +998,29 @@ func (_u *AuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *AuthCode, err
 	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
 		_spec.SetField(authcode.FieldCodeChallengeMethod, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(authcode.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(authcode.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authcode.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(authcode.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authcode.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(authcode.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(authcode.FieldSid, field.TypeString, value)
+	}
 	_node = &AuthCode{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
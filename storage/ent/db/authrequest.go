@@ -44,6 +44,8 @@ type AuthRequest struct {
 	ClaimsEmailVerified bool `json:"claims_email_verified,omitempty"`
 	// ClaimsGroups holds the value of the "claims_groups" field.
 	ClaimsGroups []string `json:"claims_groups,omitempty"`
+	// ClaimsExtra holds the value of the "claims_extra" field.
+	ClaimsExtra map[string]interface{} `json:"claims_extra,omitempty"`
 	// ClaimsPreferredUsername holds the value of the "claims_preferred_username" field.
 	ClaimsPreferredUsername string `json:"claims_preferred_username,omitempty"`
 	// ConnectorID holds the value of the "connector_id" field.
@@ -57,7 +59,25 @@ type AuthRequest struct {
 	// CodeChallengeMethod holds the value of the "code_challenge_method" field.
 	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
 	// HmacKey holds the value of the "hmac_key" field.
-	HmacKey      []byte `json:"hmac_key,omitempty"`
+	HmacKey []byte `json:"hmac_key,omitempty"`
+	// Prompt holds the value of the "prompt" field.
+	Prompt string `json:"prompt,omitempty"`
+	// MaxAge holds the value of the "max_age" field.
+	MaxAge int `json:"max_age,omitempty"`
+	// AcrValues holds the value of the "acr_values" field.
+	AcrValues []string `json:"acr_values,omitempty"`
+	// Acr holds the value of the "acr" field.
+	Acr string `json:"acr,omitempty"`
+	// Amr holds the value of the "amr" field.
+	Amr []string `json:"amr,omitempty"`
+	// AuthTime holds the value of the "auth_time" field.
+	AuthTime time.Time `json:"auth_time,omitempty"`
+	// Sid holds the value of the "sid" field.
+	Sid string `json:"sid,omitempty"`
+	// LoginHint holds the value of the "login_hint" field.
+	LoginHint string `json:"login_hint,omitempty"`
+	// UILocales holds the value of the "ui_locales" field.
+	UILocales    []string `json:"ui_locales,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -66,13 +86,15 @@ func (*AuthRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case authrequest.FieldScopes, authrequest.FieldResponseTypes, authrequest.FieldClaimsGroups, authrequest.FieldConnectorData, authrequest.FieldHmacKey:
+		case authrequest.FieldScopes, authrequest.FieldResponseTypes, authrequest.FieldClaimsGroups, authrequest.FieldClaimsExtra, authrequest.FieldConnectorData, authrequest.FieldHmacKey, authrequest.FieldAcrValues, authrequest.FieldAmr, authrequest.FieldUILocales:
 			values[i] = new([]byte)
 		case authrequest.FieldForceApprovalPrompt, authrequest.FieldLoggedIn, authrequest.FieldClaimsEmailVerified:
 			values[i] = new(sql.NullBool)
-		case authrequest.FieldID, authrequest.FieldClientID, authrequest.FieldRedirectURI, authrequest.FieldNonce, authrequest.FieldState, authrequest.FieldClaimsUserID, authrequest.FieldClaimsUsername, authrequest.FieldClaimsEmail, authrequest.FieldClaimsPreferredUsername, authrequest.FieldConnectorID, authrequest.FieldCodeChallenge, authrequest.FieldCodeChallengeMethod:
+		case authrequest.FieldMaxAge:
+			values[i] = new(sql.NullInt64)
+		case authrequest.FieldID, authrequest.FieldClientID, authrequest.FieldRedirectURI, authrequest.FieldNonce, authrequest.FieldState, authrequest.FieldClaimsUserID, authrequest.FieldClaimsUsername, authrequest.FieldClaimsEmail, authrequest.FieldClaimsPreferredUsername, authrequest.FieldConnectorID, authrequest.FieldCodeChallenge, authrequest.FieldCodeChallengeMethod, authrequest.FieldPrompt, authrequest.FieldAcr, authrequest.FieldSid, authrequest.FieldLoginHint:
 			values[i] = new(sql.NullString)
-		case authrequest.FieldExpiry:
+		case authrequest.FieldExpiry, authrequest.FieldAuthTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -179,6 +201,14 @@ func (_m *AuthRequest) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field claims_groups: %w", err)
 				}
 			}
+		case authrequest.FieldClaimsExtra:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claims_extra", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClaimsExtra); err != nil {
+					return fmt.Errorf("unmarshal field claims_extra: %w", err)
+				}
+			}
 		case authrequest.FieldClaimsPreferredUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field claims_preferred_username", values[i])
@@ -221,6 +251,66 @@ func (_m *AuthRequest) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.HmacKey = *value
 			}
+		case authrequest.FieldPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt", values[i])
+			} else if value.Valid {
+				_m.Prompt = value.String
+			}
+		case authrequest.FieldMaxAge:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_age", values[i])
+			} else if value.Valid {
+				_m.MaxAge = int(value.Int64)
+			}
+		case authrequest.FieldAcrValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field acr_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AcrValues); err != nil {
+					return fmt.Errorf("unmarshal field acr_values: %w", err)
+				}
+			}
+		case authrequest.FieldAcr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acr", values[i])
+			} else if value.Valid {
+				_m.Acr = value.String
+			}
+		case authrequest.FieldAmr:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field amr", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Amr); err != nil {
+					return fmt.Errorf("unmarshal field amr: %w", err)
+				}
+			}
+		case authrequest.FieldAuthTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_time", values[i])
+			} else if value.Valid {
+				_m.AuthTime = value.Time
+			}
+		case authrequest.FieldSid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sid", values[i])
+			} else if value.Valid {
+				_m.Sid = value.String
+			}
+		case authrequest.FieldLoginHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field login_hint", values[i])
+			} else if value.Valid {
+				_m.LoginHint = value.String
+			}
+		case authrequest.FieldUILocales:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ui_locales", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UILocales); err != nil {
+					return fmt.Errorf("unmarshal field ui_locales: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -296,6 +386,9 @@ func (_m *AuthRequest) String() string {
 	builder.WriteString("claims_groups=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ClaimsGroups))
 	builder.WriteString(", ")
+	builder.WriteString("claims_extra=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClaimsExtra))
+	builder.WriteString(", ")
 	builder.WriteString("claims_preferred_username=")
 	builder.WriteString(_m.ClaimsPreferredUsername)
 	builder.WriteString(", ")
@@ -318,6 +411,33 @@ func (_m *AuthRequest) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("hmac_key=")
 	builder.WriteString(fmt.Sprintf("%v", _m.HmacKey))
+	builder.WriteString(", ")
+	builder.WriteString("prompt=")
+	builder.WriteString(_m.Prompt)
+	builder.WriteString(", ")
+	builder.WriteString("max_age=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxAge))
+	builder.WriteString(", ")
+	builder.WriteString("acr_values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AcrValues))
+	builder.WriteString(", ")
+	builder.WriteString("acr=")
+	builder.WriteString(_m.Acr)
+	builder.WriteString(", ")
+	builder.WriteString("amr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amr))
+	builder.WriteString(", ")
+	builder.WriteString("auth_time=")
+	builder.WriteString(_m.AuthTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sid=")
+	builder.WriteString(_m.Sid)
+	builder.WriteString(", ")
+	builder.WriteString("login_hint=")
+	builder.WriteString(_m.LoginHint)
+	builder.WriteString(", ")
+	builder.WriteString("ui_locales=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UILocales))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -37,6 +37,8 @@ const (
 	FieldClaimsEmailVerified = "claims_email_verified"
 	// FieldClaimsGroups holds the string denoting the claims_groups field in the database.
 	FieldClaimsGroups = "claims_groups"
+	// FieldClaimsExtra holds the string denoting the claims_extra field in the database.
+	FieldClaimsExtra = "claims_extra"
 	// FieldClaimsPreferredUsername holds the string denoting the claims_preferred_username field in the database.
 	FieldClaimsPreferredUsername = "claims_preferred_username"
 	// FieldConnectorID holds the string denoting the connector_id field in the database.
@@ -51,6 +53,24 @@ const (
 	FieldCodeChallengeMethod = "code_challenge_method"
 	// FieldHmacKey holds the string denoting the hmac_key field in the database.
 	FieldHmacKey = "hmac_key"
+	// FieldPrompt holds the string denoting the prompt field in the database.
+	FieldPrompt = "prompt"
+	// FieldMaxAge holds the string denoting the max_age field in the database.
+	FieldMaxAge = "max_age"
+	// FieldAcrValues holds the string denoting the acr_values field in the database.
+	FieldAcrValues = "acr_values"
+	// FieldAcr holds the string denoting the acr field in the database.
+	FieldAcr = "acr"
+	// FieldAmr holds the string denoting the amr field in the database.
+	FieldAmr = "amr"
+	// FieldAuthTime holds the string denoting the auth_time field in the database.
+	FieldAuthTime = "auth_time"
+	// FieldSid holds the string denoting the sid field in the database.
+	FieldSid = "sid"
+	// FieldLoginHint holds the string denoting the login_hint field in the database.
+	FieldLoginHint = "login_hint"
+	// FieldUILocales holds the string denoting the ui_locales field in the database.
+	FieldUILocales = "ui_locales"
 	// Table holds the table name of the authrequest in the database.
 	Table = "auth_requests"
 )
@@ -71,6 +91,7 @@ var Columns = []string{
 	FieldClaimsEmail,
 	FieldClaimsEmailVerified,
 	FieldClaimsGroups,
+	FieldClaimsExtra,
 	FieldClaimsPreferredUsername,
 	FieldConnectorID,
 	FieldConnectorData,
@@ -78,6 +99,15 @@ var Columns = []string{
 	FieldCodeChallenge,
 	FieldCodeChallengeMethod,
 	FieldHmacKey,
+	FieldPrompt,
+	FieldMaxAge,
+	FieldAcrValues,
+	FieldAcr,
+	FieldAmr,
+	FieldAuthTime,
+	FieldSid,
+	FieldLoginHint,
+	FieldUILocales,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -97,6 +127,16 @@ var (
 	DefaultCodeChallenge string
 	// DefaultCodeChallengeMethod holds the default value on creation for the "code_challenge_method" field.
 	DefaultCodeChallengeMethod string
+	// DefaultPrompt holds the default value on creation for the "prompt" field.
+	DefaultPrompt string
+	// DefaultMaxAge holds the default value on creation for the "max_age" field.
+	DefaultMaxAge int
+	// DefaultAcr holds the default value on creation for the "acr" field.
+	DefaultAcr string
+	// DefaultSid holds the default value on creation for the "sid" field.
+	DefaultSid string
+	// DefaultLoginHint holds the default value on creation for the "login_hint" field.
+	DefaultLoginHint string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -183,3 +223,33 @@ func ByCodeChallenge(opts ...sql.OrderTermOption) OrderOption {
 func ByCodeChallengeMethod(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCodeChallengeMethod, opts...).ToFunc()
 }
+
+// ByPrompt orders the results by the prompt field.
+func ByPrompt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrompt, opts...).ToFunc()
+}
+
+// ByMaxAge orders the results by the max_age field.
+func ByMaxAge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAge, opts...).ToFunc()
+}
+
+// ByAcr orders the results by the acr field.
+func ByAcr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcr, opts...).ToFunc()
+}
+
+// ByAuthTime orders the results by the auth_time field.
+func ByAuthTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthTime, opts...).ToFunc()
+}
+
+// BySid orders the results by the sid field.
+func BySid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSid, opts...).ToFunc()
+}
+
+// ByLoginHint orders the results by the login_hint field.
+func ByLoginHint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginHint, opts...).ToFunc()
+}
@@ -149,6 +149,36 @@ func HmacKey(v []byte) predicate.AuthRequest {
 	return predicate.AuthRequest(sql.FieldEQ(FieldHmacKey, v))
 }
 
+// Prompt applies equality check predicate on the "prompt" field. It's identical to PromptEQ.
+func Prompt(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldPrompt, v))
+}
+
+// MaxAge applies equality check predicate on the "max_age" field. It's identical to MaxAgeEQ.
+func MaxAge(v int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldMaxAge, v))
+}
+
+// Acr applies equality check predicate on the "acr" field. It's identical to AcrEQ.
+func Acr(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldAcr, v))
+}
+
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// Sid applies equality check predicate on the "sid" field. It's identical to SidEQ.
+func Sid(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldSid, v))
+}
+
+// LoginHint applies equality check predicate on the "login_hint" field. It's identical to LoginHintEQ.
+func LoginHint(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldLoginHint, v))
+}
+
 // ClientIDEQ applies the EQ predicate on the "client_id" field.
 func ClientIDEQ(v string) predicate.AuthRequest {
 	return predicate.AuthRequest(sql.FieldEQ(FieldClientID, v))
@@ -664,6 +694,16 @@ func ClaimsGroupsNotNil() predicate.AuthRequest {
 	return predicate.AuthRequest(sql.FieldNotNull(FieldClaimsGroups))
 }
 
+// ClaimsExtraIsNil applies the IsNil predicate on the "claims_extra" field.
+func ClaimsExtraIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIsNull(FieldClaimsExtra))
+}
+
+// ClaimsExtraNotNil applies the NotNil predicate on the "claims_extra" field.
+func ClaimsExtraNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotNull(FieldClaimsExtra))
+}
+
 // ClaimsPreferredUsernameEQ applies the EQ predicate on the "claims_preferred_username" field.
 func ClaimsPreferredUsernameEQ(v string) predicate.AuthRequest {
 	return predicate.AuthRequest(sql.FieldEQ(FieldClaimsPreferredUsername, v))
@@ -1054,6 +1094,386 @@ func HmacKeyLTE(v []byte) predicate.AuthRequest {
 	return predicate.AuthRequest(sql.FieldLTE(FieldHmacKey, v))
 }
 
+// PromptEQ applies the EQ predicate on the "prompt" field.
+func PromptEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldPrompt, v))
+}
+
+// PromptNEQ applies the NEQ predicate on the "prompt" field.
+func PromptNEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNEQ(FieldPrompt, v))
+}
+
+// PromptIn applies the In predicate on the "prompt" field.
+func PromptIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIn(FieldPrompt, vs...))
+}
+
+// PromptNotIn applies the NotIn predicate on the "prompt" field.
+func PromptNotIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotIn(FieldPrompt, vs...))
+}
+
+// PromptGT applies the GT predicate on the "prompt" field.
+func PromptGT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGT(FieldPrompt, v))
+}
+
+// PromptGTE applies the GTE predicate on the "prompt" field.
+func PromptGTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGTE(FieldPrompt, v))
+}
+
+// PromptLT applies the LT predicate on the "prompt" field.
+func PromptLT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLT(FieldPrompt, v))
+}
+
+// PromptLTE applies the LTE predicate on the "prompt" field.
+func PromptLTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLTE(FieldPrompt, v))
+}
+
+// PromptContains applies the Contains predicate on the "prompt" field.
+func PromptContains(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContains(FieldPrompt, v))
+}
+
+// PromptHasPrefix applies the HasPrefix predicate on the "prompt" field.
+func PromptHasPrefix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasPrefix(FieldPrompt, v))
+}
+
+// PromptHasSuffix applies the HasSuffix predicate on the "prompt" field.
+func PromptHasSuffix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasSuffix(FieldPrompt, v))
+}
+
+// PromptEqualFold applies the EqualFold predicate on the "prompt" field.
+func PromptEqualFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEqualFold(FieldPrompt, v))
+}
+
+// PromptContainsFold applies the ContainsFold predicate on the "prompt" field.
+func PromptContainsFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContainsFold(FieldPrompt, v))
+}
+
+// MaxAgeEQ applies the EQ predicate on the "max_age" field.
+func MaxAgeEQ(v int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldMaxAge, v))
+}
+
+// MaxAgeNEQ applies the NEQ predicate on the "max_age" field.
+func MaxAgeNEQ(v int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNEQ(FieldMaxAge, v))
+}
+
+// MaxAgeIn applies the In predicate on the "max_age" field.
+func MaxAgeIn(vs ...int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIn(FieldMaxAge, vs...))
+}
+
+// MaxAgeNotIn applies the NotIn predicate on the "max_age" field.
+func MaxAgeNotIn(vs ...int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotIn(FieldMaxAge, vs...))
+}
+
+// MaxAgeGT applies the GT predicate on the "max_age" field.
+func MaxAgeGT(v int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGT(FieldMaxAge, v))
+}
+
+// MaxAgeGTE applies the GTE predicate on the "max_age" field.
+func MaxAgeGTE(v int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGTE(FieldMaxAge, v))
+}
+
+// MaxAgeLT applies the LT predicate on the "max_age" field.
+func MaxAgeLT(v int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLT(FieldMaxAge, v))
+}
+
+// MaxAgeLTE applies the LTE predicate on the "max_age" field.
+func MaxAgeLTE(v int) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLTE(FieldMaxAge, v))
+}
+
+// AcrValuesIsNil applies the IsNil predicate on the "acr_values" field.
+func AcrValuesIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIsNull(FieldAcrValues))
+}
+
+// AcrValuesNotNil applies the NotNil predicate on the "acr_values" field.
+func AcrValuesNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotNull(FieldAcrValues))
+}
+
+// AcrEQ applies the EQ predicate on the "acr" field.
+func AcrEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldAcr, v))
+}
+
+// AcrNEQ applies the NEQ predicate on the "acr" field.
+func AcrNEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNEQ(FieldAcr, v))
+}
+
+// AcrIn applies the In predicate on the "acr" field.
+func AcrIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIn(FieldAcr, vs...))
+}
+
+// AcrNotIn applies the NotIn predicate on the "acr" field.
+func AcrNotIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotIn(FieldAcr, vs...))
+}
+
+// AcrGT applies the GT predicate on the "acr" field.
+func AcrGT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGT(FieldAcr, v))
+}
+
+// AcrGTE applies the GTE predicate on the "acr" field.
+func AcrGTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGTE(FieldAcr, v))
+}
+
+// AcrLT applies the LT predicate on the "acr" field.
+func AcrLT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLT(FieldAcr, v))
+}
+
+// AcrLTE applies the LTE predicate on the "acr" field.
+func AcrLTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLTE(FieldAcr, v))
+}
+
+// AcrContains applies the Contains predicate on the "acr" field.
+func AcrContains(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContains(FieldAcr, v))
+}
+
+// AcrHasPrefix applies the HasPrefix predicate on the "acr" field.
+func AcrHasPrefix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasPrefix(FieldAcr, v))
+}
+
+// AcrHasSuffix applies the HasSuffix predicate on the "acr" field.
+func AcrHasSuffix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasSuffix(FieldAcr, v))
+}
+
+// AcrEqualFold applies the EqualFold predicate on the "acr" field.
+func AcrEqualFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEqualFold(FieldAcr, v))
+}
+
+// AcrContainsFold applies the ContainsFold predicate on the "acr" field.
+func AcrContainsFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContainsFold(FieldAcr, v))
+}
+
+// AmrIsNil applies the IsNil predicate on the "amr" field.
+func AmrIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIsNull(FieldAmr))
+}
+
+// AmrNotNil applies the NotNil predicate on the "amr" field.
+func AmrNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotNull(FieldAmr))
+}
+
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// AuthTimeIsNil applies the IsNil predicate on the "auth_time" field.
+func AuthTimeIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIsNull(FieldAuthTime))
+}
+
+// AuthTimeNotNil applies the NotNil predicate on the "auth_time" field.
+func AuthTimeNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotNull(FieldAuthTime))
+}
+
+// SidEQ applies the EQ predicate on the "sid" field.
+func SidEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldSid, v))
+}
+
+// SidNEQ applies the NEQ predicate on the "sid" field.
+func SidNEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNEQ(FieldSid, v))
+}
+
+// SidIn applies the In predicate on the "sid" field.
+func SidIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIn(FieldSid, vs...))
+}
+
+// SidNotIn applies the NotIn predicate on the "sid" field.
+func SidNotIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotIn(FieldSid, vs...))
+}
+
+// SidGT applies the GT predicate on the "sid" field.
+func SidGT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGT(FieldSid, v))
+}
+
+// SidGTE applies the GTE predicate on the "sid" field.
+func SidGTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGTE(FieldSid, v))
+}
+
+// SidLT applies the LT predicate on the "sid" field.
+func SidLT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLT(FieldSid, v))
+}
+
+// SidLTE applies the LTE predicate on the "sid" field.
+func SidLTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLTE(FieldSid, v))
+}
+
+// SidContains applies the Contains predicate on the "sid" field.
+func SidContains(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContains(FieldSid, v))
+}
+
+// SidHasPrefix applies the HasPrefix predicate on the "sid" field.
+func SidHasPrefix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasPrefix(FieldSid, v))
+}
+
+// SidHasSuffix applies the HasSuffix predicate on the "sid" field.
+func SidHasSuffix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasSuffix(FieldSid, v))
+}
+
+// SidEqualFold applies the EqualFold predicate on the "sid" field.
+func SidEqualFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEqualFold(FieldSid, v))
+}
+
+// SidContainsFold applies the ContainsFold predicate on the "sid" field.
+func SidContainsFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContainsFold(FieldSid, v))
+}
+
+// LoginHintEQ applies the EQ predicate on the "login_hint" field.
+func LoginHintEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEQ(FieldLoginHint, v))
+}
+
+// LoginHintNEQ applies the NEQ predicate on the "login_hint" field.
+func LoginHintNEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNEQ(FieldLoginHint, v))
+}
+
+// LoginHintIn applies the In predicate on the "login_hint" field.
+func LoginHintIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIn(FieldLoginHint, vs...))
+}
+
+// LoginHintNotIn applies the NotIn predicate on the "login_hint" field.
+func LoginHintNotIn(vs ...string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotIn(FieldLoginHint, vs...))
+}
+
+// LoginHintGT applies the GT predicate on the "login_hint" field.
+func LoginHintGT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGT(FieldLoginHint, v))
+}
+
+// LoginHintGTE applies the GTE predicate on the "login_hint" field.
+func LoginHintGTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldGTE(FieldLoginHint, v))
+}
+
+// LoginHintLT applies the LT predicate on the "login_hint" field.
+func LoginHintLT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLT(FieldLoginHint, v))
+}
+
+// LoginHintLTE applies the LTE predicate on the "login_hint" field.
+func LoginHintLTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldLTE(FieldLoginHint, v))
+}
+
+// LoginHintContains applies the Contains predicate on the "login_hint" field.
+func LoginHintContains(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContains(FieldLoginHint, v))
+}
+
+// LoginHintHasPrefix applies the HasPrefix predicate on the "login_hint" field.
+func LoginHintHasPrefix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasPrefix(FieldLoginHint, v))
+}
+
+// LoginHintHasSuffix applies the HasSuffix predicate on the "login_hint" field.
+func LoginHintHasSuffix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldHasSuffix(FieldLoginHint, v))
+}
+
+// LoginHintEqualFold applies the EqualFold predicate on the "login_hint" field.
+func LoginHintEqualFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldEqualFold(FieldLoginHint, v))
+}
+
+// LoginHintContainsFold applies the ContainsFold predicate on the "login_hint" field.
+func LoginHintContainsFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldContainsFold(FieldLoginHint, v))
+}
+
+// UILocalesIsNil applies the IsNil predicate on the "ui_locales" field.
+func UILocalesIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldIsNull(FieldUILocales))
+}
+
+// UILocalesNotNil applies the NotNil predicate on the "ui_locales" field.
+func UILocalesNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(sql.FieldNotNull(FieldUILocales))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AuthRequest) predicate.AuthRequest {
 	return predicate.AuthRequest(sql.AndPredicates(predicates...))
@@ -98,6 +98,12 @@ func (_c *AuthRequestCreate) SetClaimsGroups(v []string) *AuthRequestCreate {
 	return _c
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_c *AuthRequestCreate) SetClaimsExtra(v map[string]interface{}) *AuthRequestCreate {
+	_c.mutation.SetClaimsExtra(v)
+	return _c
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_c *AuthRequestCreate) SetClaimsPreferredUsername(v string) *AuthRequestCreate {
 	_c.mutation.SetClaimsPreferredUsername(v)
@@ -164,6 +170,108 @@ func (_c *AuthRequestCreate) SetHmacKey(v []byte) *AuthRequestCreate {
 	return _c
 }
 
+// SetPrompt sets the "prompt" field.
+func (_c *AuthRequestCreate) SetPrompt(v string) *AuthRequestCreate {
+	_c.mutation.SetPrompt(v)
+	return _c
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillablePrompt(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetPrompt(*v)
+	}
+	return _c
+}
+
+// SetMaxAge sets the "max_age" field.
+func (_c *AuthRequestCreate) SetMaxAge(v int) *AuthRequestCreate {
+	_c.mutation.SetMaxAge(v)
+	return _c
+}
+
+// SetNillableMaxAge sets the "max_age" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableMaxAge(v *int) *AuthRequestCreate {
+	if v != nil {
+		_c.SetMaxAge(*v)
+	}
+	return _c
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (_c *AuthRequestCreate) SetAcrValues(v []string) *AuthRequestCreate {
+	_c.mutation.SetAcrValues(v)
+	return _c
+}
+
+// SetAcr sets the "acr" field.
+func (_c *AuthRequestCreate) SetAcr(v string) *AuthRequestCreate {
+	_c.mutation.SetAcr(v)
+	return _c
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableAcr(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetAcr(*v)
+	}
+	return _c
+}
+
+// SetAmr sets the "amr" field.
+func (_c *AuthRequestCreate) SetAmr(v []string) *AuthRequestCreate {
+	_c.mutation.SetAmr(v)
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *AuthRequestCreate) SetAuthTime(v time.Time) *AuthRequestCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableAuthTime(v *time.Time) *AuthRequestCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
+// SetSid sets the "sid" field.
+func (_c *AuthRequestCreate) SetSid(v string) *AuthRequestCreate {
+	_c.mutation.SetSid(v)
+	return _c
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableSid(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetSid(*v)
+	}
+	return _c
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (_c *AuthRequestCreate) SetLoginHint(v string) *AuthRequestCreate {
+	_c.mutation.SetLoginHint(v)
+	return _c
+}
+
+// SetNillableLoginHint sets the "login_hint" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableLoginHint(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetLoginHint(*v)
+	}
+	return _c
+}
+
+// SetUILocales sets the "ui_locales" field.
+func (_c *AuthRequestCreate) SetUILocales(v []string) *AuthRequestCreate {
+	_c.mutation.SetUILocales(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuthRequestCreate) SetID(v string) *AuthRequestCreate {
 	_c.mutation.SetID(v)
@@ -217,6 +325,26 @@ func (_c *AuthRequestCreate) defaults() {
 		v := authrequest.DefaultCodeChallengeMethod
 		_c.mutation.SetCodeChallengeMethod(v)
 	}
+	if _, ok := _c.mutation.Prompt(); !ok {
+		v := authrequest.DefaultPrompt
+		_c.mutation.SetPrompt(v)
+	}
+	if _, ok := _c.mutation.MaxAge(); !ok {
+		v := authrequest.DefaultMaxAge
+		_c.mutation.SetMaxAge(v)
+	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		v := authrequest.DefaultAcr
+		_c.mutation.SetAcr(v)
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		v := authrequest.DefaultSid
+		_c.mutation.SetSid(v)
+	}
+	if _, ok := _c.mutation.LoginHint(); !ok {
+		v := authrequest.DefaultLoginHint
+		_c.mutation.SetLoginHint(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -269,6 +397,21 @@ func (_c *AuthRequestCreate) check() error {
 	if _, ok := _c.mutation.HmacKey(); !ok {
 		return &ValidationError{Name: "hmac_key", err: errors.New(`db: missing required field "AuthRequest.hmac_key"`)}
 	}
+	if _, ok := _c.mutation.Prompt(); !ok {
+		return &ValidationError{Name: "prompt", err: errors.New(`db: missing required field "AuthRequest.prompt"`)}
+	}
+	if _, ok := _c.mutation.MaxAge(); !ok {
+		return &ValidationError{Name: "max_age", err: errors.New(`db: missing required field "AuthRequest.max_age"`)}
+	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		return &ValidationError{Name: "acr", err: errors.New(`db: missing required field "AuthRequest.acr"`)}
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		return &ValidationError{Name: "sid", err: errors.New(`db: missing required field "AuthRequest.sid"`)}
+	}
+	if _, ok := _c.mutation.LoginHint(); !ok {
+		return &ValidationError{Name: "login_hint", err: errors.New(`db: missing required field "AuthRequest.login_hint"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := authrequest.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "AuthRequest.id": %w`, err)}
@@ -361,6 +504,10 @@ func (_c *AuthRequestCreate) createSpec() (*AuthRequest, *sqlgraph.CreateSpec) {
 		_spec.SetField(authrequest.FieldClaimsGroups, field.TypeJSON, value)
 		_node.ClaimsGroups = value
 	}
+	if value, ok := _c.mutation.ClaimsExtra(); ok {
+		_spec.SetField(authrequest.FieldClaimsExtra, field.TypeJSON, value)
+		_node.ClaimsExtra = value
+	}
 	if value, ok := _c.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(authrequest.FieldClaimsPreferredUsername, field.TypeString, value)
 		_node.ClaimsPreferredUsername = value
@@ -389,6 +536,42 @@ func (_c *AuthRequestCreate) createSpec() (*AuthRequest, *sqlgraph.CreateSpec) {
 		_spec.SetField(authrequest.FieldHmacKey, field.TypeBytes, value)
 		_node.HmacKey = value
 	}
+	if value, ok := _c.mutation.Prompt(); ok {
+		_spec.SetField(authrequest.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := _c.mutation.MaxAge(); ok {
+		_spec.SetField(authrequest.FieldMaxAge, field.TypeInt, value)
+		_node.MaxAge = value
+	}
+	if value, ok := _c.mutation.AcrValues(); ok {
+		_spec.SetField(authrequest.FieldAcrValues, field.TypeJSON, value)
+		_node.AcrValues = value
+	}
+	if value, ok := _c.mutation.Acr(); ok {
+		_spec.SetField(authrequest.FieldAcr, field.TypeString, value)
+		_node.Acr = value
+	}
+	if value, ok := _c.mutation.Amr(); ok {
+		_spec.SetField(authrequest.FieldAmr, field.TypeJSON, value)
+		_node.Amr = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(authrequest.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
+	if value, ok := _c.mutation.Sid(); ok {
+		_spec.SetField(authrequest.FieldSid, field.TypeString, value)
+		_node.Sid = value
+	}
+	if value, ok := _c.mutation.LoginHint(); ok {
+		_spec.SetField(authrequest.FieldLoginHint, field.TypeString, value)
+		_node.LoginHint = value
+	}
+	if value, ok := _c.mutation.UILocales(); ok {
+		_spec.SetField(authrequest.FieldUILocales, field.TypeJSON, value)
+		_node.UILocales = value
+	}
 	return _node, _spec
 }
 
@@ -223,6 +223,18 @@ func (_u *AuthRequestUpdate) ClearClaimsGroups() *AuthRequestUpdate {
 	return _u
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *AuthRequestUpdate) SetClaimsExtra(v map[string]interface{}) *AuthRequestUpdate {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *AuthRequestUpdate) ClearClaimsExtra() *AuthRequestUpdate {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_u *AuthRequestUpdate) SetClaimsPreferredUsername(v string) *AuthRequestUpdate {
 	_u.mutation.SetClaimsPreferredUsername(v)
@@ -311,6 +323,157 @@ func (_u *AuthRequestUpdate) SetHmacKey(v []byte) *AuthRequestUpdate {
 	return _u
 }
 
+// SetPrompt sets the "prompt" field.
+func (_u *AuthRequestUpdate) SetPrompt(v string) *AuthRequestUpdate {
+	_u.mutation.SetPrompt(v)
+	return _u
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillablePrompt(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetPrompt(*v)
+	}
+	return _u
+}
+
+// SetMaxAge sets the "max_age" field.
+func (_u *AuthRequestUpdate) SetMaxAge(v int) *AuthRequestUpdate {
+	_u.mutation.ResetMaxAge()
+	_u.mutation.SetMaxAge(v)
+	return _u
+}
+
+// SetNillableMaxAge sets the "max_age" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableMaxAge(v *int) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetMaxAge(*v)
+	}
+	return _u
+}
+
+// AddMaxAge adds value to the "max_age" field.
+func (_u *AuthRequestUpdate) AddMaxAge(v int) *AuthRequestUpdate {
+	_u.mutation.AddMaxAge(v)
+	return _u
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (_u *AuthRequestUpdate) SetAcrValues(v []string) *AuthRequestUpdate {
+	_u.mutation.SetAcrValues(v)
+	return _u
+}
+
+// AppendAcrValues appends value to the "acr_values" field.
+func (_u *AuthRequestUpdate) AppendAcrValues(v []string) *AuthRequestUpdate {
+	_u.mutation.AppendAcrValues(v)
+	return _u
+}
+
+// ClearAcrValues clears the value of the "acr_values" field.
+func (_u *AuthRequestUpdate) ClearAcrValues() *AuthRequestUpdate {
+	_u.mutation.ClearAcrValues()
+	return _u
+}
+
+// SetAcr sets the "acr" field.
+func (_u *AuthRequestUpdate) SetAcr(v string) *AuthRequestUpdate {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableAcr(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *AuthRequestUpdate) SetAmr(v []string) *AuthRequestUpdate {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *AuthRequestUpdate) AppendAmr(v []string) *AuthRequestUpdate {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *AuthRequestUpdate) ClearAmr() *AuthRequestUpdate {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthRequestUpdate) SetAuthTime(v time.Time) *AuthRequestUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableAuthTime(v *time.Time) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *AuthRequestUpdate) ClearAuthTime() *AuthRequestUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *AuthRequestUpdate) SetSid(v string) *AuthRequestUpdate {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableSid(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (_u *AuthRequestUpdate) SetLoginHint(v string) *AuthRequestUpdate {
+	_u.mutation.SetLoginHint(v)
+	return _u
+}
+
+// SetNillableLoginHint sets the "login_hint" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableLoginHint(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetLoginHint(*v)
+	}
+	return _u
+}
+
+// SetUILocales sets the "ui_locales" field.
+func (_u *AuthRequestUpdate) SetUILocales(v []string) *AuthRequestUpdate {
+	_u.mutation.SetUILocales(v)
+	return _u
+}
+
+// AppendUILocales appends value to the "ui_locales" field.
+func (_u *AuthRequestUpdate) AppendUILocales(v []string) *AuthRequestUpdate {
+	_u.mutation.AppendUILocales(v)
+	return _u
+}
+
+// ClearUILocales clears the value of the "ui_locales" field.
+func (_u *AuthRequestUpdate) ClearUILocales() *AuthRequestUpdate {
+	_u.mutation.ClearUILocales()
+	return _u
+}
+
 // Mutation returns the AuthRequestMutation object of the builder.
 func (_u *AuthRequestUpdate) Mutation() *AuthRequestMutation {
 	return _u.mutation
@@ -415,6 +578,12 @@ func (_u *AuthRequestUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.ClaimsGroupsCleared() {
 		_spec.ClearField(authrequest.FieldClaimsGroups, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(authrequest.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(authrequest.FieldClaimsExtra, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(authrequest.FieldClaimsPreferredUsername, field.TypeString, value)
 	}
@@ -439,6 +608,63 @@ func (_u *AuthRequestUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.HmacKey(); ok {
 		_spec.SetField(authrequest.FieldHmacKey, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.Prompt(); ok {
+		_spec.SetField(authrequest.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxAge(); ok {
+		_spec.SetField(authrequest.FieldMaxAge, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAge(); ok {
+		_spec.AddField(authrequest.FieldMaxAge, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AcrValues(); ok {
+		_spec.SetField(authrequest.FieldAcrValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAcrValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldAcrValues, value)
+		})
+	}
+	if _u.mutation.AcrValuesCleared() {
+		_spec.ClearField(authrequest.FieldAcrValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(authrequest.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(authrequest.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(authrequest.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authrequest.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(authrequest.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(authrequest.FieldSid, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LoginHint(); ok {
+		_spec.SetField(authrequest.FieldLoginHint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UILocales(); ok {
+		_spec.SetField(authrequest.FieldUILocales, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUILocales(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldUILocales, value)
+		})
+	}
+	if _u.mutation.UILocalesCleared() {
+		_spec.ClearField(authrequest.FieldUILocales, field.TypeJSON)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authrequest.Label}
@@ -653,6 +879,18 @@ func (_u *AuthRequestUpdateOne) ClearClaimsGroups() *AuthRequestUpdateOne {
 	return _u
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *AuthRequestUpdateOne) SetClaimsExtra(v map[string]interface{}) *AuthRequestUpdateOne {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *AuthRequestUpdateOne) ClearClaimsExtra() *AuthRequestUpdateOne {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_u *AuthRequestUpdateOne) SetClaimsPreferredUsername(v string) *AuthRequestUpdateOne {
 	_u.mutation.SetClaimsPreferredUsername(v)
@@ -741,6 +979,157 @@ func (_u *AuthRequestUpdateOne) SetHmacKey(v []byte) *AuthRequestUpdateOne {
 	return _u
 }
 
+// SetPrompt sets the "prompt" field.
+func (_u *AuthRequestUpdateOne) SetPrompt(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetPrompt(v)
+	return _u
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillablePrompt(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetPrompt(*v)
+	}
+	return _u
+}
+
+// SetMaxAge sets the "max_age" field.
+func (_u *AuthRequestUpdateOne) SetMaxAge(v int) *AuthRequestUpdateOne {
+	_u.mutation.ResetMaxAge()
+	_u.mutation.SetMaxAge(v)
+	return _u
+}
+
+// SetNillableMaxAge sets the "max_age" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableMaxAge(v *int) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetMaxAge(*v)
+	}
+	return _u
+}
+
+// AddMaxAge adds value to the "max_age" field.
+func (_u *AuthRequestUpdateOne) AddMaxAge(v int) *AuthRequestUpdateOne {
+	_u.mutation.AddMaxAge(v)
+	return _u
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (_u *AuthRequestUpdateOne) SetAcrValues(v []string) *AuthRequestUpdateOne {
+	_u.mutation.SetAcrValues(v)
+	return _u
+}
+
+// AppendAcrValues appends value to the "acr_values" field.
+func (_u *AuthRequestUpdateOne) AppendAcrValues(v []string) *AuthRequestUpdateOne {
+	_u.mutation.AppendAcrValues(v)
+	return _u
+}
+
+// ClearAcrValues clears the value of the "acr_values" field.
+func (_u *AuthRequestUpdateOne) ClearAcrValues() *AuthRequestUpdateOne {
+	_u.mutation.ClearAcrValues()
+	return _u
+}
+
+// SetAcr sets the "acr" field.
+func (_u *AuthRequestUpdateOne) SetAcr(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableAcr(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *AuthRequestUpdateOne) SetAmr(v []string) *AuthRequestUpdateOne {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *AuthRequestUpdateOne) AppendAmr(v []string) *AuthRequestUpdateOne {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *AuthRequestUpdateOne) ClearAmr() *AuthRequestUpdateOne {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthRequestUpdateOne) SetAuthTime(v time.Time) *AuthRequestUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableAuthTime(v *time.Time) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *AuthRequestUpdateOne) ClearAuthTime() *AuthRequestUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *AuthRequestUpdateOne) SetSid(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableSid(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (_u *AuthRequestUpdateOne) SetLoginHint(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetLoginHint(v)
+	return _u
+}
+
+// SetNillableLoginHint sets the "login_hint" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableLoginHint(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetLoginHint(*v)
+	}
+	return _u
+}
+
+// SetUILocales sets the "ui_locales" field.
+func (_u *AuthRequestUpdateOne) SetUILocales(v []string) *AuthRequestUpdateOne {
+	_u.mutation.SetUILocales(v)
+	return _u
+}
+
+// AppendUILocales appends value to the "ui_locales" field.
+func (_u *AuthRequestUpdateOne) AppendUILocales(v []string) *AuthRequestUpdateOne {
+	_u.mutation.AppendUILocales(v)
+	return _u
+}
+
+// ClearUILocales clears the value of the "ui_locales" field.
+func (_u *AuthRequestUpdateOne) ClearUILocales() *AuthRequestUpdateOne {
+	_u.mutation.ClearUILocales()
+	return _u
+}
+
 // Mutation returns the AuthRequestMutation object of the builder.
 func (_u *AuthRequestUpdateOne) Mutation() *AuthRequestMutation {
 	return _u.mutation
@@ -875,6 +1264,12 @@ func (_u *AuthRequestUpdateOne) sqlSave(ctx context.Context) (_node *AuthRequest
 	if _u.mutation.ClaimsGroupsCleared() {
 		_spec.ClearField(authrequest.FieldClaimsGroups, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(authrequest.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(authrequest.FieldClaimsExtra, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(authrequest.FieldClaimsPreferredUsername, field.TypeString, value)
 	}
@@ -899,6 +1294,63 @@ func (_u *AuthRequestUpdateOne) sqlSave(ctx context.Context) (_node *AuthRequest
 	if value, ok := _u.mutation.HmacKey(); ok {
 		_spec.SetField(authrequest.FieldHmacKey, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.Prompt(); ok {
+		_spec.SetField(authrequest.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxAge(); ok {
+		_spec.SetField(authrequest.FieldMaxAge, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAge(); ok {
+		_spec.AddField(authrequest.FieldMaxAge, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AcrValues(); ok {
+		_spec.SetField(authrequest.FieldAcrValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAcrValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldAcrValues, value)
+		})
+	}
+	if _u.mutation.AcrValuesCleared() {
+		_spec.ClearField(authrequest.FieldAcrValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(authrequest.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(authrequest.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(authrequest.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authrequest.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(authrequest.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(authrequest.FieldSid, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LoginHint(); ok {
+		_spec.SetField(authrequest.FieldLoginHint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UILocales(); ok {
+		_spec.SetField(authrequest.FieldUILocales, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUILocales(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldUILocales, value)
+		})
+	}
+	if _u.mutation.UILocalesCleared() {
+		_spec.ClearField(authrequest.FieldUILocales, field.TypeJSON)
+	}
 	_node = &AuthRequest{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -14,16 +14,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
 	"github.com/dexidp/dex/storage/ent/db/connector"
+	"github.com/dexidp/dex/storage/ent/db/consent"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/scope"
+	"github.com/dexidp/dex/storage/ent/db/usersession"
 )
 
 // Client is the client that holds all ent builders.
@@ -31,18 +39,30 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
 	// AuthCode is the client for interacting with the AuthCode builders.
 	AuthCode *AuthCodeClient
 	// AuthRequest is the client for interacting with the AuthRequest builders.
 	AuthRequest *AuthRequestClient
+	// ClientAssertion is the client for interacting with the ClientAssertion builders.
+	ClientAssertion *ClientAssertionClient
 	// Connector is the client for interacting with the Connector builders.
 	Connector *ConnectorClient
+	// Consent is the client for interacting with the Consent builders.
+	Consent *ConsentClient
 	// DeviceRequest is the client for interacting with the DeviceRequest builders.
 	DeviceRequest *DeviceRequestClient
 	// DeviceToken is the client for interacting with the DeviceToken builders.
 	DeviceToken *DeviceTokenClient
+	// DpopProof is the client for interacting with the DpopProof builders.
+	DpopProof *DpopProofClient
+	// InitialAccessToken is the client for interacting with the InitialAccessToken builders.
+	InitialAccessToken *InitialAccessTokenClient
 	// Keys is the client for interacting with the Keys builders.
 	Keys *KeysClient
+	// LogoutNotification is the client for interacting with the LogoutNotification builders.
+	LogoutNotification *LogoutNotificationClient
 	// OAuth2Client is the client for interacting with the OAuth2Client builders.
 	OAuth2Client *OAuth2ClientClient
 	// OfflineSession is the client for interacting with the OfflineSession builders.
@@ -51,6 +71,10 @@ type Client struct {
 	Password *PasswordClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// Scope is the client for interacting with the Scope builders.
+	Scope *ScopeClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -62,16 +86,24 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessToken = NewAccessTokenClient(c.config)
 	c.AuthCode = NewAuthCodeClient(c.config)
 	c.AuthRequest = NewAuthRequestClient(c.config)
+	c.ClientAssertion = NewClientAssertionClient(c.config)
 	c.Connector = NewConnectorClient(c.config)
+	c.Consent = NewConsentClient(c.config)
 	c.DeviceRequest = NewDeviceRequestClient(c.config)
 	c.DeviceToken = NewDeviceTokenClient(c.config)
+	c.DpopProof = NewDpopProofClient(c.config)
+	c.InitialAccessToken = NewInitialAccessTokenClient(c.config)
 	c.Keys = NewKeysClient(c.config)
+	c.LogoutNotification = NewLogoutNotificationClient(c.config)
 	c.OAuth2Client = NewOAuth2ClientClient(c.config)
 	c.OfflineSession = NewOfflineSessionClient(c.config)
 	c.Password = NewPasswordClient(c.config)
 	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.Scope = NewScopeClient(c.config)
+	c.UserSession = NewUserSessionClient(c.config)
 }
 
 type (
@@ -162,18 +194,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		AuthCode:       NewAuthCodeClient(cfg),
-		AuthRequest:    NewAuthRequestClient(cfg),
-		Connector:      NewConnectorClient(cfg),
-		DeviceRequest:  NewDeviceRequestClient(cfg),
-		DeviceToken:    NewDeviceTokenClient(cfg),
-		Keys:           NewKeysClient(cfg),
-		OAuth2Client:   NewOAuth2ClientClient(cfg),
-		OfflineSession: NewOfflineSessionClient(cfg),
-		Password:       NewPasswordClient(cfg),
-		RefreshToken:   NewRefreshTokenClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AccessToken:        NewAccessTokenClient(cfg),
+		AuthCode:           NewAuthCodeClient(cfg),
+		AuthRequest:        NewAuthRequestClient(cfg),
+		ClientAssertion:    NewClientAssertionClient(cfg),
+		Connector:          NewConnectorClient(cfg),
+		Consent:            NewConsentClient(cfg),
+		DeviceRequest:      NewDeviceRequestClient(cfg),
+		DeviceToken:        NewDeviceTokenClient(cfg),
+		DpopProof:          NewDpopProofClient(cfg),
+		InitialAccessToken: NewInitialAccessTokenClient(cfg),
+		Keys:               NewKeysClient(cfg),
+		LogoutNotification: NewLogoutNotificationClient(cfg),
+		OAuth2Client:       NewOAuth2ClientClient(cfg),
+		OfflineSession:     NewOfflineSessionClient(cfg),
+		Password:           NewPasswordClient(cfg),
+		RefreshToken:       NewRefreshTokenClient(cfg),
+		Scope:              NewScopeClient(cfg),
+		UserSession:        NewUserSessionClient(cfg),
 	}, nil
 }
 
@@ -191,25 +231,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		AuthCode:       NewAuthCodeClient(cfg),
-		AuthRequest:    NewAuthRequestClient(cfg),
-		Connector:      NewConnectorClient(cfg),
-		DeviceRequest:  NewDeviceRequestClient(cfg),
-		DeviceToken:    NewDeviceTokenClient(cfg),
-		Keys:           NewKeysClient(cfg),
-		OAuth2Client:   NewOAuth2ClientClient(cfg),
-		OfflineSession: NewOfflineSessionClient(cfg),
-		Password:       NewPasswordClient(cfg),
-		RefreshToken:   NewRefreshTokenClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AccessToken:        NewAccessTokenClient(cfg),
+		AuthCode:           NewAuthCodeClient(cfg),
+		AuthRequest:        NewAuthRequestClient(cfg),
+		ClientAssertion:    NewClientAssertionClient(cfg),
+		Connector:          NewConnectorClient(cfg),
+		Consent:            NewConsentClient(cfg),
+		DeviceRequest:      NewDeviceRequestClient(cfg),
+		DeviceToken:        NewDeviceTokenClient(cfg),
+		DpopProof:          NewDpopProofClient(cfg),
+		InitialAccessToken: NewInitialAccessTokenClient(cfg),
+		Keys:               NewKeysClient(cfg),
+		LogoutNotification: NewLogoutNotificationClient(cfg),
+		OAuth2Client:       NewOAuth2ClientClient(cfg),
+		OfflineSession:     NewOfflineSessionClient(cfg),
+		Password:           NewPasswordClient(cfg),
+		RefreshToken:       NewRefreshTokenClient(cfg),
+		Scope:              NewScopeClient(cfg),
+		UserSession:        NewUserSessionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuthCode.
+//		AccessToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -232,8 +280,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AuthCode, c.AuthRequest, c.Connector, c.DeviceRequest, c.DeviceToken, c.Keys,
-		c.OAuth2Client, c.OfflineSession, c.Password, c.RefreshToken,
+		c.AccessToken, c.AuthCode, c.AuthRequest, c.ClientAssertion, c.Connector,
+		c.Consent, c.DeviceRequest, c.DeviceToken, c.DpopProof, c.InitialAccessToken,
+		c.Keys, c.LogoutNotification, c.OAuth2Client, c.OfflineSession, c.Password,
+		c.RefreshToken, c.Scope, c.UserSession,
 	} {
 		n.Use(hooks...)
 	}
@@ -243,8 +293,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AuthCode, c.AuthRequest, c.Connector, c.DeviceRequest, c.DeviceToken, c.Keys,
-		c.OAuth2Client, c.OfflineSession, c.Password, c.RefreshToken,
+		c.AccessToken, c.AuthCode, c.AuthRequest, c.ClientAssertion, c.Connector,
+		c.Consent, c.DeviceRequest, c.DeviceToken, c.DpopProof, c.InitialAccessToken,
+		c.Keys, c.LogoutNotification, c.OAuth2Client, c.OfflineSession, c.Password,
+		c.RefreshToken, c.Scope, c.UserSession,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -253,18 +305,30 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessTokenMutation:
+		return c.AccessToken.mutate(ctx, m)
 	case *AuthCodeMutation:
 		return c.AuthCode.mutate(ctx, m)
 	case *AuthRequestMutation:
 		return c.AuthRequest.mutate(ctx, m)
+	case *ClientAssertionMutation:
+		return c.ClientAssertion.mutate(ctx, m)
 	case *ConnectorMutation:
 		return c.Connector.mutate(ctx, m)
+	case *ConsentMutation:
+		return c.Consent.mutate(ctx, m)
 	case *DeviceRequestMutation:
 		return c.DeviceRequest.mutate(ctx, m)
 	case *DeviceTokenMutation:
 		return c.DeviceToken.mutate(ctx, m)
+	case *DpopProofMutation:
+		return c.DpopProof.mutate(ctx, m)
+	case *InitialAccessTokenMutation:
+		return c.InitialAccessToken.mutate(ctx, m)
 	case *KeysMutation:
 		return c.Keys.mutate(ctx, m)
+	case *LogoutNotificationMutation:
+		return c.LogoutNotification.mutate(ctx, m)
 	case *OAuth2ClientMutation:
 		return c.OAuth2Client.mutate(ctx, m)
 	case *OfflineSessionMutation:
@@ -273,11 +337,148 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Password.mutate(ctx, m)
 	case *RefreshTokenMutation:
 		return c.RefreshToken.mutate(ctx, m)
+	case *ScopeMutation:
+		return c.Scope.mutate(ctx, m)
+	case *UserSessionMutation:
+		return c.UserSession.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("db: unknown mutation type %T", m)
 	}
 }
 
+// AccessTokenClient is a client for the AccessToken schema.
+type AccessTokenClient struct {
+	config
+}
+
+// NewAccessTokenClient returns a client for the AccessToken from the given config.
+func NewAccessTokenClient(c config) *AccessTokenClient {
+	return &AccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accesstoken.Hooks(f(g(h())))`.
+func (c *AccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.AccessToken = append(c.hooks.AccessToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accesstoken.Intercept(f(g(h())))`.
+func (c *AccessTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessToken = append(c.inters.AccessToken, interceptors...)
+}
+
+// Create returns a builder for creating a AccessToken entity.
+func (c *AccessTokenClient) Create() *AccessTokenCreate {
+	mutation := newAccessTokenMutation(c.config, OpCreate)
+	return &AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessToken entities.
+func (c *AccessTokenClient) CreateBulk(builders ...*AccessTokenCreate) *AccessTokenCreateBulk {
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessTokenClient) MapCreateBulk(slice any, setFunc func(*AccessTokenCreate, int)) *AccessTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessTokenCreateBulk{err: fmt.Errorf("calling to AccessTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessToken.
+func (c *AccessTokenClient) Update() *AccessTokenUpdate {
+	mutation := newAccessTokenMutation(c.config, OpUpdate)
+	return &AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessTokenClient) UpdateOne(_m *AccessToken) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessToken(_m))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessTokenClient) UpdateOneID(id string) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessTokenID(id))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessToken.
+func (c *AccessTokenClient) Delete() *AccessTokenDelete {
+	mutation := newAccessTokenMutation(c.config, OpDelete)
+	return &AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessTokenClient) DeleteOne(_m *AccessToken) *AccessTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessTokenClient) DeleteOneID(id string) *AccessTokenDeleteOne {
+	builder := c.Delete().Where(accesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessToken.
+func (c *AccessTokenClient) Query() *AccessTokenQuery {
+	return &AccessTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessToken entity by its id.
+func (c *AccessTokenClient) Get(ctx context.Context, id string) (*AccessToken, error) {
+	return c.Query().Where(accesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessTokenClient) GetX(ctx context.Context, id string) *AccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccessTokenClient) Hooks() []Hook {
+	return c.hooks.AccessToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessTokenClient) Interceptors() []Interceptor {
+	return c.inters.AccessToken
+}
+
+func (c *AccessTokenClient) mutate(ctx context.Context, m *AccessTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown AccessToken mutation op: %q", m.Op())
+	}
+}
+
 // AuthCodeClient is a client for the AuthCode schema.
 type AuthCodeClient struct {
 	config
@@ -544,6 +745,139 @@ func (c *AuthRequestClient) mutate(ctx context.Context, m *AuthRequestMutation)
 	}
 }
 
+// ClientAssertionClient is a client for the ClientAssertion schema.
+type ClientAssertionClient struct {
+	config
+}
+
+// NewClientAssertionClient returns a client for the ClientAssertion from the given config.
+func NewClientAssertionClient(c config) *ClientAssertionClient {
+	return &ClientAssertionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clientassertion.Hooks(f(g(h())))`.
+func (c *ClientAssertionClient) Use(hooks ...Hook) {
+	c.hooks.ClientAssertion = append(c.hooks.ClientAssertion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `clientassertion.Intercept(f(g(h())))`.
+func (c *ClientAssertionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientAssertion = append(c.inters.ClientAssertion, interceptors...)
+}
+
+// Create returns a builder for creating a ClientAssertion entity.
+func (c *ClientAssertionClient) Create() *ClientAssertionCreate {
+	mutation := newClientAssertionMutation(c.config, OpCreate)
+	return &ClientAssertionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClientAssertion entities.
+func (c *ClientAssertionClient) CreateBulk(builders ...*ClientAssertionCreate) *ClientAssertionCreateBulk {
+	return &ClientAssertionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClientAssertionClient) MapCreateBulk(slice any, setFunc func(*ClientAssertionCreate, int)) *ClientAssertionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClientAssertionCreateBulk{err: fmt.Errorf("calling to ClientAssertionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClientAssertionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClientAssertionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClientAssertion.
+func (c *ClientAssertionClient) Update() *ClientAssertionUpdate {
+	mutation := newClientAssertionMutation(c.config, OpUpdate)
+	return &ClientAssertionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClientAssertionClient) UpdateOne(_m *ClientAssertion) *ClientAssertionUpdateOne {
+	mutation := newClientAssertionMutation(c.config, OpUpdateOne, withClientAssertion(_m))
+	return &ClientAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClientAssertionClient) UpdateOneID(id string) *ClientAssertionUpdateOne {
+	mutation := newClientAssertionMutation(c.config, OpUpdateOne, withClientAssertionID(id))
+	return &ClientAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClientAssertion.
+func (c *ClientAssertionClient) Delete() *ClientAssertionDelete {
+	mutation := newClientAssertionMutation(c.config, OpDelete)
+	return &ClientAssertionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClientAssertionClient) DeleteOne(_m *ClientAssertion) *ClientAssertionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClientAssertionClient) DeleteOneID(id string) *ClientAssertionDeleteOne {
+	builder := c.Delete().Where(clientassertion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClientAssertionDeleteOne{builder}
+}
+
+// Query returns a query builder for ClientAssertion.
+func (c *ClientAssertionClient) Query() *ClientAssertionQuery {
+	return &ClientAssertionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClientAssertion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClientAssertion entity by its id.
+func (c *ClientAssertionClient) Get(ctx context.Context, id string) (*ClientAssertion, error) {
+	return c.Query().Where(clientassertion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClientAssertionClient) GetX(ctx context.Context, id string) *ClientAssertion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClientAssertionClient) Hooks() []Hook {
+	return c.hooks.ClientAssertion
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClientAssertionClient) Interceptors() []Interceptor {
+	return c.inters.ClientAssertion
+}
+
+func (c *ClientAssertionClient) mutate(ctx context.Context, m *ClientAssertionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClientAssertionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClientAssertionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClientAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClientAssertionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ClientAssertion mutation op: %q", m.Op())
+	}
+}
+
 // ConnectorClient is a client for the Connector schema.
 type ConnectorClient struct {
 	config
@@ -677,6 +1011,139 @@ func (c *ConnectorClient) mutate(ctx context.Context, m *ConnectorMutation) (Val
 	}
 }
 
+// ConsentClient is a client for the Consent schema.
+type ConsentClient struct {
+	config
+}
+
+// NewConsentClient returns a client for the Consent from the given config.
+func NewConsentClient(c config) *ConsentClient {
+	return &ConsentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `consent.Hooks(f(g(h())))`.
+func (c *ConsentClient) Use(hooks ...Hook) {
+	c.hooks.Consent = append(c.hooks.Consent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `consent.Intercept(f(g(h())))`.
+func (c *ConsentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Consent = append(c.inters.Consent, interceptors...)
+}
+
+// Create returns a builder for creating a Consent entity.
+func (c *ConsentClient) Create() *ConsentCreate {
+	mutation := newConsentMutation(c.config, OpCreate)
+	return &ConsentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Consent entities.
+func (c *ConsentClient) CreateBulk(builders ...*ConsentCreate) *ConsentCreateBulk {
+	return &ConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConsentClient) MapCreateBulk(slice any, setFunc func(*ConsentCreate, int)) *ConsentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConsentCreateBulk{err: fmt.Errorf("calling to ConsentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConsentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Consent.
+func (c *ConsentClient) Update() *ConsentUpdate {
+	mutation := newConsentMutation(c.config, OpUpdate)
+	return &ConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConsentClient) UpdateOne(_m *Consent) *ConsentUpdateOne {
+	mutation := newConsentMutation(c.config, OpUpdateOne, withConsent(_m))
+	return &ConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConsentClient) UpdateOneID(id string) *ConsentUpdateOne {
+	mutation := newConsentMutation(c.config, OpUpdateOne, withConsentID(id))
+	return &ConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Consent.
+func (c *ConsentClient) Delete() *ConsentDelete {
+	mutation := newConsentMutation(c.config, OpDelete)
+	return &ConsentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConsentClient) DeleteOne(_m *Consent) *ConsentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConsentClient) DeleteOneID(id string) *ConsentDeleteOne {
+	builder := c.Delete().Where(consent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConsentDeleteOne{builder}
+}
+
+// Query returns a query builder for Consent.
+func (c *ConsentClient) Query() *ConsentQuery {
+	return &ConsentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConsent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Consent entity by its id.
+func (c *ConsentClient) Get(ctx context.Context, id string) (*Consent, error) {
+	return c.Query().Where(consent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConsentClient) GetX(ctx context.Context, id string) *Consent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConsentClient) Hooks() []Hook {
+	return c.hooks.Consent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConsentClient) Interceptors() []Interceptor {
+	return c.inters.Consent
+}
+
+func (c *ConsentClient) mutate(ctx context.Context, m *ConsentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConsentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConsentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Consent mutation op: %q", m.Op())
+	}
+}
+
 // DeviceRequestClient is a client for the DeviceRequest schema.
 type DeviceRequestClient struct {
 	config
@@ -871,46 +1338,312 @@ func (c *DeviceTokenClient) UpdateOne(_m *DeviceToken) *DeviceTokenUpdateOne {
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeviceTokenClient) UpdateOneID(id int) *DeviceTokenUpdateOne {
-	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceTokenID(id))
-	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceTokenClient) UpdateOneID(id int) *DeviceTokenUpdateOne {
+	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceTokenID(id))
+	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceToken.
+func (c *DeviceTokenClient) Delete() *DeviceTokenDelete {
+	mutation := newDeviceTokenMutation(c.config, OpDelete)
+	return &DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceTokenClient) DeleteOne(_m *DeviceToken) *DeviceTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceTokenClient) DeleteOneID(id int) *DeviceTokenDeleteOne {
+	builder := c.Delete().Where(devicetoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceToken.
+func (c *DeviceTokenClient) Query() *DeviceTokenQuery {
+	return &DeviceTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceToken entity by its id.
+func (c *DeviceTokenClient) Get(ctx context.Context, id int) (*DeviceToken, error) {
+	return c.Query().Where(devicetoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceTokenClient) GetX(ctx context.Context, id int) *DeviceToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceTokenClient) Hooks() []Hook {
+	return c.hooks.DeviceToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceTokenClient) Interceptors() []Interceptor {
+	return c.inters.DeviceToken
+}
+
+func (c *DeviceTokenClient) mutate(ctx context.Context, m *DeviceTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown DeviceToken mutation op: %q", m.Op())
+	}
+}
+
+// DpopProofClient is a client for the DpopProof schema.
+type DpopProofClient struct {
+	config
+}
+
+// NewDpopProofClient returns a client for the DpopProof from the given config.
+func NewDpopProofClient(c config) *DpopProofClient {
+	return &DpopProofClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dpopproof.Hooks(f(g(h())))`.
+func (c *DpopProofClient) Use(hooks ...Hook) {
+	c.hooks.DpopProof = append(c.hooks.DpopProof, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dpopproof.Intercept(f(g(h())))`.
+func (c *DpopProofClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DpopProof = append(c.inters.DpopProof, interceptors...)
+}
+
+// Create returns a builder for creating a DpopProof entity.
+func (c *DpopProofClient) Create() *DpopProofCreate {
+	mutation := newDpopProofMutation(c.config, OpCreate)
+	return &DpopProofCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DpopProof entities.
+func (c *DpopProofClient) CreateBulk(builders ...*DpopProofCreate) *DpopProofCreateBulk {
+	return &DpopProofCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DpopProofClient) MapCreateBulk(slice any, setFunc func(*DpopProofCreate, int)) *DpopProofCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DpopProofCreateBulk{err: fmt.Errorf("calling to DpopProofClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DpopProofCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DpopProofCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DpopProof.
+func (c *DpopProofClient) Update() *DpopProofUpdate {
+	mutation := newDpopProofMutation(c.config, OpUpdate)
+	return &DpopProofUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DpopProofClient) UpdateOne(_m *DpopProof) *DpopProofUpdateOne {
+	mutation := newDpopProofMutation(c.config, OpUpdateOne, withDpopProof(_m))
+	return &DpopProofUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DpopProofClient) UpdateOneID(id string) *DpopProofUpdateOne {
+	mutation := newDpopProofMutation(c.config, OpUpdateOne, withDpopProofID(id))
+	return &DpopProofUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DpopProof.
+func (c *DpopProofClient) Delete() *DpopProofDelete {
+	mutation := newDpopProofMutation(c.config, OpDelete)
+	return &DpopProofDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DpopProofClient) DeleteOne(_m *DpopProof) *DpopProofDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DpopProofClient) DeleteOneID(id string) *DpopProofDeleteOne {
+	builder := c.Delete().Where(dpopproof.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DpopProofDeleteOne{builder}
+}
+
+// Query returns a query builder for DpopProof.
+func (c *DpopProofClient) Query() *DpopProofQuery {
+	return &DpopProofQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDpopProof},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DpopProof entity by its id.
+func (c *DpopProofClient) Get(ctx context.Context, id string) (*DpopProof, error) {
+	return c.Query().Where(dpopproof.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DpopProofClient) GetX(ctx context.Context, id string) *DpopProof {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DpopProofClient) Hooks() []Hook {
+	return c.hooks.DpopProof
+}
+
+// Interceptors returns the client interceptors.
+func (c *DpopProofClient) Interceptors() []Interceptor {
+	return c.inters.DpopProof
+}
+
+func (c *DpopProofClient) mutate(ctx context.Context, m *DpopProofMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DpopProofCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DpopProofUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DpopProofUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DpopProofDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown DpopProof mutation op: %q", m.Op())
+	}
+}
+
+// InitialAccessTokenClient is a client for the InitialAccessToken schema.
+type InitialAccessTokenClient struct {
+	config
+}
+
+// NewInitialAccessTokenClient returns a client for the InitialAccessToken from the given config.
+func NewInitialAccessTokenClient(c config) *InitialAccessTokenClient {
+	return &InitialAccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `initialaccesstoken.Hooks(f(g(h())))`.
+func (c *InitialAccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.InitialAccessToken = append(c.hooks.InitialAccessToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `initialaccesstoken.Intercept(f(g(h())))`.
+func (c *InitialAccessTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InitialAccessToken = append(c.inters.InitialAccessToken, interceptors...)
+}
+
+// Create returns a builder for creating a InitialAccessToken entity.
+func (c *InitialAccessTokenClient) Create() *InitialAccessTokenCreate {
+	mutation := newInitialAccessTokenMutation(c.config, OpCreate)
+	return &InitialAccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InitialAccessToken entities.
+func (c *InitialAccessTokenClient) CreateBulk(builders ...*InitialAccessTokenCreate) *InitialAccessTokenCreateBulk {
+	return &InitialAccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InitialAccessTokenClient) MapCreateBulk(slice any, setFunc func(*InitialAccessTokenCreate, int)) *InitialAccessTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InitialAccessTokenCreateBulk{err: fmt.Errorf("calling to InitialAccessTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InitialAccessTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InitialAccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InitialAccessToken.
+func (c *InitialAccessTokenClient) Update() *InitialAccessTokenUpdate {
+	mutation := newInitialAccessTokenMutation(c.config, OpUpdate)
+	return &InitialAccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InitialAccessTokenClient) UpdateOne(_m *InitialAccessToken) *InitialAccessTokenUpdateOne {
+	mutation := newInitialAccessTokenMutation(c.config, OpUpdateOne, withInitialAccessToken(_m))
+	return &InitialAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InitialAccessTokenClient) UpdateOneID(id string) *InitialAccessTokenUpdateOne {
+	mutation := newInitialAccessTokenMutation(c.config, OpUpdateOne, withInitialAccessTokenID(id))
+	return &InitialAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeviceToken.
-func (c *DeviceTokenClient) Delete() *DeviceTokenDelete {
-	mutation := newDeviceTokenMutation(c.config, OpDelete)
-	return &DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InitialAccessToken.
+func (c *InitialAccessTokenClient) Delete() *InitialAccessTokenDelete {
+	mutation := newInitialAccessTokenMutation(c.config, OpDelete)
+	return &InitialAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeviceTokenClient) DeleteOne(_m *DeviceToken) *DeviceTokenDeleteOne {
+func (c *InitialAccessTokenClient) DeleteOne(_m *InitialAccessToken) *InitialAccessTokenDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeviceTokenClient) DeleteOneID(id int) *DeviceTokenDeleteOne {
-	builder := c.Delete().Where(devicetoken.ID(id))
+func (c *InitialAccessTokenClient) DeleteOneID(id string) *InitialAccessTokenDeleteOne {
+	builder := c.Delete().Where(initialaccesstoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeviceTokenDeleteOne{builder}
+	return &InitialAccessTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for DeviceToken.
-func (c *DeviceTokenClient) Query() *DeviceTokenQuery {
-	return &DeviceTokenQuery{
+// Query returns a query builder for InitialAccessToken.
+func (c *InitialAccessTokenClient) Query() *InitialAccessTokenQuery {
+	return &InitialAccessTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeviceToken},
+		ctx:    &QueryContext{Type: TypeInitialAccessToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeviceToken entity by its id.
-func (c *DeviceTokenClient) Get(ctx context.Context, id int) (*DeviceToken, error) {
-	return c.Query().Where(devicetoken.ID(id)).Only(ctx)
+// Get returns a InitialAccessToken entity by its id.
+func (c *InitialAccessTokenClient) Get(ctx context.Context, id string) (*InitialAccessToken, error) {
+	return c.Query().Where(initialaccesstoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeviceTokenClient) GetX(ctx context.Context, id int) *DeviceToken {
+func (c *InitialAccessTokenClient) GetX(ctx context.Context, id string) *InitialAccessToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -919,27 +1652,27 @@ func (c *DeviceTokenClient) GetX(ctx context.Context, id int) *DeviceToken {
 }
 
 // Hooks returns the client hooks.
-func (c *DeviceTokenClient) Hooks() []Hook {
-	return c.hooks.DeviceToken
+func (c *InitialAccessTokenClient) Hooks() []Hook {
+	return c.hooks.InitialAccessToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeviceTokenClient) Interceptors() []Interceptor {
-	return c.inters.DeviceToken
+func (c *InitialAccessTokenClient) Interceptors() []Interceptor {
+	return c.inters.InitialAccessToken
 }
 
-func (c *DeviceTokenClient) mutate(ctx context.Context, m *DeviceTokenMutation) (Value, error) {
+func (c *InitialAccessTokenClient) mutate(ctx context.Context, m *InitialAccessTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InitialAccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InitialAccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InitialAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InitialAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("db: unknown DeviceToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("db: unknown InitialAccessToken mutation op: %q", m.Op())
 	}
 }
 
@@ -1076,6 +1809,139 @@ func (c *KeysClient) mutate(ctx context.Context, m *KeysMutation) (Value, error)
 	}
 }
 
+// LogoutNotificationClient is a client for the LogoutNotification schema.
+type LogoutNotificationClient struct {
+	config
+}
+
+// NewLogoutNotificationClient returns a client for the LogoutNotification from the given config.
+func NewLogoutNotificationClient(c config) *LogoutNotificationClient {
+	return &LogoutNotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `logoutnotification.Hooks(f(g(h())))`.
+func (c *LogoutNotificationClient) Use(hooks ...Hook) {
+	c.hooks.LogoutNotification = append(c.hooks.LogoutNotification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `logoutnotification.Intercept(f(g(h())))`.
+func (c *LogoutNotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LogoutNotification = append(c.inters.LogoutNotification, interceptors...)
+}
+
+// Create returns a builder for creating a LogoutNotification entity.
+func (c *LogoutNotificationClient) Create() *LogoutNotificationCreate {
+	mutation := newLogoutNotificationMutation(c.config, OpCreate)
+	return &LogoutNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LogoutNotification entities.
+func (c *LogoutNotificationClient) CreateBulk(builders ...*LogoutNotificationCreate) *LogoutNotificationCreateBulk {
+	return &LogoutNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LogoutNotificationClient) MapCreateBulk(slice any, setFunc func(*LogoutNotificationCreate, int)) *LogoutNotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LogoutNotificationCreateBulk{err: fmt.Errorf("calling to LogoutNotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LogoutNotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LogoutNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LogoutNotification.
+func (c *LogoutNotificationClient) Update() *LogoutNotificationUpdate {
+	mutation := newLogoutNotificationMutation(c.config, OpUpdate)
+	return &LogoutNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LogoutNotificationClient) UpdateOne(_m *LogoutNotification) *LogoutNotificationUpdateOne {
+	mutation := newLogoutNotificationMutation(c.config, OpUpdateOne, withLogoutNotification(_m))
+	return &LogoutNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LogoutNotificationClient) UpdateOneID(id string) *LogoutNotificationUpdateOne {
+	mutation := newLogoutNotificationMutation(c.config, OpUpdateOne, withLogoutNotificationID(id))
+	return &LogoutNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LogoutNotification.
+func (c *LogoutNotificationClient) Delete() *LogoutNotificationDelete {
+	mutation := newLogoutNotificationMutation(c.config, OpDelete)
+	return &LogoutNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LogoutNotificationClient) DeleteOne(_m *LogoutNotification) *LogoutNotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LogoutNotificationClient) DeleteOneID(id string) *LogoutNotificationDeleteOne {
+	builder := c.Delete().Where(logoutnotification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LogoutNotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for LogoutNotification.
+func (c *LogoutNotificationClient) Query() *LogoutNotificationQuery {
+	return &LogoutNotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLogoutNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LogoutNotification entity by its id.
+func (c *LogoutNotificationClient) Get(ctx context.Context, id string) (*LogoutNotification, error) {
+	return c.Query().Where(logoutnotification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LogoutNotificationClient) GetX(ctx context.Context, id string) *LogoutNotification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LogoutNotificationClient) Hooks() []Hook {
+	return c.hooks.LogoutNotification
+}
+
+// Interceptors returns the client interceptors.
+func (c *LogoutNotificationClient) Interceptors() []Interceptor {
+	return c.inters.LogoutNotification
+}
+
+func (c *LogoutNotificationClient) mutate(ctx context.Context, m *LogoutNotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LogoutNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LogoutNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LogoutNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LogoutNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown LogoutNotification mutation op: %q", m.Op())
+	}
+}
+
 // OAuth2ClientClient is a client for the OAuth2Client schema.
 type OAuth2ClientClient struct {
 	config
@@ -1608,14 +2474,284 @@ func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation
 	}
 }
 
+// ScopeClient is a client for the Scope schema.
+type ScopeClient struct {
+	config
+}
+
+// NewScopeClient returns a client for the Scope from the given config.
+func NewScopeClient(c config) *ScopeClient {
+	return &ScopeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scope.Hooks(f(g(h())))`.
+func (c *ScopeClient) Use(hooks ...Hook) {
+	c.hooks.Scope = append(c.hooks.Scope, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scope.Intercept(f(g(h())))`.
+func (c *ScopeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Scope = append(c.inters.Scope, interceptors...)
+}
+
+// Create returns a builder for creating a Scope entity.
+func (c *ScopeClient) Create() *ScopeCreate {
+	mutation := newScopeMutation(c.config, OpCreate)
+	return &ScopeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Scope entities.
+func (c *ScopeClient) CreateBulk(builders ...*ScopeCreate) *ScopeCreateBulk {
+	return &ScopeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScopeClient) MapCreateBulk(slice any, setFunc func(*ScopeCreate, int)) *ScopeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScopeCreateBulk{err: fmt.Errorf("calling to ScopeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScopeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScopeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Scope.
+func (c *ScopeClient) Update() *ScopeUpdate {
+	mutation := newScopeMutation(c.config, OpUpdate)
+	return &ScopeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScopeClient) UpdateOne(_m *Scope) *ScopeUpdateOne {
+	mutation := newScopeMutation(c.config, OpUpdateOne, withScope(_m))
+	return &ScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScopeClient) UpdateOneID(id string) *ScopeUpdateOne {
+	mutation := newScopeMutation(c.config, OpUpdateOne, withScopeID(id))
+	return &ScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Scope.
+func (c *ScopeClient) Delete() *ScopeDelete {
+	mutation := newScopeMutation(c.config, OpDelete)
+	return &ScopeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScopeClient) DeleteOne(_m *Scope) *ScopeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScopeClient) DeleteOneID(id string) *ScopeDeleteOne {
+	builder := c.Delete().Where(scope.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScopeDeleteOne{builder}
+}
+
+// Query returns a query builder for Scope.
+func (c *ScopeClient) Query() *ScopeQuery {
+	return &ScopeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScope},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Scope entity by its id.
+func (c *ScopeClient) Get(ctx context.Context, id string) (*Scope, error) {
+	return c.Query().Where(scope.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScopeClient) GetX(ctx context.Context, id string) *Scope {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScopeClient) Hooks() []Hook {
+	return c.hooks.Scope
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScopeClient) Interceptors() []Interceptor {
+	return c.inters.Scope
+}
+
+func (c *ScopeClient) mutate(ctx context.Context, m *ScopeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScopeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScopeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScopeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Scope mutation op: %q", m.Op())
+	}
+}
+
+// UserSessionClient is a client for the UserSession schema.
+type UserSessionClient struct {
+	config
+}
+
+// NewUserSessionClient returns a client for the UserSession from the given config.
+func NewUserSessionClient(c config) *UserSessionClient {
+	return &UserSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usersession.Hooks(f(g(h())))`.
+func (c *UserSessionClient) Use(hooks ...Hook) {
+	c.hooks.UserSession = append(c.hooks.UserSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usersession.Intercept(f(g(h())))`.
+func (c *UserSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserSession = append(c.inters.UserSession, interceptors...)
+}
+
+// Create returns a builder for creating a UserSession entity.
+func (c *UserSessionClient) Create() *UserSessionCreate {
+	mutation := newUserSessionMutation(c.config, OpCreate)
+	return &UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserSession entities.
+func (c *UserSessionClient) CreateBulk(builders ...*UserSessionCreate) *UserSessionCreateBulk {
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserSessionClient) MapCreateBulk(slice any, setFunc func(*UserSessionCreate, int)) *UserSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserSessionCreateBulk{err: fmt.Errorf("calling to UserSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserSession.
+func (c *UserSessionClient) Update() *UserSessionUpdate {
+	mutation := newUserSessionMutation(c.config, OpUpdate)
+	return &UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserSessionClient) UpdateOne(_m *UserSession) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSession(_m))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserSessionClient) UpdateOneID(id string) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSessionID(id))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserSession.
+func (c *UserSessionClient) Delete() *UserSessionDelete {
+	mutation := newUserSessionMutation(c.config, OpDelete)
+	return &UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserSessionClient) DeleteOne(_m *UserSession) *UserSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserSessionClient) DeleteOneID(id string) *UserSessionDeleteOne {
+	builder := c.Delete().Where(usersession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UserSession.
+func (c *UserSessionClient) Query() *UserSessionQuery {
+	return &UserSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserSession entity by its id.
+func (c *UserSessionClient) Get(ctx context.Context, id string) (*UserSession, error) {
+	return c.Query().Where(usersession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserSessionClient) GetX(ctx context.Context, id string) *UserSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserSessionClient) Hooks() []Hook {
+	return c.hooks.UserSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserSessionClient) Interceptors() []Interceptor {
+	return c.inters.UserSession
+}
+
+func (c *UserSessionClient) mutate(ctx context.Context, m *UserSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown UserSession mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuthCode, AuthRequest, Connector, DeviceRequest, DeviceToken, Keys,
-		OAuth2Client, OfflineSession, Password, RefreshToken []ent.Hook
+		AccessToken, AuthCode, AuthRequest, ClientAssertion, Connector, Consent,
+		DeviceRequest, DeviceToken, DpopProof, InitialAccessToken, Keys,
+		LogoutNotification, OAuth2Client, OfflineSession, Password, RefreshToken,
+		Scope, UserSession []ent.Hook
 	}
 	inters struct {
-		AuthCode, AuthRequest, Connector, DeviceRequest, DeviceToken, Keys,
-		OAuth2Client, OfflineSession, Password, RefreshToken []ent.Interceptor
+		AccessToken, AuthCode, AuthRequest, ClientAssertion, Connector, Consent,
+		DeviceRequest, DeviceToken, DpopProof, InitialAccessToken, Keys,
+		LogoutNotification, OAuth2Client, OfflineSession, Password, RefreshToken,
+		Scope, UserSession []ent.Interceptor
 	}
 )
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
+)
+
+// ClientAssertion is the model entity for the ClientAssertion schema.
+type ClientAssertion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry       time.Time `json:"expiry,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientAssertion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientassertion.FieldID, clientassertion.FieldClientID:
+			values[i] = new(sql.NullString)
+		case clientassertion.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientAssertion fields.
+func (_m *ClientAssertion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientassertion.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case clientassertion.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case clientassertion.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				_m.Expiry = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientAssertion.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClientAssertion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClientAssertion.
+// Note that you need to call ClientAssertion.Unwrap() before calling this method if this ClientAssertion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClientAssertion) Update() *ClientAssertionUpdateOne {
+	return NewClientAssertionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClientAssertion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClientAssertion) Unwrap() *ClientAssertion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ClientAssertion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClientAssertion) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientAssertion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("expiry=")
+	builder.WriteString(_m.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientAssertions is a parsable slice of ClientAssertion.
+type ClientAssertions []*ClientAssertion
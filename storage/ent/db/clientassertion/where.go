@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientassertion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldContainsFold(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEQ(FieldClientID, v))
+}
+
+// Expiry applies equality check predicate on the "expiry" field. It's identical to ExpiryEQ.
+func Expiry(v time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEQ(FieldExpiry, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ExpiryEQ applies the EQ predicate on the "expiry" field.
+func ExpiryEQ(v time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldEQ(FieldExpiry, v))
+}
+
+// ExpiryNEQ applies the NEQ predicate on the "expiry" field.
+func ExpiryNEQ(v time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldNEQ(FieldExpiry, v))
+}
+
+// ExpiryIn applies the In predicate on the "expiry" field.
+func ExpiryIn(vs ...time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldIn(FieldExpiry, vs...))
+}
+
+// ExpiryNotIn applies the NotIn predicate on the "expiry" field.
+func ExpiryNotIn(vs ...time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldNotIn(FieldExpiry, vs...))
+}
+
+// ExpiryGT applies the GT predicate on the "expiry" field.
+func ExpiryGT(v time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldGT(FieldExpiry, v))
+}
+
+// ExpiryGTE applies the GTE predicate on the "expiry" field.
+func ExpiryGTE(v time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldGTE(FieldExpiry, v))
+}
+
+// ExpiryLT applies the LT predicate on the "expiry" field.
+func ExpiryLT(v time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldLT(FieldExpiry, v))
+}
+
+// ExpiryLTE applies the LTE predicate on the "expiry" field.
+func ExpiryLTE(v time.Time) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.FieldLTE(FieldExpiry, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClientAssertion) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClientAssertion) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClientAssertion) predicate.ClientAssertion {
+	return predicate.ClientAssertion(sql.NotPredicates(p))
+}
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
+)
+
+// ClientAssertionCreate is the builder for creating a ClientAssertion entity.
+type ClientAssertionCreate struct {
+	config
+	mutation *ClientAssertionMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *ClientAssertionCreate) SetClientID(v string) *ClientAssertionCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *ClientAssertionCreate) SetExpiry(v time.Time) *ClientAssertionCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ClientAssertionCreate) SetID(v string) *ClientAssertionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ClientAssertionMutation object of the builder.
+func (_c *ClientAssertionCreate) Mutation() *ClientAssertionMutation {
+	return _c.mutation
+}
+
+// Save creates the ClientAssertion in the database.
+func (_c *ClientAssertionCreate) Save(ctx context.Context) (*ClientAssertion, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClientAssertionCreate) SaveX(ctx context.Context) *ClientAssertion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientAssertionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientAssertionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClientAssertionCreate) check() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`db: missing required field "ClientAssertion.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := clientassertion.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "ClientAssertion.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "ClientAssertion.expiry"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := clientassertion.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "ClientAssertion.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ClientAssertionCreate) sqlSave(ctx context.Context) (*ClientAssertion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ClientAssertion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClientAssertionCreate) createSpec() (*ClientAssertion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientAssertion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(clientassertion.Table, sqlgraph.NewFieldSpec(clientassertion.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(clientassertion.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(clientassertion.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// ClientAssertionCreateBulk is the builder for creating many ClientAssertion entities in bulk.
+type ClientAssertionCreateBulk struct {
+	config
+	err      error
+	builders []*ClientAssertionCreate
+}
+
+// Save creates the ClientAssertion entities in the database.
+func (_c *ClientAssertionCreateBulk) Save(ctx context.Context) ([]*ClientAssertion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClientAssertion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientAssertionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClientAssertionCreateBulk) SaveX(ctx context.Context) []*ClientAssertion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientAssertionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientAssertionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ClientAssertionUpdate is the builder for updating ClientAssertion entities.
+type ClientAssertionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClientAssertionMutation
+}
+
+// Where appends a list predicates to the ClientAssertionUpdate builder.
+func (_u *ClientAssertionUpdate) Where(ps ...predicate.ClientAssertion) *ClientAssertionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *ClientAssertionUpdate) SetClientID(v string) *ClientAssertionUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *ClientAssertionUpdate) SetNillableClientID(v *string) *ClientAssertionUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *ClientAssertionUpdate) SetExpiry(v time.Time) *ClientAssertionUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *ClientAssertionUpdate) SetNillableExpiry(v *time.Time) *ClientAssertionUpdate {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ClientAssertionMutation object of the builder.
+func (_u *ClientAssertionUpdate) Mutation() *ClientAssertionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClientAssertionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientAssertionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClientAssertionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientAssertionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientAssertionUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := clientassertion.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "ClientAssertion.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClientAssertionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientassertion.Table, clientassertion.Columns, sqlgraph.NewFieldSpec(clientassertion.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(clientassertion.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(clientassertion.FieldExpiry, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientassertion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClientAssertionUpdateOne is the builder for updating a single ClientAssertion entity.
+type ClientAssertionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClientAssertionMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *ClientAssertionUpdateOne) SetClientID(v string) *ClientAssertionUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *ClientAssertionUpdateOne) SetNillableClientID(v *string) *ClientAssertionUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *ClientAssertionUpdateOne) SetExpiry(v time.Time) *ClientAssertionUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *ClientAssertionUpdateOne) SetNillableExpiry(v *time.Time) *ClientAssertionUpdateOne {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ClientAssertionMutation object of the builder.
+func (_u *ClientAssertionUpdateOne) Mutation() *ClientAssertionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ClientAssertionUpdate builder.
+func (_u *ClientAssertionUpdateOne) Where(ps ...predicate.ClientAssertion) *ClientAssertionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClientAssertionUpdateOne) Select(field string, fields ...string) *ClientAssertionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClientAssertion entity.
+func (_u *ClientAssertionUpdateOne) Save(ctx context.Context) (*ClientAssertion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientAssertionUpdateOne) SaveX(ctx context.Context) *ClientAssertion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClientAssertionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientAssertionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientAssertionUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := clientassertion.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "ClientAssertion.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClientAssertionUpdateOne) sqlSave(ctx context.Context) (_node *ClientAssertion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientassertion.Table, clientassertion.Columns, sqlgraph.NewFieldSpec(clientassertion.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ClientAssertion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientassertion.FieldID)
+		for _, f := range fields {
+			if !clientassertion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != clientassertion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(clientassertion.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(clientassertion.FieldExpiry, field.TypeTime, value)
+	}
+	_node = &ClientAssertion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientassertion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/consent"
+)
+
+// ConsentCreate is the builder for creating a Consent entity.
+type ConsentCreate struct {
+	config
+	mutation *ConsentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ConsentCreate) SetUserID(v string) *ConsentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetConnID sets the "conn_id" field.
+func (_c *ConsentCreate) SetConnID(v string) *ConsentCreate {
+	_c.mutation.SetConnID(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *ConsentCreate) SetClientID(v string) *ConsentCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *ConsentCreate) SetScopes(v []string) *ConsentCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConsentCreate) SetCreatedAt(v time.Time) *ConsentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConsentCreate) SetUpdatedAt(v time.Time) *ConsentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConsentCreate) SetID(v string) *ConsentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ConsentMutation object of the builder.
+func (_c *ConsentCreate) Mutation() *ConsentMutation {
+	return _c.mutation
+}
+
+// Save creates the Consent in the database.
+func (_c *ConsentCreate) Save(ctx context.Context) (*Consent, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConsentCreate) SaveX(ctx context.Context) *Consent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConsentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConsentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConsentCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`db: missing required field "Consent.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := consent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "Consent.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConnID(); !ok {
+		return &ValidationError{Name: "conn_id", err: errors.New(`db: missing required field "Consent.conn_id"`)}
+	}
+	if v, ok := _c.mutation.ConnID(); ok {
+		if err := consent.ConnIDValidator(v); err != nil {
+			return &ValidationError{Name: "conn_id", err: fmt.Errorf(`db: validator failed for field "Consent.conn_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`db: missing required field "Consent.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := consent.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "Consent.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "Consent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "Consent.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := consent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "Consent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ConsentCreate) sqlSave(ctx context.Context) (*Consent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Consent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConsentCreate) createSpec() (*Consent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Consent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(consent.Table, sqlgraph.NewFieldSpec(consent.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(consent.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ConnID(); ok {
+		_spec.SetField(consent.FieldConnID, field.TypeString, value)
+		_node.ConnID = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(consent.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(consent.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(consent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(consent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConsentCreateBulk is the builder for creating many Consent entities in bulk.
+type ConsentCreateBulk struct {
+	config
+	err      error
+	builders []*ConsentCreate
+}
+
+// Save creates the Consent entities in the database.
+func (_c *ConsentCreateBulk) Save(ctx context.Context) ([]*Consent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Consent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConsentCreateBulk) SaveX(ctx context.Context) []*Consent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
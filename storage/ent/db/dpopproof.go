@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+)
+
+// DpopProof is the model entity for the DpopProof schema.
+type DpopProof struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry       time.Time `json:"expiry,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DpopProof) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dpopproof.FieldID:
+			values[i] = new(sql.NullString)
+		case dpopproof.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DpopProof fields.
+func (_m *DpopProof) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dpopproof.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case dpopproof.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				_m.Expiry = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DpopProof.
+// This includes values selected through modifiers, order, etc.
+func (_m *DpopProof) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DpopProof.
+// Note that you need to call DpopProof.Unwrap() before calling this method if this DpopProof
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DpopProof) Update() *DpopProofUpdateOne {
+	return NewDpopProofClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DpopProof entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DpopProof) Unwrap() *DpopProof {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: DpopProof is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DpopProof) String() string {
+	var builder strings.Builder
+	builder.WriteString("DpopProof(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("expiry=")
+	builder.WriteString(_m.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DpopProofs is a parsable slice of DpopProof.
+type DpopProofs []*DpopProof
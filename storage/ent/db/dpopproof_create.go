@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+)
+
+// DpopProofCreate is the builder for creating a DpopProof entity.
+type DpopProofCreate struct {
+	config
+	mutation *DpopProofMutation
+	hooks    []Hook
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *DpopProofCreate) SetExpiry(v time.Time) *DpopProofCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DpopProofCreate) SetID(v string) *DpopProofCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DpopProofMutation object of the builder.
+func (_c *DpopProofCreate) Mutation() *DpopProofMutation {
+	return _c.mutation
+}
+
+// Save creates the DpopProof in the database.
+func (_c *DpopProofCreate) Save(ctx context.Context) (*DpopProof, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DpopProofCreate) SaveX(ctx context.Context) *DpopProof {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DpopProofCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DpopProofCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DpopProofCreate) check() error {
+	if _, ok := _c.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "DpopProof.expiry"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := dpopproof.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "DpopProof.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DpopProofCreate) sqlSave(ctx context.Context) (*DpopProof, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DpopProof.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DpopProofCreate) createSpec() (*DpopProof, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DpopProof{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dpopproof.Table, sqlgraph.NewFieldSpec(dpopproof.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(dpopproof.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// DpopProofCreateBulk is the builder for creating many DpopProof entities in bulk.
+type DpopProofCreateBulk struct {
+	config
+	err      error
+	builders []*DpopProofCreate
+}
+
+// Save creates the DpopProof entities in the database.
+func (_c *DpopProofCreateBulk) Save(ctx context.Context) ([]*DpopProof, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DpopProof, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DpopProofMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DpopProofCreateBulk) SaveX(ctx context.Context) []*DpopProof {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DpopProofCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DpopProofCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
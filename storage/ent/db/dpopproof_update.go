@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// DpopProofUpdate is the builder for updating DpopProof entities.
+type DpopProofUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DpopProofMutation
+}
+
+// Where appends a list predicates to the DpopProofUpdate builder.
+func (_u *DpopProofUpdate) Where(ps ...predicate.DpopProof) *DpopProofUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *DpopProofUpdate) SetExpiry(v time.Time) *DpopProofUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *DpopProofUpdate) SetNillableExpiry(v *time.Time) *DpopProofUpdate {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DpopProofMutation object of the builder.
+func (_u *DpopProofUpdate) Mutation() *DpopProofMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DpopProofUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DpopProofUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DpopProofUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DpopProofUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DpopProofUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dpopproof.Table, dpopproof.Columns, sqlgraph.NewFieldSpec(dpopproof.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(dpopproof.FieldExpiry, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dpopproof.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DpopProofUpdateOne is the builder for updating a single DpopProof entity.
+type DpopProofUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DpopProofMutation
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *DpopProofUpdateOne) SetExpiry(v time.Time) *DpopProofUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *DpopProofUpdateOne) SetNillableExpiry(v *time.Time) *DpopProofUpdateOne {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DpopProofMutation object of the builder.
+func (_u *DpopProofUpdateOne) Mutation() *DpopProofMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DpopProofUpdate builder.
+func (_u *DpopProofUpdateOne) Where(ps ...predicate.DpopProof) *DpopProofUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DpopProofUpdateOne) Select(field string, fields ...string) *DpopProofUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DpopProof entity.
+func (_u *DpopProofUpdateOne) Save(ctx context.Context) (*DpopProof, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DpopProofUpdateOne) SaveX(ctx context.Context) *DpopProof {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DpopProofUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DpopProofUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DpopProofUpdateOne) sqlSave(ctx context.Context) (_node *DpopProof, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dpopproof.Table, dpopproof.Columns, sqlgraph.NewFieldSpec(dpopproof.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "DpopProof.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dpopproof.FieldID)
+		for _, f := range fields {
+			if !dpopproof.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != dpopproof.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(dpopproof.FieldExpiry, field.TypeTime, value)
+	}
+	_node = &DpopProof{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dpopproof.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,16 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
 	"github.com/dexidp/dex/storage/ent/db/connector"
+	"github.com/dexidp/dex/storage/ent/db/consent"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/scope"
+	"github.com/dexidp/dex/storage/ent/db/usersession"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -82,16 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			authcode.Table:       authcode.ValidColumn,
-			authrequest.Table:    authrequest.ValidColumn,
-			connector.Table:      connector.ValidColumn,
-			devicerequest.Table:  devicerequest.ValidColumn,
-			devicetoken.Table:    devicetoken.ValidColumn,
-			keys.Table:           keys.ValidColumn,
-			oauth2client.Table:   oauth2client.ValidColumn,
-			offlinesession.Table: offlinesession.ValidColumn,
-			password.Table:       password.ValidColumn,
-			refreshtoken.Table:   refreshtoken.ValidColumn,
+			accesstoken.Table:        accesstoken.ValidColumn,
+			authcode.Table:           authcode.ValidColumn,
+			authrequest.Table:        authrequest.ValidColumn,
+			clientassertion.Table:    clientassertion.ValidColumn,
+			connector.Table:          connector.ValidColumn,
+			consent.Table:            consent.ValidColumn,
+			devicerequest.Table:      devicerequest.ValidColumn,
+			devicetoken.Table:        devicetoken.ValidColumn,
+			dpopproof.Table:          dpopproof.ValidColumn,
+			initialaccesstoken.Table: initialaccesstoken.ValidColumn,
+			keys.Table:               keys.ValidColumn,
+			logoutnotification.Table: logoutnotification.ValidColumn,
+			oauth2client.Table:       oauth2client.ValidColumn,
+			offlinesession.Table:     offlinesession.ValidColumn,
+			password.Table:           password.ValidColumn,
+			refreshtoken.Table:       refreshtoken.ValidColumn,
+			scope.Table:              scope.ValidColumn,
+			usersession.Table:        usersession.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -9,6 +9,18 @@ import (
 	"github.com/dexidp/dex/storage/ent/db"
 )
 
+// The AccessTokenFunc type is an adapter to allow the use of ordinary
+// function as AccessToken mutator.
+type AccessTokenFunc func(context.Context, *db.AccessTokenMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccessTokenFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.AccessTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AccessTokenMutation", m)
+}
+
 // The AuthCodeFunc type is an adapter to allow the use of ordinary
 // function as AuthCode mutator.
 type AuthCodeFunc func(context.Context, *db.AuthCodeMutation) (db.Value, error)
@@ -33,6 +45,18 @@ func (f AuthRequestFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AuthRequestMutation", m)
 }
 
+// The ClientAssertionFunc type is an adapter to allow the use of ordinary
+// function as ClientAssertion mutator.
+type ClientAssertionFunc func(context.Context, *db.ClientAssertionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientAssertionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ClientAssertionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ClientAssertionMutation", m)
+}
+
 // The ConnectorFunc type is an adapter to allow the use of ordinary
 // function as Connector mutator.
 type ConnectorFunc func(context.Context, *db.ConnectorMutation) (db.Value, error)
@@ -45,6 +69,18 @@ func (f ConnectorFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ConnectorMutation", m)
 }
 
+// The ConsentFunc type is an adapter to allow the use of ordinary
+// function as Consent mutator.
+type ConsentFunc func(context.Context, *db.ConsentMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConsentFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ConsentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ConsentMutation", m)
+}
+
 // The DeviceRequestFunc type is an adapter to allow the use of ordinary
 // function as DeviceRequest mutator.
 type DeviceRequestFunc func(context.Context, *db.DeviceRequestMutation) (db.Value, error)
@@ -69,6 +105,30 @@ func (f DeviceTokenFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DeviceTokenMutation", m)
 }
 
+// The DpopProofFunc type is an adapter to allow the use of ordinary
+// function as DpopProof mutator.
+type DpopProofFunc func(context.Context, *db.DpopProofMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DpopProofFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.DpopProofMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DpopProofMutation", m)
+}
+
+// The InitialAccessTokenFunc type is an adapter to allow the use of ordinary
+// function as InitialAccessToken mutator.
+type InitialAccessTokenFunc func(context.Context, *db.InitialAccessTokenMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InitialAccessTokenFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.InitialAccessTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.InitialAccessTokenMutation", m)
+}
+
 // The KeysFunc type is an adapter to allow the use of ordinary
 // function as Keys mutator.
 type KeysFunc func(context.Context, *db.KeysMutation) (db.Value, error)
@@ -81,6 +141,18 @@ func (f KeysFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.KeysMutation", m)
 }
 
+// The LogoutNotificationFunc type is an adapter to allow the use of ordinary
+// function as LogoutNotification mutator.
+type LogoutNotificationFunc func(context.Context, *db.LogoutNotificationMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LogoutNotificationFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.LogoutNotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.LogoutNotificationMutation", m)
+}
+
 // The OAuth2ClientFunc type is an adapter to allow the use of ordinary
 // function as OAuth2Client mutator.
 type OAuth2ClientFunc func(context.Context, *db.OAuth2ClientMutation) (db.Value, error)
@@ -129,6 +201,30 @@ func (f RefreshTokenFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.RefreshTokenMutation", m)
 }
 
+// The ScopeFunc type is an adapter to allow the use of ordinary
+// function as Scope mutator.
+type ScopeFunc func(context.Context, *db.ScopeMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScopeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ScopeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ScopeMutation", m)
+}
+
+// The UserSessionFunc type is an adapter to allow the use of ordinary
+// function as UserSession mutator.
+type UserSessionFunc func(context.Context, *db.UserSessionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserSessionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.UserSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.UserSessionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, db.Mutation) bool
 
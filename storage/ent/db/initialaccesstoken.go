@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
+)
+
+// InitialAccessToken is the model entity for the InitialAccessToken schema.
+type InitialAccessToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry       time.Time `json:"expiry,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InitialAccessToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case initialaccesstoken.FieldID:
+			values[i] = new(sql.NullString)
+		case initialaccesstoken.FieldCreatedAt, initialaccesstoken.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InitialAccessToken fields.
+func (_m *InitialAccessToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case initialaccesstoken.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case initialaccesstoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case initialaccesstoken.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				_m.Expiry = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InitialAccessToken.
+// This includes values selected through modifiers, order, etc.
+func (_m *InitialAccessToken) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InitialAccessToken.
+// Note that you need to call InitialAccessToken.Unwrap() before calling this method if this InitialAccessToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InitialAccessToken) Update() *InitialAccessTokenUpdateOne {
+	return NewInitialAccessTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InitialAccessToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InitialAccessToken) Unwrap() *InitialAccessToken {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: InitialAccessToken is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InitialAccessToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("InitialAccessToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expiry=")
+	builder.WriteString(_m.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InitialAccessTokens is a parsable slice of InitialAccessToken.
+type InitialAccessTokens []*InitialAccessToken
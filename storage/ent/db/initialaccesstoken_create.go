@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
+)
+
+// InitialAccessTokenCreate is the builder for creating a InitialAccessToken entity.
+type InitialAccessTokenCreate struct {
+	config
+	mutation *InitialAccessTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InitialAccessTokenCreate) SetCreatedAt(v time.Time) *InitialAccessTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *InitialAccessTokenCreate) SetExpiry(v time.Time) *InitialAccessTokenCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InitialAccessTokenCreate) SetID(v string) *InitialAccessTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InitialAccessTokenMutation object of the builder.
+func (_c *InitialAccessTokenCreate) Mutation() *InitialAccessTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the InitialAccessToken in the database.
+func (_c *InitialAccessTokenCreate) Save(ctx context.Context) (*InitialAccessToken, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InitialAccessTokenCreate) SaveX(ctx context.Context) *InitialAccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InitialAccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InitialAccessTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InitialAccessTokenCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "InitialAccessToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "InitialAccessToken.expiry"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := initialaccesstoken.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "InitialAccessToken.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *InitialAccessTokenCreate) sqlSave(ctx context.Context) (*InitialAccessToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InitialAccessToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InitialAccessTokenCreate) createSpec() (*InitialAccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InitialAccessToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(initialaccesstoken.Table, sqlgraph.NewFieldSpec(initialaccesstoken.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(initialaccesstoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(initialaccesstoken.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// InitialAccessTokenCreateBulk is the builder for creating many InitialAccessToken entities in bulk.
+type InitialAccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*InitialAccessTokenCreate
+}
+
+// Save creates the InitialAccessToken entities in the database.
+func (_c *InitialAccessTokenCreateBulk) Save(ctx context.Context) ([]*InitialAccessToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InitialAccessToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InitialAccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InitialAccessTokenCreateBulk) SaveX(ctx context.Context) []*InitialAccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InitialAccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InitialAccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
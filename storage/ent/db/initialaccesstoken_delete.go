@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// InitialAccessTokenDelete is the builder for deleting a InitialAccessToken entity.
+type InitialAccessTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *InitialAccessTokenMutation
+}
+
+// Where appends a list predicates to the InitialAccessTokenDelete builder.
+func (_d *InitialAccessTokenDelete) Where(ps ...predicate.InitialAccessToken) *InitialAccessTokenDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InitialAccessTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InitialAccessTokenDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InitialAccessTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(initialaccesstoken.Table, sqlgraph.NewFieldSpec(initialaccesstoken.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InitialAccessTokenDeleteOne is the builder for deleting a single InitialAccessToken entity.
+type InitialAccessTokenDeleteOne struct {
+	_d *InitialAccessTokenDelete
+}
+
+// Where appends a list predicates to the InitialAccessTokenDelete builder.
+func (_d *InitialAccessTokenDeleteOne) Where(ps ...predicate.InitialAccessToken) *InitialAccessTokenDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InitialAccessTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{initialaccesstoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InitialAccessTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// InitialAccessTokenUpdate is the builder for updating InitialAccessToken entities.
+type InitialAccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InitialAccessTokenMutation
+}
+
+// Where appends a list predicates to the InitialAccessTokenUpdate builder.
+func (_u *InitialAccessTokenUpdate) Where(ps ...predicate.InitialAccessToken) *InitialAccessTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *InitialAccessTokenUpdate) SetCreatedAt(v time.Time) *InitialAccessTokenUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *InitialAccessTokenUpdate) SetNillableCreatedAt(v *time.Time) *InitialAccessTokenUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *InitialAccessTokenUpdate) SetExpiry(v time.Time) *InitialAccessTokenUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *InitialAccessTokenUpdate) SetNillableExpiry(v *time.Time) *InitialAccessTokenUpdate {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InitialAccessTokenMutation object of the builder.
+func (_u *InitialAccessTokenUpdate) Mutation() *InitialAccessTokenMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InitialAccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InitialAccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InitialAccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InitialAccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InitialAccessTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(initialaccesstoken.Table, initialaccesstoken.Columns, sqlgraph.NewFieldSpec(initialaccesstoken.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(initialaccesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(initialaccesstoken.FieldExpiry, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{initialaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InitialAccessTokenUpdateOne is the builder for updating a single InitialAccessToken entity.
+type InitialAccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InitialAccessTokenMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *InitialAccessTokenUpdateOne) SetCreatedAt(v time.Time) *InitialAccessTokenUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *InitialAccessTokenUpdateOne) SetNillableCreatedAt(v *time.Time) *InitialAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *InitialAccessTokenUpdateOne) SetExpiry(v time.Time) *InitialAccessTokenUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *InitialAccessTokenUpdateOne) SetNillableExpiry(v *time.Time) *InitialAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InitialAccessTokenMutation object of the builder.
+func (_u *InitialAccessTokenUpdateOne) Mutation() *InitialAccessTokenMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InitialAccessTokenUpdate builder.
+func (_u *InitialAccessTokenUpdateOne) Where(ps ...predicate.InitialAccessToken) *InitialAccessTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InitialAccessTokenUpdateOne) Select(field string, fields ...string) *InitialAccessTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InitialAccessToken entity.
+func (_u *InitialAccessTokenUpdateOne) Save(ctx context.Context) (*InitialAccessToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InitialAccessTokenUpdateOne) SaveX(ctx context.Context) *InitialAccessToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InitialAccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InitialAccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InitialAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *InitialAccessToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(initialaccesstoken.Table, initialaccesstoken.Columns, sqlgraph.NewFieldSpec(initialaccesstoken.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "InitialAccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, initialaccesstoken.FieldID)
+		for _, f := range fields {
+			if !initialaccesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != initialaccesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(initialaccesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(initialaccesstoken.FieldExpiry, field.TypeTime, value)
+	}
+	_node = &InitialAccessToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{initialaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package logoutnotification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldContainsFold(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldClientID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldSubject, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldSessionID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttempt applies equality check predicate on the "next_attempt" field. It's identical to NextAttemptEQ.
+func NextAttempt(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldNextAttempt, v))
+}
+
+// Expiry applies equality check predicate on the "expiry" field. It's identical to ExpiryEQ.
+func Expiry(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldExpiry, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptEQ applies the EQ predicate on the "next_attempt" field.
+func NextAttemptEQ(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldNextAttempt, v))
+}
+
+// NextAttemptNEQ applies the NEQ predicate on the "next_attempt" field.
+func NextAttemptNEQ(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldNextAttempt, v))
+}
+
+// NextAttemptIn applies the In predicate on the "next_attempt" field.
+func NextAttemptIn(vs ...time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldNextAttempt, vs...))
+}
+
+// NextAttemptNotIn applies the NotIn predicate on the "next_attempt" field.
+func NextAttemptNotIn(vs ...time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldNextAttempt, vs...))
+}
+
+// NextAttemptGT applies the GT predicate on the "next_attempt" field.
+func NextAttemptGT(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldNextAttempt, v))
+}
+
+// NextAttemptGTE applies the GTE predicate on the "next_attempt" field.
+func NextAttemptGTE(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldNextAttempt, v))
+}
+
+// NextAttemptLT applies the LT predicate on the "next_attempt" field.
+func NextAttemptLT(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldNextAttempt, v))
+}
+
+// NextAttemptLTE applies the LTE predicate on the "next_attempt" field.
+func NextAttemptLTE(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldNextAttempt, v))
+}
+
+// ExpiryEQ applies the EQ predicate on the "expiry" field.
+func ExpiryEQ(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldEQ(FieldExpiry, v))
+}
+
+// ExpiryNEQ applies the NEQ predicate on the "expiry" field.
+func ExpiryNEQ(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNEQ(FieldExpiry, v))
+}
+
+// ExpiryIn applies the In predicate on the "expiry" field.
+func ExpiryIn(vs ...time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldIn(FieldExpiry, vs...))
+}
+
+// ExpiryNotIn applies the NotIn predicate on the "expiry" field.
+func ExpiryNotIn(vs ...time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldNotIn(FieldExpiry, vs...))
+}
+
+// ExpiryGT applies the GT predicate on the "expiry" field.
+func ExpiryGT(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGT(FieldExpiry, v))
+}
+
+// ExpiryGTE applies the GTE predicate on the "expiry" field.
+func ExpiryGTE(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldGTE(FieldExpiry, v))
+}
+
+// ExpiryLT applies the LT predicate on the "expiry" field.
+func ExpiryLT(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLT(FieldExpiry, v))
+}
+
+// ExpiryLTE applies the LTE predicate on the "expiry" field.
+func ExpiryLTE(v time.Time) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.FieldLTE(FieldExpiry, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LogoutNotification) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LogoutNotification) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LogoutNotification) predicate.LogoutNotification {
+	return predicate.LogoutNotification(sql.NotPredicates(p))
+}
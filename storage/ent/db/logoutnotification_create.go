@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
+)
+
+// LogoutNotificationCreate is the builder for creating a LogoutNotification entity.
+type LogoutNotificationCreate struct {
+	config
+	mutation *LogoutNotificationMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *LogoutNotificationCreate) SetClientID(v string) *LogoutNotificationCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *LogoutNotificationCreate) SetSubject(v string) *LogoutNotificationCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *LogoutNotificationCreate) SetSessionID(v string) *LogoutNotificationCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_c *LogoutNotificationCreate) SetNillableSessionID(v *string) *LogoutNotificationCreate {
+	if v != nil {
+		_c.SetSessionID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LogoutNotificationCreate) SetCreatedAt(v time.Time) *LogoutNotificationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *LogoutNotificationCreate) SetAttempts(v int) *LogoutNotificationCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNextAttempt sets the "next_attempt" field.
+func (_c *LogoutNotificationCreate) SetNextAttempt(v time.Time) *LogoutNotificationCreate {
+	_c.mutation.SetNextAttempt(v)
+	return _c
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *LogoutNotificationCreate) SetExpiry(v time.Time) *LogoutNotificationCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LogoutNotificationCreate) SetID(v string) *LogoutNotificationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LogoutNotificationMutation object of the builder.
+func (_c *LogoutNotificationCreate) Mutation() *LogoutNotificationMutation {
+	return _c.mutation
+}
+
+// Save creates the LogoutNotification in the database.
+func (_c *LogoutNotificationCreate) Save(ctx context.Context) (*LogoutNotification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LogoutNotificationCreate) SaveX(ctx context.Context) *LogoutNotification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LogoutNotificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LogoutNotificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LogoutNotificationCreate) defaults() {
+	if _, ok := _c.mutation.SessionID(); !ok {
+		v := logoutnotification.DefaultSessionID
+		_c.mutation.SetSessionID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LogoutNotificationCreate) check() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`db: missing required field "LogoutNotification.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := logoutnotification.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "LogoutNotification.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`db: missing required field "LogoutNotification.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := logoutnotification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`db: validator failed for field "LogoutNotification.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`db: missing required field "LogoutNotification.session_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "LogoutNotification.created_at"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`db: missing required field "LogoutNotification.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttempt(); !ok {
+		return &ValidationError{Name: "next_attempt", err: errors.New(`db: missing required field "LogoutNotification.next_attempt"`)}
+	}
+	if _, ok := _c.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "LogoutNotification.expiry"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := logoutnotification.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "LogoutNotification.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LogoutNotificationCreate) sqlSave(ctx context.Context) (*LogoutNotification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LogoutNotification.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LogoutNotificationCreate) createSpec() (*LogoutNotification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LogoutNotification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(logoutnotification.Table, sqlgraph.NewFieldSpec(logoutnotification.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(logoutnotification.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(logoutnotification.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(logoutnotification.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(logoutnotification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(logoutnotification.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttempt(); ok {
+		_spec.SetField(logoutnotification.FieldNextAttempt, field.TypeTime, value)
+		_node.NextAttempt = value
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(logoutnotification.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// LogoutNotificationCreateBulk is the builder for creating many LogoutNotification entities in bulk.
+type LogoutNotificationCreateBulk struct {
+	config
+	err      error
+	builders []*LogoutNotificationCreate
+}
+
+// Save creates the LogoutNotification entities in the database.
+func (_c *LogoutNotificationCreateBulk) Save(ctx context.Context) ([]*LogoutNotification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LogoutNotification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LogoutNotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LogoutNotificationCreateBulk) SaveX(ctx context.Context) []*LogoutNotification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LogoutNotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LogoutNotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// LogoutNotificationDelete is the builder for deleting a LogoutNotification entity.
+type LogoutNotificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *LogoutNotificationMutation
+}
+
+// Where appends a list predicates to the LogoutNotificationDelete builder.
+func (_d *LogoutNotificationDelete) Where(ps ...predicate.LogoutNotification) *LogoutNotificationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LogoutNotificationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LogoutNotificationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LogoutNotificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(logoutnotification.Table, sqlgraph.NewFieldSpec(logoutnotification.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LogoutNotificationDeleteOne is the builder for deleting a single LogoutNotification entity.
+type LogoutNotificationDeleteOne struct {
+	_d *LogoutNotificationDelete
+}
+
+// Where appends a list predicates to the LogoutNotificationDelete builder.
+func (_d *LogoutNotificationDeleteOne) Where(ps ...predicate.LogoutNotification) *LogoutNotificationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LogoutNotificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{logoutnotification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LogoutNotificationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// LogoutNotificationUpdate is the builder for updating LogoutNotification entities.
+type LogoutNotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LogoutNotificationMutation
+}
+
+// Where appends a list predicates to the LogoutNotificationUpdate builder.
+func (_u *LogoutNotificationUpdate) Where(ps ...predicate.LogoutNotification) *LogoutNotificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *LogoutNotificationUpdate) SetClientID(v string) *LogoutNotificationUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *LogoutNotificationUpdate) SetNillableClientID(v *string) *LogoutNotificationUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *LogoutNotificationUpdate) SetSubject(v string) *LogoutNotificationUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *LogoutNotificationUpdate) SetNillableSubject(v *string) *LogoutNotificationUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *LogoutNotificationUpdate) SetSessionID(v string) *LogoutNotificationUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *LogoutNotificationUpdate) SetNillableSessionID(v *string) *LogoutNotificationUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LogoutNotificationUpdate) SetCreatedAt(v time.Time) *LogoutNotificationUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LogoutNotificationUpdate) SetNillableCreatedAt(v *time.Time) *LogoutNotificationUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *LogoutNotificationUpdate) SetAttempts(v int) *LogoutNotificationUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *LogoutNotificationUpdate) SetNillableAttempts(v *int) *LogoutNotificationUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *LogoutNotificationUpdate) AddAttempts(v int) *LogoutNotificationUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttempt sets the "next_attempt" field.
+func (_u *LogoutNotificationUpdate) SetNextAttempt(v time.Time) *LogoutNotificationUpdate {
+	_u.mutation.SetNextAttempt(v)
+	return _u
+}
+
+// SetNillableNextAttempt sets the "next_attempt" field if the given value is not nil.
+func (_u *LogoutNotificationUpdate) SetNillableNextAttempt(v *time.Time) *LogoutNotificationUpdate {
+	if v != nil {
+		_u.SetNextAttempt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *LogoutNotificationUpdate) SetExpiry(v time.Time) *LogoutNotificationUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *LogoutNotificationUpdate) SetNillableExpiry(v *time.Time) *LogoutNotificationUpdate {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LogoutNotificationMutation object of the builder.
+func (_u *LogoutNotificationUpdate) Mutation() *LogoutNotificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LogoutNotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LogoutNotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LogoutNotificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LogoutNotificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LogoutNotificationUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := logoutnotification.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "LogoutNotification.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := logoutnotification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`db: validator failed for field "LogoutNotification.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LogoutNotificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(logoutnotification.Table, logoutnotification.Columns, sqlgraph.NewFieldSpec(logoutnotification.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(logoutnotification.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(logoutnotification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(logoutnotification.FieldSessionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(logoutnotification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(logoutnotification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(logoutnotification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttempt(); ok {
+		_spec.SetField(logoutnotification.FieldNextAttempt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(logoutnotification.FieldExpiry, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logoutnotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LogoutNotificationUpdateOne is the builder for updating a single LogoutNotification entity.
+type LogoutNotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LogoutNotificationMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *LogoutNotificationUpdateOne) SetClientID(v string) *LogoutNotificationUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *LogoutNotificationUpdateOne) SetNillableClientID(v *string) *LogoutNotificationUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *LogoutNotificationUpdateOne) SetSubject(v string) *LogoutNotificationUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *LogoutNotificationUpdateOne) SetNillableSubject(v *string) *LogoutNotificationUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *LogoutNotificationUpdateOne) SetSessionID(v string) *LogoutNotificationUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *LogoutNotificationUpdateOne) SetNillableSessionID(v *string) *LogoutNotificationUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LogoutNotificationUpdateOne) SetCreatedAt(v time.Time) *LogoutNotificationUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LogoutNotificationUpdateOne) SetNillableCreatedAt(v *time.Time) *LogoutNotificationUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *LogoutNotificationUpdateOne) SetAttempts(v int) *LogoutNotificationUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *LogoutNotificationUpdateOne) SetNillableAttempts(v *int) *LogoutNotificationUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *LogoutNotificationUpdateOne) AddAttempts(v int) *LogoutNotificationUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttempt sets the "next_attempt" field.
+func (_u *LogoutNotificationUpdateOne) SetNextAttempt(v time.Time) *LogoutNotificationUpdateOne {
+	_u.mutation.SetNextAttempt(v)
+	return _u
+}
+
+// SetNillableNextAttempt sets the "next_attempt" field if the given value is not nil.
+func (_u *LogoutNotificationUpdateOne) SetNillableNextAttempt(v *time.Time) *LogoutNotificationUpdateOne {
+	if v != nil {
+		_u.SetNextAttempt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *LogoutNotificationUpdateOne) SetExpiry(v time.Time) *LogoutNotificationUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *LogoutNotificationUpdateOne) SetNillableExpiry(v *time.Time) *LogoutNotificationUpdateOne {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LogoutNotificationMutation object of the builder.
+func (_u *LogoutNotificationUpdateOne) Mutation() *LogoutNotificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LogoutNotificationUpdate builder.
+func (_u *LogoutNotificationUpdateOne) Where(ps ...predicate.LogoutNotification) *LogoutNotificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LogoutNotificationUpdateOne) Select(field string, fields ...string) *LogoutNotificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LogoutNotification entity.
+func (_u *LogoutNotificationUpdateOne) Save(ctx context.Context) (*LogoutNotification, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LogoutNotificationUpdateOne) SaveX(ctx context.Context) *LogoutNotification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LogoutNotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LogoutNotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LogoutNotificationUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := logoutnotification.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "LogoutNotification.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := logoutnotification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`db: validator failed for field "LogoutNotification.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LogoutNotificationUpdateOne) sqlSave(ctx context.Context) (_node *LogoutNotification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(logoutnotification.Table, logoutnotification.Columns, sqlgraph.NewFieldSpec(logoutnotification.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "LogoutNotification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, logoutnotification.FieldID)
+		for _, f := range fields {
+			if !logoutnotification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != logoutnotification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(logoutnotification.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(logoutnotification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(logoutnotification.FieldSessionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(logoutnotification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(logoutnotification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(logoutnotification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttempt(); ok {
+		_spec.SetField(logoutnotification.FieldNextAttempt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(logoutnotification.FieldExpiry, field.TypeTime, value)
+	}
+	_node = &LogoutNotification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logoutnotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
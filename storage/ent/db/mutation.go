@@ -12,17 +12,25 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
 	"github.com/dexidp/dex/storage/ent/db/connector"
+	"github.com/dexidp/dex/storage/ent/db/consent"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
 	"github.com/dexidp/dex/storage/ent/db/predicate"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/scope"
+	"github.com/dexidp/dex/storage/ent/db/usersession"
 	jose "github.com/go-jose/go-jose/v4"
 )
 
@@ -35,20 +43,28 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuthCode       = "AuthCode"
-	TypeAuthRequest    = "AuthRequest"
-	TypeConnector      = "Connector"
-	TypeDeviceRequest  = "DeviceRequest"
-	TypeDeviceToken    = "DeviceToken"
-	TypeKeys           = "Keys"
-	TypeOAuth2Client   = "OAuth2Client"
-	TypeOfflineSession = "OfflineSession"
-	TypePassword       = "Password"
-	TypeRefreshToken   = "RefreshToken"
+	TypeAccessToken        = "AccessToken"
+	TypeAuthCode           = "AuthCode"
+	TypeAuthRequest        = "AuthRequest"
+	TypeClientAssertion    = "ClientAssertion"
+	TypeConnector          = "Connector"
+	TypeConsent            = "Consent"
+	TypeDeviceRequest      = "DeviceRequest"
+	TypeDeviceToken        = "DeviceToken"
+	TypeDpopProof          = "DpopProof"
+	TypeInitialAccessToken = "InitialAccessToken"
+	TypeKeys               = "Keys"
+	TypeLogoutNotification = "LogoutNotification"
+	TypeOAuth2Client       = "OAuth2Client"
+	TypeOfflineSession     = "OfflineSession"
+	TypePassword           = "Password"
+	TypeRefreshToken       = "RefreshToken"
+	TypeScope              = "Scope"
+	TypeUserSession        = "UserSession"
 )
 
-// AuthCodeMutation represents an operation that mutates the AuthCode nodes in the graph.
-type AuthCodeMutation struct {
+// AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
+type AccessTokenMutation struct {
 	config
 	op                        Op
 	typ                       string
@@ -56,37 +72,39 @@ type AuthCodeMutation struct {
 	client_id                 *string
 	scopes                    *[]string
 	appendscopes              []string
-	nonce                     *string
-	redirect_uri              *string
+	audience                  *[]string
+	appendaudience            []string
+	connector_id              *string
 	claims_user_id            *string
 	claims_username           *string
+	claims_preferred_username *string
 	claims_email              *string
 	claims_email_verified     *bool
 	claims_groups             *[]string
 	appendclaims_groups       []string
-	claims_preferred_username *string
-	connector_id              *string
-	connector_data            *[]byte
+	claims_extra              *map[string]interface{}
+	certificate_thumbprint    *string
+	dpop_key_thumbprint       *string
+	refresh_token_id          *string
+	created_at                *time.Time
 	expiry                    *time.Time
-	code_challenge            *string
-	code_challenge_method     *string
 	clearedFields             map[string]struct{}
 	done                      bool
-	oldValue                  func(context.Context) (*AuthCode, error)
-	predicates                []predicate.AuthCode
+	oldValue                  func(context.Context) (*AccessToken, error)
+	predicates                []predicate.AccessToken
 }
 
-var _ ent.Mutation = (*AuthCodeMutation)(nil)
+var _ ent.Mutation = (*AccessTokenMutation)(nil)
 
-// authcodeOption allows management of the mutation configuration using functional options.
-type authcodeOption func(*AuthCodeMutation)
+// accesstokenOption allows management of the mutation configuration using functional options.
+type accesstokenOption func(*AccessTokenMutation)
 
-// newAuthCodeMutation creates new mutation for the AuthCode entity.
-func newAuthCodeMutation(c config, op Op, opts ...authcodeOption) *AuthCodeMutation {
-	m := &AuthCodeMutation{
+// newAccessTokenMutation creates new mutation for the AccessToken entity.
+func newAccessTokenMutation(c config, op Op, opts ...accesstokenOption) *AccessTokenMutation {
+	m := &AccessTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthCode,
+		typ:           TypeAccessToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -95,20 +113,20 @@ func newAuthCodeMutation(c config, op Op, opts ...authcodeOption) *AuthCodeMutat
 	return m
 }
 
-// withAuthCodeID sets the ID field of the mutation.
-func withAuthCodeID(id string) authcodeOption {
-	return func(m *AuthCodeMutation) {
+// withAccessTokenID sets the ID field of the mutation.
+func withAccessTokenID(id string) accesstokenOption {
+	return func(m *AccessTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthCode
+			value *AccessToken
 		)
-		m.oldValue = func(ctx context.Context) (*AuthCode, error) {
+		m.oldValue = func(ctx context.Context) (*AccessToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthCode.Get(ctx, id)
+					value, err = m.Client().AccessToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -117,10 +135,10 @@ func withAuthCodeID(id string) authcodeOption {
 	}
 }
 
-// withAuthCode sets the old AuthCode of the mutation.
-func withAuthCode(node *AuthCode) authcodeOption {
-	return func(m *AuthCodeMutation) {
-		m.oldValue = func(context.Context) (*AuthCode, error) {
+// withAccessToken sets the old AccessToken of the mutation.
+func withAccessToken(node *AccessToken) accesstokenOption {
+	return func(m *AccessTokenMutation) {
+		m.oldValue = func(context.Context) (*AccessToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -129,7 +147,7 @@ func withAuthCode(node *AuthCode) authcodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthCodeMutation) Client() *Client {
+func (m AccessTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -137,7 +155,7 @@ func (m AuthCodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthCodeMutation) Tx() (*Tx, error) {
+func (m AccessTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -147,14 +165,14 @@ func (m AuthCodeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthCode entities.
-func (m *AuthCodeMutation) SetID(id string) {
+// operation is only accepted on creation of AccessToken entities.
+func (m *AccessTokenMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthCodeMutation) ID() (id string, exists bool) {
+func (m *AccessTokenMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -165,7 +183,7 @@ func (m *AuthCodeMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthCodeMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AccessTokenMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -174,19 +192,19 @@ func (m *AuthCodeMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthCode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccessToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetClientID sets the "client_id" field.
-func (m *AuthCodeMutation) SetClientID(s string) {
+func (m *AccessTokenMutation) SetClientID(s string) {
 	m.client_id = &s
 }
 
 // ClientID returns the value of the "client_id" field in the mutation.
-func (m *AuthCodeMutation) ClientID() (r string, exists bool) {
+func (m *AccessTokenMutation) ClientID() (r string, exists bool) {
 	v := m.client_id
 	if v == nil {
 		return
@@ -194,10 +212,10 @@ func (m *AuthCodeMutation) ClientID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
@@ -212,18 +230,18 @@ func (m *AuthCodeMutation) OldClientID(ctx context.Context) (v string, err error
 }
 
 // ResetClientID resets all changes to the "client_id" field.
-func (m *AuthCodeMutation) ResetClientID() {
+func (m *AccessTokenMutation) ResetClientID() {
 	m.client_id = nil
 }
 
 // SetScopes sets the "scopes" field.
-func (m *AuthCodeMutation) SetScopes(s []string) {
+func (m *AccessTokenMutation) SetScopes(s []string) {
 	m.scopes = &s
 	m.appendscopes = nil
 }
 
 // Scopes returns the value of the "scopes" field in the mutation.
-func (m *AuthCodeMutation) Scopes() (r []string, exists bool) {
+func (m *AccessTokenMutation) Scopes() (r []string, exists bool) {
 	v := m.scopes
 	if v == nil {
 		return
@@ -231,10 +249,10 @@ func (m *AuthCodeMutation) Scopes() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *AccessTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
@@ -249,12 +267,12 @@ func (m *AuthCodeMutation) OldScopes(ctx context.Context) (v []string, err error
 }
 
 // AppendScopes adds s to the "scopes" field.
-func (m *AuthCodeMutation) AppendScopes(s []string) {
+func (m *AccessTokenMutation) AppendScopes(s []string) {
 	m.appendscopes = append(m.appendscopes, s...)
 }
 
 // AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *AuthCodeMutation) AppendedScopes() ([]string, bool) {
+func (m *AccessTokenMutation) AppendedScopes() ([]string, bool) {
 	if len(m.appendscopes) == 0 {
 		return nil, false
 	}
@@ -262,104 +280,133 @@ func (m *AuthCodeMutation) AppendedScopes() ([]string, bool) {
 }
 
 // ClearScopes clears the value of the "scopes" field.
-func (m *AuthCodeMutation) ClearScopes() {
+func (m *AccessTokenMutation) ClearScopes() {
 	m.scopes = nil
 	m.appendscopes = nil
-	m.clearedFields[authcode.FieldScopes] = struct{}{}
+	m.clearedFields[accesstoken.FieldScopes] = struct{}{}
 }
 
 // ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *AuthCodeMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[authcode.FieldScopes]
+func (m *AccessTokenMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldScopes]
 	return ok
 }
 
 // ResetScopes resets all changes to the "scopes" field.
-func (m *AuthCodeMutation) ResetScopes() {
+func (m *AccessTokenMutation) ResetScopes() {
 	m.scopes = nil
 	m.appendscopes = nil
-	delete(m.clearedFields, authcode.FieldScopes)
+	delete(m.clearedFields, accesstoken.FieldScopes)
 }
 
-// SetNonce sets the "nonce" field.
-func (m *AuthCodeMutation) SetNonce(s string) {
-	m.nonce = &s
+// SetAudience sets the "audience" field.
+func (m *AccessTokenMutation) SetAudience(s []string) {
+	m.audience = &s
+	m.appendaudience = nil
 }
 
-// Nonce returns the value of the "nonce" field in the mutation.
-func (m *AuthCodeMutation) Nonce() (r string, exists bool) {
-	v := m.nonce
+// Audience returns the value of the "audience" field in the mutation.
+func (m *AccessTokenMutation) Audience() (r []string, exists bool) {
+	v := m.audience
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNonce returns the old "nonce" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldAudience returns the old "audience" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldNonce(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldAudience(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+		return v, errors.New("OldAudience is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNonce requires an ID field in the mutation")
+		return v, errors.New("OldAudience requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+		return v, fmt.Errorf("querying old value for OldAudience: %w", err)
 	}
-	return oldValue.Nonce, nil
+	return oldValue.Audience, nil
 }
 
-// ResetNonce resets all changes to the "nonce" field.
-func (m *AuthCodeMutation) ResetNonce() {
-	m.nonce = nil
+// AppendAudience adds s to the "audience" field.
+func (m *AccessTokenMutation) AppendAudience(s []string) {
+	m.appendaudience = append(m.appendaudience, s...)
 }
 
-// SetRedirectURI sets the "redirect_uri" field.
-func (m *AuthCodeMutation) SetRedirectURI(s string) {
-	m.redirect_uri = &s
+// AppendedAudience returns the list of values that were appended to the "audience" field in this mutation.
+func (m *AccessTokenMutation) AppendedAudience() ([]string, bool) {
+	if len(m.appendaudience) == 0 {
+		return nil, false
+	}
+	return m.appendaudience, true
 }
 
-// RedirectURI returns the value of the "redirect_uri" field in the mutation.
-func (m *AuthCodeMutation) RedirectURI() (r string, exists bool) {
-	v := m.redirect_uri
+// ClearAudience clears the value of the "audience" field.
+func (m *AccessTokenMutation) ClearAudience() {
+	m.audience = nil
+	m.appendaudience = nil
+	m.clearedFields[accesstoken.FieldAudience] = struct{}{}
+}
+
+// AudienceCleared returns if the "audience" field was cleared in this mutation.
+func (m *AccessTokenMutation) AudienceCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldAudience]
+	return ok
+}
+
+// ResetAudience resets all changes to the "audience" field.
+func (m *AccessTokenMutation) ResetAudience() {
+	m.audience = nil
+	m.appendaudience = nil
+	delete(m.clearedFields, accesstoken.FieldAudience)
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (m *AccessTokenMutation) SetConnectorID(s string) {
+	m.connector_id = &s
+}
+
+// ConnectorID returns the value of the "connector_id" field in the mutation.
+func (m *AccessTokenMutation) ConnectorID() (r string, exists bool) {
+	v := m.connector_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirectURI returns the old "redirect_uri" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectorID returns the old "connector_id" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldRedirectURI(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldConnectorID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirectURI is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirectURI requires an ID field in the mutation")
+		return v, errors.New("OldConnectorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirectURI: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
 	}
-	return oldValue.RedirectURI, nil
+	return oldValue.ConnectorID, nil
 }
 
-// ResetRedirectURI resets all changes to the "redirect_uri" field.
-func (m *AuthCodeMutation) ResetRedirectURI() {
-	m.redirect_uri = nil
+// ResetConnectorID resets all changes to the "connector_id" field.
+func (m *AccessTokenMutation) ResetConnectorID() {
+	m.connector_id = nil
 }
 
 // SetClaimsUserID sets the "claims_user_id" field.
-func (m *AuthCodeMutation) SetClaimsUserID(s string) {
+func (m *AccessTokenMutation) SetClaimsUserID(s string) {
 	m.claims_user_id = &s
 }
 
 // ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
-func (m *AuthCodeMutation) ClaimsUserID() (r string, exists bool) {
+func (m *AccessTokenMutation) ClaimsUserID() (r string, exists bool) {
 	v := m.claims_user_id
 	if v == nil {
 		return
@@ -367,10 +414,10 @@ func (m *AuthCodeMutation) ClaimsUserID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsUserID returns the old "claims_user_id" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUserID returns the old "claims_user_id" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
 	}
@@ -385,17 +432,17 @@ func (m *AuthCodeMutation) OldClaimsUserID(ctx context.Context) (v string, err e
 }
 
 // ResetClaimsUserID resets all changes to the "claims_user_id" field.
-func (m *AuthCodeMutation) ResetClaimsUserID() {
+func (m *AccessTokenMutation) ResetClaimsUserID() {
 	m.claims_user_id = nil
 }
 
 // SetClaimsUsername sets the "claims_username" field.
-func (m *AuthCodeMutation) SetClaimsUsername(s string) {
+func (m *AccessTokenMutation) SetClaimsUsername(s string) {
 	m.claims_username = &s
 }
 
 // ClaimsUsername returns the value of the "claims_username" field in the mutation.
-func (m *AuthCodeMutation) ClaimsUsername() (r string, exists bool) {
+func (m *AccessTokenMutation) ClaimsUsername() (r string, exists bool) {
 	v := m.claims_username
 	if v == nil {
 		return
@@ -403,10 +450,10 @@ func (m *AuthCodeMutation) ClaimsUsername() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsUsername returns the old "claims_username" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUsername returns the old "claims_username" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
 	}
@@ -421,17 +468,53 @@ func (m *AuthCodeMutation) OldClaimsUsername(ctx context.Context) (v string, err
 }
 
 // ResetClaimsUsername resets all changes to the "claims_username" field.
-func (m *AuthCodeMutation) ResetClaimsUsername() {
+func (m *AccessTokenMutation) ResetClaimsUsername() {
 	m.claims_username = nil
 }
 
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (m *AccessTokenMutation) SetClaimsPreferredUsername(s string) {
+	m.claims_preferred_username = &s
+}
+
+// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
+func (m *AccessTokenMutation) ClaimsPreferredUsername() (r string, exists bool) {
+	v := m.claims_preferred_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessTokenMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
+	}
+	return oldValue.ClaimsPreferredUsername, nil
+}
+
+// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
+func (m *AccessTokenMutation) ResetClaimsPreferredUsername() {
+	m.claims_preferred_username = nil
+}
+
 // SetClaimsEmail sets the "claims_email" field.
-func (m *AuthCodeMutation) SetClaimsEmail(s string) {
+func (m *AccessTokenMutation) SetClaimsEmail(s string) {
 	m.claims_email = &s
 }
 
 // ClaimsEmail returns the value of the "claims_email" field in the mutation.
-func (m *AuthCodeMutation) ClaimsEmail() (r string, exists bool) {
+func (m *AccessTokenMutation) ClaimsEmail() (r string, exists bool) {
 	v := m.claims_email
 	if v == nil {
 		return
@@ -439,10 +522,10 @@ func (m *AuthCodeMutation) ClaimsEmail() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsEmail returns the old "claims_email" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmail returns the old "claims_email" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
 	}
@@ -457,17 +540,17 @@ func (m *AuthCodeMutation) OldClaimsEmail(ctx context.Context) (v string, err er
 }
 
 // ResetClaimsEmail resets all changes to the "claims_email" field.
-func (m *AuthCodeMutation) ResetClaimsEmail() {
+func (m *AccessTokenMutation) ResetClaimsEmail() {
 	m.claims_email = nil
 }
 
 // SetClaimsEmailVerified sets the "claims_email_verified" field.
-func (m *AuthCodeMutation) SetClaimsEmailVerified(b bool) {
+func (m *AccessTokenMutation) SetClaimsEmailVerified(b bool) {
 	m.claims_email_verified = &b
 }
 
 // ClaimsEmailVerified returns the value of the "claims_email_verified" field in the mutation.
-func (m *AuthCodeMutation) ClaimsEmailVerified() (r bool, exists bool) {
+func (m *AccessTokenMutation) ClaimsEmailVerified() (r bool, exists bool) {
 	v := m.claims_email_verified
 	if v == nil {
 		return
@@ -475,10 +558,10 @@ func (m *AuthCodeMutation) ClaimsEmailVerified() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
+func (m *AccessTokenMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsEmailVerified is only allowed on UpdateOne operations")
 	}
@@ -493,18 +576,18 @@ func (m *AuthCodeMutation) OldClaimsEmailVerified(ctx context.Context) (v bool,
 }
 
 // ResetClaimsEmailVerified resets all changes to the "claims_email_verified" field.
-func (m *AuthCodeMutation) ResetClaimsEmailVerified() {
+func (m *AccessTokenMutation) ResetClaimsEmailVerified() {
 	m.claims_email_verified = nil
 }
 
 // SetClaimsGroups sets the "claims_groups" field.
-func (m *AuthCodeMutation) SetClaimsGroups(s []string) {
+func (m *AccessTokenMutation) SetClaimsGroups(s []string) {
 	m.claims_groups = &s
 	m.appendclaims_groups = nil
 }
 
 // ClaimsGroups returns the value of the "claims_groups" field in the mutation.
-func (m *AuthCodeMutation) ClaimsGroups() (r []string, exists bool) {
+func (m *AccessTokenMutation) ClaimsGroups() (r []string, exists bool) {
 	v := m.claims_groups
 	if v == nil {
 		return
@@ -512,10 +595,10 @@ func (m *AuthCodeMutation) ClaimsGroups() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsGroups returns the old "claims_groups" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsGroups returns the old "claims_groups" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
+func (m *AccessTokenMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsGroups is only allowed on UpdateOne operations")
 	}
@@ -530,12 +613,12 @@ func (m *AuthCodeMutation) OldClaimsGroups(ctx context.Context) (v []string, err
 }
 
 // AppendClaimsGroups adds s to the "claims_groups" field.
-func (m *AuthCodeMutation) AppendClaimsGroups(s []string) {
+func (m *AccessTokenMutation) AppendClaimsGroups(s []string) {
 	m.appendclaims_groups = append(m.appendclaims_groups, s...)
 }
 
 // AppendedClaimsGroups returns the list of values that were appended to the "claims_groups" field in this mutation.
-func (m *AuthCodeMutation) AppendedClaimsGroups() ([]string, bool) {
+func (m *AccessTokenMutation) AppendedClaimsGroups() ([]string, bool) {
 	if len(m.appendclaims_groups) == 0 {
 		return nil, false
 	}
@@ -543,263 +626,263 @@ func (m *AuthCodeMutation) AppendedClaimsGroups() ([]string, bool) {
 }
 
 // ClearClaimsGroups clears the value of the "claims_groups" field.
-func (m *AuthCodeMutation) ClearClaimsGroups() {
+func (m *AccessTokenMutation) ClearClaimsGroups() {
 	m.claims_groups = nil
 	m.appendclaims_groups = nil
-	m.clearedFields[authcode.FieldClaimsGroups] = struct{}{}
+	m.clearedFields[accesstoken.FieldClaimsGroups] = struct{}{}
 }
 
 // ClaimsGroupsCleared returns if the "claims_groups" field was cleared in this mutation.
-func (m *AuthCodeMutation) ClaimsGroupsCleared() bool {
-	_, ok := m.clearedFields[authcode.FieldClaimsGroups]
+func (m *AccessTokenMutation) ClaimsGroupsCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldClaimsGroups]
 	return ok
 }
 
 // ResetClaimsGroups resets all changes to the "claims_groups" field.
-func (m *AuthCodeMutation) ResetClaimsGroups() {
+func (m *AccessTokenMutation) ResetClaimsGroups() {
 	m.claims_groups = nil
 	m.appendclaims_groups = nil
-	delete(m.clearedFields, authcode.FieldClaimsGroups)
+	delete(m.clearedFields, accesstoken.FieldClaimsGroups)
 }
 
-// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
-func (m *AuthCodeMutation) SetClaimsPreferredUsername(s string) {
-	m.claims_preferred_username = &s
+// SetClaimsExtra sets the "claims_extra" field.
+func (m *AccessTokenMutation) SetClaimsExtra(value map[string]interface{}) {
+	m.claims_extra = &value
 }
 
-// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
-func (m *AuthCodeMutation) ClaimsPreferredUsername() (r string, exists bool) {
-	v := m.claims_preferred_username
+// ClaimsExtra returns the value of the "claims_extra" field in the mutation.
+func (m *AccessTokenMutation) ClaimsExtra() (r map[string]interface{}, exists bool) {
+	v := m.claims_extra
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsExtra returns the old "claims_extra" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldClaimsExtra(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsExtra is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
+		return v, errors.New("OldClaimsExtra requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsExtra: %w", err)
 	}
-	return oldValue.ClaimsPreferredUsername, nil
+	return oldValue.ClaimsExtra, nil
 }
 
-// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
-func (m *AuthCodeMutation) ResetClaimsPreferredUsername() {
-	m.claims_preferred_username = nil
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (m *AccessTokenMutation) ClearClaimsExtra() {
+	m.claims_extra = nil
+	m.clearedFields[accesstoken.FieldClaimsExtra] = struct{}{}
 }
 
-// SetConnectorID sets the "connector_id" field.
-func (m *AuthCodeMutation) SetConnectorID(s string) {
-	m.connector_id = &s
+// ClaimsExtraCleared returns if the "claims_extra" field was cleared in this mutation.
+func (m *AccessTokenMutation) ClaimsExtraCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldClaimsExtra]
+	return ok
 }
 
-// ConnectorID returns the value of the "connector_id" field in the mutation.
-func (m *AuthCodeMutation) ConnectorID() (r string, exists bool) {
-	v := m.connector_id
+// ResetClaimsExtra resets all changes to the "claims_extra" field.
+func (m *AccessTokenMutation) ResetClaimsExtra() {
+	m.claims_extra = nil
+	delete(m.clearedFields, accesstoken.FieldClaimsExtra)
+}
+
+// SetCertificateThumbprint sets the "certificate_thumbprint" field.
+func (m *AccessTokenMutation) SetCertificateThumbprint(s string) {
+	m.certificate_thumbprint = &s
+}
+
+// CertificateThumbprint returns the value of the "certificate_thumbprint" field in the mutation.
+func (m *AccessTokenMutation) CertificateThumbprint() (r string, exists bool) {
+	v := m.certificate_thumbprint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorID returns the old "connector_id" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldCertificateThumbprint returns the old "certificate_thumbprint" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldConnectorID(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldCertificateThumbprint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCertificateThumbprint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorID requires an ID field in the mutation")
+		return v, errors.New("OldCertificateThumbprint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCertificateThumbprint: %w", err)
 	}
-	return oldValue.ConnectorID, nil
+	return oldValue.CertificateThumbprint, nil
 }
 
-// ResetConnectorID resets all changes to the "connector_id" field.
-func (m *AuthCodeMutation) ResetConnectorID() {
-	m.connector_id = nil
+// ResetCertificateThumbprint resets all changes to the "certificate_thumbprint" field.
+func (m *AccessTokenMutation) ResetCertificateThumbprint() {
+	m.certificate_thumbprint = nil
 }
 
-// SetConnectorData sets the "connector_data" field.
-func (m *AuthCodeMutation) SetConnectorData(b []byte) {
-	m.connector_data = &b
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (m *AccessTokenMutation) SetDpopKeyThumbprint(s string) {
+	m.dpop_key_thumbprint = &s
 }
 
-// ConnectorData returns the value of the "connector_data" field in the mutation.
-func (m *AuthCodeMutation) ConnectorData() (r []byte, exists bool) {
-	v := m.connector_data
+// DpopKeyThumbprint returns the value of the "dpop_key_thumbprint" field in the mutation.
+func (m *AccessTokenMutation) DpopKeyThumbprint() (r string, exists bool) {
+	v := m.dpop_key_thumbprint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorData returns the old "connector_data" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldDpopKeyThumbprint returns the old "dpop_key_thumbprint" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+func (m *AccessTokenMutation) OldDpopKeyThumbprint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+		return v, errors.New("OldDpopKeyThumbprint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+		return v, errors.New("OldDpopKeyThumbprint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+		return v, fmt.Errorf("querying old value for OldDpopKeyThumbprint: %w", err)
 	}
-	return oldValue.ConnectorData, nil
-}
-
-// ClearConnectorData clears the value of the "connector_data" field.
-func (m *AuthCodeMutation) ClearConnectorData() {
-	m.connector_data = nil
-	m.clearedFields[authcode.FieldConnectorData] = struct{}{}
-}
-
-// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
-func (m *AuthCodeMutation) ConnectorDataCleared() bool {
-	_, ok := m.clearedFields[authcode.FieldConnectorData]
-	return ok
+	return oldValue.DpopKeyThumbprint, nil
 }
 
-// ResetConnectorData resets all changes to the "connector_data" field.
-func (m *AuthCodeMutation) ResetConnectorData() {
-	m.connector_data = nil
-	delete(m.clearedFields, authcode.FieldConnectorData)
+// ResetDpopKeyThumbprint resets all changes to the "dpop_key_thumbprint" field.
+func (m *AccessTokenMutation) ResetDpopKeyThumbprint() {
+	m.dpop_key_thumbprint = nil
 }
 
-// SetExpiry sets the "expiry" field.
-func (m *AuthCodeMutation) SetExpiry(t time.Time) {
-	m.expiry = &t
+// SetRefreshTokenID sets the "refresh_token_id" field.
+func (m *AccessTokenMutation) SetRefreshTokenID(s string) {
+	m.refresh_token_id = &s
 }
 
-// Expiry returns the value of the "expiry" field in the mutation.
-func (m *AuthCodeMutation) Expiry() (r time.Time, exists bool) {
-	v := m.expiry
+// RefreshTokenID returns the value of the "refresh_token_id" field in the mutation.
+func (m *AccessTokenMutation) RefreshTokenID() (r string, exists bool) {
+	v := m.refresh_token_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldRefreshTokenID returns the old "refresh_token_id" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+func (m *AccessTokenMutation) OldRefreshTokenID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefreshTokenID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiry requires an ID field in the mutation")
+		return v, errors.New("OldRefreshTokenID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldRefreshTokenID: %w", err)
 	}
-	return oldValue.Expiry, nil
+	return oldValue.RefreshTokenID, nil
 }
 
-// ResetExpiry resets all changes to the "expiry" field.
-func (m *AuthCodeMutation) ResetExpiry() {
-	m.expiry = nil
+// ResetRefreshTokenID resets all changes to the "refresh_token_id" field.
+func (m *AccessTokenMutation) ResetRefreshTokenID() {
+	m.refresh_token_id = nil
 }
 
-// SetCodeChallenge sets the "code_challenge" field.
-func (m *AuthCodeMutation) SetCodeChallenge(s string) {
-	m.code_challenge = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AccessTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CodeChallenge returns the value of the "code_challenge" field in the mutation.
-func (m *AuthCodeMutation) CodeChallenge() (r string, exists bool) {
-	v := m.code_challenge
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccessTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCodeChallenge returns the old "code_challenge" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldCodeChallenge(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCodeChallenge is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCodeChallenge requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCodeChallenge: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.CodeChallenge, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCodeChallenge resets all changes to the "code_challenge" field.
-func (m *AuthCodeMutation) ResetCodeChallenge() {
-	m.code_challenge = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccessTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCodeChallengeMethod sets the "code_challenge_method" field.
-func (m *AuthCodeMutation) SetCodeChallengeMethod(s string) {
-	m.code_challenge_method = &s
+// SetExpiry sets the "expiry" field.
+func (m *AccessTokenMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
 }
 
-// CodeChallengeMethod returns the value of the "code_challenge_method" field in the mutation.
-func (m *AuthCodeMutation) CodeChallengeMethod() (r string, exists bool) {
-	v := m.code_challenge_method
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *AccessTokenMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCodeChallengeMethod returns the old "code_challenge_method" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiry returns the old "expiry" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldCodeChallengeMethod(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCodeChallengeMethod is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCodeChallengeMethod requires an ID field in the mutation")
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCodeChallengeMethod: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
 	}
-	return oldValue.CodeChallengeMethod, nil
+	return oldValue.Expiry, nil
 }
 
-// ResetCodeChallengeMethod resets all changes to the "code_challenge_method" field.
-func (m *AuthCodeMutation) ResetCodeChallengeMethod() {
-	m.code_challenge_method = nil
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *AccessTokenMutation) ResetExpiry() {
+	m.expiry = nil
 }
 
-// Where appends a list predicates to the AuthCodeMutation builder.
-func (m *AuthCodeMutation) Where(ps ...predicate.AuthCode) {
+// Where appends a list predicates to the AccessTokenMutation builder.
+func (m *AccessTokenMutation) Where(ps ...predicate.AccessToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthCodeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AccessTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthCodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthCode, len(ps))
+func (m *AccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -807,69 +890,72 @@ func (m *AuthCodeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthCodeMutation) Op() Op {
+func (m *AccessTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthCodeMutation) SetOp(op Op) {
+func (m *AccessTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthCode).
-func (m *AuthCodeMutation) Type() string {
+// Type returns the node type of this mutation (AccessToken).
+func (m *AccessTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthCodeMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+func (m *AccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 16)
 	if m.client_id != nil {
-		fields = append(fields, authcode.FieldClientID)
+		fields = append(fields, accesstoken.FieldClientID)
 	}
 	if m.scopes != nil {
-		fields = append(fields, authcode.FieldScopes)
+		fields = append(fields, accesstoken.FieldScopes)
 	}
-	if m.nonce != nil {
-		fields = append(fields, authcode.FieldNonce)
+	if m.audience != nil {
+		fields = append(fields, accesstoken.FieldAudience)
 	}
-	if m.redirect_uri != nil {
-		fields = append(fields, authcode.FieldRedirectURI)
+	if m.connector_id != nil {
+		fields = append(fields, accesstoken.FieldConnectorID)
 	}
 	if m.claims_user_id != nil {
-		fields = append(fields, authcode.FieldClaimsUserID)
+		fields = append(fields, accesstoken.FieldClaimsUserID)
 	}
 	if m.claims_username != nil {
-		fields = append(fields, authcode.FieldClaimsUsername)
+		fields = append(fields, accesstoken.FieldClaimsUsername)
+	}
+	if m.claims_preferred_username != nil {
+		fields = append(fields, accesstoken.FieldClaimsPreferredUsername)
 	}
 	if m.claims_email != nil {
-		fields = append(fields, authcode.FieldClaimsEmail)
+		fields = append(fields, accesstoken.FieldClaimsEmail)
 	}
 	if m.claims_email_verified != nil {
-		fields = append(fields, authcode.FieldClaimsEmailVerified)
+		fields = append(fields, accesstoken.FieldClaimsEmailVerified)
 	}
 	if m.claims_groups != nil {
-		fields = append(fields, authcode.FieldClaimsGroups)
+		fields = append(fields, accesstoken.FieldClaimsGroups)
 	}
-	if m.claims_preferred_username != nil {
-		fields = append(fields, authcode.FieldClaimsPreferredUsername)
+	if m.claims_extra != nil {
+		fields = append(fields, accesstoken.FieldClaimsExtra)
 	}
-	if m.connector_id != nil {
-		fields = append(fields, authcode.FieldConnectorID)
+	if m.certificate_thumbprint != nil {
+		fields = append(fields, accesstoken.FieldCertificateThumbprint)
 	}
-	if m.connector_data != nil {
-		fields = append(fields, authcode.FieldConnectorData)
+	if m.dpop_key_thumbprint != nil {
+		fields = append(fields, accesstoken.FieldDpopKeyThumbprint)
 	}
-	if m.expiry != nil {
-		fields = append(fields, authcode.FieldExpiry)
+	if m.refresh_token_id != nil {
+		fields = append(fields, accesstoken.FieldRefreshTokenID)
 	}
-	if m.code_challenge != nil {
-		fields = append(fields, authcode.FieldCodeChallenge)
+	if m.created_at != nil {
+		fields = append(fields, accesstoken.FieldCreatedAt)
 	}
-	if m.code_challenge_method != nil {
-		fields = append(fields, authcode.FieldCodeChallengeMethod)
+	if m.expiry != nil {
+		fields = append(fields, accesstoken.FieldExpiry)
 	}
 	return fields
 }
@@ -877,38 +963,40 @@ func (m *AuthCodeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthCodeMutation) Field(name string) (ent.Value, bool) {
+func (m *AccessTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authcode.FieldClientID:
+	case accesstoken.FieldClientID:
 		return m.ClientID()
-	case authcode.FieldScopes:
+	case accesstoken.FieldScopes:
 		return m.Scopes()
-	case authcode.FieldNonce:
-		return m.Nonce()
-	case authcode.FieldRedirectURI:
-		return m.RedirectURI()
-	case authcode.FieldClaimsUserID:
+	case accesstoken.FieldAudience:
+		return m.Audience()
+	case accesstoken.FieldConnectorID:
+		return m.ConnectorID()
+	case accesstoken.FieldClaimsUserID:
 		return m.ClaimsUserID()
-	case authcode.FieldClaimsUsername:
+	case accesstoken.FieldClaimsUsername:
 		return m.ClaimsUsername()
-	case authcode.FieldClaimsEmail:
+	case accesstoken.FieldClaimsPreferredUsername:
+		return m.ClaimsPreferredUsername()
+	case accesstoken.FieldClaimsEmail:
 		return m.ClaimsEmail()
-	case authcode.FieldClaimsEmailVerified:
+	case accesstoken.FieldClaimsEmailVerified:
 		return m.ClaimsEmailVerified()
-	case authcode.FieldClaimsGroups:
+	case accesstoken.FieldClaimsGroups:
 		return m.ClaimsGroups()
-	case authcode.FieldClaimsPreferredUsername:
-		return m.ClaimsPreferredUsername()
-	case authcode.FieldConnectorID:
-		return m.ConnectorID()
-	case authcode.FieldConnectorData:
-		return m.ConnectorData()
-	case authcode.FieldExpiry:
+	case accesstoken.FieldClaimsExtra:
+		return m.ClaimsExtra()
+	case accesstoken.FieldCertificateThumbprint:
+		return m.CertificateThumbprint()
+	case accesstoken.FieldDpopKeyThumbprint:
+		return m.DpopKeyThumbprint()
+	case accesstoken.FieldRefreshTokenID:
+		return m.RefreshTokenID()
+	case accesstoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case accesstoken.FieldExpiry:
 		return m.Expiry()
-	case authcode.FieldCodeChallenge:
-		return m.CodeChallenge()
-	case authcode.FieldCodeChallengeMethod:
-		return m.CodeChallengeMethod()
 	}
 	return nil, false
 }
@@ -916,321 +1004,339 @@ func (m *AuthCodeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authcode.FieldClientID:
+	case accesstoken.FieldClientID:
 		return m.OldClientID(ctx)
-	case authcode.FieldScopes:
+	case accesstoken.FieldScopes:
 		return m.OldScopes(ctx)
-	case authcode.FieldNonce:
-		return m.OldNonce(ctx)
-	case authcode.FieldRedirectURI:
-		return m.OldRedirectURI(ctx)
-	case authcode.FieldClaimsUserID:
+	case accesstoken.FieldAudience:
+		return m.OldAudience(ctx)
+	case accesstoken.FieldConnectorID:
+		return m.OldConnectorID(ctx)
+	case accesstoken.FieldClaimsUserID:
 		return m.OldClaimsUserID(ctx)
-	case authcode.FieldClaimsUsername:
+	case accesstoken.FieldClaimsUsername:
 		return m.OldClaimsUsername(ctx)
-	case authcode.FieldClaimsEmail:
+	case accesstoken.FieldClaimsPreferredUsername:
+		return m.OldClaimsPreferredUsername(ctx)
+	case accesstoken.FieldClaimsEmail:
 		return m.OldClaimsEmail(ctx)
-	case authcode.FieldClaimsEmailVerified:
+	case accesstoken.FieldClaimsEmailVerified:
 		return m.OldClaimsEmailVerified(ctx)
-	case authcode.FieldClaimsGroups:
+	case accesstoken.FieldClaimsGroups:
 		return m.OldClaimsGroups(ctx)
-	case authcode.FieldClaimsPreferredUsername:
-		return m.OldClaimsPreferredUsername(ctx)
-	case authcode.FieldConnectorID:
-		return m.OldConnectorID(ctx)
-	case authcode.FieldConnectorData:
-		return m.OldConnectorData(ctx)
-	case authcode.FieldExpiry:
+	case accesstoken.FieldClaimsExtra:
+		return m.OldClaimsExtra(ctx)
+	case accesstoken.FieldCertificateThumbprint:
+		return m.OldCertificateThumbprint(ctx)
+	case accesstoken.FieldDpopKeyThumbprint:
+		return m.OldDpopKeyThumbprint(ctx)
+	case accesstoken.FieldRefreshTokenID:
+		return m.OldRefreshTokenID(ctx)
+	case accesstoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case accesstoken.FieldExpiry:
 		return m.OldExpiry(ctx)
-	case authcode.FieldCodeChallenge:
-		return m.OldCodeChallenge(ctx)
-	case authcode.FieldCodeChallengeMethod:
-		return m.OldCodeChallengeMethod(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthCode field %s", name)
+	return nil, fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthCodeMutation) SetField(name string, value ent.Value) error {
+func (m *AccessTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authcode.FieldClientID:
+	case accesstoken.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClientID(v)
 		return nil
-	case authcode.FieldScopes:
+	case accesstoken.FieldScopes:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetScopes(v)
 		return nil
-	case authcode.FieldNonce:
-		v, ok := value.(string)
+	case accesstoken.FieldAudience:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNonce(v)
+		m.SetAudience(v)
 		return nil
-	case authcode.FieldRedirectURI:
+	case accesstoken.FieldConnectorID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRedirectURI(v)
+		m.SetConnectorID(v)
 		return nil
-	case authcode.FieldClaimsUserID:
+	case accesstoken.FieldClaimsUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsUserID(v)
 		return nil
-	case authcode.FieldClaimsUsername:
+	case accesstoken.FieldClaimsUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsUsername(v)
 		return nil
-	case authcode.FieldClaimsEmail:
+	case accesstoken.FieldClaimsPreferredUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsPreferredUsername(v)
+		return nil
+	case accesstoken.FieldClaimsEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsEmail(v)
 		return nil
-	case authcode.FieldClaimsEmailVerified:
+	case accesstoken.FieldClaimsEmailVerified:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsEmailVerified(v)
 		return nil
-	case authcode.FieldClaimsGroups:
+	case accesstoken.FieldClaimsGroups:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsGroups(v)
 		return nil
-	case authcode.FieldClaimsPreferredUsername:
-		v, ok := value.(string)
+	case accesstoken.FieldClaimsExtra:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsPreferredUsername(v)
+		m.SetClaimsExtra(v)
 		return nil
-	case authcode.FieldConnectorID:
+	case accesstoken.FieldCertificateThumbprint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnectorID(v)
+		m.SetCertificateThumbprint(v)
 		return nil
-	case authcode.FieldConnectorData:
-		v, ok := value.([]byte)
+	case accesstoken.FieldDpopKeyThumbprint:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnectorData(v)
+		m.SetDpopKeyThumbprint(v)
 		return nil
-	case authcode.FieldExpiry:
-		v, ok := value.(time.Time)
+	case accesstoken.FieldRefreshTokenID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiry(v)
+		m.SetRefreshTokenID(v)
 		return nil
-	case authcode.FieldCodeChallenge:
-		v, ok := value.(string)
+	case accesstoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCodeChallenge(v)
+		m.SetCreatedAt(v)
 		return nil
-	case authcode.FieldCodeChallengeMethod:
-		v, ok := value.(string)
+	case accesstoken.FieldExpiry:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCodeChallengeMethod(v)
+		m.SetExpiry(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuthCode field %s", name)
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthCodeMutation) AddedFields() []string {
+func (m *AccessTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthCodeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AccessTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthCodeMutation) AddField(name string, value ent.Value) error {
+func (m *AccessTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuthCode numeric field %s", name)
+	return fmt.Errorf("unknown AccessToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthCodeMutation) ClearedFields() []string {
+func (m *AccessTokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(authcode.FieldScopes) {
-		fields = append(fields, authcode.FieldScopes)
+	if m.FieldCleared(accesstoken.FieldScopes) {
+		fields = append(fields, accesstoken.FieldScopes)
 	}
-	if m.FieldCleared(authcode.FieldClaimsGroups) {
-		fields = append(fields, authcode.FieldClaimsGroups)
+	if m.FieldCleared(accesstoken.FieldAudience) {
+		fields = append(fields, accesstoken.FieldAudience)
 	}
-	if m.FieldCleared(authcode.FieldConnectorData) {
-		fields = append(fields, authcode.FieldConnectorData)
+	if m.FieldCleared(accesstoken.FieldClaimsGroups) {
+		fields = append(fields, accesstoken.FieldClaimsGroups)
+	}
+	if m.FieldCleared(accesstoken.FieldClaimsExtra) {
+		fields = append(fields, accesstoken.FieldClaimsExtra)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthCodeMutation) FieldCleared(name string) bool {
+func (m *AccessTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthCodeMutation) ClearField(name string) error {
+func (m *AccessTokenMutation) ClearField(name string) error {
 	switch name {
-	case authcode.FieldScopes:
+	case accesstoken.FieldScopes:
 		m.ClearScopes()
 		return nil
-	case authcode.FieldClaimsGroups:
+	case accesstoken.FieldAudience:
+		m.ClearAudience()
+		return nil
+	case accesstoken.FieldClaimsGroups:
 		m.ClearClaimsGroups()
 		return nil
-	case authcode.FieldConnectorData:
-		m.ClearConnectorData()
+	case accesstoken.FieldClaimsExtra:
+		m.ClearClaimsExtra()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthCode nullable field %s", name)
+	return fmt.Errorf("unknown AccessToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthCodeMutation) ResetField(name string) error {
+func (m *AccessTokenMutation) ResetField(name string) error {
 	switch name {
-	case authcode.FieldClientID:
+	case accesstoken.FieldClientID:
 		m.ResetClientID()
 		return nil
-	case authcode.FieldScopes:
+	case accesstoken.FieldScopes:
 		m.ResetScopes()
 		return nil
-	case authcode.FieldNonce:
-		m.ResetNonce()
+	case accesstoken.FieldAudience:
+		m.ResetAudience()
 		return nil
-	case authcode.FieldRedirectURI:
-		m.ResetRedirectURI()
+	case accesstoken.FieldConnectorID:
+		m.ResetConnectorID()
 		return nil
-	case authcode.FieldClaimsUserID:
+	case accesstoken.FieldClaimsUserID:
 		m.ResetClaimsUserID()
 		return nil
-	case authcode.FieldClaimsUsername:
+	case accesstoken.FieldClaimsUsername:
 		m.ResetClaimsUsername()
 		return nil
-	case authcode.FieldClaimsEmail:
+	case accesstoken.FieldClaimsPreferredUsername:
+		m.ResetClaimsPreferredUsername()
+		return nil
+	case accesstoken.FieldClaimsEmail:
 		m.ResetClaimsEmail()
 		return nil
-	case authcode.FieldClaimsEmailVerified:
+	case accesstoken.FieldClaimsEmailVerified:
 		m.ResetClaimsEmailVerified()
 		return nil
-	case authcode.FieldClaimsGroups:
+	case accesstoken.FieldClaimsGroups:
 		m.ResetClaimsGroups()
 		return nil
-	case authcode.FieldClaimsPreferredUsername:
-		m.ResetClaimsPreferredUsername()
+	case accesstoken.FieldClaimsExtra:
+		m.ResetClaimsExtra()
 		return nil
-	case authcode.FieldConnectorID:
-		m.ResetConnectorID()
+	case accesstoken.FieldCertificateThumbprint:
+		m.ResetCertificateThumbprint()
 		return nil
-	case authcode.FieldConnectorData:
-		m.ResetConnectorData()
+	case accesstoken.FieldDpopKeyThumbprint:
+		m.ResetDpopKeyThumbprint()
 		return nil
-	case authcode.FieldExpiry:
-		m.ResetExpiry()
+	case accesstoken.FieldRefreshTokenID:
+		m.ResetRefreshTokenID()
 		return nil
-	case authcode.FieldCodeChallenge:
-		m.ResetCodeChallenge()
+	case accesstoken.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case authcode.FieldCodeChallengeMethod:
-		m.ResetCodeChallengeMethod()
+	case accesstoken.FieldExpiry:
+		m.ResetExpiry()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthCode field %s", name)
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthCodeMutation) AddedEdges() []string {
+func (m *AccessTokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthCodeMutation) AddedIDs(name string) []ent.Value {
+func (m *AccessTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthCodeMutation) RemovedEdges() []string {
+func (m *AccessTokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthCodeMutation) RemovedIDs(name string) []ent.Value {
+func (m *AccessTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthCodeMutation) ClearedEdges() []string {
+func (m *AccessTokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthCodeMutation) EdgeCleared(name string) bool {
+func (m *AccessTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthCodeMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuthCode unique edge %s", name)
+func (m *AccessTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AccessToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthCodeMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuthCode edge %s", name)
+func (m *AccessTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AccessToken edge %s", name)
 }
 
-// AuthRequestMutation represents an operation that mutates the AuthRequest nodes in the graph.
-type AuthRequestMutation struct {
+// AuthCodeMutation represents an operation that mutates the AuthCode nodes in the graph.
+type AuthCodeMutation struct {
 	config
 	op                        Op
 	typ                       string
@@ -1238,43 +1344,43 @@ type AuthRequestMutation struct {
 	client_id                 *string
 	scopes                    *[]string
 	appendscopes              []string
-	response_types            *[]string
-	appendresponse_types      []string
-	redirect_uri              *string
 	nonce                     *string
-	state                     *string
-	force_approval_prompt     *bool
-	logged_in                 *bool
+	redirect_uri              *string
 	claims_user_id            *string
 	claims_username           *string
 	claims_email              *string
 	claims_email_verified     *bool
 	claims_groups             *[]string
 	appendclaims_groups       []string
+	claims_extra              *map[string]interface{}
 	claims_preferred_username *string
 	connector_id              *string
 	connector_data            *[]byte
 	expiry                    *time.Time
 	code_challenge            *string
 	code_challenge_method     *string
-	hmac_key                  *[]byte
+	acr                       *string
+	amr                       *[]string
+	appendamr                 []string
+	auth_time                 *time.Time
+	sid                       *string
 	clearedFields             map[string]struct{}
 	done                      bool
-	oldValue                  func(context.Context) (*AuthRequest, error)
-	predicates                []predicate.AuthRequest
+	oldValue                  func(context.Context) (*AuthCode, error)
+	predicates                []predicate.AuthCode
 }
 
-var _ ent.Mutation = (*AuthRequestMutation)(nil)
+var _ ent.Mutation = (*AuthCodeMutation)(nil)
 
-// authrequestOption allows management of the mutation configuration using functional options.
-type authrequestOption func(*AuthRequestMutation)
+// authcodeOption allows management of the mutation configuration using functional options.
+type authcodeOption func(*AuthCodeMutation)
 
-// newAuthRequestMutation creates new mutation for the AuthRequest entity.
-func newAuthRequestMutation(c config, op Op, opts ...authrequestOption) *AuthRequestMutation {
-	m := &AuthRequestMutation{
+// newAuthCodeMutation creates new mutation for the AuthCode entity.
+func newAuthCodeMutation(c config, op Op, opts ...authcodeOption) *AuthCodeMutation {
+	m := &AuthCodeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthRequest,
+		typ:           TypeAuthCode,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1283,20 +1389,20 @@ func newAuthRequestMutation(c config, op Op, opts ...authrequestOption) *AuthReq
 	return m
 }
 
-// withAuthRequestID sets the ID field of the mutation.
-func withAuthRequestID(id string) authrequestOption {
-	return func(m *AuthRequestMutation) {
+// withAuthCodeID sets the ID field of the mutation.
+func withAuthCodeID(id string) authcodeOption {
+	return func(m *AuthCodeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthRequest
+			value *AuthCode
 		)
-		m.oldValue = func(ctx context.Context) (*AuthRequest, error) {
+		m.oldValue = func(ctx context.Context) (*AuthCode, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthRequest.Get(ctx, id)
+					value, err = m.Client().AuthCode.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1305,10 +1411,10 @@ func withAuthRequestID(id string) authrequestOption {
 	}
 }
 
-// withAuthRequest sets the old AuthRequest of the mutation.
-func withAuthRequest(node *AuthRequest) authrequestOption {
-	return func(m *AuthRequestMutation) {
-		m.oldValue = func(context.Context) (*AuthRequest, error) {
+// withAuthCode sets the old AuthCode of the mutation.
+func withAuthCode(node *AuthCode) authcodeOption {
+	return func(m *AuthCodeMutation) {
+		m.oldValue = func(context.Context) (*AuthCode, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1317,7 +1423,7 @@ func withAuthRequest(node *AuthRequest) authrequestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthRequestMutation) Client() *Client {
+func (m AuthCodeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1325,7 +1431,7 @@ func (m AuthRequestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthRequestMutation) Tx() (*Tx, error) {
+func (m AuthCodeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -1335,14 +1441,14 @@ func (m AuthRequestMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthRequest entities.
-func (m *AuthRequestMutation) SetID(id string) {
+// operation is only accepted on creation of AuthCode entities.
+func (m *AuthCodeMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthRequestMutation) ID() (id string, exists bool) {
+func (m *AuthCodeMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1353,7 +1459,7 @@ func (m *AuthRequestMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthRequestMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AuthCodeMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1362,19 +1468,19 @@ func (m *AuthRequestMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthRequest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthCode.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetClientID sets the "client_id" field.
-func (m *AuthRequestMutation) SetClientID(s string) {
+func (m *AuthCodeMutation) SetClientID(s string) {
 	m.client_id = &s
 }
 
 // ClientID returns the value of the "client_id" field in the mutation.
-func (m *AuthRequestMutation) ClientID() (r string, exists bool) {
+func (m *AuthCodeMutation) ClientID() (r string, exists bool) {
 	v := m.client_id
 	if v == nil {
 		return
@@ -1382,10 +1488,10 @@ func (m *AuthRequestMutation) ClientID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
@@ -1400,18 +1506,18 @@ func (m *AuthRequestMutation) OldClientID(ctx context.Context) (v string, err er
 }
 
 // ResetClientID resets all changes to the "client_id" field.
-func (m *AuthRequestMutation) ResetClientID() {
+func (m *AuthCodeMutation) ResetClientID() {
 	m.client_id = nil
 }
 
 // SetScopes sets the "scopes" field.
-func (m *AuthRequestMutation) SetScopes(s []string) {
+func (m *AuthCodeMutation) SetScopes(s []string) {
 	m.scopes = &s
 	m.appendscopes = nil
 }
 
 // Scopes returns the value of the "scopes" field in the mutation.
-func (m *AuthRequestMutation) Scopes() (r []string, exists bool) {
+func (m *AuthCodeMutation) Scopes() (r []string, exists bool) {
 	v := m.scopes
 	if v == nil {
 		return
@@ -1419,10 +1525,10 @@ func (m *AuthRequestMutation) Scopes() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *AuthCodeMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
@@ -1437,12 +1543,12 @@ func (m *AuthRequestMutation) OldScopes(ctx context.Context) (v []string, err er
 }
 
 // AppendScopes adds s to the "scopes" field.
-func (m *AuthRequestMutation) AppendScopes(s []string) {
+func (m *AuthCodeMutation) AppendScopes(s []string) {
 	m.appendscopes = append(m.appendscopes, s...)
 }
 
 // AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *AuthRequestMutation) AppendedScopes() ([]string, bool) {
+func (m *AuthCodeMutation) AppendedScopes() ([]string, bool) {
 	if len(m.appendscopes) == 0 {
 		return nil, false
 	}
@@ -1450,97 +1556,68 @@ func (m *AuthRequestMutation) AppendedScopes() ([]string, bool) {
 }
 
 // ClearScopes clears the value of the "scopes" field.
-func (m *AuthRequestMutation) ClearScopes() {
+func (m *AuthCodeMutation) ClearScopes() {
 	m.scopes = nil
 	m.appendscopes = nil
-	m.clearedFields[authrequest.FieldScopes] = struct{}{}
+	m.clearedFields[authcode.FieldScopes] = struct{}{}
 }
 
 // ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *AuthRequestMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[authrequest.FieldScopes]
+func (m *AuthCodeMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[authcode.FieldScopes]
 	return ok
 }
 
 // ResetScopes resets all changes to the "scopes" field.
-func (m *AuthRequestMutation) ResetScopes() {
+func (m *AuthCodeMutation) ResetScopes() {
 	m.scopes = nil
 	m.appendscopes = nil
-	delete(m.clearedFields, authrequest.FieldScopes)
+	delete(m.clearedFields, authcode.FieldScopes)
 }
 
-// SetResponseTypes sets the "response_types" field.
-func (m *AuthRequestMutation) SetResponseTypes(s []string) {
-	m.response_types = &s
-	m.appendresponse_types = nil
+// SetNonce sets the "nonce" field.
+func (m *AuthCodeMutation) SetNonce(s string) {
+	m.nonce = &s
 }
 
-// ResponseTypes returns the value of the "response_types" field in the mutation.
-func (m *AuthRequestMutation) ResponseTypes() (r []string, exists bool) {
-	v := m.response_types
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *AuthCodeMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResponseTypes returns the old "response_types" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldNonce returns the old "nonce" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldResponseTypes(ctx context.Context) (v []string, err error) {
+func (m *AuthCodeMutation) OldNonce(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResponseTypes is only allowed on UpdateOne operations")
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResponseTypes requires an ID field in the mutation")
+		return v, errors.New("OldNonce requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResponseTypes: %w", err)
-	}
-	return oldValue.ResponseTypes, nil
-}
-
-// AppendResponseTypes adds s to the "response_types" field.
-func (m *AuthRequestMutation) AppendResponseTypes(s []string) {
-	m.appendresponse_types = append(m.appendresponse_types, s...)
-}
-
-// AppendedResponseTypes returns the list of values that were appended to the "response_types" field in this mutation.
-func (m *AuthRequestMutation) AppendedResponseTypes() ([]string, bool) {
-	if len(m.appendresponse_types) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
 	}
-	return m.appendresponse_types, true
-}
-
-// ClearResponseTypes clears the value of the "response_types" field.
-func (m *AuthRequestMutation) ClearResponseTypes() {
-	m.response_types = nil
-	m.appendresponse_types = nil
-	m.clearedFields[authrequest.FieldResponseTypes] = struct{}{}
-}
-
-// ResponseTypesCleared returns if the "response_types" field was cleared in this mutation.
-func (m *AuthRequestMutation) ResponseTypesCleared() bool {
-	_, ok := m.clearedFields[authrequest.FieldResponseTypes]
-	return ok
+	return oldValue.Nonce, nil
 }
 
-// ResetResponseTypes resets all changes to the "response_types" field.
-func (m *AuthRequestMutation) ResetResponseTypes() {
-	m.response_types = nil
-	m.appendresponse_types = nil
-	delete(m.clearedFields, authrequest.FieldResponseTypes)
+// ResetNonce resets all changes to the "nonce" field.
+func (m *AuthCodeMutation) ResetNonce() {
+	m.nonce = nil
 }
 
 // SetRedirectURI sets the "redirect_uri" field.
-func (m *AuthRequestMutation) SetRedirectURI(s string) {
+func (m *AuthCodeMutation) SetRedirectURI(s string) {
 	m.redirect_uri = &s
 }
 
 // RedirectURI returns the value of the "redirect_uri" field in the mutation.
-func (m *AuthRequestMutation) RedirectURI() (r string, exists bool) {
+func (m *AuthCodeMutation) RedirectURI() (r string, exists bool) {
 	v := m.redirect_uri
 	if v == nil {
 		return
@@ -1548,10 +1625,10 @@ func (m *AuthRequestMutation) RedirectURI() (r string, exists bool) {
 	return *v, true
 }
 
-// OldRedirectURI returns the old "redirect_uri" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldRedirectURI returns the old "redirect_uri" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldRedirectURI(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldRedirectURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRedirectURI is only allowed on UpdateOne operations")
 	}
@@ -1566,249 +1643,105 @@ func (m *AuthRequestMutation) OldRedirectURI(ctx context.Context) (v string, err
 }
 
 // ResetRedirectURI resets all changes to the "redirect_uri" field.
-func (m *AuthRequestMutation) ResetRedirectURI() {
+func (m *AuthCodeMutation) ResetRedirectURI() {
 	m.redirect_uri = nil
 }
 
-// SetNonce sets the "nonce" field.
-func (m *AuthRequestMutation) SetNonce(s string) {
-	m.nonce = &s
+// SetClaimsUserID sets the "claims_user_id" field.
+func (m *AuthCodeMutation) SetClaimsUserID(s string) {
+	m.claims_user_id = &s
 }
 
-// Nonce returns the value of the "nonce" field in the mutation.
-func (m *AuthRequestMutation) Nonce() (r string, exists bool) {
-	v := m.nonce
+// ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
+func (m *AuthCodeMutation) ClaimsUserID() (r string, exists bool) {
+	v := m.claims_user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNonce returns the old "nonce" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUserID returns the old "claims_user_id" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldNonce(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNonce requires an ID field in the mutation")
+		return v, errors.New("OldClaimsUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsUserID: %w", err)
 	}
-	return oldValue.Nonce, nil
+	return oldValue.ClaimsUserID, nil
 }
 
-// ResetNonce resets all changes to the "nonce" field.
-func (m *AuthRequestMutation) ResetNonce() {
-	m.nonce = nil
+// ResetClaimsUserID resets all changes to the "claims_user_id" field.
+func (m *AuthCodeMutation) ResetClaimsUserID() {
+	m.claims_user_id = nil
 }
 
-// SetState sets the "state" field.
-func (m *AuthRequestMutation) SetState(s string) {
-	m.state = &s
+// SetClaimsUsername sets the "claims_username" field.
+func (m *AuthCodeMutation) SetClaimsUsername(s string) {
+	m.claims_username = &s
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *AuthRequestMutation) State() (r string, exists bool) {
-	v := m.state
+// ClaimsUsername returns the value of the "claims_username" field in the mutation.
+func (m *AuthCodeMutation) ClaimsUsername() (r string, exists bool) {
+	v := m.claims_username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUsername returns the old "claims_username" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldState(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldClaimsUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsUsername: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.ClaimsUsername, nil
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *AuthRequestMutation) ResetState() {
-	m.state = nil
+// ResetClaimsUsername resets all changes to the "claims_username" field.
+func (m *AuthCodeMutation) ResetClaimsUsername() {
+	m.claims_username = nil
 }
 
-// SetForceApprovalPrompt sets the "force_approval_prompt" field.
-func (m *AuthRequestMutation) SetForceApprovalPrompt(b bool) {
-	m.force_approval_prompt = &b
+// SetClaimsEmail sets the "claims_email" field.
+func (m *AuthCodeMutation) SetClaimsEmail(s string) {
+	m.claims_email = &s
 }
 
-// ForceApprovalPrompt returns the value of the "force_approval_prompt" field in the mutation.
-func (m *AuthRequestMutation) ForceApprovalPrompt() (r bool, exists bool) {
-	v := m.force_approval_prompt
+// ClaimsEmail returns the value of the "claims_email" field in the mutation.
+func (m *AuthCodeMutation) ClaimsEmail() (r string, exists bool) {
+	v := m.claims_email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldForceApprovalPrompt returns the old "force_approval_prompt" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmail returns the old "claims_email" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldForceApprovalPrompt(ctx context.Context) (v bool, err error) {
+func (m *AuthCodeMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldForceApprovalPrompt is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldForceApprovalPrompt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldForceApprovalPrompt: %w", err)
-	}
-	return oldValue.ForceApprovalPrompt, nil
-}
-
-// ResetForceApprovalPrompt resets all changes to the "force_approval_prompt" field.
-func (m *AuthRequestMutation) ResetForceApprovalPrompt() {
-	m.force_approval_prompt = nil
-}
-
-// SetLoggedIn sets the "logged_in" field.
-func (m *AuthRequestMutation) SetLoggedIn(b bool) {
-	m.logged_in = &b
-}
-
-// LoggedIn returns the value of the "logged_in" field in the mutation.
-func (m *AuthRequestMutation) LoggedIn() (r bool, exists bool) {
-	v := m.logged_in
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLoggedIn returns the old "logged_in" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldLoggedIn(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLoggedIn is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLoggedIn requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLoggedIn: %w", err)
-	}
-	return oldValue.LoggedIn, nil
-}
-
-// ResetLoggedIn resets all changes to the "logged_in" field.
-func (m *AuthRequestMutation) ResetLoggedIn() {
-	m.logged_in = nil
-}
-
-// SetClaimsUserID sets the "claims_user_id" field.
-func (m *AuthRequestMutation) SetClaimsUserID(s string) {
-	m.claims_user_id = &s
-}
-
-// ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
-func (m *AuthRequestMutation) ClaimsUserID() (r string, exists bool) {
-	v := m.claims_user_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldClaimsUserID returns the old "claims_user_id" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsUserID: %w", err)
-	}
-	return oldValue.ClaimsUserID, nil
-}
-
-// ResetClaimsUserID resets all changes to the "claims_user_id" field.
-func (m *AuthRequestMutation) ResetClaimsUserID() {
-	m.claims_user_id = nil
-}
-
-// SetClaimsUsername sets the "claims_username" field.
-func (m *AuthRequestMutation) SetClaimsUsername(s string) {
-	m.claims_username = &s
-}
-
-// ClaimsUsername returns the value of the "claims_username" field in the mutation.
-func (m *AuthRequestMutation) ClaimsUsername() (r string, exists bool) {
-	v := m.claims_username
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldClaimsUsername returns the old "claims_username" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsUsername: %w", err)
-	}
-	return oldValue.ClaimsUsername, nil
-}
-
-// ResetClaimsUsername resets all changes to the "claims_username" field.
-func (m *AuthRequestMutation) ResetClaimsUsername() {
-	m.claims_username = nil
-}
-
-// SetClaimsEmail sets the "claims_email" field.
-func (m *AuthRequestMutation) SetClaimsEmail(s string) {
-	m.claims_email = &s
-}
-
-// ClaimsEmail returns the value of the "claims_email" field in the mutation.
-func (m *AuthRequestMutation) ClaimsEmail() (r string, exists bool) {
-	v := m.claims_email
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldClaimsEmail returns the old "claims_email" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsEmail requires an ID field in the mutation")
+		return v, errors.New("OldClaimsEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
@@ -1818,17 +1751,17 @@ func (m *AuthRequestMutation) OldClaimsEmail(ctx context.Context) (v string, err
 }
 
 // ResetClaimsEmail resets all changes to the "claims_email" field.
-func (m *AuthRequestMutation) ResetClaimsEmail() {
+func (m *AuthCodeMutation) ResetClaimsEmail() {
 	m.claims_email = nil
 }
 
 // SetClaimsEmailVerified sets the "claims_email_verified" field.
-func (m *AuthRequestMutation) SetClaimsEmailVerified(b bool) {
+func (m *AuthCodeMutation) SetClaimsEmailVerified(b bool) {
 	m.claims_email_verified = &b
 }
 
 // ClaimsEmailVerified returns the value of the "claims_email_verified" field in the mutation.
-func (m *AuthRequestMutation) ClaimsEmailVerified() (r bool, exists bool) {
+func (m *AuthCodeMutation) ClaimsEmailVerified() (r bool, exists bool) {
 	v := m.claims_email_verified
 	if v == nil {
 		return
@@ -1836,10 +1769,10 @@ func (m *AuthRequestMutation) ClaimsEmailVerified() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
+func (m *AuthCodeMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsEmailVerified is only allowed on UpdateOne operations")
 	}
@@ -1854,18 +1787,18 @@ func (m *AuthRequestMutation) OldClaimsEmailVerified(ctx context.Context) (v boo
 }
 
 // ResetClaimsEmailVerified resets all changes to the "claims_email_verified" field.
-func (m *AuthRequestMutation) ResetClaimsEmailVerified() {
+func (m *AuthCodeMutation) ResetClaimsEmailVerified() {
 	m.claims_email_verified = nil
 }
 
 // SetClaimsGroups sets the "claims_groups" field.
-func (m *AuthRequestMutation) SetClaimsGroups(s []string) {
+func (m *AuthCodeMutation) SetClaimsGroups(s []string) {
 	m.claims_groups = &s
 	m.appendclaims_groups = nil
 }
 
 // ClaimsGroups returns the value of the "claims_groups" field in the mutation.
-func (m *AuthRequestMutation) ClaimsGroups() (r []string, exists bool) {
+func (m *AuthCodeMutation) ClaimsGroups() (r []string, exists bool) {
 	v := m.claims_groups
 	if v == nil {
 		return
@@ -1873,10 +1806,10 @@ func (m *AuthRequestMutation) ClaimsGroups() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsGroups returns the old "claims_groups" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsGroups returns the old "claims_groups" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
+func (m *AuthCodeMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsGroups is only allowed on UpdateOne operations")
 	}
@@ -1891,12 +1824,12 @@ func (m *AuthRequestMutation) OldClaimsGroups(ctx context.Context) (v []string,
 }
 
 // AppendClaimsGroups adds s to the "claims_groups" field.
-func (m *AuthRequestMutation) AppendClaimsGroups(s []string) {
+func (m *AuthCodeMutation) AppendClaimsGroups(s []string) {
 	m.appendclaims_groups = append(m.appendclaims_groups, s...)
 }
 
 // AppendedClaimsGroups returns the list of values that were appended to the "claims_groups" field in this mutation.
-func (m *AuthRequestMutation) AppendedClaimsGroups() ([]string, bool) {
+func (m *AuthCodeMutation) AppendedClaimsGroups() ([]string, bool) {
 	if len(m.appendclaims_groups) == 0 {
 		return nil, false
 	}
@@ -1904,32 +1837,81 @@ func (m *AuthRequestMutation) AppendedClaimsGroups() ([]string, bool) {
 }
 
 // ClearClaimsGroups clears the value of the "claims_groups" field.
-func (m *AuthRequestMutation) ClearClaimsGroups() {
+func (m *AuthCodeMutation) ClearClaimsGroups() {
 	m.claims_groups = nil
 	m.appendclaims_groups = nil
-	m.clearedFields[authrequest.FieldClaimsGroups] = struct{}{}
+	m.clearedFields[authcode.FieldClaimsGroups] = struct{}{}
 }
 
 // ClaimsGroupsCleared returns if the "claims_groups" field was cleared in this mutation.
-func (m *AuthRequestMutation) ClaimsGroupsCleared() bool {
-	_, ok := m.clearedFields[authrequest.FieldClaimsGroups]
+func (m *AuthCodeMutation) ClaimsGroupsCleared() bool {
+	_, ok := m.clearedFields[authcode.FieldClaimsGroups]
 	return ok
 }
 
 // ResetClaimsGroups resets all changes to the "claims_groups" field.
-func (m *AuthRequestMutation) ResetClaimsGroups() {
+func (m *AuthCodeMutation) ResetClaimsGroups() {
 	m.claims_groups = nil
 	m.appendclaims_groups = nil
-	delete(m.clearedFields, authrequest.FieldClaimsGroups)
+	delete(m.clearedFields, authcode.FieldClaimsGroups)
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (m *AuthCodeMutation) SetClaimsExtra(value map[string]interface{}) {
+	m.claims_extra = &value
+}
+
+// ClaimsExtra returns the value of the "claims_extra" field in the mutation.
+func (m *AuthCodeMutation) ClaimsExtra() (r map[string]interface{}, exists bool) {
+	v := m.claims_extra
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsExtra returns the old "claims_extra" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthCodeMutation) OldClaimsExtra(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsExtra is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsExtra requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsExtra: %w", err)
+	}
+	return oldValue.ClaimsExtra, nil
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (m *AuthCodeMutation) ClearClaimsExtra() {
+	m.claims_extra = nil
+	m.clearedFields[authcode.FieldClaimsExtra] = struct{}{}
+}
+
+// ClaimsExtraCleared returns if the "claims_extra" field was cleared in this mutation.
+func (m *AuthCodeMutation) ClaimsExtraCleared() bool {
+	_, ok := m.clearedFields[authcode.FieldClaimsExtra]
+	return ok
+}
+
+// ResetClaimsExtra resets all changes to the "claims_extra" field.
+func (m *AuthCodeMutation) ResetClaimsExtra() {
+	m.claims_extra = nil
+	delete(m.clearedFields, authcode.FieldClaimsExtra)
 }
 
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
-func (m *AuthRequestMutation) SetClaimsPreferredUsername(s string) {
+func (m *AuthCodeMutation) SetClaimsPreferredUsername(s string) {
 	m.claims_preferred_username = &s
 }
 
 // ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
-func (m *AuthRequestMutation) ClaimsPreferredUsername() (r string, exists bool) {
+func (m *AuthCodeMutation) ClaimsPreferredUsername() (r string, exists bool) {
 	v := m.claims_preferred_username
 	if v == nil {
 		return
@@ -1937,10 +1919,10 @@ func (m *AuthRequestMutation) ClaimsPreferredUsername() (r string, exists bool)
 	return *v, true
 }
 
-// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
 	}
@@ -1955,17 +1937,17 @@ func (m *AuthRequestMutation) OldClaimsPreferredUsername(ctx context.Context) (v
 }
 
 // ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
-func (m *AuthRequestMutation) ResetClaimsPreferredUsername() {
+func (m *AuthCodeMutation) ResetClaimsPreferredUsername() {
 	m.claims_preferred_username = nil
 }
 
 // SetConnectorID sets the "connector_id" field.
-func (m *AuthRequestMutation) SetConnectorID(s string) {
+func (m *AuthCodeMutation) SetConnectorID(s string) {
 	m.connector_id = &s
 }
 
 // ConnectorID returns the value of the "connector_id" field in the mutation.
-func (m *AuthRequestMutation) ConnectorID() (r string, exists bool) {
+func (m *AuthCodeMutation) ConnectorID() (r string, exists bool) {
 	v := m.connector_id
 	if v == nil {
 		return
@@ -1973,10 +1955,10 @@ func (m *AuthRequestMutation) ConnectorID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldConnectorID returns the old "connector_id" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectorID returns the old "connector_id" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldConnectorID(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldConnectorID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
 	}
@@ -1991,17 +1973,17 @@ func (m *AuthRequestMutation) OldConnectorID(ctx context.Context) (v string, err
 }
 
 // ResetConnectorID resets all changes to the "connector_id" field.
-func (m *AuthRequestMutation) ResetConnectorID() {
+func (m *AuthCodeMutation) ResetConnectorID() {
 	m.connector_id = nil
 }
 
 // SetConnectorData sets the "connector_data" field.
-func (m *AuthRequestMutation) SetConnectorData(b []byte) {
+func (m *AuthCodeMutation) SetConnectorData(b []byte) {
 	m.connector_data = &b
 }
 
 // ConnectorData returns the value of the "connector_data" field in the mutation.
-func (m *AuthRequestMutation) ConnectorData() (r []byte, exists bool) {
+func (m *AuthCodeMutation) ConnectorData() (r []byte, exists bool) {
 	v := m.connector_data
 	if v == nil {
 		return
@@ -2009,10 +1991,10 @@ func (m *AuthRequestMutation) ConnectorData() (r []byte, exists bool) {
 	return *v, true
 }
 
-// OldConnectorData returns the old "connector_data" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectorData returns the old "connector_data" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+func (m *AuthCodeMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
 	}
@@ -2027,30 +2009,30 @@ func (m *AuthRequestMutation) OldConnectorData(ctx context.Context) (v *[]byte,
 }
 
 // ClearConnectorData clears the value of the "connector_data" field.
-func (m *AuthRequestMutation) ClearConnectorData() {
+func (m *AuthCodeMutation) ClearConnectorData() {
 	m.connector_data = nil
-	m.clearedFields[authrequest.FieldConnectorData] = struct{}{}
+	m.clearedFields[authcode.FieldConnectorData] = struct{}{}
 }
 
 // ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
-func (m *AuthRequestMutation) ConnectorDataCleared() bool {
-	_, ok := m.clearedFields[authrequest.FieldConnectorData]
+func (m *AuthCodeMutation) ConnectorDataCleared() bool {
+	_, ok := m.clearedFields[authcode.FieldConnectorData]
 	return ok
 }
 
 // ResetConnectorData resets all changes to the "connector_data" field.
-func (m *AuthRequestMutation) ResetConnectorData() {
+func (m *AuthCodeMutation) ResetConnectorData() {
 	m.connector_data = nil
-	delete(m.clearedFields, authrequest.FieldConnectorData)
+	delete(m.clearedFields, authcode.FieldConnectorData)
 }
 
 // SetExpiry sets the "expiry" field.
-func (m *AuthRequestMutation) SetExpiry(t time.Time) {
+func (m *AuthCodeMutation) SetExpiry(t time.Time) {
 	m.expiry = &t
 }
 
 // Expiry returns the value of the "expiry" field in the mutation.
-func (m *AuthRequestMutation) Expiry() (r time.Time, exists bool) {
+func (m *AuthCodeMutation) Expiry() (r time.Time, exists bool) {
 	v := m.expiry
 	if v == nil {
 		return
@@ -2058,10 +2040,10 @@ func (m *AuthRequestMutation) Expiry() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiry returns the old "expiry" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+func (m *AuthCodeMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
@@ -2076,17 +2058,17 @@ func (m *AuthRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err e
 }
 
 // ResetExpiry resets all changes to the "expiry" field.
-func (m *AuthRequestMutation) ResetExpiry() {
+func (m *AuthCodeMutation) ResetExpiry() {
 	m.expiry = nil
 }
 
 // SetCodeChallenge sets the "code_challenge" field.
-func (m *AuthRequestMutation) SetCodeChallenge(s string) {
+func (m *AuthCodeMutation) SetCodeChallenge(s string) {
 	m.code_challenge = &s
 }
 
 // CodeChallenge returns the value of the "code_challenge" field in the mutation.
-func (m *AuthRequestMutation) CodeChallenge() (r string, exists bool) {
+func (m *AuthCodeMutation) CodeChallenge() (r string, exists bool) {
 	v := m.code_challenge
 	if v == nil {
 		return
@@ -2094,10 +2076,10 @@ func (m *AuthRequestMutation) CodeChallenge() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCodeChallenge returns the old "code_challenge" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldCodeChallenge returns the old "code_challenge" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldCodeChallenge(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldCodeChallenge(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCodeChallenge is only allowed on UpdateOne operations")
 	}
@@ -2112,17 +2094,17 @@ func (m *AuthRequestMutation) OldCodeChallenge(ctx context.Context) (v string, e
 }
 
 // ResetCodeChallenge resets all changes to the "code_challenge" field.
-func (m *AuthRequestMutation) ResetCodeChallenge() {
+func (m *AuthCodeMutation) ResetCodeChallenge() {
 	m.code_challenge = nil
 }
 
 // SetCodeChallengeMethod sets the "code_challenge_method" field.
-func (m *AuthRequestMutation) SetCodeChallengeMethod(s string) {
+func (m *AuthCodeMutation) SetCodeChallengeMethod(s string) {
 	m.code_challenge_method = &s
 }
 
 // CodeChallengeMethod returns the value of the "code_challenge_method" field in the mutation.
-func (m *AuthRequestMutation) CodeChallengeMethod() (r string, exists bool) {
+func (m *AuthCodeMutation) CodeChallengeMethod() (r string, exists bool) {
 	v := m.code_challenge_method
 	if v == nil {
 		return
@@ -2130,10 +2112,10 @@ func (m *AuthRequestMutation) CodeChallengeMethod() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCodeChallengeMethod returns the old "code_challenge_method" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldCodeChallengeMethod returns the old "code_challenge_method" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldCodeChallengeMethod(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldCodeChallengeMethod(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCodeChallengeMethod is only allowed on UpdateOne operations")
 	}
@@ -2148,55 +2130,205 @@ func (m *AuthRequestMutation) OldCodeChallengeMethod(ctx context.Context) (v str
 }
 
 // ResetCodeChallengeMethod resets all changes to the "code_challenge_method" field.
-func (m *AuthRequestMutation) ResetCodeChallengeMethod() {
+func (m *AuthCodeMutation) ResetCodeChallengeMethod() {
 	m.code_challenge_method = nil
 }
 
-// SetHmacKey sets the "hmac_key" field.
-func (m *AuthRequestMutation) SetHmacKey(b []byte) {
-	m.hmac_key = &b
+// SetAcr sets the "acr" field.
+func (m *AuthCodeMutation) SetAcr(s string) {
+	m.acr = &s
 }
 
-// HmacKey returns the value of the "hmac_key" field in the mutation.
-func (m *AuthRequestMutation) HmacKey() (r []byte, exists bool) {
-	v := m.hmac_key
+// Acr returns the value of the "acr" field in the mutation.
+func (m *AuthCodeMutation) Acr() (r string, exists bool) {
+	v := m.acr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHmacKey returns the old "hmac_key" field's value of the AuthRequest entity.
-// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldAcr returns the old "acr" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRequestMutation) OldHmacKey(ctx context.Context) (v []byte, err error) {
+func (m *AuthCodeMutation) OldAcr(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHmacKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHmacKey requires an ID field in the mutation")
+		return v, errors.New("OldAcr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHmacKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldAcr: %w", err)
 	}
-	return oldValue.HmacKey, nil
+	return oldValue.Acr, nil
 }
 
-// ResetHmacKey resets all changes to the "hmac_key" field.
-func (m *AuthRequestMutation) ResetHmacKey() {
-	m.hmac_key = nil
+// ResetAcr resets all changes to the "acr" field.
+func (m *AuthCodeMutation) ResetAcr() {
+	m.acr = nil
 }
 
-// Where appends a list predicates to the AuthRequestMutation builder.
-func (m *AuthRequestMutation) Where(ps ...predicate.AuthRequest) {
+// SetAmr sets the "amr" field.
+func (m *AuthCodeMutation) SetAmr(s []string) {
+	m.amr = &s
+	m.appendamr = nil
+}
+
+// Amr returns the value of the "amr" field in the mutation.
+func (m *AuthCodeMutation) Amr() (r []string, exists bool) {
+	v := m.amr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmr returns the old "amr" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthCodeMutation) OldAmr(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
+	}
+	return oldValue.Amr, nil
+}
+
+// AppendAmr adds s to the "amr" field.
+func (m *AuthCodeMutation) AppendAmr(s []string) {
+	m.appendamr = append(m.appendamr, s...)
+}
+
+// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
+func (m *AuthCodeMutation) AppendedAmr() ([]string, bool) {
+	if len(m.appendamr) == 0 {
+		return nil, false
+	}
+	return m.appendamr, true
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (m *AuthCodeMutation) ClearAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	m.clearedFields[authcode.FieldAmr] = struct{}{}
+}
+
+// AmrCleared returns if the "amr" field was cleared in this mutation.
+func (m *AuthCodeMutation) AmrCleared() bool {
+	_, ok := m.clearedFields[authcode.FieldAmr]
+	return ok
+}
+
+// ResetAmr resets all changes to the "amr" field.
+func (m *AuthCodeMutation) ResetAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	delete(m.clearedFields, authcode.FieldAmr)
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (m *AuthCodeMutation) SetAuthTime(t time.Time) {
+	m.auth_time = &t
+}
+
+// AuthTime returns the value of the "auth_time" field in the mutation.
+func (m *AuthCodeMutation) AuthTime() (r time.Time, exists bool) {
+	v := m.auth_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthTime returns the old "auth_time" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthCodeMutation) OldAuthTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
+	}
+	return oldValue.AuthTime, nil
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (m *AuthCodeMutation) ClearAuthTime() {
+	m.auth_time = nil
+	m.clearedFields[authcode.FieldAuthTime] = struct{}{}
+}
+
+// AuthTimeCleared returns if the "auth_time" field was cleared in this mutation.
+func (m *AuthCodeMutation) AuthTimeCleared() bool {
+	_, ok := m.clearedFields[authcode.FieldAuthTime]
+	return ok
+}
+
+// ResetAuthTime resets all changes to the "auth_time" field.
+func (m *AuthCodeMutation) ResetAuthTime() {
+	m.auth_time = nil
+	delete(m.clearedFields, authcode.FieldAuthTime)
+}
+
+// SetSid sets the "sid" field.
+func (m *AuthCodeMutation) SetSid(s string) {
+	m.sid = &s
+}
+
+// Sid returns the value of the "sid" field in the mutation.
+func (m *AuthCodeMutation) Sid() (r string, exists bool) {
+	v := m.sid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSid returns the old "sid" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthCodeMutation) OldSid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSid: %w", err)
+	}
+	return oldValue.Sid, nil
+}
+
+// ResetSid resets all changes to the "sid" field.
+func (m *AuthCodeMutation) ResetSid() {
+	m.sid = nil
+}
+
+// Where appends a list predicates to the AuthCodeMutation builder.
+func (m *AuthCodeMutation) Where(ps ...predicate.AuthCode) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthRequestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthCodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthRequest, len(ps))
+func (m *AuthCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthCode, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2204,84 +2336,84 @@ func (m *AuthRequestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthRequestMutation) Op() Op {
+func (m *AuthCodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthRequestMutation) SetOp(op Op) {
+func (m *AuthCodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthRequest).
-func (m *AuthRequestMutation) Type() string {
+// Type returns the node type of this mutation (AuthCode).
+func (m *AuthCodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthRequestMutation) Fields() []string {
+func (m *AuthCodeMutation) Fields() []string {
 	fields := make([]string, 0, 20)
 	if m.client_id != nil {
-		fields = append(fields, authrequest.FieldClientID)
+		fields = append(fields, authcode.FieldClientID)
 	}
 	if m.scopes != nil {
-		fields = append(fields, authrequest.FieldScopes)
-	}
-	if m.response_types != nil {
-		fields = append(fields, authrequest.FieldResponseTypes)
-	}
-	if m.redirect_uri != nil {
-		fields = append(fields, authrequest.FieldRedirectURI)
+		fields = append(fields, authcode.FieldScopes)
 	}
 	if m.nonce != nil {
-		fields = append(fields, authrequest.FieldNonce)
-	}
-	if m.state != nil {
-		fields = append(fields, authrequest.FieldState)
-	}
-	if m.force_approval_prompt != nil {
-		fields = append(fields, authrequest.FieldForceApprovalPrompt)
+		fields = append(fields, authcode.FieldNonce)
 	}
-	if m.logged_in != nil {
-		fields = append(fields, authrequest.FieldLoggedIn)
+	if m.redirect_uri != nil {
+		fields = append(fields, authcode.FieldRedirectURI)
 	}
 	if m.claims_user_id != nil {
-		fields = append(fields, authrequest.FieldClaimsUserID)
+		fields = append(fields, authcode.FieldClaimsUserID)
 	}
 	if m.claims_username != nil {
-		fields = append(fields, authrequest.FieldClaimsUsername)
+		fields = append(fields, authcode.FieldClaimsUsername)
 	}
 	if m.claims_email != nil {
-		fields = append(fields, authrequest.FieldClaimsEmail)
+		fields = append(fields, authcode.FieldClaimsEmail)
 	}
 	if m.claims_email_verified != nil {
-		fields = append(fields, authrequest.FieldClaimsEmailVerified)
+		fields = append(fields, authcode.FieldClaimsEmailVerified)
 	}
 	if m.claims_groups != nil {
-		fields = append(fields, authrequest.FieldClaimsGroups)
+		fields = append(fields, authcode.FieldClaimsGroups)
+	}
+	if m.claims_extra != nil {
+		fields = append(fields, authcode.FieldClaimsExtra)
 	}
 	if m.claims_preferred_username != nil {
-		fields = append(fields, authrequest.FieldClaimsPreferredUsername)
+		fields = append(fields, authcode.FieldClaimsPreferredUsername)
 	}
 	if m.connector_id != nil {
-		fields = append(fields, authrequest.FieldConnectorID)
+		fields = append(fields, authcode.FieldConnectorID)
 	}
 	if m.connector_data != nil {
-		fields = append(fields, authrequest.FieldConnectorData)
+		fields = append(fields, authcode.FieldConnectorData)
 	}
 	if m.expiry != nil {
-		fields = append(fields, authrequest.FieldExpiry)
+		fields = append(fields, authcode.FieldExpiry)
 	}
 	if m.code_challenge != nil {
-		fields = append(fields, authrequest.FieldCodeChallenge)
+		fields = append(fields, authcode.FieldCodeChallenge)
 	}
 	if m.code_challenge_method != nil {
-		fields = append(fields, authrequest.FieldCodeChallengeMethod)
+		fields = append(fields, authcode.FieldCodeChallengeMethod)
 	}
-	if m.hmac_key != nil {
-		fields = append(fields, authrequest.FieldHmacKey)
+	if m.acr != nil {
+		fields = append(fields, authcode.FieldAcr)
+	}
+	if m.amr != nil {
+		fields = append(fields, authcode.FieldAmr)
+	}
+	if m.auth_time != nil {
+		fields = append(fields, authcode.FieldAuthTime)
+	}
+	if m.sid != nil {
+		fields = append(fields, authcode.FieldSid)
 	}
 	return fields
 }
@@ -2289,48 +2421,48 @@ func (m *AuthRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthCodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authrequest.FieldClientID:
+	case authcode.FieldClientID:
 		return m.ClientID()
-	case authrequest.FieldScopes:
+	case authcode.FieldScopes:
 		return m.Scopes()
-	case authrequest.FieldResponseTypes:
-		return m.ResponseTypes()
-	case authrequest.FieldRedirectURI:
-		return m.RedirectURI()
-	case authrequest.FieldNonce:
+	case authcode.FieldNonce:
 		return m.Nonce()
-	case authrequest.FieldState:
-		return m.State()
-	case authrequest.FieldForceApprovalPrompt:
-		return m.ForceApprovalPrompt()
-	case authrequest.FieldLoggedIn:
-		return m.LoggedIn()
-	case authrequest.FieldClaimsUserID:
+	case authcode.FieldRedirectURI:
+		return m.RedirectURI()
+	case authcode.FieldClaimsUserID:
 		return m.ClaimsUserID()
-	case authrequest.FieldClaimsUsername:
+	case authcode.FieldClaimsUsername:
 		return m.ClaimsUsername()
-	case authrequest.FieldClaimsEmail:
+	case authcode.FieldClaimsEmail:
 		return m.ClaimsEmail()
-	case authrequest.FieldClaimsEmailVerified:
+	case authcode.FieldClaimsEmailVerified:
 		return m.ClaimsEmailVerified()
-	case authrequest.FieldClaimsGroups:
+	case authcode.FieldClaimsGroups:
 		return m.ClaimsGroups()
-	case authrequest.FieldClaimsPreferredUsername:
+	case authcode.FieldClaimsExtra:
+		return m.ClaimsExtra()
+	case authcode.FieldClaimsPreferredUsername:
 		return m.ClaimsPreferredUsername()
-	case authrequest.FieldConnectorID:
+	case authcode.FieldConnectorID:
 		return m.ConnectorID()
-	case authrequest.FieldConnectorData:
+	case authcode.FieldConnectorData:
 		return m.ConnectorData()
-	case authrequest.FieldExpiry:
+	case authcode.FieldExpiry:
 		return m.Expiry()
-	case authrequest.FieldCodeChallenge:
+	case authcode.FieldCodeChallenge:
 		return m.CodeChallenge()
-	case authrequest.FieldCodeChallengeMethod:
+	case authcode.FieldCodeChallengeMethod:
 		return m.CodeChallengeMethod()
-	case authrequest.FieldHmacKey:
-		return m.HmacKey()
+	case authcode.FieldAcr:
+		return m.Acr()
+	case authcode.FieldAmr:
+		return m.Amr()
+	case authcode.FieldAuthTime:
+		return m.AuthTime()
+	case authcode.FieldSid:
+		return m.Sid()
 	}
 	return nil, false
 }
@@ -2338,412 +2470,457 @@ func (m *AuthRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authrequest.FieldClientID:
+	case authcode.FieldClientID:
 		return m.OldClientID(ctx)
-	case authrequest.FieldScopes:
+	case authcode.FieldScopes:
 		return m.OldScopes(ctx)
-	case authrequest.FieldResponseTypes:
-		return m.OldResponseTypes(ctx)
-	case authrequest.FieldRedirectURI:
-		return m.OldRedirectURI(ctx)
-	case authrequest.FieldNonce:
+	case authcode.FieldNonce:
 		return m.OldNonce(ctx)
-	case authrequest.FieldState:
-		return m.OldState(ctx)
-	case authrequest.FieldForceApprovalPrompt:
-		return m.OldForceApprovalPrompt(ctx)
-	case authrequest.FieldLoggedIn:
-		return m.OldLoggedIn(ctx)
-	case authrequest.FieldClaimsUserID:
+	case authcode.FieldRedirectURI:
+		return m.OldRedirectURI(ctx)
+	case authcode.FieldClaimsUserID:
 		return m.OldClaimsUserID(ctx)
-	case authrequest.FieldClaimsUsername:
+	case authcode.FieldClaimsUsername:
 		return m.OldClaimsUsername(ctx)
-	case authrequest.FieldClaimsEmail:
+	case authcode.FieldClaimsEmail:
 		return m.OldClaimsEmail(ctx)
-	case authrequest.FieldClaimsEmailVerified:
+	case authcode.FieldClaimsEmailVerified:
 		return m.OldClaimsEmailVerified(ctx)
-	case authrequest.FieldClaimsGroups:
+	case authcode.FieldClaimsGroups:
 		return m.OldClaimsGroups(ctx)
-	case authrequest.FieldClaimsPreferredUsername:
+	case authcode.FieldClaimsExtra:
+		return m.OldClaimsExtra(ctx)
+	case authcode.FieldClaimsPreferredUsername:
 		return m.OldClaimsPreferredUsername(ctx)
-	case authrequest.FieldConnectorID:
+	case authcode.FieldConnectorID:
 		return m.OldConnectorID(ctx)
-	case authrequest.FieldConnectorData:
+	case authcode.FieldConnectorData:
 		return m.OldConnectorData(ctx)
-	case authrequest.FieldExpiry:
+	case authcode.FieldExpiry:
 		return m.OldExpiry(ctx)
-	case authrequest.FieldCodeChallenge:
+	case authcode.FieldCodeChallenge:
 		return m.OldCodeChallenge(ctx)
-	case authrequest.FieldCodeChallengeMethod:
+	case authcode.FieldCodeChallengeMethod:
 		return m.OldCodeChallengeMethod(ctx)
-	case authrequest.FieldHmacKey:
-		return m.OldHmacKey(ctx)
+	case authcode.FieldAcr:
+		return m.OldAcr(ctx)
+	case authcode.FieldAmr:
+		return m.OldAmr(ctx)
+	case authcode.FieldAuthTime:
+		return m.OldAuthTime(ctx)
+	case authcode.FieldSid:
+		return m.OldSid(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthRequest field %s", name)
+	return nil, fmt.Errorf("unknown AuthCode field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthRequestMutation) SetField(name string, value ent.Value) error {
+func (m *AuthCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authrequest.FieldClientID:
+	case authcode.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClientID(v)
 		return nil
-	case authrequest.FieldScopes:
+	case authcode.FieldScopes:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetScopes(v)
 		return nil
-	case authrequest.FieldResponseTypes:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResponseTypes(v)
-		return nil
-	case authrequest.FieldRedirectURI:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRedirectURI(v)
-		return nil
-	case authrequest.FieldNonce:
+	case authcode.FieldNonce:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetNonce(v)
 		return nil
-	case authrequest.FieldState:
+	case authcode.FieldRedirectURI:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetState(v)
-		return nil
-	case authrequest.FieldForceApprovalPrompt:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetForceApprovalPrompt(v)
-		return nil
-	case authrequest.FieldLoggedIn:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLoggedIn(v)
+		m.SetRedirectURI(v)
 		return nil
-	case authrequest.FieldClaimsUserID:
+	case authcode.FieldClaimsUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsUserID(v)
 		return nil
-	case authrequest.FieldClaimsUsername:
+	case authcode.FieldClaimsUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsUsername(v)
 		return nil
-	case authrequest.FieldClaimsEmail:
+	case authcode.FieldClaimsEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsEmail(v)
 		return nil
-	case authrequest.FieldClaimsEmailVerified:
+	case authcode.FieldClaimsEmailVerified:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsEmailVerified(v)
 		return nil
-	case authrequest.FieldClaimsGroups:
+	case authcode.FieldClaimsGroups:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsGroups(v)
 		return nil
-	case authrequest.FieldClaimsPreferredUsername:
+	case authcode.FieldClaimsExtra:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsExtra(v)
+		return nil
+	case authcode.FieldClaimsPreferredUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsPreferredUsername(v)
 		return nil
-	case authrequest.FieldConnectorID:
+	case authcode.FieldConnectorID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetConnectorID(v)
 		return nil
-	case authrequest.FieldConnectorData:
+	case authcode.FieldConnectorData:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetConnectorData(v)
 		return nil
-	case authrequest.FieldExpiry:
+	case authcode.FieldExpiry:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExpiry(v)
 		return nil
-	case authrequest.FieldCodeChallenge:
+	case authcode.FieldCodeChallenge:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCodeChallenge(v)
 		return nil
-	case authrequest.FieldCodeChallengeMethod:
+	case authcode.FieldCodeChallengeMethod:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCodeChallengeMethod(v)
 		return nil
-	case authrequest.FieldHmacKey:
-		v, ok := value.([]byte)
+	case authcode.FieldAcr:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHmacKey(v)
+		m.SetAcr(v)
+		return nil
+	case authcode.FieldAmr:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmr(v)
+		return nil
+	case authcode.FieldAuthTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthTime(v)
+		return nil
+	case authcode.FieldSid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSid(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuthRequest field %s", name)
+	return fmt.Errorf("unknown AuthCode field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthRequestMutation) AddedFields() []string {
+func (m *AuthCodeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthCodeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthRequestMutation) AddField(name string, value ent.Value) error {
+func (m *AuthCodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuthRequest numeric field %s", name)
+	return fmt.Errorf("unknown AuthCode numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthRequestMutation) ClearedFields() []string {
+func (m *AuthCodeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(authrequest.FieldScopes) {
-		fields = append(fields, authrequest.FieldScopes)
+	if m.FieldCleared(authcode.FieldScopes) {
+		fields = append(fields, authcode.FieldScopes)
 	}
-	if m.FieldCleared(authrequest.FieldResponseTypes) {
-		fields = append(fields, authrequest.FieldResponseTypes)
+	if m.FieldCleared(authcode.FieldClaimsGroups) {
+		fields = append(fields, authcode.FieldClaimsGroups)
 	}
-	if m.FieldCleared(authrequest.FieldClaimsGroups) {
-		fields = append(fields, authrequest.FieldClaimsGroups)
+	if m.FieldCleared(authcode.FieldClaimsExtra) {
+		fields = append(fields, authcode.FieldClaimsExtra)
 	}
-	if m.FieldCleared(authrequest.FieldConnectorData) {
-		fields = append(fields, authrequest.FieldConnectorData)
+	if m.FieldCleared(authcode.FieldConnectorData) {
+		fields = append(fields, authcode.FieldConnectorData)
+	}
+	if m.FieldCleared(authcode.FieldAmr) {
+		fields = append(fields, authcode.FieldAmr)
+	}
+	if m.FieldCleared(authcode.FieldAuthTime) {
+		fields = append(fields, authcode.FieldAuthTime)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthRequestMutation) FieldCleared(name string) bool {
+func (m *AuthCodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthRequestMutation) ClearField(name string) error {
+func (m *AuthCodeMutation) ClearField(name string) error {
 	switch name {
-	case authrequest.FieldScopes:
+	case authcode.FieldScopes:
 		m.ClearScopes()
 		return nil
-	case authrequest.FieldResponseTypes:
-		m.ClearResponseTypes()
-		return nil
-	case authrequest.FieldClaimsGroups:
+	case authcode.FieldClaimsGroups:
 		m.ClearClaimsGroups()
 		return nil
-	case authrequest.FieldConnectorData:
+	case authcode.FieldClaimsExtra:
+		m.ClearClaimsExtra()
+		return nil
+	case authcode.FieldConnectorData:
 		m.ClearConnectorData()
 		return nil
+	case authcode.FieldAmr:
+		m.ClearAmr()
+		return nil
+	case authcode.FieldAuthTime:
+		m.ClearAuthTime()
+		return nil
 	}
-	return fmt.Errorf("unknown AuthRequest nullable field %s", name)
+	return fmt.Errorf("unknown AuthCode nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthRequestMutation) ResetField(name string) error {
+func (m *AuthCodeMutation) ResetField(name string) error {
 	switch name {
-	case authrequest.FieldClientID:
+	case authcode.FieldClientID:
 		m.ResetClientID()
 		return nil
-	case authrequest.FieldScopes:
+	case authcode.FieldScopes:
 		m.ResetScopes()
 		return nil
-	case authrequest.FieldResponseTypes:
-		m.ResetResponseTypes()
-		return nil
-	case authrequest.FieldRedirectURI:
-		m.ResetRedirectURI()
-		return nil
-	case authrequest.FieldNonce:
+	case authcode.FieldNonce:
 		m.ResetNonce()
 		return nil
-	case authrequest.FieldState:
-		m.ResetState()
-		return nil
-	case authrequest.FieldForceApprovalPrompt:
-		m.ResetForceApprovalPrompt()
-		return nil
-	case authrequest.FieldLoggedIn:
-		m.ResetLoggedIn()
+	case authcode.FieldRedirectURI:
+		m.ResetRedirectURI()
 		return nil
-	case authrequest.FieldClaimsUserID:
+	case authcode.FieldClaimsUserID:
 		m.ResetClaimsUserID()
 		return nil
-	case authrequest.FieldClaimsUsername:
+	case authcode.FieldClaimsUsername:
 		m.ResetClaimsUsername()
 		return nil
-	case authrequest.FieldClaimsEmail:
+	case authcode.FieldClaimsEmail:
 		m.ResetClaimsEmail()
 		return nil
-	case authrequest.FieldClaimsEmailVerified:
+	case authcode.FieldClaimsEmailVerified:
 		m.ResetClaimsEmailVerified()
 		return nil
-	case authrequest.FieldClaimsGroups:
+	case authcode.FieldClaimsGroups:
 		m.ResetClaimsGroups()
 		return nil
-	case authrequest.FieldClaimsPreferredUsername:
+	case authcode.FieldClaimsExtra:
+		m.ResetClaimsExtra()
+		return nil
+	case authcode.FieldClaimsPreferredUsername:
 		m.ResetClaimsPreferredUsername()
 		return nil
-	case authrequest.FieldConnectorID:
+	case authcode.FieldConnectorID:
 		m.ResetConnectorID()
 		return nil
-	case authrequest.FieldConnectorData:
+	case authcode.FieldConnectorData:
 		m.ResetConnectorData()
 		return nil
-	case authrequest.FieldExpiry:
+	case authcode.FieldExpiry:
 		m.ResetExpiry()
 		return nil
-	case authrequest.FieldCodeChallenge:
+	case authcode.FieldCodeChallenge:
 		m.ResetCodeChallenge()
 		return nil
-	case authrequest.FieldCodeChallengeMethod:
+	case authcode.FieldCodeChallengeMethod:
 		m.ResetCodeChallengeMethod()
 		return nil
-	case authrequest.FieldHmacKey:
-		m.ResetHmacKey()
+	case authcode.FieldAcr:
+		m.ResetAcr()
+		return nil
+	case authcode.FieldAmr:
+		m.ResetAmr()
+		return nil
+	case authcode.FieldAuthTime:
+		m.ResetAuthTime()
+		return nil
+	case authcode.FieldSid:
+		m.ResetSid()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthRequest field %s", name)
+	return fmt.Errorf("unknown AuthCode field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthRequestMutation) AddedEdges() []string {
+func (m *AuthCodeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthCodeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthRequestMutation) RemovedEdges() []string {
+func (m *AuthCodeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthCodeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthRequestMutation) ClearedEdges() []string {
+func (m *AuthCodeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthRequestMutation) EdgeCleared(name string) bool {
+func (m *AuthCodeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthRequestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuthRequest unique edge %s", name)
+func (m *AuthCodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthCode unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthRequestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuthRequest edge %s", name)
+func (m *AuthCodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthCode edge %s", name)
 }
 
-// ConnectorMutation represents an operation that mutates the Connector nodes in the graph.
-type ConnectorMutation struct {
+// AuthRequestMutation represents an operation that mutates the AuthRequest nodes in the graph.
+type AuthRequestMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *string
-	_type            *string
-	name             *string
-	resource_version *string
-	_config          *[]byte
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*Connector, error)
-	predicates       []predicate.Connector
+	op                        Op
+	typ                       string
+	id                        *string
+	client_id                 *string
+	scopes                    *[]string
+	appendscopes              []string
+	response_types            *[]string
+	appendresponse_types      []string
+	redirect_uri              *string
+	nonce                     *string
+	state                     *string
+	force_approval_prompt     *bool
+	logged_in                 *bool
+	claims_user_id            *string
+	claims_username           *string
+	claims_email              *string
+	claims_email_verified     *bool
+	claims_groups             *[]string
+	appendclaims_groups       []string
+	claims_extra              *map[string]interface{}
+	claims_preferred_username *string
+	connector_id              *string
+	connector_data            *[]byte
+	expiry                    *time.Time
+	code_challenge            *string
+	code_challenge_method     *string
+	hmac_key                  *[]byte
+	prompt                    *string
+	max_age                   *int
+	addmax_age                *int
+	acr_values                *[]string
+	appendacr_values          []string
+	acr                       *string
+	amr                       *[]string
+	appendamr                 []string
+	auth_time                 *time.Time
+	sid                       *string
+	login_hint                *string
+	ui_locales                *[]string
+	appendui_locales          []string
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*AuthRequest, error)
+	predicates                []predicate.AuthRequest
 }
 
-var _ ent.Mutation = (*ConnectorMutation)(nil)
+var _ ent.Mutation = (*AuthRequestMutation)(nil)
 
-// connectorOption allows management of the mutation configuration using functional options.
-type connectorOption func(*ConnectorMutation)
+// authrequestOption allows management of the mutation configuration using functional options.
+type authrequestOption func(*AuthRequestMutation)
 
-// newConnectorMutation creates new mutation for the Connector entity.
-func newConnectorMutation(c config, op Op, opts ...connectorOption) *ConnectorMutation {
-	m := &ConnectorMutation{
+// newAuthRequestMutation creates new mutation for the AuthRequest entity.
+func newAuthRequestMutation(c config, op Op, opts ...authrequestOption) *AuthRequestMutation {
+	m := &AuthRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConnector,
+		typ:           TypeAuthRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2752,20 +2929,20 @@ func newConnectorMutation(c config, op Op, opts ...connectorOption) *ConnectorMu
 	return m
 }
 
-// withConnectorID sets the ID field of the mutation.
-func withConnectorID(id string) connectorOption {
-	return func(m *ConnectorMutation) {
+// withAuthRequestID sets the ID field of the mutation.
+func withAuthRequestID(id string) authrequestOption {
+	return func(m *AuthRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Connector
+			value *AuthRequest
 		)
-		m.oldValue = func(ctx context.Context) (*Connector, error) {
+		m.oldValue = func(ctx context.Context) (*AuthRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Connector.Get(ctx, id)
+					value, err = m.Client().AuthRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2774,10 +2951,10 @@ func withConnectorID(id string) connectorOption {
 	}
 }
 
-// withConnector sets the old Connector of the mutation.
-func withConnector(node *Connector) connectorOption {
-	return func(m *ConnectorMutation) {
-		m.oldValue = func(context.Context) (*Connector, error) {
+// withAuthRequest sets the old AuthRequest of the mutation.
+func withAuthRequest(node *AuthRequest) authrequestOption {
+	return func(m *AuthRequestMutation) {
+		m.oldValue = func(context.Context) (*AuthRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2786,7 +2963,7 @@ func withConnector(node *Connector) connectorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConnectorMutation) Client() *Client {
+func (m AuthRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2794,7 +2971,7 @@ func (m ConnectorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConnectorMutation) Tx() (*Tx, error) {
+func (m AuthRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -2804,14 +2981,14 @@ func (m ConnectorMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Connector entities.
-func (m *ConnectorMutation) SetID(id string) {
+// operation is only accepted on creation of AuthRequest entities.
+func (m *AuthRequestMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConnectorMutation) ID() (id string, exists bool) {
+func (m *AuthRequestMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2822,7 +2999,7 @@ func (m *ConnectorMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConnectorMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AuthRequestMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2831,719 +3008,744 @@ func (m *ConnectorMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Connector.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthRequest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *ConnectorMutation) SetType(s string) {
-	m._type = &s
+// SetClientID sets the "client_id" field.
+func (m *AuthRequestMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ConnectorMutation) GetType() (r string, exists bool) {
-	v := m._type
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *AuthRequestMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.ClientID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ConnectorMutation) ResetType() {
-	m._type = nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *AuthRequestMutation) ResetClientID() {
+	m.client_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *ConnectorMutation) SetName(s string) {
-	m.name = &s
+// SetScopes sets the "scopes" field.
+func (m *AuthRequestMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ConnectorMutation) Name() (r string, exists bool) {
-	v := m.name
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *AuthRequestMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ConnectorMutation) ResetName() {
-	m.name = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *AuthRequestMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// SetResourceVersion sets the "resource_version" field.
-func (m *ConnectorMutation) SetResourceVersion(s string) {
-	m.resource_version = &s
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *AuthRequestMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// ResourceVersion returns the value of the "resource_version" field in the mutation.
-func (m *ConnectorMutation) ResourceVersion() (r string, exists bool) {
-	v := m.resource_version
+// ClearScopes clears the value of the "scopes" field.
+func (m *AuthRequestMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[authrequest.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *AuthRequestMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *AuthRequestMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, authrequest.FieldScopes)
+}
+
+// SetResponseTypes sets the "response_types" field.
+func (m *AuthRequestMutation) SetResponseTypes(s []string) {
+	m.response_types = &s
+	m.appendresponse_types = nil
+}
+
+// ResponseTypes returns the value of the "response_types" field in the mutation.
+func (m *AuthRequestMutation) ResponseTypes() (r []string, exists bool) {
+	v := m.response_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceVersion returns the old "resource_version" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseTypes returns the old "response_types" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldResourceVersion(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldResponseTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceVersion requires an ID field in the mutation")
+		return v, errors.New("OldResponseTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseTypes: %w", err)
 	}
-	return oldValue.ResourceVersion, nil
+	return oldValue.ResponseTypes, nil
 }
 
-// ResetResourceVersion resets all changes to the "resource_version" field.
-func (m *ConnectorMutation) ResetResourceVersion() {
-	m.resource_version = nil
+// AppendResponseTypes adds s to the "response_types" field.
+func (m *AuthRequestMutation) AppendResponseTypes(s []string) {
+	m.appendresponse_types = append(m.appendresponse_types, s...)
 }
 
-// SetConfig sets the "config" field.
-func (m *ConnectorMutation) SetConfig(b []byte) {
-	m._config = &b
+// AppendedResponseTypes returns the list of values that were appended to the "response_types" field in this mutation.
+func (m *AuthRequestMutation) AppendedResponseTypes() ([]string, bool) {
+	if len(m.appendresponse_types) == 0 {
+		return nil, false
+	}
+	return m.appendresponse_types, true
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *ConnectorMutation) Config() (r []byte, exists bool) {
-	v := m._config
+// ClearResponseTypes clears the value of the "response_types" field.
+func (m *AuthRequestMutation) ClearResponseTypes() {
+	m.response_types = nil
+	m.appendresponse_types = nil
+	m.clearedFields[authrequest.FieldResponseTypes] = struct{}{}
+}
+
+// ResponseTypesCleared returns if the "response_types" field was cleared in this mutation.
+func (m *AuthRequestMutation) ResponseTypesCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldResponseTypes]
+	return ok
+}
+
+// ResetResponseTypes resets all changes to the "response_types" field.
+func (m *AuthRequestMutation) ResetResponseTypes() {
+	m.response_types = nil
+	m.appendresponse_types = nil
+	delete(m.clearedFields, authrequest.FieldResponseTypes)
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (m *AuthRequestMutation) SetRedirectURI(s string) {
+	m.redirect_uri = &s
+}
+
+// RedirectURI returns the value of the "redirect_uri" field in the mutation.
+func (m *AuthRequestMutation) RedirectURI() (r string, exists bool) {
+	v := m.redirect_uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldRedirectURI returns the old "redirect_uri" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldConfig(ctx context.Context) (v []byte, err error) {
+func (m *AuthRequestMutation) OldRedirectURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedirectURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldRedirectURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedirectURI: %w", err)
 	}
-	return oldValue.Config, nil
+	return oldValue.RedirectURI, nil
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *ConnectorMutation) ResetConfig() {
-	m._config = nil
+// ResetRedirectURI resets all changes to the "redirect_uri" field.
+func (m *AuthRequestMutation) ResetRedirectURI() {
+	m.redirect_uri = nil
 }
 
-// Where appends a list predicates to the ConnectorMutation builder.
-func (m *ConnectorMutation) Where(ps ...predicate.Connector) {
-	m.predicates = append(m.predicates, ps...)
+// SetNonce sets the "nonce" field.
+func (m *AuthRequestMutation) SetNonce(s string) {
+	m.nonce = &s
 }
 
-// WhereP appends storage-level predicates to the ConnectorMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConnectorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Connector, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *AuthRequestMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ConnectorMutation) Op() Op {
-	return m.op
+// OldNonce returns the old "nonce" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldNonce(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+	}
+	return oldValue.Nonce, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ConnectorMutation) SetOp(op Op) {
-	m.op = op
+// ResetNonce resets all changes to the "nonce" field.
+func (m *AuthRequestMutation) ResetNonce() {
+	m.nonce = nil
 }
 
-// Type returns the node type of this mutation (Connector).
-func (m *ConnectorMutation) Type() string {
-	return m.typ
+// SetState sets the "state" field.
+func (m *AuthRequestMutation) SetState(s string) {
+	m.state = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ConnectorMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m._type != nil {
-		fields = append(fields, connector.FieldType)
+// State returns the value of the "state" field in the mutation.
+func (m *AuthRequestMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
 	}
-	if m.name != nil {
-		fields = append(fields, connector.FieldName)
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
-	if m.resource_version != nil {
-		fields = append(fields, connector.FieldResourceVersion)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
-	if m._config != nil {
-		fields = append(fields, connector.FieldConfig)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return fields
+	return oldValue.State, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ConnectorMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case connector.FieldType:
-		return m.GetType()
-	case connector.FieldName:
-		return m.Name()
-	case connector.FieldResourceVersion:
-		return m.ResourceVersion()
-	case connector.FieldConfig:
-		return m.Config()
-	}
-	return nil, false
+// ResetState resets all changes to the "state" field.
+func (m *AuthRequestMutation) ResetState() {
+	m.state = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ConnectorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case connector.FieldType:
-		return m.OldType(ctx)
-	case connector.FieldName:
-		return m.OldName(ctx)
-	case connector.FieldResourceVersion:
-		return m.OldResourceVersion(ctx)
-	case connector.FieldConfig:
-		return m.OldConfig(ctx)
+// SetForceApprovalPrompt sets the "force_approval_prompt" field.
+func (m *AuthRequestMutation) SetForceApprovalPrompt(b bool) {
+	m.force_approval_prompt = &b
+}
+
+// ForceApprovalPrompt returns the value of the "force_approval_prompt" field in the mutation.
+func (m *AuthRequestMutation) ForceApprovalPrompt() (r bool, exists bool) {
+	v := m.force_approval_prompt
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Connector field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ConnectorMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case connector.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case connector.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case connector.FieldResourceVersion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResourceVersion(v)
-		return nil
-	case connector.FieldConfig:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConfig(v)
-		return nil
+// OldForceApprovalPrompt returns the old "force_approval_prompt" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldForceApprovalPrompt(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForceApprovalPrompt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Connector field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForceApprovalPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForceApprovalPrompt: %w", err)
+	}
+	return oldValue.ForceApprovalPrompt, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ConnectorMutation) AddedFields() []string {
-	return nil
+// ResetForceApprovalPrompt resets all changes to the "force_approval_prompt" field.
+func (m *AuthRequestMutation) ResetForceApprovalPrompt() {
+	m.force_approval_prompt = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ConnectorMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetLoggedIn sets the "logged_in" field.
+func (m *AuthRequestMutation) SetLoggedIn(b bool) {
+	m.logged_in = &b
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ConnectorMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// LoggedIn returns the value of the "logged_in" field in the mutation.
+func (m *AuthRequestMutation) LoggedIn() (r bool, exists bool) {
+	v := m.logged_in
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Connector numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ConnectorMutation) ClearedFields() []string {
-	return nil
+// OldLoggedIn returns the old "logged_in" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldLoggedIn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoggedIn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoggedIn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoggedIn: %w", err)
+	}
+	return oldValue.LoggedIn, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ConnectorMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetLoggedIn resets all changes to the "logged_in" field.
+func (m *AuthRequestMutation) ResetLoggedIn() {
+	m.logged_in = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ConnectorMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Connector nullable field %s", name)
+// SetClaimsUserID sets the "claims_user_id" field.
+func (m *AuthRequestMutation) SetClaimsUserID(s string) {
+	m.claims_user_id = &s
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ConnectorMutation) ResetField(name string) error {
-	switch name {
-	case connector.FieldType:
-		m.ResetType()
-		return nil
-	case connector.FieldName:
-		m.ResetName()
-		return nil
-	case connector.FieldResourceVersion:
-		m.ResetResourceVersion()
-		return nil
-	case connector.FieldConfig:
-		m.ResetConfig()
-		return nil
+// ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
+func (m *AuthRequestMutation) ClaimsUserID() (r string, exists bool) {
+	v := m.claims_user_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Connector field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConnectorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ConnectorMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConnectorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ConnectorMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConnectorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ConnectorMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ConnectorMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Connector unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ConnectorMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Connector edge %s", name)
-}
-
-// DeviceRequestMutation represents an operation that mutates the DeviceRequest nodes in the graph.
-type DeviceRequestMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	user_code     *string
-	device_code   *string
-	client_id     *string
-	client_secret *string
-	scopes        *[]string
-	appendscopes  []string
-	expiry        *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*DeviceRequest, error)
-	predicates    []predicate.DeviceRequest
+	return *v, true
 }
 
-var _ ent.Mutation = (*DeviceRequestMutation)(nil)
-
-// devicerequestOption allows management of the mutation configuration using functional options.
-type devicerequestOption func(*DeviceRequestMutation)
-
-// newDeviceRequestMutation creates new mutation for the DeviceRequest entity.
-func newDeviceRequestMutation(c config, op Op, opts ...devicerequestOption) *DeviceRequestMutation {
-	m := &DeviceRequestMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDeviceRequest,
-		clearedFields: make(map[string]struct{}),
+// OldClaimsUserID returns the old "claims_user_id" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsUserID requires an ID field in the mutation")
 	}
-	return m
-}
-
-// withDeviceRequestID sets the ID field of the mutation.
-func withDeviceRequestID(id int) devicerequestOption {
-	return func(m *DeviceRequestMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *DeviceRequest
-		)
-		m.oldValue = func(ctx context.Context) (*DeviceRequest, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().DeviceRequest.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsUserID: %w", err)
 	}
+	return oldValue.ClaimsUserID, nil
 }
 
-// withDeviceRequest sets the old DeviceRequest of the mutation.
-func withDeviceRequest(node *DeviceRequest) devicerequestOption {
-	return func(m *DeviceRequestMutation) {
-		m.oldValue = func(context.Context) (*DeviceRequest, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetClaimsUserID resets all changes to the "claims_user_id" field.
+func (m *AuthRequestMutation) ResetClaimsUserID() {
+	m.claims_user_id = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceRequestMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetClaimsUsername sets the "claims_username" field.
+func (m *AuthRequestMutation) SetClaimsUsername(s string) {
+	m.claims_username = &s
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DeviceRequestMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("db: mutation is not running in a transaction")
+// ClaimsUsername returns the value of the "claims_username" field in the mutation.
+func (m *AuthRequestMutation) ClaimsUsername() (r string, exists bool) {
+	v := m.claims_username
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DeviceRequestMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// OldClaimsUsername returns the old "claims_username" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
 	}
-	return *m.id, true
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsUsername: %w", err)
+	}
+	return oldValue.ClaimsUsername, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DeviceRequestMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceRequest.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetClaimsUsername resets all changes to the "claims_username" field.
+func (m *AuthRequestMutation) ResetClaimsUsername() {
+	m.claims_username = nil
 }
 
-// SetUserCode sets the "user_code" field.
-func (m *DeviceRequestMutation) SetUserCode(s string) {
-	m.user_code = &s
+// SetClaimsEmail sets the "claims_email" field.
+func (m *AuthRequestMutation) SetClaimsEmail(s string) {
+	m.claims_email = &s
 }
 
-// UserCode returns the value of the "user_code" field in the mutation.
-func (m *DeviceRequestMutation) UserCode() (r string, exists bool) {
-	v := m.user_code
+// ClaimsEmail returns the value of the "claims_email" field in the mutation.
+func (m *AuthRequestMutation) ClaimsEmail() (r string, exists bool) {
+	v := m.claims_email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserCode returns the old "user_code" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmail returns the old "claims_email" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldUserCode(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserCode requires an ID field in the mutation")
+		return v, errors.New("OldClaimsEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsEmail: %w", err)
 	}
-	return oldValue.UserCode, nil
+	return oldValue.ClaimsEmail, nil
 }
 
-// ResetUserCode resets all changes to the "user_code" field.
-func (m *DeviceRequestMutation) ResetUserCode() {
-	m.user_code = nil
+// ResetClaimsEmail resets all changes to the "claims_email" field.
+func (m *AuthRequestMutation) ResetClaimsEmail() {
+	m.claims_email = nil
 }
 
-// SetDeviceCode sets the "device_code" field.
-func (m *DeviceRequestMutation) SetDeviceCode(s string) {
-	m.device_code = &s
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (m *AuthRequestMutation) SetClaimsEmailVerified(b bool) {
+	m.claims_email_verified = &b
 }
 
-// DeviceCode returns the value of the "device_code" field in the mutation.
-func (m *DeviceRequestMutation) DeviceCode() (r string, exists bool) {
-	v := m.device_code
+// ClaimsEmailVerified returns the value of the "claims_email_verified" field in the mutation.
+func (m *AuthRequestMutation) ClaimsEmailVerified() (r bool, exists bool) {
+	v := m.claims_email_verified
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceCode returns the old "device_code" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsEmailVerified is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+		return v, errors.New("OldClaimsEmailVerified requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsEmailVerified: %w", err)
 	}
-	return oldValue.DeviceCode, nil
+	return oldValue.ClaimsEmailVerified, nil
 }
 
-// ResetDeviceCode resets all changes to the "device_code" field.
-func (m *DeviceRequestMutation) ResetDeviceCode() {
-	m.device_code = nil
+// ResetClaimsEmailVerified resets all changes to the "claims_email_verified" field.
+func (m *AuthRequestMutation) ResetClaimsEmailVerified() {
+	m.claims_email_verified = nil
 }
 
-// SetClientID sets the "client_id" field.
-func (m *DeviceRequestMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetClaimsGroups sets the "claims_groups" field.
+func (m *AuthRequestMutation) SetClaimsGroups(s []string) {
+	m.claims_groups = &s
+	m.appendclaims_groups = nil
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *DeviceRequestMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// ClaimsGroups returns the value of the "claims_groups" field in the mutation.
+func (m *AuthRequestMutation) ClaimsGroups() (r []string, exists bool) {
+	v := m.claims_groups
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsGroups returns the old "claims_groups" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsGroups is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldClaimsGroups requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsGroups: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.ClaimsGroups, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *DeviceRequestMutation) ResetClientID() {
-	m.client_id = nil
+// AppendClaimsGroups adds s to the "claims_groups" field.
+func (m *AuthRequestMutation) AppendClaimsGroups(s []string) {
+	m.appendclaims_groups = append(m.appendclaims_groups, s...)
 }
 
-// SetClientSecret sets the "client_secret" field.
-func (m *DeviceRequestMutation) SetClientSecret(s string) {
-	m.client_secret = &s
+// AppendedClaimsGroups returns the list of values that were appended to the "claims_groups" field in this mutation.
+func (m *AuthRequestMutation) AppendedClaimsGroups() ([]string, bool) {
+	if len(m.appendclaims_groups) == 0 {
+		return nil, false
+	}
+	return m.appendclaims_groups, true
 }
 
-// ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *DeviceRequestMutation) ClientSecret() (r string, exists bool) {
-	v := m.client_secret
+// ClearClaimsGroups clears the value of the "claims_groups" field.
+func (m *AuthRequestMutation) ClearClaimsGroups() {
+	m.claims_groups = nil
+	m.appendclaims_groups = nil
+	m.clearedFields[authrequest.FieldClaimsGroups] = struct{}{}
+}
+
+// ClaimsGroupsCleared returns if the "claims_groups" field was cleared in this mutation.
+func (m *AuthRequestMutation) ClaimsGroupsCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldClaimsGroups]
+	return ok
+}
+
+// ResetClaimsGroups resets all changes to the "claims_groups" field.
+func (m *AuthRequestMutation) ResetClaimsGroups() {
+	m.claims_groups = nil
+	m.appendclaims_groups = nil
+	delete(m.clearedFields, authrequest.FieldClaimsGroups)
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (m *AuthRequestMutation) SetClaimsExtra(value map[string]interface{}) {
+	m.claims_extra = &value
+}
+
+// ClaimsExtra returns the value of the "claims_extra" field in the mutation.
+func (m *AuthRequestMutation) ClaimsExtra() (r map[string]interface{}, exists bool) {
+	v := m.claims_extra
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsExtra returns the old "claims_extra" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldClaimsExtra(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsExtra is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+		return v, errors.New("OldClaimsExtra requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsExtra: %w", err)
 	}
-	return oldValue.ClientSecret, nil
+	return oldValue.ClaimsExtra, nil
 }
 
-// ResetClientSecret resets all changes to the "client_secret" field.
-func (m *DeviceRequestMutation) ResetClientSecret() {
-	m.client_secret = nil
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (m *AuthRequestMutation) ClearClaimsExtra() {
+	m.claims_extra = nil
+	m.clearedFields[authrequest.FieldClaimsExtra] = struct{}{}
 }
 
-// SetScopes sets the "scopes" field.
-func (m *DeviceRequestMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// ClaimsExtraCleared returns if the "claims_extra" field was cleared in this mutation.
+func (m *AuthRequestMutation) ClaimsExtraCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldClaimsExtra]
+	return ok
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *DeviceRequestMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// ResetClaimsExtra resets all changes to the "claims_extra" field.
+func (m *AuthRequestMutation) ResetClaimsExtra() {
+	m.claims_extra = nil
+	delete(m.clearedFields, authrequest.FieldClaimsExtra)
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (m *AuthRequestMutation) SetClaimsPreferredUsername(s string) {
+	m.claims_preferred_username = &s
+}
+
+// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
+func (m *AuthRequestMutation) ClaimsPreferredUsername() (r string, exists bool) {
+	v := m.claims_preferred_username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *AuthRequestMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
 	}
-	return oldValue.Scopes, nil
+	return oldValue.ClaimsPreferredUsername, nil
 }
 
-// AppendScopes adds s to the "scopes" field.
-func (m *DeviceRequestMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
+// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
+func (m *AuthRequestMutation) ResetClaimsPreferredUsername() {
+	m.claims_preferred_username = nil
 }
 
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *DeviceRequestMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
+// SetConnectorID sets the "connector_id" field.
+func (m *AuthRequestMutation) SetConnectorID(s string) {
+	m.connector_id = &s
+}
+
+// ConnectorID returns the value of the "connector_id" field in the mutation.
+func (m *AuthRequestMutation) ConnectorID() (r string, exists bool) {
+	v := m.connector_id
+	if v == nil {
+		return
 	}
-	return m.appendscopes, true
+	return *v, true
 }
 
-// ClearScopes clears the value of the "scopes" field.
-func (m *DeviceRequestMutation) ClearScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	m.clearedFields[devicerequest.FieldScopes] = struct{}{}
+// OldConnectorID returns the old "connector_id" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldConnectorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
+	}
+	return oldValue.ConnectorID, nil
 }
 
-// ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *DeviceRequestMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[devicerequest.FieldScopes]
+// ResetConnectorID resets all changes to the "connector_id" field.
+func (m *AuthRequestMutation) ResetConnectorID() {
+	m.connector_id = nil
+}
+
+// SetConnectorData sets the "connector_data" field.
+func (m *AuthRequestMutation) SetConnectorData(b []byte) {
+	m.connector_data = &b
+}
+
+// ConnectorData returns the value of the "connector_data" field in the mutation.
+func (m *AuthRequestMutation) ConnectorData() (r []byte, exists bool) {
+	v := m.connector_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectorData returns the old "connector_data" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+	}
+	return oldValue.ConnectorData, nil
+}
+
+// ClearConnectorData clears the value of the "connector_data" field.
+func (m *AuthRequestMutation) ClearConnectorData() {
+	m.connector_data = nil
+	m.clearedFields[authrequest.FieldConnectorData] = struct{}{}
+}
+
+// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
+func (m *AuthRequestMutation) ConnectorDataCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldConnectorData]
 	return ok
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *DeviceRequestMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	delete(m.clearedFields, devicerequest.FieldScopes)
+// ResetConnectorData resets all changes to the "connector_data" field.
+func (m *AuthRequestMutation) ResetConnectorData() {
+	m.connector_data = nil
+	delete(m.clearedFields, authrequest.FieldConnectorData)
 }
 
 // SetExpiry sets the "expiry" field.
-func (m *DeviceRequestMutation) SetExpiry(t time.Time) {
+func (m *AuthRequestMutation) SetExpiry(t time.Time) {
 	m.expiry = &t
 }
 
 // Expiry returns the value of the "expiry" field in the mutation.
-func (m *DeviceRequestMutation) Expiry() (r time.Time, exists bool) {
+func (m *AuthRequestMutation) Expiry() (r time.Time, exists bool) {
 	v := m.expiry
 	if v == nil {
 		return
@@ -3551,10 +3753,10 @@ func (m *DeviceRequestMutation) Expiry() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiry returns the old "expiry" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+func (m *AuthRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
@@ -3569,62 +3771,7647 @@ func (m *DeviceRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err
 }
 
 // ResetExpiry resets all changes to the "expiry" field.
-func (m *DeviceRequestMutation) ResetExpiry() {
+func (m *AuthRequestMutation) ResetExpiry() {
 	m.expiry = nil
 }
 
-// Where appends a list predicates to the DeviceRequestMutation builder.
-func (m *DeviceRequestMutation) Where(ps ...predicate.DeviceRequest) {
-	m.predicates = append(m.predicates, ps...)
+// SetCodeChallenge sets the "code_challenge" field.
+func (m *AuthRequestMutation) SetCodeChallenge(s string) {
+	m.code_challenge = &s
 }
 
-// WhereP appends storage-level predicates to the DeviceRequestMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeviceRequest, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// CodeChallenge returns the value of the "code_challenge" field in the mutation.
+func (m *AuthRequestMutation) CodeChallenge() (r string, exists bool) {
+	v := m.code_challenge
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *DeviceRequestMutation) Op() Op {
-	return m.op
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DeviceRequestMutation) SetOp(op Op) {
-	m.op = op
-}
+// OldCodeChallenge returns the old "code_challenge" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldCodeChallenge(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeChallenge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeChallenge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeChallenge: %w", err)
+	}
+	return oldValue.CodeChallenge, nil
+}
+
+// ResetCodeChallenge resets all changes to the "code_challenge" field.
+func (m *AuthRequestMutation) ResetCodeChallenge() {
+	m.code_challenge = nil
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (m *AuthRequestMutation) SetCodeChallengeMethod(s string) {
+	m.code_challenge_method = &s
+}
+
+// CodeChallengeMethod returns the value of the "code_challenge_method" field in the mutation.
+func (m *AuthRequestMutation) CodeChallengeMethod() (r string, exists bool) {
+	v := m.code_challenge_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeChallengeMethod returns the old "code_challenge_method" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldCodeChallengeMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeChallengeMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeChallengeMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeChallengeMethod: %w", err)
+	}
+	return oldValue.CodeChallengeMethod, nil
+}
+
+// ResetCodeChallengeMethod resets all changes to the "code_challenge_method" field.
+func (m *AuthRequestMutation) ResetCodeChallengeMethod() {
+	m.code_challenge_method = nil
+}
+
+// SetHmacKey sets the "hmac_key" field.
+func (m *AuthRequestMutation) SetHmacKey(b []byte) {
+	m.hmac_key = &b
+}
+
+// HmacKey returns the value of the "hmac_key" field in the mutation.
+func (m *AuthRequestMutation) HmacKey() (r []byte, exists bool) {
+	v := m.hmac_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHmacKey returns the old "hmac_key" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldHmacKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHmacKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHmacKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHmacKey: %w", err)
+	}
+	return oldValue.HmacKey, nil
+}
+
+// ResetHmacKey resets all changes to the "hmac_key" field.
+func (m *AuthRequestMutation) ResetHmacKey() {
+	m.hmac_key = nil
+}
+
+// SetPrompt sets the "prompt" field.
+func (m *AuthRequestMutation) SetPrompt(s string) {
+	m.prompt = &s
+}
+
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *AuthRequestMutation) Prompt() (r string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrompt returns the old "prompt" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
+}
+
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *AuthRequestMutation) ResetPrompt() {
+	m.prompt = nil
+}
+
+// SetMaxAge sets the "max_age" field.
+func (m *AuthRequestMutation) SetMaxAge(i int) {
+	m.max_age = &i
+	m.addmax_age = nil
+}
+
+// MaxAge returns the value of the "max_age" field in the mutation.
+func (m *AuthRequestMutation) MaxAge() (r int, exists bool) {
+	v := m.max_age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAge returns the old "max_age" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldMaxAge(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAge: %w", err)
+	}
+	return oldValue.MaxAge, nil
+}
+
+// AddMaxAge adds i to the "max_age" field.
+func (m *AuthRequestMutation) AddMaxAge(i int) {
+	if m.addmax_age != nil {
+		*m.addmax_age += i
+	} else {
+		m.addmax_age = &i
+	}
+}
+
+// AddedMaxAge returns the value that was added to the "max_age" field in this mutation.
+func (m *AuthRequestMutation) AddedMaxAge() (r int, exists bool) {
+	v := m.addmax_age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAge resets all changes to the "max_age" field.
+func (m *AuthRequestMutation) ResetMaxAge() {
+	m.max_age = nil
+	m.addmax_age = nil
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (m *AuthRequestMutation) SetAcrValues(s []string) {
+	m.acr_values = &s
+	m.appendacr_values = nil
+}
+
+// AcrValues returns the value of the "acr_values" field in the mutation.
+func (m *AuthRequestMutation) AcrValues() (r []string, exists bool) {
+	v := m.acr_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcrValues returns the old "acr_values" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldAcrValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcrValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcrValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcrValues: %w", err)
+	}
+	return oldValue.AcrValues, nil
+}
+
+// AppendAcrValues adds s to the "acr_values" field.
+func (m *AuthRequestMutation) AppendAcrValues(s []string) {
+	m.appendacr_values = append(m.appendacr_values, s...)
+}
+
+// AppendedAcrValues returns the list of values that were appended to the "acr_values" field in this mutation.
+func (m *AuthRequestMutation) AppendedAcrValues() ([]string, bool) {
+	if len(m.appendacr_values) == 0 {
+		return nil, false
+	}
+	return m.appendacr_values, true
+}
+
+// ClearAcrValues clears the value of the "acr_values" field.
+func (m *AuthRequestMutation) ClearAcrValues() {
+	m.acr_values = nil
+	m.appendacr_values = nil
+	m.clearedFields[authrequest.FieldAcrValues] = struct{}{}
+}
+
+// AcrValuesCleared returns if the "acr_values" field was cleared in this mutation.
+func (m *AuthRequestMutation) AcrValuesCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldAcrValues]
+	return ok
+}
+
+// ResetAcrValues resets all changes to the "acr_values" field.
+func (m *AuthRequestMutation) ResetAcrValues() {
+	m.acr_values = nil
+	m.appendacr_values = nil
+	delete(m.clearedFields, authrequest.FieldAcrValues)
+}
+
+// SetAcr sets the "acr" field.
+func (m *AuthRequestMutation) SetAcr(s string) {
+	m.acr = &s
+}
+
+// Acr returns the value of the "acr" field in the mutation.
+func (m *AuthRequestMutation) Acr() (r string, exists bool) {
+	v := m.acr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcr returns the old "acr" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldAcr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcr: %w", err)
+	}
+	return oldValue.Acr, nil
+}
+
+// ResetAcr resets all changes to the "acr" field.
+func (m *AuthRequestMutation) ResetAcr() {
+	m.acr = nil
+}
+
+// SetAmr sets the "amr" field.
+func (m *AuthRequestMutation) SetAmr(s []string) {
+	m.amr = &s
+	m.appendamr = nil
+}
+
+// Amr returns the value of the "amr" field in the mutation.
+func (m *AuthRequestMutation) Amr() (r []string, exists bool) {
+	v := m.amr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmr returns the old "amr" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldAmr(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
+	}
+	return oldValue.Amr, nil
+}
+
+// AppendAmr adds s to the "amr" field.
+func (m *AuthRequestMutation) AppendAmr(s []string) {
+	m.appendamr = append(m.appendamr, s...)
+}
+
+// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
+func (m *AuthRequestMutation) AppendedAmr() ([]string, bool) {
+	if len(m.appendamr) == 0 {
+		return nil, false
+	}
+	return m.appendamr, true
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (m *AuthRequestMutation) ClearAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	m.clearedFields[authrequest.FieldAmr] = struct{}{}
+}
+
+// AmrCleared returns if the "amr" field was cleared in this mutation.
+func (m *AuthRequestMutation) AmrCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldAmr]
+	return ok
+}
+
+// ResetAmr resets all changes to the "amr" field.
+func (m *AuthRequestMutation) ResetAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	delete(m.clearedFields, authrequest.FieldAmr)
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (m *AuthRequestMutation) SetAuthTime(t time.Time) {
+	m.auth_time = &t
+}
+
+// AuthTime returns the value of the "auth_time" field in the mutation.
+func (m *AuthRequestMutation) AuthTime() (r time.Time, exists bool) {
+	v := m.auth_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthTime returns the old "auth_time" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldAuthTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
+	}
+	return oldValue.AuthTime, nil
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (m *AuthRequestMutation) ClearAuthTime() {
+	m.auth_time = nil
+	m.clearedFields[authrequest.FieldAuthTime] = struct{}{}
+}
+
+// AuthTimeCleared returns if the "auth_time" field was cleared in this mutation.
+func (m *AuthRequestMutation) AuthTimeCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldAuthTime]
+	return ok
+}
+
+// ResetAuthTime resets all changes to the "auth_time" field.
+func (m *AuthRequestMutation) ResetAuthTime() {
+	m.auth_time = nil
+	delete(m.clearedFields, authrequest.FieldAuthTime)
+}
+
+// SetSid sets the "sid" field.
+func (m *AuthRequestMutation) SetSid(s string) {
+	m.sid = &s
+}
+
+// Sid returns the value of the "sid" field in the mutation.
+func (m *AuthRequestMutation) Sid() (r string, exists bool) {
+	v := m.sid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSid returns the old "sid" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldSid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSid: %w", err)
+	}
+	return oldValue.Sid, nil
+}
+
+// ResetSid resets all changes to the "sid" field.
+func (m *AuthRequestMutation) ResetSid() {
+	m.sid = nil
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (m *AuthRequestMutation) SetLoginHint(s string) {
+	m.login_hint = &s
+}
+
+// LoginHint returns the value of the "login_hint" field in the mutation.
+func (m *AuthRequestMutation) LoginHint() (r string, exists bool) {
+	v := m.login_hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoginHint returns the old "login_hint" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldLoginHint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoginHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoginHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoginHint: %w", err)
+	}
+	return oldValue.LoginHint, nil
+}
+
+// ResetLoginHint resets all changes to the "login_hint" field.
+func (m *AuthRequestMutation) ResetLoginHint() {
+	m.login_hint = nil
+}
+
+// SetUILocales sets the "ui_locales" field.
+func (m *AuthRequestMutation) SetUILocales(s []string) {
+	m.ui_locales = &s
+	m.appendui_locales = nil
+}
+
+// UILocales returns the value of the "ui_locales" field in the mutation.
+func (m *AuthRequestMutation) UILocales() (r []string, exists bool) {
+	v := m.ui_locales
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUILocales returns the old "ui_locales" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldUILocales(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUILocales is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUILocales requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUILocales: %w", err)
+	}
+	return oldValue.UILocales, nil
+}
+
+// AppendUILocales adds s to the "ui_locales" field.
+func (m *AuthRequestMutation) AppendUILocales(s []string) {
+	m.appendui_locales = append(m.appendui_locales, s...)
+}
+
+// AppendedUILocales returns the list of values that were appended to the "ui_locales" field in this mutation.
+func (m *AuthRequestMutation) AppendedUILocales() ([]string, bool) {
+	if len(m.appendui_locales) == 0 {
+		return nil, false
+	}
+	return m.appendui_locales, true
+}
+
+// ClearUILocales clears the value of the "ui_locales" field.
+func (m *AuthRequestMutation) ClearUILocales() {
+	m.ui_locales = nil
+	m.appendui_locales = nil
+	m.clearedFields[authrequest.FieldUILocales] = struct{}{}
+}
+
+// UILocalesCleared returns if the "ui_locales" field was cleared in this mutation.
+func (m *AuthRequestMutation) UILocalesCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldUILocales]
+	return ok
+}
+
+// ResetUILocales resets all changes to the "ui_locales" field.
+func (m *AuthRequestMutation) ResetUILocales() {
+	m.ui_locales = nil
+	m.appendui_locales = nil
+	delete(m.clearedFields, authrequest.FieldUILocales)
+}
+
+// Where appends a list predicates to the AuthRequestMutation builder.
+func (m *AuthRequestMutation) Where(ps ...predicate.AuthRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuthRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuthRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuthRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuthRequest).
+func (m *AuthRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthRequestMutation) Fields() []string {
+	fields := make([]string, 0, 30)
+	if m.client_id != nil {
+		fields = append(fields, authrequest.FieldClientID)
+	}
+	if m.scopes != nil {
+		fields = append(fields, authrequest.FieldScopes)
+	}
+	if m.response_types != nil {
+		fields = append(fields, authrequest.FieldResponseTypes)
+	}
+	if m.redirect_uri != nil {
+		fields = append(fields, authrequest.FieldRedirectURI)
+	}
+	if m.nonce != nil {
+		fields = append(fields, authrequest.FieldNonce)
+	}
+	if m.state != nil {
+		fields = append(fields, authrequest.FieldState)
+	}
+	if m.force_approval_prompt != nil {
+		fields = append(fields, authrequest.FieldForceApprovalPrompt)
+	}
+	if m.logged_in != nil {
+		fields = append(fields, authrequest.FieldLoggedIn)
+	}
+	if m.claims_user_id != nil {
+		fields = append(fields, authrequest.FieldClaimsUserID)
+	}
+	if m.claims_username != nil {
+		fields = append(fields, authrequest.FieldClaimsUsername)
+	}
+	if m.claims_email != nil {
+		fields = append(fields, authrequest.FieldClaimsEmail)
+	}
+	if m.claims_email_verified != nil {
+		fields = append(fields, authrequest.FieldClaimsEmailVerified)
+	}
+	if m.claims_groups != nil {
+		fields = append(fields, authrequest.FieldClaimsGroups)
+	}
+	if m.claims_extra != nil {
+		fields = append(fields, authrequest.FieldClaimsExtra)
+	}
+	if m.claims_preferred_username != nil {
+		fields = append(fields, authrequest.FieldClaimsPreferredUsername)
+	}
+	if m.connector_id != nil {
+		fields = append(fields, authrequest.FieldConnectorID)
+	}
+	if m.connector_data != nil {
+		fields = append(fields, authrequest.FieldConnectorData)
+	}
+	if m.expiry != nil {
+		fields = append(fields, authrequest.FieldExpiry)
+	}
+	if m.code_challenge != nil {
+		fields = append(fields, authrequest.FieldCodeChallenge)
+	}
+	if m.code_challenge_method != nil {
+		fields = append(fields, authrequest.FieldCodeChallengeMethod)
+	}
+	if m.hmac_key != nil {
+		fields = append(fields, authrequest.FieldHmacKey)
+	}
+	if m.prompt != nil {
+		fields = append(fields, authrequest.FieldPrompt)
+	}
+	if m.max_age != nil {
+		fields = append(fields, authrequest.FieldMaxAge)
+	}
+	if m.acr_values != nil {
+		fields = append(fields, authrequest.FieldAcrValues)
+	}
+	if m.acr != nil {
+		fields = append(fields, authrequest.FieldAcr)
+	}
+	if m.amr != nil {
+		fields = append(fields, authrequest.FieldAmr)
+	}
+	if m.auth_time != nil {
+		fields = append(fields, authrequest.FieldAuthTime)
+	}
+	if m.sid != nil {
+		fields = append(fields, authrequest.FieldSid)
+	}
+	if m.login_hint != nil {
+		fields = append(fields, authrequest.FieldLoginHint)
+	}
+	if m.ui_locales != nil {
+		fields = append(fields, authrequest.FieldUILocales)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authrequest.FieldClientID:
+		return m.ClientID()
+	case authrequest.FieldScopes:
+		return m.Scopes()
+	case authrequest.FieldResponseTypes:
+		return m.ResponseTypes()
+	case authrequest.FieldRedirectURI:
+		return m.RedirectURI()
+	case authrequest.FieldNonce:
+		return m.Nonce()
+	case authrequest.FieldState:
+		return m.State()
+	case authrequest.FieldForceApprovalPrompt:
+		return m.ForceApprovalPrompt()
+	case authrequest.FieldLoggedIn:
+		return m.LoggedIn()
+	case authrequest.FieldClaimsUserID:
+		return m.ClaimsUserID()
+	case authrequest.FieldClaimsUsername:
+		return m.ClaimsUsername()
+	case authrequest.FieldClaimsEmail:
+		return m.ClaimsEmail()
+	case authrequest.FieldClaimsEmailVerified:
+		return m.ClaimsEmailVerified()
+	case authrequest.FieldClaimsGroups:
+		return m.ClaimsGroups()
+	case authrequest.FieldClaimsExtra:
+		return m.ClaimsExtra()
+	case authrequest.FieldClaimsPreferredUsername:
+		return m.ClaimsPreferredUsername()
+	case authrequest.FieldConnectorID:
+		return m.ConnectorID()
+	case authrequest.FieldConnectorData:
+		return m.ConnectorData()
+	case authrequest.FieldExpiry:
+		return m.Expiry()
+	case authrequest.FieldCodeChallenge:
+		return m.CodeChallenge()
+	case authrequest.FieldCodeChallengeMethod:
+		return m.CodeChallengeMethod()
+	case authrequest.FieldHmacKey:
+		return m.HmacKey()
+	case authrequest.FieldPrompt:
+		return m.Prompt()
+	case authrequest.FieldMaxAge:
+		return m.MaxAge()
+	case authrequest.FieldAcrValues:
+		return m.AcrValues()
+	case authrequest.FieldAcr:
+		return m.Acr()
+	case authrequest.FieldAmr:
+		return m.Amr()
+	case authrequest.FieldAuthTime:
+		return m.AuthTime()
+	case authrequest.FieldSid:
+		return m.Sid()
+	case authrequest.FieldLoginHint:
+		return m.LoginHint()
+	case authrequest.FieldUILocales:
+		return m.UILocales()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authrequest.FieldClientID:
+		return m.OldClientID(ctx)
+	case authrequest.FieldScopes:
+		return m.OldScopes(ctx)
+	case authrequest.FieldResponseTypes:
+		return m.OldResponseTypes(ctx)
+	case authrequest.FieldRedirectURI:
+		return m.OldRedirectURI(ctx)
+	case authrequest.FieldNonce:
+		return m.OldNonce(ctx)
+	case authrequest.FieldState:
+		return m.OldState(ctx)
+	case authrequest.FieldForceApprovalPrompt:
+		return m.OldForceApprovalPrompt(ctx)
+	case authrequest.FieldLoggedIn:
+		return m.OldLoggedIn(ctx)
+	case authrequest.FieldClaimsUserID:
+		return m.OldClaimsUserID(ctx)
+	case authrequest.FieldClaimsUsername:
+		return m.OldClaimsUsername(ctx)
+	case authrequest.FieldClaimsEmail:
+		return m.OldClaimsEmail(ctx)
+	case authrequest.FieldClaimsEmailVerified:
+		return m.OldClaimsEmailVerified(ctx)
+	case authrequest.FieldClaimsGroups:
+		return m.OldClaimsGroups(ctx)
+	case authrequest.FieldClaimsExtra:
+		return m.OldClaimsExtra(ctx)
+	case authrequest.FieldClaimsPreferredUsername:
+		return m.OldClaimsPreferredUsername(ctx)
+	case authrequest.FieldConnectorID:
+		return m.OldConnectorID(ctx)
+	case authrequest.FieldConnectorData:
+		return m.OldConnectorData(ctx)
+	case authrequest.FieldExpiry:
+		return m.OldExpiry(ctx)
+	case authrequest.FieldCodeChallenge:
+		return m.OldCodeChallenge(ctx)
+	case authrequest.FieldCodeChallengeMethod:
+		return m.OldCodeChallengeMethod(ctx)
+	case authrequest.FieldHmacKey:
+		return m.OldHmacKey(ctx)
+	case authrequest.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case authrequest.FieldMaxAge:
+		return m.OldMaxAge(ctx)
+	case authrequest.FieldAcrValues:
+		return m.OldAcrValues(ctx)
+	case authrequest.FieldAcr:
+		return m.OldAcr(ctx)
+	case authrequest.FieldAmr:
+		return m.OldAmr(ctx)
+	case authrequest.FieldAuthTime:
+		return m.OldAuthTime(ctx)
+	case authrequest.FieldSid:
+		return m.OldSid(ctx)
+	case authrequest.FieldLoginHint:
+		return m.OldLoginHint(ctx)
+	case authrequest.FieldUILocales:
+		return m.OldUILocales(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuthRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case authrequest.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case authrequest.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case authrequest.FieldResponseTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseTypes(v)
+		return nil
+	case authrequest.FieldRedirectURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectURI(v)
+		return nil
+	case authrequest.FieldNonce:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNonce(v)
+		return nil
+	case authrequest.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case authrequest.FieldForceApprovalPrompt:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForceApprovalPrompt(v)
+		return nil
+	case authrequest.FieldLoggedIn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoggedIn(v)
+		return nil
+	case authrequest.FieldClaimsUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsUserID(v)
+		return nil
+	case authrequest.FieldClaimsUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsUsername(v)
+		return nil
+	case authrequest.FieldClaimsEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsEmail(v)
+		return nil
+	case authrequest.FieldClaimsEmailVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsEmailVerified(v)
+		return nil
+	case authrequest.FieldClaimsGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsGroups(v)
+		return nil
+	case authrequest.FieldClaimsExtra:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsExtra(v)
+		return nil
+	case authrequest.FieldClaimsPreferredUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsPreferredUsername(v)
+		return nil
+	case authrequest.FieldConnectorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectorID(v)
+		return nil
+	case authrequest.FieldConnectorData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectorData(v)
+		return nil
+	case authrequest.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	case authrequest.FieldCodeChallenge:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeChallenge(v)
+		return nil
+	case authrequest.FieldCodeChallengeMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeChallengeMethod(v)
+		return nil
+	case authrequest.FieldHmacKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHmacKey(v)
+		return nil
+	case authrequest.FieldPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case authrequest.FieldMaxAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAge(v)
+		return nil
+	case authrequest.FieldAcrValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcrValues(v)
+		return nil
+	case authrequest.FieldAcr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcr(v)
+		return nil
+	case authrequest.FieldAmr:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmr(v)
+		return nil
+	case authrequest.FieldAuthTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthTime(v)
+		return nil
+	case authrequest.FieldSid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSid(v)
+		return nil
+	case authrequest.FieldLoginHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoginHint(v)
+		return nil
+	case authrequest.FieldUILocales:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUILocales(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_age != nil {
+		fields = append(fields, authrequest.FieldMaxAge)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case authrequest.FieldMaxAge:
+		return m.AddedMaxAge()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case authrequest.FieldMaxAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(authrequest.FieldScopes) {
+		fields = append(fields, authrequest.FieldScopes)
+	}
+	if m.FieldCleared(authrequest.FieldResponseTypes) {
+		fields = append(fields, authrequest.FieldResponseTypes)
+	}
+	if m.FieldCleared(authrequest.FieldClaimsGroups) {
+		fields = append(fields, authrequest.FieldClaimsGroups)
+	}
+	if m.FieldCleared(authrequest.FieldClaimsExtra) {
+		fields = append(fields, authrequest.FieldClaimsExtra)
+	}
+	if m.FieldCleared(authrequest.FieldConnectorData) {
+		fields = append(fields, authrequest.FieldConnectorData)
+	}
+	if m.FieldCleared(authrequest.FieldAcrValues) {
+		fields = append(fields, authrequest.FieldAcrValues)
+	}
+	if m.FieldCleared(authrequest.FieldAmr) {
+		fields = append(fields, authrequest.FieldAmr)
+	}
+	if m.FieldCleared(authrequest.FieldAuthTime) {
+		fields = append(fields, authrequest.FieldAuthTime)
+	}
+	if m.FieldCleared(authrequest.FieldUILocales) {
+		fields = append(fields, authrequest.FieldUILocales)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthRequestMutation) ClearField(name string) error {
+	switch name {
+	case authrequest.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case authrequest.FieldResponseTypes:
+		m.ClearResponseTypes()
+		return nil
+	case authrequest.FieldClaimsGroups:
+		m.ClearClaimsGroups()
+		return nil
+	case authrequest.FieldClaimsExtra:
+		m.ClearClaimsExtra()
+		return nil
+	case authrequest.FieldConnectorData:
+		m.ClearConnectorData()
+		return nil
+	case authrequest.FieldAcrValues:
+		m.ClearAcrValues()
+		return nil
+	case authrequest.FieldAmr:
+		m.ClearAmr()
+		return nil
+	case authrequest.FieldAuthTime:
+		m.ClearAuthTime()
+		return nil
+	case authrequest.FieldUILocales:
+		m.ClearUILocales()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthRequestMutation) ResetField(name string) error {
+	switch name {
+	case authrequest.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case authrequest.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case authrequest.FieldResponseTypes:
+		m.ResetResponseTypes()
+		return nil
+	case authrequest.FieldRedirectURI:
+		m.ResetRedirectURI()
+		return nil
+	case authrequest.FieldNonce:
+		m.ResetNonce()
+		return nil
+	case authrequest.FieldState:
+		m.ResetState()
+		return nil
+	case authrequest.FieldForceApprovalPrompt:
+		m.ResetForceApprovalPrompt()
+		return nil
+	case authrequest.FieldLoggedIn:
+		m.ResetLoggedIn()
+		return nil
+	case authrequest.FieldClaimsUserID:
+		m.ResetClaimsUserID()
+		return nil
+	case authrequest.FieldClaimsUsername:
+		m.ResetClaimsUsername()
+		return nil
+	case authrequest.FieldClaimsEmail:
+		m.ResetClaimsEmail()
+		return nil
+	case authrequest.FieldClaimsEmailVerified:
+		m.ResetClaimsEmailVerified()
+		return nil
+	case authrequest.FieldClaimsGroups:
+		m.ResetClaimsGroups()
+		return nil
+	case authrequest.FieldClaimsExtra:
+		m.ResetClaimsExtra()
+		return nil
+	case authrequest.FieldClaimsPreferredUsername:
+		m.ResetClaimsPreferredUsername()
+		return nil
+	case authrequest.FieldConnectorID:
+		m.ResetConnectorID()
+		return nil
+	case authrequest.FieldConnectorData:
+		m.ResetConnectorData()
+		return nil
+	case authrequest.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	case authrequest.FieldCodeChallenge:
+		m.ResetCodeChallenge()
+		return nil
+	case authrequest.FieldCodeChallengeMethod:
+		m.ResetCodeChallengeMethod()
+		return nil
+	case authrequest.FieldHmacKey:
+		m.ResetHmacKey()
+		return nil
+	case authrequest.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case authrequest.FieldMaxAge:
+		m.ResetMaxAge()
+		return nil
+	case authrequest.FieldAcrValues:
+		m.ResetAcrValues()
+		return nil
+	case authrequest.FieldAcr:
+		m.ResetAcr()
+		return nil
+	case authrequest.FieldAmr:
+		m.ResetAmr()
+		return nil
+	case authrequest.FieldAuthTime:
+		m.ResetAuthTime()
+		return nil
+	case authrequest.FieldSid:
+		m.ResetSid()
+		return nil
+	case authrequest.FieldLoginHint:
+		m.ResetLoginHint()
+		return nil
+	case authrequest.FieldUILocales:
+		m.ResetUILocales()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthRequestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthRequestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthRequest edge %s", name)
+}
+
+// ClientAssertionMutation represents an operation that mutates the ClientAssertion nodes in the graph.
+type ClientAssertionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	client_id     *string
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ClientAssertion, error)
+	predicates    []predicate.ClientAssertion
+}
+
+var _ ent.Mutation = (*ClientAssertionMutation)(nil)
+
+// clientassertionOption allows management of the mutation configuration using functional options.
+type clientassertionOption func(*ClientAssertionMutation)
+
+// newClientAssertionMutation creates new mutation for the ClientAssertion entity.
+func newClientAssertionMutation(c config, op Op, opts ...clientassertionOption) *ClientAssertionMutation {
+	m := &ClientAssertionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeClientAssertion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withClientAssertionID sets the ID field of the mutation.
+func withClientAssertionID(id string) clientassertionOption {
+	return func(m *ClientAssertionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ClientAssertion
+		)
+		m.oldValue = func(ctx context.Context) (*ClientAssertion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ClientAssertion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withClientAssertion sets the old ClientAssertion of the mutation.
+func withClientAssertion(node *ClientAssertion) clientassertionOption {
+	return func(m *ClientAssertionMutation) {
+		m.oldValue = func(context.Context) (*ClientAssertion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ClientAssertionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ClientAssertionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ClientAssertion entities.
+func (m *ClientAssertionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ClientAssertionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ClientAssertionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ClientAssertion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetClientID sets the "client_id" field.
+func (m *ClientAssertionMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *ClientAssertionMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the ClientAssertion entity.
+// If the ClientAssertion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientAssertionMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *ClientAssertionMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *ClientAssertionMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *ClientAssertionMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the ClientAssertion entity.
+// If the ClientAssertion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientAssertionMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *ClientAssertionMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the ClientAssertionMutation builder.
+func (m *ClientAssertionMutation) Where(ps ...predicate.ClientAssertion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClientAssertionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClientAssertionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ClientAssertion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClientAssertionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ClientAssertionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ClientAssertion).
+func (m *ClientAssertionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ClientAssertionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.client_id != nil {
+		fields = append(fields, clientassertion.FieldClientID)
+	}
+	if m.expiry != nil {
+		fields = append(fields, clientassertion.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ClientAssertionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case clientassertion.FieldClientID:
+		return m.ClientID()
+	case clientassertion.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ClientAssertionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case clientassertion.FieldClientID:
+		return m.OldClientID(ctx)
+	case clientassertion.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown ClientAssertion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientAssertionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case clientassertion.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case clientassertion.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ClientAssertion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ClientAssertionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ClientAssertionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientAssertionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ClientAssertion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ClientAssertionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ClientAssertionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ClientAssertionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ClientAssertion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ClientAssertionMutation) ResetField(name string) error {
+	switch name {
+	case clientassertion.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case clientassertion.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown ClientAssertion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ClientAssertionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ClientAssertionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ClientAssertionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ClientAssertionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ClientAssertionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ClientAssertionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ClientAssertionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ClientAssertion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ClientAssertionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ClientAssertion edge %s", name)
+}
+
+// ConnectorMutation represents an operation that mutates the Connector nodes in the graph.
+type ConnectorMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	_type            *string
+	name             *string
+	resource_version *string
+	_config          *[]byte
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Connector, error)
+	predicates       []predicate.Connector
+}
+
+var _ ent.Mutation = (*ConnectorMutation)(nil)
+
+// connectorOption allows management of the mutation configuration using functional options.
+type connectorOption func(*ConnectorMutation)
+
+// newConnectorMutation creates new mutation for the Connector entity.
+func newConnectorMutation(c config, op Op, opts ...connectorOption) *ConnectorMutation {
+	m := &ConnectorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConnector,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConnectorID sets the ID field of the mutation.
+func withConnectorID(id string) connectorOption {
+	return func(m *ConnectorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Connector
+		)
+		m.oldValue = func(ctx context.Context) (*Connector, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Connector.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConnector sets the old Connector of the mutation.
+func withConnector(node *Connector) connectorOption {
+	return func(m *ConnectorMutation) {
+		m.oldValue = func(context.Context) (*Connector, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConnectorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConnectorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Connector entities.
+func (m *ConnectorMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConnectorMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConnectorMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Connector.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *ConnectorMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ConnectorMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectorMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ConnectorMutation) ResetType() {
+	m._type = nil
+}
+
+// SetName sets the "name" field.
+func (m *ConnectorMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ConnectorMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectorMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ConnectorMutation) ResetName() {
+	m.name = nil
+}
+
+// SetResourceVersion sets the "resource_version" field.
+func (m *ConnectorMutation) SetResourceVersion(s string) {
+	m.resource_version = &s
+}
+
+// ResourceVersion returns the value of the "resource_version" field in the mutation.
+func (m *ConnectorMutation) ResourceVersion() (r string, exists bool) {
+	v := m.resource_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceVersion returns the old "resource_version" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectorMutation) OldResourceVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceVersion: %w", err)
+	}
+	return oldValue.ResourceVersion, nil
+}
+
+// ResetResourceVersion resets all changes to the "resource_version" field.
+func (m *ConnectorMutation) ResetResourceVersion() {
+	m.resource_version = nil
+}
+
+// SetConfig sets the "config" field.
+func (m *ConnectorMutation) SetConfig(b []byte) {
+	m._config = &b
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *ConnectorMutation) Config() (r []byte, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectorMutation) OldConfig(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *ConnectorMutation) ResetConfig() {
+	m._config = nil
+}
+
+// Where appends a list predicates to the ConnectorMutation builder.
+func (m *ConnectorMutation) Where(ps ...predicate.Connector) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConnectorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConnectorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Connector, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConnectorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConnectorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Connector).
+func (m *ConnectorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConnectorMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m._type != nil {
+		fields = append(fields, connector.FieldType)
+	}
+	if m.name != nil {
+		fields = append(fields, connector.FieldName)
+	}
+	if m.resource_version != nil {
+		fields = append(fields, connector.FieldResourceVersion)
+	}
+	if m._config != nil {
+		fields = append(fields, connector.FieldConfig)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConnectorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case connector.FieldType:
+		return m.GetType()
+	case connector.FieldName:
+		return m.Name()
+	case connector.FieldResourceVersion:
+		return m.ResourceVersion()
+	case connector.FieldConfig:
+		return m.Config()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConnectorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case connector.FieldType:
+		return m.OldType(ctx)
+	case connector.FieldName:
+		return m.OldName(ctx)
+	case connector.FieldResourceVersion:
+		return m.OldResourceVersion(ctx)
+	case connector.FieldConfig:
+		return m.OldConfig(ctx)
+	}
+	return nil, fmt.Errorf("unknown Connector field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case connector.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case connector.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case connector.FieldResourceVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceVersion(v)
+		return nil
+	case connector.FieldConfig:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Connector field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConnectorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConnectorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Connector numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConnectorMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConnectorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConnectorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Connector nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConnectorMutation) ResetField(name string) error {
+	switch name {
+	case connector.FieldType:
+		m.ResetType()
+		return nil
+	case connector.FieldName:
+		m.ResetName()
+		return nil
+	case connector.FieldResourceVersion:
+		m.ResetResourceVersion()
+		return nil
+	case connector.FieldConfig:
+		m.ResetConfig()
+		return nil
+	}
+	return fmt.Errorf("unknown Connector field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConnectorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConnectorMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConnectorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConnectorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConnectorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConnectorMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConnectorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Connector unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConnectorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Connector edge %s", name)
+}
+
+// ConsentMutation represents an operation that mutates the Consent nodes in the graph.
+type ConsentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	user_id       *string
+	conn_id       *string
+	client_id     *string
+	scopes        *[]string
+	appendscopes  []string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Consent, error)
+	predicates    []predicate.Consent
+}
+
+var _ ent.Mutation = (*ConsentMutation)(nil)
+
+// consentOption allows management of the mutation configuration using functional options.
+type consentOption func(*ConsentMutation)
+
+// newConsentMutation creates new mutation for the Consent entity.
+func newConsentMutation(c config, op Op, opts ...consentOption) *ConsentMutation {
+	m := &ConsentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConsent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConsentID sets the ID field of the mutation.
+func withConsentID(id string) consentOption {
+	return func(m *ConsentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Consent
+		)
+		m.oldValue = func(ctx context.Context) (*Consent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Consent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConsent sets the old Consent of the mutation.
+func withConsent(node *Consent) consentOption {
+	return func(m *ConsentMutation) {
+		m.oldValue = func(context.Context) (*Consent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConsentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConsentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Consent entities.
+func (m *ConsentMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConsentMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConsentMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Consent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ConsentMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ConsentMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ConsentMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetConnID sets the "conn_id" field.
+func (m *ConsentMutation) SetConnID(s string) {
+	m.conn_id = &s
+}
+
+// ConnID returns the value of the "conn_id" field in the mutation.
+func (m *ConsentMutation) ConnID() (r string, exists bool) {
+	v := m.conn_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnID returns the old "conn_id" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldConnID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnID: %w", err)
+	}
+	return oldValue.ConnID, nil
+}
+
+// ResetConnID resets all changes to the "conn_id" field.
+func (m *ConsentMutation) ResetConnID() {
+	m.conn_id = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *ConsentMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *ConsentMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *ConsentMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *ConsentMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ConsentMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *ConsentMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *ConsentMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *ConsentMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[consent.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *ConsentMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[consent.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ConsentMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, consent.FieldScopes)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConsentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConsentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConsentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConsentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConsentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConsentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ConsentMutation builder.
+func (m *ConsentMutation) Where(ps ...predicate.Consent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConsentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConsentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Consent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConsentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConsentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Consent).
+func (m *ConsentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConsentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_id != nil {
+		fields = append(fields, consent.FieldUserID)
+	}
+	if m.conn_id != nil {
+		fields = append(fields, consent.FieldConnID)
+	}
+	if m.client_id != nil {
+		fields = append(fields, consent.FieldClientID)
+	}
+	if m.scopes != nil {
+		fields = append(fields, consent.FieldScopes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, consent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, consent.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConsentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case consent.FieldUserID:
+		return m.UserID()
+	case consent.FieldConnID:
+		return m.ConnID()
+	case consent.FieldClientID:
+		return m.ClientID()
+	case consent.FieldScopes:
+		return m.Scopes()
+	case consent.FieldCreatedAt:
+		return m.CreatedAt()
+	case consent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConsentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case consent.FieldUserID:
+		return m.OldUserID(ctx)
+	case consent.FieldConnID:
+		return m.OldConnID(ctx)
+	case consent.FieldClientID:
+		return m.OldClientID(ctx)
+	case consent.FieldScopes:
+		return m.OldScopes(ctx)
+	case consent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case consent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Consent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case consent.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case consent.FieldConnID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnID(v)
+		return nil
+	case consent.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case consent.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case consent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case consent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Consent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConsentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConsentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Consent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConsentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(consent.FieldScopes) {
+		fields = append(fields, consent.FieldScopes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConsentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConsentMutation) ClearField(name string) error {
+	switch name {
+	case consent.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown Consent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConsentMutation) ResetField(name string) error {
+	switch name {
+	case consent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case consent.FieldConnID:
+		m.ResetConnID()
+		return nil
+	case consent.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case consent.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case consent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case consent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Consent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConsentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConsentMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConsentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConsentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConsentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConsentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConsentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Consent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConsentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Consent edge %s", name)
+}
+
+// DeviceRequestMutation represents an operation that mutates the DeviceRequest nodes in the graph.
+type DeviceRequestMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_code     *string
+	device_code   *string
+	client_id     *string
+	client_secret *string
+	scopes        *[]string
+	appendscopes  []string
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeviceRequest, error)
+	predicates    []predicate.DeviceRequest
+}
+
+var _ ent.Mutation = (*DeviceRequestMutation)(nil)
+
+// devicerequestOption allows management of the mutation configuration using functional options.
+type devicerequestOption func(*DeviceRequestMutation)
+
+// newDeviceRequestMutation creates new mutation for the DeviceRequest entity.
+func newDeviceRequestMutation(c config, op Op, opts ...devicerequestOption) *DeviceRequestMutation {
+	m := &DeviceRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceRequestID sets the ID field of the mutation.
+func withDeviceRequestID(id int) devicerequestOption {
+	return func(m *DeviceRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceRequest
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeviceRequest sets the old DeviceRequest of the mutation.
+func withDeviceRequest(node *DeviceRequest) devicerequestOption {
+	return func(m *DeviceRequestMutation) {
+		m.oldValue = func(context.Context) (*DeviceRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserCode sets the "user_code" field.
+func (m *DeviceRequestMutation) SetUserCode(s string) {
+	m.user_code = &s
+}
+
+// UserCode returns the value of the "user_code" field in the mutation.
+func (m *DeviceRequestMutation) UserCode() (r string, exists bool) {
+	v := m.user_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserCode returns the old "user_code" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceRequestMutation) OldUserCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserCode: %w", err)
+	}
+	return oldValue.UserCode, nil
+}
+
+// ResetUserCode resets all changes to the "user_code" field.
+func (m *DeviceRequestMutation) ResetUserCode() {
+	m.user_code = nil
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (m *DeviceRequestMutation) SetDeviceCode(s string) {
+	m.device_code = &s
+}
+
+// DeviceCode returns the value of the "device_code" field in the mutation.
+func (m *DeviceRequestMutation) DeviceCode() (r string, exists bool) {
+	v := m.device_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceCode returns the old "device_code" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceRequestMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+	}
+	return oldValue.DeviceCode, nil
+}
+
+// ResetDeviceCode resets all changes to the "device_code" field.
+func (m *DeviceRequestMutation) ResetDeviceCode() {
+	m.device_code = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *DeviceRequestMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *DeviceRequestMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceRequestMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *DeviceRequestMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (m *DeviceRequestMutation) SetClientSecret(s string) {
+	m.client_secret = &s
+}
+
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *DeviceRequestMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientSecret returns the old "client_secret" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceRequestMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+	}
+	return oldValue.ClientSecret, nil
+}
+
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *DeviceRequestMutation) ResetClientSecret() {
+	m.client_secret = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *DeviceRequestMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *DeviceRequestMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *DeviceRequestMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *DeviceRequestMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *DeviceRequestMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[devicerequest.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *DeviceRequestMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[devicerequest.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *DeviceRequestMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, devicerequest.FieldScopes)
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *DeviceRequestMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *DeviceRequestMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *DeviceRequestMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the DeviceRequestMutation builder.
+func (m *DeviceRequestMutation) Where(ps ...predicate.DeviceRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceRequest).
+func (m *DeviceRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceRequestMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_code != nil {
+		fields = append(fields, devicerequest.FieldUserCode)
+	}
+	if m.device_code != nil {
+		fields = append(fields, devicerequest.FieldDeviceCode)
+	}
+	if m.client_id != nil {
+		fields = append(fields, devicerequest.FieldClientID)
+	}
+	if m.client_secret != nil {
+		fields = append(fields, devicerequest.FieldClientSecret)
+	}
+	if m.scopes != nil {
+		fields = append(fields, devicerequest.FieldScopes)
+	}
+	if m.expiry != nil {
+		fields = append(fields, devicerequest.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case devicerequest.FieldUserCode:
+		return m.UserCode()
+	case devicerequest.FieldDeviceCode:
+		return m.DeviceCode()
+	case devicerequest.FieldClientID:
+		return m.ClientID()
+	case devicerequest.FieldClientSecret:
+		return m.ClientSecret()
+	case devicerequest.FieldScopes:
+		return m.Scopes()
+	case devicerequest.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case devicerequest.FieldUserCode:
+		return m.OldUserCode(ctx)
+	case devicerequest.FieldDeviceCode:
+		return m.OldDeviceCode(ctx)
+	case devicerequest.FieldClientID:
+		return m.OldClientID(ctx)
+	case devicerequest.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case devicerequest.FieldScopes:
+		return m.OldScopes(ctx)
+	case devicerequest.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case devicerequest.FieldUserCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserCode(v)
+		return nil
+	case devicerequest.FieldDeviceCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceCode(v)
+		return nil
+	case devicerequest.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case devicerequest.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case devicerequest.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case devicerequest.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeviceRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(devicerequest.FieldScopes) {
+		fields = append(fields, devicerequest.FieldScopes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceRequestMutation) ClearField(name string) error {
+	switch name {
+	case devicerequest.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceRequestMutation) ResetField(name string) error {
+	switch name {
+	case devicerequest.FieldUserCode:
+		m.ResetUserCode()
+		return nil
+	case devicerequest.FieldDeviceCode:
+		m.ResetDeviceCode()
+		return nil
+	case devicerequest.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case devicerequest.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case devicerequest.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case devicerequest.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceRequestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceRequestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceRequest edge %s", name)
+}
+
+// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
+type DeviceTokenMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	device_code           *string
+	status                *string
+	token                 *[]byte
+	expiry                *time.Time
+	last_request          *time.Time
+	poll_interval         *int
+	addpoll_interval      *int
+	code_challenge        *string
+	code_challenge_method *string
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*DeviceToken, error)
+	predicates            []predicate.DeviceToken
+}
+
+var _ ent.Mutation = (*DeviceTokenMutation)(nil)
+
+// devicetokenOption allows management of the mutation configuration using functional options.
+type devicetokenOption func(*DeviceTokenMutation)
+
+// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
+func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
+	m := &DeviceTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceTokenID sets the ID field of the mutation.
+func withDeviceTokenID(id int) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceToken
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeviceToken sets the old DeviceToken of the mutation.
+func withDeviceToken(node *DeviceToken) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
+		m.oldValue = func(context.Context) (*DeviceToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (m *DeviceTokenMutation) SetDeviceCode(s string) {
+	m.device_code = &s
+}
+
+// DeviceCode returns the value of the "device_code" field in the mutation.
+func (m *DeviceTokenMutation) DeviceCode() (r string, exists bool) {
+	v := m.device_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceCode returns the old "device_code" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+	}
+	return oldValue.DeviceCode, nil
+}
+
+// ResetDeviceCode resets all changes to the "device_code" field.
+func (m *DeviceTokenMutation) ResetDeviceCode() {
+	m.device_code = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DeviceTokenMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DeviceTokenMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DeviceTokenMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetToken sets the "token" field.
+func (m *DeviceTokenMutation) SetToken(b []byte) {
+	m.token = &b
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *DeviceTokenMutation) Token() (r []byte, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldToken(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ClearToken clears the value of the "token" field.
+func (m *DeviceTokenMutation) ClearToken() {
+	m.token = nil
+	m.clearedFields[devicetoken.FieldToken] = struct{}{}
+}
+
+// TokenCleared returns if the "token" field was cleared in this mutation.
+func (m *DeviceTokenMutation) TokenCleared() bool {
+	_, ok := m.clearedFields[devicetoken.FieldToken]
+	return ok
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *DeviceTokenMutation) ResetToken() {
+	m.token = nil
+	delete(m.clearedFields, devicetoken.FieldToken)
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *DeviceTokenMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *DeviceTokenMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *DeviceTokenMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// SetLastRequest sets the "last_request" field.
+func (m *DeviceTokenMutation) SetLastRequest(t time.Time) {
+	m.last_request = &t
+}
+
+// LastRequest returns the value of the "last_request" field in the mutation.
+func (m *DeviceTokenMutation) LastRequest() (r time.Time, exists bool) {
+	v := m.last_request
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRequest returns the old "last_request" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldLastRequest(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRequest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRequest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRequest: %w", err)
+	}
+	return oldValue.LastRequest, nil
+}
+
+// ResetLastRequest resets all changes to the "last_request" field.
+func (m *DeviceTokenMutation) ResetLastRequest() {
+	m.last_request = nil
+}
+
+// SetPollInterval sets the "poll_interval" field.
+func (m *DeviceTokenMutation) SetPollInterval(i int) {
+	m.poll_interval = &i
+	m.addpoll_interval = nil
+}
+
+// PollInterval returns the value of the "poll_interval" field in the mutation.
+func (m *DeviceTokenMutation) PollInterval() (r int, exists bool) {
+	v := m.poll_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollInterval returns the old "poll_interval" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldPollInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollInterval: %w", err)
+	}
+	return oldValue.PollInterval, nil
+}
+
+// AddPollInterval adds i to the "poll_interval" field.
+func (m *DeviceTokenMutation) AddPollInterval(i int) {
+	if m.addpoll_interval != nil {
+		*m.addpoll_interval += i
+	} else {
+		m.addpoll_interval = &i
+	}
+}
+
+// AddedPollInterval returns the value that was added to the "poll_interval" field in this mutation.
+func (m *DeviceTokenMutation) AddedPollInterval() (r int, exists bool) {
+	v := m.addpoll_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPollInterval resets all changes to the "poll_interval" field.
+func (m *DeviceTokenMutation) ResetPollInterval() {
+	m.poll_interval = nil
+	m.addpoll_interval = nil
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (m *DeviceTokenMutation) SetCodeChallenge(s string) {
+	m.code_challenge = &s
+}
+
+// CodeChallenge returns the value of the "code_challenge" field in the mutation.
+func (m *DeviceTokenMutation) CodeChallenge() (r string, exists bool) {
+	v := m.code_challenge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeChallenge returns the old "code_challenge" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldCodeChallenge(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeChallenge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeChallenge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeChallenge: %w", err)
+	}
+	return oldValue.CodeChallenge, nil
+}
+
+// ResetCodeChallenge resets all changes to the "code_challenge" field.
+func (m *DeviceTokenMutation) ResetCodeChallenge() {
+	m.code_challenge = nil
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (m *DeviceTokenMutation) SetCodeChallengeMethod(s string) {
+	m.code_challenge_method = &s
+}
+
+// CodeChallengeMethod returns the value of the "code_challenge_method" field in the mutation.
+func (m *DeviceTokenMutation) CodeChallengeMethod() (r string, exists bool) {
+	v := m.code_challenge_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeChallengeMethod returns the old "code_challenge_method" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldCodeChallengeMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeChallengeMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeChallengeMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeChallengeMethod: %w", err)
+	}
+	return oldValue.CodeChallengeMethod, nil
+}
+
+// ResetCodeChallengeMethod resets all changes to the "code_challenge_method" field.
+func (m *DeviceTokenMutation) ResetCodeChallengeMethod() {
+	m.code_challenge_method = nil
+}
+
+// Where appends a list predicates to the DeviceTokenMutation builder.
+func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceToken).
+func (m *DeviceTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.device_code != nil {
+		fields = append(fields, devicetoken.FieldDeviceCode)
+	}
+	if m.status != nil {
+		fields = append(fields, devicetoken.FieldStatus)
+	}
+	if m.token != nil {
+		fields = append(fields, devicetoken.FieldToken)
+	}
+	if m.expiry != nil {
+		fields = append(fields, devicetoken.FieldExpiry)
+	}
+	if m.last_request != nil {
+		fields = append(fields, devicetoken.FieldLastRequest)
+	}
+	if m.poll_interval != nil {
+		fields = append(fields, devicetoken.FieldPollInterval)
+	}
+	if m.code_challenge != nil {
+		fields = append(fields, devicetoken.FieldCodeChallenge)
+	}
+	if m.code_challenge_method != nil {
+		fields = append(fields, devicetoken.FieldCodeChallengeMethod)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case devicetoken.FieldDeviceCode:
+		return m.DeviceCode()
+	case devicetoken.FieldStatus:
+		return m.Status()
+	case devicetoken.FieldToken:
+		return m.Token()
+	case devicetoken.FieldExpiry:
+		return m.Expiry()
+	case devicetoken.FieldLastRequest:
+		return m.LastRequest()
+	case devicetoken.FieldPollInterval:
+		return m.PollInterval()
+	case devicetoken.FieldCodeChallenge:
+		return m.CodeChallenge()
+	case devicetoken.FieldCodeChallengeMethod:
+		return m.CodeChallengeMethod()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case devicetoken.FieldDeviceCode:
+		return m.OldDeviceCode(ctx)
+	case devicetoken.FieldStatus:
+		return m.OldStatus(ctx)
+	case devicetoken.FieldToken:
+		return m.OldToken(ctx)
+	case devicetoken.FieldExpiry:
+		return m.OldExpiry(ctx)
+	case devicetoken.FieldLastRequest:
+		return m.OldLastRequest(ctx)
+	case devicetoken.FieldPollInterval:
+		return m.OldPollInterval(ctx)
+	case devicetoken.FieldCodeChallenge:
+		return m.OldCodeChallenge(ctx)
+	case devicetoken.FieldCodeChallengeMethod:
+		return m.OldCodeChallengeMethod(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case devicetoken.FieldDeviceCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceCode(v)
+		return nil
+	case devicetoken.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case devicetoken.FieldToken:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case devicetoken.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	case devicetoken.FieldLastRequest:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRequest(v)
+		return nil
+	case devicetoken.FieldPollInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollInterval(v)
+		return nil
+	case devicetoken.FieldCodeChallenge:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeChallenge(v)
+		return nil
+	case devicetoken.FieldCodeChallengeMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeChallengeMethod(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addpoll_interval != nil {
+		fields = append(fields, devicetoken.FieldPollInterval)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case devicetoken.FieldPollInterval:
+		return m.AddedPollInterval()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case devicetoken.FieldPollInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPollInterval(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(devicetoken.FieldToken) {
+		fields = append(fields, devicetoken.FieldToken)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceTokenMutation) ClearField(name string) error {
+	switch name {
+	case devicetoken.FieldToken:
+		m.ClearToken()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceTokenMutation) ResetField(name string) error {
+	switch name {
+	case devicetoken.FieldDeviceCode:
+		m.ResetDeviceCode()
+		return nil
+	case devicetoken.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case devicetoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case devicetoken.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	case devicetoken.FieldLastRequest:
+		m.ResetLastRequest()
+		return nil
+	case devicetoken.FieldPollInterval:
+		m.ResetPollInterval()
+		return nil
+	case devicetoken.FieldCodeChallenge:
+		m.ResetCodeChallenge()
+		return nil
+	case devicetoken.FieldCodeChallengeMethod:
+		m.ResetCodeChallengeMethod()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken edge %s", name)
+}
+
+// DpopProofMutation represents an operation that mutates the DpopProof nodes in the graph.
+type DpopProofMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DpopProof, error)
+	predicates    []predicate.DpopProof
+}
+
+var _ ent.Mutation = (*DpopProofMutation)(nil)
+
+// dpopproofOption allows management of the mutation configuration using functional options.
+type dpopproofOption func(*DpopProofMutation)
+
+// newDpopProofMutation creates new mutation for the DpopProof entity.
+func newDpopProofMutation(c config, op Op, opts ...dpopproofOption) *DpopProofMutation {
+	m := &DpopProofMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDpopProof,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDpopProofID sets the ID field of the mutation.
+func withDpopProofID(id string) dpopproofOption {
+	return func(m *DpopProofMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DpopProof
+		)
+		m.oldValue = func(ctx context.Context) (*DpopProof, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DpopProof.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDpopProof sets the old DpopProof of the mutation.
+func withDpopProof(node *DpopProof) dpopproofOption {
+	return func(m *DpopProofMutation) {
+		m.oldValue = func(context.Context) (*DpopProof, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DpopProofMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DpopProofMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DpopProof entities.
+func (m *DpopProofMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DpopProofMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DpopProofMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DpopProof.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *DpopProofMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *DpopProofMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the DpopProof entity.
+// If the DpopProof object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DpopProofMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *DpopProofMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the DpopProofMutation builder.
+func (m *DpopProofMutation) Where(ps ...predicate.DpopProof) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DpopProofMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DpopProofMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DpopProof, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DpopProofMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DpopProofMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DpopProof).
+func (m *DpopProofMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DpopProofMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.expiry != nil {
+		fields = append(fields, dpopproof.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DpopProofMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dpopproof.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DpopProofMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dpopproof.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown DpopProof field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DpopProofMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dpopproof.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DpopProof field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DpopProofMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DpopProofMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DpopProofMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DpopProof numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DpopProofMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DpopProofMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DpopProofMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DpopProof nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DpopProofMutation) ResetField(name string) error {
+	switch name {
+	case dpopproof.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown DpopProof field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DpopProofMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DpopProofMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DpopProofMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DpopProofMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DpopProofMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DpopProofMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DpopProofMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DpopProof unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DpopProofMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DpopProof edge %s", name)
+}
+
+// InitialAccessTokenMutation represents an operation that mutates the InitialAccessToken nodes in the graph.
+type InitialAccessTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	created_at    *time.Time
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*InitialAccessToken, error)
+	predicates    []predicate.InitialAccessToken
+}
+
+var _ ent.Mutation = (*InitialAccessTokenMutation)(nil)
+
+// initialaccesstokenOption allows management of the mutation configuration using functional options.
+type initialaccesstokenOption func(*InitialAccessTokenMutation)
+
+// newInitialAccessTokenMutation creates new mutation for the InitialAccessToken entity.
+func newInitialAccessTokenMutation(c config, op Op, opts ...initialaccesstokenOption) *InitialAccessTokenMutation {
+	m := &InitialAccessTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInitialAccessToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInitialAccessTokenID sets the ID field of the mutation.
+func withInitialAccessTokenID(id string) initialaccesstokenOption {
+	return func(m *InitialAccessTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InitialAccessToken
+		)
+		m.oldValue = func(ctx context.Context) (*InitialAccessToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InitialAccessToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInitialAccessToken sets the old InitialAccessToken of the mutation.
+func withInitialAccessToken(node *InitialAccessToken) initialaccesstokenOption {
+	return func(m *InitialAccessTokenMutation) {
+		m.oldValue = func(context.Context) (*InitialAccessToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InitialAccessTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InitialAccessTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of InitialAccessToken entities.
+func (m *InitialAccessTokenMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InitialAccessTokenMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InitialAccessTokenMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InitialAccessToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InitialAccessTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InitialAccessTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the InitialAccessToken entity.
+// If the InitialAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InitialAccessTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InitialAccessTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *InitialAccessTokenMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *InitialAccessTokenMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the InitialAccessToken entity.
+// If the InitialAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InitialAccessTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *InitialAccessTokenMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the InitialAccessTokenMutation builder.
+func (m *InitialAccessTokenMutation) Where(ps ...predicate.InitialAccessToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InitialAccessTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InitialAccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InitialAccessToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InitialAccessTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InitialAccessTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InitialAccessToken).
+func (m *InitialAccessTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InitialAccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.created_at != nil {
+		fields = append(fields, initialaccesstoken.FieldCreatedAt)
+	}
+	if m.expiry != nil {
+		fields = append(fields, initialaccesstoken.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InitialAccessTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case initialaccesstoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case initialaccesstoken.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InitialAccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case initialaccesstoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case initialaccesstoken.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown InitialAccessToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InitialAccessTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case initialaccesstoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case initialaccesstoken.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InitialAccessToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InitialAccessTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InitialAccessTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InitialAccessTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown InitialAccessToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InitialAccessTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InitialAccessTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InitialAccessTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown InitialAccessToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InitialAccessTokenMutation) ResetField(name string) error {
+	switch name {
+	case initialaccesstoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case initialaccesstoken.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown InitialAccessToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InitialAccessTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InitialAccessTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InitialAccessTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InitialAccessTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InitialAccessTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InitialAccessTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InitialAccessTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InitialAccessToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InitialAccessTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InitialAccessToken edge %s", name)
+}
+
+// KeysMutation represents an operation that mutates the Keys nodes in the graph.
+type KeysMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	verification_keys       *[]storage.VerificationKey
+	appendverification_keys []storage.VerificationKey
+	signing_key             *jose.JSONWebKey
+	signing_key_pub         *jose.JSONWebKey
+	next_rotation           *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*Keys, error)
+	predicates              []predicate.Keys
+}
+
+var _ ent.Mutation = (*KeysMutation)(nil)
+
+// keysOption allows management of the mutation configuration using functional options.
+type keysOption func(*KeysMutation)
+
+// newKeysMutation creates new mutation for the Keys entity.
+func newKeysMutation(c config, op Op, opts ...keysOption) *KeysMutation {
+	m := &KeysMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeys,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeysID sets the ID field of the mutation.
+func withKeysID(id string) keysOption {
+	return func(m *KeysMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Keys
+		)
+		m.oldValue = func(ctx context.Context) (*Keys, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Keys.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeys sets the old Keys of the mutation.
+func withKeys(node *Keys) keysOption {
+	return func(m *KeysMutation) {
+		m.oldValue = func(context.Context) (*Keys, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeysMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeysMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Keys entities.
+func (m *KeysMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeysMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeysMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Keys.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVerificationKeys sets the "verification_keys" field.
+func (m *KeysMutation) SetVerificationKeys(sk []storage.VerificationKey) {
+	m.verification_keys = &sk
+	m.appendverification_keys = nil
+}
+
+// VerificationKeys returns the value of the "verification_keys" field in the mutation.
+func (m *KeysMutation) VerificationKeys() (r []storage.VerificationKey, exists bool) {
+	v := m.verification_keys
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationKeys returns the old "verification_keys" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldVerificationKeys(ctx context.Context) (v []storage.VerificationKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationKeys is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationKeys requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationKeys: %w", err)
+	}
+	return oldValue.VerificationKeys, nil
+}
+
+// AppendVerificationKeys adds sk to the "verification_keys" field.
+func (m *KeysMutation) AppendVerificationKeys(sk []storage.VerificationKey) {
+	m.appendverification_keys = append(m.appendverification_keys, sk...)
+}
+
+// AppendedVerificationKeys returns the list of values that were appended to the "verification_keys" field in this mutation.
+func (m *KeysMutation) AppendedVerificationKeys() ([]storage.VerificationKey, bool) {
+	if len(m.appendverification_keys) == 0 {
+		return nil, false
+	}
+	return m.appendverification_keys, true
+}
+
+// ResetVerificationKeys resets all changes to the "verification_keys" field.
+func (m *KeysMutation) ResetVerificationKeys() {
+	m.verification_keys = nil
+	m.appendverification_keys = nil
+}
+
+// SetSigningKey sets the "signing_key" field.
+func (m *KeysMutation) SetSigningKey(jwk jose.JSONWebKey) {
+	m.signing_key = &jwk
+}
+
+// SigningKey returns the value of the "signing_key" field in the mutation.
+func (m *KeysMutation) SigningKey() (r jose.JSONWebKey, exists bool) {
+	v := m.signing_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigningKey returns the old "signing_key" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldSigningKey(ctx context.Context) (v jose.JSONWebKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningKey: %w", err)
+	}
+	return oldValue.SigningKey, nil
+}
+
+// ResetSigningKey resets all changes to the "signing_key" field.
+func (m *KeysMutation) ResetSigningKey() {
+	m.signing_key = nil
+}
+
+// SetSigningKeyPub sets the "signing_key_pub" field.
+func (m *KeysMutation) SetSigningKeyPub(jwk jose.JSONWebKey) {
+	m.signing_key_pub = &jwk
+}
+
+// SigningKeyPub returns the value of the "signing_key_pub" field in the mutation.
+func (m *KeysMutation) SigningKeyPub() (r jose.JSONWebKey, exists bool) {
+	v := m.signing_key_pub
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigningKeyPub returns the old "signing_key_pub" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldSigningKeyPub(ctx context.Context) (v jose.JSONWebKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningKeyPub is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningKeyPub requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningKeyPub: %w", err)
+	}
+	return oldValue.SigningKeyPub, nil
+}
+
+// ResetSigningKeyPub resets all changes to the "signing_key_pub" field.
+func (m *KeysMutation) ResetSigningKeyPub() {
+	m.signing_key_pub = nil
+}
+
+// SetNextRotation sets the "next_rotation" field.
+func (m *KeysMutation) SetNextRotation(t time.Time) {
+	m.next_rotation = &t
+}
+
+// NextRotation returns the value of the "next_rotation" field in the mutation.
+func (m *KeysMutation) NextRotation() (r time.Time, exists bool) {
+	v := m.next_rotation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRotation returns the old "next_rotation" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldNextRotation(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRotation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRotation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRotation: %w", err)
+	}
+	return oldValue.NextRotation, nil
+}
+
+// ResetNextRotation resets all changes to the "next_rotation" field.
+func (m *KeysMutation) ResetNextRotation() {
+	m.next_rotation = nil
+}
+
+// Where appends a list predicates to the KeysMutation builder.
+func (m *KeysMutation) Where(ps ...predicate.Keys) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeysMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeysMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Keys, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeysMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeysMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Keys).
+func (m *KeysMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeysMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.verification_keys != nil {
+		fields = append(fields, keys.FieldVerificationKeys)
+	}
+	if m.signing_key != nil {
+		fields = append(fields, keys.FieldSigningKey)
+	}
+	if m.signing_key_pub != nil {
+		fields = append(fields, keys.FieldSigningKeyPub)
+	}
+	if m.next_rotation != nil {
+		fields = append(fields, keys.FieldNextRotation)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeysMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keys.FieldVerificationKeys:
+		return m.VerificationKeys()
+	case keys.FieldSigningKey:
+		return m.SigningKey()
+	case keys.FieldSigningKeyPub:
+		return m.SigningKeyPub()
+	case keys.FieldNextRotation:
+		return m.NextRotation()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeysMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keys.FieldVerificationKeys:
+		return m.OldVerificationKeys(ctx)
+	case keys.FieldSigningKey:
+		return m.OldSigningKey(ctx)
+	case keys.FieldSigningKeyPub:
+		return m.OldSigningKeyPub(ctx)
+	case keys.FieldNextRotation:
+		return m.OldNextRotation(ctx)
+	}
+	return nil, fmt.Errorf("unknown Keys field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeysMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keys.FieldVerificationKeys:
+		v, ok := value.([]storage.VerificationKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationKeys(v)
+		return nil
+	case keys.FieldSigningKey:
+		v, ok := value.(jose.JSONWebKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigningKey(v)
+		return nil
+	case keys.FieldSigningKeyPub:
+		v, ok := value.(jose.JSONWebKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigningKeyPub(v)
+		return nil
+	case keys.FieldNextRotation:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRotation(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Keys field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeysMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeysMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeysMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Keys numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeysMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeysMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeysMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Keys nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeysMutation) ResetField(name string) error {
+	switch name {
+	case keys.FieldVerificationKeys:
+		m.ResetVerificationKeys()
+		return nil
+	case keys.FieldSigningKey:
+		m.ResetSigningKey()
+		return nil
+	case keys.FieldSigningKeyPub:
+		m.ResetSigningKeyPub()
+		return nil
+	case keys.FieldNextRotation:
+		m.ResetNextRotation()
+		return nil
+	}
+	return fmt.Errorf("unknown Keys field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeysMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeysMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeysMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeysMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeysMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeysMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeysMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Keys unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeysMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Keys edge %s", name)
+}
+
+// LogoutNotificationMutation represents an operation that mutates the LogoutNotification nodes in the graph.
+type LogoutNotificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	client_id     *string
+	subject       *string
+	session_id    *string
+	created_at    *time.Time
+	attempts      *int
+	addattempts   *int
+	next_attempt  *time.Time
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LogoutNotification, error)
+	predicates    []predicate.LogoutNotification
+}
+
+var _ ent.Mutation = (*LogoutNotificationMutation)(nil)
+
+// logoutnotificationOption allows management of the mutation configuration using functional options.
+type logoutnotificationOption func(*LogoutNotificationMutation)
+
+// newLogoutNotificationMutation creates new mutation for the LogoutNotification entity.
+func newLogoutNotificationMutation(c config, op Op, opts ...logoutnotificationOption) *LogoutNotificationMutation {
+	m := &LogoutNotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLogoutNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLogoutNotificationID sets the ID field of the mutation.
+func withLogoutNotificationID(id string) logoutnotificationOption {
+	return func(m *LogoutNotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LogoutNotification
+		)
+		m.oldValue = func(ctx context.Context) (*LogoutNotification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LogoutNotification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLogoutNotification sets the old LogoutNotification of the mutation.
+func withLogoutNotification(node *LogoutNotification) logoutnotificationOption {
+	return func(m *LogoutNotificationMutation) {
+		m.oldValue = func(context.Context) (*LogoutNotification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LogoutNotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LogoutNotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LogoutNotification entities.
+func (m *LogoutNotificationMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LogoutNotificationMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LogoutNotificationMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LogoutNotification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetClientID sets the "client_id" field.
+func (m *LogoutNotificationMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *LogoutNotificationMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the LogoutNotification entity.
+// If the LogoutNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutNotificationMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *LogoutNotificationMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *LogoutNotificationMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *LogoutNotificationMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the LogoutNotification entity.
+// If the LogoutNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutNotificationMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *LogoutNotificationMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *LogoutNotificationMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *LogoutNotificationMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the LogoutNotification entity.
+// If the LogoutNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutNotificationMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *LogoutNotificationMutation) ResetSessionID() {
+	m.session_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LogoutNotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LogoutNotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LogoutNotification entity.
+// If the LogoutNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutNotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LogoutNotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *LogoutNotificationMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *LogoutNotificationMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the LogoutNotification entity.
+// If the LogoutNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutNotificationMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *LogoutNotificationMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *LogoutNotificationMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *LogoutNotificationMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttempt sets the "next_attempt" field.
+func (m *LogoutNotificationMutation) SetNextAttempt(t time.Time) {
+	m.next_attempt = &t
+}
+
+// NextAttempt returns the value of the "next_attempt" field in the mutation.
+func (m *LogoutNotificationMutation) NextAttempt() (r time.Time, exists bool) {
+	v := m.next_attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttempt returns the old "next_attempt" field's value of the LogoutNotification entity.
+// If the LogoutNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutNotificationMutation) OldNextAttempt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttempt: %w", err)
+	}
+	return oldValue.NextAttempt, nil
+}
+
+// ResetNextAttempt resets all changes to the "next_attempt" field.
+func (m *LogoutNotificationMutation) ResetNextAttempt() {
+	m.next_attempt = nil
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *LogoutNotificationMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *LogoutNotificationMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the LogoutNotification entity.
+// If the LogoutNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogoutNotificationMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *LogoutNotificationMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the LogoutNotificationMutation builder.
+func (m *LogoutNotificationMutation) Where(ps ...predicate.LogoutNotification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LogoutNotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LogoutNotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LogoutNotification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LogoutNotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LogoutNotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LogoutNotification).
+func (m *LogoutNotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LogoutNotificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.client_id != nil {
+		fields = append(fields, logoutnotification.FieldClientID)
+	}
+	if m.subject != nil {
+		fields = append(fields, logoutnotification.FieldSubject)
+	}
+	if m.session_id != nil {
+		fields = append(fields, logoutnotification.FieldSessionID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, logoutnotification.FieldCreatedAt)
+	}
+	if m.attempts != nil {
+		fields = append(fields, logoutnotification.FieldAttempts)
+	}
+	if m.next_attempt != nil {
+		fields = append(fields, logoutnotification.FieldNextAttempt)
+	}
+	if m.expiry != nil {
+		fields = append(fields, logoutnotification.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LogoutNotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case logoutnotification.FieldClientID:
+		return m.ClientID()
+	case logoutnotification.FieldSubject:
+		return m.Subject()
+	case logoutnotification.FieldSessionID:
+		return m.SessionID()
+	case logoutnotification.FieldCreatedAt:
+		return m.CreatedAt()
+	case logoutnotification.FieldAttempts:
+		return m.Attempts()
+	case logoutnotification.FieldNextAttempt:
+		return m.NextAttempt()
+	case logoutnotification.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LogoutNotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case logoutnotification.FieldClientID:
+		return m.OldClientID(ctx)
+	case logoutnotification.FieldSubject:
+		return m.OldSubject(ctx)
+	case logoutnotification.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case logoutnotification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case logoutnotification.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case logoutnotification.FieldNextAttempt:
+		return m.OldNextAttempt(ctx)
+	case logoutnotification.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown LogoutNotification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LogoutNotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case logoutnotification.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case logoutnotification.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case logoutnotification.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case logoutnotification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case logoutnotification.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case logoutnotification.FieldNextAttempt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttempt(v)
+		return nil
+	case logoutnotification.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LogoutNotification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LogoutNotificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, logoutnotification.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LogoutNotificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case logoutnotification.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LogoutNotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case logoutnotification.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LogoutNotification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LogoutNotificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LogoutNotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LogoutNotificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LogoutNotification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LogoutNotificationMutation) ResetField(name string) error {
+	switch name {
+	case logoutnotification.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case logoutnotification.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case logoutnotification.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case logoutnotification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case logoutnotification.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case logoutnotification.FieldNextAttempt:
+		m.ResetNextAttempt()
+		return nil
+	case logoutnotification.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown LogoutNotification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LogoutNotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LogoutNotificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LogoutNotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LogoutNotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LogoutNotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LogoutNotificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LogoutNotificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LogoutNotification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LogoutNotificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LogoutNotification edge %s", name)
+}
+
+// OAuth2ClientMutation represents an operation that mutates the OAuth2Client nodes in the graph.
+type OAuth2ClientMutation struct {
+	config
+	op                                    Op
+	typ                                   string
+	id                                    *string
+	secret                                *string
+	redirect_uris                         *[]string
+	appendredirect_uris                   []string
+	trusted_peers                         *[]string
+	appendtrusted_peers                   []string
+	post_logout_redirect_uris             *[]string
+	appendpost_logout_redirect_uris       []string
+	backchannel_logout_uri                *string
+	client_credentials_scopes             *[]string
+	appendclient_credentials_scopes       []string
+	client_credentials_audiences          *[]string
+	appendclient_credentials_audiences    []string
+	access_token_format                   *string
+	require_pushed_authorization_requests *bool
+	jwks                                  *string
+	jwks_uri                              *string
+	registration_access_token_hash        *string
+	token_endpoint_auth_method            *string
+	tls_client_auth_subject_dn            *string
+	claim_mappings                        *[]storage.ClaimMapping
+	appendclaim_mappings                  []storage.ClaimMapping
+	subject_type                          *string
+	sector_identifier_uri                 *string
+	required_scopes                       *[]string
+	appendrequired_scopes                 []string
+	expiry                                **storage.ClientExpiry
+	allowed_connectors                    *[]string
+	appendallowed_connectors              []string
+	allowed_grant_types                   *[]string
+	appendallowed_grant_types             []string
+	allowed_response_types                *[]string
+	appendallowed_response_types          []string
+	require_pkce                          *bool
+	public                                *bool
+	name                                  *string
+	logo_url                              *string
+	clearedFields                         map[string]struct{}
+	done                                  bool
+	oldValue                              func(context.Context) (*OAuth2Client, error)
+	predicates                            []predicate.OAuth2Client
+}
+
+var _ ent.Mutation = (*OAuth2ClientMutation)(nil)
+
+// oauth2clientOption allows management of the mutation configuration using functional options.
+type oauth2clientOption func(*OAuth2ClientMutation)
+
+// newOAuth2ClientMutation creates new mutation for the OAuth2Client entity.
+func newOAuth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *OAuth2ClientMutation {
+	m := &OAuth2ClientMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOAuth2Client,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOAuth2ClientID sets the ID field of the mutation.
+func withOAuth2ClientID(id string) oauth2clientOption {
+	return func(m *OAuth2ClientMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OAuth2Client
+		)
+		m.oldValue = func(ctx context.Context) (*OAuth2Client, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OAuth2Client.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOAuth2Client sets the old OAuth2Client of the mutation.
+func withOAuth2Client(node *OAuth2Client) oauth2clientOption {
+	return func(m *OAuth2ClientMutation) {
+		m.oldValue = func(context.Context) (*OAuth2Client, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OAuth2ClientMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OAuth2ClientMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OAuth2Client entities.
+func (m *OAuth2ClientMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OAuth2ClientMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OAuth2ClientMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OAuth2Client.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSecret sets the "secret" field.
+func (m *OAuth2ClientMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *OAuth2ClientMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *OAuth2ClientMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (m *OAuth2ClientMutation) SetRedirectUris(s []string) {
+	m.redirect_uris = &s
+	m.appendredirect_uris = nil
+}
+
+// RedirectUris returns the value of the "redirect_uris" field in the mutation.
+func (m *OAuth2ClientMutation) RedirectUris() (r []string, exists bool) {
+	v := m.redirect_uris
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirectUris returns the old "redirect_uris" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
+	}
+	return oldValue.RedirectUris, nil
+}
+
+// AppendRedirectUris adds s to the "redirect_uris" field.
+func (m *OAuth2ClientMutation) AppendRedirectUris(s []string) {
+	m.appendredirect_uris = append(m.appendredirect_uris, s...)
+}
+
+// AppendedRedirectUris returns the list of values that were appended to the "redirect_uris" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedRedirectUris() ([]string, bool) {
+	if len(m.appendredirect_uris) == 0 {
+		return nil, false
+	}
+	return m.appendredirect_uris, true
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (m *OAuth2ClientMutation) ClearRedirectUris() {
+	m.redirect_uris = nil
+	m.appendredirect_uris = nil
+	m.clearedFields[oauth2client.FieldRedirectUris] = struct{}{}
+}
+
+// RedirectUrisCleared returns if the "redirect_uris" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) RedirectUrisCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldRedirectUris]
+	return ok
+}
+
+// ResetRedirectUris resets all changes to the "redirect_uris" field.
+func (m *OAuth2ClientMutation) ResetRedirectUris() {
+	m.redirect_uris = nil
+	m.appendredirect_uris = nil
+	delete(m.clearedFields, oauth2client.FieldRedirectUris)
+}
+
+// SetTrustedPeers sets the "trusted_peers" field.
+func (m *OAuth2ClientMutation) SetTrustedPeers(s []string) {
+	m.trusted_peers = &s
+	m.appendtrusted_peers = nil
+}
+
+// TrustedPeers returns the value of the "trusted_peers" field in the mutation.
+func (m *OAuth2ClientMutation) TrustedPeers() (r []string, exists bool) {
+	v := m.trusted_peers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrustedPeers returns the old "trusted_peers" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldTrustedPeers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrustedPeers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrustedPeers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrustedPeers: %w", err)
+	}
+	return oldValue.TrustedPeers, nil
+}
+
+// AppendTrustedPeers adds s to the "trusted_peers" field.
+func (m *OAuth2ClientMutation) AppendTrustedPeers(s []string) {
+	m.appendtrusted_peers = append(m.appendtrusted_peers, s...)
+}
+
+// AppendedTrustedPeers returns the list of values that were appended to the "trusted_peers" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedTrustedPeers() ([]string, bool) {
+	if len(m.appendtrusted_peers) == 0 {
+		return nil, false
+	}
+	return m.appendtrusted_peers, true
+}
+
+// ClearTrustedPeers clears the value of the "trusted_peers" field.
+func (m *OAuth2ClientMutation) ClearTrustedPeers() {
+	m.trusted_peers = nil
+	m.appendtrusted_peers = nil
+	m.clearedFields[oauth2client.FieldTrustedPeers] = struct{}{}
+}
+
+// TrustedPeersCleared returns if the "trusted_peers" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) TrustedPeersCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldTrustedPeers]
+	return ok
+}
+
+// ResetTrustedPeers resets all changes to the "trusted_peers" field.
+func (m *OAuth2ClientMutation) ResetTrustedPeers() {
+	m.trusted_peers = nil
+	m.appendtrusted_peers = nil
+	delete(m.clearedFields, oauth2client.FieldTrustedPeers)
+}
+
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (m *OAuth2ClientMutation) SetPostLogoutRedirectUris(s []string) {
+	m.post_logout_redirect_uris = &s
+	m.appendpost_logout_redirect_uris = nil
+}
+
+// PostLogoutRedirectUris returns the value of the "post_logout_redirect_uris" field in the mutation.
+func (m *OAuth2ClientMutation) PostLogoutRedirectUris() (r []string, exists bool) {
+	v := m.post_logout_redirect_uris
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostLogoutRedirectUris returns the old "post_logout_redirect_uris" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldPostLogoutRedirectUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostLogoutRedirectUris is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostLogoutRedirectUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostLogoutRedirectUris: %w", err)
+	}
+	return oldValue.PostLogoutRedirectUris, nil
+}
+
+// AppendPostLogoutRedirectUris adds s to the "post_logout_redirect_uris" field.
+func (m *OAuth2ClientMutation) AppendPostLogoutRedirectUris(s []string) {
+	m.appendpost_logout_redirect_uris = append(m.appendpost_logout_redirect_uris, s...)
+}
+
+// AppendedPostLogoutRedirectUris returns the list of values that were appended to the "post_logout_redirect_uris" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedPostLogoutRedirectUris() ([]string, bool) {
+	if len(m.appendpost_logout_redirect_uris) == 0 {
+		return nil, false
+	}
+	return m.appendpost_logout_redirect_uris, true
+}
+
+// ClearPostLogoutRedirectUris clears the value of the "post_logout_redirect_uris" field.
+func (m *OAuth2ClientMutation) ClearPostLogoutRedirectUris() {
+	m.post_logout_redirect_uris = nil
+	m.appendpost_logout_redirect_uris = nil
+	m.clearedFields[oauth2client.FieldPostLogoutRedirectUris] = struct{}{}
+}
+
+// PostLogoutRedirectUrisCleared returns if the "post_logout_redirect_uris" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) PostLogoutRedirectUrisCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldPostLogoutRedirectUris]
+	return ok
+}
+
+// ResetPostLogoutRedirectUris resets all changes to the "post_logout_redirect_uris" field.
+func (m *OAuth2ClientMutation) ResetPostLogoutRedirectUris() {
+	m.post_logout_redirect_uris = nil
+	m.appendpost_logout_redirect_uris = nil
+	delete(m.clearedFields, oauth2client.FieldPostLogoutRedirectUris)
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (m *OAuth2ClientMutation) SetBackchannelLogoutURI(s string) {
+	m.backchannel_logout_uri = &s
+}
+
+// BackchannelLogoutURI returns the value of the "backchannel_logout_uri" field in the mutation.
+func (m *OAuth2ClientMutation) BackchannelLogoutURI() (r string, exists bool) {
+	v := m.backchannel_logout_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackchannelLogoutURI returns the old "backchannel_logout_uri" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldBackchannelLogoutURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackchannelLogoutURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackchannelLogoutURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackchannelLogoutURI: %w", err)
+	}
+	return oldValue.BackchannelLogoutURI, nil
+}
+
+// ResetBackchannelLogoutURI resets all changes to the "backchannel_logout_uri" field.
+func (m *OAuth2ClientMutation) ResetBackchannelLogoutURI() {
+	m.backchannel_logout_uri = nil
+}
+
+// SetClientCredentialsScopes sets the "client_credentials_scopes" field.
+func (m *OAuth2ClientMutation) SetClientCredentialsScopes(s []string) {
+	m.client_credentials_scopes = &s
+	m.appendclient_credentials_scopes = nil
+}
+
+// ClientCredentialsScopes returns the value of the "client_credentials_scopes" field in the mutation.
+func (m *OAuth2ClientMutation) ClientCredentialsScopes() (r []string, exists bool) {
+	v := m.client_credentials_scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientCredentialsScopes returns the old "client_credentials_scopes" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldClientCredentialsScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientCredentialsScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientCredentialsScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientCredentialsScopes: %w", err)
+	}
+	return oldValue.ClientCredentialsScopes, nil
+}
+
+// AppendClientCredentialsScopes adds s to the "client_credentials_scopes" field.
+func (m *OAuth2ClientMutation) AppendClientCredentialsScopes(s []string) {
+	m.appendclient_credentials_scopes = append(m.appendclient_credentials_scopes, s...)
+}
+
+// AppendedClientCredentialsScopes returns the list of values that were appended to the "client_credentials_scopes" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedClientCredentialsScopes() ([]string, bool) {
+	if len(m.appendclient_credentials_scopes) == 0 {
+		return nil, false
+	}
+	return m.appendclient_credentials_scopes, true
+}
+
+// ClearClientCredentialsScopes clears the value of the "client_credentials_scopes" field.
+func (m *OAuth2ClientMutation) ClearClientCredentialsScopes() {
+	m.client_credentials_scopes = nil
+	m.appendclient_credentials_scopes = nil
+	m.clearedFields[oauth2client.FieldClientCredentialsScopes] = struct{}{}
+}
+
+// ClientCredentialsScopesCleared returns if the "client_credentials_scopes" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) ClientCredentialsScopesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldClientCredentialsScopes]
+	return ok
+}
+
+// ResetClientCredentialsScopes resets all changes to the "client_credentials_scopes" field.
+func (m *OAuth2ClientMutation) ResetClientCredentialsScopes() {
+	m.client_credentials_scopes = nil
+	m.appendclient_credentials_scopes = nil
+	delete(m.clearedFields, oauth2client.FieldClientCredentialsScopes)
+}
+
+// SetClientCredentialsAudiences sets the "client_credentials_audiences" field.
+func (m *OAuth2ClientMutation) SetClientCredentialsAudiences(s []string) {
+	m.client_credentials_audiences = &s
+	m.appendclient_credentials_audiences = nil
+}
+
+// ClientCredentialsAudiences returns the value of the "client_credentials_audiences" field in the mutation.
+func (m *OAuth2ClientMutation) ClientCredentialsAudiences() (r []string, exists bool) {
+	v := m.client_credentials_audiences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientCredentialsAudiences returns the old "client_credentials_audiences" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldClientCredentialsAudiences(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientCredentialsAudiences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientCredentialsAudiences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientCredentialsAudiences: %w", err)
+	}
+	return oldValue.ClientCredentialsAudiences, nil
+}
+
+// AppendClientCredentialsAudiences adds s to the "client_credentials_audiences" field.
+func (m *OAuth2ClientMutation) AppendClientCredentialsAudiences(s []string) {
+	m.appendclient_credentials_audiences = append(m.appendclient_credentials_audiences, s...)
+}
+
+// AppendedClientCredentialsAudiences returns the list of values that were appended to the "client_credentials_audiences" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedClientCredentialsAudiences() ([]string, bool) {
+	if len(m.appendclient_credentials_audiences) == 0 {
+		return nil, false
+	}
+	return m.appendclient_credentials_audiences, true
+}
+
+// ClearClientCredentialsAudiences clears the value of the "client_credentials_audiences" field.
+func (m *OAuth2ClientMutation) ClearClientCredentialsAudiences() {
+	m.client_credentials_audiences = nil
+	m.appendclient_credentials_audiences = nil
+	m.clearedFields[oauth2client.FieldClientCredentialsAudiences] = struct{}{}
+}
+
+// ClientCredentialsAudiencesCleared returns if the "client_credentials_audiences" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) ClientCredentialsAudiencesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldClientCredentialsAudiences]
+	return ok
+}
+
+// ResetClientCredentialsAudiences resets all changes to the "client_credentials_audiences" field.
+func (m *OAuth2ClientMutation) ResetClientCredentialsAudiences() {
+	m.client_credentials_audiences = nil
+	m.appendclient_credentials_audiences = nil
+	delete(m.clearedFields, oauth2client.FieldClientCredentialsAudiences)
+}
+
+// SetAccessTokenFormat sets the "access_token_format" field.
+func (m *OAuth2ClientMutation) SetAccessTokenFormat(s string) {
+	m.access_token_format = &s
+}
+
+// AccessTokenFormat returns the value of the "access_token_format" field in the mutation.
+func (m *OAuth2ClientMutation) AccessTokenFormat() (r string, exists bool) {
+	v := m.access_token_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessTokenFormat returns the old "access_token_format" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldAccessTokenFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessTokenFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessTokenFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessTokenFormat: %w", err)
+	}
+	return oldValue.AccessTokenFormat, nil
+}
+
+// ResetAccessTokenFormat resets all changes to the "access_token_format" field.
+func (m *OAuth2ClientMutation) ResetAccessTokenFormat() {
+	m.access_token_format = nil
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (m *OAuth2ClientMutation) SetRequirePushedAuthorizationRequests(b bool) {
+	m.require_pushed_authorization_requests = &b
+}
+
+// RequirePushedAuthorizationRequests returns the value of the "require_pushed_authorization_requests" field in the mutation.
+func (m *OAuth2ClientMutation) RequirePushedAuthorizationRequests() (r bool, exists bool) {
+	v := m.require_pushed_authorization_requests
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequirePushedAuthorizationRequests returns the old "require_pushed_authorization_requests" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldRequirePushedAuthorizationRequests(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequirePushedAuthorizationRequests is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequirePushedAuthorizationRequests requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequirePushedAuthorizationRequests: %w", err)
+	}
+	return oldValue.RequirePushedAuthorizationRequests, nil
+}
+
+// ResetRequirePushedAuthorizationRequests resets all changes to the "require_pushed_authorization_requests" field.
+func (m *OAuth2ClientMutation) ResetRequirePushedAuthorizationRequests() {
+	m.require_pushed_authorization_requests = nil
+}
+
+// SetJwks sets the "jwks" field.
+func (m *OAuth2ClientMutation) SetJwks(s string) {
+	m.jwks = &s
+}
+
+// Jwks returns the value of the "jwks" field in the mutation.
+func (m *OAuth2ClientMutation) Jwks() (r string, exists bool) {
+	v := m.jwks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwks returns the old "jwks" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldJwks(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwks: %w", err)
+	}
+	return oldValue.Jwks, nil
+}
+
+// ResetJwks resets all changes to the "jwks" field.
+func (m *OAuth2ClientMutation) ResetJwks() {
+	m.jwks = nil
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (m *OAuth2ClientMutation) SetJwksURI(s string) {
+	m.jwks_uri = &s
+}
+
+// JwksURI returns the value of the "jwks_uri" field in the mutation.
+func (m *OAuth2ClientMutation) JwksURI() (r string, exists bool) {
+	v := m.jwks_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwksURI returns the old "jwks_uri" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldJwksURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwksURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwksURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwksURI: %w", err)
+	}
+	return oldValue.JwksURI, nil
+}
+
+// ResetJwksURI resets all changes to the "jwks_uri" field.
+func (m *OAuth2ClientMutation) ResetJwksURI() {
+	m.jwks_uri = nil
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (m *OAuth2ClientMutation) SetRegistrationAccessTokenHash(s string) {
+	m.registration_access_token_hash = &s
+}
+
+// RegistrationAccessTokenHash returns the value of the "registration_access_token_hash" field in the mutation.
+func (m *OAuth2ClientMutation) RegistrationAccessTokenHash() (r string, exists bool) {
+	v := m.registration_access_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistrationAccessTokenHash returns the old "registration_access_token_hash" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldRegistrationAccessTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistrationAccessTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistrationAccessTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistrationAccessTokenHash: %w", err)
+	}
+	return oldValue.RegistrationAccessTokenHash, nil
+}
+
+// ResetRegistrationAccessTokenHash resets all changes to the "registration_access_token_hash" field.
+func (m *OAuth2ClientMutation) ResetRegistrationAccessTokenHash() {
+	m.registration_access_token_hash = nil
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (m *OAuth2ClientMutation) SetTokenEndpointAuthMethod(s string) {
+	m.token_endpoint_auth_method = &s
+}
+
+// TokenEndpointAuthMethod returns the value of the "token_endpoint_auth_method" field in the mutation.
+func (m *OAuth2ClientMutation) TokenEndpointAuthMethod() (r string, exists bool) {
+	v := m.token_endpoint_auth_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenEndpointAuthMethod returns the old "token_endpoint_auth_method" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldTokenEndpointAuthMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenEndpointAuthMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenEndpointAuthMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenEndpointAuthMethod: %w", err)
+	}
+	return oldValue.TokenEndpointAuthMethod, nil
+}
+
+// ResetTokenEndpointAuthMethod resets all changes to the "token_endpoint_auth_method" field.
+func (m *OAuth2ClientMutation) ResetTokenEndpointAuthMethod() {
+	m.token_endpoint_auth_method = nil
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (m *OAuth2ClientMutation) SetTLSClientAuthSubjectDn(s string) {
+	m.tls_client_auth_subject_dn = &s
+}
+
+// TLSClientAuthSubjectDn returns the value of the "tls_client_auth_subject_dn" field in the mutation.
+func (m *OAuth2ClientMutation) TLSClientAuthSubjectDn() (r string, exists bool) {
+	v := m.tls_client_auth_subject_dn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSClientAuthSubjectDn returns the old "tls_client_auth_subject_dn" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldTLSClientAuthSubjectDn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSClientAuthSubjectDn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSClientAuthSubjectDn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSClientAuthSubjectDn: %w", err)
+	}
+	return oldValue.TLSClientAuthSubjectDn, nil
+}
+
+// ResetTLSClientAuthSubjectDn resets all changes to the "tls_client_auth_subject_dn" field.
+func (m *OAuth2ClientMutation) ResetTLSClientAuthSubjectDn() {
+	m.tls_client_auth_subject_dn = nil
+}
+
+// SetClaimMappings sets the "claim_mappings" field.
+func (m *OAuth2ClientMutation) SetClaimMappings(sm []storage.ClaimMapping) {
+	m.claim_mappings = &sm
+	m.appendclaim_mappings = nil
+}
+
+// ClaimMappings returns the value of the "claim_mappings" field in the mutation.
+func (m *OAuth2ClientMutation) ClaimMappings() (r []storage.ClaimMapping, exists bool) {
+	v := m.claim_mappings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimMappings returns the old "claim_mappings" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldClaimMappings(ctx context.Context) (v []storage.ClaimMapping, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimMappings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimMappings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimMappings: %w", err)
+	}
+	return oldValue.ClaimMappings, nil
+}
+
+// AppendClaimMappings adds sm to the "claim_mappings" field.
+func (m *OAuth2ClientMutation) AppendClaimMappings(sm []storage.ClaimMapping) {
+	m.appendclaim_mappings = append(m.appendclaim_mappings, sm...)
+}
+
+// AppendedClaimMappings returns the list of values that were appended to the "claim_mappings" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedClaimMappings() ([]storage.ClaimMapping, bool) {
+	if len(m.appendclaim_mappings) == 0 {
+		return nil, false
+	}
+	return m.appendclaim_mappings, true
+}
+
+// ClearClaimMappings clears the value of the "claim_mappings" field.
+func (m *OAuth2ClientMutation) ClearClaimMappings() {
+	m.claim_mappings = nil
+	m.appendclaim_mappings = nil
+	m.clearedFields[oauth2client.FieldClaimMappings] = struct{}{}
+}
+
+// ClaimMappingsCleared returns if the "claim_mappings" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) ClaimMappingsCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldClaimMappings]
+	return ok
+}
+
+// ResetClaimMappings resets all changes to the "claim_mappings" field.
+func (m *OAuth2ClientMutation) ResetClaimMappings() {
+	m.claim_mappings = nil
+	m.appendclaim_mappings = nil
+	delete(m.clearedFields, oauth2client.FieldClaimMappings)
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (m *OAuth2ClientMutation) SetSubjectType(s string) {
+	m.subject_type = &s
+}
+
+// SubjectType returns the value of the "subject_type" field in the mutation.
+func (m *OAuth2ClientMutation) SubjectType() (r string, exists bool) {
+	v := m.subject_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectType returns the old "subject_type" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldSubjectType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectType: %w", err)
+	}
+	return oldValue.SubjectType, nil
+}
+
+// ResetSubjectType resets all changes to the "subject_type" field.
+func (m *OAuth2ClientMutation) ResetSubjectType() {
+	m.subject_type = nil
+}
+
+// SetSectorIdentifierURI sets the "sector_identifier_uri" field.
+func (m *OAuth2ClientMutation) SetSectorIdentifierURI(s string) {
+	m.sector_identifier_uri = &s
+}
+
+// SectorIdentifierURI returns the value of the "sector_identifier_uri" field in the mutation.
+func (m *OAuth2ClientMutation) SectorIdentifierURI() (r string, exists bool) {
+	v := m.sector_identifier_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSectorIdentifierURI returns the old "sector_identifier_uri" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldSectorIdentifierURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSectorIdentifierURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSectorIdentifierURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSectorIdentifierURI: %w", err)
+	}
+	return oldValue.SectorIdentifierURI, nil
+}
+
+// ResetSectorIdentifierURI resets all changes to the "sector_identifier_uri" field.
+func (m *OAuth2ClientMutation) ResetSectorIdentifierURI() {
+	m.sector_identifier_uri = nil
+}
+
+// SetRequiredScopes sets the "required_scopes" field.
+func (m *OAuth2ClientMutation) SetRequiredScopes(s []string) {
+	m.required_scopes = &s
+	m.appendrequired_scopes = nil
+}
+
+// RequiredScopes returns the value of the "required_scopes" field in the mutation.
+func (m *OAuth2ClientMutation) RequiredScopes() (r []string, exists bool) {
+	v := m.required_scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiredScopes returns the old "required_scopes" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldRequiredScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiredScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiredScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiredScopes: %w", err)
+	}
+	return oldValue.RequiredScopes, nil
+}
+
+// AppendRequiredScopes adds s to the "required_scopes" field.
+func (m *OAuth2ClientMutation) AppendRequiredScopes(s []string) {
+	m.appendrequired_scopes = append(m.appendrequired_scopes, s...)
+}
+
+// AppendedRequiredScopes returns the list of values that were appended to the "required_scopes" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedRequiredScopes() ([]string, bool) {
+	if len(m.appendrequired_scopes) == 0 {
+		return nil, false
+	}
+	return m.appendrequired_scopes, true
+}
+
+// ClearRequiredScopes clears the value of the "required_scopes" field.
+func (m *OAuth2ClientMutation) ClearRequiredScopes() {
+	m.required_scopes = nil
+	m.appendrequired_scopes = nil
+	m.clearedFields[oauth2client.FieldRequiredScopes] = struct{}{}
+}
+
+// RequiredScopesCleared returns if the "required_scopes" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) RequiredScopesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldRequiredScopes]
+	return ok
+}
+
+// ResetRequiredScopes resets all changes to the "required_scopes" field.
+func (m *OAuth2ClientMutation) ResetRequiredScopes() {
+	m.required_scopes = nil
+	m.appendrequired_scopes = nil
+	delete(m.clearedFields, oauth2client.FieldRequiredScopes)
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *OAuth2ClientMutation) SetExpiry(se *storage.ClientExpiry) {
+	m.expiry = &se
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *OAuth2ClientMutation) Expiry() (r *storage.ClientExpiry, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldExpiry(ctx context.Context) (v *storage.ClientExpiry, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (m *OAuth2ClientMutation) ClearExpiry() {
+	m.expiry = nil
+	m.clearedFields[oauth2client.FieldExpiry] = struct{}{}
+}
+
+// ExpiryCleared returns if the "expiry" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) ExpiryCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldExpiry]
+	return ok
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *OAuth2ClientMutation) ResetExpiry() {
+	m.expiry = nil
+	delete(m.clearedFields, oauth2client.FieldExpiry)
+}
+
+// SetAllowedConnectors sets the "allowed_connectors" field.
+func (m *OAuth2ClientMutation) SetAllowedConnectors(s []string) {
+	m.allowed_connectors = &s
+	m.appendallowed_connectors = nil
+}
+
+// AllowedConnectors returns the value of the "allowed_connectors" field in the mutation.
+func (m *OAuth2ClientMutation) AllowedConnectors() (r []string, exists bool) {
+	v := m.allowed_connectors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedConnectors returns the old "allowed_connectors" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldAllowedConnectors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedConnectors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedConnectors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedConnectors: %w", err)
+	}
+	return oldValue.AllowedConnectors, nil
+}
 
-// Type returns the node type of this mutation (DeviceRequest).
-func (m *DeviceRequestMutation) Type() string {
+// AppendAllowedConnectors adds s to the "allowed_connectors" field.
+func (m *OAuth2ClientMutation) AppendAllowedConnectors(s []string) {
+	m.appendallowed_connectors = append(m.appendallowed_connectors, s...)
+}
+
+// AppendedAllowedConnectors returns the list of values that were appended to the "allowed_connectors" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedAllowedConnectors() ([]string, bool) {
+	if len(m.appendallowed_connectors) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_connectors, true
+}
+
+// ClearAllowedConnectors clears the value of the "allowed_connectors" field.
+func (m *OAuth2ClientMutation) ClearAllowedConnectors() {
+	m.allowed_connectors = nil
+	m.appendallowed_connectors = nil
+	m.clearedFields[oauth2client.FieldAllowedConnectors] = struct{}{}
+}
+
+// AllowedConnectorsCleared returns if the "allowed_connectors" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) AllowedConnectorsCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldAllowedConnectors]
+	return ok
+}
+
+// ResetAllowedConnectors resets all changes to the "allowed_connectors" field.
+func (m *OAuth2ClientMutation) ResetAllowedConnectors() {
+	m.allowed_connectors = nil
+	m.appendallowed_connectors = nil
+	delete(m.clearedFields, oauth2client.FieldAllowedConnectors)
+}
+
+// SetAllowedGrantTypes sets the "allowed_grant_types" field.
+func (m *OAuth2ClientMutation) SetAllowedGrantTypes(s []string) {
+	m.allowed_grant_types = &s
+	m.appendallowed_grant_types = nil
+}
+
+// AllowedGrantTypes returns the value of the "allowed_grant_types" field in the mutation.
+func (m *OAuth2ClientMutation) AllowedGrantTypes() (r []string, exists bool) {
+	v := m.allowed_grant_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedGrantTypes returns the old "allowed_grant_types" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldAllowedGrantTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedGrantTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedGrantTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedGrantTypes: %w", err)
+	}
+	return oldValue.AllowedGrantTypes, nil
+}
+
+// AppendAllowedGrantTypes adds s to the "allowed_grant_types" field.
+func (m *OAuth2ClientMutation) AppendAllowedGrantTypes(s []string) {
+	m.appendallowed_grant_types = append(m.appendallowed_grant_types, s...)
+}
+
+// AppendedAllowedGrantTypes returns the list of values that were appended to the "allowed_grant_types" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedAllowedGrantTypes() ([]string, bool) {
+	if len(m.appendallowed_grant_types) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_grant_types, true
+}
+
+// ClearAllowedGrantTypes clears the value of the "allowed_grant_types" field.
+func (m *OAuth2ClientMutation) ClearAllowedGrantTypes() {
+	m.allowed_grant_types = nil
+	m.appendallowed_grant_types = nil
+	m.clearedFields[oauth2client.FieldAllowedGrantTypes] = struct{}{}
+}
+
+// AllowedGrantTypesCleared returns if the "allowed_grant_types" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) AllowedGrantTypesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldAllowedGrantTypes]
+	return ok
+}
+
+// ResetAllowedGrantTypes resets all changes to the "allowed_grant_types" field.
+func (m *OAuth2ClientMutation) ResetAllowedGrantTypes() {
+	m.allowed_grant_types = nil
+	m.appendallowed_grant_types = nil
+	delete(m.clearedFields, oauth2client.FieldAllowedGrantTypes)
+}
+
+// SetAllowedResponseTypes sets the "allowed_response_types" field.
+func (m *OAuth2ClientMutation) SetAllowedResponseTypes(s []string) {
+	m.allowed_response_types = &s
+	m.appendallowed_response_types = nil
+}
+
+// AllowedResponseTypes returns the value of the "allowed_response_types" field in the mutation.
+func (m *OAuth2ClientMutation) AllowedResponseTypes() (r []string, exists bool) {
+	v := m.allowed_response_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedResponseTypes returns the old "allowed_response_types" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldAllowedResponseTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedResponseTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedResponseTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedResponseTypes: %w", err)
+	}
+	return oldValue.AllowedResponseTypes, nil
+}
+
+// AppendAllowedResponseTypes adds s to the "allowed_response_types" field.
+func (m *OAuth2ClientMutation) AppendAllowedResponseTypes(s []string) {
+	m.appendallowed_response_types = append(m.appendallowed_response_types, s...)
+}
+
+// AppendedAllowedResponseTypes returns the list of values that were appended to the "allowed_response_types" field in this mutation.
+func (m *OAuth2ClientMutation) AppendedAllowedResponseTypes() ([]string, bool) {
+	if len(m.appendallowed_response_types) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_response_types, true
+}
+
+// ClearAllowedResponseTypes clears the value of the "allowed_response_types" field.
+func (m *OAuth2ClientMutation) ClearAllowedResponseTypes() {
+	m.allowed_response_types = nil
+	m.appendallowed_response_types = nil
+	m.clearedFields[oauth2client.FieldAllowedResponseTypes] = struct{}{}
+}
+
+// AllowedResponseTypesCleared returns if the "allowed_response_types" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) AllowedResponseTypesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldAllowedResponseTypes]
+	return ok
+}
+
+// ResetAllowedResponseTypes resets all changes to the "allowed_response_types" field.
+func (m *OAuth2ClientMutation) ResetAllowedResponseTypes() {
+	m.allowed_response_types = nil
+	m.appendallowed_response_types = nil
+	delete(m.clearedFields, oauth2client.FieldAllowedResponseTypes)
+}
+
+// SetRequirePkce sets the "require_pkce" field.
+func (m *OAuth2ClientMutation) SetRequirePkce(b bool) {
+	m.require_pkce = &b
+}
+
+// RequirePkce returns the value of the "require_pkce" field in the mutation.
+func (m *OAuth2ClientMutation) RequirePkce() (r bool, exists bool) {
+	v := m.require_pkce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequirePkce returns the old "require_pkce" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldRequirePkce(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequirePkce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequirePkce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequirePkce: %w", err)
+	}
+	return oldValue.RequirePkce, nil
+}
+
+// ResetRequirePkce resets all changes to the "require_pkce" field.
+func (m *OAuth2ClientMutation) ResetRequirePkce() {
+	m.require_pkce = nil
+}
+
+// SetPublic sets the "public" field.
+func (m *OAuth2ClientMutation) SetPublic(b bool) {
+	m.public = &b
+}
+
+// Public returns the value of the "public" field in the mutation.
+func (m *OAuth2ClientMutation) Public() (r bool, exists bool) {
+	v := m.public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublic returns the old "public" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+	}
+	return oldValue.Public, nil
+}
+
+// ResetPublic resets all changes to the "public" field.
+func (m *OAuth2ClientMutation) ResetPublic() {
+	m.public = nil
+}
+
+// SetName sets the "name" field.
+func (m *OAuth2ClientMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OAuth2ClientMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OAuth2ClientMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (m *OAuth2ClientMutation) SetLogoURL(s string) {
+	m.logo_url = &s
+}
+
+// LogoURL returns the value of the "logo_url" field in the mutation.
+func (m *OAuth2ClientMutation) LogoURL() (r string, exists bool) {
+	v := m.logo_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoURL returns the old "logo_url" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldLogoURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+	}
+	return oldValue.LogoURL, nil
+}
+
+// ResetLogoURL resets all changes to the "logo_url" field.
+func (m *OAuth2ClientMutation) ResetLogoURL() {
+	m.logo_url = nil
+}
+
+// Where appends a list predicates to the OAuth2ClientMutation builder.
+func (m *OAuth2ClientMutation) Where(ps ...predicate.OAuth2Client) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OAuth2ClientMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OAuth2ClientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OAuth2Client, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OAuth2ClientMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OAuth2ClientMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OAuth2Client).
+func (m *OAuth2ClientMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceRequestMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.user_code != nil {
-		fields = append(fields, devicerequest.FieldUserCode)
+func (m *OAuth2ClientMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.secret != nil {
+		fields = append(fields, oauth2client.FieldSecret)
+	}
+	if m.redirect_uris != nil {
+		fields = append(fields, oauth2client.FieldRedirectUris)
+	}
+	if m.trusted_peers != nil {
+		fields = append(fields, oauth2client.FieldTrustedPeers)
+	}
+	if m.post_logout_redirect_uris != nil {
+		fields = append(fields, oauth2client.FieldPostLogoutRedirectUris)
+	}
+	if m.backchannel_logout_uri != nil {
+		fields = append(fields, oauth2client.FieldBackchannelLogoutURI)
+	}
+	if m.client_credentials_scopes != nil {
+		fields = append(fields, oauth2client.FieldClientCredentialsScopes)
+	}
+	if m.client_credentials_audiences != nil {
+		fields = append(fields, oauth2client.FieldClientCredentialsAudiences)
 	}
-	if m.device_code != nil {
-		fields = append(fields, devicerequest.FieldDeviceCode)
+	if m.access_token_format != nil {
+		fields = append(fields, oauth2client.FieldAccessTokenFormat)
 	}
-	if m.client_id != nil {
-		fields = append(fields, devicerequest.FieldClientID)
+	if m.require_pushed_authorization_requests != nil {
+		fields = append(fields, oauth2client.FieldRequirePushedAuthorizationRequests)
 	}
-	if m.client_secret != nil {
-		fields = append(fields, devicerequest.FieldClientSecret)
+	if m.jwks != nil {
+		fields = append(fields, oauth2client.FieldJwks)
 	}
-	if m.scopes != nil {
-		fields = append(fields, devicerequest.FieldScopes)
+	if m.jwks_uri != nil {
+		fields = append(fields, oauth2client.FieldJwksURI)
+	}
+	if m.registration_access_token_hash != nil {
+		fields = append(fields, oauth2client.FieldRegistrationAccessTokenHash)
+	}
+	if m.token_endpoint_auth_method != nil {
+		fields = append(fields, oauth2client.FieldTokenEndpointAuthMethod)
+	}
+	if m.tls_client_auth_subject_dn != nil {
+		fields = append(fields, oauth2client.FieldTLSClientAuthSubjectDn)
+	}
+	if m.claim_mappings != nil {
+		fields = append(fields, oauth2client.FieldClaimMappings)
+	}
+	if m.subject_type != nil {
+		fields = append(fields, oauth2client.FieldSubjectType)
+	}
+	if m.sector_identifier_uri != nil {
+		fields = append(fields, oauth2client.FieldSectorIdentifierURI)
+	}
+	if m.required_scopes != nil {
+		fields = append(fields, oauth2client.FieldRequiredScopes)
 	}
 	if m.expiry != nil {
-		fields = append(fields, devicerequest.FieldExpiry)
+		fields = append(fields, oauth2client.FieldExpiry)
+	}
+	if m.allowed_connectors != nil {
+		fields = append(fields, oauth2client.FieldAllowedConnectors)
+	}
+	if m.allowed_grant_types != nil {
+		fields = append(fields, oauth2client.FieldAllowedGrantTypes)
+	}
+	if m.allowed_response_types != nil {
+		fields = append(fields, oauth2client.FieldAllowedResponseTypes)
+	}
+	if m.require_pkce != nil {
+		fields = append(fields, oauth2client.FieldRequirePkce)
+	}
+	if m.public != nil {
+		fields = append(fields, oauth2client.FieldPublic)
+	}
+	if m.name != nil {
+		fields = append(fields, oauth2client.FieldName)
+	}
+	if m.logo_url != nil {
+		fields = append(fields, oauth2client.FieldLogoURL)
 	}
 	return fields
 }
@@ -3632,20 +11419,60 @@ func (m *DeviceRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *OAuth2ClientMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case devicerequest.FieldUserCode:
-		return m.UserCode()
-	case devicerequest.FieldDeviceCode:
-		return m.DeviceCode()
-	case devicerequest.FieldClientID:
-		return m.ClientID()
-	case devicerequest.FieldClientSecret:
-		return m.ClientSecret()
-	case devicerequest.FieldScopes:
-		return m.Scopes()
-	case devicerequest.FieldExpiry:
+	case oauth2client.FieldSecret:
+		return m.Secret()
+	case oauth2client.FieldRedirectUris:
+		return m.RedirectUris()
+	case oauth2client.FieldTrustedPeers:
+		return m.TrustedPeers()
+	case oauth2client.FieldPostLogoutRedirectUris:
+		return m.PostLogoutRedirectUris()
+	case oauth2client.FieldBackchannelLogoutURI:
+		return m.BackchannelLogoutURI()
+	case oauth2client.FieldClientCredentialsScopes:
+		return m.ClientCredentialsScopes()
+	case oauth2client.FieldClientCredentialsAudiences:
+		return m.ClientCredentialsAudiences()
+	case oauth2client.FieldAccessTokenFormat:
+		return m.AccessTokenFormat()
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		return m.RequirePushedAuthorizationRequests()
+	case oauth2client.FieldJwks:
+		return m.Jwks()
+	case oauth2client.FieldJwksURI:
+		return m.JwksURI()
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		return m.RegistrationAccessTokenHash()
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		return m.TokenEndpointAuthMethod()
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		return m.TLSClientAuthSubjectDn()
+	case oauth2client.FieldClaimMappings:
+		return m.ClaimMappings()
+	case oauth2client.FieldSubjectType:
+		return m.SubjectType()
+	case oauth2client.FieldSectorIdentifierURI:
+		return m.SectorIdentifierURI()
+	case oauth2client.FieldRequiredScopes:
+		return m.RequiredScopes()
+	case oauth2client.FieldExpiry:
 		return m.Expiry()
+	case oauth2client.FieldAllowedConnectors:
+		return m.AllowedConnectors()
+	case oauth2client.FieldAllowedGrantTypes:
+		return m.AllowedGrantTypes()
+	case oauth2client.FieldAllowedResponseTypes:
+		return m.AllowedResponseTypes()
+	case oauth2client.FieldRequirePkce:
+		return m.RequirePkce()
+	case oauth2client.FieldPublic:
+		return m.Public()
+	case oauth2client.FieldName:
+		return m.Name()
+	case oauth2client.FieldLogoURL:
+		return m.LogoURL()
 	}
 	return nil, false
 }
@@ -3653,648 +11480,835 @@ func (m *DeviceRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OAuth2ClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case devicerequest.FieldUserCode:
-		return m.OldUserCode(ctx)
-	case devicerequest.FieldDeviceCode:
-		return m.OldDeviceCode(ctx)
-	case devicerequest.FieldClientID:
-		return m.OldClientID(ctx)
-	case devicerequest.FieldClientSecret:
-		return m.OldClientSecret(ctx)
-	case devicerequest.FieldScopes:
-		return m.OldScopes(ctx)
-	case devicerequest.FieldExpiry:
+	case oauth2client.FieldSecret:
+		return m.OldSecret(ctx)
+	case oauth2client.FieldRedirectUris:
+		return m.OldRedirectUris(ctx)
+	case oauth2client.FieldTrustedPeers:
+		return m.OldTrustedPeers(ctx)
+	case oauth2client.FieldPostLogoutRedirectUris:
+		return m.OldPostLogoutRedirectUris(ctx)
+	case oauth2client.FieldBackchannelLogoutURI:
+		return m.OldBackchannelLogoutURI(ctx)
+	case oauth2client.FieldClientCredentialsScopes:
+		return m.OldClientCredentialsScopes(ctx)
+	case oauth2client.FieldClientCredentialsAudiences:
+		return m.OldClientCredentialsAudiences(ctx)
+	case oauth2client.FieldAccessTokenFormat:
+		return m.OldAccessTokenFormat(ctx)
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		return m.OldRequirePushedAuthorizationRequests(ctx)
+	case oauth2client.FieldJwks:
+		return m.OldJwks(ctx)
+	case oauth2client.FieldJwksURI:
+		return m.OldJwksURI(ctx)
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		return m.OldRegistrationAccessTokenHash(ctx)
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		return m.OldTokenEndpointAuthMethod(ctx)
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		return m.OldTLSClientAuthSubjectDn(ctx)
+	case oauth2client.FieldClaimMappings:
+		return m.OldClaimMappings(ctx)
+	case oauth2client.FieldSubjectType:
+		return m.OldSubjectType(ctx)
+	case oauth2client.FieldSectorIdentifierURI:
+		return m.OldSectorIdentifierURI(ctx)
+	case oauth2client.FieldRequiredScopes:
+		return m.OldRequiredScopes(ctx)
+	case oauth2client.FieldExpiry:
 		return m.OldExpiry(ctx)
+	case oauth2client.FieldAllowedConnectors:
+		return m.OldAllowedConnectors(ctx)
+	case oauth2client.FieldAllowedGrantTypes:
+		return m.OldAllowedGrantTypes(ctx)
+	case oauth2client.FieldAllowedResponseTypes:
+		return m.OldAllowedResponseTypes(ctx)
+	case oauth2client.FieldRequirePkce:
+		return m.OldRequirePkce(ctx)
+	case oauth2client.FieldPublic:
+		return m.OldPublic(ctx)
+	case oauth2client.FieldName:
+		return m.OldName(ctx)
+	case oauth2client.FieldLogoURL:
+		return m.OldLogoURL(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeviceRequest field %s", name)
+	return nil, fmt.Errorf("unknown OAuth2Client field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceRequestMutation) SetField(name string, value ent.Value) error {
+func (m *OAuth2ClientMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case devicerequest.FieldUserCode:
+	case oauth2client.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserCode(v)
+		m.SetSecret(v)
 		return nil
-	case devicerequest.FieldDeviceCode:
+	case oauth2client.FieldRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectUris(v)
+		return nil
+	case oauth2client.FieldTrustedPeers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrustedPeers(v)
+		return nil
+	case oauth2client.FieldPostLogoutRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostLogoutRedirectUris(v)
+		return nil
+	case oauth2client.FieldBackchannelLogoutURI:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceCode(v)
+		m.SetBackchannelLogoutURI(v)
 		return nil
-	case devicerequest.FieldClientID:
+	case oauth2client.FieldClientCredentialsScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientCredentialsScopes(v)
+		return nil
+	case oauth2client.FieldClientCredentialsAudiences:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientCredentialsAudiences(v)
+		return nil
+	case oauth2client.FieldAccessTokenFormat:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetAccessTokenFormat(v)
 		return nil
-	case devicerequest.FieldClientSecret:
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequirePushedAuthorizationRequests(v)
+		return nil
+	case oauth2client.FieldJwks:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientSecret(v)
+		m.SetJwks(v)
 		return nil
-	case devicerequest.FieldScopes:
+	case oauth2client.FieldJwksURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJwksURI(v)
+		return nil
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistrationAccessTokenHash(v)
+		return nil
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenEndpointAuthMethod(v)
+		return nil
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSClientAuthSubjectDn(v)
+		return nil
+	case oauth2client.FieldClaimMappings:
+		v, ok := value.([]storage.ClaimMapping)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimMappings(v)
+		return nil
+	case oauth2client.FieldSubjectType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectType(v)
+		return nil
+	case oauth2client.FieldSectorIdentifierURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSectorIdentifierURI(v)
+		return nil
+	case oauth2client.FieldRequiredScopes:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScopes(v)
+		m.SetRequiredScopes(v)
 		return nil
-	case devicerequest.FieldExpiry:
-		v, ok := value.(time.Time)
+	case oauth2client.FieldExpiry:
+		v, ok := value.(*storage.ClientExpiry)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExpiry(v)
 		return nil
+	case oauth2client.FieldAllowedConnectors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedConnectors(v)
+		return nil
+	case oauth2client.FieldAllowedGrantTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedGrantTypes(v)
+		return nil
+	case oauth2client.FieldAllowedResponseTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedResponseTypes(v)
+		return nil
+	case oauth2client.FieldRequirePkce:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequirePkce(v)
+		return nil
+	case oauth2client.FieldPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublic(v)
+		return nil
+	case oauth2client.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case oauth2client.FieldLogoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURL(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DeviceRequest field %s", name)
+	return fmt.Errorf("unknown OAuth2Client field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceRequestMutation) AddedFields() []string {
+func (m *OAuth2ClientMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OAuth2ClientMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceRequestMutation) AddField(name string, value ent.Value) error {
+func (m *OAuth2ClientMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DeviceRequest numeric field %s", name)
+	return fmt.Errorf("unknown OAuth2Client numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceRequestMutation) ClearedFields() []string {
+func (m *OAuth2ClientMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(devicerequest.FieldScopes) {
-		fields = append(fields, devicerequest.FieldScopes)
+	if m.FieldCleared(oauth2client.FieldRedirectUris) {
+		fields = append(fields, oauth2client.FieldRedirectUris)
+	}
+	if m.FieldCleared(oauth2client.FieldTrustedPeers) {
+		fields = append(fields, oauth2client.FieldTrustedPeers)
+	}
+	if m.FieldCleared(oauth2client.FieldPostLogoutRedirectUris) {
+		fields = append(fields, oauth2client.FieldPostLogoutRedirectUris)
+	}
+	if m.FieldCleared(oauth2client.FieldClientCredentialsScopes) {
+		fields = append(fields, oauth2client.FieldClientCredentialsScopes)
+	}
+	if m.FieldCleared(oauth2client.FieldClientCredentialsAudiences) {
+		fields = append(fields, oauth2client.FieldClientCredentialsAudiences)
+	}
+	if m.FieldCleared(oauth2client.FieldClaimMappings) {
+		fields = append(fields, oauth2client.FieldClaimMappings)
+	}
+	if m.FieldCleared(oauth2client.FieldRequiredScopes) {
+		fields = append(fields, oauth2client.FieldRequiredScopes)
+	}
+	if m.FieldCleared(oauth2client.FieldExpiry) {
+		fields = append(fields, oauth2client.FieldExpiry)
+	}
+	if m.FieldCleared(oauth2client.FieldAllowedConnectors) {
+		fields = append(fields, oauth2client.FieldAllowedConnectors)
+	}
+	if m.FieldCleared(oauth2client.FieldAllowedGrantTypes) {
+		fields = append(fields, oauth2client.FieldAllowedGrantTypes)
+	}
+	if m.FieldCleared(oauth2client.FieldAllowedResponseTypes) {
+		fields = append(fields, oauth2client.FieldAllowedResponseTypes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceRequestMutation) FieldCleared(name string) bool {
+func (m *OAuth2ClientMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceRequestMutation) ClearField(name string) error {
+func (m *OAuth2ClientMutation) ClearField(name string) error {
 	switch name {
-	case devicerequest.FieldScopes:
-		m.ClearScopes()
+	case oauth2client.FieldRedirectUris:
+		m.ClearRedirectUris()
+		return nil
+	case oauth2client.FieldTrustedPeers:
+		m.ClearTrustedPeers()
+		return nil
+	case oauth2client.FieldPostLogoutRedirectUris:
+		m.ClearPostLogoutRedirectUris()
+		return nil
+	case oauth2client.FieldClientCredentialsScopes:
+		m.ClearClientCredentialsScopes()
+		return nil
+	case oauth2client.FieldClientCredentialsAudiences:
+		m.ClearClientCredentialsAudiences()
+		return nil
+	case oauth2client.FieldClaimMappings:
+		m.ClearClaimMappings()
+		return nil
+	case oauth2client.FieldRequiredScopes:
+		m.ClearRequiredScopes()
+		return nil
+	case oauth2client.FieldExpiry:
+		m.ClearExpiry()
+		return nil
+	case oauth2client.FieldAllowedConnectors:
+		m.ClearAllowedConnectors()
+		return nil
+	case oauth2client.FieldAllowedGrantTypes:
+		m.ClearAllowedGrantTypes()
+		return nil
+	case oauth2client.FieldAllowedResponseTypes:
+		m.ClearAllowedResponseTypes()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceRequest nullable field %s", name)
+	return fmt.Errorf("unknown OAuth2Client nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceRequestMutation) ResetField(name string) error {
+func (m *OAuth2ClientMutation) ResetField(name string) error {
 	switch name {
-	case devicerequest.FieldUserCode:
-		m.ResetUserCode()
+	case oauth2client.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case devicerequest.FieldDeviceCode:
-		m.ResetDeviceCode()
+	case oauth2client.FieldRedirectUris:
+		m.ResetRedirectUris()
 		return nil
-	case devicerequest.FieldClientID:
-		m.ResetClientID()
+	case oauth2client.FieldTrustedPeers:
+		m.ResetTrustedPeers()
 		return nil
-	case devicerequest.FieldClientSecret:
-		m.ResetClientSecret()
+	case oauth2client.FieldPostLogoutRedirectUris:
+		m.ResetPostLogoutRedirectUris()
 		return nil
-	case devicerequest.FieldScopes:
-		m.ResetScopes()
+	case oauth2client.FieldBackchannelLogoutURI:
+		m.ResetBackchannelLogoutURI()
 		return nil
-	case devicerequest.FieldExpiry:
+	case oauth2client.FieldClientCredentialsScopes:
+		m.ResetClientCredentialsScopes()
+		return nil
+	case oauth2client.FieldClientCredentialsAudiences:
+		m.ResetClientCredentialsAudiences()
+		return nil
+	case oauth2client.FieldAccessTokenFormat:
+		m.ResetAccessTokenFormat()
+		return nil
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		m.ResetRequirePushedAuthorizationRequests()
+		return nil
+	case oauth2client.FieldJwks:
+		m.ResetJwks()
+		return nil
+	case oauth2client.FieldJwksURI:
+		m.ResetJwksURI()
+		return nil
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		m.ResetRegistrationAccessTokenHash()
+		return nil
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		m.ResetTokenEndpointAuthMethod()
+		return nil
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		m.ResetTLSClientAuthSubjectDn()
+		return nil
+	case oauth2client.FieldClaimMappings:
+		m.ResetClaimMappings()
+		return nil
+	case oauth2client.FieldSubjectType:
+		m.ResetSubjectType()
+		return nil
+	case oauth2client.FieldSectorIdentifierURI:
+		m.ResetSectorIdentifierURI()
+		return nil
+	case oauth2client.FieldRequiredScopes:
+		m.ResetRequiredScopes()
+		return nil
+	case oauth2client.FieldExpiry:
 		m.ResetExpiry()
 		return nil
+	case oauth2client.FieldAllowedConnectors:
+		m.ResetAllowedConnectors()
+		return nil
+	case oauth2client.FieldAllowedGrantTypes:
+		m.ResetAllowedGrantTypes()
+		return nil
+	case oauth2client.FieldAllowedResponseTypes:
+		m.ResetAllowedResponseTypes()
+		return nil
+	case oauth2client.FieldRequirePkce:
+		m.ResetRequirePkce()
+		return nil
+	case oauth2client.FieldPublic:
+		m.ResetPublic()
+		return nil
+	case oauth2client.FieldName:
+		m.ResetName()
+		return nil
+	case oauth2client.FieldLogoURL:
+		m.ResetLogoURL()
+		return nil
 	}
-	return fmt.Errorf("unknown DeviceRequest field %s", name)
+	return fmt.Errorf("unknown OAuth2Client field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceRequestMutation) AddedEdges() []string {
+func (m *OAuth2ClientMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *OAuth2ClientMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceRequestMutation) RemovedEdges() []string {
+func (m *OAuth2ClientMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *OAuth2ClientMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceRequestMutation) ClearedEdges() []string {
+func (m *OAuth2ClientMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceRequestMutation) EdgeCleared(name string) bool {
+func (m *OAuth2ClientMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceRequestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DeviceRequest unique edge %s", name)
+func (m *OAuth2ClientMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OAuth2Client unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceRequestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DeviceRequest edge %s", name)
+func (m *OAuth2ClientMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OAuth2Client edge %s", name)
 }
 
-// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
-type DeviceTokenMutation struct {
+// OfflineSessionMutation represents an operation that mutates the OfflineSession nodes in the graph.
+type OfflineSessionMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	device_code           *string
-	status                *string
-	token                 *[]byte
-	expiry                *time.Time
-	last_request          *time.Time
-	poll_interval         *int
-	addpoll_interval      *int
-	code_challenge        *string
-	code_challenge_method *string
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*DeviceToken, error)
-	predicates            []predicate.DeviceToken
-}
-
-var _ ent.Mutation = (*DeviceTokenMutation)(nil)
-
-// devicetokenOption allows management of the mutation configuration using functional options.
-type devicetokenOption func(*DeviceTokenMutation)
-
-// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
-func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
-	m := &DeviceTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDeviceToken,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withDeviceTokenID sets the ID field of the mutation.
-func withDeviceTokenID(id int) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *DeviceToken
-		)
-		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().DeviceToken.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withDeviceToken sets the old DeviceToken of the mutation.
-func withDeviceToken(node *DeviceToken) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
-		m.oldValue = func(context.Context) (*DeviceToken, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceTokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DeviceTokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("db: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DeviceTokenMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetDeviceCode sets the "device_code" field.
-func (m *DeviceTokenMutation) SetDeviceCode(s string) {
-	m.device_code = &s
-}
-
-// DeviceCode returns the value of the "device_code" field in the mutation.
-func (m *DeviceTokenMutation) DeviceCode() (r string, exists bool) {
-	v := m.device_code
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeviceCode returns the old "device_code" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
-	}
-	return oldValue.DeviceCode, nil
-}
-
-// ResetDeviceCode resets all changes to the "device_code" field.
-func (m *DeviceTokenMutation) ResetDeviceCode() {
-	m.device_code = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *DeviceTokenMutation) SetStatus(s string) {
-	m.status = &s
+	op             Op
+	typ            string
+	id             *string
+	user_id        *string
+	conn_id        *string
+	refresh        *[]byte
+	connector_data *[]byte
+	extra_claims   *map[string]interface{}
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*OfflineSession, error)
+	predicates     []predicate.OfflineSession
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DeviceTokenMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*OfflineSessionMutation)(nil)
 
-// OldStatus returns the old "status" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+// offlinesessionOption allows management of the mutation configuration using functional options.
+type offlinesessionOption func(*OfflineSessionMutation)
+
+// newOfflineSessionMutation creates new mutation for the OfflineSession entity.
+func newOfflineSessionMutation(c config, op Op, opts ...offlinesessionOption) *OfflineSessionMutation {
+	m := &OfflineSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOfflineSession,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Status, nil
+	return m
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DeviceTokenMutation) ResetStatus() {
-	m.status = nil
+// withOfflineSessionID sets the ID field of the mutation.
+func withOfflineSessionID(id string) offlinesessionOption {
+	return func(m *OfflineSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OfflineSession
+		)
+		m.oldValue = func(ctx context.Context) (*OfflineSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OfflineSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetToken sets the "token" field.
-func (m *DeviceTokenMutation) SetToken(b []byte) {
-	m.token = &b
+// withOfflineSession sets the old OfflineSession of the mutation.
+func withOfflineSession(node *OfflineSession) offlinesessionOption {
+	return func(m *OfflineSessionMutation) {
+		m.oldValue = func(context.Context) (*OfflineSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *DeviceTokenMutation) Token() (r []byte, exists bool) {
-	v := m.token
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OfflineSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldToken returns the old "token" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldToken(ctx context.Context) (v *[]byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OfflineSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
 	}
-	return oldValue.Token, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearToken clears the value of the "token" field.
-func (m *DeviceTokenMutation) ClearToken() {
-	m.token = nil
-	m.clearedFields[devicetoken.FieldToken] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OfflineSession entities.
+func (m *OfflineSessionMutation) SetID(id string) {
+	m.id = &id
 }
 
-// TokenCleared returns if the "token" field was cleared in this mutation.
-func (m *DeviceTokenMutation) TokenCleared() bool {
-	_, ok := m.clearedFields[devicetoken.FieldToken]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OfflineSessionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *DeviceTokenMutation) ResetToken() {
-	m.token = nil
-	delete(m.clearedFields, devicetoken.FieldToken)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OfflineSessionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OfflineSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetExpiry sets the "expiry" field.
-func (m *DeviceTokenMutation) SetExpiry(t time.Time) {
-	m.expiry = &t
+// SetUserID sets the "user_id" field.
+func (m *OfflineSessionMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// Expiry returns the value of the "expiry" field in the mutation.
-func (m *DeviceTokenMutation) Expiry() (r time.Time, exists bool) {
-	v := m.expiry
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OfflineSessionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+func (m *OfflineSessionMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiry requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Expiry, nil
+	return oldValue.UserID, nil
 }
 
-// ResetExpiry resets all changes to the "expiry" field.
-func (m *DeviceTokenMutation) ResetExpiry() {
-	m.expiry = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OfflineSessionMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetLastRequest sets the "last_request" field.
-func (m *DeviceTokenMutation) SetLastRequest(t time.Time) {
-	m.last_request = &t
+// SetConnID sets the "conn_id" field.
+func (m *OfflineSessionMutation) SetConnID(s string) {
+	m.conn_id = &s
 }
 
-// LastRequest returns the value of the "last_request" field in the mutation.
-func (m *DeviceTokenMutation) LastRequest() (r time.Time, exists bool) {
-	v := m.last_request
+// ConnID returns the value of the "conn_id" field in the mutation.
+func (m *OfflineSessionMutation) ConnID() (r string, exists bool) {
+	v := m.conn_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastRequest returns the old "last_request" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldConnID returns the old "conn_id" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldLastRequest(ctx context.Context) (v time.Time, err error) {
+func (m *OfflineSessionMutation) OldConnID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastRequest is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastRequest requires an ID field in the mutation")
+		return v, errors.New("OldConnID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastRequest: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnID: %w", err)
 	}
-	return oldValue.LastRequest, nil
+	return oldValue.ConnID, nil
 }
 
-// ResetLastRequest resets all changes to the "last_request" field.
-func (m *DeviceTokenMutation) ResetLastRequest() {
-	m.last_request = nil
+// ResetConnID resets all changes to the "conn_id" field.
+func (m *OfflineSessionMutation) ResetConnID() {
+	m.conn_id = nil
 }
 
-// SetPollInterval sets the "poll_interval" field.
-func (m *DeviceTokenMutation) SetPollInterval(i int) {
-	m.poll_interval = &i
-	m.addpoll_interval = nil
+// SetRefresh sets the "refresh" field.
+func (m *OfflineSessionMutation) SetRefresh(b []byte) {
+	m.refresh = &b
 }
 
-// PollInterval returns the value of the "poll_interval" field in the mutation.
-func (m *DeviceTokenMutation) PollInterval() (r int, exists bool) {
-	v := m.poll_interval
+// Refresh returns the value of the "refresh" field in the mutation.
+func (m *OfflineSessionMutation) Refresh() (r []byte, exists bool) {
+	v := m.refresh
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPollInterval returns the old "poll_interval" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldRefresh returns the old "refresh" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldPollInterval(ctx context.Context) (v int, err error) {
+func (m *OfflineSessionMutation) OldRefresh(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPollInterval is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefresh is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPollInterval requires an ID field in the mutation")
+		return v, errors.New("OldRefresh requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPollInterval: %w", err)
-	}
-	return oldValue.PollInterval, nil
-}
-
-// AddPollInterval adds i to the "poll_interval" field.
-func (m *DeviceTokenMutation) AddPollInterval(i int) {
-	if m.addpoll_interval != nil {
-		*m.addpoll_interval += i
-	} else {
-		m.addpoll_interval = &i
-	}
-}
-
-// AddedPollInterval returns the value that was added to the "poll_interval" field in this mutation.
-func (m *DeviceTokenMutation) AddedPollInterval() (r int, exists bool) {
-	v := m.addpoll_interval
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldRefresh: %w", err)
 	}
-	return *v, true
+	return oldValue.Refresh, nil
 }
 
-// ResetPollInterval resets all changes to the "poll_interval" field.
-func (m *DeviceTokenMutation) ResetPollInterval() {
-	m.poll_interval = nil
-	m.addpoll_interval = nil
+// ResetRefresh resets all changes to the "refresh" field.
+func (m *OfflineSessionMutation) ResetRefresh() {
+	m.refresh = nil
 }
 
-// SetCodeChallenge sets the "code_challenge" field.
-func (m *DeviceTokenMutation) SetCodeChallenge(s string) {
-	m.code_challenge = &s
+// SetConnectorData sets the "connector_data" field.
+func (m *OfflineSessionMutation) SetConnectorData(b []byte) {
+	m.connector_data = &b
 }
 
-// CodeChallenge returns the value of the "code_challenge" field in the mutation.
-func (m *DeviceTokenMutation) CodeChallenge() (r string, exists bool) {
-	v := m.code_challenge
+// ConnectorData returns the value of the "connector_data" field in the mutation.
+func (m *OfflineSessionMutation) ConnectorData() (r []byte, exists bool) {
+	v := m.connector_data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldCodeChallenge returns the old "code_challenge" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+
+// OldConnectorData returns the old "connector_data" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldCodeChallenge(ctx context.Context) (v string, err error) {
+func (m *OfflineSessionMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCodeChallenge is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCodeChallenge requires an ID field in the mutation")
+		return v, errors.New("OldConnectorData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCodeChallenge: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
 	}
-	return oldValue.CodeChallenge, nil
+	return oldValue.ConnectorData, nil
 }
 
-// ResetCodeChallenge resets all changes to the "code_challenge" field.
-func (m *DeviceTokenMutation) ResetCodeChallenge() {
-	m.code_challenge = nil
+// ClearConnectorData clears the value of the "connector_data" field.
+func (m *OfflineSessionMutation) ClearConnectorData() {
+	m.connector_data = nil
+	m.clearedFields[offlinesession.FieldConnectorData] = struct{}{}
 }
 
-// SetCodeChallengeMethod sets the "code_challenge_method" field.
-func (m *DeviceTokenMutation) SetCodeChallengeMethod(s string) {
-	m.code_challenge_method = &s
+// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
+func (m *OfflineSessionMutation) ConnectorDataCleared() bool {
+	_, ok := m.clearedFields[offlinesession.FieldConnectorData]
+	return ok
 }
 
-// CodeChallengeMethod returns the value of the "code_challenge_method" field in the mutation.
-func (m *DeviceTokenMutation) CodeChallengeMethod() (r string, exists bool) {
-	v := m.code_challenge_method
+// ResetConnectorData resets all changes to the "connector_data" field.
+func (m *OfflineSessionMutation) ResetConnectorData() {
+	m.connector_data = nil
+	delete(m.clearedFields, offlinesession.FieldConnectorData)
+}
+
+// SetExtraClaims sets the "extra_claims" field.
+func (m *OfflineSessionMutation) SetExtraClaims(value map[string]interface{}) {
+	m.extra_claims = &value
+}
+
+// ExtraClaims returns the value of the "extra_claims" field in the mutation.
+func (m *OfflineSessionMutation) ExtraClaims() (r map[string]interface{}, exists bool) {
+	v := m.extra_claims
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCodeChallengeMethod returns the old "code_challenge_method" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldExtraClaims returns the old "extra_claims" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldCodeChallengeMethod(ctx context.Context) (v string, err error) {
+func (m *OfflineSessionMutation) OldExtraClaims(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCodeChallengeMethod is only allowed on UpdateOne operations")
+		return v, errors.New("OldExtraClaims is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCodeChallengeMethod requires an ID field in the mutation")
+		return v, errors.New("OldExtraClaims requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCodeChallengeMethod: %w", err)
+		return v, fmt.Errorf("querying old value for OldExtraClaims: %w", err)
 	}
-	return oldValue.CodeChallengeMethod, nil
+	return oldValue.ExtraClaims, nil
 }
 
-// ResetCodeChallengeMethod resets all changes to the "code_challenge_method" field.
-func (m *DeviceTokenMutation) ResetCodeChallengeMethod() {
-	m.code_challenge_method = nil
+// ClearExtraClaims clears the value of the "extra_claims" field.
+func (m *OfflineSessionMutation) ClearExtraClaims() {
+	m.extra_claims = nil
+	m.clearedFields[offlinesession.FieldExtraClaims] = struct{}{}
 }
 
-// Where appends a list predicates to the DeviceTokenMutation builder.
-func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
+// ExtraClaimsCleared returns if the "extra_claims" field was cleared in this mutation.
+func (m *OfflineSessionMutation) ExtraClaimsCleared() bool {
+	_, ok := m.clearedFields[offlinesession.FieldExtraClaims]
+	return ok
+}
+
+// ResetExtraClaims resets all changes to the "extra_claims" field.
+func (m *OfflineSessionMutation) ResetExtraClaims() {
+	m.extra_claims = nil
+	delete(m.clearedFields, offlinesession.FieldExtraClaims)
+}
+
+// Where appends a list predicates to the OfflineSessionMutation builder.
+func (m *OfflineSessionMutation) Where(ps ...predicate.OfflineSession) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeviceTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OfflineSessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeviceToken, len(ps))
+func (m *OfflineSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OfflineSession, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4302,48 +12316,39 @@ func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeviceTokenMutation) Op() Op {
+func (m *OfflineSessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeviceTokenMutation) SetOp(op Op) {
+func (m *OfflineSessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeviceToken).
-func (m *DeviceTokenMutation) Type() string {
+// Type returns the node type of this mutation (OfflineSession).
+func (m *OfflineSessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceTokenMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.device_code != nil {
-		fields = append(fields, devicetoken.FieldDeviceCode)
-	}
-	if m.status != nil {
-		fields = append(fields, devicetoken.FieldStatus)
-	}
-	if m.token != nil {
-		fields = append(fields, devicetoken.FieldToken)
-	}
-	if m.expiry != nil {
-		fields = append(fields, devicetoken.FieldExpiry)
+func (m *OfflineSessionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, offlinesession.FieldUserID)
 	}
-	if m.last_request != nil {
-		fields = append(fields, devicetoken.FieldLastRequest)
+	if m.conn_id != nil {
+		fields = append(fields, offlinesession.FieldConnID)
 	}
-	if m.poll_interval != nil {
-		fields = append(fields, devicetoken.FieldPollInterval)
+	if m.refresh != nil {
+		fields = append(fields, offlinesession.FieldRefresh)
 	}
-	if m.code_challenge != nil {
-		fields = append(fields, devicetoken.FieldCodeChallenge)
+	if m.connector_data != nil {
+		fields = append(fields, offlinesession.FieldConnectorData)
 	}
-	if m.code_challenge_method != nil {
-		fields = append(fields, devicetoken.FieldCodeChallengeMethod)
+	if m.extra_claims != nil {
+		fields = append(fields, offlinesession.FieldExtraClaims)
 	}
 	return fields
 }
@@ -4351,24 +12356,18 @@ func (m *DeviceTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *OfflineSessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case devicetoken.FieldDeviceCode:
-		return m.DeviceCode()
-	case devicetoken.FieldStatus:
-		return m.Status()
-	case devicetoken.FieldToken:
-		return m.Token()
-	case devicetoken.FieldExpiry:
-		return m.Expiry()
-	case devicetoken.FieldLastRequest:
-		return m.LastRequest()
-	case devicetoken.FieldPollInterval:
-		return m.PollInterval()
-	case devicetoken.FieldCodeChallenge:
-		return m.CodeChallenge()
-	case devicetoken.FieldCodeChallengeMethod:
-		return m.CodeChallengeMethod()
+	case offlinesession.FieldUserID:
+		return m.UserID()
+	case offlinesession.FieldConnID:
+		return m.ConnID()
+	case offlinesession.FieldRefresh:
+		return m.Refresh()
+	case offlinesession.FieldConnectorData:
+		return m.ConnectorData()
+	case offlinesession.FieldExtraClaims:
+		return m.ExtraClaims()
 	}
 	return nil, false
 }
@@ -4376,527 +12375,651 @@ func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OfflineSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case devicetoken.FieldDeviceCode:
-		return m.OldDeviceCode(ctx)
-	case devicetoken.FieldStatus:
-		return m.OldStatus(ctx)
-	case devicetoken.FieldToken:
-		return m.OldToken(ctx)
-	case devicetoken.FieldExpiry:
-		return m.OldExpiry(ctx)
-	case devicetoken.FieldLastRequest:
-		return m.OldLastRequest(ctx)
-	case devicetoken.FieldPollInterval:
-		return m.OldPollInterval(ctx)
-	case devicetoken.FieldCodeChallenge:
-		return m.OldCodeChallenge(ctx)
-	case devicetoken.FieldCodeChallengeMethod:
-		return m.OldCodeChallengeMethod(ctx)
+	case offlinesession.FieldUserID:
+		return m.OldUserID(ctx)
+	case offlinesession.FieldConnID:
+		return m.OldConnID(ctx)
+	case offlinesession.FieldRefresh:
+		return m.OldRefresh(ctx)
+	case offlinesession.FieldConnectorData:
+		return m.OldConnectorData(ctx)
+	case offlinesession.FieldExtraClaims:
+		return m.OldExtraClaims(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
+	return nil, fmt.Errorf("unknown OfflineSession field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
+func (m *OfflineSessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case devicetoken.FieldDeviceCode:
+	case offlinesession.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceCode(v)
+		m.SetUserID(v)
 		return nil
-	case devicetoken.FieldStatus:
+	case offlinesession.FieldConnID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetConnID(v)
 		return nil
-	case devicetoken.FieldToken:
+	case offlinesession.FieldRefresh:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
-		return nil
-	case devicetoken.FieldExpiry:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiry(v)
-		return nil
-	case devicetoken.FieldLastRequest:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastRequest(v)
-		return nil
-	case devicetoken.FieldPollInterval:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPollInterval(v)
+		m.SetRefresh(v)
 		return nil
-	case devicetoken.FieldCodeChallenge:
-		v, ok := value.(string)
+	case offlinesession.FieldConnectorData:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCodeChallenge(v)
+		m.SetConnectorData(v)
 		return nil
-	case devicetoken.FieldCodeChallengeMethod:
-		v, ok := value.(string)
+	case offlinesession.FieldExtraClaims:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCodeChallengeMethod(v)
+		m.SetExtraClaims(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown OfflineSession field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceTokenMutation) AddedFields() []string {
-	var fields []string
-	if m.addpoll_interval != nil {
-		fields = append(fields, devicetoken.FieldPollInterval)
-	}
-	return fields
+func (m *OfflineSessionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case devicetoken.FieldPollInterval:
-		return m.AddedPollInterval()
-	}
+func (m *OfflineSessionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
+func (m *OfflineSessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case devicetoken.FieldPollInterval:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPollInterval(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
+	return fmt.Errorf("unknown OfflineSession numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceTokenMutation) ClearedFields() []string {
+func (m *OfflineSessionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(devicetoken.FieldToken) {
-		fields = append(fields, devicetoken.FieldToken)
+	if m.FieldCleared(offlinesession.FieldConnectorData) {
+		fields = append(fields, offlinesession.FieldConnectorData)
+	}
+	if m.FieldCleared(offlinesession.FieldExtraClaims) {
+		fields = append(fields, offlinesession.FieldExtraClaims)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceTokenMutation) FieldCleared(name string) bool {
+func (m *OfflineSessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ClearField(name string) error {
-	switch name {
-	case devicetoken.FieldToken:
-		m.ClearToken()
-		return nil
-	}
-	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ResetField(name string) error {
+func (m *OfflineSessionMutation) ClearField(name string) error {
 	switch name {
-	case devicetoken.FieldDeviceCode:
-		m.ResetDeviceCode()
-		return nil
-	case devicetoken.FieldStatus:
-		m.ResetStatus()
+	case offlinesession.FieldConnectorData:
+		m.ClearConnectorData()
 		return nil
-	case devicetoken.FieldToken:
-		m.ResetToken()
+	case offlinesession.FieldExtraClaims:
+		m.ClearExtraClaims()
 		return nil
-	case devicetoken.FieldExpiry:
-		m.ResetExpiry()
+	}
+	return fmt.Errorf("unknown OfflineSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OfflineSessionMutation) ResetField(name string) error {
+	switch name {
+	case offlinesession.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case devicetoken.FieldLastRequest:
-		m.ResetLastRequest()
+	case offlinesession.FieldConnID:
+		m.ResetConnID()
 		return nil
-	case devicetoken.FieldPollInterval:
-		m.ResetPollInterval()
+	case offlinesession.FieldRefresh:
+		m.ResetRefresh()
 		return nil
-	case devicetoken.FieldCodeChallenge:
-		m.ResetCodeChallenge()
+	case offlinesession.FieldConnectorData:
+		m.ResetConnectorData()
 		return nil
-	case devicetoken.FieldCodeChallengeMethod:
-		m.ResetCodeChallengeMethod()
+	case offlinesession.FieldExtraClaims:
+		m.ResetExtraClaims()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown OfflineSession field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceTokenMutation) AddedEdges() []string {
+func (m *OfflineSessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *OfflineSessionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceTokenMutation) RemovedEdges() []string {
+func (m *OfflineSessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *OfflineSessionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceTokenMutation) ClearedEdges() []string {
+func (m *OfflineSessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
+func (m *OfflineSessionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
+func (m *OfflineSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OfflineSession unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DeviceToken edge %s", name)
+func (m *OfflineSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OfflineSession edge %s", name)
 }
 
-// KeysMutation represents an operation that mutates the Keys nodes in the graph.
-type KeysMutation struct {
+// PasswordMutation represents an operation that mutates the Password nodes in the graph.
+type PasswordMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *string
-	verification_keys       *[]storage.VerificationKey
-	appendverification_keys []storage.VerificationKey
-	signing_key             *jose.JSONWebKey
-	signing_key_pub         *jose.JSONWebKey
-	next_rotation           *time.Time
-	clearedFields           map[string]struct{}
-	done                    bool
-	oldValue                func(context.Context) (*Keys, error)
-	predicates              []predicate.Keys
+	op                 Op
+	typ                string
+	id                 *int
+	email              *string
+	hash               *[]byte
+	username           *string
+	name               *string
+	preferred_username *string
+	email_verified     *bool
+	user_id            *string
+	groups             *[]string
+	appendgroups       []string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Password, error)
+	predicates         []predicate.Password
 }
 
-var _ ent.Mutation = (*KeysMutation)(nil)
+var _ ent.Mutation = (*PasswordMutation)(nil)
+
+// passwordOption allows management of the mutation configuration using functional options.
+type passwordOption func(*PasswordMutation)
+
+// newPasswordMutation creates new mutation for the Password entity.
+func newPasswordMutation(c config, op Op, opts ...passwordOption) *PasswordMutation {
+	m := &PasswordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePassword,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordID sets the ID field of the mutation.
+func withPasswordID(id int) passwordOption {
+	return func(m *PasswordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Password
+		)
+		m.oldValue = func(ctx context.Context) (*Password, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Password.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPassword sets the old Password of the mutation.
+func withPassword(node *Password) passwordOption {
+	return func(m *PasswordMutation) {
+		m.oldValue = func(context.Context) (*Password, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Password.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *PasswordMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *PasswordMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *PasswordMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *PasswordMutation) SetHash(b []byte) {
+	m.hash = &b
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *PasswordMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *PasswordMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *PasswordMutation) SetUsername(s string) {
+	m.username = &s
+}
 
-// keysOption allows management of the mutation configuration using functional options.
-type keysOption func(*KeysMutation)
+// Username returns the value of the "username" field in the mutation.
+func (m *PasswordMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newKeysMutation creates new mutation for the Keys entity.
-func newKeysMutation(c config, op Op, opts ...keysOption) *KeysMutation {
-	m := &KeysMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeKeys,
-		clearedFields: make(map[string]struct{}),
+// OldUsername returns the old "username" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
 }
 
-// withKeysID sets the ID field of the mutation.
-func withKeysID(id string) keysOption {
-	return func(m *KeysMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Keys
-		)
-		m.oldValue = func(ctx context.Context) (*Keys, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Keys.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetUsername resets all changes to the "username" field.
+func (m *PasswordMutation) ResetUsername() {
+	m.username = nil
 }
 
-// withKeys sets the old Keys of the mutation.
-func withKeys(node *Keys) keysOption {
-	return func(m *KeysMutation) {
-		m.oldValue = func(context.Context) (*Keys, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetName sets the "name" field.
+func (m *PasswordMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m KeysMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Name returns the value of the "name" field in the mutation.
+func (m *PasswordMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m KeysMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("db: mutation is not running in a transaction")
+// OldName returns the old "name" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Keys entities.
-func (m *KeysMutation) SetID(id string) {
-	m.id = &id
+// ResetName resets all changes to the "name" field.
+func (m *PasswordMutation) ResetName() {
+	m.name = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *KeysMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// SetPreferredUsername sets the "preferred_username" field.
+func (m *PasswordMutation) SetPreferredUsername(s string) {
+	m.preferred_username = &s
+}
+
+// PreferredUsername returns the value of the "preferred_username" field in the mutation.
+func (m *PasswordMutation) PreferredUsername() (r string, exists bool) {
+	v := m.preferred_username
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *KeysMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Keys.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldPreferredUsername returns the old "preferred_username" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldPreferredUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreferredUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreferredUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreferredUsername: %w", err)
 	}
+	return oldValue.PreferredUsername, nil
 }
 
-// SetVerificationKeys sets the "verification_keys" field.
-func (m *KeysMutation) SetVerificationKeys(sk []storage.VerificationKey) {
-	m.verification_keys = &sk
-	m.appendverification_keys = nil
+// ResetPreferredUsername resets all changes to the "preferred_username" field.
+func (m *PasswordMutation) ResetPreferredUsername() {
+	m.preferred_username = nil
 }
 
-// VerificationKeys returns the value of the "verification_keys" field in the mutation.
-func (m *KeysMutation) VerificationKeys() (r []storage.VerificationKey, exists bool) {
-	v := m.verification_keys
+// SetEmailVerified sets the "email_verified" field.
+func (m *PasswordMutation) SetEmailVerified(b bool) {
+	m.email_verified = &b
+}
+
+// EmailVerified returns the value of the "email_verified" field in the mutation.
+func (m *PasswordMutation) EmailVerified() (r bool, exists bool) {
+	v := m.email_verified
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVerificationKeys returns the old "verification_keys" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// OldEmailVerified returns the old "email_verified" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldVerificationKeys(ctx context.Context) (v []storage.VerificationKey, err error) {
+func (m *PasswordMutation) OldEmailVerified(ctx context.Context) (v *bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerificationKeys is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmailVerified is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerificationKeys requires an ID field in the mutation")
+		return v, errors.New("OldEmailVerified requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerificationKeys: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmailVerified: %w", err)
 	}
-	return oldValue.VerificationKeys, nil
+	return oldValue.EmailVerified, nil
 }
 
-// AppendVerificationKeys adds sk to the "verification_keys" field.
-func (m *KeysMutation) AppendVerificationKeys(sk []storage.VerificationKey) {
-	m.appendverification_keys = append(m.appendverification_keys, sk...)
+// ClearEmailVerified clears the value of the "email_verified" field.
+func (m *PasswordMutation) ClearEmailVerified() {
+	m.email_verified = nil
+	m.clearedFields[password.FieldEmailVerified] = struct{}{}
 }
 
-// AppendedVerificationKeys returns the list of values that were appended to the "verification_keys" field in this mutation.
-func (m *KeysMutation) AppendedVerificationKeys() ([]storage.VerificationKey, bool) {
-	if len(m.appendverification_keys) == 0 {
-		return nil, false
-	}
-	return m.appendverification_keys, true
+// EmailVerifiedCleared returns if the "email_verified" field was cleared in this mutation.
+func (m *PasswordMutation) EmailVerifiedCleared() bool {
+	_, ok := m.clearedFields[password.FieldEmailVerified]
+	return ok
 }
 
-// ResetVerificationKeys resets all changes to the "verification_keys" field.
-func (m *KeysMutation) ResetVerificationKeys() {
-	m.verification_keys = nil
-	m.appendverification_keys = nil
+// ResetEmailVerified resets all changes to the "email_verified" field.
+func (m *PasswordMutation) ResetEmailVerified() {
+	m.email_verified = nil
+	delete(m.clearedFields, password.FieldEmailVerified)
 }
 
-// SetSigningKey sets the "signing_key" field.
-func (m *KeysMutation) SetSigningKey(jwk jose.JSONWebKey) {
-	m.signing_key = &jwk
+// SetUserID sets the "user_id" field.
+func (m *PasswordMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// SigningKey returns the value of the "signing_key" field in the mutation.
-func (m *KeysMutation) SigningKey() (r jose.JSONWebKey, exists bool) {
-	v := m.signing_key
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSigningKey returns the old "signing_key" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldSigningKey(ctx context.Context) (v jose.JSONWebKey, err error) {
+func (m *PasswordMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSigningKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSigningKey requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSigningKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.SigningKey, nil
+	return oldValue.UserID, nil
 }
 
-// ResetSigningKey resets all changes to the "signing_key" field.
-func (m *KeysMutation) ResetSigningKey() {
-	m.signing_key = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetSigningKeyPub sets the "signing_key_pub" field.
-func (m *KeysMutation) SetSigningKeyPub(jwk jose.JSONWebKey) {
-	m.signing_key_pub = &jwk
+// SetGroups sets the "groups" field.
+func (m *PasswordMutation) SetGroups(s []string) {
+	m.groups = &s
+	m.appendgroups = nil
 }
 
-// SigningKeyPub returns the value of the "signing_key_pub" field in the mutation.
-func (m *KeysMutation) SigningKeyPub() (r jose.JSONWebKey, exists bool) {
-	v := m.signing_key_pub
+// Groups returns the value of the "groups" field in the mutation.
+func (m *PasswordMutation) Groups() (r []string, exists bool) {
+	v := m.groups
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSigningKeyPub returns the old "signing_key_pub" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// OldGroups returns the old "groups" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldSigningKeyPub(ctx context.Context) (v jose.JSONWebKey, err error) {
+func (m *PasswordMutation) OldGroups(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSigningKeyPub is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroups is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSigningKeyPub requires an ID field in the mutation")
+		return v, errors.New("OldGroups requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSigningKeyPub: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroups: %w", err)
 	}
-	return oldValue.SigningKeyPub, nil
+	return oldValue.Groups, nil
 }
 
-// ResetSigningKeyPub resets all changes to the "signing_key_pub" field.
-func (m *KeysMutation) ResetSigningKeyPub() {
-	m.signing_key_pub = nil
+// AppendGroups adds s to the "groups" field.
+func (m *PasswordMutation) AppendGroups(s []string) {
+	m.appendgroups = append(m.appendgroups, s...)
 }
 
-// SetNextRotation sets the "next_rotation" field.
-func (m *KeysMutation) SetNextRotation(t time.Time) {
-	m.next_rotation = &t
+// AppendedGroups returns the list of values that were appended to the "groups" field in this mutation.
+func (m *PasswordMutation) AppendedGroups() ([]string, bool) {
+	if len(m.appendgroups) == 0 {
+		return nil, false
+	}
+	return m.appendgroups, true
 }
 
-// NextRotation returns the value of the "next_rotation" field in the mutation.
-func (m *KeysMutation) NextRotation() (r time.Time, exists bool) {
-	v := m.next_rotation
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearGroups clears the value of the "groups" field.
+func (m *PasswordMutation) ClearGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	m.clearedFields[password.FieldGroups] = struct{}{}
 }
 
-// OldNextRotation returns the old "next_rotation" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldNextRotation(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRotation is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRotation requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRotation: %w", err)
-	}
-	return oldValue.NextRotation, nil
+// GroupsCleared returns if the "groups" field was cleared in this mutation.
+func (m *PasswordMutation) GroupsCleared() bool {
+	_, ok := m.clearedFields[password.FieldGroups]
+	return ok
 }
 
-// ResetNextRotation resets all changes to the "next_rotation" field.
-func (m *KeysMutation) ResetNextRotation() {
-	m.next_rotation = nil
+// ResetGroups resets all changes to the "groups" field.
+func (m *PasswordMutation) ResetGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	delete(m.clearedFields, password.FieldGroups)
 }
 
-// Where appends a list predicates to the KeysMutation builder.
-func (m *KeysMutation) Where(ps ...predicate.Keys) {
+// Where appends a list predicates to the PasswordMutation builder.
+func (m *PasswordMutation) Where(ps ...predicate.Password) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the KeysMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PasswordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *KeysMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Keys, len(ps))
+func (m *PasswordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Password, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4904,36 +13027,48 @@ func (m *KeysMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *KeysMutation) Op() Op {
+func (m *PasswordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *KeysMutation) SetOp(op Op) {
+func (m *PasswordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Keys).
-func (m *KeysMutation) Type() string {
+// Type returns the node type of this mutation (Password).
+func (m *PasswordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *KeysMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.verification_keys != nil {
-		fields = append(fields, keys.FieldVerificationKeys)
+func (m *PasswordMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.email != nil {
+		fields = append(fields, password.FieldEmail)
 	}
-	if m.signing_key != nil {
-		fields = append(fields, keys.FieldSigningKey)
+	if m.hash != nil {
+		fields = append(fields, password.FieldHash)
 	}
-	if m.signing_key_pub != nil {
-		fields = append(fields, keys.FieldSigningKeyPub)
+	if m.username != nil {
+		fields = append(fields, password.FieldUsername)
 	}
-	if m.next_rotation != nil {
-		fields = append(fields, keys.FieldNextRotation)
+	if m.name != nil {
+		fields = append(fields, password.FieldName)
+	}
+	if m.preferred_username != nil {
+		fields = append(fields, password.FieldPreferredUsername)
+	}
+	if m.email_verified != nil {
+		fields = append(fields, password.FieldEmailVerified)
+	}
+	if m.user_id != nil {
+		fields = append(fields, password.FieldUserID)
+	}
+	if m.groups != nil {
+		fields = append(fields, password.FieldGroups)
 	}
 	return fields
 }
@@ -4941,16 +13076,24 @@ func (m *KeysMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *KeysMutation) Field(name string) (ent.Value, bool) {
+func (m *PasswordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case keys.FieldVerificationKeys:
-		return m.VerificationKeys()
-	case keys.FieldSigningKey:
-		return m.SigningKey()
-	case keys.FieldSigningKeyPub:
-		return m.SigningKeyPub()
-	case keys.FieldNextRotation:
-		return m.NextRotation()
+	case password.FieldEmail:
+		return m.Email()
+	case password.FieldHash:
+		return m.Hash()
+	case password.FieldUsername:
+		return m.Username()
+	case password.FieldName:
+		return m.Name()
+	case password.FieldPreferredUsername:
+		return m.PreferredUsername()
+	case password.FieldEmailVerified:
+		return m.EmailVerified()
+	case password.FieldUserID:
+		return m.UserID()
+	case password.FieldGroups:
+		return m.Groups()
 	}
 	return nil, false
 }
@@ -4958,197 +13101,276 @@ func (m *KeysMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *KeysMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PasswordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case keys.FieldVerificationKeys:
-		return m.OldVerificationKeys(ctx)
-	case keys.FieldSigningKey:
-		return m.OldSigningKey(ctx)
-	case keys.FieldSigningKeyPub:
-		return m.OldSigningKeyPub(ctx)
-	case keys.FieldNextRotation:
-		return m.OldNextRotation(ctx)
+	case password.FieldEmail:
+		return m.OldEmail(ctx)
+	case password.FieldHash:
+		return m.OldHash(ctx)
+	case password.FieldUsername:
+		return m.OldUsername(ctx)
+	case password.FieldName:
+		return m.OldName(ctx)
+	case password.FieldPreferredUsername:
+		return m.OldPreferredUsername(ctx)
+	case password.FieldEmailVerified:
+		return m.OldEmailVerified(ctx)
+	case password.FieldUserID:
+		return m.OldUserID(ctx)
+	case password.FieldGroups:
+		return m.OldGroups(ctx)
 	}
-	return nil, fmt.Errorf("unknown Keys field %s", name)
+	return nil, fmt.Errorf("unknown Password field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeysMutation) SetField(name string, value ent.Value) error {
+func (m *PasswordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case keys.FieldVerificationKeys:
-		v, ok := value.([]storage.VerificationKey)
+	case password.FieldEmail:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVerificationKeys(v)
+		m.SetEmail(v)
 		return nil
-	case keys.FieldSigningKey:
-		v, ok := value.(jose.JSONWebKey)
+	case password.FieldHash:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSigningKey(v)
+		m.SetHash(v)
 		return nil
-	case keys.FieldSigningKeyPub:
-		v, ok := value.(jose.JSONWebKey)
+	case password.FieldUsername:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSigningKeyPub(v)
+		m.SetUsername(v)
 		return nil
-	case keys.FieldNextRotation:
-		v, ok := value.(time.Time)
+	case password.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNextRotation(v)
+		m.SetName(v)
+		return nil
+	case password.FieldPreferredUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreferredUsername(v)
+		return nil
+	case password.FieldEmailVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerified(v)
+		return nil
+	case password.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case password.FieldGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroups(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Keys field %s", name)
+	return fmt.Errorf("unknown Password field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *KeysMutation) AddedFields() []string {
+func (m *PasswordMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *KeysMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PasswordMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeysMutation) AddField(name string, value ent.Value) error {
+func (m *PasswordMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Keys numeric field %s", name)
+	return fmt.Errorf("unknown Password numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *KeysMutation) ClearedFields() []string {
-	return nil
+func (m *PasswordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(password.FieldEmailVerified) {
+		fields = append(fields, password.FieldEmailVerified)
+	}
+	if m.FieldCleared(password.FieldGroups) {
+		fields = append(fields, password.FieldGroups)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *KeysMutation) FieldCleared(name string) bool {
+func (m *PasswordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *KeysMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Keys nullable field %s", name)
+func (m *PasswordMutation) ClearField(name string) error {
+	switch name {
+	case password.FieldEmailVerified:
+		m.ClearEmailVerified()
+		return nil
+	case password.FieldGroups:
+		m.ClearGroups()
+		return nil
+	}
+	return fmt.Errorf("unknown Password nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *KeysMutation) ResetField(name string) error {
+func (m *PasswordMutation) ResetField(name string) error {
 	switch name {
-	case keys.FieldVerificationKeys:
-		m.ResetVerificationKeys()
+	case password.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case keys.FieldSigningKey:
-		m.ResetSigningKey()
+	case password.FieldHash:
+		m.ResetHash()
 		return nil
-	case keys.FieldSigningKeyPub:
-		m.ResetSigningKeyPub()
+	case password.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case keys.FieldNextRotation:
-		m.ResetNextRotation()
+	case password.FieldName:
+		m.ResetName()
+		return nil
+	case password.FieldPreferredUsername:
+		m.ResetPreferredUsername()
+		return nil
+	case password.FieldEmailVerified:
+		m.ResetEmailVerified()
+		return nil
+	case password.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case password.FieldGroups:
+		m.ResetGroups()
 		return nil
 	}
-	return fmt.Errorf("unknown Keys field %s", name)
+	return fmt.Errorf("unknown Password field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *KeysMutation) AddedEdges() []string {
+func (m *PasswordMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *KeysMutation) AddedIDs(name string) []ent.Value {
+func (m *PasswordMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *KeysMutation) RemovedEdges() []string {
+func (m *PasswordMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *KeysMutation) RemovedIDs(name string) []ent.Value {
+func (m *PasswordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *KeysMutation) ClearedEdges() []string {
+func (m *PasswordMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *KeysMutation) EdgeCleared(name string) bool {
+func (m *PasswordMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *KeysMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Keys unique edge %s", name)
+func (m *PasswordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Password unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *KeysMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Keys edge %s", name)
+func (m *PasswordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Password edge %s", name)
 }
 
-// OAuth2ClientMutation represents an operation that mutates the OAuth2Client nodes in the graph.
-type OAuth2ClientMutation struct {
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *string
-	secret              *string
-	redirect_uris       *[]string
-	appendredirect_uris []string
-	trusted_peers       *[]string
-	appendtrusted_peers []string
-	public              *bool
-	name                *string
-	logo_url            *string
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*OAuth2Client, error)
-	predicates          []predicate.OAuth2Client
+	op                        Op
+	typ                       string
+	id                        *string
+	client_id                 *string
+	scopes                    *[]string
+	appendscopes              []string
+	nonce                     *string
+	claims_user_id            *string
+	claims_username           *string
+	claims_email              *string
+	claims_email_verified     *bool
+	claims_groups             *[]string
+	appendclaims_groups       []string
+	claims_extra              *map[string]interface{}
+	claims_preferred_username *string
+	connector_id              *string
+	connector_data            *[]byte
+	token                     *string
+	obsolete_token            *string
+	dpop_key_thumbprint       *string
+	created_at                *time.Time
+	last_used                 *time.Time
+	acr                       *string
+	amr                       *[]string
+	appendamr                 []string
+	auth_time                 *time.Time
+	sid                       *string
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*RefreshToken, error)
+	predicates                []predicate.RefreshToken
 }
 
-var _ ent.Mutation = (*OAuth2ClientMutation)(nil)
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
 
-// oauth2clientOption allows management of the mutation configuration using functional options.
-type oauth2clientOption func(*OAuth2ClientMutation)
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
 
-// newOAuth2ClientMutation creates new mutation for the OAuth2Client entity.
-func newOAuth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *OAuth2ClientMutation {
-	m := &OAuth2ClientMutation{
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOAuth2Client,
+		typ:           TypeRefreshToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5157,20 +13379,20 @@ func newOAuth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *OAuth
 	return m
 }
 
-// withOAuth2ClientID sets the ID field of the mutation.
-func withOAuth2ClientID(id string) oauth2clientOption {
-	return func(m *OAuth2ClientMutation) {
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id string) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OAuth2Client
+			value *RefreshToken
 		)
-		m.oldValue = func(ctx context.Context) (*OAuth2Client, error) {
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OAuth2Client.Get(ctx, id)
+					value, err = m.Client().RefreshToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5179,10 +13401,10 @@ func withOAuth2ClientID(id string) oauth2clientOption {
 	}
 }
 
-// withOAuth2Client sets the old OAuth2Client of the mutation.
-func withOAuth2Client(node *OAuth2Client) oauth2clientOption {
-	return func(m *OAuth2ClientMutation) {
-		m.oldValue = func(context.Context) (*OAuth2Client, error) {
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5191,7 +13413,7 @@ func withOAuth2Client(node *OAuth2Client) oauth2clientOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OAuth2ClientMutation) Client() *Client {
+func (m RefreshTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5199,7 +13421,7 @@ func (m OAuth2ClientMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OAuth2ClientMutation) Tx() (*Tx, error) {
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -5209,14 +13431,14 @@ func (m OAuth2ClientMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OAuth2Client entities.
-func (m *OAuth2ClientMutation) SetID(id string) {
+// operation is only accepted on creation of RefreshToken entities.
+func (m *RefreshTokenMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OAuth2ClientMutation) ID() (id string, exists bool) {
+func (m *RefreshTokenMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5227,7 +13449,7 @@ func (m *OAuth2ClientMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OAuth2ClientMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5236,851 +13458,903 @@ func (m *OAuth2ClientMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OAuth2Client.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSecret sets the "secret" field.
-func (m *OAuth2ClientMutation) SetSecret(s string) {
-	m.secret = &s
+// SetClientID sets the "client_id" field.
+func (m *RefreshTokenMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// Secret returns the value of the "secret" field in the mutation.
-func (m *OAuth2ClientMutation) Secret() (r string, exists bool) {
-	v := m.secret
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *RefreshTokenMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldSecret(ctx context.Context) (v string, err error) {
+func (m *RefreshTokenMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecret requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *RefreshTokenMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *RefreshTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *RefreshTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *RefreshTokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *RefreshTokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *RefreshTokenMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[refreshtoken.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *RefreshTokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, refreshtoken.FieldScopes)
+}
+
+// SetNonce sets the "nonce" field.
+func (m *RefreshTokenMutation) SetNonce(s string) {
+	m.nonce = &s
+}
+
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *RefreshTokenMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNonce returns the old "nonce" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldNonce(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonce requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
 	}
-	return oldValue.Secret, nil
+	return oldValue.Nonce, nil
 }
 
-// ResetSecret resets all changes to the "secret" field.
-func (m *OAuth2ClientMutation) ResetSecret() {
-	m.secret = nil
+// ResetNonce resets all changes to the "nonce" field.
+func (m *RefreshTokenMutation) ResetNonce() {
+	m.nonce = nil
 }
 
-// SetRedirectUris sets the "redirect_uris" field.
-func (m *OAuth2ClientMutation) SetRedirectUris(s []string) {
-	m.redirect_uris = &s
-	m.appendredirect_uris = nil
+// SetClaimsUserID sets the "claims_user_id" field.
+func (m *RefreshTokenMutation) SetClaimsUserID(s string) {
+	m.claims_user_id = &s
 }
 
-// RedirectUris returns the value of the "redirect_uris" field in the mutation.
-func (m *OAuth2ClientMutation) RedirectUris() (r []string, exists bool) {
-	v := m.redirect_uris
+// ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsUserID() (r string, exists bool) {
+	v := m.claims_user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirectUris returns the old "redirect_uris" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUserID returns the old "claims_user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+func (m *RefreshTokenMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+		return v, errors.New("OldClaimsUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
-	}
-	return oldValue.RedirectUris, nil
-}
-
-// AppendRedirectUris adds s to the "redirect_uris" field.
-func (m *OAuth2ClientMutation) AppendRedirectUris(s []string) {
-	m.appendredirect_uris = append(m.appendredirect_uris, s...)
-}
-
-// AppendedRedirectUris returns the list of values that were appended to the "redirect_uris" field in this mutation.
-func (m *OAuth2ClientMutation) AppendedRedirectUris() ([]string, bool) {
-	if len(m.appendredirect_uris) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldClaimsUserID: %w", err)
 	}
-	return m.appendredirect_uris, true
-}
-
-// ClearRedirectUris clears the value of the "redirect_uris" field.
-func (m *OAuth2ClientMutation) ClearRedirectUris() {
-	m.redirect_uris = nil
-	m.appendredirect_uris = nil
-	m.clearedFields[oauth2client.FieldRedirectUris] = struct{}{}
-}
-
-// RedirectUrisCleared returns if the "redirect_uris" field was cleared in this mutation.
-func (m *OAuth2ClientMutation) RedirectUrisCleared() bool {
-	_, ok := m.clearedFields[oauth2client.FieldRedirectUris]
-	return ok
+	return oldValue.ClaimsUserID, nil
 }
 
-// ResetRedirectUris resets all changes to the "redirect_uris" field.
-func (m *OAuth2ClientMutation) ResetRedirectUris() {
-	m.redirect_uris = nil
-	m.appendredirect_uris = nil
-	delete(m.clearedFields, oauth2client.FieldRedirectUris)
+// ResetClaimsUserID resets all changes to the "claims_user_id" field.
+func (m *RefreshTokenMutation) ResetClaimsUserID() {
+	m.claims_user_id = nil
 }
 
-// SetTrustedPeers sets the "trusted_peers" field.
-func (m *OAuth2ClientMutation) SetTrustedPeers(s []string) {
-	m.trusted_peers = &s
-	m.appendtrusted_peers = nil
+// SetClaimsUsername sets the "claims_username" field.
+func (m *RefreshTokenMutation) SetClaimsUsername(s string) {
+	m.claims_username = &s
 }
 
-// TrustedPeers returns the value of the "trusted_peers" field in the mutation.
-func (m *OAuth2ClientMutation) TrustedPeers() (r []string, exists bool) {
-	v := m.trusted_peers
+// ClaimsUsername returns the value of the "claims_username" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsUsername() (r string, exists bool) {
+	v := m.claims_username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrustedPeers returns the old "trusted_peers" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUsername returns the old "claims_username" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldTrustedPeers(ctx context.Context) (v []string, err error) {
+func (m *RefreshTokenMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrustedPeers is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrustedPeers requires an ID field in the mutation")
+		return v, errors.New("OldClaimsUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrustedPeers: %w", err)
-	}
-	return oldValue.TrustedPeers, nil
-}
-
-// AppendTrustedPeers adds s to the "trusted_peers" field.
-func (m *OAuth2ClientMutation) AppendTrustedPeers(s []string) {
-	m.appendtrusted_peers = append(m.appendtrusted_peers, s...)
-}
-
-// AppendedTrustedPeers returns the list of values that were appended to the "trusted_peers" field in this mutation.
-func (m *OAuth2ClientMutation) AppendedTrustedPeers() ([]string, bool) {
-	if len(m.appendtrusted_peers) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldClaimsUsername: %w", err)
 	}
-	return m.appendtrusted_peers, true
-}
-
-// ClearTrustedPeers clears the value of the "trusted_peers" field.
-func (m *OAuth2ClientMutation) ClearTrustedPeers() {
-	m.trusted_peers = nil
-	m.appendtrusted_peers = nil
-	m.clearedFields[oauth2client.FieldTrustedPeers] = struct{}{}
-}
-
-// TrustedPeersCleared returns if the "trusted_peers" field was cleared in this mutation.
-func (m *OAuth2ClientMutation) TrustedPeersCleared() bool {
-	_, ok := m.clearedFields[oauth2client.FieldTrustedPeers]
-	return ok
+	return oldValue.ClaimsUsername, nil
 }
 
-// ResetTrustedPeers resets all changes to the "trusted_peers" field.
-func (m *OAuth2ClientMutation) ResetTrustedPeers() {
-	m.trusted_peers = nil
-	m.appendtrusted_peers = nil
-	delete(m.clearedFields, oauth2client.FieldTrustedPeers)
+// ResetClaimsUsername resets all changes to the "claims_username" field.
+func (m *RefreshTokenMutation) ResetClaimsUsername() {
+	m.claims_username = nil
 }
 
-// SetPublic sets the "public" field.
-func (m *OAuth2ClientMutation) SetPublic(b bool) {
-	m.public = &b
+// SetClaimsEmail sets the "claims_email" field.
+func (m *RefreshTokenMutation) SetClaimsEmail(s string) {
+	m.claims_email = &s
 }
 
-// Public returns the value of the "public" field in the mutation.
-func (m *OAuth2ClientMutation) Public() (r bool, exists bool) {
-	v := m.public
+// ClaimsEmail returns the value of the "claims_email" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsEmail() (r string, exists bool) {
+	v := m.claims_email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublic returns the old "public" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmail returns the old "claims_email" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldPublic(ctx context.Context) (v bool, err error) {
+func (m *RefreshTokenMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublic requires an ID field in the mutation")
+		return v, errors.New("OldClaimsEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsEmail: %w", err)
 	}
-	return oldValue.Public, nil
+	return oldValue.ClaimsEmail, nil
 }
 
-// ResetPublic resets all changes to the "public" field.
-func (m *OAuth2ClientMutation) ResetPublic() {
-	m.public = nil
+// ResetClaimsEmail resets all changes to the "claims_email" field.
+func (m *RefreshTokenMutation) ResetClaimsEmail() {
+	m.claims_email = nil
 }
 
-// SetName sets the "name" field.
-func (m *OAuth2ClientMutation) SetName(s string) {
-	m.name = &s
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (m *RefreshTokenMutation) SetClaimsEmailVerified(b bool) {
+	m.claims_email_verified = &b
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OAuth2ClientMutation) Name() (r string, exists bool) {
-	v := m.name
+// ClaimsEmailVerified returns the value of the "claims_email_verified" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsEmailVerified() (r bool, exists bool) {
+	v := m.claims_email_verified
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RefreshTokenMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsEmailVerified is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldClaimsEmailVerified requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsEmailVerified: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ClaimsEmailVerified, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *OAuth2ClientMutation) ResetName() {
-	m.name = nil
+// ResetClaimsEmailVerified resets all changes to the "claims_email_verified" field.
+func (m *RefreshTokenMutation) ResetClaimsEmailVerified() {
+	m.claims_email_verified = nil
 }
 
-// SetLogoURL sets the "logo_url" field.
-func (m *OAuth2ClientMutation) SetLogoURL(s string) {
-	m.logo_url = &s
+// SetClaimsGroups sets the "claims_groups" field.
+func (m *RefreshTokenMutation) SetClaimsGroups(s []string) {
+	m.claims_groups = &s
+	m.appendclaims_groups = nil
 }
 
-// LogoURL returns the value of the "logo_url" field in the mutation.
-func (m *OAuth2ClientMutation) LogoURL() (r string, exists bool) {
-	v := m.logo_url
+// ClaimsGroups returns the value of the "claims_groups" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsGroups() (r []string, exists bool) {
+	v := m.claims_groups
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLogoURL returns the old "logo_url" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsGroups returns the old "claims_groups" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldLogoURL(ctx context.Context) (v string, err error) {
+func (m *RefreshTokenMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsGroups is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+		return v, errors.New("OldClaimsGroups requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsGroups: %w", err)
 	}
-	return oldValue.LogoURL, nil
+	return oldValue.ClaimsGroups, nil
 }
 
-// ResetLogoURL resets all changes to the "logo_url" field.
-func (m *OAuth2ClientMutation) ResetLogoURL() {
-	m.logo_url = nil
+// AppendClaimsGroups adds s to the "claims_groups" field.
+func (m *RefreshTokenMutation) AppendClaimsGroups(s []string) {
+	m.appendclaims_groups = append(m.appendclaims_groups, s...)
 }
 
-// Where appends a list predicates to the OAuth2ClientMutation builder.
-func (m *OAuth2ClientMutation) Where(ps ...predicate.OAuth2Client) {
-	m.predicates = append(m.predicates, ps...)
+// AppendedClaimsGroups returns the list of values that were appended to the "claims_groups" field in this mutation.
+func (m *RefreshTokenMutation) AppendedClaimsGroups() ([]string, bool) {
+	if len(m.appendclaims_groups) == 0 {
+		return nil, false
+	}
+	return m.appendclaims_groups, true
 }
 
-// WhereP appends storage-level predicates to the OAuth2ClientMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OAuth2ClientMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OAuth2Client, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearClaimsGroups clears the value of the "claims_groups" field.
+func (m *RefreshTokenMutation) ClearClaimsGroups() {
+	m.claims_groups = nil
+	m.appendclaims_groups = nil
+	m.clearedFields[refreshtoken.FieldClaimsGroups] = struct{}{}
+}
+
+// ClaimsGroupsCleared returns if the "claims_groups" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ClaimsGroupsCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldClaimsGroups]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *OAuth2ClientMutation) Op() Op {
-	return m.op
+// ResetClaimsGroups resets all changes to the "claims_groups" field.
+func (m *RefreshTokenMutation) ResetClaimsGroups() {
+	m.claims_groups = nil
+	m.appendclaims_groups = nil
+	delete(m.clearedFields, refreshtoken.FieldClaimsGroups)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *OAuth2ClientMutation) SetOp(op Op) {
-	m.op = op
+// SetClaimsExtra sets the "claims_extra" field.
+func (m *RefreshTokenMutation) SetClaimsExtra(value map[string]interface{}) {
+	m.claims_extra = &value
 }
 
-// Type returns the node type of this mutation (OAuth2Client).
-func (m *OAuth2ClientMutation) Type() string {
-	return m.typ
+// ClaimsExtra returns the value of the "claims_extra" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsExtra() (r map[string]interface{}, exists bool) {
+	v := m.claims_extra
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *OAuth2ClientMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.secret != nil {
-		fields = append(fields, oauth2client.FieldSecret)
-	}
-	if m.redirect_uris != nil {
-		fields = append(fields, oauth2client.FieldRedirectUris)
-	}
-	if m.trusted_peers != nil {
-		fields = append(fields, oauth2client.FieldTrustedPeers)
-	}
-	if m.public != nil {
-		fields = append(fields, oauth2client.FieldPublic)
-	}
-	if m.name != nil {
-		fields = append(fields, oauth2client.FieldName)
+// OldClaimsExtra returns the old "claims_extra" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsExtra(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsExtra is only allowed on UpdateOne operations")
 	}
-	if m.logo_url != nil {
-		fields = append(fields, oauth2client.FieldLogoURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsExtra requires an ID field in the mutation")
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *OAuth2ClientMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case oauth2client.FieldSecret:
-		return m.Secret()
-	case oauth2client.FieldRedirectUris:
-		return m.RedirectUris()
-	case oauth2client.FieldTrustedPeers:
-		return m.TrustedPeers()
-	case oauth2client.FieldPublic:
-		return m.Public()
-	case oauth2client.FieldName:
-		return m.Name()
-	case oauth2client.FieldLogoURL:
-		return m.LogoURL()
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsExtra: %w", err)
 	}
-	return nil, false
+	return oldValue.ClaimsExtra, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *OAuth2ClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case oauth2client.FieldSecret:
-		return m.OldSecret(ctx)
-	case oauth2client.FieldRedirectUris:
-		return m.OldRedirectUris(ctx)
-	case oauth2client.FieldTrustedPeers:
-		return m.OldTrustedPeers(ctx)
-	case oauth2client.FieldPublic:
-		return m.OldPublic(ctx)
-	case oauth2client.FieldName:
-		return m.OldName(ctx)
-	case oauth2client.FieldLogoURL:
-		return m.OldLogoURL(ctx)
-	}
-	return nil, fmt.Errorf("unknown OAuth2Client field %s", name)
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (m *RefreshTokenMutation) ClearClaimsExtra() {
+	m.claims_extra = nil
+	m.clearedFields[refreshtoken.FieldClaimsExtra] = struct{}{}
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OAuth2ClientMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case oauth2client.FieldSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecret(v)
-		return nil
-	case oauth2client.FieldRedirectUris:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRedirectUris(v)
-		return nil
-	case oauth2client.FieldTrustedPeers:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTrustedPeers(v)
-		return nil
-	case oauth2client.FieldPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublic(v)
-		return nil
-	case oauth2client.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case oauth2client.FieldLogoURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLogoURL(v)
-		return nil
-	}
-	return fmt.Errorf("unknown OAuth2Client field %s", name)
+// ClaimsExtraCleared returns if the "claims_extra" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ClaimsExtraCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldClaimsExtra]
+	return ok
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *OAuth2ClientMutation) AddedFields() []string {
-	return nil
+// ResetClaimsExtra resets all changes to the "claims_extra" field.
+func (m *RefreshTokenMutation) ResetClaimsExtra() {
+	m.claims_extra = nil
+	delete(m.clearedFields, refreshtoken.FieldClaimsExtra)
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *OAuth2ClientMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (m *RefreshTokenMutation) SetClaimsPreferredUsername(s string) {
+	m.claims_preferred_username = &s
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OAuth2ClientMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsPreferredUsername() (r string, exists bool) {
+	v := m.claims_preferred_username
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown OAuth2Client numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *OAuth2ClientMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(oauth2client.FieldRedirectUris) {
-		fields = append(fields, oauth2client.FieldRedirectUris)
+// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(oauth2client.FieldTrustedPeers) {
-		fields = append(fields, oauth2client.FieldTrustedPeers)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
+	}
+	return oldValue.ClaimsPreferredUsername, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *OAuth2ClientMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
+func (m *RefreshTokenMutation) ResetClaimsPreferredUsername() {
+	m.claims_preferred_username = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *OAuth2ClientMutation) ClearField(name string) error {
-	switch name {
-	case oauth2client.FieldRedirectUris:
-		m.ClearRedirectUris()
-		return nil
-	case oauth2client.FieldTrustedPeers:
-		m.ClearTrustedPeers()
-		return nil
+// SetConnectorID sets the "connector_id" field.
+func (m *RefreshTokenMutation) SetConnectorID(s string) {
+	m.connector_id = &s
+}
+
+// ConnectorID returns the value of the "connector_id" field in the mutation.
+func (m *RefreshTokenMutation) ConnectorID() (r string, exists bool) {
+	v := m.connector_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown OAuth2Client nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *OAuth2ClientMutation) ResetField(name string) error {
-	switch name {
-	case oauth2client.FieldSecret:
-		m.ResetSecret()
-		return nil
-	case oauth2client.FieldRedirectUris:
-		m.ResetRedirectUris()
-		return nil
-	case oauth2client.FieldTrustedPeers:
-		m.ResetTrustedPeers()
-		return nil
-	case oauth2client.FieldPublic:
-		m.ResetPublic()
-		return nil
-	case oauth2client.FieldName:
-		m.ResetName()
-		return nil
-	case oauth2client.FieldLogoURL:
-		m.ResetLogoURL()
-		return nil
+// OldConnectorID returns the old "connector_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldConnectorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown OAuth2Client field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
+	}
+	return oldValue.ConnectorID, nil
+}
+
+// ResetConnectorID resets all changes to the "connector_id" field.
+func (m *RefreshTokenMutation) ResetConnectorID() {
+	m.connector_id = nil
+}
+
+// SetConnectorData sets the "connector_data" field.
+func (m *RefreshTokenMutation) SetConnectorData(b []byte) {
+	m.connector_data = &b
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OAuth2ClientMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ConnectorData returns the value of the "connector_data" field in the mutation.
+func (m *RefreshTokenMutation) ConnectorData() (r []byte, exists bool) {
+	v := m.connector_data
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *OAuth2ClientMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// OldConnectorData returns the old "connector_data" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+	}
+	return oldValue.ConnectorData, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OAuth2ClientMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearConnectorData clears the value of the "connector_data" field.
+func (m *RefreshTokenMutation) ClearConnectorData() {
+	m.connector_data = nil
+	m.clearedFields[refreshtoken.FieldConnectorData] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *OAuth2ClientMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ConnectorDataCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldConnectorData]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OAuth2ClientMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetConnectorData resets all changes to the "connector_data" field.
+func (m *RefreshTokenMutation) ResetConnectorData() {
+	m.connector_data = nil
+	delete(m.clearedFields, refreshtoken.FieldConnectorData)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *OAuth2ClientMutation) EdgeCleared(name string) bool {
-	return false
+// SetToken sets the "token" field.
+func (m *RefreshTokenMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *OAuth2ClientMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown OAuth2Client unique edge %s", name)
+// Token returns the value of the "token" field in the mutation.
+func (m *RefreshTokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *OAuth2ClientMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown OAuth2Client edge %s", name)
+// OldToken returns the old "token" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
 }
 
-// OfflineSessionMutation represents an operation that mutates the OfflineSession nodes in the graph.
-type OfflineSessionMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *string
-	user_id        *string
-	conn_id        *string
-	refresh        *[]byte
-	connector_data *[]byte
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*OfflineSession, error)
-	predicates     []predicate.OfflineSession
+// ResetToken resets all changes to the "token" field.
+func (m *RefreshTokenMutation) ResetToken() {
+	m.token = nil
 }
 
-var _ ent.Mutation = (*OfflineSessionMutation)(nil)
+// SetObsoleteToken sets the "obsolete_token" field.
+func (m *RefreshTokenMutation) SetObsoleteToken(s string) {
+	m.obsolete_token = &s
+}
 
-// offlinesessionOption allows management of the mutation configuration using functional options.
-type offlinesessionOption func(*OfflineSessionMutation)
+// ObsoleteToken returns the value of the "obsolete_token" field in the mutation.
+func (m *RefreshTokenMutation) ObsoleteToken() (r string, exists bool) {
+	v := m.obsolete_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newOfflineSessionMutation creates new mutation for the OfflineSession entity.
-func newOfflineSessionMutation(c config, op Op, opts ...offlinesessionOption) *OfflineSessionMutation {
-	m := &OfflineSessionMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeOfflineSession,
-		clearedFields: make(map[string]struct{}),
+// OldObsoleteToken returns the old "obsolete_token" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldObsoleteToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObsoleteToken is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObsoleteToken requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObsoleteToken: %w", err)
+	}
+	return oldValue.ObsoleteToken, nil
 }
 
-// withOfflineSessionID sets the ID field of the mutation.
-func withOfflineSessionID(id string) offlinesessionOption {
-	return func(m *OfflineSessionMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *OfflineSession
-		)
-		m.oldValue = func(ctx context.Context) (*OfflineSession, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().OfflineSession.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetObsoleteToken resets all changes to the "obsolete_token" field.
+func (m *RefreshTokenMutation) ResetObsoleteToken() {
+	m.obsolete_token = nil
 }
 
-// withOfflineSession sets the old OfflineSession of the mutation.
-func withOfflineSession(node *OfflineSession) offlinesessionOption {
-	return func(m *OfflineSessionMutation) {
-		m.oldValue = func(context.Context) (*OfflineSession, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (m *RefreshTokenMutation) SetDpopKeyThumbprint(s string) {
+	m.dpop_key_thumbprint = &s
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OfflineSessionMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// DpopKeyThumbprint returns the value of the "dpop_key_thumbprint" field in the mutation.
+func (m *RefreshTokenMutation) DpopKeyThumbprint() (r string, exists bool) {
+	v := m.dpop_key_thumbprint
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m OfflineSessionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("db: mutation is not running in a transaction")
+// OldDpopKeyThumbprint returns the old "dpop_key_thumbprint" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldDpopKeyThumbprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDpopKeyThumbprint is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDpopKeyThumbprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDpopKeyThumbprint: %w", err)
+	}
+	return oldValue.DpopKeyThumbprint, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OfflineSession entities.
-func (m *OfflineSessionMutation) SetID(id string) {
-	m.id = &id
+// ResetDpopKeyThumbprint resets all changes to the "dpop_key_thumbprint" field.
+func (m *RefreshTokenMutation) ResetDpopKeyThumbprint() {
+	m.dpop_key_thumbprint = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *OfflineSessionMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *OfflineSessionMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OfflineSession.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *OfflineSessionMutation) SetUserID(s string) {
-	m.user_id = &s
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *OfflineSessionMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// SetLastUsed sets the "last_used" field.
+func (m *RefreshTokenMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
+}
+
+// LastUsed returns the value of the "last_used" field in the mutation.
+func (m *RefreshTokenMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsed returns the old "last_used" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *RefreshTokenMutation) OldLastUsed(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldLastUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.LastUsed, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *OfflineSessionMutation) ResetUserID() {
-	m.user_id = nil
+// ResetLastUsed resets all changes to the "last_used" field.
+func (m *RefreshTokenMutation) ResetLastUsed() {
+	m.last_used = nil
 }
 
-// SetConnID sets the "conn_id" field.
-func (m *OfflineSessionMutation) SetConnID(s string) {
-	m.conn_id = &s
+// SetAcr sets the "acr" field.
+func (m *RefreshTokenMutation) SetAcr(s string) {
+	m.acr = &s
 }
 
-// ConnID returns the value of the "conn_id" field in the mutation.
-func (m *OfflineSessionMutation) ConnID() (r string, exists bool) {
-	v := m.conn_id
+// Acr returns the value of the "acr" field in the mutation.
+func (m *RefreshTokenMutation) Acr() (r string, exists bool) {
+	v := m.acr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnID returns the old "conn_id" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldAcr returns the old "acr" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldConnID(ctx context.Context) (v string, err error) {
+func (m *RefreshTokenMutation) OldAcr(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnID requires an ID field in the mutation")
+		return v, errors.New("OldAcr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAcr: %w", err)
 	}
-	return oldValue.ConnID, nil
+	return oldValue.Acr, nil
 }
 
-// ResetConnID resets all changes to the "conn_id" field.
-func (m *OfflineSessionMutation) ResetConnID() {
-	m.conn_id = nil
+// ResetAcr resets all changes to the "acr" field.
+func (m *RefreshTokenMutation) ResetAcr() {
+	m.acr = nil
 }
 
-// SetRefresh sets the "refresh" field.
-func (m *OfflineSessionMutation) SetRefresh(b []byte) {
-	m.refresh = &b
+// SetAmr sets the "amr" field.
+func (m *RefreshTokenMutation) SetAmr(s []string) {
+	m.amr = &s
+	m.appendamr = nil
 }
 
-// Refresh returns the value of the "refresh" field in the mutation.
-func (m *OfflineSessionMutation) Refresh() (r []byte, exists bool) {
-	v := m.refresh
+// Amr returns the value of the "amr" field in the mutation.
+func (m *RefreshTokenMutation) Amr() (r []string, exists bool) {
+	v := m.amr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefresh returns the old "refresh" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldAmr returns the old "amr" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldRefresh(ctx context.Context) (v []byte, err error) {
+func (m *RefreshTokenMutation) OldAmr(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefresh is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefresh requires an ID field in the mutation")
+		return v, errors.New("OldAmr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefresh: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
 	}
-	return oldValue.Refresh, nil
+	return oldValue.Amr, nil
 }
 
-// ResetRefresh resets all changes to the "refresh" field.
-func (m *OfflineSessionMutation) ResetRefresh() {
-	m.refresh = nil
+// AppendAmr adds s to the "amr" field.
+func (m *RefreshTokenMutation) AppendAmr(s []string) {
+	m.appendamr = append(m.appendamr, s...)
 }
 
-// SetConnectorData sets the "connector_data" field.
-func (m *OfflineSessionMutation) SetConnectorData(b []byte) {
-	m.connector_data = &b
+// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
+func (m *RefreshTokenMutation) AppendedAmr() ([]string, bool) {
+	if len(m.appendamr) == 0 {
+		return nil, false
+	}
+	return m.appendamr, true
 }
 
-// ConnectorData returns the value of the "connector_data" field in the mutation.
-func (m *OfflineSessionMutation) ConnectorData() (r []byte, exists bool) {
-	v := m.connector_data
+// ClearAmr clears the value of the "amr" field.
+func (m *RefreshTokenMutation) ClearAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	m.clearedFields[refreshtoken.FieldAmr] = struct{}{}
+}
+
+// AmrCleared returns if the "amr" field was cleared in this mutation.
+func (m *RefreshTokenMutation) AmrCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldAmr]
+	return ok
+}
+
+// ResetAmr resets all changes to the "amr" field.
+func (m *RefreshTokenMutation) ResetAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	delete(m.clearedFields, refreshtoken.FieldAmr)
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (m *RefreshTokenMutation) SetAuthTime(t time.Time) {
+	m.auth_time = &t
+}
+
+// AuthTime returns the value of the "auth_time" field in the mutation.
+func (m *RefreshTokenMutation) AuthTime() (r time.Time, exists bool) {
+	v := m.auth_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorData returns the old "connector_data" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthTime returns the old "auth_time" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+func (m *RefreshTokenMutation) OldAuthTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+		return v, errors.New("OldAuthTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
 	}
-	return oldValue.ConnectorData, nil
+	return oldValue.AuthTime, nil
 }
 
-// ClearConnectorData clears the value of the "connector_data" field.
-func (m *OfflineSessionMutation) ClearConnectorData() {
-	m.connector_data = nil
-	m.clearedFields[offlinesession.FieldConnectorData] = struct{}{}
+// ClearAuthTime clears the value of the "auth_time" field.
+func (m *RefreshTokenMutation) ClearAuthTime() {
+	m.auth_time = nil
+	m.clearedFields[refreshtoken.FieldAuthTime] = struct{}{}
 }
 
-// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
-func (m *OfflineSessionMutation) ConnectorDataCleared() bool {
-	_, ok := m.clearedFields[offlinesession.FieldConnectorData]
+// AuthTimeCleared returns if the "auth_time" field was cleared in this mutation.
+func (m *RefreshTokenMutation) AuthTimeCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldAuthTime]
 	return ok
 }
 
-// ResetConnectorData resets all changes to the "connector_data" field.
-func (m *OfflineSessionMutation) ResetConnectorData() {
-	m.connector_data = nil
-	delete(m.clearedFields, offlinesession.FieldConnectorData)
+// ResetAuthTime resets all changes to the "auth_time" field.
+func (m *RefreshTokenMutation) ResetAuthTime() {
+	m.auth_time = nil
+	delete(m.clearedFields, refreshtoken.FieldAuthTime)
 }
 
-// Where appends a list predicates to the OfflineSessionMutation builder.
-func (m *OfflineSessionMutation) Where(ps ...predicate.OfflineSession) {
+// SetSid sets the "sid" field.
+func (m *RefreshTokenMutation) SetSid(s string) {
+	m.sid = &s
+}
+
+// Sid returns the value of the "sid" field in the mutation.
+func (m *RefreshTokenMutation) Sid() (r string, exists bool) {
+	v := m.sid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSid returns the old "sid" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldSid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSid: %w", err)
+	}
+	return oldValue.Sid, nil
+}
+
+// ResetSid resets all changes to the "sid" field.
+func (m *RefreshTokenMutation) ResetSid() {
+	m.sid = nil
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OfflineSessionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OfflineSessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OfflineSession, len(ps))
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6088,36 +14362,87 @@ func (m *OfflineSessionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OfflineSessionMutation) Op() Op {
+func (m *RefreshTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OfflineSessionMutation) SetOp(op Op) {
+func (m *RefreshTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OfflineSession).
-func (m *OfflineSessionMutation) Type() string {
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OfflineSessionMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.user_id != nil {
-		fields = append(fields, offlinesession.FieldUserID)
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.client_id != nil {
+		fields = append(fields, refreshtoken.FieldClientID)
 	}
-	if m.conn_id != nil {
-		fields = append(fields, offlinesession.FieldConnID)
+	if m.scopes != nil {
+		fields = append(fields, refreshtoken.FieldScopes)
 	}
-	if m.refresh != nil {
-		fields = append(fields, offlinesession.FieldRefresh)
+	if m.nonce != nil {
+		fields = append(fields, refreshtoken.FieldNonce)
+	}
+	if m.claims_user_id != nil {
+		fields = append(fields, refreshtoken.FieldClaimsUserID)
+	}
+	if m.claims_username != nil {
+		fields = append(fields, refreshtoken.FieldClaimsUsername)
+	}
+	if m.claims_email != nil {
+		fields = append(fields, refreshtoken.FieldClaimsEmail)
+	}
+	if m.claims_email_verified != nil {
+		fields = append(fields, refreshtoken.FieldClaimsEmailVerified)
+	}
+	if m.claims_groups != nil {
+		fields = append(fields, refreshtoken.FieldClaimsGroups)
+	}
+	if m.claims_extra != nil {
+		fields = append(fields, refreshtoken.FieldClaimsExtra)
+	}
+	if m.claims_preferred_username != nil {
+		fields = append(fields, refreshtoken.FieldClaimsPreferredUsername)
+	}
+	if m.connector_id != nil {
+		fields = append(fields, refreshtoken.FieldConnectorID)
 	}
 	if m.connector_data != nil {
-		fields = append(fields, offlinesession.FieldConnectorData)
+		fields = append(fields, refreshtoken.FieldConnectorData)
+	}
+	if m.token != nil {
+		fields = append(fields, refreshtoken.FieldToken)
+	}
+	if m.obsolete_token != nil {
+		fields = append(fields, refreshtoken.FieldObsoleteToken)
+	}
+	if m.dpop_key_thumbprint != nil {
+		fields = append(fields, refreshtoken.FieldDpopKeyThumbprint)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	if m.last_used != nil {
+		fields = append(fields, refreshtoken.FieldLastUsed)
+	}
+	if m.acr != nil {
+		fields = append(fields, refreshtoken.FieldAcr)
+	}
+	if m.amr != nil {
+		fields = append(fields, refreshtoken.FieldAmr)
+	}
+	if m.auth_time != nil {
+		fields = append(fields, refreshtoken.FieldAuthTime)
+	}
+	if m.sid != nil {
+		fields = append(fields, refreshtoken.FieldSid)
 	}
 	return fields
 }
@@ -6125,16 +14450,50 @@ func (m *OfflineSessionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OfflineSessionMutation) Field(name string) (ent.Value, bool) {
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case offlinesession.FieldUserID:
-		return m.UserID()
-	case offlinesession.FieldConnID:
-		return m.ConnID()
-	case offlinesession.FieldRefresh:
-		return m.Refresh()
-	case offlinesession.FieldConnectorData:
+	case refreshtoken.FieldClientID:
+		return m.ClientID()
+	case refreshtoken.FieldScopes:
+		return m.Scopes()
+	case refreshtoken.FieldNonce:
+		return m.Nonce()
+	case refreshtoken.FieldClaimsUserID:
+		return m.ClaimsUserID()
+	case refreshtoken.FieldClaimsUsername:
+		return m.ClaimsUsername()
+	case refreshtoken.FieldClaimsEmail:
+		return m.ClaimsEmail()
+	case refreshtoken.FieldClaimsEmailVerified:
+		return m.ClaimsEmailVerified()
+	case refreshtoken.FieldClaimsGroups:
+		return m.ClaimsGroups()
+	case refreshtoken.FieldClaimsExtra:
+		return m.ClaimsExtra()
+	case refreshtoken.FieldClaimsPreferredUsername:
+		return m.ClaimsPreferredUsername()
+	case refreshtoken.FieldConnectorID:
+		return m.ConnectorID()
+	case refreshtoken.FieldConnectorData:
 		return m.ConnectorData()
+	case refreshtoken.FieldToken:
+		return m.Token()
+	case refreshtoken.FieldObsoleteToken:
+		return m.ObsoleteToken()
+	case refreshtoken.FieldDpopKeyThumbprint:
+		return m.DpopKeyThumbprint()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case refreshtoken.FieldLastUsed:
+		return m.LastUsed()
+	case refreshtoken.FieldAcr:
+		return m.Acr()
+	case refreshtoken.FieldAmr:
+		return m.Amr()
+	case refreshtoken.FieldAuthTime:
+		return m.AuthTime()
+	case refreshtoken.FieldSid:
+		return m.Sid()
 	}
 	return nil, false
 }
@@ -6142,207 +14501,437 @@ func (m *OfflineSessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OfflineSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case offlinesession.FieldUserID:
-		return m.OldUserID(ctx)
-	case offlinesession.FieldConnID:
-		return m.OldConnID(ctx)
-	case offlinesession.FieldRefresh:
-		return m.OldRefresh(ctx)
-	case offlinesession.FieldConnectorData:
+	case refreshtoken.FieldClientID:
+		return m.OldClientID(ctx)
+	case refreshtoken.FieldScopes:
+		return m.OldScopes(ctx)
+	case refreshtoken.FieldNonce:
+		return m.OldNonce(ctx)
+	case refreshtoken.FieldClaimsUserID:
+		return m.OldClaimsUserID(ctx)
+	case refreshtoken.FieldClaimsUsername:
+		return m.OldClaimsUsername(ctx)
+	case refreshtoken.FieldClaimsEmail:
+		return m.OldClaimsEmail(ctx)
+	case refreshtoken.FieldClaimsEmailVerified:
+		return m.OldClaimsEmailVerified(ctx)
+	case refreshtoken.FieldClaimsGroups:
+		return m.OldClaimsGroups(ctx)
+	case refreshtoken.FieldClaimsExtra:
+		return m.OldClaimsExtra(ctx)
+	case refreshtoken.FieldClaimsPreferredUsername:
+		return m.OldClaimsPreferredUsername(ctx)
+	case refreshtoken.FieldConnectorID:
+		return m.OldConnectorID(ctx)
+	case refreshtoken.FieldConnectorData:
 		return m.OldConnectorData(ctx)
+	case refreshtoken.FieldToken:
+		return m.OldToken(ctx)
+	case refreshtoken.FieldObsoleteToken:
+		return m.OldObsoleteToken(ctx)
+	case refreshtoken.FieldDpopKeyThumbprint:
+		return m.OldDpopKeyThumbprint(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case refreshtoken.FieldLastUsed:
+		return m.OldLastUsed(ctx)
+	case refreshtoken.FieldAcr:
+		return m.OldAcr(ctx)
+	case refreshtoken.FieldAmr:
+		return m.OldAmr(ctx)
+	case refreshtoken.FieldAuthTime:
+		return m.OldAuthTime(ctx)
+	case refreshtoken.FieldSid:
+		return m.OldSid(ctx)
 	}
-	return nil, fmt.Errorf("unknown OfflineSession field %s", name)
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OfflineSessionMutation) SetField(name string, value ent.Value) error {
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case offlinesession.FieldUserID:
+	case refreshtoken.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetClientID(v)
 		return nil
-	case offlinesession.FieldConnID:
+	case refreshtoken.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case refreshtoken.FieldNonce:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnID(v)
+		m.SetNonce(v)
 		return nil
-	case offlinesession.FieldRefresh:
-		v, ok := value.([]byte)
+	case refreshtoken.FieldClaimsUserID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefresh(v)
+		m.SetClaimsUserID(v)
 		return nil
-	case offlinesession.FieldConnectorData:
+	case refreshtoken.FieldClaimsUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsUsername(v)
+		return nil
+	case refreshtoken.FieldClaimsEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsEmail(v)
+		return nil
+	case refreshtoken.FieldClaimsEmailVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsEmailVerified(v)
+		return nil
+	case refreshtoken.FieldClaimsGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsGroups(v)
+		return nil
+	case refreshtoken.FieldClaimsExtra:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsExtra(v)
+		return nil
+	case refreshtoken.FieldClaimsPreferredUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsPreferredUsername(v)
+		return nil
+	case refreshtoken.FieldConnectorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectorID(v)
+		return nil
+	case refreshtoken.FieldConnectorData:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetConnectorData(v)
 		return nil
+	case refreshtoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case refreshtoken.FieldObsoleteToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObsoleteToken(v)
+		return nil
+	case refreshtoken.FieldDpopKeyThumbprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDpopKeyThumbprint(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case refreshtoken.FieldLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsed(v)
+		return nil
+	case refreshtoken.FieldAcr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcr(v)
+		return nil
+	case refreshtoken.FieldAmr:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmr(v)
+		return nil
+	case refreshtoken.FieldAuthTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthTime(v)
+		return nil
+	case refreshtoken.FieldSid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSid(v)
+		return nil
 	}
-	return fmt.Errorf("unknown OfflineSession field %s", name)
+	return fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OfflineSessionMutation) AddedFields() []string {
+func (m *RefreshTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OfflineSessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OfflineSessionMutation) AddField(name string, value ent.Value) error {
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown OfflineSession numeric field %s", name)
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OfflineSessionMutation) ClearedFields() []string {
+func (m *RefreshTokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(offlinesession.FieldConnectorData) {
-		fields = append(fields, offlinesession.FieldConnectorData)
+	if m.FieldCleared(refreshtoken.FieldScopes) {
+		fields = append(fields, refreshtoken.FieldScopes)
+	}
+	if m.FieldCleared(refreshtoken.FieldClaimsGroups) {
+		fields = append(fields, refreshtoken.FieldClaimsGroups)
+	}
+	if m.FieldCleared(refreshtoken.FieldClaimsExtra) {
+		fields = append(fields, refreshtoken.FieldClaimsExtra)
+	}
+	if m.FieldCleared(refreshtoken.FieldConnectorData) {
+		fields = append(fields, refreshtoken.FieldConnectorData)
+	}
+	if m.FieldCleared(refreshtoken.FieldAmr) {
+		fields = append(fields, refreshtoken.FieldAmr)
+	}
+	if m.FieldCleared(refreshtoken.FieldAuthTime) {
+		fields = append(fields, refreshtoken.FieldAuthTime)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OfflineSessionMutation) FieldCleared(name string) bool {
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OfflineSessionMutation) ClearField(name string) error {
+func (m *RefreshTokenMutation) ClearField(name string) error {
 	switch name {
-	case offlinesession.FieldConnectorData:
+	case refreshtoken.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case refreshtoken.FieldClaimsGroups:
+		m.ClearClaimsGroups()
+		return nil
+	case refreshtoken.FieldClaimsExtra:
+		m.ClearClaimsExtra()
+		return nil
+	case refreshtoken.FieldConnectorData:
 		m.ClearConnectorData()
 		return nil
+	case refreshtoken.FieldAmr:
+		m.ClearAmr()
+		return nil
+	case refreshtoken.FieldAuthTime:
+		m.ClearAuthTime()
+		return nil
 	}
-	return fmt.Errorf("unknown OfflineSession nullable field %s", name)
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OfflineSessionMutation) ResetField(name string) error {
+func (m *RefreshTokenMutation) ResetField(name string) error {
 	switch name {
-	case offlinesession.FieldUserID:
-		m.ResetUserID()
+	case refreshtoken.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case offlinesession.FieldConnID:
-		m.ResetConnID()
+	case refreshtoken.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case offlinesession.FieldRefresh:
-		m.ResetRefresh()
+	case refreshtoken.FieldNonce:
+		m.ResetNonce()
 		return nil
-	case offlinesession.FieldConnectorData:
+	case refreshtoken.FieldClaimsUserID:
+		m.ResetClaimsUserID()
+		return nil
+	case refreshtoken.FieldClaimsUsername:
+		m.ResetClaimsUsername()
+		return nil
+	case refreshtoken.FieldClaimsEmail:
+		m.ResetClaimsEmail()
+		return nil
+	case refreshtoken.FieldClaimsEmailVerified:
+		m.ResetClaimsEmailVerified()
+		return nil
+	case refreshtoken.FieldClaimsGroups:
+		m.ResetClaimsGroups()
+		return nil
+	case refreshtoken.FieldClaimsExtra:
+		m.ResetClaimsExtra()
+		return nil
+	case refreshtoken.FieldClaimsPreferredUsername:
+		m.ResetClaimsPreferredUsername()
+		return nil
+	case refreshtoken.FieldConnectorID:
+		m.ResetConnectorID()
+		return nil
+	case refreshtoken.FieldConnectorData:
 		m.ResetConnectorData()
 		return nil
+	case refreshtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case refreshtoken.FieldObsoleteToken:
+		m.ResetObsoleteToken()
+		return nil
+	case refreshtoken.FieldDpopKeyThumbprint:
+		m.ResetDpopKeyThumbprint()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case refreshtoken.FieldLastUsed:
+		m.ResetLastUsed()
+		return nil
+	case refreshtoken.FieldAcr:
+		m.ResetAcr()
+		return nil
+	case refreshtoken.FieldAmr:
+		m.ResetAmr()
+		return nil
+	case refreshtoken.FieldAuthTime:
+		m.ResetAuthTime()
+		return nil
+	case refreshtoken.FieldSid:
+		m.ResetSid()
+		return nil
 	}
-	return fmt.Errorf("unknown OfflineSession field %s", name)
+	return fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OfflineSessionMutation) AddedEdges() []string {
+func (m *RefreshTokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OfflineSessionMutation) AddedIDs(name string) []ent.Value {
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OfflineSessionMutation) RemovedEdges() []string {
+func (m *RefreshTokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OfflineSessionMutation) RemovedIDs(name string) []ent.Value {
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OfflineSessionMutation) ClearedEdges() []string {
+func (m *RefreshTokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OfflineSessionMutation) EdgeCleared(name string) bool {
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OfflineSessionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown OfflineSession unique edge %s", name)
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OfflineSessionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown OfflineSession edge %s", name)
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
 }
 
-// PasswordMutation represents an operation that mutates the Password nodes in the graph.
-type PasswordMutation struct {
+// ScopeMutation represents an operation that mutates the Scope nodes in the graph.
+type ScopeMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	email              *string
-	hash               *[]byte
-	username           *string
-	name               *string
-	preferred_username *string
-	email_verified     *bool
-	user_id            *string
-	groups             *[]string
-	appendgroups       []string
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*Password, error)
-	predicates         []predicate.Password
+	op               Op
+	typ              string
+	id               *string
+	descriptions     *map[string]string
+	claims           *[]string
+	appendclaims     []string
+	client_ids       *[]string
+	appendclient_ids []string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Scope, error)
+	predicates       []predicate.Scope
 }
 
-var _ ent.Mutation = (*PasswordMutation)(nil)
+var _ ent.Mutation = (*ScopeMutation)(nil)
 
-// passwordOption allows management of the mutation configuration using functional options.
-type passwordOption func(*PasswordMutation)
+// scopeOption allows management of the mutation configuration using functional options.
+type scopeOption func(*ScopeMutation)
 
-// newPasswordMutation creates new mutation for the Password entity.
-func newPasswordMutation(c config, op Op, opts ...passwordOption) *PasswordMutation {
-	m := &PasswordMutation{
+// newScopeMutation creates new mutation for the Scope entity.
+func newScopeMutation(c config, op Op, opts ...scopeOption) *ScopeMutation {
+	m := &ScopeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePassword,
+		typ:           TypeScope,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6351,20 +14940,20 @@ func newPasswordMutation(c config, op Op, opts ...passwordOption) *PasswordMutat
 	return m
 }
 
-// withPasswordID sets the ID field of the mutation.
-func withPasswordID(id int) passwordOption {
-	return func(m *PasswordMutation) {
+// withScopeID sets the ID field of the mutation.
+func withScopeID(id string) scopeOption {
+	return func(m *ScopeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Password
+			value *Scope
 		)
-		m.oldValue = func(ctx context.Context) (*Password, error) {
+		m.oldValue = func(ctx context.Context) (*Scope, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Password.Get(ctx, id)
+					value, err = m.Client().Scope.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6373,10 +14962,10 @@ func withPasswordID(id int) passwordOption {
 	}
 }
 
-// withPassword sets the old Password of the mutation.
-func withPassword(node *Password) passwordOption {
-	return func(m *PasswordMutation) {
-		m.oldValue = func(context.Context) (*Password, error) {
+// withScope sets the old Scope of the mutation.
+func withScope(node *Scope) scopeOption {
+	return func(m *ScopeMutation) {
+		m.oldValue = func(context.Context) (*Scope, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6385,7 +14974,7 @@ func withPassword(node *Password) passwordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasswordMutation) Client() *Client {
+func (m ScopeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6393,382 +14982,237 @@ func (m PasswordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PasswordMutation) Tx() (*Tx, error) {
+func (m ScopeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
 	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PasswordMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PasswordMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Password.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetEmail sets the "email" field.
-func (m *PasswordMutation) SetEmail(s string) {
-	m.email = &s
-}
-
-// Email returns the value of the "email" field in the mutation.
-func (m *PasswordMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEmail returns the old "email" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
-	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *PasswordMutation) ResetEmail() {
-	m.email = nil
-}
-
-// SetHash sets the "hash" field.
-func (m *PasswordMutation) SetHash(b []byte) {
-	m.hash = &b
-}
-
-// Hash returns the value of the "hash" field in the mutation.
-func (m *PasswordMutation) Hash() (r []byte, exists bool) {
-	v := m.hash
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldHash returns the old "hash" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldHash(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHash is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHash requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHash: %w", err)
-	}
-	return oldValue.Hash, nil
-}
-
-// ResetHash resets all changes to the "hash" field.
-func (m *PasswordMutation) ResetHash() {
-	m.hash = nil
-}
-
-// SetUsername sets the "username" field.
-func (m *PasswordMutation) SetUsername(s string) {
-	m.username = &s
-}
-
-// Username returns the value of the "username" field in the mutation.
-func (m *PasswordMutation) Username() (r string, exists bool) {
-	v := m.username
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUsername returns the old "username" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
-	}
-	return oldValue.Username, nil
-}
-
-// ResetUsername resets all changes to the "username" field.
-func (m *PasswordMutation) ResetUsername() {
-	m.username = nil
-}
-
-// SetName sets the "name" field.
-func (m *PasswordMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *PasswordMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *PasswordMutation) ResetName() {
-	m.name = nil
-}
-
-// SetPreferredUsername sets the "preferred_username" field.
-func (m *PasswordMutation) SetPreferredUsername(s string) {
-	m.preferred_username = &s
-}
-
-// PreferredUsername returns the value of the "preferred_username" field in the mutation.
-func (m *PasswordMutation) PreferredUsername() (r string, exists bool) {
-	v := m.preferred_username
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPreferredUsername returns the old "preferred_username" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldPreferredUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreferredUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreferredUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreferredUsername: %w", err)
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Scope entities.
+func (m *ScopeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScopeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.PreferredUsername, nil
+	return *m.id, true
 }
 
-// ResetPreferredUsername resets all changes to the "preferred_username" field.
-func (m *PasswordMutation) ResetPreferredUsername() {
-	m.preferred_username = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScopeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Scope.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetEmailVerified sets the "email_verified" field.
-func (m *PasswordMutation) SetEmailVerified(b bool) {
-	m.email_verified = &b
+// SetDescriptions sets the "descriptions" field.
+func (m *ScopeMutation) SetDescriptions(value map[string]string) {
+	m.descriptions = &value
 }
 
-// EmailVerified returns the value of the "email_verified" field in the mutation.
-func (m *PasswordMutation) EmailVerified() (r bool, exists bool) {
-	v := m.email_verified
+// Descriptions returns the value of the "descriptions" field in the mutation.
+func (m *ScopeMutation) Descriptions() (r map[string]string, exists bool) {
+	v := m.descriptions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmailVerified returns the old "email_verified" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// OldDescriptions returns the old "descriptions" field's value of the Scope entity.
+// If the Scope object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldEmailVerified(ctx context.Context) (v *bool, err error) {
+func (m *ScopeMutation) OldDescriptions(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmailVerified is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescriptions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmailVerified requires an ID field in the mutation")
+		return v, errors.New("OldDescriptions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmailVerified: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescriptions: %w", err)
 	}
-	return oldValue.EmailVerified, nil
+	return oldValue.Descriptions, nil
 }
 
-// ClearEmailVerified clears the value of the "email_verified" field.
-func (m *PasswordMutation) ClearEmailVerified() {
-	m.email_verified = nil
-	m.clearedFields[password.FieldEmailVerified] = struct{}{}
+// ClearDescriptions clears the value of the "descriptions" field.
+func (m *ScopeMutation) ClearDescriptions() {
+	m.descriptions = nil
+	m.clearedFields[scope.FieldDescriptions] = struct{}{}
 }
 
-// EmailVerifiedCleared returns if the "email_verified" field was cleared in this mutation.
-func (m *PasswordMutation) EmailVerifiedCleared() bool {
-	_, ok := m.clearedFields[password.FieldEmailVerified]
+// DescriptionsCleared returns if the "descriptions" field was cleared in this mutation.
+func (m *ScopeMutation) DescriptionsCleared() bool {
+	_, ok := m.clearedFields[scope.FieldDescriptions]
 	return ok
 }
 
-// ResetEmailVerified resets all changes to the "email_verified" field.
-func (m *PasswordMutation) ResetEmailVerified() {
-	m.email_verified = nil
-	delete(m.clearedFields, password.FieldEmailVerified)
+// ResetDescriptions resets all changes to the "descriptions" field.
+func (m *ScopeMutation) ResetDescriptions() {
+	m.descriptions = nil
+	delete(m.clearedFields, scope.FieldDescriptions)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PasswordMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetClaims sets the "claims" field.
+func (m *ScopeMutation) SetClaims(s []string) {
+	m.claims = &s
+	m.appendclaims = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PasswordMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// Claims returns the value of the "claims" field in the mutation.
+func (m *ScopeMutation) Claims() (r []string, exists bool) {
+	v := m.claims
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// OldClaims returns the old "claims" field's value of the Scope entity.
+// If the Scope object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *ScopeMutation) OldClaims(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaims is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldClaims requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaims: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Claims, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PasswordMutation) ResetUserID() {
-	m.user_id = nil
+// AppendClaims adds s to the "claims" field.
+func (m *ScopeMutation) AppendClaims(s []string) {
+	m.appendclaims = append(m.appendclaims, s...)
 }
 
-// SetGroups sets the "groups" field.
-func (m *PasswordMutation) SetGroups(s []string) {
-	m.groups = &s
-	m.appendgroups = nil
+// AppendedClaims returns the list of values that were appended to the "claims" field in this mutation.
+func (m *ScopeMutation) AppendedClaims() ([]string, bool) {
+	if len(m.appendclaims) == 0 {
+		return nil, false
+	}
+	return m.appendclaims, true
 }
 
-// Groups returns the value of the "groups" field in the mutation.
-func (m *PasswordMutation) Groups() (r []string, exists bool) {
-	v := m.groups
+// ClearClaims clears the value of the "claims" field.
+func (m *ScopeMutation) ClearClaims() {
+	m.claims = nil
+	m.appendclaims = nil
+	m.clearedFields[scope.FieldClaims] = struct{}{}
+}
+
+// ClaimsCleared returns if the "claims" field was cleared in this mutation.
+func (m *ScopeMutation) ClaimsCleared() bool {
+	_, ok := m.clearedFields[scope.FieldClaims]
+	return ok
+}
+
+// ResetClaims resets all changes to the "claims" field.
+func (m *ScopeMutation) ResetClaims() {
+	m.claims = nil
+	m.appendclaims = nil
+	delete(m.clearedFields, scope.FieldClaims)
+}
+
+// SetClientIds sets the "client_ids" field.
+func (m *ScopeMutation) SetClientIds(s []string) {
+	m.client_ids = &s
+	m.appendclient_ids = nil
+}
+
+// ClientIds returns the value of the "client_ids" field in the mutation.
+func (m *ScopeMutation) ClientIds() (r []string, exists bool) {
+	v := m.client_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroups returns the old "groups" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// OldClientIds returns the old "client_ids" field's value of the Scope entity.
+// If the Scope object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldGroups(ctx context.Context) (v []string, err error) {
+func (m *ScopeMutation) OldClientIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroups is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroups requires an ID field in the mutation")
+		return v, errors.New("OldClientIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroups: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientIds: %w", err)
 	}
-	return oldValue.Groups, nil
+	return oldValue.ClientIds, nil
 }
 
-// AppendGroups adds s to the "groups" field.
-func (m *PasswordMutation) AppendGroups(s []string) {
-	m.appendgroups = append(m.appendgroups, s...)
+// AppendClientIds adds s to the "client_ids" field.
+func (m *ScopeMutation) AppendClientIds(s []string) {
+	m.appendclient_ids = append(m.appendclient_ids, s...)
 }
 
-// AppendedGroups returns the list of values that were appended to the "groups" field in this mutation.
-func (m *PasswordMutation) AppendedGroups() ([]string, bool) {
-	if len(m.appendgroups) == 0 {
+// AppendedClientIds returns the list of values that were appended to the "client_ids" field in this mutation.
+func (m *ScopeMutation) AppendedClientIds() ([]string, bool) {
+	if len(m.appendclient_ids) == 0 {
 		return nil, false
 	}
-	return m.appendgroups, true
+	return m.appendclient_ids, true
 }
 
-// ClearGroups clears the value of the "groups" field.
-func (m *PasswordMutation) ClearGroups() {
-	m.groups = nil
-	m.appendgroups = nil
-	m.clearedFields[password.FieldGroups] = struct{}{}
+// ClearClientIds clears the value of the "client_ids" field.
+func (m *ScopeMutation) ClearClientIds() {
+	m.client_ids = nil
+	m.appendclient_ids = nil
+	m.clearedFields[scope.FieldClientIds] = struct{}{}
 }
 
-// GroupsCleared returns if the "groups" field was cleared in this mutation.
-func (m *PasswordMutation) GroupsCleared() bool {
-	_, ok := m.clearedFields[password.FieldGroups]
+// ClientIdsCleared returns if the "client_ids" field was cleared in this mutation.
+func (m *ScopeMutation) ClientIdsCleared() bool {
+	_, ok := m.clearedFields[scope.FieldClientIds]
 	return ok
 }
 
-// ResetGroups resets all changes to the "groups" field.
-func (m *PasswordMutation) ResetGroups() {
-	m.groups = nil
-	m.appendgroups = nil
-	delete(m.clearedFields, password.FieldGroups)
+// ResetClientIds resets all changes to the "client_ids" field.
+func (m *ScopeMutation) ResetClientIds() {
+	m.client_ids = nil
+	m.appendclient_ids = nil
+	delete(m.clearedFields, scope.FieldClientIds)
 }
 
-// Where appends a list predicates to the PasswordMutation builder.
-func (m *PasswordMutation) Where(ps ...predicate.Password) {
+// Where appends a list predicates to the ScopeMutation builder.
+func (m *ScopeMutation) Where(ps ...predicate.Scope) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PasswordMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ScopeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PasswordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Password, len(ps))
+func (m *ScopeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Scope, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6776,343 +15220,267 @@ func (m *PasswordMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PasswordMutation) Op() Op {
+func (m *ScopeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PasswordMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Password).
-func (m *PasswordMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PasswordMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.email != nil {
-		fields = append(fields, password.FieldEmail)
-	}
-	if m.hash != nil {
-		fields = append(fields, password.FieldHash)
-	}
-	if m.username != nil {
-		fields = append(fields, password.FieldUsername)
-	}
-	if m.name != nil {
-		fields = append(fields, password.FieldName)
-	}
-	if m.preferred_username != nil {
-		fields = append(fields, password.FieldPreferredUsername)
-	}
-	if m.email_verified != nil {
-		fields = append(fields, password.FieldEmailVerified)
-	}
-	if m.user_id != nil {
-		fields = append(fields, password.FieldUserID)
-	}
-	if m.groups != nil {
-		fields = append(fields, password.FieldGroups)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PasswordMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case password.FieldEmail:
-		return m.Email()
-	case password.FieldHash:
-		return m.Hash()
-	case password.FieldUsername:
-		return m.Username()
-	case password.FieldName:
-		return m.Name()
-	case password.FieldPreferredUsername:
-		return m.PreferredUsername()
-	case password.FieldEmailVerified:
-		return m.EmailVerified()
-	case password.FieldUserID:
-		return m.UserID()
-	case password.FieldGroups:
-		return m.Groups()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PasswordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case password.FieldEmail:
-		return m.OldEmail(ctx)
-	case password.FieldHash:
-		return m.OldHash(ctx)
-	case password.FieldUsername:
-		return m.OldUsername(ctx)
-	case password.FieldName:
-		return m.OldName(ctx)
-	case password.FieldPreferredUsername:
-		return m.OldPreferredUsername(ctx)
-	case password.FieldEmailVerified:
-		return m.OldEmailVerified(ctx)
-	case password.FieldUserID:
-		return m.OldUserID(ctx)
-	case password.FieldGroups:
-		return m.OldGroups(ctx)
-	}
-	return nil, fmt.Errorf("unknown Password field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PasswordMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case password.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case password.FieldHash:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHash(v)
-		return nil
-	case password.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case password.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case password.FieldPreferredUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPreferredUsername(v)
-		return nil
-	case password.FieldEmailVerified:
-		v, ok := value.(bool)
+func (m *ScopeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Scope).
+func (m *ScopeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScopeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.descriptions != nil {
+		fields = append(fields, scope.FieldDescriptions)
+	}
+	if m.claims != nil {
+		fields = append(fields, scope.FieldClaims)
+	}
+	if m.client_ids != nil {
+		fields = append(fields, scope.FieldClientIds)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScopeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scope.FieldDescriptions:
+		return m.Descriptions()
+	case scope.FieldClaims:
+		return m.Claims()
+	case scope.FieldClientIds:
+		return m.ClientIds()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScopeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scope.FieldDescriptions:
+		return m.OldDescriptions(ctx)
+	case scope.FieldClaims:
+		return m.OldClaims(ctx)
+	case scope.FieldClientIds:
+		return m.OldClientIds(ctx)
+	}
+	return nil, fmt.Errorf("unknown Scope field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScopeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scope.FieldDescriptions:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmailVerified(v)
+		m.SetDescriptions(v)
 		return nil
-	case password.FieldUserID:
-		v, ok := value.(string)
+	case scope.FieldClaims:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetClaims(v)
 		return nil
-	case password.FieldGroups:
+	case scope.FieldClientIds:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroups(v)
+		m.SetClientIds(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Password field %s", name)
+	return fmt.Errorf("unknown Scope field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PasswordMutation) AddedFields() []string {
+func (m *ScopeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PasswordMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScopeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordMutation) AddField(name string, value ent.Value) error {
+func (m *ScopeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Password numeric field %s", name)
+	return fmt.Errorf("unknown Scope numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PasswordMutation) ClearedFields() []string {
+func (m *ScopeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(password.FieldEmailVerified) {
-		fields = append(fields, password.FieldEmailVerified)
+	if m.FieldCleared(scope.FieldDescriptions) {
+		fields = append(fields, scope.FieldDescriptions)
 	}
-	if m.FieldCleared(password.FieldGroups) {
-		fields = append(fields, password.FieldGroups)
+	if m.FieldCleared(scope.FieldClaims) {
+		fields = append(fields, scope.FieldClaims)
+	}
+	if m.FieldCleared(scope.FieldClientIds) {
+		fields = append(fields, scope.FieldClientIds)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PasswordMutation) FieldCleared(name string) bool {
+func (m *ScopeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PasswordMutation) ClearField(name string) error {
+func (m *ScopeMutation) ClearField(name string) error {
 	switch name {
-	case password.FieldEmailVerified:
-		m.ClearEmailVerified()
+	case scope.FieldDescriptions:
+		m.ClearDescriptions()
 		return nil
-	case password.FieldGroups:
-		m.ClearGroups()
+	case scope.FieldClaims:
+		m.ClearClaims()
+		return nil
+	case scope.FieldClientIds:
+		m.ClearClientIds()
 		return nil
 	}
-	return fmt.Errorf("unknown Password nullable field %s", name)
+	return fmt.Errorf("unknown Scope nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PasswordMutation) ResetField(name string) error {
+func (m *ScopeMutation) ResetField(name string) error {
 	switch name {
-	case password.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case password.FieldHash:
-		m.ResetHash()
-		return nil
-	case password.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case password.FieldName:
-		m.ResetName()
+	case scope.FieldDescriptions:
+		m.ResetDescriptions()
 		return nil
-	case password.FieldPreferredUsername:
-		m.ResetPreferredUsername()
-		return nil
-	case password.FieldEmailVerified:
-		m.ResetEmailVerified()
-		return nil
-	case password.FieldUserID:
-		m.ResetUserID()
+	case scope.FieldClaims:
+		m.ResetClaims()
 		return nil
-	case password.FieldGroups:
-		m.ResetGroups()
+	case scope.FieldClientIds:
+		m.ResetClientIds()
 		return nil
 	}
-	return fmt.Errorf("unknown Password field %s", name)
+	return fmt.Errorf("unknown Scope field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasswordMutation) AddedEdges() []string {
+func (m *ScopeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PasswordMutation) AddedIDs(name string) []ent.Value {
+func (m *ScopeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasswordMutation) RemovedEdges() []string {
+func (m *ScopeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PasswordMutation) RemovedIDs(name string) []ent.Value {
+func (m *ScopeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasswordMutation) ClearedEdges() []string {
+func (m *ScopeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PasswordMutation) EdgeCleared(name string) bool {
+func (m *ScopeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PasswordMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Password unique edge %s", name)
+func (m *ScopeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Scope unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PasswordMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Password edge %s", name)
+func (m *ScopeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Scope edge %s", name)
 }
 
-// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
-type RefreshTokenMutation struct {
+// UserSessionMutation represents an operation that mutates the UserSession nodes in the graph.
+type UserSessionMutation struct {
 	config
 	op                        Op
 	typ                       string
 	id                        *string
-	client_id                 *string
-	scopes                    *[]string
-	appendscopes              []string
-	nonce                     *string
+	connector_id              *string
+	connector_data            *[]byte
 	claims_user_id            *string
 	claims_username           *string
+	claims_preferred_username *string
 	claims_email              *string
 	claims_email_verified     *bool
 	claims_groups             *[]string
 	appendclaims_groups       []string
-	claims_preferred_username *string
-	connector_id              *string
-	connector_data            *[]byte
-	token                     *string
-	obsolete_token            *string
+	claims_extra              *map[string]interface{}
 	created_at                *time.Time
 	last_used                 *time.Time
+	expiry                    *time.Time
+	acr                       *string
+	amr                       *[]string
+	appendamr                 []string
+	auth_time                 *time.Time
+	sid                       *string
+	client_ids                *[]string
+	appendclient_ids          []string
 	clearedFields             map[string]struct{}
 	done                      bool
-	oldValue                  func(context.Context) (*RefreshToken, error)
-	predicates                []predicate.RefreshToken
+	oldValue                  func(context.Context) (*UserSession, error)
+	predicates                []predicate.UserSession
 }
 
-var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+var _ ent.Mutation = (*UserSessionMutation)(nil)
 
-// refreshtokenOption allows management of the mutation configuration using functional options.
-type refreshtokenOption func(*RefreshTokenMutation)
+// usersessionOption allows management of the mutation configuration using functional options.
+type usersessionOption func(*UserSessionMutation)
 
-// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
-func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
-	m := &RefreshTokenMutation{
+// newUserSessionMutation creates new mutation for the UserSession entity.
+func newUserSessionMutation(c config, op Op, opts ...usersessionOption) *UserSessionMutation {
+	m := &UserSessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRefreshToken,
+		typ:           TypeUserSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7121,20 +15489,20 @@ func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *Refre
 	return m
 }
 
-// withRefreshTokenID sets the ID field of the mutation.
-func withRefreshTokenID(id string) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
+// withUserSessionID sets the ID field of the mutation.
+func withUserSessionID(id string) usersessionOption {
+	return func(m *UserSessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RefreshToken
+			value *UserSession
 		)
-		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+		m.oldValue = func(ctx context.Context) (*UserSession, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RefreshToken.Get(ctx, id)
+					value, err = m.Client().UserSession.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7143,10 +15511,10 @@ func withRefreshTokenID(id string) refreshtokenOption {
 	}
 }
 
-// withRefreshToken sets the old RefreshToken of the mutation.
-func withRefreshToken(node *RefreshToken) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
-		m.oldValue = func(context.Context) (*RefreshToken, error) {
+// withUserSession sets the old UserSession of the mutation.
+func withUserSession(node *UserSession) usersessionOption {
+	return func(m *UserSessionMutation) {
+		m.oldValue = func(context.Context) (*UserSession, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7155,7 +15523,7 @@ func withRefreshToken(node *RefreshToken) refreshtokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RefreshTokenMutation) Client() *Client {
+func (m UserSessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7163,7 +15531,7 @@ func (m RefreshTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RefreshTokenMutation) Tx() (*Tx, error) {
+func (m UserSessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -7173,14 +15541,14 @@ func (m RefreshTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RefreshToken entities.
-func (m *RefreshTokenMutation) SetID(id string) {
+// operation is only accepted on creation of UserSession entities.
+func (m *UserSessionMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RefreshTokenMutation) ID() (id string, exists bool) {
+func (m *UserSessionMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7191,7 +15559,7 @@ func (m *RefreshTokenMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UserSessionMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7200,156 +15568,104 @@ func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserSession.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *RefreshTokenMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetConnectorID sets the "connector_id" field.
+func (m *UserSessionMutation) SetConnectorID(s string) {
+	m.connector_id = &s
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *RefreshTokenMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// ConnectorID returns the value of the "connector_id" field in the mutation.
+func (m *UserSessionMutation) ConnectorID() (r string, exists bool) {
+	v := m.connector_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectorID returns the old "connector_id" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldConnectorID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldConnectorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.ConnectorID, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *RefreshTokenMutation) ResetClientID() {
-	m.client_id = nil
+// ResetConnectorID resets all changes to the "connector_id" field.
+func (m *UserSessionMutation) ResetConnectorID() {
+	m.connector_id = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *RefreshTokenMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// SetConnectorData sets the "connector_data" field.
+func (m *UserSessionMutation) SetConnectorData(b []byte) {
+	m.connector_data = &b
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *RefreshTokenMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// ConnectorData returns the value of the "connector_data" field in the mutation.
+func (m *UserSessionMutation) ConnectorData() (r []byte, exists bool) {
+	v := m.connector_data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectorData returns the old "connector_data" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *UserSessionMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldConnectorData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
-	}
-	return oldValue.Scopes, nil
-}
-
-// AppendScopes adds s to the "scopes" field.
-func (m *RefreshTokenMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
-}
-
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *RefreshTokenMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
 	}
-	return m.appendscopes, true
+	return oldValue.ConnectorData, nil
 }
 
-// ClearScopes clears the value of the "scopes" field.
-func (m *RefreshTokenMutation) ClearScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	m.clearedFields[refreshtoken.FieldScopes] = struct{}{}
+// ClearConnectorData clears the value of the "connector_data" field.
+func (m *UserSessionMutation) ClearConnectorData() {
+	m.connector_data = nil
+	m.clearedFields[usersession.FieldConnectorData] = struct{}{}
 }
 
-// ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *RefreshTokenMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldScopes]
+// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
+func (m *UserSessionMutation) ConnectorDataCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldConnectorData]
 	return ok
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *RefreshTokenMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	delete(m.clearedFields, refreshtoken.FieldScopes)
-}
-
-// SetNonce sets the "nonce" field.
-func (m *RefreshTokenMutation) SetNonce(s string) {
-	m.nonce = &s
-}
-
-// Nonce returns the value of the "nonce" field in the mutation.
-func (m *RefreshTokenMutation) Nonce() (r string, exists bool) {
-	v := m.nonce
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldNonce returns the old "nonce" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldNonce(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNonce requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
-	}
-	return oldValue.Nonce, nil
-}
-
-// ResetNonce resets all changes to the "nonce" field.
-func (m *RefreshTokenMutation) ResetNonce() {
-	m.nonce = nil
+// ResetConnectorData resets all changes to the "connector_data" field.
+func (m *UserSessionMutation) ResetConnectorData() {
+	m.connector_data = nil
+	delete(m.clearedFields, usersession.FieldConnectorData)
 }
 
 // SetClaimsUserID sets the "claims_user_id" field.
-func (m *RefreshTokenMutation) SetClaimsUserID(s string) {
+func (m *UserSessionMutation) SetClaimsUserID(s string) {
 	m.claims_user_id = &s
 }
 
 // ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsUserID() (r string, exists bool) {
+func (m *UserSessionMutation) ClaimsUserID() (r string, exists bool) {
 	v := m.claims_user_id
 	if v == nil {
 		return
@@ -7357,10 +15673,10 @@ func (m *RefreshTokenMutation) ClaimsUserID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsUserID returns the old "claims_user_id" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUserID returns the old "claims_user_id" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
 	}
@@ -7375,17 +15691,17 @@ func (m *RefreshTokenMutation) OldClaimsUserID(ctx context.Context) (v string, e
 }
 
 // ResetClaimsUserID resets all changes to the "claims_user_id" field.
-func (m *RefreshTokenMutation) ResetClaimsUserID() {
+func (m *UserSessionMutation) ResetClaimsUserID() {
 	m.claims_user_id = nil
 }
 
 // SetClaimsUsername sets the "claims_username" field.
-func (m *RefreshTokenMutation) SetClaimsUsername(s string) {
+func (m *UserSessionMutation) SetClaimsUsername(s string) {
 	m.claims_username = &s
 }
 
 // ClaimsUsername returns the value of the "claims_username" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsUsername() (r string, exists bool) {
+func (m *UserSessionMutation) ClaimsUsername() (r string, exists bool) {
 	v := m.claims_username
 	if v == nil {
 		return
@@ -7393,10 +15709,10 @@ func (m *RefreshTokenMutation) ClaimsUsername() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsUsername returns the old "claims_username" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsUsername returns the old "claims_username" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
 	}
@@ -7411,17 +15727,53 @@ func (m *RefreshTokenMutation) OldClaimsUsername(ctx context.Context) (v string,
 }
 
 // ResetClaimsUsername resets all changes to the "claims_username" field.
-func (m *RefreshTokenMutation) ResetClaimsUsername() {
+func (m *UserSessionMutation) ResetClaimsUsername() {
 	m.claims_username = nil
 }
 
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (m *UserSessionMutation) SetClaimsPreferredUsername(s string) {
+	m.claims_preferred_username = &s
+}
+
+// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
+func (m *UserSessionMutation) ClaimsPreferredUsername() (r string, exists bool) {
+	v := m.claims_preferred_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSessionMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
+	}
+	return oldValue.ClaimsPreferredUsername, nil
+}
+
+// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
+func (m *UserSessionMutation) ResetClaimsPreferredUsername() {
+	m.claims_preferred_username = nil
+}
+
 // SetClaimsEmail sets the "claims_email" field.
-func (m *RefreshTokenMutation) SetClaimsEmail(s string) {
+func (m *UserSessionMutation) SetClaimsEmail(s string) {
 	m.claims_email = &s
 }
 
 // ClaimsEmail returns the value of the "claims_email" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsEmail() (r string, exists bool) {
+func (m *UserSessionMutation) ClaimsEmail() (r string, exists bool) {
 	v := m.claims_email
 	if v == nil {
 		return
@@ -7429,10 +15781,10 @@ func (m *RefreshTokenMutation) ClaimsEmail() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsEmail returns the old "claims_email" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmail returns the old "claims_email" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
 	}
@@ -7447,17 +15799,17 @@ func (m *RefreshTokenMutation) OldClaimsEmail(ctx context.Context) (v string, er
 }
 
 // ResetClaimsEmail resets all changes to the "claims_email" field.
-func (m *RefreshTokenMutation) ResetClaimsEmail() {
+func (m *UserSessionMutation) ResetClaimsEmail() {
 	m.claims_email = nil
 }
 
 // SetClaimsEmailVerified sets the "claims_email_verified" field.
-func (m *RefreshTokenMutation) SetClaimsEmailVerified(b bool) {
+func (m *UserSessionMutation) SetClaimsEmailVerified(b bool) {
 	m.claims_email_verified = &b
 }
 
 // ClaimsEmailVerified returns the value of the "claims_email_verified" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsEmailVerified() (r bool, exists bool) {
+func (m *UserSessionMutation) ClaimsEmailVerified() (r bool, exists bool) {
 	v := m.claims_email_verified
 	if v == nil {
 		return
@@ -7465,10 +15817,10 @@ func (m *RefreshTokenMutation) ClaimsEmailVerified() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
+func (m *UserSessionMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsEmailVerified is only allowed on UpdateOne operations")
 	}
@@ -7483,18 +15835,18 @@ func (m *RefreshTokenMutation) OldClaimsEmailVerified(ctx context.Context) (v bo
 }
 
 // ResetClaimsEmailVerified resets all changes to the "claims_email_verified" field.
-func (m *RefreshTokenMutation) ResetClaimsEmailVerified() {
+func (m *UserSessionMutation) ResetClaimsEmailVerified() {
 	m.claims_email_verified = nil
 }
 
 // SetClaimsGroups sets the "claims_groups" field.
-func (m *RefreshTokenMutation) SetClaimsGroups(s []string) {
+func (m *UserSessionMutation) SetClaimsGroups(s []string) {
 	m.claims_groups = &s
 	m.appendclaims_groups = nil
 }
 
 // ClaimsGroups returns the value of the "claims_groups" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsGroups() (r []string, exists bool) {
+func (m *UserSessionMutation) ClaimsGroups() (r []string, exists bool) {
 	v := m.claims_groups
 	if v == nil {
 		return
@@ -7502,10 +15854,10 @@ func (m *RefreshTokenMutation) ClaimsGroups() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldClaimsGroups returns the old "claims_groups" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsGroups returns the old "claims_groups" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
+func (m *UserSessionMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClaimsGroups is only allowed on UpdateOne operations")
 	}
@@ -7520,12 +15872,12 @@ func (m *RefreshTokenMutation) OldClaimsGroups(ctx context.Context) (v []string,
 }
 
 // AppendClaimsGroups adds s to the "claims_groups" field.
-func (m *RefreshTokenMutation) AppendClaimsGroups(s []string) {
+func (m *UserSessionMutation) AppendClaimsGroups(s []string) {
 	m.appendclaims_groups = append(m.appendclaims_groups, s...)
 }
 
 // AppendedClaimsGroups returns the list of values that were appended to the "claims_groups" field in this mutation.
-func (m *RefreshTokenMutation) AppendedClaimsGroups() ([]string, bool) {
+func (m *UserSessionMutation) AppendedClaimsGroups() ([]string, bool) {
 	if len(m.appendclaims_groups) == 0 {
 		return nil, false
 	}
@@ -7533,299 +15885,442 @@ func (m *RefreshTokenMutation) AppendedClaimsGroups() ([]string, bool) {
 }
 
 // ClearClaimsGroups clears the value of the "claims_groups" field.
-func (m *RefreshTokenMutation) ClearClaimsGroups() {
+func (m *UserSessionMutation) ClearClaimsGroups() {
 	m.claims_groups = nil
 	m.appendclaims_groups = nil
-	m.clearedFields[refreshtoken.FieldClaimsGroups] = struct{}{}
+	m.clearedFields[usersession.FieldClaimsGroups] = struct{}{}
 }
 
 // ClaimsGroupsCleared returns if the "claims_groups" field was cleared in this mutation.
-func (m *RefreshTokenMutation) ClaimsGroupsCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldClaimsGroups]
+func (m *UserSessionMutation) ClaimsGroupsCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldClaimsGroups]
 	return ok
 }
 
 // ResetClaimsGroups resets all changes to the "claims_groups" field.
-func (m *RefreshTokenMutation) ResetClaimsGroups() {
+func (m *UserSessionMutation) ResetClaimsGroups() {
 	m.claims_groups = nil
 	m.appendclaims_groups = nil
-	delete(m.clearedFields, refreshtoken.FieldClaimsGroups)
+	delete(m.clearedFields, usersession.FieldClaimsGroups)
 }
 
-// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
-func (m *RefreshTokenMutation) SetClaimsPreferredUsername(s string) {
-	m.claims_preferred_username = &s
+// SetClaimsExtra sets the "claims_extra" field.
+func (m *UserSessionMutation) SetClaimsExtra(value map[string]interface{}) {
+	m.claims_extra = &value
 }
 
-// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsPreferredUsername() (r string, exists bool) {
-	v := m.claims_preferred_username
+// ClaimsExtra returns the value of the "claims_extra" field in the mutation.
+func (m *UserSessionMutation) ClaimsExtra() (r map[string]interface{}, exists bool) {
+	v := m.claims_extra
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimsExtra returns the old "claims_extra" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldClaimsExtra(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimsExtra is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
+		return v, errors.New("OldClaimsExtra requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimsExtra: %w", err)
 	}
-	return oldValue.ClaimsPreferredUsername, nil
+	return oldValue.ClaimsExtra, nil
 }
 
-// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
-func (m *RefreshTokenMutation) ResetClaimsPreferredUsername() {
-	m.claims_preferred_username = nil
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (m *UserSessionMutation) ClearClaimsExtra() {
+	m.claims_extra = nil
+	m.clearedFields[usersession.FieldClaimsExtra] = struct{}{}
 }
 
-// SetConnectorID sets the "connector_id" field.
-func (m *RefreshTokenMutation) SetConnectorID(s string) {
-	m.connector_id = &s
+// ClaimsExtraCleared returns if the "claims_extra" field was cleared in this mutation.
+func (m *UserSessionMutation) ClaimsExtraCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldClaimsExtra]
+	return ok
 }
 
-// ConnectorID returns the value of the "connector_id" field in the mutation.
-func (m *RefreshTokenMutation) ConnectorID() (r string, exists bool) {
-	v := m.connector_id
+// ResetClaimsExtra resets all changes to the "claims_extra" field.
+func (m *UserSessionMutation) ResetClaimsExtra() {
+	m.claims_extra = nil
+	delete(m.clearedFields, usersession.FieldClaimsExtra)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserSessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserSessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorID returns the old "connector_id" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldConnectorID(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ConnectorID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetConnectorID resets all changes to the "connector_id" field.
-func (m *RefreshTokenMutation) ResetConnectorID() {
-	m.connector_id = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserSessionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetConnectorData sets the "connector_data" field.
-func (m *RefreshTokenMutation) SetConnectorData(b []byte) {
-	m.connector_data = &b
+// SetLastUsed sets the "last_used" field.
+func (m *UserSessionMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
 }
 
-// ConnectorData returns the value of the "connector_data" field in the mutation.
-func (m *RefreshTokenMutation) ConnectorData() (r []byte, exists bool) {
-	v := m.connector_data
+// LastUsed returns the value of the "last_used" field in the mutation.
+func (m *UserSessionMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorData returns the old "connector_data" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsed returns the old "last_used" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+func (m *UserSessionMutation) OldLastUsed(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+		return v, errors.New("OldLastUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
 	}
-	return oldValue.ConnectorData, nil
+	return oldValue.LastUsed, nil
 }
 
-// ClearConnectorData clears the value of the "connector_data" field.
-func (m *RefreshTokenMutation) ClearConnectorData() {
-	m.connector_data = nil
-	m.clearedFields[refreshtoken.FieldConnectorData] = struct{}{}
+// ResetLastUsed resets all changes to the "last_used" field.
+func (m *UserSessionMutation) ResetLastUsed() {
+	m.last_used = nil
 }
 
-// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
-func (m *RefreshTokenMutation) ConnectorDataCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldConnectorData]
-	return ok
+// SetExpiry sets the "expiry" field.
+func (m *UserSessionMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
 }
 
-// ResetConnectorData resets all changes to the "connector_data" field.
-func (m *RefreshTokenMutation) ResetConnectorData() {
-	m.connector_data = nil
-	delete(m.clearedFields, refreshtoken.FieldConnectorData)
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *UserSessionMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetToken sets the "token" field.
-func (m *RefreshTokenMutation) SetToken(s string) {
-	m.token = &s
+// OldExpiry returns the old "expiry" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSessionMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *RefreshTokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *UserSessionMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// SetAcr sets the "acr" field.
+func (m *UserSessionMutation) SetAcr(s string) {
+	m.acr = &s
+}
+
+// Acr returns the value of the "acr" field in the mutation.
+func (m *UserSessionMutation) Acr() (r string, exists bool) {
+	v := m.acr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAcr returns the old "acr" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldAcr(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldAcr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldAcr: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Acr, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *RefreshTokenMutation) ResetToken() {
-	m.token = nil
+// ResetAcr resets all changes to the "acr" field.
+func (m *UserSessionMutation) ResetAcr() {
+	m.acr = nil
 }
 
-// SetObsoleteToken sets the "obsolete_token" field.
-func (m *RefreshTokenMutation) SetObsoleteToken(s string) {
-	m.obsolete_token = &s
+// SetAmr sets the "amr" field.
+func (m *UserSessionMutation) SetAmr(s []string) {
+	m.amr = &s
+	m.appendamr = nil
 }
 
-// ObsoleteToken returns the value of the "obsolete_token" field in the mutation.
-func (m *RefreshTokenMutation) ObsoleteToken() (r string, exists bool) {
-	v := m.obsolete_token
+// Amr returns the value of the "amr" field in the mutation.
+func (m *UserSessionMutation) Amr() (r []string, exists bool) {
+	v := m.amr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObsoleteToken returns the old "obsolete_token" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAmr returns the old "amr" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldObsoleteToken(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldAmr(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObsoleteToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObsoleteToken requires an ID field in the mutation")
+		return v, errors.New("OldAmr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObsoleteToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
 	}
-	return oldValue.ObsoleteToken, nil
+	return oldValue.Amr, nil
 }
 
-// ResetObsoleteToken resets all changes to the "obsolete_token" field.
-func (m *RefreshTokenMutation) ResetObsoleteToken() {
-	m.obsolete_token = nil
+// AppendAmr adds s to the "amr" field.
+func (m *UserSessionMutation) AppendAmr(s []string) {
+	m.appendamr = append(m.appendamr, s...)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
+func (m *UserSessionMutation) AppendedAmr() ([]string, bool) {
+	if len(m.appendamr) == 0 {
+		return nil, false
+	}
+	return m.appendamr, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ClearAmr clears the value of the "amr" field.
+func (m *UserSessionMutation) ClearAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	m.clearedFields[usersession.FieldAmr] = struct{}{}
+}
+
+// AmrCleared returns if the "amr" field was cleared in this mutation.
+func (m *UserSessionMutation) AmrCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldAmr]
+	return ok
+}
+
+// ResetAmr resets all changes to the "amr" field.
+func (m *UserSessionMutation) ResetAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	delete(m.clearedFields, usersession.FieldAmr)
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (m *UserSessionMutation) SetAuthTime(t time.Time) {
+	m.auth_time = &t
+}
+
+// AuthTime returns the value of the "auth_time" field in the mutation.
+func (m *UserSessionMutation) AuthTime() (r time.Time, exists bool) {
+	v := m.auth_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthTime returns the old "auth_time" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSessionMutation) OldAuthTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAuthTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AuthTime, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RefreshTokenMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearAuthTime clears the value of the "auth_time" field.
+func (m *UserSessionMutation) ClearAuthTime() {
+	m.auth_time = nil
+	m.clearedFields[usersession.FieldAuthTime] = struct{}{}
 }
 
-// SetLastUsed sets the "last_used" field.
-func (m *RefreshTokenMutation) SetLastUsed(t time.Time) {
-	m.last_used = &t
+// AuthTimeCleared returns if the "auth_time" field was cleared in this mutation.
+func (m *UserSessionMutation) AuthTimeCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldAuthTime]
+	return ok
 }
 
-// LastUsed returns the value of the "last_used" field in the mutation.
-func (m *RefreshTokenMutation) LastUsed() (r time.Time, exists bool) {
-	v := m.last_used
+// ResetAuthTime resets all changes to the "auth_time" field.
+func (m *UserSessionMutation) ResetAuthTime() {
+	m.auth_time = nil
+	delete(m.clearedFields, usersession.FieldAuthTime)
+}
+
+// SetSid sets the "sid" field.
+func (m *UserSessionMutation) SetSid(s string) {
+	m.sid = &s
+}
+
+// Sid returns the value of the "sid" field in the mutation.
+func (m *UserSessionMutation) Sid() (r string, exists bool) {
+	v := m.sid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSid returns the old "sid" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSessionMutation) OldSid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSid: %w", err)
+	}
+	return oldValue.Sid, nil
+}
+
+// ResetSid resets all changes to the "sid" field.
+func (m *UserSessionMutation) ResetSid() {
+	m.sid = nil
+}
+
+// SetClientIds sets the "client_ids" field.
+func (m *UserSessionMutation) SetClientIds(s []string) {
+	m.client_ids = &s
+	m.appendclient_ids = nil
+}
+
+// ClientIds returns the value of the "client_ids" field in the mutation.
+func (m *UserSessionMutation) ClientIds() (r []string, exists bool) {
+	v := m.client_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsed returns the old "last_used" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClientIds returns the old "client_ids" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldLastUsed(ctx context.Context) (v time.Time, err error) {
+func (m *UserSessionMutation) OldClientIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+		return v, errors.New("OldClientIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientIds: %w", err)
 	}
-	return oldValue.LastUsed, nil
+	return oldValue.ClientIds, nil
 }
 
-// ResetLastUsed resets all changes to the "last_used" field.
-func (m *RefreshTokenMutation) ResetLastUsed() {
-	m.last_used = nil
+// AppendClientIds adds s to the "client_ids" field.
+func (m *UserSessionMutation) AppendClientIds(s []string) {
+	m.appendclient_ids = append(m.appendclient_ids, s...)
 }
 
-// Where appends a list predicates to the RefreshTokenMutation builder.
-func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+// AppendedClientIds returns the list of values that were appended to the "client_ids" field in this mutation.
+func (m *UserSessionMutation) AppendedClientIds() ([]string, bool) {
+	if len(m.appendclient_ids) == 0 {
+		return nil, false
+	}
+	return m.appendclient_ids, true
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (m *UserSessionMutation) ClearClientIds() {
+	m.client_ids = nil
+	m.appendclient_ids = nil
+	m.clearedFields[usersession.FieldClientIds] = struct{}{}
+}
+
+// ClientIdsCleared returns if the "client_ids" field was cleared in this mutation.
+func (m *UserSessionMutation) ClientIdsCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldClientIds]
+	return ok
+}
+
+// ResetClientIds resets all changes to the "client_ids" field.
+func (m *UserSessionMutation) ResetClientIds() {
+	m.client_ids = nil
+	m.appendclient_ids = nil
+	delete(m.clearedFields, usersession.FieldClientIds)
+}
+
+// Where appends a list predicates to the UserSessionMutation builder.
+func (m *UserSessionMutation) Where(ps ...predicate.UserSession) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserSessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RefreshToken, len(ps))
+func (m *UserSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserSession, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7833,69 +16328,75 @@ func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RefreshTokenMutation) Op() Op {
+func (m *UserSessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RefreshTokenMutation) SetOp(op Op) {
+func (m *UserSessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RefreshToken).
-func (m *RefreshTokenMutation) Type() string {
+// Type returns the node type of this mutation (UserSession).
+func (m *UserSessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RefreshTokenMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.client_id != nil {
-		fields = append(fields, refreshtoken.FieldClientID)
-	}
-	if m.scopes != nil {
-		fields = append(fields, refreshtoken.FieldScopes)
+func (m *UserSessionMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.connector_id != nil {
+		fields = append(fields, usersession.FieldConnectorID)
 	}
-	if m.nonce != nil {
-		fields = append(fields, refreshtoken.FieldNonce)
+	if m.connector_data != nil {
+		fields = append(fields, usersession.FieldConnectorData)
 	}
 	if m.claims_user_id != nil {
-		fields = append(fields, refreshtoken.FieldClaimsUserID)
+		fields = append(fields, usersession.FieldClaimsUserID)
 	}
 	if m.claims_username != nil {
-		fields = append(fields, refreshtoken.FieldClaimsUsername)
+		fields = append(fields, usersession.FieldClaimsUsername)
+	}
+	if m.claims_preferred_username != nil {
+		fields = append(fields, usersession.FieldClaimsPreferredUsername)
 	}
 	if m.claims_email != nil {
-		fields = append(fields, refreshtoken.FieldClaimsEmail)
+		fields = append(fields, usersession.FieldClaimsEmail)
 	}
 	if m.claims_email_verified != nil {
-		fields = append(fields, refreshtoken.FieldClaimsEmailVerified)
+		fields = append(fields, usersession.FieldClaimsEmailVerified)
 	}
 	if m.claims_groups != nil {
-		fields = append(fields, refreshtoken.FieldClaimsGroups)
+		fields = append(fields, usersession.FieldClaimsGroups)
 	}
-	if m.claims_preferred_username != nil {
-		fields = append(fields, refreshtoken.FieldClaimsPreferredUsername)
+	if m.claims_extra != nil {
+		fields = append(fields, usersession.FieldClaimsExtra)
 	}
-	if m.connector_id != nil {
-		fields = append(fields, refreshtoken.FieldConnectorID)
+	if m.created_at != nil {
+		fields = append(fields, usersession.FieldCreatedAt)
 	}
-	if m.connector_data != nil {
-		fields = append(fields, refreshtoken.FieldConnectorData)
+	if m.last_used != nil {
+		fields = append(fields, usersession.FieldLastUsed)
 	}
-	if m.token != nil {
-		fields = append(fields, refreshtoken.FieldToken)
+	if m.expiry != nil {
+		fields = append(fields, usersession.FieldExpiry)
 	}
-	if m.obsolete_token != nil {
-		fields = append(fields, refreshtoken.FieldObsoleteToken)
+	if m.acr != nil {
+		fields = append(fields, usersession.FieldAcr)
 	}
-	if m.created_at != nil {
-		fields = append(fields, refreshtoken.FieldCreatedAt)
+	if m.amr != nil {
+		fields = append(fields, usersession.FieldAmr)
 	}
-	if m.last_used != nil {
-		fields = append(fields, refreshtoken.FieldLastUsed)
+	if m.auth_time != nil {
+		fields = append(fields, usersession.FieldAuthTime)
+	}
+	if m.sid != nil {
+		fields = append(fields, usersession.FieldSid)
+	}
+	if m.client_ids != nil {
+		fields = append(fields, usersession.FieldClientIds)
 	}
 	return fields
 }
@@ -7903,38 +16404,42 @@ func (m *RefreshTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *UserSessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case refreshtoken.FieldClientID:
-		return m.ClientID()
-	case refreshtoken.FieldScopes:
-		return m.Scopes()
-	case refreshtoken.FieldNonce:
-		return m.Nonce()
-	case refreshtoken.FieldClaimsUserID:
+	case usersession.FieldConnectorID:
+		return m.ConnectorID()
+	case usersession.FieldConnectorData:
+		return m.ConnectorData()
+	case usersession.FieldClaimsUserID:
 		return m.ClaimsUserID()
-	case refreshtoken.FieldClaimsUsername:
+	case usersession.FieldClaimsUsername:
 		return m.ClaimsUsername()
-	case refreshtoken.FieldClaimsEmail:
+	case usersession.FieldClaimsPreferredUsername:
+		return m.ClaimsPreferredUsername()
+	case usersession.FieldClaimsEmail:
 		return m.ClaimsEmail()
-	case refreshtoken.FieldClaimsEmailVerified:
+	case usersession.FieldClaimsEmailVerified:
 		return m.ClaimsEmailVerified()
-	case refreshtoken.FieldClaimsGroups:
+	case usersession.FieldClaimsGroups:
 		return m.ClaimsGroups()
-	case refreshtoken.FieldClaimsPreferredUsername:
-		return m.ClaimsPreferredUsername()
-	case refreshtoken.FieldConnectorID:
-		return m.ConnectorID()
-	case refreshtoken.FieldConnectorData:
-		return m.ConnectorData()
-	case refreshtoken.FieldToken:
-		return m.Token()
-	case refreshtoken.FieldObsoleteToken:
-		return m.ObsoleteToken()
-	case refreshtoken.FieldCreatedAt:
+	case usersession.FieldClaimsExtra:
+		return m.ClaimsExtra()
+	case usersession.FieldCreatedAt:
 		return m.CreatedAt()
-	case refreshtoken.FieldLastUsed:
+	case usersession.FieldLastUsed:
 		return m.LastUsed()
+	case usersession.FieldExpiry:
+		return m.Expiry()
+	case usersession.FieldAcr:
+		return m.Acr()
+	case usersession.FieldAmr:
+		return m.Amr()
+	case usersession.FieldAuthTime:
+		return m.AuthTime()
+	case usersession.FieldSid:
+		return m.Sid()
+	case usersession.FieldClientIds:
+		return m.ClientIds()
 	}
 	return nil, false
 }
@@ -7942,315 +16447,357 @@ func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case refreshtoken.FieldClientID:
-		return m.OldClientID(ctx)
-	case refreshtoken.FieldScopes:
-		return m.OldScopes(ctx)
-	case refreshtoken.FieldNonce:
-		return m.OldNonce(ctx)
-	case refreshtoken.FieldClaimsUserID:
+	case usersession.FieldConnectorID:
+		return m.OldConnectorID(ctx)
+	case usersession.FieldConnectorData:
+		return m.OldConnectorData(ctx)
+	case usersession.FieldClaimsUserID:
 		return m.OldClaimsUserID(ctx)
-	case refreshtoken.FieldClaimsUsername:
+	case usersession.FieldClaimsUsername:
 		return m.OldClaimsUsername(ctx)
-	case refreshtoken.FieldClaimsEmail:
+	case usersession.FieldClaimsPreferredUsername:
+		return m.OldClaimsPreferredUsername(ctx)
+	case usersession.FieldClaimsEmail:
 		return m.OldClaimsEmail(ctx)
-	case refreshtoken.FieldClaimsEmailVerified:
+	case usersession.FieldClaimsEmailVerified:
 		return m.OldClaimsEmailVerified(ctx)
-	case refreshtoken.FieldClaimsGroups:
+	case usersession.FieldClaimsGroups:
 		return m.OldClaimsGroups(ctx)
-	case refreshtoken.FieldClaimsPreferredUsername:
-		return m.OldClaimsPreferredUsername(ctx)
-	case refreshtoken.FieldConnectorID:
-		return m.OldConnectorID(ctx)
-	case refreshtoken.FieldConnectorData:
-		return m.OldConnectorData(ctx)
-	case refreshtoken.FieldToken:
-		return m.OldToken(ctx)
-	case refreshtoken.FieldObsoleteToken:
-		return m.OldObsoleteToken(ctx)
-	case refreshtoken.FieldCreatedAt:
+	case usersession.FieldClaimsExtra:
+		return m.OldClaimsExtra(ctx)
+	case usersession.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case refreshtoken.FieldLastUsed:
+	case usersession.FieldLastUsed:
 		return m.OldLastUsed(ctx)
+	case usersession.FieldExpiry:
+		return m.OldExpiry(ctx)
+	case usersession.FieldAcr:
+		return m.OldAcr(ctx)
+	case usersession.FieldAmr:
+		return m.OldAmr(ctx)
+	case usersession.FieldAuthTime:
+		return m.OldAuthTime(ctx)
+	case usersession.FieldSid:
+		return m.OldSid(ctx)
+	case usersession.FieldClientIds:
+		return m.OldClientIds(ctx)
 	}
-	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+	return nil, fmt.Errorf("unknown UserSession field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+func (m *UserSessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case refreshtoken.FieldClientID:
+	case usersession.FieldConnectorID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetConnectorID(v)
 		return nil
-	case refreshtoken.FieldScopes:
-		v, ok := value.([]string)
+	case usersession.FieldConnectorData:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScopes(v)
+		m.SetConnectorData(v)
 		return nil
-	case refreshtoken.FieldNonce:
+	case usersession.FieldClaimsUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNonce(v)
+		m.SetClaimsUserID(v)
 		return nil
-	case refreshtoken.FieldClaimsUserID:
+	case usersession.FieldClaimsUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsUserID(v)
+		m.SetClaimsUsername(v)
 		return nil
-	case refreshtoken.FieldClaimsUsername:
+	case usersession.FieldClaimsPreferredUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsUsername(v)
+		m.SetClaimsPreferredUsername(v)
 		return nil
-	case refreshtoken.FieldClaimsEmail:
+	case usersession.FieldClaimsEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsEmail(v)
 		return nil
-	case refreshtoken.FieldClaimsEmailVerified:
+	case usersession.FieldClaimsEmailVerified:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsEmailVerified(v)
 		return nil
-	case refreshtoken.FieldClaimsGroups:
+	case usersession.FieldClaimsGroups:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClaimsGroups(v)
 		return nil
-	case refreshtoken.FieldClaimsPreferredUsername:
-		v, ok := value.(string)
+	case usersession.FieldClaimsExtra:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsPreferredUsername(v)
+		m.SetClaimsExtra(v)
 		return nil
-	case refreshtoken.FieldConnectorID:
-		v, ok := value.(string)
+	case usersession.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnectorID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case refreshtoken.FieldConnectorData:
-		v, ok := value.([]byte)
+	case usersession.FieldLastUsed:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnectorData(v)
+		m.SetLastUsed(v)
 		return nil
-	case refreshtoken.FieldToken:
-		v, ok := value.(string)
+	case usersession.FieldExpiry:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetExpiry(v)
 		return nil
-	case refreshtoken.FieldObsoleteToken:
+	case usersession.FieldAcr:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetObsoleteToken(v)
+		m.SetAcr(v)
 		return nil
-	case refreshtoken.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case usersession.FieldAmr:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAmr(v)
 		return nil
-	case refreshtoken.FieldLastUsed:
+	case usersession.FieldAuthTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsed(v)
+		m.SetAuthTime(v)
+		return nil
+	case usersession.FieldSid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSid(v)
+		return nil
+	case usersession.FieldClientIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientIds(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	return fmt.Errorf("unknown UserSession field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RefreshTokenMutation) AddedFields() []string {
+func (m *UserSessionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserSessionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+func (m *UserSessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+	return fmt.Errorf("unknown UserSession numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RefreshTokenMutation) ClearedFields() []string {
+func (m *UserSessionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(refreshtoken.FieldScopes) {
-		fields = append(fields, refreshtoken.FieldScopes)
+	if m.FieldCleared(usersession.FieldConnectorData) {
+		fields = append(fields, usersession.FieldConnectorData)
 	}
-	if m.FieldCleared(refreshtoken.FieldClaimsGroups) {
-		fields = append(fields, refreshtoken.FieldClaimsGroups)
+	if m.FieldCleared(usersession.FieldClaimsGroups) {
+		fields = append(fields, usersession.FieldClaimsGroups)
 	}
-	if m.FieldCleared(refreshtoken.FieldConnectorData) {
-		fields = append(fields, refreshtoken.FieldConnectorData)
+	if m.FieldCleared(usersession.FieldClaimsExtra) {
+		fields = append(fields, usersession.FieldClaimsExtra)
+	}
+	if m.FieldCleared(usersession.FieldAmr) {
+		fields = append(fields, usersession.FieldAmr)
+	}
+	if m.FieldCleared(usersession.FieldAuthTime) {
+		fields = append(fields, usersession.FieldAuthTime)
+	}
+	if m.FieldCleared(usersession.FieldClientIds) {
+		fields = append(fields, usersession.FieldClientIds)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+func (m *UserSessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ClearField(name string) error {
+func (m *UserSessionMutation) ClearField(name string) error {
 	switch name {
-	case refreshtoken.FieldScopes:
-		m.ClearScopes()
+	case usersession.FieldConnectorData:
+		m.ClearConnectorData()
 		return nil
-	case refreshtoken.FieldClaimsGroups:
+	case usersession.FieldClaimsGroups:
 		m.ClearClaimsGroups()
 		return nil
-	case refreshtoken.FieldConnectorData:
-		m.ClearConnectorData()
+	case usersession.FieldClaimsExtra:
+		m.ClearClaimsExtra()
+		return nil
+	case usersession.FieldAmr:
+		m.ClearAmr()
+		return nil
+	case usersession.FieldAuthTime:
+		m.ClearAuthTime()
+		return nil
+	case usersession.FieldClientIds:
+		m.ClearClientIds()
 		return nil
 	}
-	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+	return fmt.Errorf("unknown UserSession nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ResetField(name string) error {
+func (m *UserSessionMutation) ResetField(name string) error {
 	switch name {
-	case refreshtoken.FieldClientID:
-		m.ResetClientID()
-		return nil
-	case refreshtoken.FieldScopes:
-		m.ResetScopes()
+	case usersession.FieldConnectorID:
+		m.ResetConnectorID()
 		return nil
-	case refreshtoken.FieldNonce:
-		m.ResetNonce()
+	case usersession.FieldConnectorData:
+		m.ResetConnectorData()
 		return nil
-	case refreshtoken.FieldClaimsUserID:
+	case usersession.FieldClaimsUserID:
 		m.ResetClaimsUserID()
 		return nil
-	case refreshtoken.FieldClaimsUsername:
+	case usersession.FieldClaimsUsername:
 		m.ResetClaimsUsername()
 		return nil
-	case refreshtoken.FieldClaimsEmail:
+	case usersession.FieldClaimsPreferredUsername:
+		m.ResetClaimsPreferredUsername()
+		return nil
+	case usersession.FieldClaimsEmail:
 		m.ResetClaimsEmail()
 		return nil
-	case refreshtoken.FieldClaimsEmailVerified:
+	case usersession.FieldClaimsEmailVerified:
 		m.ResetClaimsEmailVerified()
 		return nil
-	case refreshtoken.FieldClaimsGroups:
+	case usersession.FieldClaimsGroups:
 		m.ResetClaimsGroups()
 		return nil
-	case refreshtoken.FieldClaimsPreferredUsername:
-		m.ResetClaimsPreferredUsername()
+	case usersession.FieldClaimsExtra:
+		m.ResetClaimsExtra()
 		return nil
-	case refreshtoken.FieldConnectorID:
-		m.ResetConnectorID()
+	case usersession.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case refreshtoken.FieldConnectorData:
-		m.ResetConnectorData()
+	case usersession.FieldLastUsed:
+		m.ResetLastUsed()
 		return nil
-	case refreshtoken.FieldToken:
-		m.ResetToken()
+	case usersession.FieldExpiry:
+		m.ResetExpiry()
 		return nil
-	case refreshtoken.FieldObsoleteToken:
-		m.ResetObsoleteToken()
+	case usersession.FieldAcr:
+		m.ResetAcr()
 		return nil
-	case refreshtoken.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case usersession.FieldAmr:
+		m.ResetAmr()
 		return nil
-	case refreshtoken.FieldLastUsed:
-		m.ResetLastUsed()
+	case usersession.FieldAuthTime:
+		m.ResetAuthTime()
+		return nil
+	case usersession.FieldSid:
+		m.ResetSid()
+		return nil
+	case usersession.FieldClientIds:
+		m.ResetClientIds()
 		return nil
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	return fmt.Errorf("unknown UserSession field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RefreshTokenMutation) AddedEdges() []string {
+func (m *UserSessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *UserSessionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RefreshTokenMutation) RemovedEdges() []string {
+func (m *UserSessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserSessionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RefreshTokenMutation) ClearedEdges() []string {
+func (m *UserSessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+func (m *UserSessionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RefreshTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+func (m *UserSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserSession unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RefreshTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown RefreshToken edge %s", name)
+func (m *UserSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserSession edge %s", name)
 }
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 )
 
@@ -23,6 +24,46 @@ type OAuth2Client struct {
 	RedirectUris []string `json:"redirect_uris,omitempty"`
 	// TrustedPeers holds the value of the "trusted_peers" field.
 	TrustedPeers []string `json:"trusted_peers,omitempty"`
+	// PostLogoutRedirectUris holds the value of the "post_logout_redirect_uris" field.
+	PostLogoutRedirectUris []string `json:"post_logout_redirect_uris,omitempty"`
+	// BackchannelLogoutURI holds the value of the "backchannel_logout_uri" field.
+	BackchannelLogoutURI string `json:"backchannel_logout_uri,omitempty"`
+	// ClientCredentialsScopes holds the value of the "client_credentials_scopes" field.
+	ClientCredentialsScopes []string `json:"client_credentials_scopes,omitempty"`
+	// ClientCredentialsAudiences holds the value of the "client_credentials_audiences" field.
+	ClientCredentialsAudiences []string `json:"client_credentials_audiences,omitempty"`
+	// AccessTokenFormat holds the value of the "access_token_format" field.
+	AccessTokenFormat string `json:"access_token_format,omitempty"`
+	// RequirePushedAuthorizationRequests holds the value of the "require_pushed_authorization_requests" field.
+	RequirePushedAuthorizationRequests bool `json:"require_pushed_authorization_requests,omitempty"`
+	// Jwks holds the value of the "jwks" field.
+	Jwks string `json:"jwks,omitempty"`
+	// JwksURI holds the value of the "jwks_uri" field.
+	JwksURI string `json:"jwks_uri,omitempty"`
+	// RegistrationAccessTokenHash holds the value of the "registration_access_token_hash" field.
+	RegistrationAccessTokenHash string `json:"registration_access_token_hash,omitempty"`
+	// TokenEndpointAuthMethod holds the value of the "token_endpoint_auth_method" field.
+	TokenEndpointAuthMethod string `json:"token_endpoint_auth_method,omitempty"`
+	// TLSClientAuthSubjectDn holds the value of the "tls_client_auth_subject_dn" field.
+	TLSClientAuthSubjectDn string `json:"tls_client_auth_subject_dn,omitempty"`
+	// ClaimMappings holds the value of the "claim_mappings" field.
+	ClaimMappings []storage.ClaimMapping `json:"claim_mappings,omitempty"`
+	// SubjectType holds the value of the "subject_type" field.
+	SubjectType string `json:"subject_type,omitempty"`
+	// SectorIdentifierURI holds the value of the "sector_identifier_uri" field.
+	SectorIdentifierURI string `json:"sector_identifier_uri,omitempty"`
+	// RequiredScopes holds the value of the "required_scopes" field.
+	RequiredScopes []string `json:"required_scopes,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry *storage.ClientExpiry `json:"expiry,omitempty"`
+	// AllowedConnectors holds the value of the "allowed_connectors" field.
+	AllowedConnectors []string `json:"allowed_connectors,omitempty"`
+	// AllowedGrantTypes holds the value of the "allowed_grant_types" field.
+	AllowedGrantTypes []string `json:"allowed_grant_types,omitempty"`
+	// AllowedResponseTypes holds the value of the "allowed_response_types" field.
+	AllowedResponseTypes []string `json:"allowed_response_types,omitempty"`
+	// RequirePkce holds the value of the "require_pkce" field.
+	RequirePkce bool `json:"require_pkce,omitempty"`
 	// Public holds the value of the "public" field.
 	Public bool `json:"public,omitempty"`
 	// Name holds the value of the "name" field.
@@ -37,11 +78,11 @@ func (*OAuth2Client) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case oauth2client.FieldRedirectUris, oauth2client.FieldTrustedPeers:
+		case oauth2client.FieldRedirectUris, oauth2client.FieldTrustedPeers, oauth2client.FieldPostLogoutRedirectUris, oauth2client.FieldClientCredentialsScopes, oauth2client.FieldClientCredentialsAudiences, oauth2client.FieldClaimMappings, oauth2client.FieldRequiredScopes, oauth2client.FieldExpiry, oauth2client.FieldAllowedConnectors, oauth2client.FieldAllowedGrantTypes, oauth2client.FieldAllowedResponseTypes:
 			values[i] = new([]byte)
-		case oauth2client.FieldPublic:
+		case oauth2client.FieldRequirePushedAuthorizationRequests, oauth2client.FieldRequirePkce, oauth2client.FieldPublic:
 			values[i] = new(sql.NullBool)
-		case oauth2client.FieldID, oauth2client.FieldSecret, oauth2client.FieldName, oauth2client.FieldLogoURL:
+		case oauth2client.FieldID, oauth2client.FieldSecret, oauth2client.FieldBackchannelLogoutURI, oauth2client.FieldAccessTokenFormat, oauth2client.FieldJwks, oauth2client.FieldJwksURI, oauth2client.FieldRegistrationAccessTokenHash, oauth2client.FieldTokenEndpointAuthMethod, oauth2client.FieldTLSClientAuthSubjectDn, oauth2client.FieldSubjectType, oauth2client.FieldSectorIdentifierURI, oauth2client.FieldName, oauth2client.FieldLogoURL:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -86,6 +127,144 @@ func (_m *OAuth2Client) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field trusted_peers: %w", err)
 				}
 			}
+		case oauth2client.FieldPostLogoutRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field post_logout_redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PostLogoutRedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field post_logout_redirect_uris: %w", err)
+				}
+			}
+		case oauth2client.FieldBackchannelLogoutURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backchannel_logout_uri", values[i])
+			} else if value.Valid {
+				_m.BackchannelLogoutURI = value.String
+			}
+		case oauth2client.FieldClientCredentialsScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field client_credentials_scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClientCredentialsScopes); err != nil {
+					return fmt.Errorf("unmarshal field client_credentials_scopes: %w", err)
+				}
+			}
+		case oauth2client.FieldClientCredentialsAudiences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field client_credentials_audiences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClientCredentialsAudiences); err != nil {
+					return fmt.Errorf("unmarshal field client_credentials_audiences: %w", err)
+				}
+			}
+		case oauth2client.FieldAccessTokenFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_token_format", values[i])
+			} else if value.Valid {
+				_m.AccessTokenFormat = value.String
+			}
+		case oauth2client.FieldRequirePushedAuthorizationRequests:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_pushed_authorization_requests", values[i])
+			} else if value.Valid {
+				_m.RequirePushedAuthorizationRequests = value.Bool
+			}
+		case oauth2client.FieldJwks:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jwks", values[i])
+			} else if value.Valid {
+				_m.Jwks = value.String
+			}
+		case oauth2client.FieldJwksURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jwks_uri", values[i])
+			} else if value.Valid {
+				_m.JwksURI = value.String
+			}
+		case oauth2client.FieldRegistrationAccessTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registration_access_token_hash", values[i])
+			} else if value.Valid {
+				_m.RegistrationAccessTokenHash = value.String
+			}
+		case oauth2client.FieldTokenEndpointAuthMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_endpoint_auth_method", values[i])
+			} else if value.Valid {
+				_m.TokenEndpointAuthMethod = value.String
+			}
+		case oauth2client.FieldTLSClientAuthSubjectDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_client_auth_subject_dn", values[i])
+			} else if value.Valid {
+				_m.TLSClientAuthSubjectDn = value.String
+			}
+		case oauth2client.FieldClaimMappings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_mappings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClaimMappings); err != nil {
+					return fmt.Errorf("unmarshal field claim_mappings: %w", err)
+				}
+			}
+		case oauth2client.FieldSubjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_type", values[i])
+			} else if value.Valid {
+				_m.SubjectType = value.String
+			}
+		case oauth2client.FieldSectorIdentifierURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sector_identifier_uri", values[i])
+			} else if value.Valid {
+				_m.SectorIdentifierURI = value.String
+			}
+		case oauth2client.FieldRequiredScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field required_scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RequiredScopes); err != nil {
+					return fmt.Errorf("unmarshal field required_scopes: %w", err)
+				}
+			}
+		case oauth2client.FieldExpiry:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Expiry); err != nil {
+					return fmt.Errorf("unmarshal field expiry: %w", err)
+				}
+			}
+		case oauth2client.FieldAllowedConnectors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_connectors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedConnectors); err != nil {
+					return fmt.Errorf("unmarshal field allowed_connectors: %w", err)
+				}
+			}
+		case oauth2client.FieldAllowedGrantTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_grant_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedGrantTypes); err != nil {
+					return fmt.Errorf("unmarshal field allowed_grant_types: %w", err)
+				}
+			}
+		case oauth2client.FieldAllowedResponseTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_response_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedResponseTypes); err != nil {
+					return fmt.Errorf("unmarshal field allowed_response_types: %w", err)
+				}
+			}
+		case oauth2client.FieldRequirePkce:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_pkce", values[i])
+			} else if value.Valid {
+				_m.RequirePkce = value.Bool
+			}
 		case oauth2client.FieldPublic:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field public", values[i])
@@ -149,6 +328,66 @@ func (_m *OAuth2Client) String() string {
 	builder.WriteString("trusted_peers=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TrustedPeers))
 	builder.WriteString(", ")
+	builder.WriteString("post_logout_redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PostLogoutRedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("backchannel_logout_uri=")
+	builder.WriteString(_m.BackchannelLogoutURI)
+	builder.WriteString(", ")
+	builder.WriteString("client_credentials_scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientCredentialsScopes))
+	builder.WriteString(", ")
+	builder.WriteString("client_credentials_audiences=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientCredentialsAudiences))
+	builder.WriteString(", ")
+	builder.WriteString("access_token_format=")
+	builder.WriteString(_m.AccessTokenFormat)
+	builder.WriteString(", ")
+	builder.WriteString("require_pushed_authorization_requests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequirePushedAuthorizationRequests))
+	builder.WriteString(", ")
+	builder.WriteString("jwks=")
+	builder.WriteString(_m.Jwks)
+	builder.WriteString(", ")
+	builder.WriteString("jwks_uri=")
+	builder.WriteString(_m.JwksURI)
+	builder.WriteString(", ")
+	builder.WriteString("registration_access_token_hash=")
+	builder.WriteString(_m.RegistrationAccessTokenHash)
+	builder.WriteString(", ")
+	builder.WriteString("token_endpoint_auth_method=")
+	builder.WriteString(_m.TokenEndpointAuthMethod)
+	builder.WriteString(", ")
+	builder.WriteString("tls_client_auth_subject_dn=")
+	builder.WriteString(_m.TLSClientAuthSubjectDn)
+	builder.WriteString(", ")
+	builder.WriteString("claim_mappings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClaimMappings))
+	builder.WriteString(", ")
+	builder.WriteString("subject_type=")
+	builder.WriteString(_m.SubjectType)
+	builder.WriteString(", ")
+	builder.WriteString("sector_identifier_uri=")
+	builder.WriteString(_m.SectorIdentifierURI)
+	builder.WriteString(", ")
+	builder.WriteString("required_scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredScopes))
+	builder.WriteString(", ")
+	builder.WriteString("expiry=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Expiry))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_connectors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedConnectors))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_grant_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedGrantTypes))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_response_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedResponseTypes))
+	builder.WriteString(", ")
+	builder.WriteString("require_pkce=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequirePkce))
+	builder.WriteString(", ")
 	builder.WriteString("public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Public))
 	builder.WriteString(", ")
@@ -17,6 +17,46 @@ const (
 	FieldRedirectUris = "redirect_uris"
 	// FieldTrustedPeers holds the string denoting the trusted_peers field in the database.
 	FieldTrustedPeers = "trusted_peers"
+	// FieldPostLogoutRedirectUris holds the string denoting the post_logout_redirect_uris field in the database.
+	FieldPostLogoutRedirectUris = "post_logout_redirect_uris"
+	// FieldBackchannelLogoutURI holds the string denoting the backchannel_logout_uri field in the database.
+	FieldBackchannelLogoutURI = "backchannel_logout_uri"
+	// FieldClientCredentialsScopes holds the string denoting the client_credentials_scopes field in the database.
+	FieldClientCredentialsScopes = "client_credentials_scopes"
+	// FieldClientCredentialsAudiences holds the string denoting the client_credentials_audiences field in the database.
+	FieldClientCredentialsAudiences = "client_credentials_audiences"
+	// FieldAccessTokenFormat holds the string denoting the access_token_format field in the database.
+	FieldAccessTokenFormat = "access_token_format"
+	// FieldRequirePushedAuthorizationRequests holds the string denoting the require_pushed_authorization_requests field in the database.
+	FieldRequirePushedAuthorizationRequests = "require_pushed_authorization_requests"
+	// FieldJwks holds the string denoting the jwks field in the database.
+	FieldJwks = "jwks"
+	// FieldJwksURI holds the string denoting the jwks_uri field in the database.
+	FieldJwksURI = "jwks_uri"
+	// FieldRegistrationAccessTokenHash holds the string denoting the registration_access_token_hash field in the database.
+	FieldRegistrationAccessTokenHash = "registration_access_token_hash"
+	// FieldTokenEndpointAuthMethod holds the string denoting the token_endpoint_auth_method field in the database.
+	FieldTokenEndpointAuthMethod = "token_endpoint_auth_method"
+	// FieldTLSClientAuthSubjectDn holds the string denoting the tls_client_auth_subject_dn field in the database.
+	FieldTLSClientAuthSubjectDn = "tls_client_auth_subject_dn"
+	// FieldClaimMappings holds the string denoting the claim_mappings field in the database.
+	FieldClaimMappings = "claim_mappings"
+	// FieldSubjectType holds the string denoting the subject_type field in the database.
+	FieldSubjectType = "subject_type"
+	// FieldSectorIdentifierURI holds the string denoting the sector_identifier_uri field in the database.
+	FieldSectorIdentifierURI = "sector_identifier_uri"
+	// FieldRequiredScopes holds the string denoting the required_scopes field in the database.
+	FieldRequiredScopes = "required_scopes"
+	// FieldExpiry holds the string denoting the expiry field in the database.
+	FieldExpiry = "expiry"
+	// FieldAllowedConnectors holds the string denoting the allowed_connectors field in the database.
+	FieldAllowedConnectors = "allowed_connectors"
+	// FieldAllowedGrantTypes holds the string denoting the allowed_grant_types field in the database.
+	FieldAllowedGrantTypes = "allowed_grant_types"
+	// FieldAllowedResponseTypes holds the string denoting the allowed_response_types field in the database.
+	FieldAllowedResponseTypes = "allowed_response_types"
+	// FieldRequirePkce holds the string denoting the require_pkce field in the database.
+	FieldRequirePkce = "require_pkce"
 	// FieldPublic holds the string denoting the public field in the database.
 	FieldPublic = "public"
 	// FieldName holds the string denoting the name field in the database.
@@ -33,6 +73,26 @@ var Columns = []string{
 	FieldSecret,
 	FieldRedirectUris,
 	FieldTrustedPeers,
+	FieldPostLogoutRedirectUris,
+	FieldBackchannelLogoutURI,
+	FieldClientCredentialsScopes,
+	FieldClientCredentialsAudiences,
+	FieldAccessTokenFormat,
+	FieldRequirePushedAuthorizationRequests,
+	FieldJwks,
+	FieldJwksURI,
+	FieldRegistrationAccessTokenHash,
+	FieldTokenEndpointAuthMethod,
+	FieldTLSClientAuthSubjectDn,
+	FieldClaimMappings,
+	FieldSubjectType,
+	FieldSectorIdentifierURI,
+	FieldRequiredScopes,
+	FieldExpiry,
+	FieldAllowedConnectors,
+	FieldAllowedGrantTypes,
+	FieldAllowedResponseTypes,
+	FieldRequirePkce,
 	FieldPublic,
 	FieldName,
 	FieldLogoURL,
@@ -51,6 +111,28 @@ func ValidColumn(column string) bool {
 var (
 	// SecretValidator is a validator for the "secret" field. It is called by the builders before save.
 	SecretValidator func(string) error
+	// DefaultBackchannelLogoutURI holds the default value on creation for the "backchannel_logout_uri" field.
+	DefaultBackchannelLogoutURI string
+	// DefaultAccessTokenFormat holds the default value on creation for the "access_token_format" field.
+	DefaultAccessTokenFormat string
+	// DefaultRequirePushedAuthorizationRequests holds the default value on creation for the "require_pushed_authorization_requests" field.
+	DefaultRequirePushedAuthorizationRequests bool
+	// DefaultJwks holds the default value on creation for the "jwks" field.
+	DefaultJwks string
+	// DefaultJwksURI holds the default value on creation for the "jwks_uri" field.
+	DefaultJwksURI string
+	// DefaultRegistrationAccessTokenHash holds the default value on creation for the "registration_access_token_hash" field.
+	DefaultRegistrationAccessTokenHash string
+	// DefaultTokenEndpointAuthMethod holds the default value on creation for the "token_endpoint_auth_method" field.
+	DefaultTokenEndpointAuthMethod string
+	// DefaultTLSClientAuthSubjectDn holds the default value on creation for the "tls_client_auth_subject_dn" field.
+	DefaultTLSClientAuthSubjectDn string
+	// DefaultSubjectType holds the default value on creation for the "subject_type" field.
+	DefaultSubjectType string
+	// DefaultSectorIdentifierURI holds the default value on creation for the "sector_identifier_uri" field.
+	DefaultSectorIdentifierURI string
+	// DefaultRequirePkce holds the default value on creation for the "require_pkce" field.
+	DefaultRequirePkce bool
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// LogoURLValidator is a validator for the "logo_url" field. It is called by the builders before save.
@@ -72,6 +154,61 @@ func BySecret(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSecret, opts...).ToFunc()
 }
 
+// ByBackchannelLogoutURI orders the results by the backchannel_logout_uri field.
+func ByBackchannelLogoutURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackchannelLogoutURI, opts...).ToFunc()
+}
+
+// ByAccessTokenFormat orders the results by the access_token_format field.
+func ByAccessTokenFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessTokenFormat, opts...).ToFunc()
+}
+
+// ByRequirePushedAuthorizationRequests orders the results by the require_pushed_authorization_requests field.
+func ByRequirePushedAuthorizationRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequirePushedAuthorizationRequests, opts...).ToFunc()
+}
+
+// ByJwks orders the results by the jwks field.
+func ByJwks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJwks, opts...).ToFunc()
+}
+
+// ByJwksURI orders the results by the jwks_uri field.
+func ByJwksURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJwksURI, opts...).ToFunc()
+}
+
+// ByRegistrationAccessTokenHash orders the results by the registration_access_token_hash field.
+func ByRegistrationAccessTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistrationAccessTokenHash, opts...).ToFunc()
+}
+
+// ByTokenEndpointAuthMethod orders the results by the token_endpoint_auth_method field.
+func ByTokenEndpointAuthMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenEndpointAuthMethod, opts...).ToFunc()
+}
+
+// ByTLSClientAuthSubjectDn orders the results by the tls_client_auth_subject_dn field.
+func ByTLSClientAuthSubjectDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSClientAuthSubjectDn, opts...).ToFunc()
+}
+
+// BySubjectType orders the results by the subject_type field.
+func BySubjectType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectType, opts...).ToFunc()
+}
+
+// BySectorIdentifierURI orders the results by the sector_identifier_uri field.
+func BySectorIdentifierURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSectorIdentifierURI, opts...).ToFunc()
+}
+
+// ByRequirePkce orders the results by the require_pkce field.
+func ByRequirePkce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequirePkce, opts...).ToFunc()
+}
+
 // ByPublic orders the results by the public field.
 func ByPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublic, opts...).ToFunc()
@@ -67,6 +67,61 @@ func Secret(v string) predicate.OAuth2Client {
 	return predicate.OAuth2Client(sql.FieldEQ(FieldSecret, v))
 }
 
+// BackchannelLogoutURI applies equality check predicate on the "backchannel_logout_uri" field. It's identical to BackchannelLogoutURIEQ.
+func BackchannelLogoutURI(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldBackchannelLogoutURI, v))
+}
+
+// AccessTokenFormat applies equality check predicate on the "access_token_format" field. It's identical to AccessTokenFormatEQ.
+func AccessTokenFormat(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldAccessTokenFormat, v))
+}
+
+// RequirePushedAuthorizationRequests applies equality check predicate on the "require_pushed_authorization_requests" field. It's identical to RequirePushedAuthorizationRequestsEQ.
+func RequirePushedAuthorizationRequests(v bool) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldRequirePushedAuthorizationRequests, v))
+}
+
+// Jwks applies equality check predicate on the "jwks" field. It's identical to JwksEQ.
+func Jwks(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldJwks, v))
+}
+
+// JwksURI applies equality check predicate on the "jwks_uri" field. It's identical to JwksURIEQ.
+func JwksURI(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldJwksURI, v))
+}
+
+// RegistrationAccessTokenHash applies equality check predicate on the "registration_access_token_hash" field. It's identical to RegistrationAccessTokenHashEQ.
+func RegistrationAccessTokenHash(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldRegistrationAccessTokenHash, v))
+}
+
+// TokenEndpointAuthMethod applies equality check predicate on the "token_endpoint_auth_method" field. It's identical to TokenEndpointAuthMethodEQ.
+func TokenEndpointAuthMethod(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldTokenEndpointAuthMethod, v))
+}
+
+// TLSClientAuthSubjectDn applies equality check predicate on the "tls_client_auth_subject_dn" field. It's identical to TLSClientAuthSubjectDnEQ.
+func TLSClientAuthSubjectDn(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldTLSClientAuthSubjectDn, v))
+}
+
+// SubjectType applies equality check predicate on the "subject_type" field. It's identical to SubjectTypeEQ.
+func SubjectType(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldSubjectType, v))
+}
+
+// SectorIdentifierURI applies equality check predicate on the "sector_identifier_uri" field. It's identical to SectorIdentifierURIEQ.
+func SectorIdentifierURI(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldSectorIdentifierURI, v))
+}
+
+// RequirePkce applies equality check predicate on the "require_pkce" field. It's identical to RequirePkceEQ.
+func RequirePkce(v bool) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldRequirePkce, v))
+}
+
 // Public applies equality check predicate on the "public" field. It's identical to PublicEQ.
 func Public(v bool) predicate.OAuth2Client {
 	return predicate.OAuth2Client(sql.FieldEQ(FieldPublic, v))
@@ -167,6 +222,701 @@ func TrustedPeersNotNil() predicate.OAuth2Client {
 	return predicate.OAuth2Client(sql.FieldNotNull(FieldTrustedPeers))
 }
 
+// PostLogoutRedirectUrisIsNil applies the IsNil predicate on the "post_logout_redirect_uris" field.
+func PostLogoutRedirectUrisIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldPostLogoutRedirectUris))
+}
+
+// PostLogoutRedirectUrisNotNil applies the NotNil predicate on the "post_logout_redirect_uris" field.
+func PostLogoutRedirectUrisNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldPostLogoutRedirectUris))
+}
+
+// BackchannelLogoutURIEQ applies the EQ predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURINEQ applies the NEQ predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURINEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURIIn applies the In predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldBackchannelLogoutURI, vs...))
+}
+
+// BackchannelLogoutURINotIn applies the NotIn predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURINotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldBackchannelLogoutURI, vs...))
+}
+
+// BackchannelLogoutURIGT applies the GT predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURIGTE applies the GTE predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURILT applies the LT predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURILT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURILTE applies the LTE predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURILTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURIContains applies the Contains predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURIHasPrefix applies the HasPrefix predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURIHasSuffix applies the HasSuffix predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURIEqualFold applies the EqualFold predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldBackchannelLogoutURI, v))
+}
+
+// BackchannelLogoutURIContainsFold applies the ContainsFold predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldBackchannelLogoutURI, v))
+}
+
+// ClientCredentialsScopesIsNil applies the IsNil predicate on the "client_credentials_scopes" field.
+func ClientCredentialsScopesIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldClientCredentialsScopes))
+}
+
+// ClientCredentialsScopesNotNil applies the NotNil predicate on the "client_credentials_scopes" field.
+func ClientCredentialsScopesNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldClientCredentialsScopes))
+}
+
+// ClientCredentialsAudiencesIsNil applies the IsNil predicate on the "client_credentials_audiences" field.
+func ClientCredentialsAudiencesIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldClientCredentialsAudiences))
+}
+
+// ClientCredentialsAudiencesNotNil applies the NotNil predicate on the "client_credentials_audiences" field.
+func ClientCredentialsAudiencesNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldClientCredentialsAudiences))
+}
+
+// AccessTokenFormatEQ applies the EQ predicate on the "access_token_format" field.
+func AccessTokenFormatEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatNEQ applies the NEQ predicate on the "access_token_format" field.
+func AccessTokenFormatNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatIn applies the In predicate on the "access_token_format" field.
+func AccessTokenFormatIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldAccessTokenFormat, vs...))
+}
+
+// AccessTokenFormatNotIn applies the NotIn predicate on the "access_token_format" field.
+func AccessTokenFormatNotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldAccessTokenFormat, vs...))
+}
+
+// AccessTokenFormatGT applies the GT predicate on the "access_token_format" field.
+func AccessTokenFormatGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatGTE applies the GTE predicate on the "access_token_format" field.
+func AccessTokenFormatGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatLT applies the LT predicate on the "access_token_format" field.
+func AccessTokenFormatLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatLTE applies the LTE predicate on the "access_token_format" field.
+func AccessTokenFormatLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatContains applies the Contains predicate on the "access_token_format" field.
+func AccessTokenFormatContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatHasPrefix applies the HasPrefix predicate on the "access_token_format" field.
+func AccessTokenFormatHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatHasSuffix applies the HasSuffix predicate on the "access_token_format" field.
+func AccessTokenFormatHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatEqualFold applies the EqualFold predicate on the "access_token_format" field.
+func AccessTokenFormatEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldAccessTokenFormat, v))
+}
+
+// AccessTokenFormatContainsFold applies the ContainsFold predicate on the "access_token_format" field.
+func AccessTokenFormatContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldAccessTokenFormat, v))
+}
+
+// RequirePushedAuthorizationRequestsEQ applies the EQ predicate on the "require_pushed_authorization_requests" field.
+func RequirePushedAuthorizationRequestsEQ(v bool) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldRequirePushedAuthorizationRequests, v))
+}
+
+// RequirePushedAuthorizationRequestsNEQ applies the NEQ predicate on the "require_pushed_authorization_requests" field.
+func RequirePushedAuthorizationRequestsNEQ(v bool) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldRequirePushedAuthorizationRequests, v))
+}
+
+// JwksEQ applies the EQ predicate on the "jwks" field.
+func JwksEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldJwks, v))
+}
+
+// JwksNEQ applies the NEQ predicate on the "jwks" field.
+func JwksNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldJwks, v))
+}
+
+// JwksIn applies the In predicate on the "jwks" field.
+func JwksIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldJwks, vs...))
+}
+
+// JwksNotIn applies the NotIn predicate on the "jwks" field.
+func JwksNotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldJwks, vs...))
+}
+
+// JwksGT applies the GT predicate on the "jwks" field.
+func JwksGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldJwks, v))
+}
+
+// JwksGTE applies the GTE predicate on the "jwks" field.
+func JwksGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldJwks, v))
+}
+
+// JwksLT applies the LT predicate on the "jwks" field.
+func JwksLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldJwks, v))
+}
+
+// JwksLTE applies the LTE predicate on the "jwks" field.
+func JwksLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldJwks, v))
+}
+
+// JwksContains applies the Contains predicate on the "jwks" field.
+func JwksContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldJwks, v))
+}
+
+// JwksHasPrefix applies the HasPrefix predicate on the "jwks" field.
+func JwksHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldJwks, v))
+}
+
+// JwksHasSuffix applies the HasSuffix predicate on the "jwks" field.
+func JwksHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldJwks, v))
+}
+
+// JwksEqualFold applies the EqualFold predicate on the "jwks" field.
+func JwksEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldJwks, v))
+}
+
+// JwksContainsFold applies the ContainsFold predicate on the "jwks" field.
+func JwksContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldJwks, v))
+}
+
+// JwksURIEQ applies the EQ predicate on the "jwks_uri" field.
+func JwksURIEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldJwksURI, v))
+}
+
+// JwksURINEQ applies the NEQ predicate on the "jwks_uri" field.
+func JwksURINEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldJwksURI, v))
+}
+
+// JwksURIIn applies the In predicate on the "jwks_uri" field.
+func JwksURIIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldJwksURI, vs...))
+}
+
+// JwksURINotIn applies the NotIn predicate on the "jwks_uri" field.
+func JwksURINotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldJwksURI, vs...))
+}
+
+// JwksURIGT applies the GT predicate on the "jwks_uri" field.
+func JwksURIGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldJwksURI, v))
+}
+
+// JwksURIGTE applies the GTE predicate on the "jwks_uri" field.
+func JwksURIGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldJwksURI, v))
+}
+
+// JwksURILT applies the LT predicate on the "jwks_uri" field.
+func JwksURILT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldJwksURI, v))
+}
+
+// JwksURILTE applies the LTE predicate on the "jwks_uri" field.
+func JwksURILTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldJwksURI, v))
+}
+
+// JwksURIContains applies the Contains predicate on the "jwks_uri" field.
+func JwksURIContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldJwksURI, v))
+}
+
+// JwksURIHasPrefix applies the HasPrefix predicate on the "jwks_uri" field.
+func JwksURIHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldJwksURI, v))
+}
+
+// JwksURIHasSuffix applies the HasSuffix predicate on the "jwks_uri" field.
+func JwksURIHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldJwksURI, v))
+}
+
+// JwksURIEqualFold applies the EqualFold predicate on the "jwks_uri" field.
+func JwksURIEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldJwksURI, v))
+}
+
+// JwksURIContainsFold applies the ContainsFold predicate on the "jwks_uri" field.
+func JwksURIContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldJwksURI, v))
+}
+
+// RegistrationAccessTokenHashEQ applies the EQ predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashNEQ applies the NEQ predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashIn applies the In predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldRegistrationAccessTokenHash, vs...))
+}
+
+// RegistrationAccessTokenHashNotIn applies the NotIn predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashNotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldRegistrationAccessTokenHash, vs...))
+}
+
+// RegistrationAccessTokenHashGT applies the GT predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashGTE applies the GTE predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashLT applies the LT predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashLTE applies the LTE predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashContains applies the Contains predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashHasPrefix applies the HasPrefix predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashHasSuffix applies the HasSuffix predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashEqualFold applies the EqualFold predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashContainsFold applies the ContainsFold predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldRegistrationAccessTokenHash, v))
+}
+
+// TokenEndpointAuthMethodEQ applies the EQ predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodNEQ applies the NEQ predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodIn applies the In predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldTokenEndpointAuthMethod, vs...))
+}
+
+// TokenEndpointAuthMethodNotIn applies the NotIn predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodNotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldTokenEndpointAuthMethod, vs...))
+}
+
+// TokenEndpointAuthMethodGT applies the GT predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodGTE applies the GTE predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodLT applies the LT predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodLTE applies the LTE predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodContains applies the Contains predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodHasPrefix applies the HasPrefix predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodHasSuffix applies the HasSuffix predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodEqualFold applies the EqualFold predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodContainsFold applies the ContainsFold predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldTokenEndpointAuthMethod, v))
+}
+
+// TLSClientAuthSubjectDnEQ applies the EQ predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnNEQ applies the NEQ predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnIn applies the In predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldTLSClientAuthSubjectDn, vs...))
+}
+
+// TLSClientAuthSubjectDnNotIn applies the NotIn predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnNotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldTLSClientAuthSubjectDn, vs...))
+}
+
+// TLSClientAuthSubjectDnGT applies the GT predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnGTE applies the GTE predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnLT applies the LT predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnLTE applies the LTE predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnContains applies the Contains predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnHasPrefix applies the HasPrefix predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnHasSuffix applies the HasSuffix predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnEqualFold applies the EqualFold predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnContainsFold applies the ContainsFold predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldTLSClientAuthSubjectDn, v))
+}
+
+// ClaimMappingsIsNil applies the IsNil predicate on the "claim_mappings" field.
+func ClaimMappingsIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldClaimMappings))
+}
+
+// ClaimMappingsNotNil applies the NotNil predicate on the "claim_mappings" field.
+func ClaimMappingsNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldClaimMappings))
+}
+
+// SubjectTypeEQ applies the EQ predicate on the "subject_type" field.
+func SubjectTypeEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeNEQ applies the NEQ predicate on the "subject_type" field.
+func SubjectTypeNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeIn applies the In predicate on the "subject_type" field.
+func SubjectTypeIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldSubjectType, vs...))
+}
+
+// SubjectTypeNotIn applies the NotIn predicate on the "subject_type" field.
+func SubjectTypeNotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldSubjectType, vs...))
+}
+
+// SubjectTypeGT applies the GT predicate on the "subject_type" field.
+func SubjectTypeGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldSubjectType, v))
+}
+
+// SubjectTypeGTE applies the GTE predicate on the "subject_type" field.
+func SubjectTypeGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldSubjectType, v))
+}
+
+// SubjectTypeLT applies the LT predicate on the "subject_type" field.
+func SubjectTypeLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldSubjectType, v))
+}
+
+// SubjectTypeLTE applies the LTE predicate on the "subject_type" field.
+func SubjectTypeLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldSubjectType, v))
+}
+
+// SubjectTypeContains applies the Contains predicate on the "subject_type" field.
+func SubjectTypeContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldSubjectType, v))
+}
+
+// SubjectTypeHasPrefix applies the HasPrefix predicate on the "subject_type" field.
+func SubjectTypeHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldSubjectType, v))
+}
+
+// SubjectTypeHasSuffix applies the HasSuffix predicate on the "subject_type" field.
+func SubjectTypeHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldSubjectType, v))
+}
+
+// SubjectTypeEqualFold applies the EqualFold predicate on the "subject_type" field.
+func SubjectTypeEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldSubjectType, v))
+}
+
+// SubjectTypeContainsFold applies the ContainsFold predicate on the "subject_type" field.
+func SubjectTypeContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldSubjectType, v))
+}
+
+// SectorIdentifierURIEQ applies the EQ predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURINEQ applies the NEQ predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURINEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURIIn applies the In predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIn(FieldSectorIdentifierURI, vs...))
+}
+
+// SectorIdentifierURINotIn applies the NotIn predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURINotIn(vs ...string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotIn(FieldSectorIdentifierURI, vs...))
+}
+
+// SectorIdentifierURIGT applies the GT predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGT(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURIGTE applies the GTE predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldGTE(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURILT applies the LT predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURILT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLT(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURILTE applies the LTE predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURILTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldLTE(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURIContains applies the Contains predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContains(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURIHasPrefix applies the HasPrefix predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasPrefix(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURIHasSuffix applies the HasSuffix predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldHasSuffix(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURIEqualFold applies the EqualFold predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEqualFold(FieldSectorIdentifierURI, v))
+}
+
+// SectorIdentifierURIContainsFold applies the ContainsFold predicate on the "sector_identifier_uri" field.
+func SectorIdentifierURIContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldContainsFold(FieldSectorIdentifierURI, v))
+}
+
+// RequiredScopesIsNil applies the IsNil predicate on the "required_scopes" field.
+func RequiredScopesIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldRequiredScopes))
+}
+
+// RequiredScopesNotNil applies the NotNil predicate on the "required_scopes" field.
+func RequiredScopesNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldRequiredScopes))
+}
+
+// ExpiryIsNil applies the IsNil predicate on the "expiry" field.
+func ExpiryIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldExpiry))
+}
+
+// ExpiryNotNil applies the NotNil predicate on the "expiry" field.
+func ExpiryNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldExpiry))
+}
+
+// AllowedConnectorsIsNil applies the IsNil predicate on the "allowed_connectors" field.
+func AllowedConnectorsIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldAllowedConnectors))
+}
+
+// AllowedConnectorsNotNil applies the NotNil predicate on the "allowed_connectors" field.
+func AllowedConnectorsNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldAllowedConnectors))
+}
+
+// AllowedGrantTypesIsNil applies the IsNil predicate on the "allowed_grant_types" field.
+func AllowedGrantTypesIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldAllowedGrantTypes))
+}
+
+// AllowedGrantTypesNotNil applies the NotNil predicate on the "allowed_grant_types" field.
+func AllowedGrantTypesNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldAllowedGrantTypes))
+}
+
+// AllowedResponseTypesIsNil applies the IsNil predicate on the "allowed_response_types" field.
+func AllowedResponseTypesIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldIsNull(FieldAllowedResponseTypes))
+}
+
+// AllowedResponseTypesNotNil applies the NotNil predicate on the "allowed_response_types" field.
+func AllowedResponseTypesNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNotNull(FieldAllowedResponseTypes))
+}
+
+// RequirePkceEQ applies the EQ predicate on the "require_pkce" field.
+func RequirePkceEQ(v bool) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldEQ(FieldRequirePkce, v))
+}
+
+// RequirePkceNEQ applies the NEQ predicate on the "require_pkce" field.
+func RequirePkceNEQ(v bool) predicate.OAuth2Client {
+	return predicate.OAuth2Client(sql.FieldNEQ(FieldRequirePkce, v))
+}
+
 // PublicEQ applies the EQ predicate on the "public" field.
 func PublicEQ(v bool) predicate.OAuth2Client {
 	return predicate.OAuth2Client(sql.FieldEQ(FieldPublic, v))
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 )
 
@@ -37,6 +38,214 @@ func (_c *OAuth2ClientCreate) SetTrustedPeers(v []string) *OAuth2ClientCreate {
 	return _c
 }
 
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (_c *OAuth2ClientCreate) SetPostLogoutRedirectUris(v []string) *OAuth2ClientCreate {
+	_c.mutation.SetPostLogoutRedirectUris(v)
+	return _c
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (_c *OAuth2ClientCreate) SetBackchannelLogoutURI(v string) *OAuth2ClientCreate {
+	_c.mutation.SetBackchannelLogoutURI(v)
+	return _c
+}
+
+// SetNillableBackchannelLogoutURI sets the "backchannel_logout_uri" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableBackchannelLogoutURI(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetBackchannelLogoutURI(*v)
+	}
+	return _c
+}
+
+// SetClientCredentialsScopes sets the "client_credentials_scopes" field.
+func (_c *OAuth2ClientCreate) SetClientCredentialsScopes(v []string) *OAuth2ClientCreate {
+	_c.mutation.SetClientCredentialsScopes(v)
+	return _c
+}
+
+// SetClientCredentialsAudiences sets the "client_credentials_audiences" field.
+func (_c *OAuth2ClientCreate) SetClientCredentialsAudiences(v []string) *OAuth2ClientCreate {
+	_c.mutation.SetClientCredentialsAudiences(v)
+	return _c
+}
+
+// SetAccessTokenFormat sets the "access_token_format" field.
+func (_c *OAuth2ClientCreate) SetAccessTokenFormat(v string) *OAuth2ClientCreate {
+	_c.mutation.SetAccessTokenFormat(v)
+	return _c
+}
+
+// SetNillableAccessTokenFormat sets the "access_token_format" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableAccessTokenFormat(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetAccessTokenFormat(*v)
+	}
+	return _c
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (_c *OAuth2ClientCreate) SetRequirePushedAuthorizationRequests(v bool) *OAuth2ClientCreate {
+	_c.mutation.SetRequirePushedAuthorizationRequests(v)
+	return _c
+}
+
+// SetNillableRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableRequirePushedAuthorizationRequests(v *bool) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetRequirePushedAuthorizationRequests(*v)
+	}
+	return _c
+}
+
+// SetJwks sets the "jwks" field.
+func (_c *OAuth2ClientCreate) SetJwks(v string) *OAuth2ClientCreate {
+	_c.mutation.SetJwks(v)
+	return _c
+}
+
+// SetNillableJwks sets the "jwks" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableJwks(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetJwks(*v)
+	}
+	return _c
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (_c *OAuth2ClientCreate) SetJwksURI(v string) *OAuth2ClientCreate {
+	_c.mutation.SetJwksURI(v)
+	return _c
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableJwksURI(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetJwksURI(*v)
+	}
+	return _c
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (_c *OAuth2ClientCreate) SetRegistrationAccessTokenHash(v string) *OAuth2ClientCreate {
+	_c.mutation.SetRegistrationAccessTokenHash(v)
+	return _c
+}
+
+// SetNillableRegistrationAccessTokenHash sets the "registration_access_token_hash" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableRegistrationAccessTokenHash(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetRegistrationAccessTokenHash(*v)
+	}
+	return _c
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (_c *OAuth2ClientCreate) SetTokenEndpointAuthMethod(v string) *OAuth2ClientCreate {
+	_c.mutation.SetTokenEndpointAuthMethod(v)
+	return _c
+}
+
+// SetNillableTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableTokenEndpointAuthMethod(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetTokenEndpointAuthMethod(*v)
+	}
+	return _c
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (_c *OAuth2ClientCreate) SetTLSClientAuthSubjectDn(v string) *OAuth2ClientCreate {
+	_c.mutation.SetTLSClientAuthSubjectDn(v)
+	return _c
+}
+
+// SetNillableTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableTLSClientAuthSubjectDn(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetTLSClientAuthSubjectDn(*v)
+	}
+	return _c
+}
+
+// SetClaimMappings sets the "claim_mappings" field.
+func (_c *OAuth2ClientCreate) SetClaimMappings(v []storage.ClaimMapping) *OAuth2ClientCreate {
+	_c.mutation.SetClaimMappings(v)
+	return _c
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_c *OAuth2ClientCreate) SetSubjectType(v string) *OAuth2ClientCreate {
+	_c.mutation.SetSubjectType(v)
+	return _c
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableSubjectType(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetSubjectType(*v)
+	}
+	return _c
+}
+
+// SetSectorIdentifierURI sets the "sector_identifier_uri" field.
+func (_c *OAuth2ClientCreate) SetSectorIdentifierURI(v string) *OAuth2ClientCreate {
+	_c.mutation.SetSectorIdentifierURI(v)
+	return _c
+}
+
+// SetNillableSectorIdentifierURI sets the "sector_identifier_uri" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableSectorIdentifierURI(v *string) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetSectorIdentifierURI(*v)
+	}
+	return _c
+}
+
+// SetRequiredScopes sets the "required_scopes" field.
+func (_c *OAuth2ClientCreate) SetRequiredScopes(v []string) *OAuth2ClientCreate {
+	_c.mutation.SetRequiredScopes(v)
+	return _c
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *OAuth2ClientCreate) SetExpiry(v *storage.ClientExpiry) *OAuth2ClientCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetAllowedConnectors sets the "allowed_connectors" field.
+func (_c *OAuth2ClientCreate) SetAllowedConnectors(v []string) *OAuth2ClientCreate {
+	_c.mutation.SetAllowedConnectors(v)
+	return _c
+}
+
+// SetAllowedGrantTypes sets the "allowed_grant_types" field.
+func (_c *OAuth2ClientCreate) SetAllowedGrantTypes(v []string) *OAuth2ClientCreate {
+	_c.mutation.SetAllowedGrantTypes(v)
+	return _c
+}
+
+// SetAllowedResponseTypes sets the "allowed_response_types" field.
+func (_c *OAuth2ClientCreate) SetAllowedResponseTypes(v []string) *OAuth2ClientCreate {
+	_c.mutation.SetAllowedResponseTypes(v)
+	return _c
+}
+
+// SetRequirePkce sets the "require_pkce" field.
+func (_c *OAuth2ClientCreate) SetRequirePkce(v bool) *OAuth2ClientCreate {
+	_c.mutation.SetRequirePkce(v)
+	return _c
+}
+
+// SetNillableRequirePkce sets the "require_pkce" field if the given value is not nil.
+func (_c *OAuth2ClientCreate) SetNillableRequirePkce(v *bool) *OAuth2ClientCreate {
+	if v != nil {
+		_c.SetRequirePkce(*v)
+	}
+	return _c
+}
+
 // SetPublic sets the "public" field.
 func (_c *OAuth2ClientCreate) SetPublic(v bool) *OAuth2ClientCreate {
 	_c.mutation.SetPublic(v)
@@ -68,6 +277,7 @@ func (_c *OAuth2ClientCreate) Mutation() *OAuth2ClientMutation {
 
 // Save creates the OAuth2Client in the database.
 func (_c *OAuth2ClientCreate) Save(ctx context.Context) (*OAuth2Client, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -93,6 +303,54 @@ func (_c *OAuth2ClientCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *OAuth2ClientCreate) defaults() {
+	if _, ok := _c.mutation.BackchannelLogoutURI(); !ok {
+		v := oauth2client.DefaultBackchannelLogoutURI
+		_c.mutation.SetBackchannelLogoutURI(v)
+	}
+	if _, ok := _c.mutation.AccessTokenFormat(); !ok {
+		v := oauth2client.DefaultAccessTokenFormat
+		_c.mutation.SetAccessTokenFormat(v)
+	}
+	if _, ok := _c.mutation.RequirePushedAuthorizationRequests(); !ok {
+		v := oauth2client.DefaultRequirePushedAuthorizationRequests
+		_c.mutation.SetRequirePushedAuthorizationRequests(v)
+	}
+	if _, ok := _c.mutation.Jwks(); !ok {
+		v := oauth2client.DefaultJwks
+		_c.mutation.SetJwks(v)
+	}
+	if _, ok := _c.mutation.JwksURI(); !ok {
+		v := oauth2client.DefaultJwksURI
+		_c.mutation.SetJwksURI(v)
+	}
+	if _, ok := _c.mutation.RegistrationAccessTokenHash(); !ok {
+		v := oauth2client.DefaultRegistrationAccessTokenHash
+		_c.mutation.SetRegistrationAccessTokenHash(v)
+	}
+	if _, ok := _c.mutation.TokenEndpointAuthMethod(); !ok {
+		v := oauth2client.DefaultTokenEndpointAuthMethod
+		_c.mutation.SetTokenEndpointAuthMethod(v)
+	}
+	if _, ok := _c.mutation.TLSClientAuthSubjectDn(); !ok {
+		v := oauth2client.DefaultTLSClientAuthSubjectDn
+		_c.mutation.SetTLSClientAuthSubjectDn(v)
+	}
+	if _, ok := _c.mutation.SubjectType(); !ok {
+		v := oauth2client.DefaultSubjectType
+		_c.mutation.SetSubjectType(v)
+	}
+	if _, ok := _c.mutation.SectorIdentifierURI(); !ok {
+		v := oauth2client.DefaultSectorIdentifierURI
+		_c.mutation.SetSectorIdentifierURI(v)
+	}
+	if _, ok := _c.mutation.RequirePkce(); !ok {
+		v := oauth2client.DefaultRequirePkce
+		_c.mutation.SetRequirePkce(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *OAuth2ClientCreate) check() error {
 	if _, ok := _c.mutation.Secret(); !ok {
@@ -103,6 +361,39 @@ func (_c *OAuth2ClientCreate) check() error {
 			return &ValidationError{Name: "secret", err: fmt.Errorf(`db: validator failed for field "OAuth2Client.secret": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.BackchannelLogoutURI(); !ok {
+		return &ValidationError{Name: "backchannel_logout_uri", err: errors.New(`db: missing required field "OAuth2Client.backchannel_logout_uri"`)}
+	}
+	if _, ok := _c.mutation.AccessTokenFormat(); !ok {
+		return &ValidationError{Name: "access_token_format", err: errors.New(`db: missing required field "OAuth2Client.access_token_format"`)}
+	}
+	if _, ok := _c.mutation.RequirePushedAuthorizationRequests(); !ok {
+		return &ValidationError{Name: "require_pushed_authorization_requests", err: errors.New(`db: missing required field "OAuth2Client.require_pushed_authorization_requests"`)}
+	}
+	if _, ok := _c.mutation.Jwks(); !ok {
+		return &ValidationError{Name: "jwks", err: errors.New(`db: missing required field "OAuth2Client.jwks"`)}
+	}
+	if _, ok := _c.mutation.JwksURI(); !ok {
+		return &ValidationError{Name: "jwks_uri", err: errors.New(`db: missing required field "OAuth2Client.jwks_uri"`)}
+	}
+	if _, ok := _c.mutation.RegistrationAccessTokenHash(); !ok {
+		return &ValidationError{Name: "registration_access_token_hash", err: errors.New(`db: missing required field "OAuth2Client.registration_access_token_hash"`)}
+	}
+	if _, ok := _c.mutation.TokenEndpointAuthMethod(); !ok {
+		return &ValidationError{Name: "token_endpoint_auth_method", err: errors.New(`db: missing required field "OAuth2Client.token_endpoint_auth_method"`)}
+	}
+	if _, ok := _c.mutation.TLSClientAuthSubjectDn(); !ok {
+		return &ValidationError{Name: "tls_client_auth_subject_dn", err: errors.New(`db: missing required field "OAuth2Client.tls_client_auth_subject_dn"`)}
+	}
+	if _, ok := _c.mutation.SubjectType(); !ok {
+		return &ValidationError{Name: "subject_type", err: errors.New(`db: missing required field "OAuth2Client.subject_type"`)}
+	}
+	if _, ok := _c.mutation.SectorIdentifierURI(); !ok {
+		return &ValidationError{Name: "sector_identifier_uri", err: errors.New(`db: missing required field "OAuth2Client.sector_identifier_uri"`)}
+	}
+	if _, ok := _c.mutation.RequirePkce(); !ok {
+		return &ValidationError{Name: "require_pkce", err: errors.New(`db: missing required field "OAuth2Client.require_pkce"`)}
+	}
 	if _, ok := _c.mutation.Public(); !ok {
 		return &ValidationError{Name: "public", err: errors.New(`db: missing required field "OAuth2Client.public"`)}
 	}
@@ -174,6 +465,86 @@ func (_c *OAuth2ClientCreate) createSpec() (*OAuth2Client, *sqlgraph.CreateSpec)
 		_spec.SetField(oauth2client.FieldTrustedPeers, field.TypeJSON, value)
 		_node.TrustedPeers = value
 	}
+	if value, ok := _c.mutation.PostLogoutRedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON, value)
+		_node.PostLogoutRedirectUris = value
+	}
+	if value, ok := _c.mutation.BackchannelLogoutURI(); ok {
+		_spec.SetField(oauth2client.FieldBackchannelLogoutURI, field.TypeString, value)
+		_node.BackchannelLogoutURI = value
+	}
+	if value, ok := _c.mutation.ClientCredentialsScopes(); ok {
+		_spec.SetField(oauth2client.FieldClientCredentialsScopes, field.TypeJSON, value)
+		_node.ClientCredentialsScopes = value
+	}
+	if value, ok := _c.mutation.ClientCredentialsAudiences(); ok {
+		_spec.SetField(oauth2client.FieldClientCredentialsAudiences, field.TypeJSON, value)
+		_node.ClientCredentialsAudiences = value
+	}
+	if value, ok := _c.mutation.AccessTokenFormat(); ok {
+		_spec.SetField(oauth2client.FieldAccessTokenFormat, field.TypeString, value)
+		_node.AccessTokenFormat = value
+	}
+	if value, ok := _c.mutation.RequirePushedAuthorizationRequests(); ok {
+		_spec.SetField(oauth2client.FieldRequirePushedAuthorizationRequests, field.TypeBool, value)
+		_node.RequirePushedAuthorizationRequests = value
+	}
+	if value, ok := _c.mutation.Jwks(); ok {
+		_spec.SetField(oauth2client.FieldJwks, field.TypeString, value)
+		_node.Jwks = value
+	}
+	if value, ok := _c.mutation.JwksURI(); ok {
+		_spec.SetField(oauth2client.FieldJwksURI, field.TypeString, value)
+		_node.JwksURI = value
+	}
+	if value, ok := _c.mutation.RegistrationAccessTokenHash(); ok {
+		_spec.SetField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString, value)
+		_node.RegistrationAccessTokenHash = value
+	}
+	if value, ok := _c.mutation.TokenEndpointAuthMethod(); ok {
+		_spec.SetField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString, value)
+		_node.TokenEndpointAuthMethod = value
+	}
+	if value, ok := _c.mutation.TLSClientAuthSubjectDn(); ok {
+		_spec.SetField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString, value)
+		_node.TLSClientAuthSubjectDn = value
+	}
+	if value, ok := _c.mutation.ClaimMappings(); ok {
+		_spec.SetField(oauth2client.FieldClaimMappings, field.TypeJSON, value)
+		_node.ClaimMappings = value
+	}
+	if value, ok := _c.mutation.SubjectType(); ok {
+		_spec.SetField(oauth2client.FieldSubjectType, field.TypeString, value)
+		_node.SubjectType = value
+	}
+	if value, ok := _c.mutation.SectorIdentifierURI(); ok {
+		_spec.SetField(oauth2client.FieldSectorIdentifierURI, field.TypeString, value)
+		_node.SectorIdentifierURI = value
+	}
+	if value, ok := _c.mutation.RequiredScopes(); ok {
+		_spec.SetField(oauth2client.FieldRequiredScopes, field.TypeJSON, value)
+		_node.RequiredScopes = value
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(oauth2client.FieldExpiry, field.TypeJSON, value)
+		_node.Expiry = value
+	}
+	if value, ok := _c.mutation.AllowedConnectors(); ok {
+		_spec.SetField(oauth2client.FieldAllowedConnectors, field.TypeJSON, value)
+		_node.AllowedConnectors = value
+	}
+	if value, ok := _c.mutation.AllowedGrantTypes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedGrantTypes, field.TypeJSON, value)
+		_node.AllowedGrantTypes = value
+	}
+	if value, ok := _c.mutation.AllowedResponseTypes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedResponseTypes, field.TypeJSON, value)
+		_node.AllowedResponseTypes = value
+	}
+	if value, ok := _c.mutation.RequirePkce(); ok {
+		_spec.SetField(oauth2client.FieldRequirePkce, field.TypeBool, value)
+		_node.RequirePkce = value
+	}
 	if value, ok := _c.mutation.Public(); ok {
 		_spec.SetField(oauth2client.FieldPublic, field.TypeBool, value)
 		_node.Public = value
@@ -207,6 +578,7 @@ func (_c *OAuth2ClientCreateBulk) Save(ctx context.Context) ([]*OAuth2Client, er
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*OAuth2ClientMutation)
 				if !ok {
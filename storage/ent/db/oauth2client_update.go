@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/predicate"
 )
@@ -78,6 +79,316 @@ func (_u *OAuth2ClientUpdate) ClearTrustedPeers() *OAuth2ClientUpdate {
 	return _u
 }
 
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (_u *OAuth2ClientUpdate) SetPostLogoutRedirectUris(v []string) *OAuth2ClientUpdate {
+	_u.mutation.SetPostLogoutRedirectUris(v)
+	return _u
+}
+
+// AppendPostLogoutRedirectUris appends value to the "post_logout_redirect_uris" field.
+func (_u *OAuth2ClientUpdate) AppendPostLogoutRedirectUris(v []string) *OAuth2ClientUpdate {
+	_u.mutation.AppendPostLogoutRedirectUris(v)
+	return _u
+}
+
+// ClearPostLogoutRedirectUris clears the value of the "post_logout_redirect_uris" field.
+func (_u *OAuth2ClientUpdate) ClearPostLogoutRedirectUris() *OAuth2ClientUpdate {
+	_u.mutation.ClearPostLogoutRedirectUris()
+	return _u
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (_u *OAuth2ClientUpdate) SetBackchannelLogoutURI(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetBackchannelLogoutURI(v)
+	return _u
+}
+
+// SetNillableBackchannelLogoutURI sets the "backchannel_logout_uri" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableBackchannelLogoutURI(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetBackchannelLogoutURI(*v)
+	}
+	return _u
+}
+
+// SetClientCredentialsScopes sets the "client_credentials_scopes" field.
+func (_u *OAuth2ClientUpdate) SetClientCredentialsScopes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.SetClientCredentialsScopes(v)
+	return _u
+}
+
+// AppendClientCredentialsScopes appends value to the "client_credentials_scopes" field.
+func (_u *OAuth2ClientUpdate) AppendClientCredentialsScopes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.AppendClientCredentialsScopes(v)
+	return _u
+}
+
+// ClearClientCredentialsScopes clears the value of the "client_credentials_scopes" field.
+func (_u *OAuth2ClientUpdate) ClearClientCredentialsScopes() *OAuth2ClientUpdate {
+	_u.mutation.ClearClientCredentialsScopes()
+	return _u
+}
+
+// SetClientCredentialsAudiences sets the "client_credentials_audiences" field.
+func (_u *OAuth2ClientUpdate) SetClientCredentialsAudiences(v []string) *OAuth2ClientUpdate {
+	_u.mutation.SetClientCredentialsAudiences(v)
+	return _u
+}
+
+// AppendClientCredentialsAudiences appends value to the "client_credentials_audiences" field.
+func (_u *OAuth2ClientUpdate) AppendClientCredentialsAudiences(v []string) *OAuth2ClientUpdate {
+	_u.mutation.AppendClientCredentialsAudiences(v)
+	return _u
+}
+
+// ClearClientCredentialsAudiences clears the value of the "client_credentials_audiences" field.
+func (_u *OAuth2ClientUpdate) ClearClientCredentialsAudiences() *OAuth2ClientUpdate {
+	_u.mutation.ClearClientCredentialsAudiences()
+	return _u
+}
+
+// SetAccessTokenFormat sets the "access_token_format" field.
+func (_u *OAuth2ClientUpdate) SetAccessTokenFormat(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetAccessTokenFormat(v)
+	return _u
+}
+
+// SetNillableAccessTokenFormat sets the "access_token_format" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableAccessTokenFormat(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetAccessTokenFormat(*v)
+	}
+	return _u
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (_u *OAuth2ClientUpdate) SetRequirePushedAuthorizationRequests(v bool) *OAuth2ClientUpdate {
+	_u.mutation.SetRequirePushedAuthorizationRequests(v)
+	return _u
+}
+
+// SetNillableRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableRequirePushedAuthorizationRequests(v *bool) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetRequirePushedAuthorizationRequests(*v)
+	}
+	return _u
+}
+
+// SetJwks sets the "jwks" field.
+func (_u *OAuth2ClientUpdate) SetJwks(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetJwks(v)
+	return _u
+}
+
+// SetNillableJwks sets the "jwks" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableJwks(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetJwks(*v)
+	}
+	return _u
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (_u *OAuth2ClientUpdate) SetJwksURI(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetJwksURI(v)
+	return _u
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableJwksURI(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetJwksURI(*v)
+	}
+	return _u
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (_u *OAuth2ClientUpdate) SetRegistrationAccessTokenHash(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetRegistrationAccessTokenHash(v)
+	return _u
+}
+
+// SetNillableRegistrationAccessTokenHash sets the "registration_access_token_hash" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableRegistrationAccessTokenHash(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetRegistrationAccessTokenHash(*v)
+	}
+	return _u
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (_u *OAuth2ClientUpdate) SetTokenEndpointAuthMethod(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetTokenEndpointAuthMethod(v)
+	return _u
+}
+
+// SetNillableTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableTokenEndpointAuthMethod(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetTokenEndpointAuthMethod(*v)
+	}
+	return _u
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (_u *OAuth2ClientUpdate) SetTLSClientAuthSubjectDn(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetTLSClientAuthSubjectDn(v)
+	return _u
+}
+
+// SetNillableTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableTLSClientAuthSubjectDn(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetTLSClientAuthSubjectDn(*v)
+	}
+	return _u
+}
+
+// SetClaimMappings sets the "claim_mappings" field.
+func (_u *OAuth2ClientUpdate) SetClaimMappings(v []storage.ClaimMapping) *OAuth2ClientUpdate {
+	_u.mutation.SetClaimMappings(v)
+	return _u
+}
+
+// AppendClaimMappings appends value to the "claim_mappings" field.
+func (_u *OAuth2ClientUpdate) AppendClaimMappings(v []storage.ClaimMapping) *OAuth2ClientUpdate {
+	_u.mutation.AppendClaimMappings(v)
+	return _u
+}
+
+// ClearClaimMappings clears the value of the "claim_mappings" field.
+func (_u *OAuth2ClientUpdate) ClearClaimMappings() *OAuth2ClientUpdate {
+	_u.mutation.ClearClaimMappings()
+	return _u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_u *OAuth2ClientUpdate) SetSubjectType(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetSubjectType(v)
+	return _u
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableSubjectType(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetSubjectType(*v)
+	}
+	return _u
+}
+
+// SetSectorIdentifierURI sets the "sector_identifier_uri" field.
+func (_u *OAuth2ClientUpdate) SetSectorIdentifierURI(v string) *OAuth2ClientUpdate {
+	_u.mutation.SetSectorIdentifierURI(v)
+	return _u
+}
+
+// SetNillableSectorIdentifierURI sets the "sector_identifier_uri" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableSectorIdentifierURI(v *string) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetSectorIdentifierURI(*v)
+	}
+	return _u
+}
+
+// SetRequiredScopes sets the "required_scopes" field.
+func (_u *OAuth2ClientUpdate) SetRequiredScopes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.SetRequiredScopes(v)
+	return _u
+}
+
+// AppendRequiredScopes appends value to the "required_scopes" field.
+func (_u *OAuth2ClientUpdate) AppendRequiredScopes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.AppendRequiredScopes(v)
+	return _u
+}
+
+// ClearRequiredScopes clears the value of the "required_scopes" field.
+func (_u *OAuth2ClientUpdate) ClearRequiredScopes() *OAuth2ClientUpdate {
+	_u.mutation.ClearRequiredScopes()
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *OAuth2ClientUpdate) SetExpiry(v *storage.ClientExpiry) *OAuth2ClientUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (_u *OAuth2ClientUpdate) ClearExpiry() *OAuth2ClientUpdate {
+	_u.mutation.ClearExpiry()
+	return _u
+}
+
+// SetAllowedConnectors sets the "allowed_connectors" field.
+func (_u *OAuth2ClientUpdate) SetAllowedConnectors(v []string) *OAuth2ClientUpdate {
+	_u.mutation.SetAllowedConnectors(v)
+	return _u
+}
+
+// AppendAllowedConnectors appends value to the "allowed_connectors" field.
+func (_u *OAuth2ClientUpdate) AppendAllowedConnectors(v []string) *OAuth2ClientUpdate {
+	_u.mutation.AppendAllowedConnectors(v)
+	return _u
+}
+
+// ClearAllowedConnectors clears the value of the "allowed_connectors" field.
+func (_u *OAuth2ClientUpdate) ClearAllowedConnectors() *OAuth2ClientUpdate {
+	_u.mutation.ClearAllowedConnectors()
+	return _u
+}
+
+// SetAllowedGrantTypes sets the "allowed_grant_types" field.
+func (_u *OAuth2ClientUpdate) SetAllowedGrantTypes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.SetAllowedGrantTypes(v)
+	return _u
+}
+
+// AppendAllowedGrantTypes appends value to the "allowed_grant_types" field.
+func (_u *OAuth2ClientUpdate) AppendAllowedGrantTypes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.AppendAllowedGrantTypes(v)
+	return _u
+}
+
+// ClearAllowedGrantTypes clears the value of the "allowed_grant_types" field.
+func (_u *OAuth2ClientUpdate) ClearAllowedGrantTypes() *OAuth2ClientUpdate {
+	_u.mutation.ClearAllowedGrantTypes()
+	return _u
+}
+
+// SetAllowedResponseTypes sets the "allowed_response_types" field.
+func (_u *OAuth2ClientUpdate) SetAllowedResponseTypes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.SetAllowedResponseTypes(v)
+	return _u
+}
+
+// AppendAllowedResponseTypes appends value to the "allowed_response_types" field.
+func (_u *OAuth2ClientUpdate) AppendAllowedResponseTypes(v []string) *OAuth2ClientUpdate {
+	_u.mutation.AppendAllowedResponseTypes(v)
+	return _u
+}
+
+// ClearAllowedResponseTypes clears the value of the "allowed_response_types" field.
+func (_u *OAuth2ClientUpdate) ClearAllowedResponseTypes() *OAuth2ClientUpdate {
+	_u.mutation.ClearAllowedResponseTypes()
+	return _u
+}
+
+// SetRequirePkce sets the "require_pkce" field.
+func (_u *OAuth2ClientUpdate) SetRequirePkce(v bool) *OAuth2ClientUpdate {
+	_u.mutation.SetRequirePkce(v)
+	return _u
+}
+
+// SetNillableRequirePkce sets the "require_pkce" field if the given value is not nil.
+func (_u *OAuth2ClientUpdate) SetNillableRequirePkce(v *bool) *OAuth2ClientUpdate {
+	if v != nil {
+		_u.SetRequirePkce(*v)
+	}
+	return _u
+}
+
 // SetPublic sets the "public" field.
 func (_u *OAuth2ClientUpdate) SetPublic(v bool) *OAuth2ClientUpdate {
 	_u.mutation.SetPublic(v)
@@ -209,6 +520,133 @@ func (_u *OAuth2ClientUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.TrustedPeersCleared() {
 		_spec.ClearField(oauth2client.FieldTrustedPeers, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.PostLogoutRedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPostLogoutRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldPostLogoutRedirectUris, value)
+		})
+	}
+	if _u.mutation.PostLogoutRedirectUrisCleared() {
+		_spec.ClearField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BackchannelLogoutURI(); ok {
+		_spec.SetField(oauth2client.FieldBackchannelLogoutURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientCredentialsScopes(); ok {
+		_spec.SetField(oauth2client.FieldClientCredentialsScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientCredentialsScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldClientCredentialsScopes, value)
+		})
+	}
+	if _u.mutation.ClientCredentialsScopesCleared() {
+		_spec.ClearField(oauth2client.FieldClientCredentialsScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClientCredentialsAudiences(); ok {
+		_spec.SetField(oauth2client.FieldClientCredentialsAudiences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientCredentialsAudiences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldClientCredentialsAudiences, value)
+		})
+	}
+	if _u.mutation.ClientCredentialsAudiencesCleared() {
+		_spec.ClearField(oauth2client.FieldClientCredentialsAudiences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessTokenFormat(); ok {
+		_spec.SetField(oauth2client.FieldAccessTokenFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequirePushedAuthorizationRequests(); ok {
+		_spec.SetField(oauth2client.FieldRequirePushedAuthorizationRequests, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Jwks(); ok {
+		_spec.SetField(oauth2client.FieldJwks, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.JwksURI(); ok {
+		_spec.SetField(oauth2client.FieldJwksURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RegistrationAccessTokenHash(); ok {
+		_spec.SetField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenEndpointAuthMethod(); ok {
+		_spec.SetField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TLSClientAuthSubjectDn(); ok {
+		_spec.SetField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimMappings(); ok {
+		_spec.SetField(oauth2client.FieldClaimMappings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaimMappings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldClaimMappings, value)
+		})
+	}
+	if _u.mutation.ClaimMappingsCleared() {
+		_spec.ClearField(oauth2client.FieldClaimMappings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SubjectType(); ok {
+		_spec.SetField(oauth2client.FieldSubjectType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SectorIdentifierURI(); ok {
+		_spec.SetField(oauth2client.FieldSectorIdentifierURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequiredScopes(); ok {
+		_spec.SetField(oauth2client.FieldRequiredScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequiredScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRequiredScopes, value)
+		})
+	}
+	if _u.mutation.RequiredScopesCleared() {
+		_spec.ClearField(oauth2client.FieldRequiredScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(oauth2client.FieldExpiry, field.TypeJSON, value)
+	}
+	if _u.mutation.ExpiryCleared() {
+		_spec.ClearField(oauth2client.FieldExpiry, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedConnectors(); ok {
+		_spec.SetField(oauth2client.FieldAllowedConnectors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedConnectors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedConnectors, value)
+		})
+	}
+	if _u.mutation.AllowedConnectorsCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedConnectors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedGrantTypes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedGrantTypes, value)
+		})
+	}
+	if _u.mutation.AllowedGrantTypesCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedGrantTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedResponseTypes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedResponseTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedResponseTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedResponseTypes, value)
+		})
+	}
+	if _u.mutation.AllowedResponseTypesCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedResponseTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequirePkce(); ok {
+		_spec.SetField(oauth2client.FieldRequirePkce, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Public(); ok {
 		_spec.SetField(oauth2client.FieldPublic, field.TypeBool, value)
 	}
@@ -288,6 +726,316 @@ func (_u *OAuth2ClientUpdateOne) ClearTrustedPeers() *OAuth2ClientUpdateOne {
 	return _u
 }
 
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (_u *OAuth2ClientUpdateOne) SetPostLogoutRedirectUris(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetPostLogoutRedirectUris(v)
+	return _u
+}
+
+// AppendPostLogoutRedirectUris appends value to the "post_logout_redirect_uris" field.
+func (_u *OAuth2ClientUpdateOne) AppendPostLogoutRedirectUris(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendPostLogoutRedirectUris(v)
+	return _u
+}
+
+// ClearPostLogoutRedirectUris clears the value of the "post_logout_redirect_uris" field.
+func (_u *OAuth2ClientUpdateOne) ClearPostLogoutRedirectUris() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearPostLogoutRedirectUris()
+	return _u
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (_u *OAuth2ClientUpdateOne) SetBackchannelLogoutURI(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetBackchannelLogoutURI(v)
+	return _u
+}
+
+// SetNillableBackchannelLogoutURI sets the "backchannel_logout_uri" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableBackchannelLogoutURI(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetBackchannelLogoutURI(*v)
+	}
+	return _u
+}
+
+// SetClientCredentialsScopes sets the "client_credentials_scopes" field.
+func (_u *OAuth2ClientUpdateOne) SetClientCredentialsScopes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetClientCredentialsScopes(v)
+	return _u
+}
+
+// AppendClientCredentialsScopes appends value to the "client_credentials_scopes" field.
+func (_u *OAuth2ClientUpdateOne) AppendClientCredentialsScopes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendClientCredentialsScopes(v)
+	return _u
+}
+
+// ClearClientCredentialsScopes clears the value of the "client_credentials_scopes" field.
+func (_u *OAuth2ClientUpdateOne) ClearClientCredentialsScopes() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearClientCredentialsScopes()
+	return _u
+}
+
+// SetClientCredentialsAudiences sets the "client_credentials_audiences" field.
+func (_u *OAuth2ClientUpdateOne) SetClientCredentialsAudiences(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetClientCredentialsAudiences(v)
+	return _u
+}
+
+// AppendClientCredentialsAudiences appends value to the "client_credentials_audiences" field.
+func (_u *OAuth2ClientUpdateOne) AppendClientCredentialsAudiences(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendClientCredentialsAudiences(v)
+	return _u
+}
+
+// ClearClientCredentialsAudiences clears the value of the "client_credentials_audiences" field.
+func (_u *OAuth2ClientUpdateOne) ClearClientCredentialsAudiences() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearClientCredentialsAudiences()
+	return _u
+}
+
+// SetAccessTokenFormat sets the "access_token_format" field.
+func (_u *OAuth2ClientUpdateOne) SetAccessTokenFormat(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetAccessTokenFormat(v)
+	return _u
+}
+
+// SetNillableAccessTokenFormat sets the "access_token_format" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableAccessTokenFormat(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetAccessTokenFormat(*v)
+	}
+	return _u
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (_u *OAuth2ClientUpdateOne) SetRequirePushedAuthorizationRequests(v bool) *OAuth2ClientUpdateOne {
+	_u.mutation.SetRequirePushedAuthorizationRequests(v)
+	return _u
+}
+
+// SetNillableRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableRequirePushedAuthorizationRequests(v *bool) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetRequirePushedAuthorizationRequests(*v)
+	}
+	return _u
+}
+
+// SetJwks sets the "jwks" field.
+func (_u *OAuth2ClientUpdateOne) SetJwks(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetJwks(v)
+	return _u
+}
+
+// SetNillableJwks sets the "jwks" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableJwks(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetJwks(*v)
+	}
+	return _u
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (_u *OAuth2ClientUpdateOne) SetJwksURI(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetJwksURI(v)
+	return _u
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableJwksURI(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetJwksURI(*v)
+	}
+	return _u
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (_u *OAuth2ClientUpdateOne) SetRegistrationAccessTokenHash(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetRegistrationAccessTokenHash(v)
+	return _u
+}
+
+// SetNillableRegistrationAccessTokenHash sets the "registration_access_token_hash" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableRegistrationAccessTokenHash(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetRegistrationAccessTokenHash(*v)
+	}
+	return _u
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (_u *OAuth2ClientUpdateOne) SetTokenEndpointAuthMethod(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetTokenEndpointAuthMethod(v)
+	return _u
+}
+
+// SetNillableTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableTokenEndpointAuthMethod(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetTokenEndpointAuthMethod(*v)
+	}
+	return _u
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (_u *OAuth2ClientUpdateOne) SetTLSClientAuthSubjectDn(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetTLSClientAuthSubjectDn(v)
+	return _u
+}
+
+// SetNillableTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableTLSClientAuthSubjectDn(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetTLSClientAuthSubjectDn(*v)
+	}
+	return _u
+}
+
+// SetClaimMappings sets the "claim_mappings" field.
+func (_u *OAuth2ClientUpdateOne) SetClaimMappings(v []storage.ClaimMapping) *OAuth2ClientUpdateOne {
+	_u.mutation.SetClaimMappings(v)
+	return _u
+}
+
+// AppendClaimMappings appends value to the "claim_mappings" field.
+func (_u *OAuth2ClientUpdateOne) AppendClaimMappings(v []storage.ClaimMapping) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendClaimMappings(v)
+	return _u
+}
+
+// ClearClaimMappings clears the value of the "claim_mappings" field.
+func (_u *OAuth2ClientUpdateOne) ClearClaimMappings() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearClaimMappings()
+	return _u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_u *OAuth2ClientUpdateOne) SetSubjectType(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetSubjectType(v)
+	return _u
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableSubjectType(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetSubjectType(*v)
+	}
+	return _u
+}
+
+// SetSectorIdentifierURI sets the "sector_identifier_uri" field.
+func (_u *OAuth2ClientUpdateOne) SetSectorIdentifierURI(v string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetSectorIdentifierURI(v)
+	return _u
+}
+
+// SetNillableSectorIdentifierURI sets the "sector_identifier_uri" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableSectorIdentifierURI(v *string) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetSectorIdentifierURI(*v)
+	}
+	return _u
+}
+
+// SetRequiredScopes sets the "required_scopes" field.
+func (_u *OAuth2ClientUpdateOne) SetRequiredScopes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetRequiredScopes(v)
+	return _u
+}
+
+// AppendRequiredScopes appends value to the "required_scopes" field.
+func (_u *OAuth2ClientUpdateOne) AppendRequiredScopes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendRequiredScopes(v)
+	return _u
+}
+
+// ClearRequiredScopes clears the value of the "required_scopes" field.
+func (_u *OAuth2ClientUpdateOne) ClearRequiredScopes() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearRequiredScopes()
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *OAuth2ClientUpdateOne) SetExpiry(v *storage.ClientExpiry) *OAuth2ClientUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (_u *OAuth2ClientUpdateOne) ClearExpiry() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearExpiry()
+	return _u
+}
+
+// SetAllowedConnectors sets the "allowed_connectors" field.
+func (_u *OAuth2ClientUpdateOne) SetAllowedConnectors(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetAllowedConnectors(v)
+	return _u
+}
+
+// AppendAllowedConnectors appends value to the "allowed_connectors" field.
+func (_u *OAuth2ClientUpdateOne) AppendAllowedConnectors(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendAllowedConnectors(v)
+	return _u
+}
+
+// ClearAllowedConnectors clears the value of the "allowed_connectors" field.
+func (_u *OAuth2ClientUpdateOne) ClearAllowedConnectors() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearAllowedConnectors()
+	return _u
+}
+
+// SetAllowedGrantTypes sets the "allowed_grant_types" field.
+func (_u *OAuth2ClientUpdateOne) SetAllowedGrantTypes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetAllowedGrantTypes(v)
+	return _u
+}
+
+// AppendAllowedGrantTypes appends value to the "allowed_grant_types" field.
+func (_u *OAuth2ClientUpdateOne) AppendAllowedGrantTypes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendAllowedGrantTypes(v)
+	return _u
+}
+
+// ClearAllowedGrantTypes clears the value of the "allowed_grant_types" field.
+func (_u *OAuth2ClientUpdateOne) ClearAllowedGrantTypes() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearAllowedGrantTypes()
+	return _u
+}
+
+// SetAllowedResponseTypes sets the "allowed_response_types" field.
+func (_u *OAuth2ClientUpdateOne) SetAllowedResponseTypes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.SetAllowedResponseTypes(v)
+	return _u
+}
+
+// AppendAllowedResponseTypes appends value to the "allowed_response_types" field.
+func (_u *OAuth2ClientUpdateOne) AppendAllowedResponseTypes(v []string) *OAuth2ClientUpdateOne {
+	_u.mutation.AppendAllowedResponseTypes(v)
+	return _u
+}
+
+// ClearAllowedResponseTypes clears the value of the "allowed_response_types" field.
+func (_u *OAuth2ClientUpdateOne) ClearAllowedResponseTypes() *OAuth2ClientUpdateOne {
+	_u.mutation.ClearAllowedResponseTypes()
+	return _u
+}
+
+// SetRequirePkce sets the "require_pkce" field.
+func (_u *OAuth2ClientUpdateOne) SetRequirePkce(v bool) *OAuth2ClientUpdateOne {
+	_u.mutation.SetRequirePkce(v)
+	return _u
+}
+
+// SetNillableRequirePkce sets the "require_pkce" field if the given value is not nil.
+func (_u *OAuth2ClientUpdateOne) SetNillableRequirePkce(v *bool) *OAuth2ClientUpdateOne {
+	if v != nil {
+		_u.SetRequirePkce(*v)
+	}
+	return _u
+}
+
 // SetPublic sets the "public" field.
 func (_u *OAuth2ClientUpdateOne) SetPublic(v bool) *OAuth2ClientUpdateOne {
 	_u.mutation.SetPublic(v)
@@ -449,6 +1197,133 @@ func (_u *OAuth2ClientUpdateOne) sqlSave(ctx context.Context) (_node *OAuth2Clie
 	if _u.mutation.TrustedPeersCleared() {
 		_spec.ClearField(oauth2client.FieldTrustedPeers, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.PostLogoutRedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPostLogoutRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldPostLogoutRedirectUris, value)
+		})
+	}
+	if _u.mutation.PostLogoutRedirectUrisCleared() {
+		_spec.ClearField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BackchannelLogoutURI(); ok {
+		_spec.SetField(oauth2client.FieldBackchannelLogoutURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientCredentialsScopes(); ok {
+		_spec.SetField(oauth2client.FieldClientCredentialsScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientCredentialsScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldClientCredentialsScopes, value)
+		})
+	}
+	if _u.mutation.ClientCredentialsScopesCleared() {
+		_spec.ClearField(oauth2client.FieldClientCredentialsScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClientCredentialsAudiences(); ok {
+		_spec.SetField(oauth2client.FieldClientCredentialsAudiences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientCredentialsAudiences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldClientCredentialsAudiences, value)
+		})
+	}
+	if _u.mutation.ClientCredentialsAudiencesCleared() {
+		_spec.ClearField(oauth2client.FieldClientCredentialsAudiences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessTokenFormat(); ok {
+		_spec.SetField(oauth2client.FieldAccessTokenFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequirePushedAuthorizationRequests(); ok {
+		_spec.SetField(oauth2client.FieldRequirePushedAuthorizationRequests, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Jwks(); ok {
+		_spec.SetField(oauth2client.FieldJwks, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.JwksURI(); ok {
+		_spec.SetField(oauth2client.FieldJwksURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RegistrationAccessTokenHash(); ok {
+		_spec.SetField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenEndpointAuthMethod(); ok {
+		_spec.SetField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TLSClientAuthSubjectDn(); ok {
+		_spec.SetField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimMappings(); ok {
+		_spec.SetField(oauth2client.FieldClaimMappings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaimMappings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldClaimMappings, value)
+		})
+	}
+	if _u.mutation.ClaimMappingsCleared() {
+		_spec.ClearField(oauth2client.FieldClaimMappings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SubjectType(); ok {
+		_spec.SetField(oauth2client.FieldSubjectType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SectorIdentifierURI(); ok {
+		_spec.SetField(oauth2client.FieldSectorIdentifierURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequiredScopes(); ok {
+		_spec.SetField(oauth2client.FieldRequiredScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequiredScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRequiredScopes, value)
+		})
+	}
+	if _u.mutation.RequiredScopesCleared() {
+		_spec.ClearField(oauth2client.FieldRequiredScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(oauth2client.FieldExpiry, field.TypeJSON, value)
+	}
+	if _u.mutation.ExpiryCleared() {
+		_spec.ClearField(oauth2client.FieldExpiry, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedConnectors(); ok {
+		_spec.SetField(oauth2client.FieldAllowedConnectors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedConnectors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedConnectors, value)
+		})
+	}
+	if _u.mutation.AllowedConnectorsCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedConnectors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedGrantTypes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedGrantTypes, value)
+		})
+	}
+	if _u.mutation.AllowedGrantTypesCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedGrantTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedResponseTypes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedResponseTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedResponseTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedResponseTypes, value)
+		})
+	}
+	if _u.mutation.AllowedResponseTypesCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedResponseTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequirePkce(); ok {
+		_spec.SetField(oauth2client.FieldRequirePkce, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Public(); ok {
 		_spec.SetField(oauth2client.FieldPublic, field.TypeBool, value)
 	}
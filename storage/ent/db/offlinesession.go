@@ -3,6 +3,7 @@
 package db
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -24,7 +25,9 @@ type OfflineSession struct {
 	Refresh []byte `json:"refresh,omitempty"`
 	// ConnectorData holds the value of the "connector_data" field.
 	ConnectorData *[]byte `json:"connector_data,omitempty"`
-	selectValues  sql.SelectValues
+	// ExtraClaims holds the value of the "extra_claims" field.
+	ExtraClaims  map[string]interface{} `json:"extra_claims,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -32,7 +35,7 @@ func (*OfflineSession) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case offlinesession.FieldRefresh, offlinesession.FieldConnectorData:
+		case offlinesession.FieldRefresh, offlinesession.FieldConnectorData, offlinesession.FieldExtraClaims:
 			values[i] = new([]byte)
 		case offlinesession.FieldID, offlinesession.FieldUserID, offlinesession.FieldConnID:
 			values[i] = new(sql.NullString)
@@ -81,6 +84,14 @@ func (_m *OfflineSession) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ConnectorData = value
 			}
+		case offlinesession.FieldExtraClaims:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field extra_claims", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExtraClaims); err != nil {
+					return fmt.Errorf("unmarshal field extra_claims: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -130,6 +141,9 @@ func (_m *OfflineSession) String() string {
 		builder.WriteString("connector_data=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("extra_claims=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExtraClaims))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -19,6 +19,8 @@ const (
 	FieldRefresh = "refresh"
 	// FieldConnectorData holds the string denoting the connector_data field in the database.
 	FieldConnectorData = "connector_data"
+	// FieldExtraClaims holds the string denoting the extra_claims field in the database.
+	FieldExtraClaims = "extra_claims"
 	// Table holds the table name of the offlinesession in the database.
 	Table = "offline_sessions"
 )
@@ -30,6 +32,7 @@ var Columns = []string{
 	FieldConnID,
 	FieldRefresh,
 	FieldConnectorData,
+	FieldExtraClaims,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
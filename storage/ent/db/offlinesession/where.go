@@ -302,6 +302,16 @@ func ConnectorDataNotNil() predicate.OfflineSession {
 	return predicate.OfflineSession(sql.FieldNotNull(FieldConnectorData))
 }
 
+// ExtraClaimsIsNil applies the IsNil predicate on the "extra_claims" field.
+func ExtraClaimsIsNil() predicate.OfflineSession {
+	return predicate.OfflineSession(sql.FieldIsNull(FieldExtraClaims))
+}
+
+// ExtraClaimsNotNil applies the NotNil predicate on the "extra_claims" field.
+func ExtraClaimsNotNil() predicate.OfflineSession {
+	return predicate.OfflineSession(sql.FieldNotNull(FieldExtraClaims))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OfflineSession) predicate.OfflineSession {
 	return predicate.OfflineSession(sql.AndPredicates(predicates...))
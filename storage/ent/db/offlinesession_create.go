@@ -43,6 +43,12 @@ func (_c *OfflineSessionCreate) SetConnectorData(v []byte) *OfflineSessionCreate
 	return _c
 }
 
+// SetExtraClaims sets the "extra_claims" field.
+func (_c *OfflineSessionCreate) SetExtraClaims(v map[string]interface{}) *OfflineSessionCreate {
+	_c.mutation.SetExtraClaims(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *OfflineSessionCreate) SetID(v string) *OfflineSessionCreate {
 	_c.mutation.SetID(v)
@@ -158,6 +164,10 @@ func (_c *OfflineSessionCreate) createSpec() (*OfflineSession, *sqlgraph.CreateS
 		_spec.SetField(offlinesession.FieldConnectorData, field.TypeBytes, value)
 		_node.ConnectorData = &value
 	}
+	if value, ok := _c.mutation.ExtraClaims(); ok {
+		_spec.SetField(offlinesession.FieldExtraClaims, field.TypeJSON, value)
+		_node.ExtraClaims = value
+	}
 	return _node, _spec
 }
 
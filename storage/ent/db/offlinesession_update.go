@@ -73,6 +73,18 @@ func (_u *OfflineSessionUpdate) ClearConnectorData() *OfflineSessionUpdate {
 	return _u
 }
 
+// SetExtraClaims sets the "extra_claims" field.
+func (_u *OfflineSessionUpdate) SetExtraClaims(v map[string]interface{}) *OfflineSessionUpdate {
+	_u.mutation.SetExtraClaims(v)
+	return _u
+}
+
+// ClearExtraClaims clears the value of the "extra_claims" field.
+func (_u *OfflineSessionUpdate) ClearExtraClaims() *OfflineSessionUpdate {
+	_u.mutation.ClearExtraClaims()
+	return _u
+}
+
 // Mutation returns the OfflineSessionMutation object of the builder.
 func (_u *OfflineSessionUpdate) Mutation() *OfflineSessionMutation {
 	return _u.mutation
@@ -147,6 +159,12 @@ func (_u *OfflineSessionUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ConnectorDataCleared() {
 		_spec.ClearField(offlinesession.FieldConnectorData, field.TypeBytes)
 	}
+	if value, ok := _u.mutation.ExtraClaims(); ok {
+		_spec.SetField(offlinesession.FieldExtraClaims, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtraClaimsCleared() {
+		_spec.ClearField(offlinesession.FieldExtraClaims, field.TypeJSON)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{offlinesession.Label}
@@ -213,6 +231,18 @@ func (_u *OfflineSessionUpdateOne) ClearConnectorData() *OfflineSessionUpdateOne
 	return _u
 }
 
+// SetExtraClaims sets the "extra_claims" field.
+func (_u *OfflineSessionUpdateOne) SetExtraClaims(v map[string]interface{}) *OfflineSessionUpdateOne {
+	_u.mutation.SetExtraClaims(v)
+	return _u
+}
+
+// ClearExtraClaims clears the value of the "extra_claims" field.
+func (_u *OfflineSessionUpdateOne) ClearExtraClaims() *OfflineSessionUpdateOne {
+	_u.mutation.ClearExtraClaims()
+	return _u
+}
+
 // Mutation returns the OfflineSessionMutation object of the builder.
 func (_u *OfflineSessionUpdateOne) Mutation() *OfflineSessionMutation {
 	return _u.mutation
@@ -317,6 +347,12 @@ func (_u *OfflineSessionUpdateOne) sqlSave(ctx context.Context) (_node *OfflineS
 	if _u.mutation.ConnectorDataCleared() {
 		_spec.ClearField(offlinesession.FieldConnectorData, field.TypeBytes)
 	}
+	if value, ok := _u.mutation.ExtraClaims(); ok {
+		_spec.SetField(offlinesession.FieldExtraClaims, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtraClaimsCleared() {
+		_spec.ClearField(offlinesession.FieldExtraClaims, field.TypeJSON)
+	}
 	_node = &OfflineSession{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,24 +6,42 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
 // AuthCode is the predicate function for authcode builders.
 type AuthCode func(*sql.Selector)
 
 // AuthRequest is the predicate function for authrequest builders.
 type AuthRequest func(*sql.Selector)
 
+// ClientAssertion is the predicate function for clientassertion builders.
+type ClientAssertion func(*sql.Selector)
+
 // Connector is the predicate function for connector builders.
 type Connector func(*sql.Selector)
 
+// Consent is the predicate function for consent builders.
+type Consent func(*sql.Selector)
+
 // DeviceRequest is the predicate function for devicerequest builders.
 type DeviceRequest func(*sql.Selector)
 
 // DeviceToken is the predicate function for devicetoken builders.
 type DeviceToken func(*sql.Selector)
 
+// DpopProof is the predicate function for dpopproof builders.
+type DpopProof func(*sql.Selector)
+
+// InitialAccessToken is the predicate function for initialaccesstoken builders.
+type InitialAccessToken func(*sql.Selector)
+
 // Keys is the predicate function for keys builders.
 type Keys func(*sql.Selector)
 
+// LogoutNotification is the predicate function for logoutnotification builders.
+type LogoutNotification func(*sql.Selector)
+
 // OAuth2Client is the predicate function for oauth2client builders.
 type OAuth2Client func(*sql.Selector)
 
@@ -35,3 +53,9 @@ type Password func(*sql.Selector)
 
 // RefreshToken is the predicate function for refreshtoken builders.
 type RefreshToken func(*sql.Selector)
+
+// Scope is the predicate function for scope builders.
+type Scope func(*sql.Selector)
+
+// UserSession is the predicate function for usersession builders.
+type UserSession func(*sql.Selector)
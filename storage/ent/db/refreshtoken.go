@@ -34,6 +34,8 @@ type RefreshToken struct {
 	ClaimsEmailVerified bool `json:"claims_email_verified,omitempty"`
 	// ClaimsGroups holds the value of the "claims_groups" field.
 	ClaimsGroups []string `json:"claims_groups,omitempty"`
+	// ClaimsExtra holds the value of the "claims_extra" field.
+	ClaimsExtra map[string]interface{} `json:"claims_extra,omitempty"`
 	// ClaimsPreferredUsername holds the value of the "claims_preferred_username" field.
 	ClaimsPreferredUsername string `json:"claims_preferred_username,omitempty"`
 	// ConnectorID holds the value of the "connector_id" field.
@@ -44,10 +46,20 @@ type RefreshToken struct {
 	Token string `json:"token,omitempty"`
 	// ObsoleteToken holds the value of the "obsolete_token" field.
 	ObsoleteToken string `json:"obsolete_token,omitempty"`
+	// DpopKeyThumbprint holds the value of the "dpop_key_thumbprint" field.
+	DpopKeyThumbprint string `json:"dpop_key_thumbprint,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// LastUsed holds the value of the "last_used" field.
-	LastUsed     time.Time `json:"last_used,omitempty"`
+	LastUsed time.Time `json:"last_used,omitempty"`
+	// Acr holds the value of the "acr" field.
+	Acr string `json:"acr,omitempty"`
+	// Amr holds the value of the "amr" field.
+	Amr []string `json:"amr,omitempty"`
+	// AuthTime holds the value of the "auth_time" field.
+	AuthTime time.Time `json:"auth_time,omitempty"`
+	// Sid holds the value of the "sid" field.
+	Sid          string `json:"sid,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -56,13 +68,13 @@ func (*RefreshToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case refreshtoken.FieldScopes, refreshtoken.FieldClaimsGroups, refreshtoken.FieldConnectorData:
+		case refreshtoken.FieldScopes, refreshtoken.FieldClaimsGroups, refreshtoken.FieldClaimsExtra, refreshtoken.FieldConnectorData, refreshtoken.FieldAmr:
 			values[i] = new([]byte)
 		case refreshtoken.FieldClaimsEmailVerified:
 			values[i] = new(sql.NullBool)
-		case refreshtoken.FieldID, refreshtoken.FieldClientID, refreshtoken.FieldNonce, refreshtoken.FieldClaimsUserID, refreshtoken.FieldClaimsUsername, refreshtoken.FieldClaimsEmail, refreshtoken.FieldClaimsPreferredUsername, refreshtoken.FieldConnectorID, refreshtoken.FieldToken, refreshtoken.FieldObsoleteToken:
+		case refreshtoken.FieldID, refreshtoken.FieldClientID, refreshtoken.FieldNonce, refreshtoken.FieldClaimsUserID, refreshtoken.FieldClaimsUsername, refreshtoken.FieldClaimsEmail, refreshtoken.FieldClaimsPreferredUsername, refreshtoken.FieldConnectorID, refreshtoken.FieldToken, refreshtoken.FieldObsoleteToken, refreshtoken.FieldDpopKeyThumbprint, refreshtoken.FieldAcr, refreshtoken.FieldSid:
 			values[i] = new(sql.NullString)
-		case refreshtoken.FieldCreatedAt, refreshtoken.FieldLastUsed:
+		case refreshtoken.FieldCreatedAt, refreshtoken.FieldLastUsed, refreshtoken.FieldAuthTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -137,6 +149,14 @@ func (_m *RefreshToken) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field claims_groups: %w", err)
 				}
 			}
+		case refreshtoken.FieldClaimsExtra:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claims_extra", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClaimsExtra); err != nil {
+					return fmt.Errorf("unmarshal field claims_extra: %w", err)
+				}
+			}
 		case refreshtoken.FieldClaimsPreferredUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field claims_preferred_username", values[i])
@@ -167,6 +187,12 @@ func (_m *RefreshToken) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ObsoleteToken = value.String
 			}
+		case refreshtoken.FieldDpopKeyThumbprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dpop_key_thumbprint", values[i])
+			} else if value.Valid {
+				_m.DpopKeyThumbprint = value.String
+			}
 		case refreshtoken.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -179,6 +205,32 @@ func (_m *RefreshToken) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LastUsed = value.Time
 			}
+		case refreshtoken.FieldAcr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acr", values[i])
+			} else if value.Valid {
+				_m.Acr = value.String
+			}
+		case refreshtoken.FieldAmr:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field amr", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Amr); err != nil {
+					return fmt.Errorf("unmarshal field amr: %w", err)
+				}
+			}
+		case refreshtoken.FieldAuthTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_time", values[i])
+			} else if value.Valid {
+				_m.AuthTime = value.Time
+			}
+		case refreshtoken.FieldSid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sid", values[i])
+			} else if value.Valid {
+				_m.Sid = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -239,6 +291,9 @@ func (_m *RefreshToken) String() string {
 	builder.WriteString("claims_groups=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ClaimsGroups))
 	builder.WriteString(", ")
+	builder.WriteString("claims_extra=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClaimsExtra))
+	builder.WriteString(", ")
 	builder.WriteString("claims_preferred_username=")
 	builder.WriteString(_m.ClaimsPreferredUsername)
 	builder.WriteString(", ")
@@ -256,11 +311,26 @@ func (_m *RefreshToken) String() string {
 	builder.WriteString("obsolete_token=")
 	builder.WriteString(_m.ObsoleteToken)
 	builder.WriteString(", ")
+	builder.WriteString("dpop_key_thumbprint=")
+	builder.WriteString(_m.DpopKeyThumbprint)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("last_used=")
 	builder.WriteString(_m.LastUsed.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("acr=")
+	builder.WriteString(_m.Acr)
+	builder.WriteString(", ")
+	builder.WriteString("amr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amr))
+	builder.WriteString(", ")
+	builder.WriteString("auth_time=")
+	builder.WriteString(_m.AuthTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sid=")
+	builder.WriteString(_m.Sid)
 	builder.WriteByte(')')
 	return builder.String()
 }
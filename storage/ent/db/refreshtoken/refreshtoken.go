@@ -29,6 +29,8 @@ const (
 	FieldClaimsEmailVerified = "claims_email_verified"
 	// FieldClaimsGroups holds the string denoting the claims_groups field in the database.
 	FieldClaimsGroups = "claims_groups"
+	// FieldClaimsExtra holds the string denoting the claims_extra field in the database.
+	FieldClaimsExtra = "claims_extra"
 	// FieldClaimsPreferredUsername holds the string denoting the claims_preferred_username field in the database.
 	FieldClaimsPreferredUsername = "claims_preferred_username"
 	// FieldConnectorID holds the string denoting the connector_id field in the database.
@@ -39,10 +41,20 @@ const (
 	FieldToken = "token"
 	// FieldObsoleteToken holds the string denoting the obsolete_token field in the database.
 	FieldObsoleteToken = "obsolete_token"
+	// FieldDpopKeyThumbprint holds the string denoting the dpop_key_thumbprint field in the database.
+	FieldDpopKeyThumbprint = "dpop_key_thumbprint"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldLastUsed holds the string denoting the last_used field in the database.
 	FieldLastUsed = "last_used"
+	// FieldAcr holds the string denoting the acr field in the database.
+	FieldAcr = "acr"
+	// FieldAmr holds the string denoting the amr field in the database.
+	FieldAmr = "amr"
+	// FieldAuthTime holds the string denoting the auth_time field in the database.
+	FieldAuthTime = "auth_time"
+	// FieldSid holds the string denoting the sid field in the database.
+	FieldSid = "sid"
 	// Table holds the table name of the refreshtoken in the database.
 	Table = "refresh_tokens"
 )
@@ -58,13 +70,19 @@ var Columns = []string{
 	FieldClaimsEmail,
 	FieldClaimsEmailVerified,
 	FieldClaimsGroups,
+	FieldClaimsExtra,
 	FieldClaimsPreferredUsername,
 	FieldConnectorID,
 	FieldConnectorData,
 	FieldToken,
 	FieldObsoleteToken,
+	FieldDpopKeyThumbprint,
 	FieldCreatedAt,
 	FieldLastUsed,
+	FieldAcr,
+	FieldAmr,
+	FieldAuthTime,
+	FieldSid,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -96,10 +114,16 @@ var (
 	DefaultToken string
 	// DefaultObsoleteToken holds the default value on creation for the "obsolete_token" field.
 	DefaultObsoleteToken string
+	// DefaultDpopKeyThumbprint holds the default value on creation for the "dpop_key_thumbprint" field.
+	DefaultDpopKeyThumbprint string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultLastUsed holds the default value on creation for the "last_used" field.
 	DefaultLastUsed func() time.Time
+	// DefaultAcr holds the default value on creation for the "acr" field.
+	DefaultAcr string
+	// DefaultSid holds the default value on creation for the "sid" field.
+	DefaultSid string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -162,6 +186,11 @@ func ByObsoleteToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldObsoleteToken, opts...).ToFunc()
 }
 
+// ByDpopKeyThumbprint orders the results by the dpop_key_thumbprint field.
+func ByDpopKeyThumbprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDpopKeyThumbprint, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -171,3 +200,18 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByLastUsed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUsed, opts...).ToFunc()
 }
+
+// ByAcr orders the results by the acr field.
+func ByAcr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcr, opts...).ToFunc()
+}
+
+// ByAuthTime orders the results by the auth_time field.
+func ByAuthTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthTime, opts...).ToFunc()
+}
+
+// BySid orders the results by the sid field.
+func BySid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSid, opts...).ToFunc()
+}
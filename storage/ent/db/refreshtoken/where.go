@@ -119,6 +119,11 @@ func ObsoleteToken(v string) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldEQ(FieldObsoleteToken, v))
 }
 
+// DpopKeyThumbprint applies equality check predicate on the "dpop_key_thumbprint" field. It's identical to DpopKeyThumbprintEQ.
+func DpopKeyThumbprint(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldDpopKeyThumbprint, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -129,6 +134,21 @@ func LastUsed(v time.Time) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldEQ(FieldLastUsed, v))
 }
 
+// Acr applies equality check predicate on the "acr" field. It's identical to AcrEQ.
+func Acr(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldAcr, v))
+}
+
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// Sid applies equality check predicate on the "sid" field. It's identical to SidEQ.
+func Sid(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldSid, v))
+}
+
 // ClientIDEQ applies the EQ predicate on the "client_id" field.
 func ClientIDEQ(v string) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldEQ(FieldClientID, v))
@@ -484,6 +504,16 @@ func ClaimsGroupsNotNil() predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldNotNull(FieldClaimsGroups))
 }
 
+// ClaimsExtraIsNil applies the IsNil predicate on the "claims_extra" field.
+func ClaimsExtraIsNil() predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldIsNull(FieldClaimsExtra))
+}
+
+// ClaimsExtraNotNil applies the NotNil predicate on the "claims_extra" field.
+func ClaimsExtraNotNil() predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNotNull(FieldClaimsExtra))
+}
+
 // ClaimsPreferredUsernameEQ applies the EQ predicate on the "claims_preferred_username" field.
 func ClaimsPreferredUsernameEQ(v string) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldEQ(FieldClaimsPreferredUsername, v))
@@ -794,6 +824,71 @@ func ObsoleteTokenContainsFold(v string) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldContainsFold(FieldObsoleteToken, v))
 }
 
+// DpopKeyThumbprintEQ applies the EQ predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintNEQ applies the NEQ predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintNEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNEQ(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintIn applies the In predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintIn(vs ...string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldIn(FieldDpopKeyThumbprint, vs...))
+}
+
+// DpopKeyThumbprintNotIn applies the NotIn predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintNotIn(vs ...string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNotIn(FieldDpopKeyThumbprint, vs...))
+}
+
+// DpopKeyThumbprintGT applies the GT predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintGT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGT(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintGTE applies the GTE predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintGTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGTE(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintLT applies the LT predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintLT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLT(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintLTE applies the LTE predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintLTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLTE(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintContains applies the Contains predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintContains(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldContains(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintHasPrefix applies the HasPrefix predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintHasPrefix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldHasPrefix(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintHasSuffix applies the HasSuffix predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintHasSuffix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldHasSuffix(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintEqualFold applies the EqualFold predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintEqualFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEqualFold(FieldDpopKeyThumbprint, v))
+}
+
+// DpopKeyThumbprintContainsFold applies the ContainsFold predicate on the "dpop_key_thumbprint" field.
+func DpopKeyThumbprintContainsFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldContainsFold(FieldDpopKeyThumbprint, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -874,6 +969,196 @@ func LastUsedLTE(v time.Time) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.FieldLTE(FieldLastUsed, v))
 }
 
+// AcrEQ applies the EQ predicate on the "acr" field.
+func AcrEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldAcr, v))
+}
+
+// AcrNEQ applies the NEQ predicate on the "acr" field.
+func AcrNEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNEQ(FieldAcr, v))
+}
+
+// AcrIn applies the In predicate on the "acr" field.
+func AcrIn(vs ...string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldIn(FieldAcr, vs...))
+}
+
+// AcrNotIn applies the NotIn predicate on the "acr" field.
+func AcrNotIn(vs ...string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNotIn(FieldAcr, vs...))
+}
+
+// AcrGT applies the GT predicate on the "acr" field.
+func AcrGT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGT(FieldAcr, v))
+}
+
+// AcrGTE applies the GTE predicate on the "acr" field.
+func AcrGTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGTE(FieldAcr, v))
+}
+
+// AcrLT applies the LT predicate on the "acr" field.
+func AcrLT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLT(FieldAcr, v))
+}
+
+// AcrLTE applies the LTE predicate on the "acr" field.
+func AcrLTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLTE(FieldAcr, v))
+}
+
+// AcrContains applies the Contains predicate on the "acr" field.
+func AcrContains(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldContains(FieldAcr, v))
+}
+
+// AcrHasPrefix applies the HasPrefix predicate on the "acr" field.
+func AcrHasPrefix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldHasPrefix(FieldAcr, v))
+}
+
+// AcrHasSuffix applies the HasSuffix predicate on the "acr" field.
+func AcrHasSuffix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldHasSuffix(FieldAcr, v))
+}
+
+// AcrEqualFold applies the EqualFold predicate on the "acr" field.
+func AcrEqualFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEqualFold(FieldAcr, v))
+}
+
+// AcrContainsFold applies the ContainsFold predicate on the "acr" field.
+func AcrContainsFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldContainsFold(FieldAcr, v))
+}
+
+// AmrIsNil applies the IsNil predicate on the "amr" field.
+func AmrIsNil() predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldIsNull(FieldAmr))
+}
+
+// AmrNotNil applies the NotNil predicate on the "amr" field.
+func AmrNotNil() predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNotNull(FieldAmr))
+}
+
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// AuthTimeIsNil applies the IsNil predicate on the "auth_time" field.
+func AuthTimeIsNil() predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldIsNull(FieldAuthTime))
+}
+
+// AuthTimeNotNil applies the NotNil predicate on the "auth_time" field.
+func AuthTimeNotNil() predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNotNull(FieldAuthTime))
+}
+
+// SidEQ applies the EQ predicate on the "sid" field.
+func SidEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEQ(FieldSid, v))
+}
+
+// SidNEQ applies the NEQ predicate on the "sid" field.
+func SidNEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNEQ(FieldSid, v))
+}
+
+// SidIn applies the In predicate on the "sid" field.
+func SidIn(vs ...string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldIn(FieldSid, vs...))
+}
+
+// SidNotIn applies the NotIn predicate on the "sid" field.
+func SidNotIn(vs ...string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldNotIn(FieldSid, vs...))
+}
+
+// SidGT applies the GT predicate on the "sid" field.
+func SidGT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGT(FieldSid, v))
+}
+
+// SidGTE applies the GTE predicate on the "sid" field.
+func SidGTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldGTE(FieldSid, v))
+}
+
+// SidLT applies the LT predicate on the "sid" field.
+func SidLT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLT(FieldSid, v))
+}
+
+// SidLTE applies the LTE predicate on the "sid" field.
+func SidLTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldLTE(FieldSid, v))
+}
+
+// SidContains applies the Contains predicate on the "sid" field.
+func SidContains(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldContains(FieldSid, v))
+}
+
+// SidHasPrefix applies the HasPrefix predicate on the "sid" field.
+func SidHasPrefix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldHasPrefix(FieldSid, v))
+}
+
+// SidHasSuffix applies the HasSuffix predicate on the "sid" field.
+func SidHasSuffix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldHasSuffix(FieldSid, v))
+}
+
+// SidEqualFold applies the EqualFold predicate on the "sid" field.
+func SidEqualFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldEqualFold(FieldSid, v))
+}
+
+// SidContainsFold applies the ContainsFold predicate on the "sid" field.
+func SidContainsFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(sql.FieldContainsFold(FieldSid, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.RefreshToken) predicate.RefreshToken {
 	return predicate.RefreshToken(sql.AndPredicates(predicates...))
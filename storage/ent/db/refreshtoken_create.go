@@ -68,6 +68,12 @@ func (_c *RefreshTokenCreate) SetClaimsGroups(v []string) *RefreshTokenCreate {
 	return _c
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_c *RefreshTokenCreate) SetClaimsExtra(v map[string]interface{}) *RefreshTokenCreate {
+	_c.mutation.SetClaimsExtra(v)
+	return _c
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_c *RefreshTokenCreate) SetClaimsPreferredUsername(v string) *RefreshTokenCreate {
 	_c.mutation.SetClaimsPreferredUsername(v)
@@ -122,6 +128,20 @@ func (_c *RefreshTokenCreate) SetNillableObsoleteToken(v *string) *RefreshTokenC
 	return _c
 }
 
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (_c *RefreshTokenCreate) SetDpopKeyThumbprint(v string) *RefreshTokenCreate {
+	_c.mutation.SetDpopKeyThumbprint(v)
+	return _c
+}
+
+// SetNillableDpopKeyThumbprint sets the "dpop_key_thumbprint" field if the given value is not nil.
+func (_c *RefreshTokenCreate) SetNillableDpopKeyThumbprint(v *string) *RefreshTokenCreate {
+	if v != nil {
+		_c.SetDpopKeyThumbprint(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *RefreshTokenCreate) SetCreatedAt(v time.Time) *RefreshTokenCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -150,6 +170,54 @@ func (_c *RefreshTokenCreate) SetNillableLastUsed(v *time.Time) *RefreshTokenCre
 	return _c
 }
 
+// SetAcr sets the "acr" field.
+func (_c *RefreshTokenCreate) SetAcr(v string) *RefreshTokenCreate {
+	_c.mutation.SetAcr(v)
+	return _c
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_c *RefreshTokenCreate) SetNillableAcr(v *string) *RefreshTokenCreate {
+	if v != nil {
+		_c.SetAcr(*v)
+	}
+	return _c
+}
+
+// SetAmr sets the "amr" field.
+func (_c *RefreshTokenCreate) SetAmr(v []string) *RefreshTokenCreate {
+	_c.mutation.SetAmr(v)
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *RefreshTokenCreate) SetAuthTime(v time.Time) *RefreshTokenCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *RefreshTokenCreate) SetNillableAuthTime(v *time.Time) *RefreshTokenCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
+// SetSid sets the "sid" field.
+func (_c *RefreshTokenCreate) SetSid(v string) *RefreshTokenCreate {
+	_c.mutation.SetSid(v)
+	return _c
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_c *RefreshTokenCreate) SetNillableSid(v *string) *RefreshTokenCreate {
+	if v != nil {
+		_c.SetSid(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RefreshTokenCreate) SetID(v string) *RefreshTokenCreate {
 	_c.mutation.SetID(v)
@@ -203,6 +271,10 @@ func (_c *RefreshTokenCreate) defaults() {
 		v := refreshtoken.DefaultObsoleteToken
 		_c.mutation.SetObsoleteToken(v)
 	}
+	if _, ok := _c.mutation.DpopKeyThumbprint(); !ok {
+		v := refreshtoken.DefaultDpopKeyThumbprint
+		_c.mutation.SetDpopKeyThumbprint(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := refreshtoken.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -211,6 +283,14 @@ func (_c *RefreshTokenCreate) defaults() {
 		v := refreshtoken.DefaultLastUsed()
 		_c.mutation.SetLastUsed(v)
 	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		v := refreshtoken.DefaultAcr
+		_c.mutation.SetAcr(v)
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		v := refreshtoken.DefaultSid
+		_c.mutation.SetSid(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -275,12 +355,21 @@ func (_c *RefreshTokenCreate) check() error {
 	if _, ok := _c.mutation.ObsoleteToken(); !ok {
 		return &ValidationError{Name: "obsolete_token", err: errors.New(`db: missing required field "RefreshToken.obsolete_token"`)}
 	}
+	if _, ok := _c.mutation.DpopKeyThumbprint(); !ok {
+		return &ValidationError{Name: "dpop_key_thumbprint", err: errors.New(`db: missing required field "RefreshToken.dpop_key_thumbprint"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "RefreshToken.created_at"`)}
 	}
 	if _, ok := _c.mutation.LastUsed(); !ok {
 		return &ValidationError{Name: "last_used", err: errors.New(`db: missing required field "RefreshToken.last_used"`)}
 	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		return &ValidationError{Name: "acr", err: errors.New(`db: missing required field "RefreshToken.acr"`)}
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		return &ValidationError{Name: "sid", err: errors.New(`db: missing required field "RefreshToken.sid"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := refreshtoken.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "RefreshToken.id": %w`, err)}
@@ -353,6 +442,10 @@ func (_c *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec)
 		_spec.SetField(refreshtoken.FieldClaimsGroups, field.TypeJSON, value)
 		_node.ClaimsGroups = value
 	}
+	if value, ok := _c.mutation.ClaimsExtra(); ok {
+		_spec.SetField(refreshtoken.FieldClaimsExtra, field.TypeJSON, value)
+		_node.ClaimsExtra = value
+	}
 	if value, ok := _c.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(refreshtoken.FieldClaimsPreferredUsername, field.TypeString, value)
 		_node.ClaimsPreferredUsername = value
@@ -373,6 +466,10 @@ func (_c *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec)
 		_spec.SetField(refreshtoken.FieldObsoleteToken, field.TypeString, value)
 		_node.ObsoleteToken = value
 	}
+	if value, ok := _c.mutation.DpopKeyThumbprint(); ok {
+		_spec.SetField(refreshtoken.FieldDpopKeyThumbprint, field.TypeString, value)
+		_node.DpopKeyThumbprint = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(refreshtoken.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -381,6 +478,22 @@ func (_c *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec)
 		_spec.SetField(refreshtoken.FieldLastUsed, field.TypeTime, value)
 		_node.LastUsed = value
 	}
+	if value, ok := _c.mutation.Acr(); ok {
+		_spec.SetField(refreshtoken.FieldAcr, field.TypeString, value)
+		_node.Acr = value
+	}
+	if value, ok := _c.mutation.Amr(); ok {
+		_spec.SetField(refreshtoken.FieldAmr, field.TypeJSON, value)
+		_node.Amr = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(refreshtoken.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
+	if value, ok := _c.mutation.Sid(); ok {
+		_spec.SetField(refreshtoken.FieldSid, field.TypeString, value)
+		_node.Sid = value
+	}
 	return _node, _spec
 }
 
@@ -149,6 +149,18 @@ func (_u *RefreshTokenUpdate) ClearClaimsGroups() *RefreshTokenUpdate {
 	return _u
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *RefreshTokenUpdate) SetClaimsExtra(v map[string]interface{}) *RefreshTokenUpdate {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *RefreshTokenUpdate) ClearClaimsExtra() *RefreshTokenUpdate {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_u *RefreshTokenUpdate) SetClaimsPreferredUsername(v string) *RefreshTokenUpdate {
 	_u.mutation.SetClaimsPreferredUsername(v)
@@ -217,6 +229,20 @@ func (_u *RefreshTokenUpdate) SetNillableObsoleteToken(v *string) *RefreshTokenU
 	return _u
 }
 
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (_u *RefreshTokenUpdate) SetDpopKeyThumbprint(v string) *RefreshTokenUpdate {
+	_u.mutation.SetDpopKeyThumbprint(v)
+	return _u
+}
+
+// SetNillableDpopKeyThumbprint sets the "dpop_key_thumbprint" field if the given value is not nil.
+func (_u *RefreshTokenUpdate) SetNillableDpopKeyThumbprint(v *string) *RefreshTokenUpdate {
+	if v != nil {
+		_u.SetDpopKeyThumbprint(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *RefreshTokenUpdate) SetCreatedAt(v time.Time) *RefreshTokenUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -245,6 +271,72 @@ func (_u *RefreshTokenUpdate) SetNillableLastUsed(v *time.Time) *RefreshTokenUpd
 	return _u
 }
 
+// SetAcr sets the "acr" field.
+func (_u *RefreshTokenUpdate) SetAcr(v string) *RefreshTokenUpdate {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *RefreshTokenUpdate) SetNillableAcr(v *string) *RefreshTokenUpdate {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *RefreshTokenUpdate) SetAmr(v []string) *RefreshTokenUpdate {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *RefreshTokenUpdate) AppendAmr(v []string) *RefreshTokenUpdate {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *RefreshTokenUpdate) ClearAmr() *RefreshTokenUpdate {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *RefreshTokenUpdate) SetAuthTime(v time.Time) *RefreshTokenUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *RefreshTokenUpdate) SetNillableAuthTime(v *time.Time) *RefreshTokenUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *RefreshTokenUpdate) ClearAuthTime() *RefreshTokenUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *RefreshTokenUpdate) SetSid(v string) *RefreshTokenUpdate {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *RefreshTokenUpdate) SetNillableSid(v *string) *RefreshTokenUpdate {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
 // Mutation returns the RefreshTokenMutation object of the builder.
 func (_u *RefreshTokenUpdate) Mutation() *RefreshTokenMutation {
 	return _u.mutation
@@ -364,6 +456,12 @@ func (_u *RefreshTokenUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.ClaimsGroupsCleared() {
 		_spec.ClearField(refreshtoken.FieldClaimsGroups, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(refreshtoken.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(refreshtoken.FieldClaimsExtra, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(refreshtoken.FieldClaimsPreferredUsername, field.TypeString, value)
 	}
@@ -382,12 +480,38 @@ func (_u *RefreshTokenUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.ObsoleteToken(); ok {
 		_spec.SetField(refreshtoken.FieldObsoleteToken, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DpopKeyThumbprint(); ok {
+		_spec.SetField(refreshtoken.FieldDpopKeyThumbprint, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(refreshtoken.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.LastUsed(); ok {
 		_spec.SetField(refreshtoken.FieldLastUsed, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(refreshtoken.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(refreshtoken.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, refreshtoken.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(refreshtoken.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(refreshtoken.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(refreshtoken.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(refreshtoken.FieldSid, field.TypeString, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{refreshtoken.Label}
@@ -528,6 +652,18 @@ func (_u *RefreshTokenUpdateOne) ClearClaimsGroups() *RefreshTokenUpdateOne {
 	return _u
 }
 
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *RefreshTokenUpdateOne) SetClaimsExtra(v map[string]interface{}) *RefreshTokenUpdateOne {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *RefreshTokenUpdateOne) ClearClaimsExtra() *RefreshTokenUpdateOne {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
 // SetClaimsPreferredUsername sets the "claims_preferred_username" field.
 func (_u *RefreshTokenUpdateOne) SetClaimsPreferredUsername(v string) *RefreshTokenUpdateOne {
 	_u.mutation.SetClaimsPreferredUsername(v)
@@ -596,6 +732,20 @@ func (_u *RefreshTokenUpdateOne) SetNillableObsoleteToken(v *string) *RefreshTok
 	return _u
 }
 
+// SetDpopKeyThumbprint sets the "dpop_key_thumbprint" field.
+func (_u *RefreshTokenUpdateOne) SetDpopKeyThumbprint(v string) *RefreshTokenUpdateOne {
+	_u.mutation.SetDpopKeyThumbprint(v)
+	return _u
+}
+
+// SetNillableDpopKeyThumbprint sets the "dpop_key_thumbprint" field if the given value is not nil.
+func (_u *RefreshTokenUpdateOne) SetNillableDpopKeyThumbprint(v *string) *RefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetDpopKeyThumbprint(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *RefreshTokenUpdateOne) SetCreatedAt(v time.Time) *RefreshTokenUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -624,6 +774,72 @@ func (_u *RefreshTokenUpdateOne) SetNillableLastUsed(v *time.Time) *RefreshToken
 	return _u
 }
 
+// SetAcr sets the "acr" field.
+func (_u *RefreshTokenUpdateOne) SetAcr(v string) *RefreshTokenUpdateOne {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *RefreshTokenUpdateOne) SetNillableAcr(v *string) *RefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *RefreshTokenUpdateOne) SetAmr(v []string) *RefreshTokenUpdateOne {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *RefreshTokenUpdateOne) AppendAmr(v []string) *RefreshTokenUpdateOne {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *RefreshTokenUpdateOne) ClearAmr() *RefreshTokenUpdateOne {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *RefreshTokenUpdateOne) SetAuthTime(v time.Time) *RefreshTokenUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *RefreshTokenUpdateOne) SetNillableAuthTime(v *time.Time) *RefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *RefreshTokenUpdateOne) ClearAuthTime() *RefreshTokenUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *RefreshTokenUpdateOne) SetSid(v string) *RefreshTokenUpdateOne {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *RefreshTokenUpdateOne) SetNillableSid(v *string) *RefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
 // Mutation returns the RefreshTokenMutation object of the builder.
 func (_u *RefreshTokenUpdateOne) Mutation() *RefreshTokenMutation {
 	return _u.mutation
@@ -773,6 +989,12 @@ func (_u *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshTok
 	if _u.mutation.ClaimsGroupsCleared() {
 		_spec.ClearField(refreshtoken.FieldClaimsGroups, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(refreshtoken.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(refreshtoken.FieldClaimsExtra, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
 		_spec.SetField(refreshtoken.FieldClaimsPreferredUsername, field.TypeString, value)
 	}
@@ -791,12 +1013,38 @@ func (_u *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshTok
 	if value, ok := _u.mutation.ObsoleteToken(); ok {
 		_spec.SetField(refreshtoken.FieldObsoleteToken, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DpopKeyThumbprint(); ok {
+		_spec.SetField(refreshtoken.FieldDpopKeyThumbprint, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(refreshtoken.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.LastUsed(); ok {
 		_spec.SetField(refreshtoken.FieldLastUsed, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(refreshtoken.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(refreshtoken.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, refreshtoken.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(refreshtoken.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(refreshtoken.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(refreshtoken.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(refreshtoken.FieldSid, field.TypeString, value)
+	}
 	_node = &RefreshToken{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
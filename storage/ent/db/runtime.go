@@ -5,16 +5,24 @@ package db
 import (
 	"time"
 
+	"github.com/dexidp/dex/storage/ent/db/accesstoken"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/clientassertion"
 	"github.com/dexidp/dex/storage/ent/db/connector"
+	"github.com/dexidp/dex/storage/ent/db/consent"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/dpopproof"
+	"github.com/dexidp/dex/storage/ent/db/initialaccesstoken"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/logoutnotification"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/scope"
+	"github.com/dexidp/dex/storage/ent/db/usersession"
 	"github.com/dexidp/dex/storage/ent/schema"
 )
 
@@ -22,6 +30,48 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescClientID is the schema descriptor for client_id field.
+	accesstokenDescClientID := accesstokenFields[1].Descriptor()
+	// accesstoken.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	accesstoken.ClientIDValidator = accesstokenDescClientID.Validators[0].(func(string) error)
+	// accesstokenDescConnectorID is the schema descriptor for connector_id field.
+	accesstokenDescConnectorID := accesstokenFields[4].Descriptor()
+	// accesstoken.DefaultConnectorID holds the default value on creation for the connector_id field.
+	accesstoken.DefaultConnectorID = accesstokenDescConnectorID.Default.(string)
+	// accesstokenDescClaimsUserID is the schema descriptor for claims_user_id field.
+	accesstokenDescClaimsUserID := accesstokenFields[5].Descriptor()
+	// accesstoken.ClaimsUserIDValidator is a validator for the "claims_user_id" field. It is called by the builders before save.
+	accesstoken.ClaimsUserIDValidator = accesstokenDescClaimsUserID.Validators[0].(func(string) error)
+	// accesstokenDescClaimsUsername is the schema descriptor for claims_username field.
+	accesstokenDescClaimsUsername := accesstokenFields[6].Descriptor()
+	// accesstoken.DefaultClaimsUsername holds the default value on creation for the claims_username field.
+	accesstoken.DefaultClaimsUsername = accesstokenDescClaimsUsername.Default.(string)
+	// accesstokenDescClaimsPreferredUsername is the schema descriptor for claims_preferred_username field.
+	accesstokenDescClaimsPreferredUsername := accesstokenFields[7].Descriptor()
+	// accesstoken.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
+	accesstoken.DefaultClaimsPreferredUsername = accesstokenDescClaimsPreferredUsername.Default.(string)
+	// accesstokenDescClaimsEmail is the schema descriptor for claims_email field.
+	accesstokenDescClaimsEmail := accesstokenFields[8].Descriptor()
+	// accesstoken.DefaultClaimsEmail holds the default value on creation for the claims_email field.
+	accesstoken.DefaultClaimsEmail = accesstokenDescClaimsEmail.Default.(string)
+	// accesstokenDescCertificateThumbprint is the schema descriptor for certificate_thumbprint field.
+	accesstokenDescCertificateThumbprint := accesstokenFields[12].Descriptor()
+	// accesstoken.DefaultCertificateThumbprint holds the default value on creation for the certificate_thumbprint field.
+	accesstoken.DefaultCertificateThumbprint = accesstokenDescCertificateThumbprint.Default.(string)
+	// accesstokenDescDpopKeyThumbprint is the schema descriptor for dpop_key_thumbprint field.
+	accesstokenDescDpopKeyThumbprint := accesstokenFields[13].Descriptor()
+	// accesstoken.DefaultDpopKeyThumbprint holds the default value on creation for the dpop_key_thumbprint field.
+	accesstoken.DefaultDpopKeyThumbprint = accesstokenDescDpopKeyThumbprint.Default.(string)
+	// accesstokenDescRefreshTokenID is the schema descriptor for refresh_token_id field.
+	accesstokenDescRefreshTokenID := accesstokenFields[14].Descriptor()
+	// accesstoken.DefaultRefreshTokenID holds the default value on creation for the refresh_token_id field.
+	accesstoken.DefaultRefreshTokenID = accesstokenDescRefreshTokenID.Default.(string)
+	// accesstokenDescID is the schema descriptor for id field.
+	accesstokenDescID := accesstokenFields[0].Descriptor()
+	// accesstoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accesstoken.IDValidator = accesstokenDescID.Validators[0].(func(string) error)
 	authcodeFields := schema.AuthCode{}.Fields()
 	_ = authcodeFields
 	// authcodeDescClientID is the schema descriptor for client_id field.
@@ -49,21 +99,29 @@ func init() {
 	// authcode.ClaimsEmailValidator is a validator for the "claims_email" field. It is called by the builders before save.
 	authcode.ClaimsEmailValidator = authcodeDescClaimsEmail.Validators[0].(func(string) error)
 	// authcodeDescClaimsPreferredUsername is the schema descriptor for claims_preferred_username field.
-	authcodeDescClaimsPreferredUsername := authcodeFields[10].Descriptor()
+	authcodeDescClaimsPreferredUsername := authcodeFields[11].Descriptor()
 	// authcode.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
 	authcode.DefaultClaimsPreferredUsername = authcodeDescClaimsPreferredUsername.Default.(string)
 	// authcodeDescConnectorID is the schema descriptor for connector_id field.
-	authcodeDescConnectorID := authcodeFields[11].Descriptor()
+	authcodeDescConnectorID := authcodeFields[12].Descriptor()
 	// authcode.ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
 	authcode.ConnectorIDValidator = authcodeDescConnectorID.Validators[0].(func(string) error)
 	// authcodeDescCodeChallenge is the schema descriptor for code_challenge field.
-	authcodeDescCodeChallenge := authcodeFields[14].Descriptor()
+	authcodeDescCodeChallenge := authcodeFields[15].Descriptor()
 	// authcode.DefaultCodeChallenge holds the default value on creation for the code_challenge field.
 	authcode.DefaultCodeChallenge = authcodeDescCodeChallenge.Default.(string)
 	// authcodeDescCodeChallengeMethod is the schema descriptor for code_challenge_method field.
-	authcodeDescCodeChallengeMethod := authcodeFields[15].Descriptor()
+	authcodeDescCodeChallengeMethod := authcodeFields[16].Descriptor()
 	// authcode.DefaultCodeChallengeMethod holds the default value on creation for the code_challenge_method field.
 	authcode.DefaultCodeChallengeMethod = authcodeDescCodeChallengeMethod.Default.(string)
+	// authcodeDescAcr is the schema descriptor for acr field.
+	authcodeDescAcr := authcodeFields[17].Descriptor()
+	// authcode.DefaultAcr holds the default value on creation for the acr field.
+	authcode.DefaultAcr = authcodeDescAcr.Default.(string)
+	// authcodeDescSid is the schema descriptor for sid field.
+	authcodeDescSid := authcodeFields[20].Descriptor()
+	// authcode.DefaultSid holds the default value on creation for the sid field.
+	authcode.DefaultSid = authcodeDescSid.Default.(string)
 	// authcodeDescID is the schema descriptor for id field.
 	authcodeDescID := authcodeFields[0].Descriptor()
 	// authcode.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -71,21 +129,51 @@ func init() {
 	authrequestFields := schema.AuthRequest{}.Fields()
 	_ = authrequestFields
 	// authrequestDescClaimsPreferredUsername is the schema descriptor for claims_preferred_username field.
-	authrequestDescClaimsPreferredUsername := authrequestFields[14].Descriptor()
+	authrequestDescClaimsPreferredUsername := authrequestFields[15].Descriptor()
 	// authrequest.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
 	authrequest.DefaultClaimsPreferredUsername = authrequestDescClaimsPreferredUsername.Default.(string)
 	// authrequestDescCodeChallenge is the schema descriptor for code_challenge field.
-	authrequestDescCodeChallenge := authrequestFields[18].Descriptor()
+	authrequestDescCodeChallenge := authrequestFields[19].Descriptor()
 	// authrequest.DefaultCodeChallenge holds the default value on creation for the code_challenge field.
 	authrequest.DefaultCodeChallenge = authrequestDescCodeChallenge.Default.(string)
 	// authrequestDescCodeChallengeMethod is the schema descriptor for code_challenge_method field.
-	authrequestDescCodeChallengeMethod := authrequestFields[19].Descriptor()
+	authrequestDescCodeChallengeMethod := authrequestFields[20].Descriptor()
 	// authrequest.DefaultCodeChallengeMethod holds the default value on creation for the code_challenge_method field.
 	authrequest.DefaultCodeChallengeMethod = authrequestDescCodeChallengeMethod.Default.(string)
+	// authrequestDescPrompt is the schema descriptor for prompt field.
+	authrequestDescPrompt := authrequestFields[22].Descriptor()
+	// authrequest.DefaultPrompt holds the default value on creation for the prompt field.
+	authrequest.DefaultPrompt = authrequestDescPrompt.Default.(string)
+	// authrequestDescMaxAge is the schema descriptor for max_age field.
+	authrequestDescMaxAge := authrequestFields[23].Descriptor()
+	// authrequest.DefaultMaxAge holds the default value on creation for the max_age field.
+	authrequest.DefaultMaxAge = authrequestDescMaxAge.Default.(int)
+	// authrequestDescAcr is the schema descriptor for acr field.
+	authrequestDescAcr := authrequestFields[25].Descriptor()
+	// authrequest.DefaultAcr holds the default value on creation for the acr field.
+	authrequest.DefaultAcr = authrequestDescAcr.Default.(string)
+	// authrequestDescSid is the schema descriptor for sid field.
+	authrequestDescSid := authrequestFields[28].Descriptor()
+	// authrequest.DefaultSid holds the default value on creation for the sid field.
+	authrequest.DefaultSid = authrequestDescSid.Default.(string)
+	// authrequestDescLoginHint is the schema descriptor for login_hint field.
+	authrequestDescLoginHint := authrequestFields[29].Descriptor()
+	// authrequest.DefaultLoginHint holds the default value on creation for the login_hint field.
+	authrequest.DefaultLoginHint = authrequestDescLoginHint.Default.(string)
 	// authrequestDescID is the schema descriptor for id field.
 	authrequestDescID := authrequestFields[0].Descriptor()
 	// authrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	authrequest.IDValidator = authrequestDescID.Validators[0].(func(string) error)
+	clientassertionFields := schema.ClientAssertion{}.Fields()
+	_ = clientassertionFields
+	// clientassertionDescClientID is the schema descriptor for client_id field.
+	clientassertionDescClientID := clientassertionFields[1].Descriptor()
+	// clientassertion.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	clientassertion.ClientIDValidator = clientassertionDescClientID.Validators[0].(func(string) error)
+	// clientassertionDescID is the schema descriptor for id field.
+	clientassertionDescID := clientassertionFields[0].Descriptor()
+	// clientassertion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	clientassertion.IDValidator = clientassertionDescID.Validators[0].(func(string) error)
 	connectorFields := schema.Connector{}.Fields()
 	_ = connectorFields
 	// connectorDescType is the schema descriptor for type field.
@@ -114,6 +202,24 @@ func init() {
 			return nil
 		}
 	}()
+	consentFields := schema.Consent{}.Fields()
+	_ = consentFields
+	// consentDescUserID is the schema descriptor for user_id field.
+	consentDescUserID := consentFields[1].Descriptor()
+	// consent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	consent.UserIDValidator = consentDescUserID.Validators[0].(func(string) error)
+	// consentDescConnID is the schema descriptor for conn_id field.
+	consentDescConnID := consentFields[2].Descriptor()
+	// consent.ConnIDValidator is a validator for the "conn_id" field. It is called by the builders before save.
+	consent.ConnIDValidator = consentDescConnID.Validators[0].(func(string) error)
+	// consentDescClientID is the schema descriptor for client_id field.
+	consentDescClientID := consentFields[3].Descriptor()
+	// consent.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	consent.ClientIDValidator = consentDescClientID.Validators[0].(func(string) error)
+	// consentDescID is the schema descriptor for id field.
+	consentDescID := consentFields[0].Descriptor()
+	// consent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	consent.IDValidator = consentDescID.Validators[0].(func(string) error)
 	devicerequestFields := schema.DeviceRequest{}.Fields()
 	_ = devicerequestFields
 	// devicerequestDescUserCode is the schema descriptor for user_code field.
@@ -150,24 +256,98 @@ func init() {
 	devicetokenDescCodeChallengeMethod := devicetokenFields[7].Descriptor()
 	// devicetoken.DefaultCodeChallengeMethod holds the default value on creation for the code_challenge_method field.
 	devicetoken.DefaultCodeChallengeMethod = devicetokenDescCodeChallengeMethod.Default.(string)
+	dpopproofFields := schema.DpopProof{}.Fields()
+	_ = dpopproofFields
+	// dpopproofDescID is the schema descriptor for id field.
+	dpopproofDescID := dpopproofFields[0].Descriptor()
+	// dpopproof.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	dpopproof.IDValidator = dpopproofDescID.Validators[0].(func(string) error)
+	initialaccesstokenFields := schema.InitialAccessToken{}.Fields()
+	_ = initialaccesstokenFields
+	// initialaccesstokenDescID is the schema descriptor for id field.
+	initialaccesstokenDescID := initialaccesstokenFields[0].Descriptor()
+	// initialaccesstoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	initialaccesstoken.IDValidator = initialaccesstokenDescID.Validators[0].(func(string) error)
 	keysFields := schema.Keys{}.Fields()
 	_ = keysFields
 	// keysDescID is the schema descriptor for id field.
 	keysDescID := keysFields[0].Descriptor()
 	// keys.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	keys.IDValidator = keysDescID.Validators[0].(func(string) error)
+	logoutnotificationFields := schema.LogoutNotification{}.Fields()
+	_ = logoutnotificationFields
+	// logoutnotificationDescClientID is the schema descriptor for client_id field.
+	logoutnotificationDescClientID := logoutnotificationFields[1].Descriptor()
+	// logoutnotification.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	logoutnotification.ClientIDValidator = logoutnotificationDescClientID.Validators[0].(func(string) error)
+	// logoutnotificationDescSubject is the schema descriptor for subject field.
+	logoutnotificationDescSubject := logoutnotificationFields[2].Descriptor()
+	// logoutnotification.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	logoutnotification.SubjectValidator = logoutnotificationDescSubject.Validators[0].(func(string) error)
+	// logoutnotificationDescSessionID is the schema descriptor for session_id field.
+	logoutnotificationDescSessionID := logoutnotificationFields[3].Descriptor()
+	// logoutnotification.DefaultSessionID holds the default value on creation for the session_id field.
+	logoutnotification.DefaultSessionID = logoutnotificationDescSessionID.Default.(string)
+	// logoutnotificationDescID is the schema descriptor for id field.
+	logoutnotificationDescID := logoutnotificationFields[0].Descriptor()
+	// logoutnotification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	logoutnotification.IDValidator = logoutnotificationDescID.Validators[0].(func(string) error)
 	oauth2clientFields := schema.OAuth2Client{}.Fields()
 	_ = oauth2clientFields
 	// oauth2clientDescSecret is the schema descriptor for secret field.
 	oauth2clientDescSecret := oauth2clientFields[1].Descriptor()
 	// oauth2client.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
 	oauth2client.SecretValidator = oauth2clientDescSecret.Validators[0].(func(string) error)
+	// oauth2clientDescBackchannelLogoutURI is the schema descriptor for backchannel_logout_uri field.
+	oauth2clientDescBackchannelLogoutURI := oauth2clientFields[5].Descriptor()
+	// oauth2client.DefaultBackchannelLogoutURI holds the default value on creation for the backchannel_logout_uri field.
+	oauth2client.DefaultBackchannelLogoutURI = oauth2clientDescBackchannelLogoutURI.Default.(string)
+	// oauth2clientDescAccessTokenFormat is the schema descriptor for access_token_format field.
+	oauth2clientDescAccessTokenFormat := oauth2clientFields[8].Descriptor()
+	// oauth2client.DefaultAccessTokenFormat holds the default value on creation for the access_token_format field.
+	oauth2client.DefaultAccessTokenFormat = oauth2clientDescAccessTokenFormat.Default.(string)
+	// oauth2clientDescRequirePushedAuthorizationRequests is the schema descriptor for require_pushed_authorization_requests field.
+	oauth2clientDescRequirePushedAuthorizationRequests := oauth2clientFields[9].Descriptor()
+	// oauth2client.DefaultRequirePushedAuthorizationRequests holds the default value on creation for the require_pushed_authorization_requests field.
+	oauth2client.DefaultRequirePushedAuthorizationRequests = oauth2clientDescRequirePushedAuthorizationRequests.Default.(bool)
+	// oauth2clientDescJwks is the schema descriptor for jwks field.
+	oauth2clientDescJwks := oauth2clientFields[10].Descriptor()
+	// oauth2client.DefaultJwks holds the default value on creation for the jwks field.
+	oauth2client.DefaultJwks = oauth2clientDescJwks.Default.(string)
+	// oauth2clientDescJwksURI is the schema descriptor for jwks_uri field.
+	oauth2clientDescJwksURI := oauth2clientFields[11].Descriptor()
+	// oauth2client.DefaultJwksURI holds the default value on creation for the jwks_uri field.
+	oauth2client.DefaultJwksURI = oauth2clientDescJwksURI.Default.(string)
+	// oauth2clientDescRegistrationAccessTokenHash is the schema descriptor for registration_access_token_hash field.
+	oauth2clientDescRegistrationAccessTokenHash := oauth2clientFields[12].Descriptor()
+	// oauth2client.DefaultRegistrationAccessTokenHash holds the default value on creation for the registration_access_token_hash field.
+	oauth2client.DefaultRegistrationAccessTokenHash = oauth2clientDescRegistrationAccessTokenHash.Default.(string)
+	// oauth2clientDescTokenEndpointAuthMethod is the schema descriptor for token_endpoint_auth_method field.
+	oauth2clientDescTokenEndpointAuthMethod := oauth2clientFields[13].Descriptor()
+	// oauth2client.DefaultTokenEndpointAuthMethod holds the default value on creation for the token_endpoint_auth_method field.
+	oauth2client.DefaultTokenEndpointAuthMethod = oauth2clientDescTokenEndpointAuthMethod.Default.(string)
+	// oauth2clientDescTLSClientAuthSubjectDn is the schema descriptor for tls_client_auth_subject_dn field.
+	oauth2clientDescTLSClientAuthSubjectDn := oauth2clientFields[14].Descriptor()
+	// oauth2client.DefaultTLSClientAuthSubjectDn holds the default value on creation for the tls_client_auth_subject_dn field.
+	oauth2client.DefaultTLSClientAuthSubjectDn = oauth2clientDescTLSClientAuthSubjectDn.Default.(string)
+	// oauth2clientDescSubjectType is the schema descriptor for subject_type field.
+	oauth2clientDescSubjectType := oauth2clientFields[16].Descriptor()
+	// oauth2client.DefaultSubjectType holds the default value on creation for the subject_type field.
+	oauth2client.DefaultSubjectType = oauth2clientDescSubjectType.Default.(string)
+	// oauth2clientDescSectorIdentifierURI is the schema descriptor for sector_identifier_uri field.
+	oauth2clientDescSectorIdentifierURI := oauth2clientFields[17].Descriptor()
+	// oauth2client.DefaultSectorIdentifierURI holds the default value on creation for the sector_identifier_uri field.
+	oauth2client.DefaultSectorIdentifierURI = oauth2clientDescSectorIdentifierURI.Default.(string)
+	// oauth2clientDescRequirePkce is the schema descriptor for require_pkce field.
+	oauth2clientDescRequirePkce := oauth2clientFields[23].Descriptor()
+	// oauth2client.DefaultRequirePkce holds the default value on creation for the require_pkce field.
+	oauth2client.DefaultRequirePkce = oauth2clientDescRequirePkce.Default.(bool)
 	// oauth2clientDescName is the schema descriptor for name field.
-	oauth2clientDescName := oauth2clientFields[5].Descriptor()
+	oauth2clientDescName := oauth2clientFields[25].Descriptor()
 	// oauth2client.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	oauth2client.NameValidator = oauth2clientDescName.Validators[0].(func(string) error)
 	// oauth2clientDescLogoURL is the schema descriptor for logo_url field.
-	oauth2clientDescLogoURL := oauth2clientFields[6].Descriptor()
+	oauth2clientDescLogoURL := oauth2clientFields[26].Descriptor()
 	// oauth2client.LogoURLValidator is a validator for the "logo_url" field. It is called by the builders before save.
 	oauth2client.LogoURLValidator = oauth2clientDescLogoURL.Validators[0].(func(string) error)
 	// oauth2clientDescID is the schema descriptor for id field.
@@ -247,31 +427,83 @@ func init() {
 	// refreshtoken.ClaimsEmailValidator is a validator for the "claims_email" field. It is called by the builders before save.
 	refreshtoken.ClaimsEmailValidator = refreshtokenDescClaimsEmail.Validators[0].(func(string) error)
 	// refreshtokenDescClaimsPreferredUsername is the schema descriptor for claims_preferred_username field.
-	refreshtokenDescClaimsPreferredUsername := refreshtokenFields[9].Descriptor()
+	refreshtokenDescClaimsPreferredUsername := refreshtokenFields[10].Descriptor()
 	// refreshtoken.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
 	refreshtoken.DefaultClaimsPreferredUsername = refreshtokenDescClaimsPreferredUsername.Default.(string)
 	// refreshtokenDescConnectorID is the schema descriptor for connector_id field.
-	refreshtokenDescConnectorID := refreshtokenFields[10].Descriptor()
+	refreshtokenDescConnectorID := refreshtokenFields[11].Descriptor()
 	// refreshtoken.ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
 	refreshtoken.ConnectorIDValidator = refreshtokenDescConnectorID.Validators[0].(func(string) error)
 	// refreshtokenDescToken is the schema descriptor for token field.
-	refreshtokenDescToken := refreshtokenFields[12].Descriptor()
+	refreshtokenDescToken := refreshtokenFields[13].Descriptor()
 	// refreshtoken.DefaultToken holds the default value on creation for the token field.
 	refreshtoken.DefaultToken = refreshtokenDescToken.Default.(string)
 	// refreshtokenDescObsoleteToken is the schema descriptor for obsolete_token field.
-	refreshtokenDescObsoleteToken := refreshtokenFields[13].Descriptor()
+	refreshtokenDescObsoleteToken := refreshtokenFields[14].Descriptor()
 	// refreshtoken.DefaultObsoleteToken holds the default value on creation for the obsolete_token field.
 	refreshtoken.DefaultObsoleteToken = refreshtokenDescObsoleteToken.Default.(string)
+	// refreshtokenDescDpopKeyThumbprint is the schema descriptor for dpop_key_thumbprint field.
+	refreshtokenDescDpopKeyThumbprint := refreshtokenFields[15].Descriptor()
+	// refreshtoken.DefaultDpopKeyThumbprint holds the default value on creation for the dpop_key_thumbprint field.
+	refreshtoken.DefaultDpopKeyThumbprint = refreshtokenDescDpopKeyThumbprint.Default.(string)
 	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
-	refreshtokenDescCreatedAt := refreshtokenFields[14].Descriptor()
+	refreshtokenDescCreatedAt := refreshtokenFields[16].Descriptor()
 	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
 	// refreshtokenDescLastUsed is the schema descriptor for last_used field.
-	refreshtokenDescLastUsed := refreshtokenFields[15].Descriptor()
+	refreshtokenDescLastUsed := refreshtokenFields[17].Descriptor()
 	// refreshtoken.DefaultLastUsed holds the default value on creation for the last_used field.
 	refreshtoken.DefaultLastUsed = refreshtokenDescLastUsed.Default.(func() time.Time)
+	// refreshtokenDescAcr is the schema descriptor for acr field.
+	refreshtokenDescAcr := refreshtokenFields[18].Descriptor()
+	// refreshtoken.DefaultAcr holds the default value on creation for the acr field.
+	refreshtoken.DefaultAcr = refreshtokenDescAcr.Default.(string)
+	// refreshtokenDescSid is the schema descriptor for sid field.
+	refreshtokenDescSid := refreshtokenFields[21].Descriptor()
+	// refreshtoken.DefaultSid holds the default value on creation for the sid field.
+	refreshtoken.DefaultSid = refreshtokenDescSid.Default.(string)
 	// refreshtokenDescID is the schema descriptor for id field.
 	refreshtokenDescID := refreshtokenFields[0].Descriptor()
 	// refreshtoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	refreshtoken.IDValidator = refreshtokenDescID.Validators[0].(func(string) error)
+	scopeFields := schema.Scope{}.Fields()
+	_ = scopeFields
+	// scopeDescID is the schema descriptor for id field.
+	scopeDescID := scopeFields[0].Descriptor()
+	// scope.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scope.IDValidator = scopeDescID.Validators[0].(func(string) error)
+	usersessionFields := schema.UserSession{}.Fields()
+	_ = usersessionFields
+	// usersessionDescConnectorID is the schema descriptor for connector_id field.
+	usersessionDescConnectorID := usersessionFields[1].Descriptor()
+	// usersession.ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
+	usersession.ConnectorIDValidator = usersessionDescConnectorID.Validators[0].(func(string) error)
+	// usersessionDescClaimsUserID is the schema descriptor for claims_user_id field.
+	usersessionDescClaimsUserID := usersessionFields[3].Descriptor()
+	// usersession.ClaimsUserIDValidator is a validator for the "claims_user_id" field. It is called by the builders before save.
+	usersession.ClaimsUserIDValidator = usersessionDescClaimsUserID.Validators[0].(func(string) error)
+	// usersessionDescClaimsUsername is the schema descriptor for claims_username field.
+	usersessionDescClaimsUsername := usersessionFields[4].Descriptor()
+	// usersession.DefaultClaimsUsername holds the default value on creation for the claims_username field.
+	usersession.DefaultClaimsUsername = usersessionDescClaimsUsername.Default.(string)
+	// usersessionDescClaimsPreferredUsername is the schema descriptor for claims_preferred_username field.
+	usersessionDescClaimsPreferredUsername := usersessionFields[5].Descriptor()
+	// usersession.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
+	usersession.DefaultClaimsPreferredUsername = usersessionDescClaimsPreferredUsername.Default.(string)
+	// usersessionDescClaimsEmail is the schema descriptor for claims_email field.
+	usersessionDescClaimsEmail := usersessionFields[6].Descriptor()
+	// usersession.DefaultClaimsEmail holds the default value on creation for the claims_email field.
+	usersession.DefaultClaimsEmail = usersessionDescClaimsEmail.Default.(string)
+	// usersessionDescAcr is the schema descriptor for acr field.
+	usersessionDescAcr := usersessionFields[13].Descriptor()
+	// usersession.DefaultAcr holds the default value on creation for the acr field.
+	usersession.DefaultAcr = usersessionDescAcr.Default.(string)
+	// usersessionDescSid is the schema descriptor for sid field.
+	usersessionDescSid := usersessionFields[16].Descriptor()
+	// usersession.DefaultSid holds the default value on creation for the sid field.
+	usersession.DefaultSid = usersessionDescSid.Default.(string)
+	// usersessionDescID is the schema descriptor for id field.
+	usersessionDescID := usersessionFields[0].Descriptor()
+	// usersession.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usersession.IDValidator = usersessionDescID.Validators[0].(func(string) error)
 }
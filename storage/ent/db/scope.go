@@ -0,0 +1,132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/scope"
+)
+
+// Scope is the model entity for the Scope schema.
+type Scope struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Descriptions holds the value of the "descriptions" field.
+	Descriptions map[string]string `json:"descriptions,omitempty"`
+	// Claims holds the value of the "claims" field.
+	Claims []string `json:"claims,omitempty"`
+	// ClientIds holds the value of the "client_ids" field.
+	ClientIds    []string `json:"client_ids,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Scope) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scope.FieldDescriptions, scope.FieldClaims, scope.FieldClientIds:
+			values[i] = new([]byte)
+		case scope.FieldID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Scope fields.
+func (_m *Scope) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scope.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case scope.FieldDescriptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field descriptions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Descriptions); err != nil {
+					return fmt.Errorf("unmarshal field descriptions: %w", err)
+				}
+			}
+		case scope.FieldClaims:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claims", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Claims); err != nil {
+					return fmt.Errorf("unmarshal field claims: %w", err)
+				}
+			}
+		case scope.FieldClientIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClientIds); err != nil {
+					return fmt.Errorf("unmarshal field client_ids: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Scope.
+// This includes values selected through modifiers, order, etc.
+func (_m *Scope) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Scope.
+// Note that you need to call Scope.Unwrap() before calling this method if this Scope
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Scope) Update() *ScopeUpdateOne {
+	return NewScopeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Scope entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Scope) Unwrap() *Scope {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Scope is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Scope) String() string {
+	var builder strings.Builder
+	builder.WriteString("Scope(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("descriptions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Descriptions))
+	builder.WriteString(", ")
+	builder.WriteString("claims=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Claims))
+	builder.WriteString(", ")
+	builder.WriteString("client_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientIds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Scopes is a parsable slice of Scope.
+type Scopes []*Scope
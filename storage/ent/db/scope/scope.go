@@ -0,0 +1,53 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scope
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the scope type in the database.
+	Label = "scope"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDescriptions holds the string denoting the descriptions field in the database.
+	FieldDescriptions = "descriptions"
+	// FieldClaims holds the string denoting the claims field in the database.
+	FieldClaims = "claims"
+	// FieldClientIds holds the string denoting the client_ids field in the database.
+	FieldClientIds = "client_ids"
+	// Table holds the table name of the scope in the database.
+	Table = "scopes"
+)
+
+// Columns holds all SQL columns for scope fields.
+var Columns = []string{
+	FieldID,
+	FieldDescriptions,
+	FieldClaims,
+	FieldClientIds,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Scope queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scope
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Scope {
+	return predicate.Scope(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Scope {
+	return predicate.Scope(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Scope {
+	return predicate.Scope(sql.FieldContainsFold(FieldID, id))
+}
+
+// DescriptionsIsNil applies the IsNil predicate on the "descriptions" field.
+func DescriptionsIsNil() predicate.Scope {
+	return predicate.Scope(sql.FieldIsNull(FieldDescriptions))
+}
+
+// DescriptionsNotNil applies the NotNil predicate on the "descriptions" field.
+func DescriptionsNotNil() predicate.Scope {
+	return predicate.Scope(sql.FieldNotNull(FieldDescriptions))
+}
+
+// ClaimsIsNil applies the IsNil predicate on the "claims" field.
+func ClaimsIsNil() predicate.Scope {
+	return predicate.Scope(sql.FieldIsNull(FieldClaims))
+}
+
+// ClaimsNotNil applies the NotNil predicate on the "claims" field.
+func ClaimsNotNil() predicate.Scope {
+	return predicate.Scope(sql.FieldNotNull(FieldClaims))
+}
+
+// ClientIdsIsNil applies the IsNil predicate on the "client_ids" field.
+func ClientIdsIsNil() predicate.Scope {
+	return predicate.Scope(sql.FieldIsNull(FieldClientIds))
+}
+
+// ClientIdsNotNil applies the NotNil predicate on the "client_ids" field.
+func ClientIdsNotNil() predicate.Scope {
+	return predicate.Scope(sql.FieldNotNull(FieldClientIds))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Scope) predicate.Scope {
+	return predicate.Scope(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Scope) predicate.Scope {
+	return predicate.Scope(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Scope) predicate.Scope {
+	return predicate.Scope(sql.NotPredicates(p))
+}
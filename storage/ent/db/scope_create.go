@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/scope"
+)
+
+// ScopeCreate is the builder for creating a Scope entity.
+type ScopeCreate struct {
+	config
+	mutation *ScopeMutation
+	hooks    []Hook
+}
+
+// SetDescriptions sets the "descriptions" field.
+func (_c *ScopeCreate) SetDescriptions(v map[string]string) *ScopeCreate {
+	_c.mutation.SetDescriptions(v)
+	return _c
+}
+
+// SetClaims sets the "claims" field.
+func (_c *ScopeCreate) SetClaims(v []string) *ScopeCreate {
+	_c.mutation.SetClaims(v)
+	return _c
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_c *ScopeCreate) SetClientIds(v []string) *ScopeCreate {
+	_c.mutation.SetClientIds(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScopeCreate) SetID(v string) *ScopeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScopeMutation object of the builder.
+func (_c *ScopeCreate) Mutation() *ScopeMutation {
+	return _c.mutation
+}
+
+// Save creates the Scope in the database.
+func (_c *ScopeCreate) Save(ctx context.Context) (*Scope, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScopeCreate) SaveX(ctx context.Context) *Scope {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScopeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScopeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScopeCreate) check() error {
+	if v, ok := _c.mutation.ID(); ok {
+		if err := scope.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "Scope.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScopeCreate) sqlSave(ctx context.Context) (*Scope, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Scope.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScopeCreate) createSpec() (*Scope, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Scope{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scope.Table, sqlgraph.NewFieldSpec(scope.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Descriptions(); ok {
+		_spec.SetField(scope.FieldDescriptions, field.TypeJSON, value)
+		_node.Descriptions = value
+	}
+	if value, ok := _c.mutation.Claims(); ok {
+		_spec.SetField(scope.FieldClaims, field.TypeJSON, value)
+		_node.Claims = value
+	}
+	if value, ok := _c.mutation.ClientIds(); ok {
+		_spec.SetField(scope.FieldClientIds, field.TypeJSON, value)
+		_node.ClientIds = value
+	}
+	return _node, _spec
+}
+
+// ScopeCreateBulk is the builder for creating many Scope entities in bulk.
+type ScopeCreateBulk struct {
+	config
+	err      error
+	builders []*ScopeCreate
+}
+
+// Save creates the Scope entities in the database.
+func (_c *ScopeCreateBulk) Save(ctx context.Context) ([]*Scope, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Scope, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScopeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScopeCreateBulk) SaveX(ctx context.Context) []*Scope {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScopeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScopeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/scope"
+)
+
+// ScopeUpdate is the builder for updating Scope entities.
+type ScopeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScopeMutation
+}
+
+// Where appends a list predicates to the ScopeUpdate builder.
+func (_u *ScopeUpdate) Where(ps ...predicate.Scope) *ScopeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDescriptions sets the "descriptions" field.
+func (_u *ScopeUpdate) SetDescriptions(v map[string]string) *ScopeUpdate {
+	_u.mutation.SetDescriptions(v)
+	return _u
+}
+
+// ClearDescriptions clears the value of the "descriptions" field.
+func (_u *ScopeUpdate) ClearDescriptions() *ScopeUpdate {
+	_u.mutation.ClearDescriptions()
+	return _u
+}
+
+// SetClaims sets the "claims" field.
+func (_u *ScopeUpdate) SetClaims(v []string) *ScopeUpdate {
+	_u.mutation.SetClaims(v)
+	return _u
+}
+
+// AppendClaims appends value to the "claims" field.
+func (_u *ScopeUpdate) AppendClaims(v []string) *ScopeUpdate {
+	_u.mutation.AppendClaims(v)
+	return _u
+}
+
+// ClearClaims clears the value of the "claims" field.
+func (_u *ScopeUpdate) ClearClaims() *ScopeUpdate {
+	_u.mutation.ClearClaims()
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ScopeUpdate) SetClientIds(v []string) *ScopeUpdate {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ScopeUpdate) AppendClientIds(v []string) *ScopeUpdate {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ScopeUpdate) ClearClientIds() *ScopeUpdate {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// Mutation returns the ScopeMutation object of the builder.
+func (_u *ScopeUpdate) Mutation() *ScopeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScopeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScopeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScopeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScopeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ScopeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scope.Table, scope.Columns, sqlgraph.NewFieldSpec(scope.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Descriptions(); ok {
+		_spec.SetField(scope.FieldDescriptions, field.TypeJSON, value)
+	}
+	if _u.mutation.DescriptionsCleared() {
+		_spec.ClearField(scope.FieldDescriptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Claims(); ok {
+		_spec.SetField(scope.FieldClaims, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaims(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scope.FieldClaims, value)
+		})
+	}
+	if _u.mutation.ClaimsCleared() {
+		_spec.ClearField(scope.FieldClaims, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(scope.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scope.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(scope.FieldClientIds, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScopeUpdateOne is the builder for updating a single Scope entity.
+type ScopeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScopeMutation
+}
+
+// SetDescriptions sets the "descriptions" field.
+func (_u *ScopeUpdateOne) SetDescriptions(v map[string]string) *ScopeUpdateOne {
+	_u.mutation.SetDescriptions(v)
+	return _u
+}
+
+// ClearDescriptions clears the value of the "descriptions" field.
+func (_u *ScopeUpdateOne) ClearDescriptions() *ScopeUpdateOne {
+	_u.mutation.ClearDescriptions()
+	return _u
+}
+
+// SetClaims sets the "claims" field.
+func (_u *ScopeUpdateOne) SetClaims(v []string) *ScopeUpdateOne {
+	_u.mutation.SetClaims(v)
+	return _u
+}
+
+// AppendClaims appends value to the "claims" field.
+func (_u *ScopeUpdateOne) AppendClaims(v []string) *ScopeUpdateOne {
+	_u.mutation.AppendClaims(v)
+	return _u
+}
+
+// ClearClaims clears the value of the "claims" field.
+func (_u *ScopeUpdateOne) ClearClaims() *ScopeUpdateOne {
+	_u.mutation.ClearClaims()
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ScopeUpdateOne) SetClientIds(v []string) *ScopeUpdateOne {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ScopeUpdateOne) AppendClientIds(v []string) *ScopeUpdateOne {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ScopeUpdateOne) ClearClientIds() *ScopeUpdateOne {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// Mutation returns the ScopeMutation object of the builder.
+func (_u *ScopeUpdateOne) Mutation() *ScopeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScopeUpdate builder.
+func (_u *ScopeUpdateOne) Where(ps ...predicate.Scope) *ScopeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScopeUpdateOne) Select(field string, fields ...string) *ScopeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Scope entity.
+func (_u *ScopeUpdateOne) Save(ctx context.Context) (*Scope, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScopeUpdateOne) SaveX(ctx context.Context) *Scope {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScopeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScopeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ScopeUpdateOne) sqlSave(ctx context.Context) (_node *Scope, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scope.Table, scope.Columns, sqlgraph.NewFieldSpec(scope.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "Scope.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scope.FieldID)
+		for _, f := range fields {
+			if !scope.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != scope.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Descriptions(); ok {
+		_spec.SetField(scope.FieldDescriptions, field.TypeJSON, value)
+	}
+	if _u.mutation.DescriptionsCleared() {
+		_spec.ClearField(scope.FieldDescriptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Claims(); ok {
+		_spec.SetField(scope.FieldClaims, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaims(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scope.FieldClaims, value)
+		})
+	}
+	if _u.mutation.ClaimsCleared() {
+		_spec.ClearField(scope.FieldClaims, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(scope.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scope.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(scope.FieldClientIds, field.TypeJSON)
+	}
+	_node = &Scope{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,18 +12,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
 	// AuthCode is the client for interacting with the AuthCode builders.
 	AuthCode *AuthCodeClient
 	// AuthRequest is the client for interacting with the AuthRequest builders.
 	AuthRequest *AuthRequestClient
+	// ClientAssertion is the client for interacting with the ClientAssertion builders.
+	ClientAssertion *ClientAssertionClient
 	// Connector is the client for interacting with the Connector builders.
 	Connector *ConnectorClient
+	// Consent is the client for interacting with the Consent builders.
+	Consent *ConsentClient
 	// DeviceRequest is the client for interacting with the DeviceRequest builders.
 	DeviceRequest *DeviceRequestClient
 	// DeviceToken is the client for interacting with the DeviceToken builders.
 	DeviceToken *DeviceTokenClient
+	// DpopProof is the client for interacting with the DpopProof builders.
+	DpopProof *DpopProofClient
+	// InitialAccessToken is the client for interacting with the InitialAccessToken builders.
+	InitialAccessToken *InitialAccessTokenClient
 	// Keys is the client for interacting with the Keys builders.
 	Keys *KeysClient
+	// LogoutNotification is the client for interacting with the LogoutNotification builders.
+	LogoutNotification *LogoutNotificationClient
 	// OAuth2Client is the client for interacting with the OAuth2Client builders.
 	OAuth2Client *OAuth2ClientClient
 	// OfflineSession is the client for interacting with the OfflineSession builders.
@@ -32,6 +44,10 @@ type Tx struct {
 	Password *PasswordClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// Scope is the client for interacting with the Scope builders.
+	Scope *ScopeClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
 
 	// lazily loaded.
 	client     *Client
@@ -163,16 +179,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
 	tx.AuthCode = NewAuthCodeClient(tx.config)
 	tx.AuthRequest = NewAuthRequestClient(tx.config)
+	tx.ClientAssertion = NewClientAssertionClient(tx.config)
 	tx.Connector = NewConnectorClient(tx.config)
+	tx.Consent = NewConsentClient(tx.config)
 	tx.DeviceRequest = NewDeviceRequestClient(tx.config)
 	tx.DeviceToken = NewDeviceTokenClient(tx.config)
+	tx.DpopProof = NewDpopProofClient(tx.config)
+	tx.InitialAccessToken = NewInitialAccessTokenClient(tx.config)
 	tx.Keys = NewKeysClient(tx.config)
+	tx.LogoutNotification = NewLogoutNotificationClient(tx.config)
 	tx.OAuth2Client = NewOAuth2ClientClient(tx.config)
 	tx.OfflineSession = NewOfflineSessionClient(tx.config)
 	tx.Password = NewPasswordClient(tx.config)
 	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Scope = NewScopeClient(tx.config)
+	tx.UserSession = NewUserSessionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -182,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuthCode.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,870 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usersession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldID, id))
+}
+
+// ConnectorID applies equality check predicate on the "connector_id" field. It's identical to ConnectorIDEQ.
+func ConnectorID(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// ConnectorData applies equality check predicate on the "connector_data" field. It's identical to ConnectorDataEQ.
+func ConnectorData(v []byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldConnectorData, v))
+}
+
+// ClaimsUserID applies equality check predicate on the "claims_user_id" field. It's identical to ClaimsUserIDEQ.
+func ClaimsUserID(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsUserID, v))
+}
+
+// ClaimsUsername applies equality check predicate on the "claims_username" field. It's identical to ClaimsUsernameEQ.
+func ClaimsUsername(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsUsername, v))
+}
+
+// ClaimsPreferredUsername applies equality check predicate on the "claims_preferred_username" field. It's identical to ClaimsPreferredUsernameEQ.
+func ClaimsPreferredUsername(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsEmail applies equality check predicate on the "claims_email" field. It's identical to ClaimsEmailEQ.
+func ClaimsEmail(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailVerified applies equality check predicate on the "claims_email_verified" field. It's identical to ClaimsEmailVerifiedEQ.
+func ClaimsEmailVerified(v bool) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsEmailVerified, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LastUsed applies equality check predicate on the "last_used" field. It's identical to LastUsedEQ.
+func LastUsed(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldLastUsed, v))
+}
+
+// Expiry applies equality check predicate on the "expiry" field. It's identical to ExpiryEQ.
+func Expiry(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldExpiry, v))
+}
+
+// Acr applies equality check predicate on the "acr" field. It's identical to AcrEQ.
+func Acr(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldAcr, v))
+}
+
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// Sid applies equality check predicate on the "sid" field. It's identical to SidEQ.
+func Sid(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldSid, v))
+}
+
+// ConnectorIDEQ applies the EQ predicate on the "connector_id" field.
+func ConnectorIDEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDNEQ applies the NEQ predicate on the "connector_id" field.
+func ConnectorIDNEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDIn applies the In predicate on the "connector_id" field.
+func ConnectorIDIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDNotIn applies the NotIn predicate on the "connector_id" field.
+func ConnectorIDNotIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDGT applies the GT predicate on the "connector_id" field.
+func ConnectorIDGT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldConnectorID, v))
+}
+
+// ConnectorIDGTE applies the GTE predicate on the "connector_id" field.
+func ConnectorIDGTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldConnectorID, v))
+}
+
+// ConnectorIDLT applies the LT predicate on the "connector_id" field.
+func ConnectorIDLT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldConnectorID, v))
+}
+
+// ConnectorIDLTE applies the LTE predicate on the "connector_id" field.
+func ConnectorIDLTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldConnectorID, v))
+}
+
+// ConnectorIDContains applies the Contains predicate on the "connector_id" field.
+func ConnectorIDContains(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContains(FieldConnectorID, v))
+}
+
+// ConnectorIDHasPrefix applies the HasPrefix predicate on the "connector_id" field.
+func ConnectorIDHasPrefix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasPrefix(FieldConnectorID, v))
+}
+
+// ConnectorIDHasSuffix applies the HasSuffix predicate on the "connector_id" field.
+func ConnectorIDHasSuffix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasSuffix(FieldConnectorID, v))
+}
+
+// ConnectorIDEqualFold applies the EqualFold predicate on the "connector_id" field.
+func ConnectorIDEqualFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldConnectorID, v))
+}
+
+// ConnectorIDContainsFold applies the ContainsFold predicate on the "connector_id" field.
+func ConnectorIDContainsFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldConnectorID, v))
+}
+
+// ConnectorDataEQ applies the EQ predicate on the "connector_data" field.
+func ConnectorDataEQ(v []byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldConnectorData, v))
+}
+
+// ConnectorDataNEQ applies the NEQ predicate on the "connector_data" field.
+func ConnectorDataNEQ(v []byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldConnectorData, v))
+}
+
+// ConnectorDataIn applies the In predicate on the "connector_data" field.
+func ConnectorDataIn(vs ...[]byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldConnectorData, vs...))
+}
+
+// ConnectorDataNotIn applies the NotIn predicate on the "connector_data" field.
+func ConnectorDataNotIn(vs ...[]byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldConnectorData, vs...))
+}
+
+// ConnectorDataGT applies the GT predicate on the "connector_data" field.
+func ConnectorDataGT(v []byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldConnectorData, v))
+}
+
+// ConnectorDataGTE applies the GTE predicate on the "connector_data" field.
+func ConnectorDataGTE(v []byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldConnectorData, v))
+}
+
+// ConnectorDataLT applies the LT predicate on the "connector_data" field.
+func ConnectorDataLT(v []byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldConnectorData, v))
+}
+
+// ConnectorDataLTE applies the LTE predicate on the "connector_data" field.
+func ConnectorDataLTE(v []byte) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldConnectorData, v))
+}
+
+// ConnectorDataIsNil applies the IsNil predicate on the "connector_data" field.
+func ConnectorDataIsNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldIsNull(FieldConnectorData))
+}
+
+// ConnectorDataNotNil applies the NotNil predicate on the "connector_data" field.
+func ConnectorDataNotNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotNull(FieldConnectorData))
+}
+
+// ClaimsUserIDEQ applies the EQ predicate on the "claims_user_id" field.
+func ClaimsUserIDEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDNEQ applies the NEQ predicate on the "claims_user_id" field.
+func ClaimsUserIDNEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDIn applies the In predicate on the "claims_user_id" field.
+func ClaimsUserIDIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldClaimsUserID, vs...))
+}
+
+// ClaimsUserIDNotIn applies the NotIn predicate on the "claims_user_id" field.
+func ClaimsUserIDNotIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldClaimsUserID, vs...))
+}
+
+// ClaimsUserIDGT applies the GT predicate on the "claims_user_id" field.
+func ClaimsUserIDGT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDGTE applies the GTE predicate on the "claims_user_id" field.
+func ClaimsUserIDGTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDLT applies the LT predicate on the "claims_user_id" field.
+func ClaimsUserIDLT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDLTE applies the LTE predicate on the "claims_user_id" field.
+func ClaimsUserIDLTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDContains applies the Contains predicate on the "claims_user_id" field.
+func ClaimsUserIDContains(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContains(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDHasPrefix applies the HasPrefix predicate on the "claims_user_id" field.
+func ClaimsUserIDHasPrefix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasPrefix(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDHasSuffix applies the HasSuffix predicate on the "claims_user_id" field.
+func ClaimsUserIDHasSuffix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasSuffix(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDEqualFold applies the EqualFold predicate on the "claims_user_id" field.
+func ClaimsUserIDEqualFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldClaimsUserID, v))
+}
+
+// ClaimsUserIDContainsFold applies the ContainsFold predicate on the "claims_user_id" field.
+func ClaimsUserIDContainsFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldClaimsUserID, v))
+}
+
+// ClaimsUsernameEQ applies the EQ predicate on the "claims_username" field.
+func ClaimsUsernameEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameNEQ applies the NEQ predicate on the "claims_username" field.
+func ClaimsUsernameNEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameIn applies the In predicate on the "claims_username" field.
+func ClaimsUsernameIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldClaimsUsername, vs...))
+}
+
+// ClaimsUsernameNotIn applies the NotIn predicate on the "claims_username" field.
+func ClaimsUsernameNotIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldClaimsUsername, vs...))
+}
+
+// ClaimsUsernameGT applies the GT predicate on the "claims_username" field.
+func ClaimsUsernameGT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameGTE applies the GTE predicate on the "claims_username" field.
+func ClaimsUsernameGTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameLT applies the LT predicate on the "claims_username" field.
+func ClaimsUsernameLT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameLTE applies the LTE predicate on the "claims_username" field.
+func ClaimsUsernameLTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameContains applies the Contains predicate on the "claims_username" field.
+func ClaimsUsernameContains(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContains(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameHasPrefix applies the HasPrefix predicate on the "claims_username" field.
+func ClaimsUsernameHasPrefix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasPrefix(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameHasSuffix applies the HasSuffix predicate on the "claims_username" field.
+func ClaimsUsernameHasSuffix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasSuffix(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameEqualFold applies the EqualFold predicate on the "claims_username" field.
+func ClaimsUsernameEqualFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldClaimsUsername, v))
+}
+
+// ClaimsUsernameContainsFold applies the ContainsFold predicate on the "claims_username" field.
+func ClaimsUsernameContainsFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldClaimsUsername, v))
+}
+
+// ClaimsPreferredUsernameEQ applies the EQ predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameNEQ applies the NEQ predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameNEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameIn applies the In predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldClaimsPreferredUsername, vs...))
+}
+
+// ClaimsPreferredUsernameNotIn applies the NotIn predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameNotIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldClaimsPreferredUsername, vs...))
+}
+
+// ClaimsPreferredUsernameGT applies the GT predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameGT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameGTE applies the GTE predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameGTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameLT applies the LT predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameLT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameLTE applies the LTE predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameLTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameContains applies the Contains predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameContains(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContains(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameHasPrefix applies the HasPrefix predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameHasPrefix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasPrefix(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameHasSuffix applies the HasSuffix predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameHasSuffix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasSuffix(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameEqualFold applies the EqualFold predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameEqualFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsPreferredUsernameContainsFold applies the ContainsFold predicate on the "claims_preferred_username" field.
+func ClaimsPreferredUsernameContainsFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldClaimsPreferredUsername, v))
+}
+
+// ClaimsEmailEQ applies the EQ predicate on the "claims_email" field.
+func ClaimsEmailEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailNEQ applies the NEQ predicate on the "claims_email" field.
+func ClaimsEmailNEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailIn applies the In predicate on the "claims_email" field.
+func ClaimsEmailIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldClaimsEmail, vs...))
+}
+
+// ClaimsEmailNotIn applies the NotIn predicate on the "claims_email" field.
+func ClaimsEmailNotIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldClaimsEmail, vs...))
+}
+
+// ClaimsEmailGT applies the GT predicate on the "claims_email" field.
+func ClaimsEmailGT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailGTE applies the GTE predicate on the "claims_email" field.
+func ClaimsEmailGTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailLT applies the LT predicate on the "claims_email" field.
+func ClaimsEmailLT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailLTE applies the LTE predicate on the "claims_email" field.
+func ClaimsEmailLTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailContains applies the Contains predicate on the "claims_email" field.
+func ClaimsEmailContains(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContains(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailHasPrefix applies the HasPrefix predicate on the "claims_email" field.
+func ClaimsEmailHasPrefix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasPrefix(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailHasSuffix applies the HasSuffix predicate on the "claims_email" field.
+func ClaimsEmailHasSuffix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasSuffix(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailEqualFold applies the EqualFold predicate on the "claims_email" field.
+func ClaimsEmailEqualFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailContainsFold applies the ContainsFold predicate on the "claims_email" field.
+func ClaimsEmailContainsFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldClaimsEmail, v))
+}
+
+// ClaimsEmailVerifiedEQ applies the EQ predicate on the "claims_email_verified" field.
+func ClaimsEmailVerifiedEQ(v bool) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldClaimsEmailVerified, v))
+}
+
+// ClaimsEmailVerifiedNEQ applies the NEQ predicate on the "claims_email_verified" field.
+func ClaimsEmailVerifiedNEQ(v bool) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldClaimsEmailVerified, v))
+}
+
+// ClaimsGroupsIsNil applies the IsNil predicate on the "claims_groups" field.
+func ClaimsGroupsIsNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldIsNull(FieldClaimsGroups))
+}
+
+// ClaimsGroupsNotNil applies the NotNil predicate on the "claims_groups" field.
+func ClaimsGroupsNotNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotNull(FieldClaimsGroups))
+}
+
+// ClaimsExtraIsNil applies the IsNil predicate on the "claims_extra" field.
+func ClaimsExtraIsNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldIsNull(FieldClaimsExtra))
+}
+
+// ClaimsExtraNotNil applies the NotNil predicate on the "claims_extra" field.
+func ClaimsExtraNotNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotNull(FieldClaimsExtra))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// LastUsedEQ applies the EQ predicate on the "last_used" field.
+func LastUsedEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldLastUsed, v))
+}
+
+// LastUsedNEQ applies the NEQ predicate on the "last_used" field.
+func LastUsedNEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldLastUsed, v))
+}
+
+// LastUsedIn applies the In predicate on the "last_used" field.
+func LastUsedIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldLastUsed, vs...))
+}
+
+// LastUsedNotIn applies the NotIn predicate on the "last_used" field.
+func LastUsedNotIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldLastUsed, vs...))
+}
+
+// LastUsedGT applies the GT predicate on the "last_used" field.
+func LastUsedGT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldLastUsed, v))
+}
+
+// LastUsedGTE applies the GTE predicate on the "last_used" field.
+func LastUsedGTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldLastUsed, v))
+}
+
+// LastUsedLT applies the LT predicate on the "last_used" field.
+func LastUsedLT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldLastUsed, v))
+}
+
+// LastUsedLTE applies the LTE predicate on the "last_used" field.
+func LastUsedLTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldLastUsed, v))
+}
+
+// ExpiryEQ applies the EQ predicate on the "expiry" field.
+func ExpiryEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldExpiry, v))
+}
+
+// ExpiryNEQ applies the NEQ predicate on the "expiry" field.
+func ExpiryNEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldExpiry, v))
+}
+
+// ExpiryIn applies the In predicate on the "expiry" field.
+func ExpiryIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldExpiry, vs...))
+}
+
+// ExpiryNotIn applies the NotIn predicate on the "expiry" field.
+func ExpiryNotIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldExpiry, vs...))
+}
+
+// ExpiryGT applies the GT predicate on the "expiry" field.
+func ExpiryGT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldExpiry, v))
+}
+
+// ExpiryGTE applies the GTE predicate on the "expiry" field.
+func ExpiryGTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldExpiry, v))
+}
+
+// ExpiryLT applies the LT predicate on the "expiry" field.
+func ExpiryLT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldExpiry, v))
+}
+
+// ExpiryLTE applies the LTE predicate on the "expiry" field.
+func ExpiryLTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldExpiry, v))
+}
+
+// AcrEQ applies the EQ predicate on the "acr" field.
+func AcrEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldAcr, v))
+}
+
+// AcrNEQ applies the NEQ predicate on the "acr" field.
+func AcrNEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldAcr, v))
+}
+
+// AcrIn applies the In predicate on the "acr" field.
+func AcrIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldAcr, vs...))
+}
+
+// AcrNotIn applies the NotIn predicate on the "acr" field.
+func AcrNotIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldAcr, vs...))
+}
+
+// AcrGT applies the GT predicate on the "acr" field.
+func AcrGT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldAcr, v))
+}
+
+// AcrGTE applies the GTE predicate on the "acr" field.
+func AcrGTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldAcr, v))
+}
+
+// AcrLT applies the LT predicate on the "acr" field.
+func AcrLT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldAcr, v))
+}
+
+// AcrLTE applies the LTE predicate on the "acr" field.
+func AcrLTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldAcr, v))
+}
+
+// AcrContains applies the Contains predicate on the "acr" field.
+func AcrContains(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContains(FieldAcr, v))
+}
+
+// AcrHasPrefix applies the HasPrefix predicate on the "acr" field.
+func AcrHasPrefix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasPrefix(FieldAcr, v))
+}
+
+// AcrHasSuffix applies the HasSuffix predicate on the "acr" field.
+func AcrHasSuffix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasSuffix(FieldAcr, v))
+}
+
+// AcrEqualFold applies the EqualFold predicate on the "acr" field.
+func AcrEqualFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldAcr, v))
+}
+
+// AcrContainsFold applies the ContainsFold predicate on the "acr" field.
+func AcrContainsFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldAcr, v))
+}
+
+// AmrIsNil applies the IsNil predicate on the "amr" field.
+func AmrIsNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldIsNull(FieldAmr))
+}
+
+// AmrNotNil applies the NotNil predicate on the "amr" field.
+func AmrNotNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotNull(FieldAmr))
+}
+
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// AuthTimeIsNil applies the IsNil predicate on the "auth_time" field.
+func AuthTimeIsNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldIsNull(FieldAuthTime))
+}
+
+// AuthTimeNotNil applies the NotNil predicate on the "auth_time" field.
+func AuthTimeNotNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotNull(FieldAuthTime))
+}
+
+// SidEQ applies the EQ predicate on the "sid" field.
+func SidEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEQ(FieldSid, v))
+}
+
+// SidNEQ applies the NEQ predicate on the "sid" field.
+func SidNEQ(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNEQ(FieldSid, v))
+}
+
+// SidIn applies the In predicate on the "sid" field.
+func SidIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldIn(FieldSid, vs...))
+}
+
+// SidNotIn applies the NotIn predicate on the "sid" field.
+func SidNotIn(vs ...string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotIn(FieldSid, vs...))
+}
+
+// SidGT applies the GT predicate on the "sid" field.
+func SidGT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGT(FieldSid, v))
+}
+
+// SidGTE applies the GTE predicate on the "sid" field.
+func SidGTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldGTE(FieldSid, v))
+}
+
+// SidLT applies the LT predicate on the "sid" field.
+func SidLT(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLT(FieldSid, v))
+}
+
+// SidLTE applies the LTE predicate on the "sid" field.
+func SidLTE(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldLTE(FieldSid, v))
+}
+
+// SidContains applies the Contains predicate on the "sid" field.
+func SidContains(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContains(FieldSid, v))
+}
+
+// SidHasPrefix applies the HasPrefix predicate on the "sid" field.
+func SidHasPrefix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasPrefix(FieldSid, v))
+}
+
+// SidHasSuffix applies the HasSuffix predicate on the "sid" field.
+func SidHasSuffix(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldHasSuffix(FieldSid, v))
+}
+
+// SidEqualFold applies the EqualFold predicate on the "sid" field.
+func SidEqualFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldEqualFold(FieldSid, v))
+}
+
+// SidContainsFold applies the ContainsFold predicate on the "sid" field.
+func SidContainsFold(v string) predicate.UserSession {
+	return predicate.UserSession(sql.FieldContainsFold(FieldSid, v))
+}
+
+// ClientIdsIsNil applies the IsNil predicate on the "client_ids" field.
+func ClientIdsIsNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldIsNull(FieldClientIds))
+}
+
+// ClientIdsNotNil applies the NotNil predicate on the "client_ids" field.
+func ClientIdsNotNil() predicate.UserSession {
+	return predicate.UserSession(sql.FieldNotNull(FieldClientIds))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserSession) predicate.UserSession {
+	return predicate.UserSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserSession) predicate.UserSession {
+	return predicate.UserSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserSession) predicate.UserSession {
+	return predicate.UserSession(sql.NotPredicates(p))
+}
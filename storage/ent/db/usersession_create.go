@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/usersession"
+)
+
+// UserSessionCreate is the builder for creating a UserSession entity.
+type UserSessionCreate struct {
+	config
+	mutation *UserSessionMutation
+	hooks    []Hook
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_c *UserSessionCreate) SetConnectorID(v string) *UserSessionCreate {
+	_c.mutation.SetConnectorID(v)
+	return _c
+}
+
+// SetConnectorData sets the "connector_data" field.
+func (_c *UserSessionCreate) SetConnectorData(v []byte) *UserSessionCreate {
+	_c.mutation.SetConnectorData(v)
+	return _c
+}
+
+// SetClaimsUserID sets the "claims_user_id" field.
+func (_c *UserSessionCreate) SetClaimsUserID(v string) *UserSessionCreate {
+	_c.mutation.SetClaimsUserID(v)
+	return _c
+}
+
+// SetClaimsUsername sets the "claims_username" field.
+func (_c *UserSessionCreate) SetClaimsUsername(v string) *UserSessionCreate {
+	_c.mutation.SetClaimsUsername(v)
+	return _c
+}
+
+// SetNillableClaimsUsername sets the "claims_username" field if the given value is not nil.
+func (_c *UserSessionCreate) SetNillableClaimsUsername(v *string) *UserSessionCreate {
+	if v != nil {
+		_c.SetClaimsUsername(*v)
+	}
+	return _c
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (_c *UserSessionCreate) SetClaimsPreferredUsername(v string) *UserSessionCreate {
+	_c.mutation.SetClaimsPreferredUsername(v)
+	return _c
+}
+
+// SetNillableClaimsPreferredUsername sets the "claims_preferred_username" field if the given value is not nil.
+func (_c *UserSessionCreate) SetNillableClaimsPreferredUsername(v *string) *UserSessionCreate {
+	if v != nil {
+		_c.SetClaimsPreferredUsername(*v)
+	}
+	return _c
+}
+
+// SetClaimsEmail sets the "claims_email" field.
+func (_c *UserSessionCreate) SetClaimsEmail(v string) *UserSessionCreate {
+	_c.mutation.SetClaimsEmail(v)
+	return _c
+}
+
+// SetNillableClaimsEmail sets the "claims_email" field if the given value is not nil.
+func (_c *UserSessionCreate) SetNillableClaimsEmail(v *string) *UserSessionCreate {
+	if v != nil {
+		_c.SetClaimsEmail(*v)
+	}
+	return _c
+}
+
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (_c *UserSessionCreate) SetClaimsEmailVerified(v bool) *UserSessionCreate {
+	_c.mutation.SetClaimsEmailVerified(v)
+	return _c
+}
+
+// SetClaimsGroups sets the "claims_groups" field.
+func (_c *UserSessionCreate) SetClaimsGroups(v []string) *UserSessionCreate {
+	_c.mutation.SetClaimsGroups(v)
+	return _c
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (_c *UserSessionCreate) SetClaimsExtra(v map[string]interface{}) *UserSessionCreate {
+	_c.mutation.SetClaimsExtra(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserSessionCreate) SetCreatedAt(v time.Time) *UserSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetLastUsed sets the "last_used" field.
+func (_c *UserSessionCreate) SetLastUsed(v time.Time) *UserSessionCreate {
+	_c.mutation.SetLastUsed(v)
+	return _c
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *UserSessionCreate) SetExpiry(v time.Time) *UserSessionCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetAcr sets the "acr" field.
+func (_c *UserSessionCreate) SetAcr(v string) *UserSessionCreate {
+	_c.mutation.SetAcr(v)
+	return _c
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_c *UserSessionCreate) SetNillableAcr(v *string) *UserSessionCreate {
+	if v != nil {
+		_c.SetAcr(*v)
+	}
+	return _c
+}
+
+// SetAmr sets the "amr" field.
+func (_c *UserSessionCreate) SetAmr(v []string) *UserSessionCreate {
+	_c.mutation.SetAmr(v)
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *UserSessionCreate) SetAuthTime(v time.Time) *UserSessionCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *UserSessionCreate) SetNillableAuthTime(v *time.Time) *UserSessionCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
+// SetSid sets the "sid" field.
+func (_c *UserSessionCreate) SetSid(v string) *UserSessionCreate {
+	_c.mutation.SetSid(v)
+	return _c
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_c *UserSessionCreate) SetNillableSid(v *string) *UserSessionCreate {
+	if v != nil {
+		_c.SetSid(*v)
+	}
+	return _c
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_c *UserSessionCreate) SetClientIds(v []string) *UserSessionCreate {
+	_c.mutation.SetClientIds(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserSessionCreate) SetID(v string) *UserSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserSessionMutation object of the builder.
+func (_c *UserSessionCreate) Mutation() *UserSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the UserSession in the database.
+func (_c *UserSessionCreate) Save(ctx context.Context) (*UserSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserSessionCreate) SaveX(ctx context.Context) *UserSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserSessionCreate) defaults() {
+	if _, ok := _c.mutation.ClaimsUsername(); !ok {
+		v := usersession.DefaultClaimsUsername
+		_c.mutation.SetClaimsUsername(v)
+	}
+	if _, ok := _c.mutation.ClaimsPreferredUsername(); !ok {
+		v := usersession.DefaultClaimsPreferredUsername
+		_c.mutation.SetClaimsPreferredUsername(v)
+	}
+	if _, ok := _c.mutation.ClaimsEmail(); !ok {
+		v := usersession.DefaultClaimsEmail
+		_c.mutation.SetClaimsEmail(v)
+	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		v := usersession.DefaultAcr
+		_c.mutation.SetAcr(v)
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		v := usersession.DefaultSid
+		_c.mutation.SetSid(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserSessionCreate) check() error {
+	if _, ok := _c.mutation.ConnectorID(); !ok {
+		return &ValidationError{Name: "connector_id", err: errors.New(`db: missing required field "UserSession.connector_id"`)}
+	}
+	if v, ok := _c.mutation.ConnectorID(); ok {
+		if err := usersession.ConnectorIDValidator(v); err != nil {
+			return &ValidationError{Name: "connector_id", err: fmt.Errorf(`db: validator failed for field "UserSession.connector_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClaimsUserID(); !ok {
+		return &ValidationError{Name: "claims_user_id", err: errors.New(`db: missing required field "UserSession.claims_user_id"`)}
+	}
+	if v, ok := _c.mutation.ClaimsUserID(); ok {
+		if err := usersession.ClaimsUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`db: validator failed for field "UserSession.claims_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClaimsUsername(); !ok {
+		return &ValidationError{Name: "claims_username", err: errors.New(`db: missing required field "UserSession.claims_username"`)}
+	}
+	if _, ok := _c.mutation.ClaimsPreferredUsername(); !ok {
+		return &ValidationError{Name: "claims_preferred_username", err: errors.New(`db: missing required field "UserSession.claims_preferred_username"`)}
+	}
+	if _, ok := _c.mutation.ClaimsEmail(); !ok {
+		return &ValidationError{Name: "claims_email", err: errors.New(`db: missing required field "UserSession.claims_email"`)}
+	}
+	if _, ok := _c.mutation.ClaimsEmailVerified(); !ok {
+		return &ValidationError{Name: "claims_email_verified", err: errors.New(`db: missing required field "UserSession.claims_email_verified"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "UserSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.LastUsed(); !ok {
+		return &ValidationError{Name: "last_used", err: errors.New(`db: missing required field "UserSession.last_used"`)}
+	}
+	if _, ok := _c.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "UserSession.expiry"`)}
+	}
+	if _, ok := _c.mutation.Acr(); !ok {
+		return &ValidationError{Name: "acr", err: errors.New(`db: missing required field "UserSession.acr"`)}
+	}
+	if _, ok := _c.mutation.Sid(); !ok {
+		return &ValidationError{Name: "sid", err: errors.New(`db: missing required field "UserSession.sid"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := usersession.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "UserSession.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserSessionCreate) sqlSave(ctx context.Context) (*UserSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected UserSession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserSessionCreate) createSpec() (*UserSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usersession.Table, sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ConnectorID(); ok {
+		_spec.SetField(usersession.FieldConnectorID, field.TypeString, value)
+		_node.ConnectorID = value
+	}
+	if value, ok := _c.mutation.ConnectorData(); ok {
+		_spec.SetField(usersession.FieldConnectorData, field.TypeBytes, value)
+		_node.ConnectorData = &value
+	}
+	if value, ok := _c.mutation.ClaimsUserID(); ok {
+		_spec.SetField(usersession.FieldClaimsUserID, field.TypeString, value)
+		_node.ClaimsUserID = value
+	}
+	if value, ok := _c.mutation.ClaimsUsername(); ok {
+		_spec.SetField(usersession.FieldClaimsUsername, field.TypeString, value)
+		_node.ClaimsUsername = value
+	}
+	if value, ok := _c.mutation.ClaimsPreferredUsername(); ok {
+		_spec.SetField(usersession.FieldClaimsPreferredUsername, field.TypeString, value)
+		_node.ClaimsPreferredUsername = value
+	}
+	if value, ok := _c.mutation.ClaimsEmail(); ok {
+		_spec.SetField(usersession.FieldClaimsEmail, field.TypeString, value)
+		_node.ClaimsEmail = value
+	}
+	if value, ok := _c.mutation.ClaimsEmailVerified(); ok {
+		_spec.SetField(usersession.FieldClaimsEmailVerified, field.TypeBool, value)
+		_node.ClaimsEmailVerified = value
+	}
+	if value, ok := _c.mutation.ClaimsGroups(); ok {
+		_spec.SetField(usersession.FieldClaimsGroups, field.TypeJSON, value)
+		_node.ClaimsGroups = value
+	}
+	if value, ok := _c.mutation.ClaimsExtra(); ok {
+		_spec.SetField(usersession.FieldClaimsExtra, field.TypeJSON, value)
+		_node.ClaimsExtra = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usersession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastUsed(); ok {
+		_spec.SetField(usersession.FieldLastUsed, field.TypeTime, value)
+		_node.LastUsed = value
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(usersession.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	if value, ok := _c.mutation.Acr(); ok {
+		_spec.SetField(usersession.FieldAcr, field.TypeString, value)
+		_node.Acr = value
+	}
+	if value, ok := _c.mutation.Amr(); ok {
+		_spec.SetField(usersession.FieldAmr, field.TypeJSON, value)
+		_node.Amr = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(usersession.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
+	if value, ok := _c.mutation.Sid(); ok {
+		_spec.SetField(usersession.FieldSid, field.TypeString, value)
+		_node.Sid = value
+	}
+	if value, ok := _c.mutation.ClientIds(); ok {
+		_spec.SetField(usersession.FieldClientIds, field.TypeJSON, value)
+		_node.ClientIds = value
+	}
+	return _node, _spec
+}
+
+// UserSessionCreateBulk is the builder for creating many UserSession entities in bulk.
+type UserSessionCreateBulk struct {
+	config
+	err      error
+	builders []*UserSessionCreate
+}
+
+// Save creates the UserSession entities in the database.
+func (_c *UserSessionCreateBulk) Save(ctx context.Context) ([]*UserSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserSessionCreateBulk) SaveX(ctx context.Context) []*UserSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
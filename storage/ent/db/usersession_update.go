@@ -0,0 +1,885 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/usersession"
+)
+
+// UserSessionUpdate is the builder for updating UserSession entities.
+type UserSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserSessionMutation
+}
+
+// Where appends a list predicates to the UserSessionUpdate builder.
+func (_u *UserSessionUpdate) Where(ps ...predicate.UserSession) *UserSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_u *UserSessionUpdate) SetConnectorID(v string) *UserSessionUpdate {
+	_u.mutation.SetConnectorID(v)
+	return _u
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableConnectorID(v *string) *UserSessionUpdate {
+	if v != nil {
+		_u.SetConnectorID(*v)
+	}
+	return _u
+}
+
+// SetConnectorData sets the "connector_data" field.
+func (_u *UserSessionUpdate) SetConnectorData(v []byte) *UserSessionUpdate {
+	_u.mutation.SetConnectorData(v)
+	return _u
+}
+
+// ClearConnectorData clears the value of the "connector_data" field.
+func (_u *UserSessionUpdate) ClearConnectorData() *UserSessionUpdate {
+	_u.mutation.ClearConnectorData()
+	return _u
+}
+
+// SetClaimsUserID sets the "claims_user_id" field.
+func (_u *UserSessionUpdate) SetClaimsUserID(v string) *UserSessionUpdate {
+	_u.mutation.SetClaimsUserID(v)
+	return _u
+}
+
+// SetNillableClaimsUserID sets the "claims_user_id" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableClaimsUserID(v *string) *UserSessionUpdate {
+	if v != nil {
+		_u.SetClaimsUserID(*v)
+	}
+	return _u
+}
+
+// SetClaimsUsername sets the "claims_username" field.
+func (_u *UserSessionUpdate) SetClaimsUsername(v string) *UserSessionUpdate {
+	_u.mutation.SetClaimsUsername(v)
+	return _u
+}
+
+// SetNillableClaimsUsername sets the "claims_username" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableClaimsUsername(v *string) *UserSessionUpdate {
+	if v != nil {
+		_u.SetClaimsUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (_u *UserSessionUpdate) SetClaimsPreferredUsername(v string) *UserSessionUpdate {
+	_u.mutation.SetClaimsPreferredUsername(v)
+	return _u
+}
+
+// SetNillableClaimsPreferredUsername sets the "claims_preferred_username" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableClaimsPreferredUsername(v *string) *UserSessionUpdate {
+	if v != nil {
+		_u.SetClaimsPreferredUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmail sets the "claims_email" field.
+func (_u *UserSessionUpdate) SetClaimsEmail(v string) *UserSessionUpdate {
+	_u.mutation.SetClaimsEmail(v)
+	return _u
+}
+
+// SetNillableClaimsEmail sets the "claims_email" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableClaimsEmail(v *string) *UserSessionUpdate {
+	if v != nil {
+		_u.SetClaimsEmail(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (_u *UserSessionUpdate) SetClaimsEmailVerified(v bool) *UserSessionUpdate {
+	_u.mutation.SetClaimsEmailVerified(v)
+	return _u
+}
+
+// SetNillableClaimsEmailVerified sets the "claims_email_verified" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableClaimsEmailVerified(v *bool) *UserSessionUpdate {
+	if v != nil {
+		_u.SetClaimsEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetClaimsGroups sets the "claims_groups" field.
+func (_u *UserSessionUpdate) SetClaimsGroups(v []string) *UserSessionUpdate {
+	_u.mutation.SetClaimsGroups(v)
+	return _u
+}
+
+// AppendClaimsGroups appends value to the "claims_groups" field.
+func (_u *UserSessionUpdate) AppendClaimsGroups(v []string) *UserSessionUpdate {
+	_u.mutation.AppendClaimsGroups(v)
+	return _u
+}
+
+// ClearClaimsGroups clears the value of the "claims_groups" field.
+func (_u *UserSessionUpdate) ClearClaimsGroups() *UserSessionUpdate {
+	_u.mutation.ClearClaimsGroups()
+	return _u
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *UserSessionUpdate) SetClaimsExtra(v map[string]interface{}) *UserSessionUpdate {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *UserSessionUpdate) ClearClaimsExtra() *UserSessionUpdate {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserSessionUpdate) SetCreatedAt(v time.Time) *UserSessionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableCreatedAt(v *time.Time) *UserSessionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsed sets the "last_used" field.
+func (_u *UserSessionUpdate) SetLastUsed(v time.Time) *UserSessionUpdate {
+	_u.mutation.SetLastUsed(v)
+	return _u
+}
+
+// SetNillableLastUsed sets the "last_used" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableLastUsed(v *time.Time) *UserSessionUpdate {
+	if v != nil {
+		_u.SetLastUsed(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *UserSessionUpdate) SetExpiry(v time.Time) *UserSessionUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableExpiry(v *time.Time) *UserSessionUpdate {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// SetAcr sets the "acr" field.
+func (_u *UserSessionUpdate) SetAcr(v string) *UserSessionUpdate {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableAcr(v *string) *UserSessionUpdate {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *UserSessionUpdate) SetAmr(v []string) *UserSessionUpdate {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *UserSessionUpdate) AppendAmr(v []string) *UserSessionUpdate {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *UserSessionUpdate) ClearAmr() *UserSessionUpdate {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *UserSessionUpdate) SetAuthTime(v time.Time) *UserSessionUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableAuthTime(v *time.Time) *UserSessionUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *UserSessionUpdate) ClearAuthTime() *UserSessionUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *UserSessionUpdate) SetSid(v string) *UserSessionUpdate {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *UserSessionUpdate) SetNillableSid(v *string) *UserSessionUpdate {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *UserSessionUpdate) SetClientIds(v []string) *UserSessionUpdate {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *UserSessionUpdate) AppendClientIds(v []string) *UserSessionUpdate {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *UserSessionUpdate) ClearClientIds() *UserSessionUpdate {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// Mutation returns the UserSessionMutation object of the builder.
+func (_u *UserSessionUpdate) Mutation() *UserSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserSessionUpdate) check() error {
+	if v, ok := _u.mutation.ConnectorID(); ok {
+		if err := usersession.ConnectorIDValidator(v); err != nil {
+			return &ValidationError{Name: "connector_id", err: fmt.Errorf(`db: validator failed for field "UserSession.connector_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClaimsUserID(); ok {
+		if err := usersession.ClaimsUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`db: validator failed for field "UserSession.claims_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usersession.Table, usersession.Columns, sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectorID(); ok {
+		_spec.SetField(usersession.FieldConnectorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConnectorData(); ok {
+		_spec.SetField(usersession.FieldConnectorData, field.TypeBytes, value)
+	}
+	if _u.mutation.ConnectorDataCleared() {
+		_spec.ClearField(usersession.FieldConnectorData, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.ClaimsUserID(); ok {
+		_spec.SetField(usersession.FieldClaimsUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsUsername(); ok {
+		_spec.SetField(usersession.FieldClaimsUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
+		_spec.SetField(usersession.FieldClaimsPreferredUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmail(); ok {
+		_spec.SetField(usersession.FieldClaimsEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmailVerified(); ok {
+		_spec.SetField(usersession.FieldClaimsEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClaimsGroups(); ok {
+		_spec.SetField(usersession.FieldClaimsGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaimsGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersession.FieldClaimsGroups, value)
+		})
+	}
+	if _u.mutation.ClaimsGroupsCleared() {
+		_spec.ClearField(usersession.FieldClaimsGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(usersession.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(usersession.FieldClaimsExtra, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(usersession.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsed(); ok {
+		_spec.SetField(usersession.FieldLastUsed, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(usersession.FieldExpiry, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(usersession.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(usersession.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersession.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(usersession.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(usersession.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(usersession.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(usersession.FieldSid, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(usersession.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersession.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(usersession.FieldClientIds, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserSessionUpdateOne is the builder for updating a single UserSession entity.
+type UserSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserSessionMutation
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_u *UserSessionUpdateOne) SetConnectorID(v string) *UserSessionUpdateOne {
+	_u.mutation.SetConnectorID(v)
+	return _u
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableConnectorID(v *string) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetConnectorID(*v)
+	}
+	return _u
+}
+
+// SetConnectorData sets the "connector_data" field.
+func (_u *UserSessionUpdateOne) SetConnectorData(v []byte) *UserSessionUpdateOne {
+	_u.mutation.SetConnectorData(v)
+	return _u
+}
+
+// ClearConnectorData clears the value of the "connector_data" field.
+func (_u *UserSessionUpdateOne) ClearConnectorData() *UserSessionUpdateOne {
+	_u.mutation.ClearConnectorData()
+	return _u
+}
+
+// SetClaimsUserID sets the "claims_user_id" field.
+func (_u *UserSessionUpdateOne) SetClaimsUserID(v string) *UserSessionUpdateOne {
+	_u.mutation.SetClaimsUserID(v)
+	return _u
+}
+
+// SetNillableClaimsUserID sets the "claims_user_id" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableClaimsUserID(v *string) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetClaimsUserID(*v)
+	}
+	return _u
+}
+
+// SetClaimsUsername sets the "claims_username" field.
+func (_u *UserSessionUpdateOne) SetClaimsUsername(v string) *UserSessionUpdateOne {
+	_u.mutation.SetClaimsUsername(v)
+	return _u
+}
+
+// SetNillableClaimsUsername sets the "claims_username" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableClaimsUsername(v *string) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetClaimsUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (_u *UserSessionUpdateOne) SetClaimsPreferredUsername(v string) *UserSessionUpdateOne {
+	_u.mutation.SetClaimsPreferredUsername(v)
+	return _u
+}
+
+// SetNillableClaimsPreferredUsername sets the "claims_preferred_username" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableClaimsPreferredUsername(v *string) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetClaimsPreferredUsername(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmail sets the "claims_email" field.
+func (_u *UserSessionUpdateOne) SetClaimsEmail(v string) *UserSessionUpdateOne {
+	_u.mutation.SetClaimsEmail(v)
+	return _u
+}
+
+// SetNillableClaimsEmail sets the "claims_email" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableClaimsEmail(v *string) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetClaimsEmail(*v)
+	}
+	return _u
+}
+
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (_u *UserSessionUpdateOne) SetClaimsEmailVerified(v bool) *UserSessionUpdateOne {
+	_u.mutation.SetClaimsEmailVerified(v)
+	return _u
+}
+
+// SetNillableClaimsEmailVerified sets the "claims_email_verified" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableClaimsEmailVerified(v *bool) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetClaimsEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetClaimsGroups sets the "claims_groups" field.
+func (_u *UserSessionUpdateOne) SetClaimsGroups(v []string) *UserSessionUpdateOne {
+	_u.mutation.SetClaimsGroups(v)
+	return _u
+}
+
+// AppendClaimsGroups appends value to the "claims_groups" field.
+func (_u *UserSessionUpdateOne) AppendClaimsGroups(v []string) *UserSessionUpdateOne {
+	_u.mutation.AppendClaimsGroups(v)
+	return _u
+}
+
+// ClearClaimsGroups clears the value of the "claims_groups" field.
+func (_u *UserSessionUpdateOne) ClearClaimsGroups() *UserSessionUpdateOne {
+	_u.mutation.ClearClaimsGroups()
+	return _u
+}
+
+// SetClaimsExtra sets the "claims_extra" field.
+func (_u *UserSessionUpdateOne) SetClaimsExtra(v map[string]interface{}) *UserSessionUpdateOne {
+	_u.mutation.SetClaimsExtra(v)
+	return _u
+}
+
+// ClearClaimsExtra clears the value of the "claims_extra" field.
+func (_u *UserSessionUpdateOne) ClearClaimsExtra() *UserSessionUpdateOne {
+	_u.mutation.ClearClaimsExtra()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserSessionUpdateOne) SetCreatedAt(v time.Time) *UserSessionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableCreatedAt(v *time.Time) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsed sets the "last_used" field.
+func (_u *UserSessionUpdateOne) SetLastUsed(v time.Time) *UserSessionUpdateOne {
+	_u.mutation.SetLastUsed(v)
+	return _u
+}
+
+// SetNillableLastUsed sets the "last_used" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableLastUsed(v *time.Time) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetLastUsed(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *UserSessionUpdateOne) SetExpiry(v time.Time) *UserSessionUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableExpiry(v *time.Time) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// SetAcr sets the "acr" field.
+func (_u *UserSessionUpdateOne) SetAcr(v string) *UserSessionUpdateOne {
+	_u.mutation.SetAcr(v)
+	return _u
+}
+
+// SetNillableAcr sets the "acr" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableAcr(v *string) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetAcr(*v)
+	}
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *UserSessionUpdateOne) SetAmr(v []string) *UserSessionUpdateOne {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *UserSessionUpdateOne) AppendAmr(v []string) *UserSessionUpdateOne {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *UserSessionUpdateOne) ClearAmr() *UserSessionUpdateOne {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *UserSessionUpdateOne) SetAuthTime(v time.Time) *UserSessionUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableAuthTime(v *time.Time) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *UserSessionUpdateOne) ClearAuthTime() *UserSessionUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *UserSessionUpdateOne) SetSid(v string) *UserSessionUpdateOne {
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *UserSessionUpdateOne) SetNillableSid(v *string) *UserSessionUpdateOne {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *UserSessionUpdateOne) SetClientIds(v []string) *UserSessionUpdateOne {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *UserSessionUpdateOne) AppendClientIds(v []string) *UserSessionUpdateOne {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *UserSessionUpdateOne) ClearClientIds() *UserSessionUpdateOne {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// Mutation returns the UserSessionMutation object of the builder.
+func (_u *UserSessionUpdateOne) Mutation() *UserSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserSessionUpdate builder.
+func (_u *UserSessionUpdateOne) Where(ps ...predicate.UserSession) *UserSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserSessionUpdateOne) Select(field string, fields ...string) *UserSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserSession entity.
+func (_u *UserSessionUpdateOne) Save(ctx context.Context) (*UserSession, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserSessionUpdateOne) SaveX(ctx context.Context) *UserSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.ConnectorID(); ok {
+		if err := usersession.ConnectorIDValidator(v); err != nil {
+			return &ValidationError{Name: "connector_id", err: fmt.Errorf(`db: validator failed for field "UserSession.connector_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClaimsUserID(); ok {
+		if err := usersession.ClaimsUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "claims_user_id", err: fmt.Errorf(`db: validator failed for field "UserSession.claims_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserSessionUpdateOne) sqlSave(ctx context.Context) (_node *UserSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usersession.Table, usersession.Columns, sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "UserSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usersession.FieldID)
+		for _, f := range fields {
+			if !usersession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != usersession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectorID(); ok {
+		_spec.SetField(usersession.FieldConnectorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConnectorData(); ok {
+		_spec.SetField(usersession.FieldConnectorData, field.TypeBytes, value)
+	}
+	if _u.mutation.ConnectorDataCleared() {
+		_spec.ClearField(usersession.FieldConnectorData, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.ClaimsUserID(); ok {
+		_spec.SetField(usersession.FieldClaimsUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsUsername(); ok {
+		_spec.SetField(usersession.FieldClaimsUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsPreferredUsername(); ok {
+		_spec.SetField(usersession.FieldClaimsPreferredUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmail(); ok {
+		_spec.SetField(usersession.FieldClaimsEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimsEmailVerified(); ok {
+		_spec.SetField(usersession.FieldClaimsEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClaimsGroups(); ok {
+		_spec.SetField(usersession.FieldClaimsGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaimsGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersession.FieldClaimsGroups, value)
+		})
+	}
+	if _u.mutation.ClaimsGroupsCleared() {
+		_spec.ClearField(usersession.FieldClaimsGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimsExtra(); ok {
+		_spec.SetField(usersession.FieldClaimsExtra, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimsExtraCleared() {
+		_spec.ClearField(usersession.FieldClaimsExtra, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(usersession.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsed(); ok {
+		_spec.SetField(usersession.FieldLastUsed, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(usersession.FieldExpiry, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Acr(); ok {
+		_spec.SetField(usersession.FieldAcr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(usersession.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersession.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(usersession.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(usersession.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(usersession.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(usersession.FieldSid, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(usersession.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usersession.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(usersession.FieldClientIds, field.TypeJSON)
+	}
+	_node = &UserSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
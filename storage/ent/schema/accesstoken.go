@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table access_token
+(
+    id                        text      not null  primary key,
+    client_id                 text      not null,
+    scopes                    blob      not null,
+    audience                  blob      not null,
+    connector_id              text      not null,
+    claims_user_id            text      not null,
+    claims_username           text      not null,
+    claims_preferred_username text      not null,
+    claims_email              text      not null,
+    claims_email_verified     integer   not null,
+    claims_groups             blob      not null,
+    certificate_thumbprint    text      not null,
+    dpop_key_thumbprint       text      not null,
+    created_at                timestamp not null,
+    expiry                    timestamp not null,
+    refresh_token_id          text      not null
+);
+*/
+
+// AccessToken holds the schema definition for the AccessToken entity.
+type AccessToken struct {
+	ent.Schema
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("client_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.JSON("scopes", []string{}).
+			Optional(),
+		field.JSON("audience", []string{}).
+			Optional(),
+
+		field.Text("connector_id").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("claims_user_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("claims_username").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("claims_preferred_username").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("claims_email").
+			SchemaType(textSchema).
+			Default(""),
+		field.Bool("claims_email_verified"),
+		field.JSON("claims_groups", []string{}).
+			Optional(),
+		field.JSON("claims_extra", map[string]any{}).
+			Optional(),
+		field.Text("certificate_thumbprint").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("dpop_key_thumbprint").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("refresh_token_id").
+			SchemaType(textSchema).
+			Default(""),
+
+		field.Time("created_at").
+			SchemaType(timeSchema),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the AccessToken.
+func (AccessToken) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -63,6 +63,8 @@ func (AuthCode) Fields() []ent.Field {
 		field.Bool("claims_email_verified"),
 		field.JSON("claims_groups", []string{}).
 			Optional(),
+		field.JSON("claims_extra", map[string]any{}).
+			Optional(),
 		field.Text("claims_preferred_username").
 			SchemaType(textSchema).
 			Default(""),
@@ -81,6 +83,17 @@ func (AuthCode) Fields() []ent.Field {
 		field.Text("code_challenge_method").
 			SchemaType(textSchema).
 			Default(""),
+		field.Text("acr").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("amr", []string{}).
+			Optional(),
+		field.Time("auth_time").
+			SchemaType(timeSchema).
+			Optional(),
+		field.Text("sid").
+			SchemaType(textSchema).
+			Default(""),
 	}
 }
 
@@ -69,6 +69,8 @@ func (AuthRequest) Fields() []ent.Field {
 		field.Bool("claims_email_verified"),
 		field.JSON("claims_groups", []string{}).
 			Optional(),
+		field.JSON("claims_extra", map[string]any{}).
+			Optional(),
 		field.Text("claims_preferred_username").
 			SchemaType(textSchema).
 			Default(""),
@@ -88,6 +90,30 @@ func (AuthRequest) Fields() []ent.Field {
 			SchemaType(textSchema).
 			Default(""),
 		field.Bytes("hmac_key"),
+
+		field.Text("prompt").
+			SchemaType(textSchema).
+			Default(""),
+		field.Int("max_age").
+			Default(-1),
+		field.JSON("acr_values", []string{}).
+			Optional(),
+		field.Text("acr").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("amr", []string{}).
+			Optional(),
+		field.Time("auth_time").
+			SchemaType(timeSchema).
+			Optional(),
+		field.Text("sid").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("login_hint").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("ui_locales", []string{}).
+			Optional(),
 	}
 }
 
@@ -3,6 +3,8 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+
+	"github.com/dexidp/dex/storage"
 )
 
 /* Original SQL table:
@@ -38,6 +40,55 @@ func (OAuth2Client) Fields() []ent.Field {
 			Optional(),
 		field.JSON("trusted_peers", []string{}).
 			Optional(),
+		field.JSON("post_logout_redirect_uris", []string{}).
+			Optional(),
+		field.Text("backchannel_logout_uri").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("client_credentials_scopes", []string{}).
+			Optional(),
+		field.JSON("client_credentials_audiences", []string{}).
+			Optional(),
+		field.Text("access_token_format").
+			SchemaType(textSchema).
+			Default(""),
+		field.Bool("require_pushed_authorization_requests").
+			Default(false),
+		field.Text("jwks").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("jwks_uri").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("registration_access_token_hash").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("token_endpoint_auth_method").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("tls_client_auth_subject_dn").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("claim_mappings", []storage.ClaimMapping{}).
+			Optional(),
+		field.Text("subject_type").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("sector_identifier_uri").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("required_scopes", []string{}).
+			Optional(),
+		field.JSON("expiry", &storage.ClientExpiry{}).
+			Optional(),
+		field.JSON("allowed_connectors", []string{}).
+			Optional(),
+		field.JSON("allowed_grant_types", []string{}).
+			Optional(),
+		field.JSON("allowed_response_types", []string{}).
+			Optional(),
+		field.Bool("require_pkce").
+			Default(false),
 		field.Bool("public"),
 		field.Text("name").
 			SchemaType(textSchema).
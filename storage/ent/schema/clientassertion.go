@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table client_assertion
+(
+    id        text      not null  primary key,
+    client_id text      not null,
+    expiry    timestamp not null
+);
+*/
+
+// ClientAssertion holds the schema definition for the ClientAssertion entity.
+type ClientAssertion struct {
+	ent.Schema
+}
+
+// Fields of the ClientAssertion.
+func (ClientAssertion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("client_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the ClientAssertion.
+func (ClientAssertion) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
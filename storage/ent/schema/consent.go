@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table consent
+(
+    user_id    text      not null,
+    conn_id    text      not null,
+    client_id  text      not null,
+    scopes     blob      not null,
+    created_at timestamp not null,
+    updated_at timestamp not null,
+    primary key (user_id, conn_id, client_id)
+);
+*/
+
+// Consent holds the schema definition for the Consent entity.
+type Consent struct {
+	ent.Schema
+}
+
+// Fields of the Consent.
+func (Consent) Fields() []ent.Field {
+	return []ent.Field{
+		// Using id field here because it's impossible to create multi-key primary yet
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("user_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("conn_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("client_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.JSON("scopes", []string{}).
+			Optional(),
+		field.Time("created_at").
+			SchemaType(timeSchema),
+		field.Time("updated_at").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the Consent.
+func (Consent) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
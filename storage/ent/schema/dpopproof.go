@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table dpop_proof
+(
+    id     text      not null  primary key,
+    expiry timestamp not null
+);
+*/
+
+// DpopProof holds the schema definition for the DpopProof entity.
+type DpopProof struct {
+	ent.Schema
+}
+
+// Fields of the DpopProof.
+func (DpopProof) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the DpopProof.
+func (DpopProof) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
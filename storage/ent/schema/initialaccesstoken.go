@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table initial_access_token
+(
+    id         text      not null  primary key,
+    created_at timestamp not null,
+    expiry     timestamp not null
+);
+*/
+
+// InitialAccessToken holds the schema definition for the InitialAccessToken entity.
+type InitialAccessToken struct {
+	ent.Schema
+}
+
+// Fields of the InitialAccessToken.
+func (InitialAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Time("created_at").
+			SchemaType(timeSchema),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the InitialAccessToken.
+func (InitialAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
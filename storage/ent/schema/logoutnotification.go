@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table logout_notification
+(
+    id           text      not null  primary key,
+    client_id    text      not null,
+    subject      text      not null,
+    created_at   timestamp not null,
+    attempts     integer   not null,
+    next_attempt timestamp not null,
+    expiry       timestamp not null
+);
+*/
+
+// LogoutNotification holds the schema definition for the LogoutNotification entity.
+type LogoutNotification struct {
+	ent.Schema
+}
+
+// Fields of the LogoutNotification.
+func (LogoutNotification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("client_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("subject").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("session_id").
+			SchemaType(textSchema).
+			Default(""),
+		field.Time("created_at").
+			SchemaType(timeSchema),
+		field.Int("attempts"),
+		field.Time("next_attempt").
+			SchemaType(timeSchema),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the LogoutNotification.
+func (LogoutNotification) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
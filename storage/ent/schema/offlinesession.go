@@ -37,6 +37,7 @@ func (OfflineSession) Fields() []ent.Field {
 			NotEmpty(),
 		field.Bytes("refresh"),
 		field.Bytes("connector_data").Nillable().Optional(),
+		field.JSON("extra_claims", map[string]any{}).Optional(),
 	}
 }
 
@@ -25,7 +25,8 @@ create table refresh_token
     created_at                timestamp default '0001-01-01 00:00:00 UTC' not null,
     last_used                 timestamp default '0001-01-01 00:00:00 UTC' not null,
     claims_preferred_username text      default '' not null,
-    obsolete_token            text      default ''
+    obsolete_token            text      default '',
+    dpop_key_thumbprint       text      default ''
 );
 */
 
@@ -62,6 +63,8 @@ func (RefreshToken) Fields() []ent.Field {
 		field.Bool("claims_email_verified"),
 		field.JSON("claims_groups", []string{}).
 			Optional(),
+		field.JSON("claims_extra", map[string]any{}).
+			Optional(),
 		field.Text("claims_preferred_username").
 			SchemaType(textSchema).
 			Default(""),
@@ -79,6 +82,9 @@ func (RefreshToken) Fields() []ent.Field {
 		field.Text("obsolete_token").
 			SchemaType(textSchema).
 			Default(""),
+		field.Text("dpop_key_thumbprint").
+			SchemaType(textSchema).
+			Default(""),
 
 		field.Time("created_at").
 			SchemaType(timeSchema).
@@ -86,6 +92,17 @@ func (RefreshToken) Fields() []ent.Field {
 		field.Time("last_used").
 			SchemaType(timeSchema).
 			Default(time.Now),
+		field.Text("acr").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("amr", []string{}).
+			Optional(),
+		field.Time("auth_time").
+			SchemaType(timeSchema).
+			Optional(),
+		field.Text("sid").
+			SchemaType(textSchema).
+			Default(""),
 	}
 }
 
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table scope
+(
+    name         text not null  primary key,
+    descriptions blob not null,
+    claims       blob not null,
+    client_ids   blob not null
+);
+*/
+
+// Scope holds the schema definition for the Scope entity.
+type Scope struct {
+	ent.Schema
+}
+
+// Fields of the Scope.
+func (Scope) Fields() []ent.Field {
+	return []ent.Field{
+		// The name of the scope.
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.JSON("descriptions", map[string]string{}).
+			Optional(),
+		field.JSON("claims", []string{}).
+			Optional(),
+		field.JSON("client_ids", []string{}).
+			Optional(),
+	}
+}
+
+// Edges of the Scope.
+func (Scope) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
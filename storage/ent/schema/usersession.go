@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table user_session
+(
+    id                        text      not null  primary key,
+    connector_id              text      not null,
+    connector_data            blob,
+    claims_user_id            text      not null,
+    claims_username           text      not null,
+    claims_preferred_username text      not null,
+    claims_email              text      not null,
+    claims_email_verified     integer   not null,
+    claims_groups             blob      not null,
+    created_at                timestamp not null,
+    last_used                 timestamp not null,
+    expiry                    timestamp not null
+);
+*/
+
+// UserSession holds the schema definition for the UserSession entity.
+type UserSession struct {
+	ent.Schema
+}
+
+// Fields of the UserSession.
+func (UserSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+
+		field.Text("connector_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Bytes("connector_data").
+			Nillable().
+			Optional(),
+
+		field.Text("claims_user_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("claims_username").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("claims_preferred_username").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("claims_email").
+			SchemaType(textSchema).
+			Default(""),
+		field.Bool("claims_email_verified"),
+		field.JSON("claims_groups", []string{}).
+			Optional(),
+		field.JSON("claims_extra", map[string]any{}).
+			Optional(),
+
+		field.Time("created_at").
+			SchemaType(timeSchema),
+		field.Time("last_used").
+			SchemaType(timeSchema),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+		field.Text("acr").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("amr", []string{}).
+			Optional(),
+		field.Time("auth_time").
+			SchemaType(timeSchema).
+			Optional(),
+		field.Text("sid").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("client_ids", []string{}).
+			Optional(),
+	}
+}
+
+// Edges of the UserSession.
+func (UserSession) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
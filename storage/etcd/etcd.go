@@ -14,16 +14,24 @@ import (
 )
 
 const (
-	clientPrefix         = "client/"
-	authCodePrefix       = "auth_code/"
-	refreshTokenPrefix   = "refresh_token/"
-	authRequestPrefix    = "auth_req/"
-	passwordPrefix       = "password/"
-	offlineSessionPrefix = "offline_session/"
-	connectorPrefix      = "connector/"
-	keysName             = "openid-connect-keys"
-	deviceRequestPrefix  = "device_req/"
-	deviceTokenPrefix    = "device_token/"
+	clientPrefix             = "client/"
+	authCodePrefix           = "auth_code/"
+	refreshTokenPrefix       = "refresh_token/"
+	authRequestPrefix        = "auth_req/"
+	passwordPrefix           = "password/"
+	offlineSessionPrefix     = "offline_session/"
+	connectorPrefix          = "connector/"
+	keysName                 = "openid-connect-keys"
+	deviceRequestPrefix      = "device_req/"
+	deviceTokenPrefix        = "device_token/"
+	userSessionPrefix        = "user_session/"
+	logoutNotificationPrefix = "logout_notification/"
+	accessTokenPrefix        = "access_token/"
+	initialAccessTokenPrefix = "initial_access_token/"
+	clientAssertionPrefix    = "client_assertion/"
+	dpopProofPrefix          = "dpop_proof/"
+	consentPrefix            = "consent/"
+	scopePrefix              = "scope/"
 
 	// defaultStorageTimeout will be applied to all storage's operations.
 	defaultStorageTimeout = 5 * time.Second
@@ -106,6 +114,96 @@ func (c *conn) GarbageCollect(ctx context.Context, now time.Time) (result storag
 			result.DeviceTokens++
 		}
 	}
+
+	userSessions, err := c.listUserSessions(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, userSession := range userSessions {
+		if now.After(userSession.Expiry) {
+			if err := c.deleteKey(ctx, keyID(userSessionPrefix, userSession.ID)); err != nil {
+				c.logger.Error("failed to delete user session", "err", err)
+				delErr = fmt.Errorf("failed to delete user session: %v", err)
+			}
+			result.UserSessions++
+		}
+	}
+
+	notifications, err := c.listLogoutNotifications(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, notification := range notifications {
+		if now.After(notification.Expiry) {
+			if err := c.deleteKey(ctx, keyID(logoutNotificationPrefix, notification.ID)); err != nil {
+				c.logger.Error("failed to delete logout notification", "err", err)
+				delErr = fmt.Errorf("failed to delete logout notification: %v", err)
+			}
+			result.LogoutNotifications++
+		}
+	}
+
+	accessTokens, err := c.listAccessTokens(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, accessToken := range accessTokens {
+		if now.After(accessToken.Expiry) {
+			if err := c.deleteKey(ctx, keyID(accessTokenPrefix, accessToken.ID)); err != nil {
+				c.logger.Error("failed to delete access token", "err", err)
+				delErr = fmt.Errorf("failed to delete access token: %v", err)
+			}
+			result.AccessTokens++
+		}
+	}
+
+	initialAccessTokens, err := c.listInitialAccessTokens(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, initialAccessToken := range initialAccessTokens {
+		if now.After(initialAccessToken.Expiry) {
+			if err := c.deleteKey(ctx, keyID(initialAccessTokenPrefix, initialAccessToken.ID)); err != nil {
+				c.logger.Error("failed to delete initial access token", "err", err)
+				delErr = fmt.Errorf("failed to delete initial access token: %v", err)
+			}
+			result.InitialAccessTokens++
+		}
+	}
+
+	clientAssertions, err := c.listClientAssertions(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, clientAssertion := range clientAssertions {
+		if now.After(clientAssertion.Expiry) {
+			if err := c.deleteKey(ctx, keyID(clientAssertionPrefix, clientAssertion.ID)); err != nil {
+				c.logger.Error("failed to delete client assertion", "err", err)
+				delErr = fmt.Errorf("failed to delete client assertion: %v", err)
+			}
+			result.ClientAssertions++
+		}
+	}
+
+	dpopProofs, err := c.listDPoPProofs(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, dpopProof := range dpopProofs {
+		if now.After(dpopProof.Expiry) {
+			if err := c.deleteKey(ctx, keyID(dpopProofPrefix, dpopProof.ID)); err != nil {
+				c.logger.Error("failed to delete dpop proof", "err", err)
+				delErr = fmt.Errorf("failed to delete dpop proof: %v", err)
+			}
+			result.DPoPProofs++
+		}
+	}
 	return result, delErr
 }
 
@@ -557,6 +655,14 @@ func keySession(userID, connID string) string {
 	return offlineSessionPrefix + strings.ToLower(userID+"|"+connID)
 }
 
+// keyConsents is the prefix of the keys of the consents of a user.
+func keyConsents(userID, connID string) string {
+	return consentPrefix + userID + "|" + connID + "|"
+}
+func keyConsent(userID, connID, clientID string) string {
+	return keyConsents(userID, connID) + clientID
+}
+
 func (c *conn) CreateDeviceRequest(ctx context.Context, d storage.DeviceRequest) error {
 	return c.txnCreate(ctx, keyID(deviceRequestPrefix, d.UserCode), fromStorageDeviceRequest(d))
 }
@@ -632,3 +738,373 @@ func (c *conn) UpdateDeviceToken(ctx context.Context, deviceCode string, updater
 		return json.Marshal(fromStorageDeviceToken(updated))
 	})
 }
+
+func (c *conn) CreateUserSession(ctx context.Context, s storage.UserSession) error {
+	return c.txnCreate(ctx, keyID(userSessionPrefix, s.ID), fromStorageUserSession(s))
+}
+
+func (c *conn) GetUserSession(ctx context.Context, id string) (s storage.UserSession, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	var us UserSession
+	if err = c.getKey(ctx, keyID(userSessionPrefix, id), &us); err == nil {
+		s = toStorageUserSession(us)
+	}
+	return
+}
+
+func (c *conn) DeleteUserSession(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(userSessionPrefix, id))
+}
+
+func (c *conn) listUserSessions(ctx context.Context) (sessions []UserSession, err error) {
+	res, err := c.db.Get(ctx, userSessionPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return sessions, err
+	}
+	for _, v := range res.Kvs {
+		var us UserSession
+		if err = json.Unmarshal(v.Value, &us); err != nil {
+			return sessions, err
+		}
+		sessions = append(sessions, us)
+	}
+	return sessions, nil
+}
+
+func (c *conn) UpdateUserSession(ctx context.Context, id string, updater func(old storage.UserSession) (storage.UserSession, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyID(userSessionPrefix, id), func(currentValue []byte) ([]byte, error) {
+		var current UserSession
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageUserSession(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageUserSession(updated))
+	})
+}
+
+func (c *conn) CreateLogoutNotification(ctx context.Context, n storage.LogoutNotification) error {
+	return c.txnCreate(ctx, keyID(logoutNotificationPrefix, n.ID), fromStorageLogoutNotification(n))
+}
+
+func (c *conn) ListLogoutNotifications(ctx context.Context) ([]storage.LogoutNotification, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	notifications, err := c.listLogoutNotifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	storageNotifications := make([]storage.LogoutNotification, 0, len(notifications))
+	for _, n := range notifications {
+		storageNotifications = append(storageNotifications, toStorageLogoutNotification(n))
+	}
+	return storageNotifications, nil
+}
+
+func (c *conn) listLogoutNotifications(ctx context.Context) (notifications []LogoutNotification, err error) {
+	res, err := c.db.Get(ctx, logoutNotificationPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return notifications, err
+	}
+	for _, v := range res.Kvs {
+		var n LogoutNotification
+		if err = json.Unmarshal(v.Value, &n); err != nil {
+			return notifications, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+func (c *conn) UpdateLogoutNotification(ctx context.Context, id string, updater func(old storage.LogoutNotification) (storage.LogoutNotification, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyID(logoutNotificationPrefix, id), func(currentValue []byte) ([]byte, error) {
+		var current LogoutNotification
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageLogoutNotification(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageLogoutNotification(updated))
+	})
+}
+
+func (c *conn) DeleteLogoutNotification(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(logoutNotificationPrefix, id))
+}
+
+func (c *conn) CreateAccessToken(ctx context.Context, t storage.AccessToken) error {
+	return c.txnCreate(ctx, keyID(accessTokenPrefix, t.ID), fromStorageAccessToken(t))
+}
+
+func (c *conn) GetAccessToken(ctx context.Context, id string) (t storage.AccessToken, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	var at AccessToken
+	if err = c.getKey(ctx, keyID(accessTokenPrefix, id), &at); err == nil {
+		t = toStorageAccessToken(at)
+	}
+	return
+}
+
+func (c *conn) DeleteAccessToken(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(accessTokenPrefix, id))
+}
+
+func (c *conn) DeleteRefreshAccessTokens(ctx context.Context, refreshID string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	tokens, err := c.listAccessTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = storage.ErrNotFound
+	for _, t := range tokens {
+		if t.RefreshTokenID != refreshID {
+			continue
+		}
+		if err = c.deleteKey(ctx, keyID(accessTokenPrefix, t.ID)); err != nil && err != storage.ErrNotFound {
+			return err
+		}
+		err = nil
+	}
+	return err
+}
+
+func (c *conn) listAccessTokens(ctx context.Context) (tokens []AccessToken, err error) {
+	res, err := c.db.Get(ctx, accessTokenPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return tokens, err
+	}
+	for _, v := range res.Kvs {
+		var at AccessToken
+		if err = json.Unmarshal(v.Value, &at); err != nil {
+			return tokens, err
+		}
+		tokens = append(tokens, at)
+	}
+	return tokens, nil
+}
+
+func (c *conn) CreateInitialAccessToken(ctx context.Context, t storage.InitialAccessToken) error {
+	return c.txnCreate(ctx, keyID(initialAccessTokenPrefix, t.ID), fromStorageInitialAccessToken(t))
+}
+
+func (c *conn) GetInitialAccessToken(ctx context.Context, id string) (t storage.InitialAccessToken, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	var it InitialAccessToken
+	if err = c.getKey(ctx, keyID(initialAccessTokenPrefix, id), &it); err == nil {
+		t = toStorageInitialAccessToken(it)
+	}
+	return
+}
+
+func (c *conn) DeleteInitialAccessToken(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(initialAccessTokenPrefix, id))
+}
+
+func (c *conn) listInitialAccessTokens(ctx context.Context) (tokens []InitialAccessToken, err error) {
+	res, err := c.db.Get(ctx, initialAccessTokenPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return tokens, err
+	}
+	for _, v := range res.Kvs {
+		var it InitialAccessToken
+		if err = json.Unmarshal(v.Value, &it); err != nil {
+			return tokens, err
+		}
+		tokens = append(tokens, it)
+	}
+	return tokens, nil
+}
+
+func (c *conn) CreateClientAssertion(ctx context.Context, a storage.ClientAssertion) error {
+	return c.txnCreate(ctx, keyID(clientAssertionPrefix, a.ID), fromStorageClientAssertion(a))
+}
+
+func (c *conn) GetClientAssertion(ctx context.Context, id string) (a storage.ClientAssertion, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	var ca ClientAssertion
+	if err = c.getKey(ctx, keyID(clientAssertionPrefix, id), &ca); err == nil {
+		a = toStorageClientAssertion(ca)
+	}
+	return
+}
+
+func (c *conn) listClientAssertions(ctx context.Context) (assertions []ClientAssertion, err error) {
+	res, err := c.db.Get(ctx, clientAssertionPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return assertions, err
+	}
+	for _, v := range res.Kvs {
+		var ca ClientAssertion
+		if err = json.Unmarshal(v.Value, &ca); err != nil {
+			return assertions, err
+		}
+		assertions = append(assertions, ca)
+	}
+	return assertions, nil
+}
+
+func (c *conn) CreateDPoPProof(ctx context.Context, p storage.DPoPProof) error {
+	return c.txnCreate(ctx, keyID(dpopProofPrefix, p.ID), fromStorageDPoPProof(p))
+}
+
+func (c *conn) GetDPoPProof(ctx context.Context, id string) (p storage.DPoPProof, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	var dp DPoPProof
+	if err = c.getKey(ctx, keyID(dpopProofPrefix, id), &dp); err == nil {
+		p = toStorageDPoPProof(dp)
+	}
+	return
+}
+
+func (c *conn) listDPoPProofs(ctx context.Context) (proofs []DPoPProof, err error) {
+	res, err := c.db.Get(ctx, dpopProofPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return proofs, err
+	}
+	for _, v := range res.Kvs {
+		var dp DPoPProof
+		if err = json.Unmarshal(v.Value, &dp); err != nil {
+			return proofs, err
+		}
+		proofs = append(proofs, dp)
+	}
+	return proofs, nil
+}
+
+func (c *conn) CreateConsent(ctx context.Context, cs storage.Consent) error {
+	return c.txnCreate(ctx, keyConsent(cs.UserID, cs.ConnectorID, cs.ClientID), fromStorageConsent(cs))
+}
+
+func (c *conn) GetConsent(ctx context.Context, userID, connID, clientID string) (cs storage.Consent, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	var consent Consent
+	if err = c.getKey(ctx, keyConsent(userID, connID, clientID), &consent); err == nil {
+		cs = toStorageConsent(consent)
+	}
+	return
+}
+
+func (c *conn) ListConsents(ctx context.Context, userID, connID string) ([]storage.Consent, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	res, err := c.db.Get(ctx, keyConsents(userID, connID), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	var consents []storage.Consent
+	for _, v := range res.Kvs {
+		var consent Consent
+		if err = json.Unmarshal(v.Value, &consent); err != nil {
+			return nil, err
+		}
+		// IDs containing the separator can share a prefix.
+		if consent.UserID == userID && consent.ConnID == connID {
+			consents = append(consents, toStorageConsent(consent))
+		}
+	}
+	return consents, nil
+}
+
+func (c *conn) UpdateConsent(ctx context.Context, userID, connID, clientID string, updater func(cs storage.Consent) (storage.Consent, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyConsent(userID, connID, clientID), func(currentValue []byte) ([]byte, error) {
+		var current Consent
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageConsent(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageConsent(updated))
+	})
+}
+
+func (c *conn) DeleteConsent(ctx context.Context, userID, connID, clientID string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyConsent(userID, connID, clientID))
+}
+
+func (c *conn) CreateScope(ctx context.Context, sc storage.Scope) error {
+	return c.txnCreate(ctx, keyID(scopePrefix, sc.Name), sc)
+}
+
+func (c *conn) GetScope(ctx context.Context, name string) (sc storage.Scope, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	err = c.getKey(ctx, keyID(scopePrefix, name), &sc)
+	return sc, err
+}
+
+func (c *conn) ListScopes(ctx context.Context) (scopes []storage.Scope, err error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	res, err := c.db.Get(ctx, scopePrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range res.Kvs {
+		var sc storage.Scope
+		if err = json.Unmarshal(v.Value, &sc); err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, sc)
+	}
+	return scopes, nil
+}
+
+func (c *conn) UpdateScope(ctx context.Context, name string, updater func(sc storage.Scope) (storage.Scope, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyID(scopePrefix, name), func(currentValue []byte) ([]byte, error) {
+		var current storage.Scope
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(current)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(updated)
+	})
+}
+
+func (c *conn) DeleteScope(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(scopePrefix, name))
+}
@@ -20,6 +20,8 @@ type AuthCode struct {
 	ConnectorData []byte `json:"connectorData,omitempty"`
 	Claims        Claims `json:"claims,omitempty"`
 
+	Authentication Authentication `json:"authentication"`
+
 	Expiry time.Time `json:"expiry"`
 
 	CodeChallenge       string `json:"code_challenge,omitempty"`
@@ -28,15 +30,16 @@ type AuthCode struct {
 
 func toStorageAuthCode(a AuthCode) storage.AuthCode {
 	return storage.AuthCode{
-		ID:            a.ID,
-		ClientID:      a.ClientID,
-		RedirectURI:   a.RedirectURI,
-		ConnectorID:   a.ConnectorID,
-		ConnectorData: a.ConnectorData,
-		Nonce:         a.Nonce,
-		Scopes:        a.Scopes,
-		Claims:        toStorageClaims(a.Claims),
-		Expiry:        a.Expiry,
+		ID:             a.ID,
+		ClientID:       a.ClientID,
+		RedirectURI:    a.RedirectURI,
+		ConnectorID:    a.ConnectorID,
+		ConnectorData:  a.ConnectorData,
+		Nonce:          a.Nonce,
+		Scopes:         a.Scopes,
+		Claims:         toStorageClaims(a.Claims),
+		Authentication: toStorageAuthentication(a.Authentication),
+		Expiry:         a.Expiry,
 		PKCE: storage.PKCE{
 			CodeChallenge:       a.CodeChallenge,
 			CodeChallengeMethod: a.CodeChallengeMethod,
@@ -54,6 +57,7 @@ func fromStorageAuthCode(a storage.AuthCode) AuthCode {
 		Nonce:               a.Nonce,
 		Scopes:              a.Scopes,
 		Claims:              fromStorageClaims(a.Claims),
+		Authentication:      fromStorageAuthentication(a.Authentication),
 		Expiry:              a.Expiry,
 		CodeChallenge:       a.PKCE.CodeChallenge,
 		CodeChallengeMethod: a.PKCE.CodeChallengeMethod,
@@ -77,7 +81,8 @@ type AuthRequest struct {
 
 	LoggedIn bool `json:"logged_in"`
 
-	Claims Claims `json:"claims"`
+	Claims         Claims         `json:"claims"`
+	Authentication Authentication `json:"authentication"`
 
 	ConnectorID   string `json:"connector_id"`
 	ConnectorData []byte `json:"connector_data"`
@@ -86,6 +91,12 @@ type AuthRequest struct {
 	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
 
 	HMACKey []byte `json:"hmac_key"`
+
+	Prompt    string   `json:"prompt,omitempty"`
+	MaxAge    int      `json:"max_age"`
+	ACRValues []string `json:"acr_values,omitempty"`
+	LoginHint string   `json:"login_hint,omitempty"`
+	UILocales []string `json:"ui_locales,omitempty"`
 }
 
 func fromStorageAuthRequest(a storage.AuthRequest) AuthRequest {
@@ -101,11 +112,17 @@ func fromStorageAuthRequest(a storage.AuthRequest) AuthRequest {
 		Expiry:              a.Expiry,
 		LoggedIn:            a.LoggedIn,
 		Claims:              fromStorageClaims(a.Claims),
+		Authentication:      fromStorageAuthentication(a.Authentication),
 		ConnectorID:         a.ConnectorID,
 		ConnectorData:       a.ConnectorData,
 		CodeChallenge:       a.PKCE.CodeChallenge,
 		CodeChallengeMethod: a.PKCE.CodeChallengeMethod,
 		HMACKey:             a.HMACKey,
+		Prompt:              a.Prompt,
+		MaxAge:              a.MaxAge,
+		ACRValues:           a.ACRValues,
+		LoginHint:           a.LoginHint,
+		UILocales:           a.UILocales,
 	}
 }
 
@@ -124,11 +141,17 @@ func toStorageAuthRequest(a AuthRequest) storage.AuthRequest {
 		ConnectorData:       a.ConnectorData,
 		Expiry:              a.Expiry,
 		Claims:              toStorageClaims(a.Claims),
+		Authentication:      toStorageAuthentication(a.Authentication),
 		PKCE: storage.PKCE{
 			CodeChallenge:       a.CodeChallenge,
 			CodeChallengeMethod: a.CodeChallengeMethod,
 		},
-		HMACKey: a.HMACKey,
+		HMACKey:   a.HMACKey,
+		Prompt:    a.Prompt,
+		MaxAge:    a.MaxAge,
+		ACRValues: a.ACRValues,
+		LoginHint: a.LoginHint,
+		UILocales: a.UILocales,
 	}
 }
 
@@ -151,48 +174,59 @@ type RefreshToken struct {
 	Scopes []string `json:"scopes"`
 
 	Nonce string `json:"nonce"`
+
+	Authentication Authentication `json:"authentication"`
+
+	DPoPKeyThumbprint string `json:"dpop_key_thumbprint,omitempty"`
 }
 
 func toStorageRefreshToken(r RefreshToken) storage.RefreshToken {
 	return storage.RefreshToken{
-		ID:            r.ID,
-		Token:         r.Token,
-		ObsoleteToken: r.ObsoleteToken,
-		CreatedAt:     r.CreatedAt,
-		LastUsed:      r.LastUsed,
-		ClientID:      r.ClientID,
-		ConnectorID:   r.ConnectorID,
-		ConnectorData: r.ConnectorData,
-		Scopes:        r.Scopes,
-		Nonce:         r.Nonce,
-		Claims:        toStorageClaims(r.Claims),
+		ID:             r.ID,
+		Token:          r.Token,
+		ObsoleteToken:  r.ObsoleteToken,
+		CreatedAt:      r.CreatedAt,
+		LastUsed:       r.LastUsed,
+		ClientID:       r.ClientID,
+		ConnectorID:    r.ConnectorID,
+		ConnectorData:  r.ConnectorData,
+		Scopes:         r.Scopes,
+		Nonce:          r.Nonce,
+		Claims:         toStorageClaims(r.Claims),
+		Authentication: toStorageAuthentication(r.Authentication),
+
+		DPoPKeyThumbprint: r.DPoPKeyThumbprint,
 	}
 }
 
 func fromStorageRefreshToken(r storage.RefreshToken) RefreshToken {
 	return RefreshToken{
-		ID:            r.ID,
-		Token:         r.Token,
-		ObsoleteToken: r.ObsoleteToken,
-		CreatedAt:     r.CreatedAt,
-		LastUsed:      r.LastUsed,
-		ClientID:      r.ClientID,
-		ConnectorID:   r.ConnectorID,
-		ConnectorData: r.ConnectorData,
-		Scopes:        r.Scopes,
-		Nonce:         r.Nonce,
-		Claims:        fromStorageClaims(r.Claims),
+		ID:             r.ID,
+		Token:          r.Token,
+		ObsoleteToken:  r.ObsoleteToken,
+		CreatedAt:      r.CreatedAt,
+		LastUsed:       r.LastUsed,
+		ClientID:       r.ClientID,
+		ConnectorID:    r.ConnectorID,
+		ConnectorData:  r.ConnectorData,
+		Scopes:         r.Scopes,
+		Nonce:          r.Nonce,
+		Claims:         fromStorageClaims(r.Claims),
+		Authentication: fromStorageAuthentication(r.Authentication),
+
+		DPoPKeyThumbprint: r.DPoPKeyThumbprint,
 	}
 }
 
 // Claims is a mirrored struct from storage with JSON struct tags.
 type Claims struct {
-	UserID            string   `json:"userID"`
-	Username          string   `json:"username"`
-	PreferredUsername string   `json:"preferredUsername"`
-	Email             string   `json:"email"`
-	EmailVerified     bool     `json:"emailVerified"`
-	Groups            []string `json:"groups,omitempty"`
+	UserID            string         `json:"userID"`
+	Username          string         `json:"username"`
+	PreferredUsername string         `json:"preferredUsername"`
+	Email             string         `json:"email"`
+	EmailVerified     bool           `json:"emailVerified"`
+	Groups            []string       `json:"groups,omitempty"`
+	ExtraClaims       map[string]any `json:"extraClaims,omitempty"`
 }
 
 func fromStorageClaims(i storage.Claims) Claims {
@@ -203,6 +237,7 @@ func fromStorageClaims(i storage.Claims) Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ExtraClaims:       i.ExtraClaims,
 	}
 }
 
@@ -214,6 +249,33 @@ func toStorageClaims(i Claims) storage.Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ExtraClaims:       i.ExtraClaims,
+	}
+}
+
+// Authentication is a mirrored struct from storage with JSON struct tags.
+type Authentication struct {
+	ACR       string    `json:"acr,omitempty"`
+	AMR       []string  `json:"amr,omitempty"`
+	Time      time.Time `json:"time"`
+	SessionID string    `json:"session_id,omitempty"`
+}
+
+func fromStorageAuthentication(a storage.Authentication) Authentication {
+	return Authentication{
+		ACR:       a.ACR,
+		AMR:       a.AMR,
+		Time:      a.Time,
+		SessionID: a.SessionID,
+	}
+}
+
+func toStorageAuthentication(a Authentication) storage.Authentication {
+	return storage.Authentication{
+		ACR:       a.ACR,
+		AMR:       a.AMR,
+		Time:      a.Time,
+		SessionID: a.SessionID,
 	}
 }
 
@@ -231,6 +293,7 @@ type OfflineSessions struct {
 	ConnID        string                              `json:"conn_id,omitempty"`
 	Refresh       map[string]*storage.RefreshTokenRef `json:"refresh,omitempty"`
 	ConnectorData []byte                              `json:"connectorData,omitempty"`
+	ExtraClaims   map[string]any                      `json:"extraClaims,omitempty"`
 }
 
 func fromStorageOfflineSessions(o storage.OfflineSessions) OfflineSessions {
@@ -239,6 +302,7 @@ func fromStorageOfflineSessions(o storage.OfflineSessions) OfflineSessions {
 		ConnID:        o.ConnID,
 		Refresh:       o.Refresh,
 		ConnectorData: o.ConnectorData,
+		ExtraClaims:   o.ExtraClaims,
 	}
 }
 
@@ -248,6 +312,7 @@ func toStorageOfflineSessions(o OfflineSessions) storage.OfflineSessions {
 		ConnID:        o.ConnID,
 		Refresh:       o.Refresh,
 		ConnectorData: o.ConnectorData,
+		ExtraClaims:   o.ExtraClaims,
 	}
 	if s.Refresh == nil {
 		// Server code assumes this will be non-nil.
@@ -327,3 +392,237 @@ func toStorageDeviceToken(t DeviceToken) storage.DeviceToken {
 		},
 	}
 }
+
+// UserSession is a mirrored struct from storage with JSON struct tags
+type UserSession struct {
+	ID            string `json:"id"`
+	ConnectorID   string `json:"connector_id"`
+	ConnectorData []byte `json:"connector_data,omitempty"`
+	Claims        Claims `json:"claims"`
+
+	Authentication Authentication `json:"authentication"`
+	ClientIDs      []string       `json:"client_ids,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	LastUsed  time.Time `json:"last_used"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+func fromStorageUserSession(s storage.UserSession) UserSession {
+	return UserSession{
+		ID:             s.ID,
+		ConnectorID:    s.ConnectorID,
+		ConnectorData:  s.ConnectorData,
+		Claims:         fromStorageClaims(s.Claims),
+		Authentication: fromStorageAuthentication(s.Authentication),
+		ClientIDs:      s.ClientIDs,
+		CreatedAt:      s.CreatedAt,
+		LastUsed:       s.LastUsed,
+		Expiry:         s.Expiry,
+	}
+}
+
+func toStorageUserSession(s UserSession) storage.UserSession {
+	return storage.UserSession{
+		ID:             s.ID,
+		ConnectorID:    s.ConnectorID,
+		ConnectorData:  s.ConnectorData,
+		Claims:         toStorageClaims(s.Claims),
+		Authentication: toStorageAuthentication(s.Authentication),
+		ClientIDs:      s.ClientIDs,
+		CreatedAt:      s.CreatedAt,
+		LastUsed:       s.LastUsed,
+		Expiry:         s.Expiry,
+	}
+}
+
+// LogoutNotification is a mirrored struct from storage with JSON struct tags
+type LogoutNotification struct {
+	ID        string `json:"id"`
+	ClientID  string `json:"client_id"`
+	Subject   string `json:"subject"`
+	SessionID string `json:"session_id,omitempty"`
+
+	CreatedAt   time.Time `json:"created_at"`
+	Attempts    int       `json:"attempts"`
+	NextAttempt time.Time `json:"next_attempt"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+func fromStorageLogoutNotification(n storage.LogoutNotification) LogoutNotification {
+	return LogoutNotification{
+		ID:          n.ID,
+		ClientID:    n.ClientID,
+		Subject:     n.Subject,
+		SessionID:   n.SessionID,
+		CreatedAt:   n.CreatedAt,
+		Attempts:    n.Attempts,
+		NextAttempt: n.NextAttempt,
+		Expiry:      n.Expiry,
+	}
+}
+
+func toStorageLogoutNotification(n LogoutNotification) storage.LogoutNotification {
+	return storage.LogoutNotification{
+		ID:          n.ID,
+		ClientID:    n.ClientID,
+		Subject:     n.Subject,
+		SessionID:   n.SessionID,
+		CreatedAt:   n.CreatedAt,
+		Attempts:    n.Attempts,
+		NextAttempt: n.NextAttempt,
+		Expiry:      n.Expiry,
+	}
+}
+
+// AccessToken is a mirrored struct from storage with JSON struct tags
+type AccessToken struct {
+	ID          string   `json:"id"`
+	ClientID    string   `json:"client_id"`
+	Scopes      []string `json:"scopes,omitempty"`
+	Audience    []string `json:"audience,omitempty"`
+	ConnectorID string   `json:"connector_id,omitempty"`
+	Claims      Claims   `json:"claims"`
+
+	RefreshTokenID string `json:"refresh_token_id,omitempty"`
+
+	CertificateThumbprint string `json:"certificate_thumbprint,omitempty"`
+	DPoPKeyThumbprint     string `json:"dpop_key_thumbprint,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+func fromStorageAccessToken(t storage.AccessToken) AccessToken {
+	return AccessToken{
+		ID:          t.ID,
+		ClientID:    t.ClientID,
+		Scopes:      t.Scopes,
+		Audience:    t.Audience,
+		ConnectorID: t.ConnectorID,
+		Claims:      fromStorageClaims(t.Claims),
+
+		RefreshTokenID: t.RefreshTokenID,
+
+		CertificateThumbprint: t.CertificateThumbprint,
+		DPoPKeyThumbprint:     t.DPoPKeyThumbprint,
+		CreatedAt:             t.CreatedAt,
+		Expiry:                t.Expiry,
+	}
+}
+
+func toStorageAccessToken(t AccessToken) storage.AccessToken {
+	return storage.AccessToken{
+		ID:          t.ID,
+		ClientID:    t.ClientID,
+		Scopes:      t.Scopes,
+		Audience:    t.Audience,
+		ConnectorID: t.ConnectorID,
+		Claims:      toStorageClaims(t.Claims),
+
+		RefreshTokenID: t.RefreshTokenID,
+
+		CertificateThumbprint: t.CertificateThumbprint,
+		DPoPKeyThumbprint:     t.DPoPKeyThumbprint,
+		CreatedAt:             t.CreatedAt,
+		Expiry:                t.Expiry,
+	}
+}
+
+// InitialAccessToken is a mirrored struct from storage with JSON struct tags
+type InitialAccessToken struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+func fromStorageInitialAccessToken(t storage.InitialAccessToken) InitialAccessToken {
+	return InitialAccessToken{
+		ID:        t.ID,
+		CreatedAt: t.CreatedAt,
+		Expiry:    t.Expiry,
+	}
+}
+
+func toStorageInitialAccessToken(t InitialAccessToken) storage.InitialAccessToken {
+	return storage.InitialAccessToken{
+		ID:        t.ID,
+		CreatedAt: t.CreatedAt,
+		Expiry:    t.Expiry,
+	}
+}
+
+// ClientAssertion is a mirrored struct from storage with JSON struct tags
+type ClientAssertion struct {
+	ID       string    `json:"id"`
+	ClientID string    `json:"client_id"`
+	Expiry   time.Time `json:"expiry"`
+}
+
+func fromStorageClientAssertion(a storage.ClientAssertion) ClientAssertion {
+	return ClientAssertion{
+		ID:       a.ID,
+		ClientID: a.ClientID,
+		Expiry:   a.Expiry,
+	}
+}
+
+func toStorageClientAssertion(a ClientAssertion) storage.ClientAssertion {
+	return storage.ClientAssertion{
+		ID:       a.ID,
+		ClientID: a.ClientID,
+		Expiry:   a.Expiry,
+	}
+}
+
+// DPoPProof is a mirrored struct from storage with JSON struct tags
+type DPoPProof struct {
+	ID     string    `json:"id"`
+	Expiry time.Time `json:"expiry"`
+}
+
+func fromStorageDPoPProof(p storage.DPoPProof) DPoPProof {
+	return DPoPProof{
+		ID:     p.ID,
+		Expiry: p.Expiry,
+	}
+}
+
+func toStorageDPoPProof(p DPoPProof) storage.DPoPProof {
+	return storage.DPoPProof{
+		ID:     p.ID,
+		Expiry: p.Expiry,
+	}
+}
+
+// Consent is a mirrored struct from storage with JSON struct tags
+type Consent struct {
+	UserID    string    `json:"user_id"`
+	ConnID    string    `json:"conn_id"`
+	ClientID  string    `json:"client_id"`
+	Scopes    []string  `json:"scopes,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func fromStorageConsent(c storage.Consent) Consent {
+	return Consent{
+		UserID:    c.UserID,
+		ConnID:    c.ConnectorID,
+		ClientID:  c.ClientID,
+		Scopes:    c.Scopes,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toStorageConsent(c Consent) storage.Consent {
+	return storage.Consent{
+		UserID:      c.UserID,
+		ConnectorID: c.ConnID,
+		ClientID:    c.ClientID,
+		Scopes:      c.Scopes,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
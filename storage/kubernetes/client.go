@@ -86,6 +86,16 @@ func (cli *client) offlineTokenName(userID string, connID string) string {
 	return offlineTokenName(userID, connID, cli.hash)
 }
 
+// consentName maps the IDs a consent is keyed by to a single Kubernetes object
+// name. The IDs are length prefixed so that they can't run into each other.
+func (cli *client) consentName(userID, connID, clientID string) string {
+	hash := cli.hash()
+	for _, id := range []string{userID, connID, clientID} {
+		hash.Write([]byte(strconv.Itoa(len(id)) + ":" + id))
+	}
+	return strings.TrimRight(encoding.EncodeToString(hash.Sum(nil)), "=")
+}
+
 // Kubernetes names must match the regexp '[a-z0-9]([-a-z0-9]*[a-z0-9])?'.
 var encoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567")
 
@@ -15,29 +15,45 @@ import (
 )
 
 const (
-	kindAuthCode        = "AuthCode"
-	kindAuthRequest     = "AuthRequest"
-	kindClient          = "OAuth2Client"
-	kindRefreshToken    = "RefreshToken"
-	kindKeys            = "SigningKey"
-	kindPassword        = "Password"
-	kindOfflineSessions = "OfflineSessions"
-	kindConnector       = "Connector"
-	kindDeviceRequest   = "DeviceRequest"
-	kindDeviceToken     = "DeviceToken"
+	kindAuthCode           = "AuthCode"
+	kindAuthRequest        = "AuthRequest"
+	kindClient             = "OAuth2Client"
+	kindRefreshToken       = "RefreshToken"
+	kindKeys               = "SigningKey"
+	kindPassword           = "Password"
+	kindOfflineSessions    = "OfflineSessions"
+	kindConnector          = "Connector"
+	kindDeviceRequest      = "DeviceRequest"
+	kindDeviceToken        = "DeviceToken"
+	kindUserSession        = "UserSession"
+	kindLogoutNotification = "LogoutNotification"
+	kindAccessToken        = "AccessToken"
+	kindInitialAccessToken = "InitialAccessToken"
+	kindClientAssertion    = "ClientAssertion"
+	kindDPoPProof          = "DPoPProof"
+	kindConsent            = "Consent"
+	kindScope              = "Scope"
 )
 
 const (
-	resourceAuthCode        = "authcodes"
-	resourceAuthRequest     = "authrequests"
-	resourceClient          = "oauth2clients"
-	resourceRefreshToken    = "refreshtokens"
-	resourceKeys            = "signingkeies" // Kubernetes attempts to pluralize.
-	resourcePassword        = "passwords"
-	resourceOfflineSessions = "offlinesessionses" // Again attempts to pluralize.
-	resourceConnector       = "connectors"
-	resourceDeviceRequest   = "devicerequests"
-	resourceDeviceToken     = "devicetokens"
+	resourceAuthCode           = "authcodes"
+	resourceAuthRequest        = "authrequests"
+	resourceClient             = "oauth2clients"
+	resourceRefreshToken       = "refreshtokens"
+	resourceKeys               = "signingkeies" // Kubernetes attempts to pluralize.
+	resourcePassword           = "passwords"
+	resourceOfflineSessions    = "offlinesessionses" // Again attempts to pluralize.
+	resourceConnector          = "connectors"
+	resourceDeviceRequest      = "devicerequests"
+	resourceDeviceToken        = "devicetokens"
+	resourceUserSession        = "usersessions"
+	resourceLogoutNotification = "logoutnotifications"
+	resourceAccessToken        = "accesstokens"
+	resourceInitialAccessToken = "initialaccesstokens"
+	resourceClientAssertion    = "clientassertions"
+	resourceDPoPProof          = "dpopproofs"
+	resourceConsent            = "consents"
+	resourceScope              = "scopes"
 )
 
 const (
@@ -690,6 +706,96 @@ func (cli *client) GarbageCollect(ctx context.Context, now time.Time) (result st
 		}
 	}
 
+	var userSessions UserSessionList
+	if err := cli.listN(resourceUserSession, &userSessions, gcResultLimit); err != nil {
+		return result, fmt.Errorf("failed to list user sessions: %v", err)
+	}
+
+	for _, userSession := range userSessions.UserSessions {
+		if now.After(userSession.Expiry) {
+			if err := cli.delete(resourceUserSession, userSession.ObjectMeta.Name); err != nil {
+				cli.logger.Error("failed to delete user session", "err", err)
+				delErr = fmt.Errorf("failed to delete user session: %v", err)
+			}
+			result.UserSessions++
+		}
+	}
+
+	var notifications LogoutNotificationList
+	if err := cli.listN(resourceLogoutNotification, &notifications, gcResultLimit); err != nil {
+		return result, fmt.Errorf("failed to list logout notifications: %v", err)
+	}
+
+	for _, notification := range notifications.LogoutNotifications {
+		if now.After(notification.Expiry) {
+			if err := cli.delete(resourceLogoutNotification, notification.ObjectMeta.Name); err != nil {
+				cli.logger.Error("failed to delete logout notification", "err", err)
+				delErr = fmt.Errorf("failed to delete logout notification: %v", err)
+			}
+			result.LogoutNotifications++
+		}
+	}
+
+	var accessTokens AccessTokenList
+	if err := cli.listN(resourceAccessToken, &accessTokens, gcResultLimit); err != nil {
+		return result, fmt.Errorf("failed to list access tokens: %v", err)
+	}
+
+	for _, accessToken := range accessTokens.AccessTokens {
+		if now.After(accessToken.Expiry) {
+			if err := cli.delete(resourceAccessToken, accessToken.ObjectMeta.Name); err != nil {
+				cli.logger.Error("failed to delete access token", "err", err)
+				delErr = fmt.Errorf("failed to delete access token: %v", err)
+			}
+			result.AccessTokens++
+		}
+	}
+
+	var initialAccessTokens InitialAccessTokenList
+	if err := cli.listN(resourceInitialAccessToken, &initialAccessTokens, gcResultLimit); err != nil {
+		return result, fmt.Errorf("failed to list initial access tokens: %v", err)
+	}
+
+	for _, initialAccessToken := range initialAccessTokens.InitialAccessTokens {
+		if now.After(initialAccessToken.Expiry) {
+			if err := cli.delete(resourceInitialAccessToken, initialAccessToken.ObjectMeta.Name); err != nil {
+				cli.logger.Error("failed to delete initial access token", "err", err)
+				delErr = fmt.Errorf("failed to delete initial access token: %v", err)
+			}
+			result.InitialAccessTokens++
+		}
+	}
+
+	var clientAssertions ClientAssertionList
+	if err := cli.listN(resourceClientAssertion, &clientAssertions, gcResultLimit); err != nil {
+		return result, fmt.Errorf("failed to list client assertions: %v", err)
+	}
+
+	for _, clientAssertion := range clientAssertions.ClientAssertions {
+		if now.After(clientAssertion.Expiry) {
+			if err := cli.delete(resourceClientAssertion, clientAssertion.ObjectMeta.Name); err != nil {
+				cli.logger.Error("failed to delete client assertion", "err", err)
+				delErr = fmt.Errorf("failed to delete client assertion: %v", err)
+			}
+			result.ClientAssertions++
+		}
+	}
+
+	var dpopProofs DPoPProofList
+	if err := cli.listN(resourceDPoPProof, &dpopProofs, gcResultLimit); err != nil {
+		return result, fmt.Errorf("failed to list dpop proofs: %v", err)
+	}
+
+	for _, dpopProof := range dpopProofs.DPoPProofs {
+		if now.After(dpopProof.Expiry) {
+			if err := cli.delete(resourceDPoPProof, dpopProof.ObjectMeta.Name); err != nil {
+				cli.logger.Error("failed to delete dpop proof", "err", err)
+				delErr = fmt.Errorf("failed to delete dpop proof: %v", err)
+			}
+			result.DPoPProofs++
+		}
+	}
+
 	if delErr != nil {
 		return result, delErr
 	}
@@ -743,6 +849,79 @@ func (cli *client) UpdateDeviceToken(ctx context.Context, deviceCode string, upd
 	})
 }
 
+func (cli *client) CreateUserSession(ctx context.Context, s storage.UserSession) error {
+	return cli.post(resourceUserSession, cli.fromStorageUserSession(s))
+}
+
+func (cli *client) GetUserSession(ctx context.Context, id string) (storage.UserSession, error) {
+	var s UserSession
+	if err := cli.get(resourceUserSession, id, &s); err != nil {
+		return storage.UserSession{}, err
+	}
+	return toStorageUserSession(s), nil
+}
+
+func (cli *client) DeleteUserSession(ctx context.Context, id string) error {
+	return cli.delete(resourceUserSession, id)
+}
+
+func (cli *client) UpdateUserSession(ctx context.Context, id string, updater func(old storage.UserSession) (storage.UserSession, error)) error {
+	return retryOnConflict(ctx, func() error {
+		var s UserSession
+		if err := cli.get(resourceUserSession, id, &s); err != nil {
+			return err
+		}
+		updated, err := updater(toStorageUserSession(s))
+		if err != nil {
+			return err
+		}
+		updated.ID = id
+
+		newSession := cli.fromStorageUserSession(updated)
+		newSession.ObjectMeta = s.ObjectMeta
+		return cli.put(resourceUserSession, s.ObjectMeta.Name, newSession)
+	})
+}
+
+func (cli *client) CreateLogoutNotification(ctx context.Context, n storage.LogoutNotification) error {
+	return cli.post(resourceLogoutNotification, cli.fromStorageLogoutNotification(n))
+}
+
+func (cli *client) ListLogoutNotifications(ctx context.Context) ([]storage.LogoutNotification, error) {
+	var list LogoutNotificationList
+	if err := cli.list(resourceLogoutNotification, &list); err != nil {
+		return nil, fmt.Errorf("failed to list logout notifications: %v", err)
+	}
+
+	notifications := make([]storage.LogoutNotification, len(list.LogoutNotifications))
+	for i, n := range list.LogoutNotifications {
+		notifications[i] = toStorageLogoutNotification(n)
+	}
+	return notifications, nil
+}
+
+func (cli *client) DeleteLogoutNotification(ctx context.Context, id string) error {
+	return cli.delete(resourceLogoutNotification, id)
+}
+
+func (cli *client) UpdateLogoutNotification(ctx context.Context, id string, updater func(old storage.LogoutNotification) (storage.LogoutNotification, error)) error {
+	return retryOnConflict(ctx, func() error {
+		var n LogoutNotification
+		if err := cli.get(resourceLogoutNotification, id, &n); err != nil {
+			return err
+		}
+		updated, err := updater(toStorageLogoutNotification(n))
+		if err != nil {
+			return err
+		}
+		updated.ID = id
+
+		newNotification := cli.fromStorageLogoutNotification(updated)
+		newNotification.ObjectMeta = n.ObjectMeta
+		return cli.put(resourceLogoutNotification, n.ObjectMeta.Name, newNotification)
+	})
+}
+
 func isKubernetesAPIConflictError(err error) bool {
 	if httpErr, ok := err.(httpError); ok {
 		if httpErr.StatusCode() == http.StatusConflict {
@@ -782,3 +961,203 @@ func retryOnConflict(ctx context.Context, action func() error) error {
 		}
 	}
 }
+
+func (cli *client) CreateAccessToken(ctx context.Context, t storage.AccessToken) error {
+	return cli.post(resourceAccessToken, cli.fromStorageAccessToken(t))
+}
+
+func (cli *client) GetAccessToken(ctx context.Context, id string) (storage.AccessToken, error) {
+	var t AccessToken
+	if err := cli.get(resourceAccessToken, id, &t); err != nil {
+		return storage.AccessToken{}, err
+	}
+	return toStorageAccessToken(t), nil
+}
+
+func (cli *client) DeleteAccessToken(ctx context.Context, id string) error {
+	return cli.delete(resourceAccessToken, id)
+}
+
+func (cli *client) DeleteRefreshAccessTokens(ctx context.Context, refreshID string) error {
+	var list AccessTokenList
+	if err := cli.list(resourceAccessToken, &list); err != nil {
+		return fmt.Errorf("failed to list access tokens: %v", err)
+	}
+
+	err := storage.ErrNotFound
+	for _, t := range list.AccessTokens {
+		if t.RefreshTokenID != refreshID {
+			continue
+		}
+		if err = cli.delete(resourceAccessToken, t.ObjectMeta.Name); err != nil && err != storage.ErrNotFound {
+			return fmt.Errorf("delete access token: %v", err)
+		}
+		err = nil
+	}
+	return err
+}
+
+func (cli *client) CreateInitialAccessToken(ctx context.Context, t storage.InitialAccessToken) error {
+	return cli.post(resourceInitialAccessToken, cli.fromStorageInitialAccessToken(t))
+}
+
+func (cli *client) GetInitialAccessToken(ctx context.Context, id string) (storage.InitialAccessToken, error) {
+	var t InitialAccessToken
+	if err := cli.get(resourceInitialAccessToken, id, &t); err != nil {
+		return storage.InitialAccessToken{}, err
+	}
+	return toStorageInitialAccessToken(t), nil
+}
+
+func (cli *client) DeleteInitialAccessToken(ctx context.Context, id string) error {
+	return cli.delete(resourceInitialAccessToken, id)
+}
+
+func (cli *client) CreateClientAssertion(ctx context.Context, a storage.ClientAssertion) error {
+	return cli.post(resourceClientAssertion, cli.fromStorageClientAssertion(a))
+}
+
+func (cli *client) GetClientAssertion(ctx context.Context, id string) (storage.ClientAssertion, error) {
+	var a ClientAssertion
+	if err := cli.get(resourceClientAssertion, id, &a); err != nil {
+		return storage.ClientAssertion{}, err
+	}
+	return toStorageClientAssertion(a), nil
+}
+
+func (cli *client) CreateDPoPProof(ctx context.Context, p storage.DPoPProof) error {
+	return cli.post(resourceDPoPProof, cli.fromStorageDPoPProof(p))
+}
+
+func (cli *client) GetDPoPProof(ctx context.Context, id string) (storage.DPoPProof, error) {
+	var p DPoPProof
+	if err := cli.get(resourceDPoPProof, id, &p); err != nil {
+		return storage.DPoPProof{}, err
+	}
+	return toStorageDPoPProof(p), nil
+}
+
+func (cli *client) CreateConsent(ctx context.Context, c storage.Consent) error {
+	return cli.post(resourceConsent, cli.fromStorageConsent(c))
+}
+
+func (cli *client) GetConsent(ctx context.Context, userID, connID, clientID string) (storage.Consent, error) {
+	c, err := cli.getConsent(userID, connID, clientID)
+	if err != nil {
+		return storage.Consent{}, err
+	}
+	return toStorageConsent(c), nil
+}
+
+func (cli *client) getConsent(userID, connID, clientID string) (c Consent, err error) {
+	if err = cli.get(resourceConsent, cli.consentName(userID, connID, clientID), &c); err != nil {
+		return Consent{}, err
+	}
+	if userID != c.UserID || connID != c.ConnID || clientID != c.ClientID {
+		return Consent{}, fmt.Errorf("get consent: wrong consent retrieved")
+	}
+	return c, nil
+}
+
+func (cli *client) ListConsents(ctx context.Context, userID, connID string) ([]storage.Consent, error) {
+	var list ConsentList
+	if err := cli.list(resourceConsent, &list); err != nil {
+		return nil, fmt.Errorf("failed to list consents: %v", err)
+	}
+
+	var consents []storage.Consent
+	for _, c := range list.Consents {
+		if c.UserID == userID && c.ConnID == connID {
+			consents = append(consents, toStorageConsent(c))
+		}
+	}
+	return consents, nil
+}
+
+func (cli *client) UpdateConsent(ctx context.Context, userID, connID, clientID string, updater func(c storage.Consent) (storage.Consent, error)) error {
+	return retryOnConflict(ctx, func() error {
+		c, err := cli.getConsent(userID, connID, clientID)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updater(toStorageConsent(c))
+		if err != nil {
+			return err
+		}
+
+		newConsent := cli.fromStorageConsent(updated)
+		newConsent.ObjectMeta = c.ObjectMeta
+		return cli.put(resourceConsent, c.ObjectMeta.Name, newConsent)
+	})
+}
+
+func (cli *client) DeleteConsent(ctx context.Context, userID, connID, clientID string) error {
+	c, err := cli.getConsent(userID, connID, clientID)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceConsent, c.ObjectMeta.Name)
+}
+
+func (cli *client) CreateScope(ctx context.Context, sc storage.Scope) error {
+	return cli.post(resourceScope, cli.fromStorageScope(sc))
+}
+
+func (cli *client) GetScope(ctx context.Context, name string) (storage.Scope, error) {
+	sc, err := cli.getScope(name)
+	if err != nil {
+		return storage.Scope{}, err
+	}
+	return toStorageScope(sc), nil
+}
+
+func (cli *client) getScope(name string) (Scope, error) {
+	var sc Scope
+	if err := cli.get(resourceScope, cli.idToName(name), &sc); err != nil {
+		return Scope{}, err
+	}
+	if sc.ScopeName != name {
+		return Scope{}, fmt.Errorf("get scope: name %q mapped to scope with name %q", name, sc.ScopeName)
+	}
+	return sc, nil
+}
+
+func (cli *client) ListScopes(ctx context.Context) ([]storage.Scope, error) {
+	var list ScopeList
+	if err := cli.list(resourceScope, &list); err != nil {
+		return nil, fmt.Errorf("failed to list scopes: %v", err)
+	}
+
+	scopes := make([]storage.Scope, len(list.Scopes))
+	for i, sc := range list.Scopes {
+		scopes[i] = toStorageScope(sc)
+	}
+	return scopes, nil
+}
+
+func (cli *client) UpdateScope(ctx context.Context, name string, updater func(sc storage.Scope) (storage.Scope, error)) error {
+	return retryOnConflict(ctx, func() error {
+		sc, err := cli.getScope(name)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updater(toStorageScope(sc))
+		if err != nil {
+			return err
+		}
+
+		newScope := cli.fromStorageScope(updated)
+		newScope.ObjectMeta = sc.ObjectMeta
+		return cli.put(resourceScope, sc.ObjectMeta.Name, newScope)
+	})
+}
+
+func (cli *client) DeleteScope(ctx context.Context, name string) error {
+	sc, err := cli.getScope(name)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceScope, sc.ObjectMeta.Name)
+}
@@ -72,6 +72,14 @@ func (s *StorageTestSuite) TestStorage() {
 			resourceAuthRequest,
 			resourceDeviceRequest,
 			resourceDeviceToken,
+			resourceUserSession,
+			resourceLogoutNotification,
+			resourceAccessToken,
+			resourceInitialAccessToken,
+			resourceClientAssertion,
+			resourceDPoPProof,
+			resourceConsent,
+			resourceScope,
 			resourceClient,
 			resourceRefreshToken,
 			resourceKeys,
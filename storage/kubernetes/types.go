@@ -226,6 +226,142 @@ func customResourceDefinitions(apiVersion string) []k8sapi.CustomResourceDefinit
 				},
 			},
 		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "usersessions.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "usersessions",
+					Singular: "usersession",
+					Kind:     "UserSession",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "logoutnotifications.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "logoutnotifications",
+					Singular: "logoutnotification",
+					Kind:     "LogoutNotification",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "accesstokens.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "accesstokens",
+					Singular: "accesstoken",
+					Kind:     "AccessToken",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "initialaccesstokens.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "initialaccesstokens",
+					Singular: "initialaccesstoken",
+					Kind:     "InitialAccessToken",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "clientassertions.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "clientassertions",
+					Singular: "clientassertion",
+					Kind:     "ClientAssertion",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "dpopproofs.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "dpopproofs",
+					Singular: "dpopproof",
+					Kind:     "DPoPProof",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "consents.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "consents",
+					Singular: "consent",
+					Kind:     "Consent",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "scopes.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "scopes",
+					Singular: "scope",
+					Kind:     "Scope",
+				},
+			},
+		},
 	}
 }
 
@@ -247,6 +383,37 @@ type Client struct {
 	RedirectURIs []string `json:"redirectURIs,omitempty"`
 	TrustedPeers []string `json:"trustedPeers,omitempty"`
 
+	PostLogoutRedirectURIs []string `json:"postLogoutRedirectURIs,omitempty"`
+	BackchannelLogoutURI   string   `json:"backchannelLogoutURI,omitempty"`
+
+	ClientCredentialsScopes    []string `json:"clientCredentialsScopes,omitempty"`
+	ClientCredentialsAudiences []string `json:"clientCredentialsAudiences,omitempty"`
+
+	AccessTokenFormat string `json:"accessTokenFormat,omitempty"`
+
+	ClaimMappings []storage.ClaimMapping `json:"claimMappings,omitempty"`
+
+	SubjectType         string `json:"subjectType,omitempty"`
+	SectorIdentifierURI string `json:"sectorIdentifierURI,omitempty"`
+
+	RequiredScopes []string `json:"requiredScopes,omitempty"`
+
+	Expiry *storage.ClientExpiry `json:"expiry,omitempty"`
+
+	AllowedConnectors    []string `json:"allowedConnectors,omitempty"`
+	AllowedGrantTypes    []string `json:"allowedGrantTypes,omitempty"`
+	AllowedResponseTypes []string `json:"allowedResponseTypes,omitempty"`
+	RequirePKCE          bool     `json:"requirePKCE,omitempty"`
+
+	RequirePushedAuthorizationRequests bool `json:"requirePushedAuthorizationRequests,omitempty"`
+
+	JWKS    string `json:"jwks,omitempty"`
+	JWKSURI string `json:"jwksURI,omitempty"`
+
+	RegistrationAccessTokenHash string `json:"registrationAccessTokenHash,omitempty"`
+	TokenEndpointAuthMethod     string `json:"tokenEndpointAuthMethod,omitempty"`
+	TLSClientAuthSubjectDN      string `json:"tlsClientAuthSubjectDN,omitempty"`
+
 	Public bool `json:"public"`
 
 	Name    string `json:"name,omitempty"`
@@ -270,36 +437,77 @@ func (cli *client) fromStorageClient(c storage.Client) Client {
 			Name:      cli.idToName(c.ID),
 			Namespace: cli.namespace,
 		},
-		ID:           c.ID,
-		Secret:       c.Secret,
-		RedirectURIs: c.RedirectURIs,
-		TrustedPeers: c.TrustedPeers,
-		Public:       c.Public,
-		Name:         c.Name,
-		LogoURL:      c.LogoURL,
+		ID:                                 c.ID,
+		Secret:                             c.Secret,
+		RedirectURIs:                       c.RedirectURIs,
+		TrustedPeers:                       c.TrustedPeers,
+		PostLogoutRedirectURIs:             c.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               c.BackchannelLogoutURI,
+		ClientCredentialsScopes:            c.ClientCredentialsScopes,
+		ClientCredentialsAudiences:         c.ClientCredentialsAudiences,
+		AccessTokenFormat:                  c.AccessTokenFormat,
+		ClaimMappings:                      c.ClaimMappings,
+		SubjectType:                        c.SubjectType,
+		SectorIdentifierURI:                c.SectorIdentifierURI,
+		RequiredScopes:                     c.RequiredScopes,
+		Expiry:                             c.Expiry,
+		AllowedConnectors:                  c.AllowedConnectors,
+		AllowedGrantTypes:                  c.AllowedGrantTypes,
+		AllowedResponseTypes:               c.AllowedResponseTypes,
+		RequirePKCE:                        c.RequirePKCE,
+		RequirePushedAuthorizationRequests: c.RequirePushedAuthorizationRequests,
+		JWKS:                               c.JWKS,
+		JWKSURI:                            c.JWKSURI,
+		RegistrationAccessTokenHash:        c.RegistrationAccessTokenHash,
+		TokenEndpointAuthMethod:            c.TokenEndpointAuthMethod,
+		TLSClientAuthSubjectDN:             c.TLSClientAuthSubjectDN,
+		Public:                             c.Public,
+		Name:                               c.Name,
+		LogoURL:                            c.LogoURL,
 	}
 }
 
 func toStorageClient(c Client) storage.Client {
 	return storage.Client{
-		ID:           c.ID,
-		Secret:       c.Secret,
-		RedirectURIs: c.RedirectURIs,
-		TrustedPeers: c.TrustedPeers,
-		Public:       c.Public,
-		Name:         c.Name,
-		LogoURL:      c.LogoURL,
+		ID:                                 c.ID,
+		Secret:                             c.Secret,
+		RedirectURIs:                       c.RedirectURIs,
+		TrustedPeers:                       c.TrustedPeers,
+		PostLogoutRedirectURIs:             c.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               c.BackchannelLogoutURI,
+		ClientCredentialsScopes:            c.ClientCredentialsScopes,
+		ClientCredentialsAudiences:         c.ClientCredentialsAudiences,
+		AccessTokenFormat:                  c.AccessTokenFormat,
+		ClaimMappings:                      c.ClaimMappings,
+		SubjectType:                        c.SubjectType,
+		SectorIdentifierURI:                c.SectorIdentifierURI,
+		RequiredScopes:                     c.RequiredScopes,
+		Expiry:                             c.Expiry,
+		AllowedConnectors:                  c.AllowedConnectors,
+		AllowedGrantTypes:                  c.AllowedGrantTypes,
+		AllowedResponseTypes:               c.AllowedResponseTypes,
+		RequirePKCE:                        c.RequirePKCE,
+		RequirePushedAuthorizationRequests: c.RequirePushedAuthorizationRequests,
+		JWKS:                               c.JWKS,
+		JWKSURI:                            c.JWKSURI,
+		RegistrationAccessTokenHash:        c.RegistrationAccessTokenHash,
+		TokenEndpointAuthMethod:            c.TokenEndpointAuthMethod,
+		TLSClientAuthSubjectDN:             c.TLSClientAuthSubjectDN,
+		Public:                             c.Public,
+		Name:                               c.Name,
+		LogoURL:                            c.LogoURL,
 	}
 }
 
 // Claims is a mirrored struct from storage with JSON struct tags.
 type Claims struct {
-	UserID            string   `json:"userID"`
-	Username          string   `json:"username"`
-	PreferredUsername string   `json:"preferredUsername"`
-	Email             string   `json:"email"`
-	EmailVerified     bool     `json:"emailVerified"`
-	Groups            []string `json:"groups,omitempty"`
+	UserID            string         `json:"userID"`
+	Username          string         `json:"username"`
+	PreferredUsername string         `json:"preferredUsername"`
+	Email             string         `json:"email"`
+	EmailVerified     bool           `json:"emailVerified"`
+	Groups            []string       `json:"groups,omitempty"`
+	ExtraClaims       map[string]any `json:"extraClaims,omitempty"`
 }
 
 func fromStorageClaims(i storage.Claims) Claims {
@@ -310,6 +518,7 @@ func fromStorageClaims(i storage.Claims) Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ExtraClaims:       i.ExtraClaims,
 	}
 }
 
@@ -321,6 +530,33 @@ func toStorageClaims(i Claims) storage.Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ExtraClaims:       i.ExtraClaims,
+	}
+}
+
+// Authentication is a mirrored struct from storage with JSON struct tags.
+type Authentication struct {
+	ACR       string    `json:"acr,omitempty"`
+	AMR       []string  `json:"amr,omitempty"`
+	Time      time.Time `json:"time"`
+	SessionID string    `json:"sessionID,omitempty"`
+}
+
+func fromStorageAuthentication(a storage.Authentication) Authentication {
+	return Authentication{
+		ACR:       a.ACR,
+		AMR:       a.AMR,
+		Time:      a.Time,
+		SessionID: a.SessionID,
+	}
+}
+
+func toStorageAuthentication(a Authentication) storage.Authentication {
+	return storage.Authentication{
+		ACR:       a.ACR,
+		AMR:       a.AMR,
+		Time:      a.Time,
+		SessionID: a.SessionID,
 	}
 }
 
@@ -347,7 +583,8 @@ type AuthRequest struct {
 
 	// The identity of the end user. Generally nil until the user authenticates
 	// with a backend.
-	Claims Claims `json:"claims,omitempty"`
+	Claims         Claims         `json:"claims,omitempty"`
+	Authentication Authentication `json:"authentication,omitempty"`
 	// The connector used to login the user. Set when the user authenticates.
 	ConnectorID   string `json:"connectorID,omitempty"`
 	ConnectorData []byte `json:"connectorData,omitempty"`
@@ -358,6 +595,12 @@ type AuthRequest struct {
 	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
 
 	HMACKey []byte `json:"hmac_key"`
+
+	Prompt    string   `json:"prompt,omitempty"`
+	MaxAge    int      `json:"maxAge"`
+	ACRValues []string `json:"acrValues,omitempty"`
+	LoginHint string   `json:"loginHint,omitempty"`
+	UILocales []string `json:"uiLocales,omitempty"`
 }
 
 // AuthRequestList is a list of AuthRequests.
@@ -382,11 +625,17 @@ func toStorageAuthRequest(req AuthRequest) storage.AuthRequest {
 		ConnectorData:       req.ConnectorData,
 		Expiry:              req.Expiry,
 		Claims:              toStorageClaims(req.Claims),
+		Authentication:      toStorageAuthentication(req.Authentication),
 		PKCE: storage.PKCE{
 			CodeChallenge:       req.CodeChallenge,
 			CodeChallengeMethod: req.CodeChallengeMethod,
 		},
-		HMACKey: req.HMACKey,
+		HMACKey:   req.HMACKey,
+		Prompt:    req.Prompt,
+		MaxAge:    req.MaxAge,
+		ACRValues: req.ACRValues,
+		LoginHint: req.LoginHint,
+		UILocales: req.UILocales,
 	}
 	return a
 }
@@ -413,9 +662,15 @@ func (cli *client) fromStorageAuthRequest(a storage.AuthRequest) AuthRequest {
 		ConnectorData:       a.ConnectorData,
 		Expiry:              a.Expiry,
 		Claims:              fromStorageClaims(a.Claims),
+		Authentication:      fromStorageAuthentication(a.Authentication),
 		CodeChallenge:       a.PKCE.CodeChallenge,
 		CodeChallengeMethod: a.PKCE.CodeChallengeMethod,
 		HMACKey:             a.HMACKey,
+		Prompt:              a.Prompt,
+		MaxAge:              a.MaxAge,
+		ACRValues:           a.ACRValues,
+		LoginHint:           a.LoginHint,
+		UILocales:           a.UILocales,
 	}
 	return req
 }
@@ -495,7 +750,8 @@ type AuthCode struct {
 	Nonce string `json:"nonce,omitempty"`
 	State string `json:"state,omitempty"`
 
-	Claims Claims `json:"claims,omitempty"`
+	Claims         Claims         `json:"claims,omitempty"`
+	Authentication Authentication `json:"authentication,omitempty"`
 
 	ConnectorID   string `json:"connectorID,omitempty"`
 	ConnectorData []byte `json:"connectorData,omitempty"`
@@ -530,6 +786,7 @@ func (cli *client) fromStorageAuthCode(a storage.AuthCode) AuthCode {
 		Nonce:               a.Nonce,
 		Scopes:              a.Scopes,
 		Claims:              fromStorageClaims(a.Claims),
+		Authentication:      fromStorageAuthentication(a.Authentication),
 		Expiry:              a.Expiry,
 		CodeChallenge:       a.PKCE.CodeChallenge,
 		CodeChallengeMethod: a.PKCE.CodeChallengeMethod,
@@ -538,15 +795,16 @@ func (cli *client) fromStorageAuthCode(a storage.AuthCode) AuthCode {
 
 func toStorageAuthCode(a AuthCode) storage.AuthCode {
 	return storage.AuthCode{
-		ID:            a.ObjectMeta.Name,
-		ClientID:      a.ClientID,
-		RedirectURI:   a.RedirectURI,
-		ConnectorID:   a.ConnectorID,
-		ConnectorData: a.ConnectorData,
-		Nonce:         a.Nonce,
-		Scopes:        a.Scopes,
-		Claims:        toStorageClaims(a.Claims),
-		Expiry:        a.Expiry,
+		ID:             a.ObjectMeta.Name,
+		ClientID:       a.ClientID,
+		RedirectURI:    a.RedirectURI,
+		ConnectorID:    a.ConnectorID,
+		ConnectorData:  a.ConnectorData,
+		Nonce:          a.Nonce,
+		Scopes:         a.Scopes,
+		Claims:         toStorageClaims(a.Claims),
+		Authentication: toStorageAuthentication(a.Authentication),
+		Expiry:         a.Expiry,
 		PKCE: storage.PKCE{
 			CodeChallenge:       a.CodeChallenge,
 			CodeChallengeMethod: a.CodeChallengeMethod,
@@ -571,9 +829,12 @@ type RefreshToken struct {
 
 	Nonce string `json:"nonce,omitempty"`
 
-	Claims        Claims `json:"claims,omitempty"`
-	ConnectorID   string `json:"connectorID,omitempty"`
-	ConnectorData []byte `json:"connectorData,omitempty"`
+	Claims         Claims         `json:"claims,omitempty"`
+	Authentication Authentication `json:"authentication,omitempty"`
+	ConnectorID    string         `json:"connectorID,omitempty"`
+	ConnectorData  []byte         `json:"connectorData,omitempty"`
+
+	DPoPKeyThumbprint string `json:"dpopKeyThumbprint,omitempty"`
 }
 
 // RefreshList is a list of refresh tokens.
@@ -585,17 +846,20 @@ type RefreshList struct {
 
 func toStorageRefreshToken(r RefreshToken) storage.RefreshToken {
 	return storage.RefreshToken{
-		ID:            r.ObjectMeta.Name,
-		Token:         r.Token,
-		ObsoleteToken: r.ObsoleteToken,
-		CreatedAt:     r.CreatedAt,
-		LastUsed:      r.LastUsed,
-		ClientID:      r.ClientID,
-		ConnectorID:   r.ConnectorID,
-		ConnectorData: r.ConnectorData,
-		Scopes:        r.Scopes,
-		Nonce:         r.Nonce,
-		Claims:        toStorageClaims(r.Claims),
+		ID:             r.ObjectMeta.Name,
+		Token:          r.Token,
+		ObsoleteToken:  r.ObsoleteToken,
+		CreatedAt:      r.CreatedAt,
+		LastUsed:       r.LastUsed,
+		ClientID:       r.ClientID,
+		ConnectorID:    r.ConnectorID,
+		ConnectorData:  r.ConnectorData,
+		Scopes:         r.Scopes,
+		Nonce:          r.Nonce,
+		Claims:         toStorageClaims(r.Claims),
+		Authentication: toStorageAuthentication(r.Authentication),
+
+		DPoPKeyThumbprint: r.DPoPKeyThumbprint,
 	}
 }
 
@@ -609,16 +873,19 @@ func (cli *client) fromStorageRefreshToken(r storage.RefreshToken) RefreshToken
 			Name:      r.ID,
 			Namespace: cli.namespace,
 		},
-		Token:         r.Token,
-		ObsoleteToken: r.ObsoleteToken,
-		CreatedAt:     r.CreatedAt,
-		LastUsed:      r.LastUsed,
-		ClientID:      r.ClientID,
-		ConnectorID:   r.ConnectorID,
-		ConnectorData: r.ConnectorData,
-		Scopes:        r.Scopes,
-		Nonce:         r.Nonce,
-		Claims:        fromStorageClaims(r.Claims),
+		Token:          r.Token,
+		ObsoleteToken:  r.ObsoleteToken,
+		CreatedAt:      r.CreatedAt,
+		LastUsed:       r.LastUsed,
+		ClientID:       r.ClientID,
+		ConnectorID:    r.ConnectorID,
+		ConnectorData:  r.ConnectorData,
+		Scopes:         r.Scopes,
+		Nonce:          r.Nonce,
+		Claims:         fromStorageClaims(r.Claims),
+		Authentication: fromStorageAuthentication(r.Authentication),
+
+		DPoPKeyThumbprint: r.DPoPKeyThumbprint,
 	}
 }
 
@@ -677,6 +944,7 @@ type OfflineSessions struct {
 	ConnID        string                              `json:"connID,omitempty"`
 	Refresh       map[string]*storage.RefreshTokenRef `json:"refresh,omitempty"`
 	ConnectorData []byte                              `json:"connectorData,omitempty"`
+	ExtraClaims   map[string]any                      `json:"extraClaims,omitempty"`
 }
 
 func (cli *client) fromStorageOfflineSessions(o storage.OfflineSessions) OfflineSessions {
@@ -693,6 +961,7 @@ func (cli *client) fromStorageOfflineSessions(o storage.OfflineSessions) Offline
 		ConnID:        o.ConnID,
 		Refresh:       o.Refresh,
 		ConnectorData: o.ConnectorData,
+		ExtraClaims:   o.ExtraClaims,
 	}
 }
 
@@ -702,6 +971,7 @@ func toStorageOfflineSessions(o OfflineSessions) storage.OfflineSessions {
 		ConnID:        o.ConnID,
 		Refresh:       o.Refresh,
 		ConnectorData: o.ConnectorData,
+		ExtraClaims:   o.ExtraClaims,
 	}
 	if s.Refresh == nil {
 		// Server code assumes this will be non-nil.
@@ -864,3 +1134,404 @@ func toStorageDeviceToken(t DeviceToken) storage.DeviceToken {
 		},
 	}
 }
+
+// UserSession is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type UserSession struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ConnectorID    string         `json:"connectorID,omitempty"`
+	ConnectorData  []byte         `json:"connectorData,omitempty"`
+	Claims         Claims         `json:"claims,omitempty"`
+	Authentication Authentication `json:"authentication,omitempty"`
+	ClientIDs      []string       `json:"clientIDs,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	LastUsed  time.Time `json:"lastUsed"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+// UserSessionList is a list of UserSessions.
+type UserSessionList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	UserSessions    []UserSession `json:"items"`
+}
+
+func (cli *client) fromStorageUserSession(s storage.UserSession) UserSession {
+	return UserSession{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindUserSession,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      s.ID,
+			Namespace: cli.namespace,
+		},
+		ConnectorID:    s.ConnectorID,
+		ConnectorData:  s.ConnectorData,
+		Claims:         fromStorageClaims(s.Claims),
+		Authentication: fromStorageAuthentication(s.Authentication),
+		ClientIDs:      s.ClientIDs,
+		CreatedAt:      s.CreatedAt,
+		LastUsed:       s.LastUsed,
+		Expiry:         s.Expiry,
+	}
+}
+
+func toStorageUserSession(s UserSession) storage.UserSession {
+	return storage.UserSession{
+		ID:             s.ObjectMeta.Name,
+		ConnectorID:    s.ConnectorID,
+		ConnectorData:  s.ConnectorData,
+		Claims:         toStorageClaims(s.Claims),
+		Authentication: toStorageAuthentication(s.Authentication),
+		ClientIDs:      s.ClientIDs,
+		CreatedAt:      s.CreatedAt,
+		LastUsed:       s.LastUsed,
+		Expiry:         s.Expiry,
+	}
+}
+
+// LogoutNotification is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type LogoutNotification struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ClientID  string `json:"clientID,omitempty"`
+	Subject   string `json:"subject,omitempty"`
+	SessionID string `json:"sessionID,omitempty"`
+
+	CreatedAt   time.Time `json:"createdAt"`
+	Attempts    int       `json:"attempts"`
+	NextAttempt time.Time `json:"nextAttempt"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// LogoutNotificationList is a list of LogoutNotifications.
+type LogoutNotificationList struct {
+	k8sapi.TypeMeta     `json:",inline"`
+	k8sapi.ListMeta     `json:"metadata,omitempty"`
+	LogoutNotifications []LogoutNotification `json:"items"`
+}
+
+func (cli *client) fromStorageLogoutNotification(n storage.LogoutNotification) LogoutNotification {
+	return LogoutNotification{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindLogoutNotification,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      n.ID,
+			Namespace: cli.namespace,
+		},
+		ClientID:    n.ClientID,
+		Subject:     n.Subject,
+		SessionID:   n.SessionID,
+		CreatedAt:   n.CreatedAt,
+		Attempts:    n.Attempts,
+		NextAttempt: n.NextAttempt,
+		Expiry:      n.Expiry,
+	}
+}
+
+func toStorageLogoutNotification(n LogoutNotification) storage.LogoutNotification {
+	return storage.LogoutNotification{
+		ID:          n.ObjectMeta.Name,
+		ClientID:    n.ClientID,
+		Subject:     n.Subject,
+		SessionID:   n.SessionID,
+		CreatedAt:   n.CreatedAt,
+		Attempts:    n.Attempts,
+		NextAttempt: n.NextAttempt,
+		Expiry:      n.Expiry,
+	}
+}
+
+// AccessToken is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type AccessToken struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ClientID string   `json:"clientID,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+	Audience []string `json:"audience,omitempty"`
+
+	ConnectorID string `json:"connectorID,omitempty"`
+	Claims      Claims `json:"claims,omitempty"`
+
+	RefreshTokenID string `json:"refreshTokenID,omitempty"`
+
+	CertificateThumbprint string `json:"certificateThumbprint,omitempty"`
+	DPoPKeyThumbprint     string `json:"dpopKeyThumbprint,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+// AccessTokenList is a list of AccessTokens.
+type AccessTokenList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	AccessTokens    []AccessToken `json:"items"`
+}
+
+func (cli *client) fromStorageAccessToken(t storage.AccessToken) AccessToken {
+	return AccessToken{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindAccessToken,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      t.ID,
+			Namespace: cli.namespace,
+		},
+		ClientID:    t.ClientID,
+		Scopes:      t.Scopes,
+		Audience:    t.Audience,
+		ConnectorID: t.ConnectorID,
+		Claims:      fromStorageClaims(t.Claims),
+
+		RefreshTokenID: t.RefreshTokenID,
+
+		CertificateThumbprint: t.CertificateThumbprint,
+		DPoPKeyThumbprint:     t.DPoPKeyThumbprint,
+		CreatedAt:             t.CreatedAt,
+		Expiry:                t.Expiry,
+	}
+}
+
+func toStorageAccessToken(t AccessToken) storage.AccessToken {
+	return storage.AccessToken{
+		ID:          t.ObjectMeta.Name,
+		ClientID:    t.ClientID,
+		Scopes:      t.Scopes,
+		Audience:    t.Audience,
+		ConnectorID: t.ConnectorID,
+		Claims:      toStorageClaims(t.Claims),
+
+		RefreshTokenID: t.RefreshTokenID,
+
+		CertificateThumbprint: t.CertificateThumbprint,
+		DPoPKeyThumbprint:     t.DPoPKeyThumbprint,
+		CreatedAt:             t.CreatedAt,
+		Expiry:                t.Expiry,
+	}
+}
+
+// InitialAccessToken is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type InitialAccessToken struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+// InitialAccessTokenList is a list of InitialAccessTokens.
+type InitialAccessTokenList struct {
+	k8sapi.TypeMeta     `json:",inline"`
+	k8sapi.ListMeta     `json:"metadata,omitempty"`
+	InitialAccessTokens []InitialAccessToken `json:"items"`
+}
+
+func (cli *client) fromStorageInitialAccessToken(t storage.InitialAccessToken) InitialAccessToken {
+	return InitialAccessToken{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindInitialAccessToken,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      t.ID,
+			Namespace: cli.namespace,
+		},
+		CreatedAt: t.CreatedAt,
+		Expiry:    t.Expiry,
+	}
+}
+
+func toStorageInitialAccessToken(t InitialAccessToken) storage.InitialAccessToken {
+	return storage.InitialAccessToken{
+		ID:        t.ObjectMeta.Name,
+		CreatedAt: t.CreatedAt,
+		Expiry:    t.Expiry,
+	}
+}
+
+// ClientAssertion is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type ClientAssertion struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ClientID string    `json:"clientID"`
+	Expiry   time.Time `json:"expiry"`
+}
+
+// ClientAssertionList is a list of ClientAssertions.
+type ClientAssertionList struct {
+	k8sapi.TypeMeta  `json:",inline"`
+	k8sapi.ListMeta  `json:"metadata,omitempty"`
+	ClientAssertions []ClientAssertion `json:"items"`
+}
+
+func (cli *client) fromStorageClientAssertion(a storage.ClientAssertion) ClientAssertion {
+	return ClientAssertion{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindClientAssertion,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      a.ID,
+			Namespace: cli.namespace,
+		},
+		ClientID: a.ClientID,
+		Expiry:   a.Expiry,
+	}
+}
+
+func toStorageClientAssertion(a ClientAssertion) storage.ClientAssertion {
+	return storage.ClientAssertion{
+		ID:       a.ObjectMeta.Name,
+		ClientID: a.ClientID,
+		Expiry:   a.Expiry,
+	}
+}
+
+// DPoPProof is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type DPoPProof struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	Expiry time.Time `json:"expiry"`
+}
+
+// DPoPProofList is a list of DPoPProofs.
+type DPoPProofList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	DPoPProofs      []DPoPProof `json:"items"`
+}
+
+func (cli *client) fromStorageDPoPProof(p storage.DPoPProof) DPoPProof {
+	return DPoPProof{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindDPoPProof,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      p.ID,
+			Namespace: cli.namespace,
+		},
+		Expiry: p.Expiry,
+	}
+}
+
+func toStorageDPoPProof(p DPoPProof) storage.DPoPProof {
+	return storage.DPoPProof{
+		ID:     p.ObjectMeta.Name,
+		Expiry: p.Expiry,
+	}
+}
+
+// Consent is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type Consent struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	UserID    string    `json:"userID,omitempty"`
+	ConnID    string    `json:"connID,omitempty"`
+	ClientID  string    `json:"clientID,omitempty"`
+	Scopes    []string  `json:"scopes,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ConsentList is a list of Consents.
+type ConsentList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	Consents        []Consent `json:"items"`
+}
+
+func (cli *client) fromStorageConsent(c storage.Consent) Consent {
+	return Consent{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindConsent,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.consentName(c.UserID, c.ConnectorID, c.ClientID),
+			Namespace: cli.namespace,
+		},
+		UserID:    c.UserID,
+		ConnID:    c.ConnectorID,
+		ClientID:  c.ClientID,
+		Scopes:    c.Scopes,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toStorageConsent(c Consent) storage.Consent {
+	return storage.Consent{
+		UserID:      c.UserID,
+		ConnectorID: c.ConnID,
+		ClientID:    c.ClientID,
+		Scopes:      c.Scopes,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// Scope is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type Scope struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	// ScopeName is the name of the scope, which Kubernetes names can't hold.
+	ScopeName    string            `json:"scopeName,omitempty"`
+	Descriptions map[string]string `json:"descriptions,omitempty"`
+	Claims       []string          `json:"claims,omitempty"`
+	ClientIDs    []string          `json:"clientIDs,omitempty"`
+}
+
+// ScopeList is a list of Scopes.
+type ScopeList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	Scopes          []Scope `json:"items"`
+}
+
+func (cli *client) fromStorageScope(sc storage.Scope) Scope {
+	return Scope{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindScope,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.idToName(sc.Name),
+			Namespace: cli.namespace,
+		},
+		ScopeName:    sc.Name,
+		Descriptions: sc.Descriptions,
+		Claims:       sc.Claims,
+		ClientIDs:    sc.ClientIDs,
+	}
+}
+
+func toStorageScope(sc Scope) storage.Scope {
+	return storage.Scope{
+		Name:         sc.ScopeName,
+		Descriptions: sc.Descriptions,
+		Claims:       sc.Claims,
+		ClientIDs:    sc.ClientIDs,
+	}
+}
@@ -16,16 +16,24 @@ var _ storage.Storage = (*memStorage)(nil)
 // New returns an in memory storage.
 func New(logger *slog.Logger) storage.Storage {
 	return &memStorage{
-		clients:         make(map[string]storage.Client),
-		authCodes:       make(map[string]storage.AuthCode),
-		refreshTokens:   make(map[string]storage.RefreshToken),
-		authReqs:        make(map[string]storage.AuthRequest),
-		passwords:       make(map[string]storage.Password),
-		offlineSessions: make(map[offlineSessionID]storage.OfflineSessions),
-		connectors:      make(map[string]storage.Connector),
-		deviceRequests:  make(map[string]storage.DeviceRequest),
-		deviceTokens:    make(map[string]storage.DeviceToken),
-		logger:          logger,
+		clients:             make(map[string]storage.Client),
+		authCodes:           make(map[string]storage.AuthCode),
+		refreshTokens:       make(map[string]storage.RefreshToken),
+		authReqs:            make(map[string]storage.AuthRequest),
+		passwords:           make(map[string]storage.Password),
+		offlineSessions:     make(map[offlineSessionID]storage.OfflineSessions),
+		connectors:          make(map[string]storage.Connector),
+		deviceRequests:      make(map[string]storage.DeviceRequest),
+		deviceTokens:        make(map[string]storage.DeviceToken),
+		userSessions:        make(map[string]storage.UserSession),
+		logoutNotifications: make(map[string]storage.LogoutNotification),
+		accessTokens:        make(map[string]storage.AccessToken),
+		initialAccessTokens: make(map[string]storage.InitialAccessToken),
+		clientAssertions:    make(map[string]storage.ClientAssertion),
+		dpopProofs:          make(map[string]storage.DPoPProof),
+		consents:            make(map[consentID]storage.Consent),
+		scopes:              make(map[string]storage.Scope),
+		logger:              logger,
 	}
 }
 
@@ -43,15 +51,23 @@ func (c *Config) Open(logger *slog.Logger) (storage.Storage, error) {
 type memStorage struct {
 	mu sync.Mutex
 
-	clients         map[string]storage.Client
-	authCodes       map[string]storage.AuthCode
-	refreshTokens   map[string]storage.RefreshToken
-	authReqs        map[string]storage.AuthRequest
-	passwords       map[string]storage.Password
-	offlineSessions map[offlineSessionID]storage.OfflineSessions
-	connectors      map[string]storage.Connector
-	deviceRequests  map[string]storage.DeviceRequest
-	deviceTokens    map[string]storage.DeviceToken
+	clients             map[string]storage.Client
+	authCodes           map[string]storage.AuthCode
+	refreshTokens       map[string]storage.RefreshToken
+	authReqs            map[string]storage.AuthRequest
+	passwords           map[string]storage.Password
+	offlineSessions     map[offlineSessionID]storage.OfflineSessions
+	connectors          map[string]storage.Connector
+	deviceRequests      map[string]storage.DeviceRequest
+	deviceTokens        map[string]storage.DeviceToken
+	userSessions        map[string]storage.UserSession
+	logoutNotifications map[string]storage.LogoutNotification
+	accessTokens        map[string]storage.AccessToken
+	initialAccessTokens map[string]storage.InitialAccessToken
+	clientAssertions    map[string]storage.ClientAssertion
+	dpopProofs          map[string]storage.DPoPProof
+	consents            map[consentID]storage.Consent
+	scopes              map[string]storage.Scope
 
 	keys storage.Keys
 
@@ -63,6 +79,12 @@ type offlineSessionID struct {
 	connID string
 }
 
+type consentID struct {
+	userID   string
+	connID   string
+	clientID string
+}
+
 func (s *memStorage) tx(f func()) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -97,6 +119,42 @@ func (s *memStorage) GarbageCollect(ctx context.Context, now time.Time) (result
 				result.DeviceTokens++
 			}
 		}
+		for id, a := range s.userSessions {
+			if now.After(a.Expiry) {
+				delete(s.userSessions, id)
+				result.UserSessions++
+			}
+		}
+		for id, n := range s.logoutNotifications {
+			if now.After(n.Expiry) {
+				delete(s.logoutNotifications, id)
+				result.LogoutNotifications++
+			}
+		}
+		for id, t := range s.accessTokens {
+			if now.After(t.Expiry) {
+				delete(s.accessTokens, id)
+				result.AccessTokens++
+			}
+		}
+		for id, t := range s.initialAccessTokens {
+			if now.After(t.Expiry) {
+				delete(s.initialAccessTokens, id)
+				result.InitialAccessTokens++
+			}
+		}
+		for id, a := range s.clientAssertions {
+			if now.After(a.Expiry) {
+				delete(s.clientAssertions, id)
+				result.ClientAssertions++
+			}
+		}
+		for id, p := range s.dpopProofs {
+			if now.After(p.Expiry) {
+				delete(s.dpopProofs, id)
+				result.DPoPProofs++
+			}
+		}
 	})
 	return result, nil
 }
@@ -541,3 +599,336 @@ func (s *memStorage) UpdateDeviceToken(ctx context.Context, deviceCode string, u
 	})
 	return
 }
+
+func (s *memStorage) CreateUserSession(ctx context.Context, u storage.UserSession) (err error) {
+	s.tx(func() {
+		if _, ok := s.userSessions[u.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.userSessions[u.ID] = u
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetUserSession(ctx context.Context, id string) (u storage.UserSession, err error) {
+	s.tx(func() {
+		var ok bool
+		if u, ok = s.userSessions[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateUserSession(ctx context.Context, id string, updater func(u storage.UserSession) (storage.UserSession, error)) (err error) {
+	s.tx(func() {
+		u, ok := s.userSessions[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if u, err = updater(u); err == nil {
+			s.userSessions[id] = u
+		}
+	})
+	return
+}
+
+func (s *memStorage) DeleteUserSession(ctx context.Context, id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.userSessions[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.userSessions, id)
+	})
+	return
+}
+
+func (s *memStorage) CreateLogoutNotification(ctx context.Context, n storage.LogoutNotification) (err error) {
+	s.tx(func() {
+		if _, ok := s.logoutNotifications[n.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.logoutNotifications[n.ID] = n
+		}
+	})
+	return
+}
+
+func (s *memStorage) ListLogoutNotifications(ctx context.Context) (notifications []storage.LogoutNotification, err error) {
+	s.tx(func() {
+		for _, n := range s.logoutNotifications {
+			notifications = append(notifications, n)
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateLogoutNotification(ctx context.Context, id string, updater func(n storage.LogoutNotification) (storage.LogoutNotification, error)) (err error) {
+	s.tx(func() {
+		n, ok := s.logoutNotifications[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if n, err = updater(n); err == nil {
+			s.logoutNotifications[id] = n
+		}
+	})
+	return
+}
+
+func (s *memStorage) DeleteLogoutNotification(ctx context.Context, id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.logoutNotifications[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.logoutNotifications, id)
+	})
+	return
+}
+
+func (s *memStorage) CreateAccessToken(ctx context.Context, t storage.AccessToken) (err error) {
+	s.tx(func() {
+		if _, ok := s.accessTokens[t.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.accessTokens[t.ID] = t
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetAccessToken(ctx context.Context, id string) (t storage.AccessToken, err error) {
+	s.tx(func() {
+		var ok bool
+		if t, ok = s.accessTokens[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) DeleteAccessToken(ctx context.Context, id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.accessTokens[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.accessTokens, id)
+	})
+	return
+}
+
+func (s *memStorage) DeleteRefreshAccessTokens(ctx context.Context, refreshID string) (err error) {
+	s.tx(func() {
+		err = storage.ErrNotFound
+		for id, t := range s.accessTokens {
+			if t.RefreshTokenID == refreshID {
+				delete(s.accessTokens, id)
+				err = nil
+			}
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateInitialAccessToken(ctx context.Context, t storage.InitialAccessToken) (err error) {
+	s.tx(func() {
+		if _, ok := s.initialAccessTokens[t.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.initialAccessTokens[t.ID] = t
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetInitialAccessToken(ctx context.Context, id string) (t storage.InitialAccessToken, err error) {
+	s.tx(func() {
+		var ok bool
+		if t, ok = s.initialAccessTokens[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) DeleteInitialAccessToken(ctx context.Context, id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.initialAccessTokens[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.initialAccessTokens, id)
+	})
+	return
+}
+
+func (s *memStorage) CreateClientAssertion(ctx context.Context, a storage.ClientAssertion) (err error) {
+	s.tx(func() {
+		if _, ok := s.clientAssertions[a.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.clientAssertions[a.ID] = a
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetClientAssertion(ctx context.Context, id string) (a storage.ClientAssertion, err error) {
+	s.tx(func() {
+		var ok bool
+		if a, ok = s.clientAssertions[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateDPoPProof(ctx context.Context, p storage.DPoPProof) (err error) {
+	s.tx(func() {
+		if _, ok := s.dpopProofs[p.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.dpopProofs[p.ID] = p
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetDPoPProof(ctx context.Context, id string) (p storage.DPoPProof, err error) {
+	s.tx(func() {
+		var ok bool
+		if p, ok = s.dpopProofs[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateConsent(ctx context.Context, c storage.Consent) (err error) {
+	id := consentID{userID: c.UserID, connID: c.ConnectorID, clientID: c.ClientID}
+	s.tx(func() {
+		if _, ok := s.consents[id]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.consents[id] = c
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetConsent(ctx context.Context, userID, connID, clientID string) (c storage.Consent, err error) {
+	id := consentID{userID: userID, connID: connID, clientID: clientID}
+	s.tx(func() {
+		var ok bool
+		if c, ok = s.consents[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) ListConsents(ctx context.Context, userID, connID string) (consents []storage.Consent, err error) {
+	s.tx(func() {
+		for id, c := range s.consents {
+			if id.userID == userID && id.connID == connID {
+				consents = append(consents, c)
+			}
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateConsent(ctx context.Context, userID, connID, clientID string, updater func(c storage.Consent) (storage.Consent, error)) (err error) {
+	id := consentID{userID: userID, connID: connID, clientID: clientID}
+	s.tx(func() {
+		c, ok := s.consents[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if c, err = updater(c); err == nil {
+			s.consents[id] = c
+		}
+	})
+	return
+}
+
+func (s *memStorage) DeleteConsent(ctx context.Context, userID, connID, clientID string) (err error) {
+	id := consentID{userID: userID, connID: connID, clientID: clientID}
+	s.tx(func() {
+		if _, ok := s.consents[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.consents, id)
+	})
+	return
+}
+
+func (s *memStorage) CreateScope(ctx context.Context, sc storage.Scope) (err error) {
+	s.tx(func() {
+		if _, ok := s.scopes[sc.Name]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.scopes[sc.Name] = sc
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetScope(ctx context.Context, name string) (sc storage.Scope, err error) {
+	s.tx(func() {
+		var ok bool
+		if sc, ok = s.scopes[name]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) ListScopes(ctx context.Context) (scopes []storage.Scope, err error) {
+	s.tx(func() {
+		for _, sc := range s.scopes {
+			scopes = append(scopes, sc)
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateScope(ctx context.Context, name string, updater func(sc storage.Scope) (storage.Scope, error)) (err error) {
+	s.tx(func() {
+		sc, ok := s.scopes[name]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if sc, err = updater(sc); err == nil {
+			s.scopes[name] = sc
+		}
+	})
+	return
+}
+
+func (s *memStorage) DeleteScope(ctx context.Context, name string) (err error) {
+	s.tx(func() {
+		if _, ok := s.scopes[name]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.scopes, name)
+	})
+	return
+}
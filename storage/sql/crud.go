@@ -121,6 +121,54 @@ func (c *conn) GarbageCollect(ctc context.Context, now time.Time) (storage.GCRes
 		result.DeviceTokens = n
 	}
 
+	r, err = c.Exec(`delete from user_session where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc user_session: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.UserSessions = n
+	}
+
+	r, err = c.Exec(`delete from logout_notification where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc logout_notification: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.LogoutNotifications = n
+	}
+
+	r, err = c.Exec(`delete from access_token where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc access_token: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.AccessTokens = n
+	}
+
+	r, err = c.Exec(`delete from initial_access_token where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc initial_access_token: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.InitialAccessTokens = n
+	}
+
+	r, err = c.Exec(`delete from client_assertion where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc client_assertion: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.ClientAssertions = n
+	}
+
+	r, err = c.Exec(`delete from dpop_proof where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc dpop_proof: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.DPoPProofs = n
+	}
+
 	return result, err
 }
 
@@ -134,10 +182,15 @@ func (c *conn) CreateAuthRequest(ctx context.Context, a storage.AuthRequest) err
 			connector_id, connector_data,
 			expiry,
 			code_challenge, code_challenge_method,
-			hmac_key
+			hmac_key,
+			prompt, max_age,
+			claims_extra,
+			acr_values, acr, amr, auth_time,
+			login_hint, ui_locales, sid
 		)
 		values (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24,
+			$25, $26, $27, $28, $29, $30, $31
 		);
 	`,
 		a.ID, a.ClientID, encoder(a.ResponseTypes), encoder(a.Scopes), a.RedirectURI, a.Nonce, a.State,
@@ -148,6 +201,10 @@ func (c *conn) CreateAuthRequest(ctx context.Context, a storage.AuthRequest) err
 		a.Expiry,
 		a.PKCE.CodeChallenge, a.PKCE.CodeChallengeMethod,
 		a.HMACKey,
+		a.Prompt, a.MaxAge,
+		encoder(a.Claims.ExtraClaims),
+		encoder(a.ACRValues), a.Authentication.ACR, encoder(a.Authentication.AMR), a.Authentication.Time,
+		a.LoginHint, encoder(a.UILocales), a.Authentication.SessionID,
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -180,8 +237,12 @@ func (c *conn) UpdateAuthRequest(ctx context.Context, id string, updater func(a
 				connector_id = $15, connector_data = $16,
 				expiry = $17,
 				code_challenge = $18, code_challenge_method = $19,
-				hmac_key = $20
-			where id = $21;
+				hmac_key = $20,
+				prompt = $21, max_age = $22,
+				claims_extra = $23,
+				acr_values = $24, acr = $25, amr = $26, auth_time = $27,
+				login_hint = $28, ui_locales = $29, sid = $30
+			where id = $31;
 		`,
 			a.ClientID, encoder(a.ResponseTypes), encoder(a.Scopes), a.RedirectURI, a.Nonce, a.State,
 			a.ForceApprovalPrompt, a.LoggedIn,
@@ -191,6 +252,10 @@ func (c *conn) UpdateAuthRequest(ctx context.Context, id string, updater func(a
 			a.ConnectorID, a.ConnectorData,
 			a.Expiry,
 			a.PKCE.CodeChallenge, a.PKCE.CodeChallengeMethod, a.HMACKey,
+			a.Prompt, a.MaxAge,
+			encoder(a.Claims.ExtraClaims),
+			encoder(a.ACRValues), a.Authentication.ACR, encoder(a.Authentication.AMR), a.Authentication.Time,
+			a.LoginHint, encoder(a.UILocales), a.Authentication.SessionID,
 			r.ID,
 		)
 		if err != nil {
@@ -212,7 +277,11 @@ func getAuthRequest(ctx context.Context, q querier, id string) (a storage.AuthRe
 			claims_user_id, claims_username, claims_preferred_username,
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data, expiry,
-			code_challenge, code_challenge_method, hmac_key
+			code_challenge, code_challenge_method, hmac_key,
+			prompt, max_age,
+			claims_extra,
+			acr_values, acr, amr, auth_time,
+			login_hint, ui_locales, sid
 		from auth_request where id = $1;
 	`, id).Scan(
 		&a.ID, &a.ClientID, decoder(&a.ResponseTypes), decoder(&a.Scopes), &a.RedirectURI, &a.Nonce, &a.State,
@@ -222,6 +291,10 @@ func getAuthRequest(ctx context.Context, q querier, id string) (a storage.AuthRe
 		decoder(&a.Claims.Groups),
 		&a.ConnectorID, &a.ConnectorData, &a.Expiry,
 		&a.PKCE.CodeChallenge, &a.PKCE.CodeChallengeMethod, &a.HMACKey,
+		&a.Prompt, &a.MaxAge,
+		decoder(&a.Claims.ExtraClaims),
+		decoder(&a.ACRValues), &a.Authentication.ACR, decoder(&a.Authentication.AMR), &a.Authentication.Time,
+		&a.LoginHint, decoder(&a.UILocales), &a.Authentication.SessionID,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -240,14 +313,18 @@ func (c *conn) CreateAuthCode(ctx context.Context, a storage.AuthCode) error {
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
 			expiry,
-			code_challenge, code_challenge_method
+			code_challenge, code_challenge_method,
+			claims_extra,
+			acr, amr, auth_time, sid
 		)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21);
 	`,
 		a.ID, a.ClientID, encoder(a.Scopes), a.Nonce, a.RedirectURI, a.Claims.UserID,
 		a.Claims.Username, a.Claims.PreferredUsername, a.Claims.Email, a.Claims.EmailVerified,
 		encoder(a.Claims.Groups), a.ConnectorID, a.ConnectorData, a.Expiry,
 		a.PKCE.CodeChallenge, a.PKCE.CodeChallengeMethod,
+		encoder(a.Claims.ExtraClaims),
+		a.Authentication.ACR, encoder(a.Authentication.AMR), a.Authentication.Time, a.Authentication.SessionID,
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -266,13 +343,17 @@ func (c *conn) GetAuthCode(ctx context.Context, id string) (a storage.AuthCode,
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
 			expiry,
-			code_challenge, code_challenge_method
+			code_challenge, code_challenge_method,
+			claims_extra,
+			acr, amr, auth_time, sid
 		from auth_code where id = $1;
 	`, id).Scan(
 		&a.ID, &a.ClientID, decoder(&a.Scopes), &a.Nonce, &a.RedirectURI, &a.Claims.UserID,
 		&a.Claims.Username, &a.Claims.PreferredUsername, &a.Claims.Email, &a.Claims.EmailVerified,
 		decoder(&a.Claims.Groups), &a.ConnectorID, &a.ConnectorData, &a.Expiry,
 		&a.PKCE.CodeChallenge, &a.PKCE.CodeChallengeMethod,
+		decoder(&a.Claims.ExtraClaims),
+		&a.Authentication.ACR, decoder(&a.Authentication.AMR), &a.Authentication.Time, &a.Authentication.SessionID,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -290,9 +371,11 @@ func (c *conn) CreateRefresh(ctx context.Context, r storage.RefreshToken) error
 			claims_user_id, claims_username, claims_preferred_username,
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
-			token, obsolete_token, created_at, last_used
+			token, obsolete_token, created_at, last_used,
+			dpop_key_thumbprint, claims_extra,
+			acr, amr, auth_time, sid
 		)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22);
 	`,
 		r.ID, r.ClientID, encoder(r.Scopes), r.Nonce,
 		r.Claims.UserID, r.Claims.Username, r.Claims.PreferredUsername,
@@ -300,6 +383,8 @@ func (c *conn) CreateRefresh(ctx context.Context, r storage.RefreshToken) error
 		encoder(r.Claims.Groups),
 		r.ConnectorID, r.ConnectorData,
 		r.Token, r.ObsoleteToken, r.CreatedAt, r.LastUsed,
+		r.DPoPKeyThumbprint, encoder(r.Claims.ExtraClaims),
+		r.Authentication.ACR, encoder(r.Authentication.AMR), r.Authentication.Time, r.Authentication.SessionID,
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -336,16 +421,25 @@ func (c *conn) UpdateRefreshToken(ctx context.Context, id string, updater func(o
 				token = $12,
                 obsolete_token = $13,
 				created_at = $14,
-				last_used = $15
+				last_used = $15,
+				dpop_key_thumbprint = $16,
+				claims_extra = $17,
+				acr = $18,
+				amr = $19,
+				auth_time = $20,
+				sid = $21
 			where
-				id = $16
+				id = $22
 		`,
 			r.ClientID, encoder(r.Scopes), r.Nonce,
 			r.Claims.UserID, r.Claims.Username, r.Claims.PreferredUsername,
 			r.Claims.Email, r.Claims.EmailVerified,
 			encoder(r.Claims.Groups),
 			r.ConnectorID, r.ConnectorData,
-			r.Token, r.ObsoleteToken, r.CreatedAt, r.LastUsed, id,
+			r.Token, r.ObsoleteToken, r.CreatedAt, r.LastUsed,
+			r.DPoPKeyThumbprint, encoder(r.Claims.ExtraClaims),
+			r.Authentication.ACR, encoder(r.Authentication.AMR), r.Authentication.Time, r.Authentication.SessionID,
+			id,
 		)
 		if err != nil {
 			return fmt.Errorf("update refresh token: %v", err)
@@ -366,7 +460,9 @@ func getRefresh(ctx context.Context, q querier, id string) (storage.RefreshToken
 			claims_email, claims_email_verified,
 			claims_groups,
 			connector_id, connector_data,
-			token, obsolete_token, created_at, last_used
+			token, obsolete_token, created_at, last_used,
+			dpop_key_thumbprint, claims_extra,
+			acr, amr, auth_time, sid
 		from refresh_token where id = $1;
 	`, id))
 }
@@ -378,7 +474,9 @@ func (c *conn) ListRefreshTokens(ctx context.Context) ([]storage.RefreshToken, e
 			claims_user_id, claims_username, claims_preferred_username,
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
-			token, obsolete_token, created_at, last_used
+			token, obsolete_token, created_at, last_used,
+			dpop_key_thumbprint, claims_extra,
+			acr, amr, auth_time, sid
 		from refresh_token;
 	`)
 	if err != nil {
@@ -408,6 +506,8 @@ func scanRefresh(s scanner) (r storage.RefreshToken, err error) {
 		decoder(&r.Claims.Groups),
 		&r.ConnectorID, &r.ConnectorData,
 		&r.Token, &r.ObsoleteToken, &r.CreatedAt, &r.LastUsed,
+		&r.DPoPKeyThumbprint, decoder(&r.Claims.ExtraClaims),
+		&r.Authentication.ACR, decoder(&r.Authentication.AMR), &r.Authentication.Time, &r.Authentication.SessionID,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -513,9 +613,35 @@ func (c *conn) UpdateClient(ctx context.Context, id string, updater func(old sto
 				trusted_peers = $3,
 				public = $4,
 				name = $5,
-				logo_url = $6
-			where id = $7;
-		`, nc.Secret, encoder(nc.RedirectURIs), encoder(nc.TrustedPeers), nc.Public, nc.Name, nc.LogoURL, id,
+				logo_url = $6,
+				post_logout_redirect_uris = $7,
+				backchannel_logout_uri = $8,
+				client_credentials_scopes = $9,
+				client_credentials_audiences = $10,
+				access_token_format = $11,
+				require_pushed_authorization_requests = $12,
+				jwks = $13, jwks_uri = $14,
+				registration_access_token_hash = $15,
+				token_endpoint_auth_method = $16,
+				tls_client_auth_subject_dn = $17,
+				claim_mappings = $18,
+				subject_type = $19, sector_identifier_uri = $20,
+				required_scopes = $21,
+				expiry = $22,
+				allowed_connectors = $23, allowed_grant_types = $24,
+				allowed_response_types = $25, require_pkce = $26
+			where id = $27;
+		`, nc.Secret, encoder(nc.RedirectURIs), encoder(nc.TrustedPeers), nc.Public, nc.Name, nc.LogoURL,
+			encoder(nc.PostLogoutRedirectURIs), nc.BackchannelLogoutURI,
+			encoder(nc.ClientCredentialsScopes), encoder(nc.ClientCredentialsAudiences),
+			nc.AccessTokenFormat, nc.RequirePushedAuthorizationRequests,
+			nc.JWKS, nc.JWKSURI, nc.RegistrationAccessTokenHash,
+			nc.TokenEndpointAuthMethod, nc.TLSClientAuthSubjectDN,
+			encoder(nc.ClaimMappings),
+			nc.SubjectType, nc.SectorIdentifierURI,
+			encoder(nc.RequiredScopes), encoder(nc.Expiry),
+			encoder(nc.AllowedConnectors), encoder(nc.AllowedGrantTypes),
+			encoder(nc.AllowedResponseTypes), nc.RequirePKCE, id,
 		)
 		if err != nil {
 			return fmt.Errorf("update client: %v", err)
@@ -527,12 +653,32 @@ func (c *conn) UpdateClient(ctx context.Context, id string, updater func(old sto
 func (c *conn) CreateClient(ctx context.Context, cli storage.Client) error {
 	_, err := c.Exec(`
 		insert into client (
-			id, secret, redirect_uris, trusted_peers, public, name, logo_url
+			id, secret, redirect_uris, trusted_peers, public, name, logo_url,
+			post_logout_redirect_uris, backchannel_logout_uri,
+			client_credentials_scopes, client_credentials_audiences,
+			access_token_format, require_pushed_authorization_requests,
+			jwks, jwks_uri, registration_access_token_hash,
+			token_endpoint_auth_method, tls_client_auth_subject_dn,
+			claim_mappings,
+			subject_type, sector_identifier_uri,
+			required_scopes, expiry,
+			allowed_connectors, allowed_grant_types,
+			allowed_response_types, require_pkce
 		)
-		values ($1, $2, $3, $4, $5, $6, $7);
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27);
 	`,
 		cli.ID, cli.Secret, encoder(cli.RedirectURIs), encoder(cli.TrustedPeers),
-		cli.Public, cli.Name, cli.LogoURL,
+		cli.Public, cli.Name, cli.LogoURL, encoder(cli.PostLogoutRedirectURIs),
+		cli.BackchannelLogoutURI,
+		encoder(cli.ClientCredentialsScopes), encoder(cli.ClientCredentialsAudiences),
+		cli.AccessTokenFormat, cli.RequirePushedAuthorizationRequests,
+		cli.JWKS, cli.JWKSURI, cli.RegistrationAccessTokenHash,
+		cli.TokenEndpointAuthMethod, cli.TLSClientAuthSubjectDN,
+		encoder(cli.ClaimMappings),
+		cli.SubjectType, cli.SectorIdentifierURI,
+		encoder(cli.RequiredScopes), encoder(cli.Expiry),
+		encoder(cli.AllowedConnectors), encoder(cli.AllowedGrantTypes),
+		encoder(cli.AllowedResponseTypes), cli.RequirePKCE,
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -546,7 +692,17 @@ func (c *conn) CreateClient(ctx context.Context, cli storage.Client) error {
 func getClient(ctx context.Context, q querier, id string) (storage.Client, error) {
 	return scanClient(q.QueryRow(`
 		select
-			id, secret, redirect_uris, trusted_peers, public, name, logo_url
+			id, secret, redirect_uris, trusted_peers, public, name, logo_url,
+			post_logout_redirect_uris, backchannel_logout_uri,
+			client_credentials_scopes, client_credentials_audiences,
+			access_token_format, require_pushed_authorization_requests,
+			jwks, jwks_uri, registration_access_token_hash,
+			token_endpoint_auth_method, tls_client_auth_subject_dn,
+			claim_mappings,
+			subject_type, sector_identifier_uri,
+			required_scopes, expiry,
+			allowed_connectors, allowed_grant_types,
+			allowed_response_types, require_pkce
 	    from client where id = $1;
 	`, id))
 }
@@ -558,7 +714,17 @@ func (c *conn) GetClient(ctx context.Context, id string) (storage.Client, error)
 func (c *conn) ListClients(ctx context.Context) ([]storage.Client, error) {
 	rows, err := c.Query(`
 		select
-			id, secret, redirect_uris, trusted_peers, public, name, logo_url
+			id, secret, redirect_uris, trusted_peers, public, name, logo_url,
+			post_logout_redirect_uris, backchannel_logout_uri,
+			client_credentials_scopes, client_credentials_audiences,
+			access_token_format, require_pushed_authorization_requests,
+			jwks, jwks_uri, registration_access_token_hash,
+			token_endpoint_auth_method, tls_client_auth_subject_dn,
+			claim_mappings,
+			subject_type, sector_identifier_uri,
+			required_scopes, expiry,
+			allowed_connectors, allowed_grant_types,
+			allowed_response_types, require_pkce
 		from client;
 	`)
 	if err != nil {
@@ -583,7 +749,17 @@ func (c *conn) ListClients(ctx context.Context) ([]storage.Client, error) {
 func scanClient(s scanner) (cli storage.Client, err error) {
 	err = s.Scan(
 		&cli.ID, &cli.Secret, decoder(&cli.RedirectURIs), decoder(&cli.TrustedPeers),
-		&cli.Public, &cli.Name, &cli.LogoURL,
+		&cli.Public, &cli.Name, &cli.LogoURL, decoder(&cli.PostLogoutRedirectURIs),
+		&cli.BackchannelLogoutURI,
+		decoder(&cli.ClientCredentialsScopes), decoder(&cli.ClientCredentialsAudiences),
+		&cli.AccessTokenFormat, &cli.RequirePushedAuthorizationRequests,
+		&cli.JWKS, &cli.JWKSURI, &cli.RegistrationAccessTokenHash,
+		&cli.TokenEndpointAuthMethod, &cli.TLSClientAuthSubjectDN,
+		decoder(&cli.ClaimMappings),
+		&cli.SubjectType, &cli.SectorIdentifierURI,
+		decoder(&cli.RequiredScopes), decoder(&cli.Expiry),
+		decoder(&cli.AllowedConnectors), decoder(&cli.AllowedGrantTypes),
+		decoder(&cli.AllowedResponseTypes), &cli.RequirePKCE,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -698,13 +874,13 @@ func scanPassword(s scanner) (p storage.Password, err error) {
 func (c *conn) CreateOfflineSessions(ctx context.Context, s storage.OfflineSessions) error {
 	_, err := c.Exec(`
 		insert into offline_session (
-			user_id, conn_id, refresh, connector_data
+			user_id, conn_id, refresh, connector_data, extra_claims
 		)
 		values (
-			$1, $2, $3, $4
+			$1, $2, $3, $4, $5
 		);
 	`,
-		s.UserID, s.ConnID, encoder(s.Refresh), s.ConnectorData,
+		s.UserID, s.ConnID, encoder(s.Refresh), s.ConnectorData, encoder(s.ExtraClaims),
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -730,10 +906,11 @@ func (c *conn) UpdateOfflineSessions(ctx context.Context, userID string, connID
 			update offline_session
 			set
 				refresh = $1,
-				connector_data = $2
-			where user_id = $3 AND conn_id = $4;
+				connector_data = $2,
+				extra_claims = $3
+			where user_id = $4 AND conn_id = $5;
 		`,
-			encoder(newSession.Refresh), newSession.ConnectorData, s.UserID, s.ConnID,
+			encoder(newSession.Refresh), newSession.ConnectorData, encoder(newSession.ExtraClaims), s.UserID, s.ConnID,
 		)
 		if err != nil {
 			return fmt.Errorf("update offline session: %v", err)
@@ -749,7 +926,7 @@ func (c *conn) GetOfflineSessions(ctx context.Context, userID string, connID str
 func getOfflineSessions(ctx context.Context, q querier, userID string, connID string) (storage.OfflineSessions, error) {
 	return scanOfflineSessions(q.QueryRow(`
 		select
-			user_id, conn_id, refresh, connector_data
+			user_id, conn_id, refresh, connector_data, extra_claims
 		from offline_session
 		where user_id = $1 AND conn_id = $2;
 		`, userID, connID))
@@ -757,7 +934,7 @@ func getOfflineSessions(ctx context.Context, q querier, userID string, connID st
 
 func scanOfflineSessions(s scanner) (o storage.OfflineSessions, err error) {
 	err = s.Scan(
-		&o.UserID, &o.ConnID, decoder(&o.Refresh), &o.ConnectorData,
+		&o.UserID, &o.ConnID, decoder(&o.Refresh), &o.ConnectorData, decoder(&o.ExtraClaims),
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -888,6 +1065,10 @@ func (c *conn) DeletePassword(ctx context.Context, email string) error {
 	return c.delete("password", "email", strings.ToLower(email))
 }
 
+func (c *conn) DeleteUserSession(ctx context.Context, id string) error {
+	return c.delete("user_session", "id", id)
+}
+
 func (c *conn) DeleteConnector(ctx context.Context, id string) error {
 	return c.delete("connector", "id", id)
 }
@@ -1040,3 +1221,579 @@ func (c *conn) UpdateDeviceToken(ctx context.Context, deviceCode string, updater
 		return nil
 	})
 }
+
+func (c *conn) CreateUserSession(ctx context.Context, u storage.UserSession) error {
+	_, err := c.Exec(`
+		insert into user_session (
+			id, connector_id, connector_data,
+			claims_user_id, claims_username, claims_preferred_username,
+			claims_email, claims_email_verified, claims_groups,
+			created_at, last_used, expiry,
+			claims_extra,
+			acr, amr, auth_time, sid,
+			client_ids
+		)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18);
+	`,
+		u.ID, u.ConnectorID, u.ConnectorData,
+		u.Claims.UserID, u.Claims.Username, u.Claims.PreferredUsername,
+		u.Claims.Email, u.Claims.EmailVerified, encoder(u.Claims.Groups),
+		u.CreatedAt, u.LastUsed, u.Expiry,
+		encoder(u.Claims.ExtraClaims),
+		u.Authentication.ACR, encoder(u.Authentication.AMR), u.Authentication.Time, u.Authentication.SessionID,
+		encoder(u.ClientIDs),
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert user session: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetUserSession(ctx context.Context, id string) (storage.UserSession, error) {
+	return getUserSession(ctx, c, id)
+}
+
+func getUserSession(ctx context.Context, q querier, id string) (u storage.UserSession, err error) {
+	err = q.QueryRow(`
+		select
+			id, connector_id, connector_data,
+			claims_user_id, claims_username, claims_preferred_username,
+			claims_email, claims_email_verified, claims_groups,
+			created_at, last_used, expiry,
+			claims_extra,
+			acr, amr, auth_time, sid,
+			client_ids
+		from user_session where id = $1;
+	`, id).Scan(
+		&u.ID, &u.ConnectorID, &u.ConnectorData,
+		&u.Claims.UserID, &u.Claims.Username, &u.Claims.PreferredUsername,
+		&u.Claims.Email, &u.Claims.EmailVerified, decoder(&u.Claims.Groups),
+		&u.CreatedAt, &u.LastUsed, &u.Expiry,
+		decoder(&u.Claims.ExtraClaims),
+		&u.Authentication.ACR, decoder(&u.Authentication.AMR), &u.Authentication.Time, &u.Authentication.SessionID,
+		decoder(&u.ClientIDs),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return u, storage.ErrNotFound
+		}
+		return u, fmt.Errorf("select user session: %v", err)
+	}
+	return u, nil
+}
+
+func (c *conn) UpdateUserSession(ctx context.Context, id string, updater func(old storage.UserSession) (storage.UserSession, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		u, err := getUserSession(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if u, err = updater(u); err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update user_session
+			set
+				connector_id = $1,
+				connector_data = $2,
+				claims_user_id = $3,
+				claims_username = $4,
+				claims_preferred_username = $5,
+				claims_email = $6,
+				claims_email_verified = $7,
+				claims_groups = $8,
+				created_at = $9,
+				last_used = $10,
+				expiry = $11,
+				claims_extra = $12,
+				acr = $13,
+				amr = $14,
+				auth_time = $15,
+				sid = $16,
+				client_ids = $17
+			where
+				id = $18
+		`,
+			u.ConnectorID, u.ConnectorData,
+			u.Claims.UserID, u.Claims.Username, u.Claims.PreferredUsername,
+			u.Claims.Email, u.Claims.EmailVerified, encoder(u.Claims.Groups),
+			u.CreatedAt, u.LastUsed, u.Expiry,
+			encoder(u.Claims.ExtraClaims),
+			u.Authentication.ACR, encoder(u.Authentication.AMR), u.Authentication.Time, u.Authentication.SessionID,
+			encoder(u.ClientIDs),
+			id,
+		)
+		if err != nil {
+			return fmt.Errorf("update user session: %v", err)
+		}
+		return nil
+	})
+}
+
+func (c *conn) CreateLogoutNotification(ctx context.Context, n storage.LogoutNotification) error {
+	_, err := c.Exec(`
+		insert into logout_notification (
+			id, client_id, subject, session_id, created_at, attempts, next_attempt, expiry
+		)
+		values ($1, $2, $3, $4, $5, $6, $7, $8);
+	`,
+		n.ID, n.ClientID, n.Subject, n.SessionID, n.CreatedAt, n.Attempts, n.NextAttempt, n.Expiry,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert logout notification: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) ListLogoutNotifications(ctx context.Context) ([]storage.LogoutNotification, error) {
+	rows, err := c.Query(`
+		select
+			id, client_id, subject, session_id, created_at, attempts, next_attempt, expiry
+		from logout_notification;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []storage.LogoutNotification
+	for rows.Next() {
+		n, err := scanLogoutNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func getLogoutNotification(ctx context.Context, q querier, id string) (storage.LogoutNotification, error) {
+	return scanLogoutNotification(q.QueryRow(`
+		select
+			id, client_id, subject, session_id, created_at, attempts, next_attempt, expiry
+		from logout_notification where id = $1;
+	`, id))
+}
+
+func scanLogoutNotification(s scanner) (n storage.LogoutNotification, err error) {
+	err = s.Scan(
+		&n.ID, &n.ClientID, &n.Subject, &n.SessionID, &n.CreatedAt, &n.Attempts, &n.NextAttempt, &n.Expiry,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return n, storage.ErrNotFound
+		}
+		return n, fmt.Errorf("select logout notification: %v", err)
+	}
+	return n, nil
+}
+
+func (c *conn) UpdateLogoutNotification(ctx context.Context, id string, updater func(old storage.LogoutNotification) (storage.LogoutNotification, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		n, err := getLogoutNotification(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if n, err = updater(n); err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update logout_notification
+			set
+				client_id = $1,
+				subject = $2,
+				session_id = $3,
+				created_at = $4,
+				attempts = $5,
+				next_attempt = $6,
+				expiry = $7
+			where
+				id = $8
+		`,
+			n.ClientID, n.Subject, n.SessionID, n.CreatedAt, n.Attempts, n.NextAttempt, n.Expiry,
+			id,
+		)
+		if err != nil {
+			return fmt.Errorf("update logout notification: %v", err)
+		}
+		return nil
+	})
+}
+
+func (c *conn) DeleteLogoutNotification(ctx context.Context, id string) error {
+	return c.delete("logout_notification", "id", id)
+}
+
+func (c *conn) CreateAccessToken(ctx context.Context, t storage.AccessToken) error {
+	_, err := c.Exec(`
+		insert into access_token (
+			id, client_id, scopes, audience, connector_id,
+			claims_user_id, claims_username, claims_preferred_username,
+			claims_email, claims_email_verified, claims_groups,
+			certificate_thumbprint, dpop_key_thumbprint, created_at, expiry,
+			claims_extra, refresh_token_id
+		)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
+	`,
+		t.ID, t.ClientID, encoder(t.Scopes), encoder(t.Audience), t.ConnectorID,
+		t.Claims.UserID, t.Claims.Username, t.Claims.PreferredUsername,
+		t.Claims.Email, t.Claims.EmailVerified, encoder(t.Claims.Groups),
+		t.CertificateThumbprint, t.DPoPKeyThumbprint, t.CreatedAt, t.Expiry,
+		encoder(t.Claims.ExtraClaims), t.RefreshTokenID,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert access token: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetAccessToken(ctx context.Context, id string) (t storage.AccessToken, err error) {
+	err = c.QueryRow(`
+		select
+			id, client_id, scopes, audience, connector_id,
+			claims_user_id, claims_username, claims_preferred_username,
+			claims_email, claims_email_verified, claims_groups,
+			certificate_thumbprint, dpop_key_thumbprint, created_at, expiry,
+			claims_extra, refresh_token_id
+		from access_token where id = $1;
+	`, id).Scan(
+		&t.ID, &t.ClientID, decoder(&t.Scopes), decoder(&t.Audience), &t.ConnectorID,
+		&t.Claims.UserID, &t.Claims.Username, &t.Claims.PreferredUsername,
+		&t.Claims.Email, &t.Claims.EmailVerified, decoder(&t.Claims.Groups),
+		&t.CertificateThumbprint, &t.DPoPKeyThumbprint, &t.CreatedAt, &t.Expiry,
+		decoder(&t.Claims.ExtraClaims), &t.RefreshTokenID,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return t, storage.ErrNotFound
+		}
+		return t, fmt.Errorf("select access token: %v", err)
+	}
+	return t, nil
+}
+
+func (c *conn) DeleteAccessToken(ctx context.Context, id string) error {
+	return c.delete("access_token", "id", id)
+}
+
+func (c *conn) DeleteRefreshAccessTokens(ctx context.Context, refreshID string) error {
+	return c.delete("access_token", "refresh_token_id", refreshID)
+}
+
+func (c *conn) CreateInitialAccessToken(ctx context.Context, t storage.InitialAccessToken) error {
+	_, err := c.Exec(`
+		insert into initial_access_token (id, created_at, expiry)
+		values ($1, $2, $3);
+	`, t.ID, t.CreatedAt, t.Expiry)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert initial access token: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetInitialAccessToken(ctx context.Context, id string) (t storage.InitialAccessToken, err error) {
+	err = c.QueryRow(`
+		select id, created_at, expiry
+		from initial_access_token where id = $1;
+	`, id).Scan(&t.ID, &t.CreatedAt, &t.Expiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return t, storage.ErrNotFound
+		}
+		return t, fmt.Errorf("select initial access token: %v", err)
+	}
+	return t, nil
+}
+
+func (c *conn) DeleteInitialAccessToken(ctx context.Context, id string) error {
+	return c.delete("initial_access_token", "id", id)
+}
+
+func (c *conn) CreateClientAssertion(ctx context.Context, a storage.ClientAssertion) error {
+	_, err := c.Exec(`
+		insert into client_assertion (id, client_id, expiry)
+		values ($1, $2, $3);
+	`, a.ID, a.ClientID, a.Expiry)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert client assertion: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetClientAssertion(ctx context.Context, id string) (a storage.ClientAssertion, err error) {
+	err = c.QueryRow(`
+		select id, client_id, expiry
+		from client_assertion where id = $1;
+	`, id).Scan(&a.ID, &a.ClientID, &a.Expiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return a, storage.ErrNotFound
+		}
+		return a, fmt.Errorf("select client assertion: %v", err)
+	}
+	return a, nil
+}
+
+func (c *conn) CreateDPoPProof(ctx context.Context, p storage.DPoPProof) error {
+	_, err := c.Exec(`
+		insert into dpop_proof (id, expiry)
+		values ($1, $2);
+	`, p.ID, p.Expiry)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert dpop proof: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetDPoPProof(ctx context.Context, id string) (p storage.DPoPProof, err error) {
+	err = c.QueryRow(`
+		select id, expiry
+		from dpop_proof where id = $1;
+	`, id).Scan(&p.ID, &p.Expiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return p, storage.ErrNotFound
+		}
+		return p, fmt.Errorf("select dpop proof: %v", err)
+	}
+	return p, nil
+}
+
+func (c *conn) CreateConsent(ctx context.Context, cs storage.Consent) error {
+	_, err := c.Exec(`
+		insert into consent (
+			user_id, conn_id, client_id, scopes, created_at, updated_at
+		)
+		values (
+			$1, $2, $3, $4, $5, $6
+		);
+	`,
+		cs.UserID, cs.ConnectorID, cs.ClientID, encoder(cs.Scopes), cs.CreatedAt, cs.UpdatedAt,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert consent: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetConsent(ctx context.Context, userID, connID, clientID string) (storage.Consent, error) {
+	return getConsent(ctx, c, userID, connID, clientID)
+}
+
+func getConsent(ctx context.Context, q querier, userID, connID, clientID string) (storage.Consent, error) {
+	return scanConsent(q.QueryRow(`
+		select
+			user_id, conn_id, client_id, scopes, created_at, updated_at
+		from consent
+		where user_id = $1 AND conn_id = $2 AND client_id = $3;
+	`, userID, connID, clientID))
+}
+
+func (c *conn) ListConsents(ctx context.Context, userID, connID string) ([]storage.Consent, error) {
+	rows, err := c.Query(`
+		select
+			user_id, conn_id, client_id, scopes, created_at, updated_at
+		from consent
+		where user_id = $1 AND conn_id = $2;
+	`, userID, connID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var consents []storage.Consent
+	for rows.Next() {
+		cs, err := scanConsent(rows)
+		if err != nil {
+			return nil, err
+		}
+		consents = append(consents, cs)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return consents, nil
+}
+
+func (c *conn) UpdateConsent(ctx context.Context, userID, connID, clientID string, updater func(cs storage.Consent) (storage.Consent, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		cs, err := getConsent(ctx, tx, userID, connID, clientID)
+		if err != nil {
+			return err
+		}
+
+		nc, err := updater(cs)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update consent
+			set
+				scopes = $1,
+				updated_at = $2
+			where user_id = $3 AND conn_id = $4 AND client_id = $5;
+		`,
+			encoder(nc.Scopes), nc.UpdatedAt, userID, connID, clientID,
+		)
+		if err != nil {
+			return fmt.Errorf("update consent: %v", err)
+		}
+		return nil
+	})
+}
+
+func scanConsent(s scanner) (cs storage.Consent, err error) {
+	err = s.Scan(
+		&cs.UserID, &cs.ConnectorID, &cs.ClientID, decoder(&cs.Scopes), &cs.CreatedAt, &cs.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return cs, storage.ErrNotFound
+		}
+		return cs, fmt.Errorf("select consent: %v", err)
+	}
+	return cs, nil
+}
+
+func (c *conn) DeleteConsent(ctx context.Context, userID, connID, clientID string) error {
+	result, err := c.Exec(`delete from consent where user_id = $1 AND conn_id = $2 AND client_id = $3`, userID, connID, clientID)
+	if err != nil {
+		return fmt.Errorf("delete consent: %v", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %v", err)
+	}
+	if n < 1 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (c *conn) CreateScope(ctx context.Context, sc storage.Scope) error {
+	_, err := c.Exec(`
+		insert into scope (
+			name, descriptions, claims, client_ids
+		)
+		values (
+			$1, $2, $3, $4
+		);
+	`,
+		sc.Name, encoder(sc.Descriptions), encoder(sc.Claims), encoder(sc.ClientIDs),
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert scope: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetScope(ctx context.Context, name string) (storage.Scope, error) {
+	return getScope(ctx, c, name)
+}
+
+func getScope(ctx context.Context, q querier, name string) (storage.Scope, error) {
+	return scanScope(q.QueryRow(`
+		select
+			name, descriptions, claims, client_ids
+		from scope
+		where name = $1;
+	`, name))
+}
+
+func (c *conn) ListScopes(ctx context.Context) ([]storage.Scope, error) {
+	rows, err := c.Query(`
+		select
+			name, descriptions, claims, client_ids
+		from scope;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scopes []storage.Scope
+	for rows.Next() {
+		sc, err := scanScope(rows)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, sc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
+func (c *conn) UpdateScope(ctx context.Context, name string, updater func(sc storage.Scope) (storage.Scope, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		sc, err := getScope(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+
+		nsc, err := updater(sc)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update scope
+			set
+				descriptions = $1,
+				claims = $2,
+				client_ids = $3
+			where name = $4;
+		`,
+			encoder(nsc.Descriptions), encoder(nsc.Claims), encoder(nsc.ClientIDs), name,
+		)
+		if err != nil {
+			return fmt.Errorf("update scope: %v", err)
+		}
+		return nil
+	})
+}
+
+func scanScope(s scanner) (sc storage.Scope, err error) {
+	err = s.Scan(
+		&sc.Name, decoder(&sc.Descriptions), decoder(&sc.Claims), decoder(&sc.ClientIDs),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return sc, storage.ErrNotFound
+		}
+		return sc, fmt.Errorf("select scope: %v", err)
+	}
+	return sc, nil
+}
+
+func (c *conn) DeleteScope(ctx context.Context, name string) error {
+	return c.delete("scope", "name", name)
+}
@@ -374,4 +374,756 @@ var migrations = []migration{
 		},
 		flavor: &flavorMySQL,
 	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column post_logout_redirect_uris bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column post_logout_redirect_uris bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column post_logout_redirect_uris bytea;`,
+			`
+			update client
+				set post_logout_redirect_uris = 'null'
+				where post_logout_redirect_uris is null;`,
+			`
+			alter table client
+				modify column post_logout_redirect_uris bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			create table user_session (
+				id text not null primary key,
+				connector_id text not null,
+				connector_data bytea,
+
+				claims_user_id text not null,
+				claims_username text not null,
+				claims_preferred_username text not null,
+				claims_email text not null,
+				claims_email_verified boolean not null,
+				claims_groups bytea not null, -- JSON array of strings
+
+				created_at timestamptz not null,
+				last_used timestamptz not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column backchannel_logout_uri text not null default '';`,
+			`
+			create table logout_notification (
+				id text not null primary key,
+				client_id text not null,
+				subject text not null,
+				created_at timestamptz not null,
+				attempts integer not null,
+				next_attempt timestamptz not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column client_credentials_scopes bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table client
+				add column client_credentials_audiences bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column client_credentials_scopes bytea not null default 'null';`,
+			`
+			alter table client
+				add column client_credentials_audiences bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column client_credentials_scopes bytea;`,
+			`
+			alter table client
+				add column client_credentials_audiences bytea;`,
+			`
+			update client
+				set client_credentials_scopes = 'null', client_credentials_audiences = 'null';`,
+			`
+			alter table client
+				modify column client_credentials_scopes bytea not null;`,
+			`
+			alter table client
+				modify column client_credentials_audiences bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column access_token_format text not null default '';`,
+			`
+			create table access_token (
+				id text not null primary key,
+				client_id text not null,
+				scopes bytea not null, -- JSON array of strings
+				audience bytea not null, -- JSON array of strings
+
+				connector_id text not null,
+				claims_user_id text not null,
+				claims_username text not null,
+				claims_preferred_username text not null,
+				claims_email text not null,
+				claims_email_verified boolean not null,
+				claims_groups bytea not null, -- JSON array of strings
+
+				created_at timestamptz not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column prompt text not null default '';`,
+			`
+			alter table auth_request
+				add column max_age integer not null default -1;`,
+			`
+			alter table client
+				add column require_pushed_authorization_requests boolean not null default false;`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column jwks text not null default '';`,
+			`
+			alter table client
+				add column jwks_uri text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column registration_access_token_hash text not null default '';`,
+			`
+			create table initial_access_token (
+				id text not null primary key,
+				created_at timestamptz not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column token_endpoint_auth_method text not null default '';`,
+			`
+			create table client_assertion (
+				id text not null primary key,
+				client_id text not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column tls_client_auth_subject_dn text not null default '';`,
+			`
+			alter table access_token
+				add column certificate_thumbprint text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table refresh_token
+				add column dpop_key_thumbprint text not null default '';`,
+			`
+			alter table access_token
+				add column dpop_key_thumbprint text not null default '';`,
+			`
+			create table dpop_proof (
+				id text not null primary key,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column claim_mappings bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column claim_mappings bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column claim_mappings bytea;`,
+			`
+			update client
+				set claim_mappings = 'null';`,
+			`
+			alter table client
+				modify column claim_mappings bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column claims_extra bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table auth_code
+				add column claims_extra bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table refresh_token
+				add column claims_extra bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table access_token
+				add column claims_extra bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table user_session
+				add column claims_extra bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table offline_session
+				add column extra_claims bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column claims_extra bytea not null default 'null';`,
+			`
+			alter table auth_code
+				add column claims_extra bytea not null default 'null';`,
+			`
+			alter table refresh_token
+				add column claims_extra bytea not null default 'null';`,
+			`
+			alter table access_token
+				add column claims_extra bytea not null default 'null';`,
+			`
+			alter table user_session
+				add column claims_extra bytea not null default 'null';`,
+			`
+			alter table offline_session
+				add column extra_claims bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column claims_extra bytea;`,
+			`
+			update auth_request
+				set claims_extra = 'null';`,
+			`
+			alter table auth_request
+				modify column claims_extra bytea not null;`,
+			`
+			alter table auth_code
+				add column claims_extra bytea;`,
+			`
+			update auth_code
+				set claims_extra = 'null';`,
+			`
+			alter table auth_code
+				modify column claims_extra bytea not null;`,
+			`
+			alter table refresh_token
+				add column claims_extra bytea;`,
+			`
+			update refresh_token
+				set claims_extra = 'null';`,
+			`
+			alter table refresh_token
+				modify column claims_extra bytea not null;`,
+			`
+			alter table access_token
+				add column claims_extra bytea;`,
+			`
+			update access_token
+				set claims_extra = 'null';`,
+			`
+			alter table access_token
+				modify column claims_extra bytea not null;`,
+			`
+			alter table user_session
+				add column claims_extra bytea;`,
+			`
+			update user_session
+				set claims_extra = 'null';`,
+			`
+			alter table user_session
+				modify column claims_extra bytea not null;`,
+			`
+			alter table offline_session
+				add column extra_claims bytea;`,
+			`
+			update offline_session
+				set extra_claims = 'null';`,
+			`
+			alter table offline_session
+				modify column extra_claims bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column acr text not null default '';`,
+			`
+			alter table auth_request
+				add column auth_time timestamptz not null default '0001-01-01 00:00:00 UTC';`,
+			`
+			alter table auth_code
+				add column acr text not null default '';`,
+			`
+			alter table auth_code
+				add column auth_time timestamptz not null default '0001-01-01 00:00:00 UTC';`,
+			`
+			alter table refresh_token
+				add column acr text not null default '';`,
+			`
+			alter table refresh_token
+				add column auth_time timestamptz not null default '0001-01-01 00:00:00 UTC';`,
+			`
+			alter table user_session
+				add column acr text not null default '';`,
+			`
+			alter table user_session
+				add column auth_time timestamptz not null default '0001-01-01 00:00:00 UTC';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column acr_values bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table auth_request
+				add column amr bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table auth_code
+				add column amr bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table refresh_token
+				add column amr bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table user_session
+				add column amr bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column acr_values bytea not null default 'null';`,
+			`
+			alter table auth_request
+				add column amr bytea not null default 'null';`,
+			`
+			alter table auth_code
+				add column amr bytea not null default 'null';`,
+			`
+			alter table refresh_token
+				add column amr bytea not null default 'null';`,
+			`
+			alter table user_session
+				add column amr bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column acr_values bytea;`,
+			`
+			update auth_request
+				set acr_values = 'null';`,
+			`
+			alter table auth_request
+				modify column acr_values bytea not null;`,
+			`
+			alter table auth_request
+				add column amr bytea;`,
+			`
+			update auth_request
+				set amr = 'null';`,
+			`
+			alter table auth_request
+				modify column amr bytea not null;`,
+			`
+			alter table auth_code
+				add column amr bytea;`,
+			`
+			update auth_code
+				set amr = 'null';`,
+			`
+			alter table auth_code
+				modify column amr bytea not null;`,
+			`
+			alter table refresh_token
+				add column amr bytea;`,
+			`
+			update refresh_token
+				set amr = 'null';`,
+			`
+			alter table refresh_token
+				modify column amr bytea not null;`,
+			`
+			alter table user_session
+				add column amr bytea;`,
+			`
+			update user_session
+				set amr = 'null';`,
+			`
+			alter table user_session
+				modify column amr bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column login_hint text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column ui_locales bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column ui_locales bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column ui_locales bytea;`,
+			`
+			update auth_request
+				set ui_locales = 'null';`,
+			`
+			alter table auth_request
+				modify column ui_locales bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column subject_type text not null default '';`,
+			`
+			alter table client
+				add column sector_identifier_uri text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			create table consent (
+				user_id text not null,
+				conn_id text not null,
+				client_id text not null,
+				scopes bytea not null,
+				created_at timestamptz not null,
+				updated_at timestamptz not null,
+				primary key (user_id, conn_id, client_id)
+			);`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			create table consent (
+				user_id text not null,
+				conn_id text not null,
+				client_id text not null,
+				scopes bytea not null,
+				created_at timestamptz not null,
+				updated_at timestamptz not null,
+				primary key (user_id, conn_id, client_id)
+			);`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		// A key of three varchar(384) columns is longer than InnoDB allows.
+		stmts: []string{
+			`
+			create table consent (
+				user_id varchar(255) not null,
+				conn_id varchar(255) not null,
+				client_id varchar(255) not null,
+				scopes bytea not null,
+				created_at timestamptz not null,
+				updated_at timestamptz not null,
+				primary key (user_id, conn_id, client_id)
+			);`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column required_scopes bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column required_scopes bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column required_scopes bytea;`,
+			`
+			update client
+				set required_scopes = 'null';`,
+			`
+			alter table client
+				modify column required_scopes bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			create table scope (
+				name text not null primary key,
+				descriptions bytea not null,
+				claims bytea not null,
+				client_ids bytea not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column expiry bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column expiry bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column expiry bytea;`,
+			`
+			update client
+				set expiry = 'null';`,
+			`
+			alter table client
+				modify column expiry bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column allowed_connectors bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table client
+				add column allowed_grant_types bytea not null default convert_to('null', 'UTF8');`,
+			`
+			alter table client
+				add column allowed_response_types bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column allowed_connectors bytea not null default 'null';`,
+			`
+			alter table client
+				add column allowed_grant_types bytea not null default 'null';`,
+			`
+			alter table client
+				add column allowed_response_types bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column allowed_connectors bytea;`,
+			`
+			update client
+				set allowed_connectors = 'null';`,
+			`
+			alter table client
+				modify column allowed_connectors bytea not null;`,
+			`
+			alter table client
+				add column allowed_grant_types bytea;`,
+			`
+			update client
+				set allowed_grant_types = 'null';`,
+			`
+			alter table client
+				modify column allowed_grant_types bytea not null;`,
+			`
+			alter table client
+				add column allowed_response_types bytea;`,
+			`
+			update client
+				set allowed_response_types = 'null';`,
+			`
+			alter table client
+				modify column allowed_response_types bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column require_pkce boolean not null default false;`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column sid text not null default '';`,
+			`
+			alter table auth_code
+				add column sid text not null default '';`,
+			`
+			alter table refresh_token
+				add column sid text not null default '';`,
+			`
+			alter table user_session
+				add column sid text not null default '';`,
+			`
+			alter table logout_notification
+				add column session_id text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table user_session
+				add column client_ids bytea not null default convert_to('null', 'UTF8');`,
+		},
+		flavor: &flavorPostgres,
+	},
+	{
+		stmts: []string{
+			`
+			alter table user_session
+				add column client_ids bytea not null default 'null';`,
+		},
+		flavor: &flavorSQLite3,
+	},
+	{
+		stmts: []string{
+			`
+			alter table user_session
+				add column client_ids bytea;`,
+			`
+			update user_session
+				set client_ids = 'null';`,
+			`
+			alter table user_session
+				modify column client_ids bytea not null;`,
+		},
+		flavor: &flavorMySQL,
+	},
+	{
+		stmts: []string{
+			`
+			alter table access_token
+				add column refresh_token_id text not null default '';`,
+			`
+			create index access_token_refresh_token_id on access_token (refresh_token_id);`,
+		},
+	},
 }
@@ -280,3 +280,73 @@ func (s *staticConnectorsStorage) UpdateConnector(ctx context.Context, id string
 	}
 	return s.Storage.UpdateConnector(ctx, id, updater)
 }
+
+// staticScopesStorage represents a storage with a read-only set of scopes.
+type staticScopesStorage struct {
+	Storage
+
+	// A read-only set of scopes.
+	scopes       []Scope
+	scopesByName map[string]Scope
+}
+
+// WithStaticScopes returns a storage with a read-only set of scopes. Write
+// actions, such as updating existing scopes, will fail.
+func WithStaticScopes(s Storage, staticScopes []Scope) Storage {
+	scopesByName := make(map[string]Scope, len(staticScopes))
+	for _, sc := range staticScopes {
+		scopesByName[sc.Name] = sc
+	}
+	return staticScopesStorage{s, staticScopes, scopesByName}
+}
+
+func (s staticScopesStorage) isStatic(name string) bool {
+	_, ok := s.scopesByName[name]
+	return ok
+}
+
+func (s staticScopesStorage) GetScope(ctx context.Context, name string) (Scope, error) {
+	if sc, ok := s.scopesByName[name]; ok {
+		return sc, nil
+	}
+	return s.Storage.GetScope(ctx, name)
+}
+
+func (s staticScopesStorage) ListScopes(ctx context.Context) ([]Scope, error) {
+	scopes, err := s.Storage.ListScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n := 0
+	for _, sc := range scopes {
+		// If an entry has the same name as those provided in the static
+		// values, prefer the static value.
+		if !s.isStatic(sc.Name) {
+			scopes[n] = sc
+			n++
+		}
+	}
+	return append(scopes[:n], s.scopes...), nil
+}
+
+func (s staticScopesStorage) CreateScope(ctx context.Context, sc Scope) error {
+	if s.isStatic(sc.Name) {
+		return errors.New("static scopes: read-only cannot create scope")
+	}
+	return s.Storage.CreateScope(ctx, sc)
+}
+
+func (s staticScopesStorage) DeleteScope(ctx context.Context, name string) error {
+	if s.isStatic(name) {
+		return errors.New("static scopes: read-only cannot delete scope")
+	}
+	return s.Storage.DeleteScope(ctx, name)
+}
+
+func (s staticScopesStorage) UpdateScope(ctx context.Context, name string, updater func(old Scope) (Scope, error)) error {
+	if s.isStatic(name) {
+		return errors.New("static scopes: read-only cannot update scope")
+	}
+	return s.Storage.UpdateScope(ctx, name, updater)
+}
@@ -56,10 +56,16 @@ func NewHMACKey(h crypto.Hash) []byte {
 
 // GCResult returns the number of objects deleted by garbage collection.
 type GCResult struct {
-	AuthRequests   int64
-	AuthCodes      int64
-	DeviceRequests int64
-	DeviceTokens   int64
+	AuthRequests        int64
+	AuthCodes           int64
+	DeviceRequests      int64
+	DeviceTokens        int64
+	UserSessions        int64
+	LogoutNotifications int64
+	AccessTokens        int64
+	InitialAccessTokens int64
+	ClientAssertions    int64
+	DPoPProofs          int64
 }
 
 // IsEmpty returns whether the garbage collection result is empty or not.
@@ -67,7 +73,13 @@ func (g *GCResult) IsEmpty() bool {
 	return g.AuthRequests == 0 &&
 		g.AuthCodes == 0 &&
 		g.DeviceRequests == 0 &&
-		g.DeviceTokens == 0
+		g.DeviceTokens == 0 &&
+		g.UserSessions == 0 &&
+		g.LogoutNotifications == 0 &&
+		g.AccessTokens == 0 &&
+		g.InitialAccessTokens == 0 &&
+		g.ClientAssertions == 0 &&
+		g.DPoPProofs == 0
 }
 
 // Storage is the storage interface used by the server. Implementations are
@@ -86,6 +98,14 @@ type Storage interface {
 	CreateConnector(ctx context.Context, c Connector) error
 	CreateDeviceRequest(ctx context.Context, d DeviceRequest) error
 	CreateDeviceToken(ctx context.Context, d DeviceToken) error
+	CreateUserSession(ctx context.Context, s UserSession) error
+	CreateLogoutNotification(ctx context.Context, n LogoutNotification) error
+	CreateAccessToken(ctx context.Context, t AccessToken) error
+	CreateInitialAccessToken(ctx context.Context, t InitialAccessToken) error
+	CreateClientAssertion(ctx context.Context, a ClientAssertion) error
+	CreateDPoPProof(ctx context.Context, p DPoPProof) error
+	CreateConsent(ctx context.Context, c Consent) error
+	CreateScope(ctx context.Context, s Scope) error
 
 	// TODO(ericchiang): return (T, bool, error) so we can indicate not found
 	// requests that way instead of using ErrNotFound.
@@ -99,11 +119,23 @@ type Storage interface {
 	GetConnector(ctx context.Context, id string) (Connector, error)
 	GetDeviceRequest(ctx context.Context, userCode string) (DeviceRequest, error)
 	GetDeviceToken(ctx context.Context, deviceCode string) (DeviceToken, error)
+	GetUserSession(ctx context.Context, id string) (UserSession, error)
+	GetAccessToken(ctx context.Context, id string) (AccessToken, error)
+	GetInitialAccessToken(ctx context.Context, id string) (InitialAccessToken, error)
+	GetClientAssertion(ctx context.Context, id string) (ClientAssertion, error)
+	GetDPoPProof(ctx context.Context, id string) (DPoPProof, error)
+	GetConsent(ctx context.Context, userID, connID, clientID string) (Consent, error)
+	GetScope(ctx context.Context, name string) (Scope, error)
 
 	ListClients(ctx context.Context) ([]Client, error)
 	ListRefreshTokens(ctx context.Context) ([]RefreshToken, error)
 	ListPasswords(ctx context.Context) ([]Password, error)
 	ListConnectors(ctx context.Context) ([]Connector, error)
+	ListLogoutNotifications(ctx context.Context) ([]LogoutNotification, error)
+	// ListConsents returns the consents of the user with userID at the
+	// connector connID.
+	ListConsents(ctx context.Context, userID, connID string) ([]Consent, error)
+	ListScopes(ctx context.Context) ([]Scope, error)
 
 	// Delete methods MUST be atomic.
 	DeleteAuthRequest(ctx context.Context, id string) error
@@ -113,6 +145,15 @@ type Storage interface {
 	DeletePassword(ctx context.Context, email string) error
 	DeleteOfflineSessions(ctx context.Context, userID string, connID string) error
 	DeleteConnector(ctx context.Context, id string) error
+	DeleteUserSession(ctx context.Context, id string) error
+	DeleteLogoutNotification(ctx context.Context, id string) error
+	DeleteAccessToken(ctx context.Context, id string) error
+	// DeleteRefreshAccessTokens deletes the access tokens issued along with the
+	// refresh token with refreshID. It returns ErrNotFound if there are none.
+	DeleteRefreshAccessTokens(ctx context.Context, refreshID string) error
+	DeleteInitialAccessToken(ctx context.Context, id string) error
+	DeleteConsent(ctx context.Context, userID, connID, clientID string) error
+	DeleteScope(ctx context.Context, name string) error
 
 	// Update methods take a function for updating an object then performs that update within
 	// a transaction. "updater" functions may be called multiple times by a single update call.
@@ -136,9 +177,15 @@ type Storage interface {
 	UpdateOfflineSessions(ctx context.Context, userID string, connID string, updater func(s OfflineSessions) (OfflineSessions, error)) error
 	UpdateConnector(ctx context.Context, id string, updater func(c Connector) (Connector, error)) error
 	UpdateDeviceToken(ctx context.Context, deviceCode string, updater func(t DeviceToken) (DeviceToken, error)) error
+	UpdateUserSession(ctx context.Context, id string, updater func(s UserSession) (UserSession, error)) error
+	UpdateLogoutNotification(ctx context.Context, id string, updater func(n LogoutNotification) (LogoutNotification, error)) error
+	UpdateConsent(ctx context.Context, userID, connID, clientID string, updater func(c Consent) (Consent, error)) error
+	UpdateScope(ctx context.Context, name string, updater func(s Scope) (Scope, error)) error
 
 	// GarbageCollect deletes all expired AuthCodes,
-	// AuthRequests, DeviceRequests, and DeviceTokens.
+	// AuthRequests, DeviceRequests, DeviceTokens, UserSessions,
+	// LogoutNotifications, AccessTokens, InitialAccessTokens, ClientAssertions
+	// and DPoPProofs.
 	GarbageCollect(ctx context.Context, now time.Time) (GCResult, error)
 }
 
@@ -158,6 +205,85 @@ type Client struct {
 	// requested to redirect to MUST match one of these values, unless the client is "public".
 	RedirectURIs []string `json:"redirectURIs"`
 
+	// PostLogoutRedirectURIs are the URIs the client may ask dex to send the user back
+	// to after an RP-initiated logout. Matching is exact, and unlike RedirectURIs there is
+	// no loopback exception for public clients.
+	PostLogoutRedirectURIs []string `json:"postLogoutRedirectURIs"`
+
+	// BackchannelLogoutURI, if set, receives an OpenID Connect back-channel logout
+	// token whenever a user's session with this client is ended by dex.
+	BackchannelLogoutURI string `json:"backchannelLogoutURI"`
+
+	// ClientCredentialsScopes and ClientCredentialsAudiences are the scopes and
+	// audiences the client may request for itself through the client_credentials
+	// grant. Tokens from that grant are always issued to the client's own ID.
+	ClientCredentialsScopes    []string `json:"clientCredentialsScopes"`
+	ClientCredentialsAudiences []string `json:"clientCredentialsAudiences"`
+
+	// RequirePushedAuthorizationRequests rejects authorization requests from the
+	// client unless they were pushed to the PAR endpoint (RFC 9126) first.
+	RequirePushedAuthorizationRequests bool `json:"requirePushedAuthorizationRequests"`
+
+	// JWKS is a JSON Web Key Set holding the public keys of the client, and
+	// JWKSURI a URL to fetch it from. At most one of them is set. The keys verify
+	// the request objects (RFC 9101) and client assertions (RFC 7523) signed by
+	// the client.
+	JWKS    string `json:"jwks"`
+	JWKSURI string `json:"jwksURI"`
+
+	// RegistrationAccessTokenHash is the hash of the token a dynamically
+	// registered client manages its registration with (RFC 7592). It is empty
+	// for other clients.
+	RegistrationAccessTokenHash string `json:"registrationAccessTokenHash"`
+
+	// TokenEndpointAuthMethod restricts how the client authenticates at the
+	// token endpoint: "client_secret_basic", "client_secret_post",
+	// "client_secret_jwt", "private_key_jwt" or "none". If empty, the client
+	// can use any method it has credentials for.
+	TokenEndpointAuthMethod string `json:"tokenEndpointAuthMethod"`
+
+	// TLSClientAuthSubjectDN is the subject distinguished name, in RFC 4514
+	// form, of the certificate a "tls_client_auth" client authenticates with
+	// (RFC 8705).
+	TLSClientAuthSubjectDN string `json:"tlsClientAuthSubjectDN"`
+
+	// AccessTokenFormat is either "jwt", the default, or "opaque". Opaque access
+	// tokens are random strings kept in storage, so they carry no claims and can
+	// be revoked before they expire.
+	AccessTokenFormat string `json:"accessTokenFormat"`
+
+	// ClaimMappings customize the claims of the ID tokens, JWT access tokens,
+	// userinfo and introspection responses issued for the client. They are
+	// applied in order.
+	ClaimMappings []ClaimMapping `json:"claimMappings"`
+
+	// SubjectType is either "public", the default, where the "sub" claim is the
+	// same for every client, or "pairwise", where each sector gets its own
+	// identifier for a user. The sector is the host of SectorIdentifierURI if
+	// set, or else the host of the redirect URIs.
+	SubjectType         string `json:"subjectType"`
+	SectorIdentifierURI string `json:"sectorIdentifierURI"`
+
+	// RequiredScopes are the scopes the user can't deselect on the approval
+	// screen when the client requests them.
+	RequiredScopes []string `json:"requiredScopes"`
+
+	// Expiry overrides the server's token lifetimes and refresh token policy
+	// for the client. Settings it leaves empty fall back to the server's.
+	Expiry *ClientExpiry `json:"expiry,omitempty"`
+
+	// AllowedConnectors, AllowedGrantTypes and AllowedResponseTypes restrict
+	// the connectors users of the client log in with, the grants the client
+	// can use at the token endpoint and the response types it can request.
+	// Empty lists allow everything the server does.
+	AllowedConnectors    []string `json:"allowedConnectors"`
+	AllowedGrantTypes    []string `json:"allowedGrantTypes"`
+	AllowedResponseTypes []string `json:"allowedResponseTypes"`
+
+	// RequirePKCE rejects authorization code requests from the client without
+	// a PKCE code challenge (RFC 7636).
+	RequirePKCE bool `json:"requirePKCE"`
+
 	// TrustedPeers are a list of peers which can issue tokens on this client's behalf using
 	// the dynamic "oauth2:server:client_id:(client_id)" scope. If a peer makes such a request,
 	// this client's ID will appear as the ID Token's audience.
@@ -173,6 +299,42 @@ type Client struct {
 	LogoURL string `json:"logoURL"`
 }
 
+// ClaimMapping sets or removes a claim of the tokens issued for a client.
+type ClaimMapping struct {
+	// Claim is the name of the claim.
+	Claim string `json:"claim"`
+
+	// Template is a Go text/template rendering the value of the claim. The
+	// claim is removed if the template is empty.
+	Template string `json:"template,omitempty"`
+
+	// JSON parses the rendered template as JSON, for claims that are not
+	// strings.
+	JSON bool `json:"json,omitempty"`
+}
+
+// ClientExpiry holds the token lifetimes of a client. Durations are Go duration
+// strings, such as "5m" or "12h".
+type ClientExpiry struct {
+	// IDTokens is how long ID tokens are valid for.
+	IDTokens string `json:"idTokens,omitempty"`
+
+	// AccessTokens is how long access tokens are valid for. Defaults to
+	// IDTokens if that is set.
+	AccessTokens string `json:"accessTokens,omitempty"`
+
+	RefreshTokens ClientRefreshTokenExpiry `json:"refreshTokens"`
+}
+
+// ClientRefreshTokenExpiry overrides the refresh token policy for a client.
+type ClientRefreshTokenExpiry struct {
+	// DisableRotation, if set, turns refresh token rotation off or on.
+	DisableRotation *bool `json:"disableRotation,omitempty"`
+
+	ValidIfNotUsedFor string `json:"validIfNotUsedFor,omitempty"`
+	AbsoluteLifetime  string `json:"absoluteLifetime,omitempty"`
+}
+
 // Claims represents the ID Token claims supported by the server.
 type Claims struct {
 	UserID            string
@@ -182,6 +344,10 @@ type Claims struct {
 	EmailVerified     bool
 
 	Groups []string
+
+	// ExtraClaims are further attributes of the user passed on by the
+	// connector, keyed by claim name.
+	ExtraClaims map[string]any
 }
 
 // PKCE is a container for the data needed to perform Proof Key for Code Exchange (RFC 7636) auth flow
@@ -212,6 +378,20 @@ type AuthRequest struct {
 	// attempts.
 	ForceApprovalPrompt bool
 
+	// Prompt and MaxAge are the OpenID Connect "prompt" and "max_age" parameters.
+	// MaxAge is in seconds, and negative if the client did not send it.
+	Prompt string
+	MaxAge int
+
+	// ACRValues are the Authentication Context Class References the client
+	// requested through the "acr_values" parameter, in order of preference.
+	ACRValues []string
+
+	// LoginHint and UILocales are the OpenID Connect "login_hint" and
+	// "ui_locales" parameters, used to prefill and translate the login pages.
+	LoginHint string
+	UILocales []string
+
 	Expiry time.Time
 
 	// Has the user proved their identity through a backing identity provider?
@@ -223,6 +403,9 @@ type AuthRequest struct {
 	// with a backend.
 	Claims Claims
 
+	// How and when the end user authenticated. Set along with Claims.
+	Authentication Authentication
+
 	// The connector used to login the user and any data the connector wishes to persists.
 	// Set when the user authenticates.
 	ConnectorID   string
@@ -235,6 +418,21 @@ type AuthRequest struct {
 	HMACKey []byte
 }
 
+// Authentication records how and when an end user authenticated, which ID
+// tokens report in their "acr", "amr", "auth_time" and "sid" claims.
+type Authentication struct {
+	// ACR is the Authentication Context Class Reference the login met.
+	ACR string
+	// AMR lists the Authentication Method References (RFC 8176) of the
+	// methods used, such as "pwd" or "otp".
+	AMR []string
+	// Time is when the end user authenticated.
+	Time time.Time
+	// SessionID identifies the login to clients, which back-channel logouts
+	// refer to. Unlike the ID of a UserSession it is not a secret.
+	SessionID string
+}
+
 // AuthCode represents a code which can be exchanged for an OAuth2 token response.
 //
 // This value is created once an end user has authorized a client, the server has
@@ -267,6 +465,9 @@ type AuthCode struct {
 	ConnectorData []byte
 	Claims        Claims
 
+	// How and when the end user authenticated.
+	Authentication Authentication
+
 	Expiry time.Time
 
 	// PKCE CodeChallenge and CodeChallengeMethod
@@ -303,6 +504,15 @@ type RefreshToken struct {
 	// Nonce value supplied during the initial redirect. This is required to be part
 	// of the claims of any future id_token generated by the client.
 	Nonce string
+
+	// How and when the end user authenticated for the initial request.
+	// Refreshing does not authenticate the user again.
+	Authentication Authentication
+
+	// DPoPKeyThumbprint binds the refresh token of a public client to the DPoP
+	// key (RFC 9449) it was issued for. Refreshing requires a proof signed by
+	// that key. Empty for unbound tokens.
+	DPoPKeyThumbprint string
 }
 
 // RefreshTokenRef is a reference object that contains metadata about refresh tokens.
@@ -330,6 +540,10 @@ type OfflineSessions struct {
 
 	// Authentication data provided by an upstream source.
 	ConnectorData []byte
+
+	// ExtraClaims are the attributes the connector passed on for the user the
+	// last time they logged in or refreshed a token.
+	ExtraClaims map[string]any
 }
 
 // Password is an email to password mapping managed by the storage.
@@ -457,3 +671,149 @@ type DeviceToken struct {
 	PollIntervalSeconds int
 	PKCE                PKCE
 }
+
+// UserSession is a browser single sign-on session. It remembers the identity a
+// user proved to a connector, so later authorization requests from the same
+// browser can be answered without asking the connector again, whichever client
+// they come from.
+type UserSession struct {
+	// ID is the value of the session cookie.
+	ID string
+
+	// The connector the user logged in with, and the identity it returned.
+	ConnectorID   string
+	ConnectorData []byte
+	Claims        Claims
+
+	// How the user last authenticated with the connector.
+	Authentication Authentication
+
+	// ClientIDs lists the clients the session logged the user in to, which
+	// are told when it ends.
+	ClientIDs []string
+
+	// CreatedAt is when the user last authenticated with the connector.
+	CreatedAt time.Time
+	LastUsed  time.Time
+
+	// Expiry is the earlier of the idle and absolute timeouts. Using the session
+	// pushes the idle timeout back, so the server moves Expiry forward, but never
+	// past CreatedAt plus the absolute lifetime.
+	Expiry time.Time
+}
+
+// AccessToken is an opaque access token. Unlike a JWT access token it carries
+// no information itself: resource servers resolve it through the introspection
+// or userinfo endpoints, and deleting it revokes it immediately.
+type AccessToken struct {
+	// ID is the hash of the token value, which only the client holds.
+	ID string
+
+	ClientID string
+	Scopes   []string
+	Audience []string
+
+	// The connector the user logged in with, and the identity it returned.
+	// Tokens issued through the client_credentials grant have no connector,
+	// and the client ID as subject.
+	ConnectorID string
+	Claims      Claims
+
+	// RefreshTokenID is the ID of the refresh token issued along with the
+	// token, if any. Revoking the refresh token revokes the token as well.
+	RefreshTokenID string
+
+	// CertificateThumbprint binds the token to the client certificate the
+	// client authenticated with (RFC 8705). Empty for bearer tokens.
+	CertificateThumbprint string
+
+	// DPoPKeyThumbprint binds the token to the DPoP key (RFC 9449) of the
+	// token request. Empty for bearer tokens.
+	DPoPKeyThumbprint string
+
+	CreatedAt time.Time
+	Expiry    time.Time
+}
+
+// InitialAccessToken allows registering clients at the dynamic client
+// registration endpoint (RFC 7591). Admins issue them through the gRPC API.
+type InitialAccessToken struct {
+	// ID is the hash of the token value, which only the holder of the token knows.
+	ID string
+
+	CreatedAt time.Time
+	Expiry    time.Time
+}
+
+// Consent records the scopes a user granted a client on the approval screen,
+// so that they aren't asked again for them. There is one per user, connector
+// and client.
+type Consent struct {
+	UserID      string
+	ConnectorID string
+	ClientID    string
+
+	// Scopes the user granted the client. Later approvals add to them.
+	Scopes []string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Scope is a scope defined by admins on top of the ones built into dex.
+type Scope struct {
+	// Name clients request the scope with.
+	Name string `json:"name"`
+	// Descriptions of the scope shown on the approval screen, keyed by
+	// language code. The "en" description is the fallback.
+	Descriptions map[string]string `json:"descriptions"`
+	// Claims are the names of the extra claims of the user, as the connector
+	// returned them, which the scope releases.
+	Claims []string `json:"claims"`
+	// ClientIDs are the clients allowed to request the scope. If empty, every
+	// client is.
+	ClientIDs []string `json:"clientIDs"`
+}
+
+// ClientAssertion records a JWT a client authenticated with (RFC 7523), so
+// that it cannot be replayed until it expires.
+type ClientAssertion struct {
+	// ID is derived from the client ID and the "jti" claim of the assertion.
+	ID       string
+	ClientID string
+
+	Expiry time.Time
+}
+
+// DPoPProof records a DPoP proof (RFC 9449) the server accepted, so that it
+// cannot be replayed while it is fresh enough to be accepted again.
+type DPoPProof struct {
+	// ID is derived from the key thumbprint and the "jti" claim of the proof.
+	ID string
+
+	Expiry time.Time
+}
+
+// LogoutNotification is a back-channel logout that still has to be delivered
+// to a client. Notifications are queued in storage so a client that is down
+// when the user logs out still learns about it once it comes back.
+type LogoutNotification struct {
+	ID       string
+	ClientID string
+
+	// Subject is the "sub" claim of the ID tokens the client holds for the user.
+	Subject string
+	// SessionID is the "sid" claim of those ID tokens, if the logout ends a
+	// single sign-on session.
+	SessionID string
+
+	CreatedAt time.Time
+
+	// Attempts counts delivery attempts so far, and NextAttempt is the
+	// earliest time the next one may be made.
+	Attempts    int
+	NextAttempt time.Time
+
+	// Expiry is when dex stops trying to deliver the notification.
+	Expiry time.Time
+}